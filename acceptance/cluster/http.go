@@ -24,6 +24,7 @@ import (
 	"net/http"
 
 	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
@@ -39,6 +40,19 @@ var HTTPClient = http.Client{
 		},
 	}}
 
+// useClientCert makes HTTPClient present the client certificate of the user
+// found in certsDir. Without one, the nodes only serve their health
+// endpoints.
+func useClientCert(certsDir, user string) error {
+	cert, err := tls.LoadX509KeyPair(security.ClientCertPath(certsDir, user),
+		security.ClientKeyPath(certsDir, user))
+	if err != nil {
+		return err
+	}
+	HTTPClient.Transport.(*http.Transport).TLSClientConfig.Certificates = []tls.Certificate{cert}
+	return nil
+}
+
 // getJSON retrieves the URL specified by the parameters and
 // and unmarshals the result into the supplied interface.
 func getJSON(tls bool, hostport, path string, v interface{}) error {
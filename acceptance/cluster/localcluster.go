@@ -345,7 +345,7 @@ func (l *LocalCluster) createRoach(i int, cmd ...string) *Container {
 
 func (l *LocalCluster) createCACert() {
 	log.Infof("creating ca (%dbit) in: %s", keyLen, l.CertsDir)
-	maybePanic(security.RunCreateCACert(l.CertsDir, keyLen))
+	maybePanic(security.RunCreateCACert(l.CertsDir, security.CertOptions{KeySize: keyLen}))
 }
 
 func (l *LocalCluster) createNodeCerts() {
@@ -354,7 +354,8 @@ func (l *LocalCluster) createNodeCerts() {
 	for i := 0; i < l.numLocal; i++ {
 		nodes = append(nodes, nodeStr(i))
 	}
-	maybePanic(security.RunCreateNodeCert(l.CertsDir, keyLen, nodes))
+	maybePanic(security.RunCreateNodeCert(l.CertsDir, security.CertOptions{KeySize: keyLen}, nodes))
+	maybePanic(useClientCert(l.CertsDir, security.NodeUser))
 }
 
 func (l *LocalCluster) startNode(i int) *Container {
@@ -399,7 +400,7 @@ func (l *LocalCluster) startNode(i int) *Container {
   trace: %[2]s/debug/requests
   logs:  %[3]s/cockroach.INFO
   certs: %[4]s
-  pprof: docker exec -it %[5]s /bin/bash -c 'go tool pprof /cockroach <(wget --no-check-certificate -qO- https://$(hostname):26257/debug/pprof/heap)'`,
+  pprof: docker exec -it %[5]s /bin/bash -c 'go tool pprof /cockroach <(wget --no-check-certificate --certificate=/certs/node.client.crt --private-key=/certs/node.client.key -qO- https://$(hostname):26257/debug/pprof/heap)'`,
 		c.Name, uri, locallogDir, l.CertsDir, c.ID[:5]))
 	return c
 }
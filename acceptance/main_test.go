@@ -19,11 +19,9 @@
 package acceptance
 
 import (
-	"crypto/tls"
 	"encoding/json"
 	"flag"
 	"io/ioutil"
-	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -33,7 +31,6 @@ import (
 
 	"github.com/cockroachdb/cockroach/acceptance/cluster"
 	"github.com/cockroachdb/cockroach/acceptance/terrafarm"
-	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/caller"
@@ -155,22 +152,10 @@ func makeClient(t util.Tester, str string) (*client.DB, *stop.Stopper) {
 	return db, stopper
 }
 
-// HTTPClient is an http.Client configured for querying a cluster. We need to
-// run with "InsecureSkipVerify" (at least on Docker) due to the fact that we
-// cannot use a fixed hostname to reach the cluster. This in turn means that we
-// do not have a verified server name in the certs.
-var HTTPClient = http.Client{
-	Timeout: base.NetworkTimeout,
-	Transport: &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: true,
-		},
-	}}
-
 // getJSON retrieves the URL specified by the parameters and
 // and unmarshals the result into the supplied interface.
 func getJSON(url, rel string, v interface{}) error {
-	resp, err := HTTPClient.Get(url + rel)
+	resp, err := cluster.HTTPClient.Get(url + rel)
 	if err != nil {
 		if log.V(1) {
 			log.Info(err)
@@ -18,8 +18,11 @@ package base
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"log"
+	"net"
 	"net/http"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -56,12 +59,19 @@ type Context struct {
 	// the server is running ("node"), or the user passed in client calls.
 	User string
 
-	// Protects both clientTLSConfig and serverTLSConfig.
+	// TLSOptions restrict the TLS versions and cipher suites of the client
+	// and server TLS configs.
+	TLSOptions security.TLSOptions
+
+	// Protects clientTLSConfig, serverTLSConfig and caCerts.
 	tlsConfigMu sync.Mutex
 	// clientTLSConfig is the loaded client tlsConfig. It is initialized lazily.
 	clientTLSConfig *tls.Config
 	// serverTLSConfig is the loaded server tlsConfig. It is initialized lazily.
 	serverTLSConfig *tls.Config
+	// caCerts are the certificates of the CA the TLS configs were loaded
+	// with.
+	caCerts []*x509.Certificate
 
 	// httpClient is a lazily-initialized http client.
 	// It should be accessed through Context.GetHTTPClient() which will
@@ -116,6 +126,12 @@ func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 		if err != nil {
 			return nil, util.Errorf("error setting up client TLS config: %s", err)
 		}
+		if err := ctx.TLSOptions.Apply(cfg); err != nil {
+			return nil, util.Errorf("error setting up client TLS config: %s", err)
+		}
+		if err := ctx.loadCACertsLocked(); err != nil {
+			return nil, util.Errorf("error setting up client TLS config: %s", err)
+		}
 		ctx.clientTLSConfig = cfg
 	} else {
 		log.Println("no certificates directory specified: using insecure TLS")
@@ -149,11 +165,120 @@ func (ctx *Context) GetServerTLSConfig() (*tls.Config, error) {
 	if err != nil {
 		return nil, util.Errorf("error setting up server TLS config: %s", err)
 	}
+	if err := ctx.TLSOptions.Apply(cfg); err != nil {
+		return nil, util.Errorf("error setting up server TLS config: %s", err)
+	}
+	if err := ctx.loadCACertsLocked(); err != nil {
+		return nil, util.Errorf("error setting up server TLS config: %s", err)
+	}
 	ctx.serverTLSConfig = cfg
 
 	return ctx.serverTLSConfig, nil
 }
 
+// loadCACertsLocked records the certificates of the CA, unless they've been
+// loaded along with the other TLS config already.
+func (ctx *Context) loadCACertsLocked() error {
+	if ctx.caCerts != nil {
+		return nil
+	}
+	caCerts, err := security.LoadCACertificates(ctx.Certs)
+	if err != nil {
+		return err
+	}
+	ctx.caCerts = caCerts
+	return nil
+}
+
+// ReloadCertificates loads the certificates in the Certs directory again and
+// replaces the TLS configs returned by GetClientTLSConfig and
+// GetServerTLSConfig, which have to be loaded already, so that connections
+// established from now on use the new certificates. Existing connections are
+// unaffected. The new certificates are verified first; if they're invalid,
+// an error is returned and the current TLS configs are kept.
+func (ctx *Context) ReloadCertificates() error {
+	if ctx.Insecure || ctx.Certs == "" {
+		return nil
+	}
+
+	ctx.tlsConfigMu.Lock()
+	defer ctx.tlsConfigMu.Unlock()
+
+	caCerts, err := security.LoadCACertificates(ctx.Certs)
+	if err != nil {
+		return util.Errorf("error reloading CA certificate: %s", err)
+	}
+	var serverCfg, clientCfg *tls.Config
+	if ctx.serverTLSConfig != nil {
+		if serverCfg, err = security.LoadServerTLSConfig(ctx.Certs, ctx.User); err == nil {
+			if err = ctx.TLSOptions.Apply(serverCfg); err == nil {
+				err = security.VerifyTLSConfig(serverCfg)
+			}
+		}
+		if err != nil {
+			return util.Errorf("error reloading server TLS config: %s", err)
+		}
+	}
+	if ctx.clientTLSConfig != nil {
+		if clientCfg, err = security.LoadClientTLSConfig(ctx.Certs, ctx.User); err == nil {
+			if err = ctx.TLSOptions.Apply(clientCfg); err == nil {
+				err = security.VerifyTLSConfig(clientCfg)
+			}
+		}
+		if err != nil {
+			return util.Errorf("error reloading client TLS config: %s", err)
+		}
+	}
+
+	ctx.caCerts = caCerts
+	if serverCfg != nil {
+		ctx.serverTLSConfig = serverCfg
+	}
+	if clientCfg != nil {
+		ctx.clientTLSConfig = clientCfg
+	}
+	return nil
+}
+
+// SetTLSConfigs replaces the TLS configs of the context. It is used to pass
+// the configs reloaded by ReloadCertificates on to copies of the context.
+func (ctx *Context) SetTLSConfigs(serverCfg, clientCfg *tls.Config) {
+	ctx.tlsConfigMu.Lock()
+	defer ctx.tlsConfigMu.Unlock()
+	ctx.serverTLSConfig = serverCfg
+	ctx.clientTLSConfig = clientCfg
+}
+
+// LoadedCertificates returns the certificates of the loaded TLS configs: the
+// certificates of the CA, followed by the server and client certificates of
+// the context's user if they've been loaded.
+func (ctx *Context) LoadedCertificates() ([]security.CertInfo, error) {
+	ctx.tlsConfigMu.Lock()
+	defer ctx.tlsConfigMu.Unlock()
+
+	var certs []security.CertInfo
+	for _, caCert := range ctx.caCerts {
+		certs = append(certs, security.CertInfo{Filename: "ca.crt", Cert: caCert})
+	}
+	for _, c := range []struct {
+		filename string
+		cfg      *tls.Config
+	}{
+		{ctx.User + ".server.crt", ctx.serverTLSConfig},
+		{filepath.Base(security.ClientCertPath(ctx.Certs, ctx.User)), ctx.clientTLSConfig},
+	} {
+		if c.cfg == nil || len(c.cfg.Certificates) == 0 {
+			continue
+		}
+		cert, err := x509.ParseCertificate(c.cfg.Certificates[0].Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, security.CertInfo{Filename: c.filename, Cert: cert})
+	}
+	return certs, nil
+}
+
 // GetHTTPClient returns the context http client, initializing it
 // if needed. It uses the context client TLS config.
 func (ctx *Context) GetHTTPClient() (*http.Client, error) {
@@ -167,14 +292,28 @@ func (ctx *Context) GetHTTPClient() (*http.Client, error) {
 	if ctx.Insecure {
 		log.Println("running in insecure mode, this is strongly discouraged. See --insecure and --certs.")
 	}
-	tlsConfig, err := ctx.GetClientTLSConfig()
-	if err != nil {
+	if _, err := ctx.GetClientTLSConfig(); err != nil {
 		return nil, err
 	}
 	ctx.httpClient = &http.Client{
-		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Transport: ctx.NewHTTPTransport(),
 		Timeout:   NetworkTimeout,
 	}
 
 	return ctx.httpClient, nil
 }
+
+// NewHTTPTransport returns an http.Transport which establishes TLS
+// connections with the config returned by GetClientTLSConfig at the time, so
+// that new connections use reloaded certificates.
+func (ctx *Context) NewHTTPTransport() *http.Transport {
+	return &http.Transport{
+		DialTLS: func(network, addr string) (net.Conn, error) {
+			cfg, err := ctx.GetClientTLSConfig()
+			if err != nil {
+				return nil, err
+			}
+			return tls.DialWithDialer(&net.Dialer{Timeout: NetworkTimeout}, network, addr, cfg)
+		},
+	}
+}
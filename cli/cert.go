@@ -17,6 +17,10 @@
 package cli
 
 import (
+	"os"
+	"strings"
+	"time"
+
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util"
 
@@ -25,7 +29,46 @@ import (
 
 const defaultKeySize = 2048
 
+// certExpiryWarning is how long before their expiration certs are flagged
+// by the list command.
+const certExpiryWarning = 30 * 24 * time.Hour
+
 var keySize int
+var keyCurve string
+var certLifetime time.Duration
+var certIntermediate bool
+var certHosts hostList
+
+// hostList is a flag value which accumulates the hosts given by repeated
+// flags.
+type hostList []string
+
+// String implements the pflag.Value interface.
+func (h *hostList) String() string {
+	return strings.Join(*h, ",")
+}
+
+// Type implements the pflag.Value interface.
+func (h *hostList) Type() string {
+	return "hostList"
+}
+
+// Set implements the pflag.Value interface.
+func (h *hostList) Set(host string) error {
+	*h = append(*h, host)
+	return nil
+}
+
+// certOptions returns the options of generated certificates given by the
+// flags.
+func certOptions() security.CertOptions {
+	return security.CertOptions{
+		KeySize:      keySize,
+		Curve:        keyCurve,
+		Lifetime:     certLifetime,
+		Intermediate: certIntermediate,
+	}
+}
 
 // A createCACert command generates a CA certificate and stores it
 // in the cert directory.
@@ -35,6 +78,8 @@ var createCACertCmd = &cobra.Command{
 	Long: `
 Generates a new key pair and CA certificate, writing them to
 individual files in the directory specified by --certs (required).
+With --intermediate, an intermediate CA signed by the new CA is also
+generated, to sign node and client certificates.
 `,
 	SilenceUsage: true,
 	RunE:         runCreateCACert,
@@ -43,7 +88,7 @@ individual files in the directory specified by --certs (required).
 // runCreateCACert generates key pair and CA certificate and writes them
 // to their corresponding files.
 func runCreateCACert(cmd *cobra.Command, args []string) error {
-	if err := security.RunCreateCACert(context.Certs, keySize); err != nil {
+	if err := security.RunCreateCACert(context.Certs, certOptions()); err != nil {
 		return util.Errorf("failed to generate CA certificate: %s", err)
 	}
 	return nil
@@ -57,8 +102,13 @@ var createNodeCertCmd = &cobra.Command{
 	Long: `
 Generates server and client certificates and keys for a given node, writing them to
 individual files in the directory specified by --certs (required).
-The certs directory should contain a CA cert and key.
-At least one host should be passed in (either IP address of dns name).
+The certs directory should contain a CA cert and key, or an intermediate
+CA cert and key if --intermediate is specified.
+At least one host should be passed in (either IP address of dns name), either as
+an argument or with --host, which can be repeated. The hosts are placed in the
+subject alternative names of the server certificate, which clients verify the
+host they connect to against. A dns name may start with a "*" label, e.g.
+*.cluster.local, to match any host of a domain.
 `,
 	SilenceUsage: true,
 	RunE:         runCreateNodeCert,
@@ -67,7 +117,7 @@ At least one host should be passed in (either IP address of dns name).
 // runCreateNodeCert generates key pair and CA certificate and writes them
 // to their corresponding files.
 func runCreateNodeCert(cmd *cobra.Command, args []string) error {
-	if err := security.RunCreateNodeCert(context.Certs, keySize, args); err != nil {
+	if err := security.RunCreateNodeCert(context.Certs, certOptions(), append(args, certHosts...)); err != nil {
 		return util.Errorf("failed to generate node certificate: %s", err)
 	}
 	return nil
@@ -81,7 +131,10 @@ var createClientCertCmd = &cobra.Command{
 	Long: `
 Generates a new key pair and client certificate, writing them to
 individual files in the directory specified by --certs (required).
-The certs directory should contain a CA cert and key.
+The certs directory should contain a CA cert and key, or an intermediate
+CA cert and key if --intermediate is specified.
+The username is the common name of the certificate, which identifies the
+user to the server.
 `,
 	SilenceUsage: true,
 	RunE:         runCreateClientCert,
@@ -94,21 +147,75 @@ func runCreateClientCert(cmd *cobra.Command, args []string) error {
 		mustUsage(cmd)
 		return errMissingParams
 	}
-	if err := security.RunCreateClientCert(context.Certs, keySize, args[0]); err != nil {
+	if err := security.RunCreateClientCert(context.Certs, certOptions(), args[0]); err != nil {
 		return util.Errorf("failed to generate clent certificate: %s", err)
 	}
 	return nil
 }
 
+// A listCerts command lists the certificates in the cert directory.
+var listCertsCmd = &cobra.Command{
+	Use:   "list [options]",
+	Short: "list certs and their expirations",
+	Long: `
+Lists the certificates in the directory specified by --certs (required) with
+their principals and expirations. The principals are the common name and the
+hosts of a certificate. Certificates which expire within 30 days are flagged.
+`,
+	SilenceUsage: true,
+	RunE:         runListCerts,
+}
+
+// runListCerts prints the certificates in the certs directory.
+func runListCerts(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		mustUsage(cmd)
+		return errMissingParams
+	}
+	certs, err := security.ListCertificates(context.Certs)
+	if err != nil {
+		return util.Errorf("failed to list certificates: %s", err)
+	}
+
+	now := time.Now()
+	rows := make([][]string, len(certs))
+	for i, c := range certs {
+		principals := append([]string{c.Cert.Subject.CommonName}, c.Cert.DNSNames...)
+		for _, ip := range c.Cert.IPAddresses {
+			principals = append(principals, ip.String())
+		}
+		var status string
+		if now.After(c.Cert.NotAfter) {
+			status = "expired"
+		} else if c.Cert.NotAfter.Sub(now) < certExpiryWarning {
+			status = "expires soon"
+		}
+		rows[i] = []string{c.Filename, strings.Join(principals, ", "),
+			c.Cert.NotAfter.UTC().Format(time.RFC3339), status}
+	}
+	if err := printQueryOutput(os.Stdout, []string{"File", "Principals", "Expires", "Status"}, rows); err != nil {
+		return util.Errorf("failed to print certificates: %s", err)
+	}
+	return nil
+}
+
+// createCertCmds are the commands which generate certificates.
+var createCertCmds = []*cobra.Command{
+	createCACertCmd,
+	createNodeCertCmd,
+	createClientCertCmd,
+}
+
 var certCmds = []*cobra.Command{
 	createCACertCmd,
 	createNodeCertCmd,
 	createClientCertCmd,
+	listCertsCmd,
 }
 
 var certCmd = &cobra.Command{
 	Use:   "cert",
-	Short: "create ca, node, and client certs",
+	Short: "create and list ca, node, and client certs",
 	Run: func(cmd *cobra.Command, args []string) {
 		mustUsage(cmd)
 	},
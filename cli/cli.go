@@ -66,14 +66,18 @@ func init() {
 		logCmd,
 
 		sqlShellCmd,
+		dumpCmd,
+		loadCmd,
 		kvCmd,
 		userCmd,
 		rangeCmd,
 		zoneCmd,
+		nodeCmd,
 
 		// Miscellaneous commands.
 		// TODO(pmattis): stats
 		versionCmd,
+		debugCmd,
 	)
 }
 
@@ -18,15 +18,21 @@ package cli
 
 import (
 	"bytes"
+	"database/sql"
+	"encoding/csv"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
 )
@@ -371,52 +377,87 @@ func Example_max_results() {
 func Example_zone() {
 	c := newCLITest()
 
-	zone100 := `replicas:
+	db, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=%s",
+		security.RootUser, c.ServingAddr(), security.EmbeddedCertsDir))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE DATABASE t`,
+		`CREATE TABLE t.f (a INT PRIMARY KEY)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := db.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zoneT := `replicas:
+- attrs: [us-east-1a,ssd]
+range_min_bytes: 1048576
+range_max_bytes: 67108864
+`
+	zoneTF := `replicas:
 - attrs: [us-east-1a,ssd]
 - attrs: [us-east-1b,ssd]
 - attrs: [us-west-1b,ssd]
 range_min_bytes: 8388608
 range_max_bytes: 67108864
 `
-	c.Run("zone ls")
 	// Call RunWithArgs to bypass the "split-by-whitespace" arg builder.
-	c.RunWithArgs([]string{"zone", "set", "100", zone100})
-	c.Run("zone ls")
-	c.Run("zone get 100")
-	c.Run("zone rm 100")
+	c.RunWithArgs([]string{"zone", "set", "t", zoneT})
+	c.Run("zone get t.f")
+	c.RunWithArgs([]string{"zone", "set", "t.f", zoneTF})
+	c.Run("zone get t.f")
 	c.Run("zone ls")
+	c.Run("zone rm t.f")
+	c.Run("zone get t.f")
+	c.Run("zone rm .default")
 	c.Run("quit")
 
 	// Output:
-	// zone ls
-	// zone set 100 replicas:
+	// zone set t replicas:
 	// - attrs: [us-east-1a,ssd]
-	// - attrs: [us-east-1b,ssd]
-	// - attrs: [us-west-1b,ssd]
-	// range_min_bytes: 8388608
+	// range_min_bytes: 1048576
 	// range_max_bytes: 67108864
 	//
-	// OK
-	// zone ls
-	// Object 100:
+	// ok
+	// zone get t.f
+	// # inherited from t
 	// replicas:
 	// - attrs: [us-east-1a, ssd]
-	// - attrs: [us-east-1b, ssd]
-	// - attrs: [us-west-1b, ssd]
+	// range_min_bytes: 1048576
+	// range_max_bytes: 67108864
+	// zone set t.f replicas:
+	// - attrs: [us-east-1a,ssd]
+	// - attrs: [us-east-1b,ssd]
+	// - attrs: [us-west-1b,ssd]
 	// range_min_bytes: 8388608
 	// range_max_bytes: 67108864
 	//
-	// zone get 100
+	// ok
+	// zone get t.f
 	// replicas:
 	// - attrs: [us-east-1a, ssd]
 	// - attrs: [us-east-1b, ssd]
 	// - attrs: [us-west-1b, ssd]
 	// range_min_bytes: 8388608
 	// range_max_bytes: 67108864
-	//
-	// zone rm 100
-	// OK
 	// zone ls
+	// t
+	// t.f
+	// zone rm t.f
+	// ok
+	// zone get t.f
+	// # inherited from t
+	// replicas:
+	// - attrs: [us-east-1a, ssd]
+	// range_min_bytes: 1048576
+	// range_max_bytes: 67108864
+	// zone rm .default
+	// the default zone config cannot be removed
 	// quit
 	// node drained and shutdown: ok
 }
@@ -506,25 +547,34 @@ func TestFlagUsage(t *testing.T) {
 Available Commands:
   init        init new Cockroach cluster
   start       start a node by joining the gossip network
-  cert        create ca, node, and client certs
+  cert        create and list ca, node, and client certs
   exterminate destroy all data held by the node
   quit        drain and shutdown node
 
   log         make log files human-readable
 
   sql         open a sql shell
+  dump        dump sql tables
+  load        load data into sql tables
   kv          get, put, conditional put, increment, delete, scan, and reverse scan key/value pairs
   user        get, set, list and remove users
   range       list, split and merge ranges
   zone        get, set, list and remove zones
+  node        list nodes, show their status, and decommission them
 
   version     output version information
+  debug       debugging commands
 
 Flags:
       --alsologtostderr         log to standard error as well as files
       --color                   colorize standard error output according to severity (default "auto")
       --log-backtrace-at        when logging hits line file:N, emit a stack trace (default :0)
       --log-dir                 if non-empty, write log files in this directory
+      --log-file-max-size       roll over to a new log file once the current one exceeds this many bytes (default 10485760)
+      --log-format              format of log entries written to standard error and log files: text or json (default text)
+      --log-max-age             remove log files not written to for longer than this; 0 for no limit (default 0s)
+      --log-max-files           maximum number of log files kept per severity; 0 for no limit
+      --log-max-total-size      maximum combined size in bytes of the log files kept per severity; 0 for no limit (default 104857600)
       --logtostderr             log to standard error instead of files (default true)
       --verbosity               log level for V logs
       --vmodule                 comma-separated list of pattern=N settings for file-filtered logging
@@ -536,3 +586,109 @@ Use "cockroach [command] --help" for more information about a command.
 		t.Errorf("got:\n%s\n----\nexpected:\n%s", got, expected)
 	}
 }
+
+// captureStdout returns everything written to os.Stdout while running f.
+func captureStdout(f func()) string {
+	old := os.Stdout
+	defer func() {
+		os.Stdout = old
+	}()
+
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	done := make(chan struct{})
+	var buf bytes.Buffer
+	go func() {
+		_, _ = io.Copy(&buf, r)
+		close(done)
+	}()
+	f()
+	w.Close()
+	<-done
+	return buf.String()
+}
+
+// TestNodeStatus verifies the tables rendered by "node ls" and "node status"
+// in each of the output formats.
+func TestNodeStatus(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	context.InitDefaults()
+
+	s := &server.TestServer{Ctx: server.NewTestContext()}
+	// Have the node write its status summary right away.
+	s.Ctx.MetricsFrequency = 10 * time.Millisecond
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	c := cliTest{TestServer: s}
+	defer c.Stop()
+
+	run := func(line string) []string {
+		out := captureStdout(func() { c.Run(line) })
+		// The first line echoes the command.
+		return strings.Split(strings.TrimSpace(out), "\n")[1:]
+	}
+
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if lines := run("node ls --format=tsv"); !reflect.DeepEqual(lines, []string{"id", "1"}) {
+			return util.Errorf("unexpected node listing %q", lines)
+		}
+		return nil
+	})
+
+	for _, line := range []string{"node status --format=csv", "node status 1 --format=csv"} {
+		records, err := csv.NewReader(strings.NewReader(strings.Join(run(line), "\n"))).ReadAll()
+		if err != nil {
+			t.Fatalf("%s: %s", line, err)
+		}
+		if len(records) != 2 {
+			t.Fatalf("%s: expected a header and a single node, got %q", line, records)
+		}
+		if a, e := records[0], nodeStatusColumns; !reflect.DeepEqual(a, e) {
+			t.Errorf("%s: expected columns %q, got %q", line, e, a)
+		}
+		row := records[1]
+		if a, e := row[0], "1"; a != e {
+			t.Errorf("%s: expected node ID %s, got %s", line, e, a)
+		}
+		if a, e := row[1], c.ServingAddr(); a != e {
+			t.Errorf("%s: expected address %s, got %s", line, e, a)
+		}
+		if a, e := row[2], c.PGAddr(); a != e {
+			t.Errorf("%s: expected SQL address %s, got %s", line, e, a)
+		}
+		if a, e := row[6], "true"; a != e {
+			t.Errorf("%s: expected node to be live, got %s", line, a)
+		}
+		if n, err := strconv.Atoi(row[7]); err != nil || n <= 0 {
+			t.Errorf("%s: expected a positive range count, got %q", line, row[7])
+		}
+		// Leaders and capacity are only reported once the store has
+		// published its status.
+		for _, i := range []int{8, 9, 10} {
+			if n, err := strconv.ParseInt(row[i], 10, 64); err != nil || n < 0 {
+				t.Errorf("%s: expected non-negative %s, got %q", line, nodeStatusColumns[i], row[i])
+			}
+		}
+	}
+
+	lines := run("node status")
+	if len(lines) != 6 {
+		t.Fatalf("expected a table with a single node, got:\n%s", strings.Join(lines, "\n"))
+	}
+	if !regexp.MustCompile(`^\| +id +\| +address +\|`).MatchString(lines[1]) {
+		t.Errorf("unexpected table header %q", lines[1])
+	}
+	if !regexp.MustCompile(`^\| +1 +\| +` + regexp.QuoteMeta(c.ServingAddr()) + ` +\|`).MatchString(lines[3]) {
+		t.Errorf("unexpected table row %q", lines[3])
+	}
+	if a, e := lines[5], "1 result(s)"; a != e {
+		t.Errorf("expected %q, got %q", e, a)
+	}
+
+	if lines := run("node status --format=json"); len(lines) != 1 ||
+		!strings.Contains(lines[0], `unknown format "json"`) {
+		t.Errorf("expected an unknown format error, got %q", lines)
+	}
+}
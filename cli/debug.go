@@ -0,0 +1,241 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/spf13/cobra"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+var debugKeysFrom, debugKeysTo, debugKeysValues string
+
+// openStore opens the store in dir read-only. The store is closed when the
+// stopper is stopped. The store must not be in use by a running node.
+func openStore(dir string, stopper *stop.Stopper) engine.Engine {
+	db := engine.NewReadOnlyRocksDB(roachpb.Attributes{}, dir, context.CacheSize,
+		context.MemtableBudget, stopper)
+	if err := db.Open(); err != nil {
+		panicf("unable to open store %s: %s\n", dir, err)
+	}
+	return db
+}
+
+// A debugKeysCmd command dumps the keys of a store.
+var debugKeysCmd = &cobra.Command{
+	Use:   "keys [options] <directory>",
+	Short: "dump all the keys in a store",
+	Long: `
+Pretty-prints all the keys in the store at <directory>, or those in the span
+given by --from and --to, along with their MVCC timestamps. The store is opened
+read-only and must not be in use by a running node.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runDebugKeys),
+}
+
+// parseDebugKey returns the key given as the argument of a flag, either in
+// the human readable format of keys.PrettyPrint if it starts with a slash
+// (e.g. /Table/51/1), or as an escaped raw key otherwise.
+func parseDebugKey(arg string) roachpb.Key {
+	if strings.HasPrefix(arg, "/") {
+		key, err := keys.UglyPrint(arg)
+		if err != nil {
+			panicf("%s\n", err)
+		}
+		return key
+	}
+	return roachpb.Key(unquoteArg(arg, false))
+}
+
+func runDebugKeys(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		mustUsage(cmd)
+		return
+	}
+
+	var formatValue func([]byte) string
+	switch debugKeysValues {
+	case "":
+	case "hex":
+		formatValue = hex.EncodeToString
+	case "escaped":
+		formatValue = func(v []byte) string { return strconv.Quote(string(v)) }
+	default:
+		panicf("unknown value format %q; expected hex or escaped\n", debugKeysValues)
+	}
+
+	from := engine.MakeMVCCMetadataKey(parseDebugKey(debugKeysFrom))
+	to := engine.MakeMVCCMetadataKey(roachpb.KeyMax)
+	if debugKeysTo != "" {
+		to = engine.MakeMVCCMetadataKey(parseDebugKey(debugKeysTo))
+	}
+
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	db := openStore(args[0], stopper)
+
+	if err := db.Iterate(from, to, func(kv engine.MVCCKeyValue) (bool, error) {
+		if formatValue == nil {
+			fmt.Printf("%s\n", kv.Key)
+		} else {
+			fmt.Printf("%s %s\n", kv.Key, formatValue(kv.Value))
+		}
+		return false, nil
+	}); err != nil {
+		panicf("unable to iterate over store: %s\n", err)
+	}
+}
+
+// A debugRangeDescriptorsCmd command prints the range descriptors of a store.
+var debugRangeDescriptorsCmd = &cobra.Command{
+	Use:   "range-descriptors [options] <directory>",
+	Short: "print all range descriptors in a store",
+	Long: `
+Prints all the versions of the local copies of the range descriptors in the
+store at <directory>. The store is opened read-only and must not be in use by
+a running node.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runDebugRangeDescriptors),
+}
+
+func runDebugRangeDescriptors(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		mustUsage(cmd)
+		return
+	}
+
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	db := openStore(args[0], stopper)
+
+	start := engine.MakeMVCCMetadataKey(keys.LocalRangePrefix)
+	end := engine.MakeMVCCMetadataKey(keys.LocalRangeMax)
+	if err := db.Iterate(start, end, func(kv engine.MVCCKeyValue) (bool, error) {
+		// Skip the MVCC metadata and any range-local keys other than the
+		// range descriptors.
+		if !kv.Key.IsValue() {
+			return false, nil
+		}
+		_, suffix, _, err := keys.DecodeRangeKey(kv.Key.Key)
+		if err != nil {
+			return false, err
+		}
+		if !bytes.Equal(suffix, keys.LocalRangeDescriptorSuffix) {
+			return false, nil
+		}
+		value := roachpb.Value{RawBytes: kv.Value}
+		if len(value.RawBytes) == 0 {
+			// A deletion tombstone.
+			fmt.Printf("%s: <deleted>\n", kv.Key)
+			return false, nil
+		}
+		var desc roachpb.RangeDescriptor
+		if err := value.GetProto(&desc); err != nil {
+			return false, err
+		}
+		fmt.Printf("%s: %s\n", kv.Key, &desc)
+		return false, nil
+	}); err != nil {
+		panicf("unable to read range descriptors: %s\n", err)
+	}
+}
+
+// A debugRaftLogCmd command prints the raft log of a range.
+var debugRaftLogCmd = &cobra.Command{
+	Use:   "raft-log [options] <directory> <range-id>",
+	Short: "print the raft log of a range",
+	Long: `
+Prints the raft log entries of the range with the given ID in the store at
+<directory>, including the commands they contain. The store is opened
+read-only and must not be in use by a running node.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runDebugRaftLog),
+}
+
+func runDebugRaftLog(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		mustUsage(cmd)
+		return
+	}
+	id, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		panicf("invalid range ID %q: %s\n", args[1], err)
+	}
+	rangeID := roachpb.RangeID(id)
+
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	db := openStore(args[0], stopper)
+
+	printEntry := func(kv roachpb.KeyValue) (bool, error) {
+		var ent raftpb.Entry
+		if err := kv.Value.GetProto(&ent); err != nil {
+			return false, err
+		}
+		fmt.Printf("Index:%d Term:%d Type:%s\n", ent.Index, ent.Term, ent.Type)
+		commandID, command, err := storage.DecodeRaftEntry(ent)
+		if err != nil {
+			return false, err
+		}
+		if command == nil {
+			fmt.Printf("\t<empty>\n")
+			return false, nil
+		}
+		fmt.Printf("\tcommand-id=%x replica=%s: %s\n", commandID, &command.OriginReplica, command.Cmd)
+		return false, nil
+	}
+	start := keys.RaftLogPrefix(rangeID)
+	if _, err := engine.MVCCIterate(db, start, start.PrefixEnd(), roachpb.ZeroTimestamp,
+		true /* consistent */, nil /* txn */, false /* !reverse */, printEntry); err != nil {
+		panicf("unable to read raft log of range %d: %s\n", rangeID, err)
+	}
+}
+
+var debugCmds = []*cobra.Command{
+	debugKeysCmd,
+	debugRangeDescriptorsCmd,
+	debugRaftLogCmd,
+}
+
+var debugCmd = &cobra.Command{
+	Use:   "debug [command]",
+	Short: "debugging commands",
+	Long: `Various commands for debugging.
+
+These commands inspect the stores of a node which is not running and are
+useful when a node is unable to start.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		mustUsage(cmd)
+	},
+}
+
+func init() {
+	debugCmd.AddCommand(debugCmds...)
+}
@@ -0,0 +1,97 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestDebugCommands runs the debug commands against the store of a stopped
+// server.
+func TestDebugCommands(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	context.InitDefaults()
+
+	dir := util.CreateTempDir(t, "debug_store")
+	defer util.CleanupDir(dir)
+
+	// The engine outlives the server, which would otherwise remove the
+	// directory of its on-disk stores when stopped.
+	engStopper := stop.NewStopper()
+	eng := engine.NewRocksDB(roachpb.Attributes{}, dir, 1<<20, 1<<20, engStopper)
+	if err := eng.Open(); err != nil {
+		t.Fatal(err)
+	}
+	s := &server.TestServer{Ctx: server.NewTestContext()}
+	s.Ctx.Engines = []engine.Engine{eng}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if pErr := s.DB().Put("debug-key", "debug-value"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	s.Stop()
+	engStopper.Stop()
+
+	run := func(args ...string) string {
+		var err error
+		out := captureStdout(func() {
+			err = Run(append([]string{"debug"}, args...))
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", args, err)
+		}
+		return out
+	}
+
+	out := run("keys", dir, "--from=debug", "--to=debug-key\\x00", "--values=escaped")
+	found := false
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if !strings.HasPrefix(line, `"debug-key"`) {
+			t.Errorf("unexpected key outside of span: %s", line)
+		}
+		// Only the version of the key, as opposed to its MVCC metadata,
+		// carries a timestamp.
+		if strings.HasPrefix(line, `"debug-key"/`) && strings.HasSuffix(line, `debug-value"`) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected the written key and value in output:\n%s", out)
+	}
+
+	if out := run("keys", dir, "--from=debug", "--to=debug-key\\x00", "--values=hex"); !strings.Contains(out,
+		"64656275672d76616c7565" /* debug-value */) {
+		t.Errorf("expected hex value in output:\n%s", out)
+	}
+
+	if out := run("range-descriptors", dir); !strings.Contains(out, "/RangeDescriptor/") ||
+		!strings.Contains(out, "range_id:1 ") {
+		t.Errorf("expected the descriptor of the first range in output:\n%s", out)
+	}
+
+	out = run("raft-log", dir, "1")
+	if !strings.Contains(out, "Index:") || !strings.Contains(out, "Put [") {
+		t.Errorf("expected raft log entries with put commands in output:\n%s", out)
+	}
+}
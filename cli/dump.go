@@ -0,0 +1,452 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+var dumpFormat string
+
+// dumpPageSize is the number of rows read by each SELECT statement of a
+// dump. It is a variable for testing.
+var dumpPageSize = 1000
+
+// dumpInsertSize is the number of rows per INSERT statement of a dump.
+const dumpInsertSize = 100
+
+// implicitRowIDDefault is the default expression of the hidden "rowid"
+// column added to tables created without a primary key.
+const implicitRowIDDefault = "experimental_unique_int()"
+
+// A dumpCmd command dumps the tables of a database.
+var dumpCmd = &cobra.Command{
+	Use:   "dump [options] <database> [<table>...]",
+	Short: "dump sql tables",
+	Long: `
+Dumps the schema and contents of the given tables, or of all the tables in
+<database> if none are given, as SQL statements which recreate them. Each table
+is dumped as a CREATE TABLE statement followed by INSERT statements for its rows
+in primary key order. Table names are not qualified by the database name, so the
+dump can be loaded into any database.
+
+With --format=csv, the rows of a single table are dumped as comma separated
+values with a header line instead.
+
+All tables are read in a single transaction, so the dump is a consistent
+snapshot of the data.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runDump),
+}
+
+func runDump(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		mustUsage(cmd)
+		return
+	}
+	switch dumpFormat {
+	case "sql":
+	case "csv":
+		if len(args) != 2 {
+			panicf("--format=csv requires exactly one table")
+		}
+	default:
+		panicf("unknown format %q; expected sql or csv", dumpFormat)
+	}
+
+	db := makeSQLClient()
+	defer func() { _ = db.Close() }()
+	if err := dumpTables(os.Stdout, db, args[0], args[1:], dumpFormat); err != nil {
+		panicf("unable to dump %s: %s", args[0], err)
+	}
+}
+
+// dumpTables writes the given tables of the database to w in the given format
+// ("sql" or "csv"). If no tables are given, all the tables of the database are
+// dumped.
+func dumpTables(w io.Writer, db *sql.DB, dbName string, tables []string, format string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	// Rolling back is a no-op once the transaction has been committed.
+	defer func() { _ = tx.Rollback() }()
+
+	if len(tables) == 0 {
+		if tables, err = dumpTableNames(tx, dbName); err != nil {
+			return err
+		}
+	}
+	for i, table := range tables {
+		schema, err := dumpSchema(tx, dbName, table)
+		if err != nil {
+			return err
+		}
+		if format == "csv" {
+			if err := dumpCSV(w, tx, schema); err != nil {
+				return err
+			}
+			continue
+		}
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s;\n", schema.createStatement())
+		if err := dumpInserts(w, tx, schema); err != nil {
+			return err
+		}
+	}
+	// Committing the read-only transaction fails if its reads did not all
+	// observe the same snapshot.
+	return tx.Commit()
+}
+
+// dumpTableNames returns the names of the tables of the database.
+func dumpTableNames(tx *sql.Tx, dbName string) ([]string, error) {
+	rows, err := tx.Query(fmt.Sprintf("SHOW TABLES FROM %s", parser.Name(dbName)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+// A dumpColumn is a column of a dumped table.
+type dumpColumn struct {
+	name, typ    string
+	nullable     bool
+	defaultValue sql.NullString
+}
+
+// A dumpIndex is an index of a dumped table.
+type dumpIndex struct {
+	name    string
+	unique  bool
+	columns []string // "<name> ASC" or "<name> DESC"
+	storing []string
+}
+
+// A dumpTableSchema is the schema of a dumped table, as reported by SHOW
+// COLUMNS and SHOW INDEX.
+type dumpTableSchema struct {
+	dbName, name string
+	columns      []dumpColumn
+	primaryKey   []string
+	// implicitPrimaryKey is set if the table was created without a primary
+	// key and is keyed by a hidden row ID column.
+	implicitPrimaryKey bool
+	indexes            []dumpIndex
+}
+
+// dumpSchema reads the schema of the table. The hidden row ID column of a
+// table created without a primary key is left out, since recreating the table
+// adds it again.
+func dumpSchema(tx *sql.Tx, dbName, table string) (*dumpTableSchema, error) {
+	schema := &dumpTableSchema{dbName: dbName, name: table}
+	qualified := schema.qualifiedName()
+
+	rows, err := tx.Query(fmt.Sprintf("SHOW COLUMNS FROM %s", qualified))
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var col dumpColumn
+		if err := rows.Scan(&col.name, &col.typ, &col.nullable, &col.defaultValue); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		schema.columns = append(schema.columns, col)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.Query(fmt.Sprintf("SHOW INDEX FROM %s", qualified))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tableName, name, column, direction string
+		var unique, storing bool
+		var seq int64
+		if err := rows.Scan(&tableName, &name, &unique, &seq, &column, &direction, &storing); err != nil {
+			return nil, err
+		}
+		// The primary index is listed first.
+		if len(schema.indexes) == 0 || schema.indexes[len(schema.indexes)-1].name != name {
+			schema.indexes = append(schema.indexes, dumpIndex{name: name, unique: unique})
+		}
+		idx := &schema.indexes[len(schema.indexes)-1]
+		if storing {
+			idx.storing = append(idx.storing, parser.Name(column).String())
+		} else {
+			idx.columns = append(idx.columns, fmt.Sprintf("%s %s", parser.Name(column), direction))
+		}
+		if len(schema.indexes) == 1 && !storing {
+			schema.primaryKey = append(schema.primaryKey, column)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(schema.indexes) == 0 {
+		return nil, fmt.Errorf("table %s has no primary key", qualified)
+	}
+
+	if len(schema.primaryKey) == 1 && schema.primaryKey[0] == "rowid" {
+		for i, col := range schema.columns {
+			if col.name == "rowid" && col.defaultValue.String == implicitRowIDDefault {
+				schema.columns = append(schema.columns[:i], schema.columns[i+1:]...)
+				schema.implicitPrimaryKey = true
+				break
+			}
+		}
+	}
+	return schema, nil
+}
+
+func (s *dumpTableSchema) qualifiedName() string {
+	return fmt.Sprintf("%s.%s", parser.Name(s.dbName), parser.Name(s.name))
+}
+
+// columnNames returns the quoted names of the dumped columns.
+func (s *dumpTableSchema) columnNames() []string {
+	names := make([]string, len(s.columns))
+	for i, col := range s.columns {
+		names[i] = parser.Name(col.name).String()
+	}
+	return names
+}
+
+// createStatement returns the CREATE TABLE statement for the table.
+func (s *dumpTableSchema) createStatement() string {
+	var defs []string
+	for _, col := range s.columns {
+		def := fmt.Sprintf("%s %s", parser.Name(col.name), col.typ)
+		if !col.nullable {
+			def += " NOT NULL"
+		}
+		if col.defaultValue.Valid {
+			def += " DEFAULT " + col.defaultValue.String
+		}
+		defs = append(defs, def)
+	}
+	if !s.implicitPrimaryKey {
+		pk := make([]string, len(s.primaryKey))
+		for i, col := range s.primaryKey {
+			pk[i] = parser.Name(col).String()
+		}
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(pk, ", ")))
+	}
+	for _, idx := range s.indexes[1:] {
+		def := fmt.Sprintf("INDEX %s (%s)", parser.Name(idx.name), strings.Join(idx.columns, ", "))
+		if idx.unique {
+			def = "UNIQUE " + def
+		}
+		if len(idx.storing) > 0 {
+			def += fmt.Sprintf(" STORING (%s)", strings.Join(idx.storing, ", "))
+		}
+		defs = append(defs, def)
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n\t%s\n)", parser.Name(s.name), strings.Join(defs, ",\n\t"))
+}
+
+// scanPages reads the rows of the table in primary key order, dumpPageSize
+// rows at a time, and calls fn with the values of each row.
+func (s *dumpTableSchema) scanPages(tx *sql.Tx, fn func([]interface{}) error) error {
+	order := make([]string, len(s.primaryKey))
+	for i, col := range s.primaryKey {
+		order[i] = parser.Name(col).String()
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s ORDER BY %s LIMIT %d",
+		strings.Join(s.columnNames(), ", "), s.qualifiedName(), strings.Join(order, ", "), dumpPageSize)
+
+	vals := make([]interface{}, len(s.columns))
+	for i := range vals {
+		vals[i] = new(interface{})
+	}
+	row := make([]interface{}, len(s.columns))
+	for offset := 0; ; offset += dumpPageSize {
+		rows, err := tx.Query(fmt.Sprintf("%s OFFSET %d", query, offset))
+		if err != nil {
+			return err
+		}
+		n := 0
+		for rows.Next() {
+			if err := rows.Scan(vals...); err != nil {
+				_ = rows.Close()
+				return err
+			}
+			for i, v := range vals {
+				row[i] = *v.(*interface{})
+			}
+			if err := fn(row); err != nil {
+				_ = rows.Close()
+				return err
+			}
+			n++
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+		if n < dumpPageSize {
+			return nil
+		}
+	}
+}
+
+// dumpInserts writes INSERT statements for the rows of the table to w.
+func dumpInserts(w io.Writer, tx *sql.Tx, s *dumpTableSchema) error {
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES", parser.Name(s.name), strings.Join(s.columnNames(), ", "))
+	var values []string
+	flush := func() {
+		if len(values) > 0 {
+			fmt.Fprintf(w, "%s\n\t%s;\n", insert, strings.Join(values, ",\n\t"))
+			values = values[:0]
+		}
+	}
+	if err := s.scanPages(tx, func(row []interface{}) error {
+		literals := make([]string, len(row))
+		for i, val := range row {
+			var err error
+			if literals[i], err = sqlLiteral(val); err != nil {
+				return fmt.Errorf("column %s: %s", s.columns[i].name, err)
+			}
+		}
+		values = append(values, fmt.Sprintf("(%s)", strings.Join(literals, ", ")))
+		if len(values) == dumpInsertSize {
+			flush()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	flush()
+	return nil
+}
+
+// dumpCSV writes a header line with the column names of the table followed
+// by the values of its rows to w.
+func dumpCSV(w io.Writer, tx *sql.Tx, s *dumpTableSchema) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(s.columns))
+	for i, col := range s.columns {
+		header[i] = col.name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := s.scanPages(tx, func(row []interface{}) error {
+		record := make([]string, len(row))
+		for i, val := range row {
+			var err error
+			if record[i], err = csvValue(val); err != nil {
+				return fmt.Errorf("column %s: %s", s.columns[i].name, err)
+			}
+		}
+		return cw.Write(record)
+	}); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// sqlLiteral returns the SQL literal for a value returned by the driver.
+// Bytes are rendered as hex escapes and timestamps include their zone.
+func sqlLiteral(val interface{}) (string, error) {
+	switch t := val.(type) {
+	case nil:
+		return "NULL", nil
+	case bool:
+		return parser.DBool(t).String(), nil
+	case int64:
+		return parser.DInt(t).String(), nil
+	case float64:
+		if math.IsNaN(t) || math.IsInf(t, 0) {
+			return fmt.Sprintf("CAST(%s AS FLOAT)", parser.DString(strconv.FormatFloat(t, 'g', -1, 64))), nil
+		}
+		return parser.DFloat(t).String(), nil
+	case string:
+		return parser.DString(t).String(), nil
+	case []byte:
+		var buf bytes.Buffer
+		buf.WriteString("b'")
+		for _, b := range t {
+			fmt.Fprintf(&buf, `\x%02x`, b)
+		}
+		buf.WriteString("'")
+		return buf.String(), nil
+	case driver.Date:
+		return fmt.Sprintf("DATE %s", parser.DString(t.String())), nil
+	case time.Time:
+		return fmt.Sprintf("TIMESTAMP %s", parser.DString(parser.DTimestamp{Time: t}.String())), nil
+	case time.Duration:
+		return fmt.Sprintf("INTERVAL %s", parser.DString(t.String())), nil
+	}
+	return "", fmt.Errorf("unsupported value %v of type %T", val, val)
+}
+
+// csvValue returns the CSV field for a value returned by the driver. NULL is
+// rendered as an empty field, bytes as "\x" followed by their hex encoding and
+// timestamps include their zone.
+func csvValue(val interface{}) (string, error) {
+	switch t := val.(type) {
+	case nil:
+		return "", nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	case float64:
+		return strconv.FormatFloat(t, 'g', -1, 64), nil
+	case string:
+		return t, nil
+	case []byte:
+		return `\x` + hex.EncodeToString(t), nil
+	case driver.Date:
+		return t.String(), nil
+	case time.Time:
+		return parser.DTimestamp{Time: t}.String(), nil
+	case time.Duration:
+		return t.String(), nil
+	}
+	return "", fmt.Errorf("unsupported value %v of type %T", val, val)
+}
@@ -0,0 +1,123 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestDumpRoundTrip dumps a table, loads the dump into a fresh server and
+// verifies that the schema and contents of the table are preserved.
+func TestDumpRoundTrip(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	context.InitDefaults()
+
+	// Read the rows over several pages.
+	defer func(pageSize int) { dumpPageSize = pageSize }(dumpPageSize)
+	dumpPageSize = 3
+
+	src := server.StartTestServer(t)
+	defer src.Stop()
+	srcDB := makeTestDBClient(t, src)
+	defer srcDB.Close()
+
+	if _, err := srcDB.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.t (
+	id INT PRIMARY KEY,
+	s STRING,
+	b BYTES,
+	f FLOAT NOT NULL DEFAULT 1.5,
+	ok BOOL,
+	day DATE,
+	ts TIMESTAMP,
+	dur INTERVAL,
+	INDEX s_idx (s DESC) STORING (f)
+);
+CREATE TABLE d.nopk (x INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := srcDB.Exec(fmt.Sprintf(`INSERT INTO d.t VALUES ($1, $2, $3, $4, $5, DATE '2016-03-26',
+	TIMESTAMP '2016-03-26 10:10:%02d.123456789+02:00', INTERVAL '%dh%dm')`, i, i, i),
+			i, fmt.Sprintf("it's\n%d", i), []byte{0, byte(i), 0xff, '\''}, float64(i)/3, i%2 == 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := srcDB.Exec(`
+INSERT INTO d.t (id) VALUES (10);
+INSERT INTO d.nopk VALUES (1), (NULL);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	var dumpErr error
+	dump := captureStdout(func() {
+		dumpErr = Run([]string{"dump", fmt.Sprintf("--addr=%s", src.ServingAddr()),
+			fmt.Sprintf("--certs=%s", security.EmbeddedCertsDir), "d"})
+	})
+	if dumpErr != nil {
+		t.Fatal(dumpErr)
+	}
+	if !strings.Contains(dump, `b'\x00\x01\xff\x27'`) {
+		t.Errorf("expected bytes to be dumped as hex:\n%s", dump)
+	}
+
+	dst := server.StartTestServer(t)
+	defer dst.Stop()
+	dstDB := makeTestDBClient(t, dst)
+	defer dstDB.Close()
+	if _, err := dstDB.Exec("CREATE DATABASE d; SET DATABASE = d;\n" + dump); err != nil {
+		t.Fatalf("unable to load dump: %s\n%s", err, dump)
+	}
+
+	for _, query := range []string{
+		`SHOW COLUMNS FROM d.t`,
+		`SHOW INDEX FROM d.t`,
+		`SELECT * FROM d.t ORDER BY id`,
+		`SELECT * FROM d.nopk ORDER BY x`,
+	} {
+		_, expected, err := runQuery(srcDB, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, actual, err := runQuery(dstDB, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: expected\n%s\ngot\n%s", query, expected, actual)
+		}
+	}
+
+	csvDump := captureStdout(func() {
+		dumpErr = Run([]string{"dump", fmt.Sprintf("--addr=%s", src.ServingAddr()),
+			fmt.Sprintf("--certs=%s", security.EmbeddedCertsDir), "--format=csv", "d", "t"})
+	})
+	if dumpErr != nil {
+		t.Fatal(dumpErr)
+	}
+	if e := "id,s,b,f,ok,day,ts,dur\n0,\"it's\n0\",\\x0000ff27,0,true,2016-03-26,"; !strings.HasPrefix(csvDump, e) {
+		t.Errorf("expected csv dump to start with %q, got %q", e, csvDump)
+	}
+}
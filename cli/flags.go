@@ -23,10 +23,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
 )
 
 var maxResults int64
+var nodeFormat string
 
 // pflagValue wraps flag.Value and implements the extra methods of the
 // pflag.Value interface.
@@ -67,7 +69,23 @@ var flagUsage = map[string]string{
 `,
 	"certs": `
         Directory containing RSA key and x509 certs. This flag is required if
-        --insecure=false.
+        --insecure=false. A running node reloads the certs when it receives
+        SIGHUP.
+`,
+	"tls-min-version": `
+        The minimum TLS version, 1.0, 1.1 or 1.2, of the connections to and
+        from the node. Defaults to 1.2, or 1.0 with --tls-compat.
+`,
+	"tls-cipher-suites": `
+        A comma-separated list of the names of the cipher suites allowed for
+        the connections to and from the node, such as
+        TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256. Defaults to the AEAD suites with
+        forward secrecy, or all the suites supported with --tls-compat.
+`,
+	"tls-compat": `
+        Relax the default TLS version and cipher suites for compatibility with
+        older clients: TLS 1.0 and all the supported suites are allowed unless
+        --tls-min-version or --tls-cipher-suites are given.
 `,
 	"gossip": `
         A comma-separated list of gossip addresses or resolvers for gossip
@@ -78,16 +96,38 @@ var flagUsage = map[string]string{
           or "self" for single-node systems.
         - unix: unix socket
         - lb: RPC load balancer forwarding to an arbitrary node
+        - srv: DNS name whose SRV records list the nodes; the records are
+          looked up again on every pass through the nodes
         - http-lb: HTTP load balancer: we query
           http(s)://<address>/_status/details/local
 `,
 	"key-size": `
         Key size in bits for CA/Node/Client certificates.
+`,
+	"curve": `
+        Generate ECDSA keys on the given elliptic curve, one of P224, P256,
+        P384 or P521, instead of RSA keys of --key-size bits.
+`,
+	"lifetime": `
+        Duration for which the generated certificates are valid.
+`,
+	"intermediate": `
+        Use an intermediate CA, ca.intermediate.{crt,key}: create-ca generates
+        it along with the CA, and node and client certificates are signed by
+        it and include its certificate in their chain.
+`,
+	"host": `
+        Host name or IP address placed in the node's server certificate. Can
+        be repeated.
 `,
 	"linearizable": `
         Enables linearizable behaviour of operations on this node by making
         sure that no commit timestamp is reported back to the client until all
         other node clocks have necessarily passed it.
+`,
+	"drain-timeout": `
+        Maximum duration of each phase of draining the node, such as waiting
+        for open SQL transactions to finish, before shutting it down.
 `,
 	"dev": `
         Runs the node as a standalone in-memory cluster and forces --insecure
@@ -127,6 +167,31 @@ var flagUsage = map[string]string{
 		Adjusts the timeout for stores.  If there's been no gossiped updated
 		from a store after this time, the store is considered unavailable.
         Replicas on an unavailable store will be moved to available ones.
+`,
+	"slow-request-threshold": `
+        Requests to the node taking longer than this are counted in the
+        exec.slow-count metric. Zero disables the count.
+`,
+	"max-time-series": `
+        The maximum number of time series recorded by the node at each
+        interval. Series of the stores are dropped before those of the node,
+        and counted in the internal.tsd.series.truncated metric. Zero means
+        no limit.
+`,
+	"cert-expiry-warning": `
+        How long before their expiration warnings are logged about the
+        certificates loaded by the node. The time remaining until their
+        expiration is recorded in the certs.<name>.expiry-seconds metrics.
+`,
+	"crl": `
+        Path to a PEM or DER encoded certificate revocation list, published by
+        the CA, against which client certificates are checked. The file is
+        read again whenever it changes.
+`,
+	"crl-fail-open": `
+        Accept client certificates when the certificate revocation list can't
+        be read, isn't signed by their issuer or has expired. By default they
+        are rejected.
 `,
 	"stores": `
         A comma-separated list of stores, specified by a colon-separated list
@@ -141,10 +206,104 @@ var flagUsage = map[string]string{
 `,
 	"max-results": `
         Define the maximum number of results that will be retrieved.
+`,
+	"format": `
+        The output format: "pretty" (default) prints a table, while "tsv" and
+        "csv" print tab and comma separated values.
+`,
+	"dump-format": `
+        The dump format: "sql" (default) prints CREATE TABLE and INSERT
+        statements, while "csv" prints comma separated values.
+`,
+	"table": `
+        The table to load, as <database>.<table>.
+`,
+	"file": `
+        The file to load. Defaults to standard input.
+`,
+	"delimiter": `
+        The field delimiter of the loaded file.
+`,
+	"null": `
+        The field value which is loaded as NULL. Defaults to an empty field.
+`,
+	"header": `
+        Map the fields of the loaded file to columns by the names in its first
+        line, rather than in the order of the columns of the table.
+`,
+	"skip-errors": `
+        Write the records which can not be loaded to the --rejects file instead
+        of aborting the load.
+`,
+	"rejects": `
+        The file which receives the records rejected with --skip-errors.
 `,
 	"balance-mode": `
 		Determines the criteria used by nodes to make balanced allocation
 		decisions.  Valid options are "usage" (default) or "rangecount".
+`,
+	"unsafe-debug-endpoints": `
+        Serve the /debug/ endpoints without requiring a client certificate.
+        WARNING: these endpoints expose stack traces and heap contents and
+        this is only intended for insecure development clusters.
+`,
+	"skip-version-check": `
+        Start the node even if its binary does not support the version of the
+        cluster. WARNING: this may corrupt data and is only intended for
+        development.
+`,
+	"listening-url-file": `
+        After the node has joined the cluster and is serving clients, write
+        a JSON document with its node ID, cluster ID and RPC, HTTP and SQL
+        addresses to this file. The file is removed on clean shutdown.
+`,
+	"profile-dir": `
+        Directory in which heap, goroutine and CPU profiles requested through
+        the /_status/profile/ endpoints are stored. Only the most recent
+        profiles are retained. If empty, profile capture is disabled.
+`,
+	"event-webhook-url": `
+        URL to which events such as schema changes, nodes joining the cluster
+        and changes in the number of under-replicated ranges are POSTed in
+        batches as JSON. Events are dropped, oldest first, if the webhook is
+        unavailable for long. If empty, events are not forwarded.
+`,
+	"event-webhook-secret": `
+        Shared secret with which requests to the event webhook are signed. The
+        hex encoded HMAC-SHA256 of each request body is sent in the
+        X-Cockroach-Signature header.
+`,
+	"event-webhook-types": `
+        Comma-separated list of the types of the events forwarded to the event
+        webhook, e.g. "create_table,ranges_underreplicated". Defaults to all.
+`,
+	"syslog-addr": `
+        Address of a syslog daemon to which a copy of the log entries is
+        forwarded as RFC 5424 messages, in the form network://address with
+        network one of udp, tcp, unix or unixgram, e.g. "udp://localhost:514"
+        or "unixgram:///dev/log". Entries are dropped while the daemon can't
+        keep up. If empty, entries are not forwarded.
+`,
+	"syslog-facility": `
+        Facility of the log entries forwarded to the syslog daemon, e.g.
+        "daemon" or "local0".
+`,
+	"syslog-severity": `
+        Minimum severity of the log entries forwarded to the syslog daemon:
+        INFO, WARNING, ERROR or FATAL.
+`,
+	"from": `
+        Start key in a key-value store dump. Defaults to the first key. Keys
+        starting with a slash are in the pretty-printed format of the dump,
+        e.g. /Table/51/1; other keys are raw, with Go-style escapes.
+`,
+	"to": `
+        Exclusive end key in a key-value store dump. Defaults to the last key.
+        Accepts the same formats as --from.
+`,
+	"values": `
+        Print the value of each key in a key-value store dump, either as "hex"
+        or as a Go-"escaped" string. By default values are not printed.
 `,
 	"password": `
         The created user's password. If provided, disables prompting. Pass '-' to provide
@@ -182,6 +341,14 @@ func initFlags(ctx *server.Context) {
 		// Server flags.
 		f.StringVar(&ctx.Addr, "addr", ctx.Addr, flagUsage["addr"])
 		f.StringVar(&ctx.PGAddr, "pgaddr", ctx.PGAddr, flagUsage["pgaddr"])
+		f.StringVar(&ctx.ListeningURLFile, "listening-url-file", ctx.ListeningURLFile, flagUsage["listening-url-file"])
+		f.StringVar(&ctx.ProfileDir, "profile-dir", ctx.ProfileDir, flagUsage["profile-dir"])
+		f.StringVar(&ctx.EventWebhookURL, "event-webhook-url", ctx.EventWebhookURL, flagUsage["event-webhook-url"])
+		f.StringVar(&ctx.EventWebhookSecret, "event-webhook-secret", ctx.EventWebhookSecret, flagUsage["event-webhook-secret"])
+		f.StringVar(&ctx.EventWebhookTypes, "event-webhook-types", ctx.EventWebhookTypes, flagUsage["event-webhook-types"])
+		f.StringVar(&ctx.SyslogAddr, "syslog-addr", ctx.SyslogAddr, flagUsage["syslog-addr"])
+		f.StringVar(&ctx.SyslogFacility, "syslog-facility", ctx.SyslogFacility, flagUsage["syslog-facility"])
+		f.StringVar(&ctx.SyslogSeverity, "syslog-severity", ctx.SyslogSeverity, flagUsage["syslog-severity"])
 		f.StringVar(&ctx.Attrs, "attrs", ctx.Attrs, flagUsage["attrs"])
 		f.StringVar(&ctx.Stores, "stores", ctx.Stores, flagUsage["stores"])
 		f.DurationVar(&ctx.MaxOffset, "max-offset", ctx.MaxOffset, flagUsage["max-offset"])
@@ -191,6 +358,14 @@ func initFlags(ctx *server.Context) {
 		// Security flags.
 		f.StringVar(&ctx.Certs, "certs", ctx.Certs, flagUsage["certs"])
 		f.BoolVar(&ctx.Insecure, "insecure", ctx.Insecure, flagUsage["insecure"])
+		f.StringVar(&ctx.TLSOptions.MinVersion, "tls-min-version", ctx.TLSOptions.MinVersion, flagUsage["tls-min-version"])
+		f.StringVar(&ctx.TLSOptions.CipherSuites, "tls-cipher-suites", ctx.TLSOptions.CipherSuites, flagUsage["tls-cipher-suites"])
+		f.BoolVar(&ctx.TLSOptions.Compat, "tls-compat", ctx.TLSOptions.Compat, flagUsage["tls-compat"])
+		f.DurationVar(&ctx.CertExpiryWarning, "cert-expiry-warning", ctx.CertExpiryWarning, flagUsage["cert-expiry-warning"])
+		f.StringVar(&ctx.CRLFile, "crl", ctx.CRLFile, flagUsage["crl"])
+		f.BoolVar(&ctx.CRLFailOpen, "crl-fail-open", ctx.CRLFailOpen, flagUsage["crl-fail-open"])
+		f.BoolVar(&ctx.UnsafeDebugEndpoints, "unsafe-debug-endpoints", ctx.UnsafeDebugEndpoints, flagUsage["unsafe-debug-endpoints"])
+		f.BoolVar(&ctx.SkipVersionCheck, "skip-version-check", ctx.SkipVersionCheck, flagUsage["skip-version-check"])
 
 		// Gossip flags.
 		f.StringVar(&ctx.GossipBootstrap, "gossip", ctx.GossipBootstrap, flagUsage["gossip"])
@@ -204,6 +379,8 @@ func initFlags(ctx *server.Context) {
 		f.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, flagUsage["scan-interval"])
 		f.DurationVar(&ctx.ScanMaxIdleTime, "scan-max-idle-time", ctx.ScanMaxIdleTime, flagUsage["scan-max-idle-time"])
 		f.DurationVar(&ctx.TimeUntilStoreDead, "time-until-store-dead", ctx.TimeUntilStoreDead, flagUsage["time-until-store-dead"])
+		f.DurationVar(&ctx.SlowRequestThreshold, "slow-request-threshold", ctx.SlowRequestThreshold, flagUsage["slow-request-threshold"])
+		f.IntVar(&ctx.MaxTimeSeries, "max-time-series", ctx.MaxTimeSeries, flagUsage["max-time-series"])
 
 		if err := startCmd.MarkFlagRequired("gossip"); err != nil {
 			panic(err)
@@ -224,20 +401,30 @@ func initFlags(ctx *server.Context) {
 	for _, cmd := range certCmds {
 		f := cmd.Flags()
 		f.StringVar(&ctx.Certs, "certs", ctx.Certs, flagUsage["certs"])
-		f.IntVar(&keySize, "key-size", defaultKeySize, flagUsage["key-size"])
 		if err := cmd.MarkFlagRequired("certs"); err != nil {
 			panic(err)
 		}
+	}
+
+	for _, cmd := range createCertCmds {
+		f := cmd.Flags()
+		f.IntVar(&keySize, "key-size", defaultKeySize, flagUsage["key-size"])
+		f.StringVar(&keyCurve, "curve", "", flagUsage["curve"])
+		f.DurationVar(&certLifetime, "lifetime", security.DefaultCertLifetime, flagUsage["lifetime"])
+		f.BoolVar(&certIntermediate, "intermediate", false, flagUsage["intermediate"])
 		if err := cmd.MarkFlagRequired("key-size"); err != nil {
 			panic(err)
 		}
 	}
+	createNodeCertCmd.Flags().Var(&certHosts, "host", flagUsage["host"])
 
 	setUserCmd.Flags().StringVar(&password, "password", "", flagUsage["password"])
 
+	quitCmd.Flags().DurationVar(&drainTimeout, "drain-timeout", drainTimeout, flagUsage["drain-timeout"])
+
 	clientCmds := []*cobra.Command{
-		sqlShellCmd, kvCmd, rangeCmd,
-		userCmd, zoneCmd,
+		sqlShellCmd, dumpCmd, loadCmd, kvCmd, rangeCmd,
+		userCmd, zoneCmd, nodeCmd,
 		exterminateCmd, quitCmd, /* startCmd is covered above */
 	}
 	for _, cmd := range clientCmds {
@@ -254,6 +441,33 @@ func initFlags(ctx *server.Context) {
 		f := cmd.Flags()
 		f.Int64Var(&maxResults, "max-results", 1000, flagUsage["max-results"])
 	}
+
+	// Output format flag for the node listing and status.
+	for _, cmd := range []*cobra.Command{lsNodesCmd, statusNodeCmd} {
+		f := cmd.Flags()
+		f.StringVar(&nodeFormat, "format", "pretty", flagUsage["format"])
+	}
+
+	// The dump format shares its flag name with the output format above.
+	dumpCmd.Flags().StringVar(&dumpFormat, "format", "sql", flagUsage["dump-format"])
+
+	{
+		f := loadCSVCmd.Flags()
+		f.StringVar(&loadTable, "table", "", flagUsage["table"])
+		f.StringVar(&loadFile, "file", "", flagUsage["file"])
+		f.StringVar(&loadDelimiter, "delimiter", ",", flagUsage["delimiter"])
+		f.StringVar(&loadNull, "null", "", flagUsage["null"])
+		f.BoolVar(&loadHeader, "header", true, flagUsage["header"])
+		f.BoolVar(&loadSkipErrors, "skip-errors", false, flagUsage["skip-errors"])
+		f.StringVar(&loadRejects, "rejects", "", flagUsage["rejects"])
+	}
+
+	{
+		f := debugKeysCmd.Flags()
+		f.StringVar(&debugKeysFrom, "from", "", flagUsage["from"])
+		f.StringVar(&debugKeysTo, "to", "", flagUsage["to"])
+		f.StringVar(&debugKeysValues, "values", "", flagUsage["values"])
+	}
 }
 
 func init() {
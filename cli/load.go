@@ -0,0 +1,463 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+var loadTable string
+var loadFile string
+var loadDelimiter string
+var loadNull string
+var loadHeader bool
+var loadSkipErrors bool
+var loadRejects string
+
+// loadBatchSize is the number of rows written by each INSERT statement of a
+// load. It is a variable for testing.
+var loadBatchSize = 500
+
+// loadProgressInterval is the minimum interval between progress reports.
+const loadProgressInterval = 10 * time.Second
+
+// A loadCSVCmd command loads comma separated values into a table.
+var loadCSVCmd = &cobra.Command{
+	Use:   "csv [options]",
+	Short: "load comma separated values into a table",
+	Long: `
+Loads the records of a CSV file, or of standard input if no file is given, into
+the table given by --table=<database>.<table>. With --header (the default), the
+first record names the columns of the fields; otherwise the fields are assigned
+to the columns of the table in order. Fields equal to the --null marker are
+loaded as NULL, BYTES fields starting with "\x" are hex decoded and all other
+fields are converted to the type of their column. This is the format written
+by "dump --format=csv".
+
+The rows are written by batches of INSERT statements, so the rows written
+before a failure are kept. With --skip-errors, records which can not be
+converted or inserted are written to the --rejects file, following the header
+if there is one, instead of aborting the load.
+`,
+	Example:      `  cockroach load csv --table=db.t --file=t.csv --skip-errors --rejects=t.rejects`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runLoadCSV),
+}
+
+func runLoadCSV(cmd *cobra.Command, args []string) {
+	if len(args) != 0 || loadTable == "" {
+		mustUsage(cmd)
+		return
+	}
+	i := strings.Index(loadTable, ".")
+	if i <= 0 || i == len(loadTable)-1 {
+		panicf("invalid table name %q; expected <database>.<table>", loadTable)
+	}
+	delimiter, size := utf8.DecodeRuneInString(loadDelimiter)
+	if size == 0 || size != len(loadDelimiter) || delimiter == '"' || delimiter == '\r' || delimiter == '\n' {
+		panicf("invalid delimiter %q", loadDelimiter)
+	}
+	if loadSkipErrors && loadRejects == "" {
+		panicf("--skip-errors requires a --rejects file")
+	}
+
+	in := io.Reader(os.Stdin)
+	if loadFile != "" {
+		f, err := os.Open(loadFile)
+		if err != nil {
+			panicf("unable to open %s: %s", loadFile, err)
+		}
+		defer func() { _ = f.Close() }()
+		in = f
+	}
+
+	db := makeSQLClient()
+	defer func() { _ = db.Close() }()
+	schema, err := loadSchema(db, loadTable[:i], loadTable[i+1:])
+	if err != nil {
+		panicf("unable to load %s: %s", loadTable, err)
+	}
+
+	l := &csvLoader{
+		db:        db,
+		schema:    schema,
+		delimiter: delimiter,
+		null:      loadNull,
+		header:    loadHeader,
+		progress:  os.Stderr,
+	}
+	if loadSkipErrors {
+		f, err := os.Create(loadRejects)
+		if err != nil {
+			panicf("unable to create %s: %s", loadRejects, err)
+		}
+		defer func() { _ = f.Close() }()
+		l.rejects = f
+	}
+	if err := l.load(in); err != nil {
+		panicf("unable to load %s: %s", loadTable, err)
+	}
+	fmt.Println(l.status())
+}
+
+// loadSchema reads the schema of the table.
+func loadSchema(db *sql.DB, dbName, table string) (*dumpTableSchema, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	return dumpSchema(tx, dbName, table)
+}
+
+// A csvRecord is a record of a CSV file.
+type csvRecord struct {
+	line   int    // the line the record starts on
+	text   string // the lines of the record, as read
+	fields []string
+	err    error    // set if the record could not be parsed
+	values []string // the SQL literals of the fields
+}
+
+// A csvRecordReader reads the records of a CSV file along with the lines they
+// were read from, which are used to report and reject invalid records.
+type csvRecordReader struct {
+	r         *bufio.Reader
+	delimiter rune
+	lines     int   // the number of lines read
+	bytes     int64 // the number of bytes read
+}
+
+// read returns the next record, skipping empty lines. A record spans several
+// lines if a quoted field contains a newline. It returns io.EOF when there
+// are no more records.
+func (cr *csvRecordReader) read() (csvRecord, error) {
+	for {
+		var buf bytes.Buffer
+		rec := csvRecord{line: cr.lines + 1}
+		for {
+			text, err := cr.r.ReadString('\n')
+			if len(text) > 0 {
+				buf.WriteString(text)
+				cr.bytes += int64(len(text))
+				cr.lines++
+			}
+			if err == io.EOF {
+				if buf.Len() == 0 {
+					return rec, io.EOF
+				}
+				break
+			} else if err != nil {
+				return rec, err
+			}
+			// Quotes come in pairs unless a quoted field continues on the
+			// next line.
+			if bytes.Count(buf.Bytes(), []byte{'"'})%2 == 0 {
+				break
+			}
+		}
+		rec.text = buf.String()
+		if strings.TrimRight(rec.text, "\r\n") == "" {
+			continue
+		}
+		r := csv.NewReader(strings.NewReader(rec.text))
+		r.Comma = cr.delimiter
+		r.FieldsPerRecord = -1
+		if rec.fields, rec.err = r.Read(); rec.err != nil {
+			// The position reported by the CSV reader is relative to the
+			// start of the record.
+			if pErr, ok := rec.err.(*csv.ParseError); ok {
+				rec.err = pErr.Err
+			}
+		}
+		return rec, nil
+	}
+}
+
+// A csvLoader loads the records of a CSV file into a table.
+type csvLoader struct {
+	db        *sql.DB
+	schema    *dumpTableSchema
+	delimiter rune
+	null      string
+	header    bool
+	// rejects receives the records which could not be loaded. If it is nil,
+	// the load is aborted at the first such record.
+	rejects io.Writer
+	// progress receives periodic progress reports as well as the errors of
+	// rejected records.
+	progress io.Writer
+
+	r          *csvRecordReader
+	headerText string
+	// columns are the indexes into schema.columns of the fields of a record.
+	columns    []int
+	insert     string
+	batch      []csvRecord
+	rows       int64
+	rejected   int64
+	start      time.Time
+	lastReport time.Time
+}
+
+// load reads the records from in and inserts them into the table.
+func (l *csvLoader) load(in io.Reader) error {
+	l.r = &csvRecordReader{r: bufio.NewReader(in), delimiter: l.delimiter}
+	l.start = time.Now()
+	l.lastReport = l.start
+	if !l.header {
+		columns := make([]int, len(l.schema.columns))
+		for i := range columns {
+			columns[i] = i
+		}
+		l.setColumns(columns)
+	}
+
+	for {
+		rec, err := l.r.read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if l.columns == nil {
+			if rec.err != nil {
+				return fmt.Errorf("line %d: %s", rec.line, rec.err)
+			}
+			if err := l.readHeader(rec); err != nil {
+				return fmt.Errorf("line %d: %s", rec.line, err)
+			}
+			continue
+		}
+		if rec.err == nil {
+			rec.values, rec.err = l.convert(rec.fields)
+		}
+		if rec.err != nil {
+			if err := l.reject(rec, rec.err); err != nil {
+				return err
+			}
+			continue
+		}
+		l.batch = append(l.batch, rec)
+		if len(l.batch) >= loadBatchSize {
+			if err := l.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if l.columns == nil {
+		return fmt.Errorf("missing header")
+	}
+	return l.flush()
+}
+
+// readHeader maps the fields of a record to the columns named by the header.
+func (l *csvLoader) readHeader(rec csvRecord) error {
+	columns := make([]int, len(rec.fields))
+	seen := map[int]bool{}
+	for i, name := range rec.fields {
+		columns[i] = -1
+		for j, col := range l.schema.columns {
+			if col.name == name {
+				columns[i] = j
+				break
+			}
+		}
+		if columns[i] < 0 {
+			return fmt.Errorf("unknown column %q", name)
+		}
+		if seen[columns[i]] {
+			return fmt.Errorf("duplicate column %q", name)
+		}
+		seen[columns[i]] = true
+	}
+	l.headerText = rec.text
+	l.setColumns(columns)
+	return nil
+}
+
+// setColumns sets the columns of the fields of a record.
+func (l *csvLoader) setColumns(columns []int) {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = parser.Name(l.schema.columns[c].name).String()
+	}
+	l.columns = columns
+	l.insert = fmt.Sprintf("INSERT INTO %s (%s) VALUES", l.schema.qualifiedName(), strings.Join(names, ", "))
+}
+
+// convert returns the SQL literals for the fields of a record.
+func (l *csvLoader) convert(fields []string) ([]string, error) {
+	if len(fields) != len(l.columns) {
+		return nil, fmt.Errorf("expected %d fields, got %d", len(l.columns), len(fields))
+	}
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		if field == l.null {
+			values[i] = "NULL"
+			continue
+		}
+		col := l.schema.columns[l.columns[i]]
+		var err error
+		if values[i], err = csvLiteral(col.typ, field); err != nil {
+			return nil, fmt.Errorf("column %s: %s", col.name, err)
+		}
+	}
+	return values, nil
+}
+
+// reject writes a record which could not be loaded to the rejects file, or
+// returns an error if there is none.
+func (l *csvLoader) reject(rec csvRecord, err error) error {
+	err = fmt.Errorf("line %d: %s", rec.line, err)
+	if l.rejects == nil {
+		return err
+	}
+	fmt.Fprintf(l.progress, "rejected %s\n", err)
+	text := rec.text
+	if l.rejected == 0 {
+		text = l.headerText + text
+	}
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	if _, err := io.WriteString(l.rejects, text); err != nil {
+		return err
+	}
+	l.rejected++
+	return nil
+}
+
+// flush inserts the batched records. If the rows can not be inserted and
+// records are rejected rather than aborting the load, they are inserted one
+// at a time to find the ones which fail.
+func (l *csvLoader) flush() error {
+	if len(l.batch) == 0 {
+		return nil
+	}
+	batch := l.batch
+	l.batch = l.batch[:0]
+	if err := l.insertRows(batch); err != nil {
+		if l.rejects == nil {
+			return fmt.Errorf("lines %d to %d: %s", batch[0].line, batch[len(batch)-1].line, err)
+		}
+		for _, rec := range batch {
+			if err := l.insertRows([]csvRecord{rec}); err != nil {
+				if err := l.reject(rec, err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if now := time.Now(); now.Sub(l.lastReport) >= loadProgressInterval {
+		fmt.Fprintln(l.progress, l.status())
+		l.lastReport = now
+	}
+	return nil
+}
+
+// insertRows inserts the rows of the records with a single statement.
+func (l *csvLoader) insertRows(recs []csvRecord) error {
+	tuples := make([]string, len(recs))
+	for i, rec := range recs {
+		tuples[i] = fmt.Sprintf("(%s)", strings.Join(rec.values, ", "))
+	}
+	if _, err := l.db.Exec(fmt.Sprintf("%s %s", l.insert, strings.Join(tuples, ", "))); err != nil {
+		return err
+	}
+	l.rows += int64(len(recs))
+	return nil
+}
+
+// status returns a summary of the progress of the load.
+func (l *csvLoader) status() string {
+	elapsed := time.Since(l.start)
+	return fmt.Sprintf("%d rows loaded, %d rejected, %d bytes read in %s (%.0f rows/sec)",
+		l.rows, l.rejected, l.r.bytes, elapsed, float64(l.rows)/elapsed.Seconds())
+}
+
+// csvLiteral returns the SQL literal for a field loaded into a column of the
+// given type. The field is expected in the format written by csvValue.
+func csvLiteral(typ, field string) (string, error) {
+	kind := typ
+	if i := strings.Index(kind, "("); i >= 0 {
+		kind = kind[:i]
+	}
+	var val interface{}
+	var err error
+	switch kind {
+	case "BOOL":
+		val, err = strconv.ParseBool(field)
+	case "INT":
+		val, err = strconv.ParseInt(field, 10, 64)
+	case "FLOAT":
+		val, err = strconv.ParseFloat(field, 64)
+	case "STRING":
+		val = field
+	case "BYTES":
+		if strings.HasPrefix(field, `\x`) {
+			val, err = hex.DecodeString(field[2:])
+		} else {
+			val = []byte(field)
+		}
+	case "DATE":
+		if _, err = parser.ParseDate(parser.DString(field)); err == nil {
+			return fmt.Sprintf("DATE %s", parser.DString(field)), nil
+		}
+	case "TIMESTAMP":
+		var ts parser.DTimestamp
+		ts, err = parser.EvalContext{}.ParseTimestamp(parser.DString(field))
+		val = ts.Time
+	case "INTERVAL":
+		val, err = time.ParseDuration(field)
+	default:
+		return "", fmt.Errorf("unsupported column type %s", typ)
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not parse %q as %s", field, kind)
+	}
+	return sqlLiteral(val)
+}
+
+var loadCmds = []*cobra.Command{
+	loadCSVCmd,
+}
+
+var loadCmd = &cobra.Command{
+	Use:   "load",
+	Short: "load data into sql tables",
+	Run: func(cmd *cobra.Command, args []string) {
+		mustUsage(cmd)
+	},
+}
+
+func init() {
+	loadCmd.AddCommand(loadCmds...)
+}
@@ -0,0 +1,120 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestLoadCSV loads a file with valid records, a record with an embedded
+// quoted delimiter and a record with a type error.
+func TestLoadCSV(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	context.InitDefaults()
+
+	// Write the rows over several statements.
+	defer func(batchSize int) { loadBatchSize = batchSize }(loadBatchSize)
+	loadBatchSize = 2
+
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	db := makeTestDBClient(t, s)
+	defer db.Close()
+	if _, err := db.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.t (id INT PRIMARY KEY, s STRING, f FLOAT, ok BOOL, b BYTES);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := util.CreateTempDir(t, "load_csv")
+	defer util.CleanupDir(dir)
+	file := filepath.Join(dir, "t.csv")
+	rejects := filepath.Join(dir, "t.rejects")
+	// The fields are not in the order of the columns of the table. The record
+	// on lines 3 and 4 has a quoted field with a delimiter and a newline and
+	// the record on line 5 has a type error.
+	const header = "ok,id,s,f,b\n"
+	const badRecord = ",3,three,x,\n"
+	if err := ioutil.WriteFile(file, []byte(header+`true,1,one,1.5,\x00ff
+false,2,"two, and ""2""
+on two lines",2.5,
+`+badRecord+`,4,,4,
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	load := func(args ...string) (string, error) {
+		var err error
+		out := captureStdout(func() {
+			err = Run(append([]string{"load", "csv", fmt.Sprintf("--addr=%s", s.ServingAddr()),
+				fmt.Sprintf("--certs=%s", security.EmbeddedCertsDir), "--table=d.t",
+				fmt.Sprintf("--file=%s", file)}, args...))
+		})
+		return out, err
+	}
+	query := func() [][]string {
+		_, rows, err := runQuery(db, `SELECT * FROM d.t ORDER BY id`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rows
+	}
+	validRows := [][]string{
+		{"1", "one", "1.5", "true", `"\x00\xff"`},
+		{"2", "two, and \"2\"\non two lines", "2.5", "false", "NULL"},
+	}
+
+	// The load is aborted at the type error, keeping the rows written before.
+	if _, err := load(); !testutils.IsError(err, `line 5: column f: could not parse "x" as FLOAT`) {
+		t.Fatalf("expected type error, got %v", err)
+	}
+	if rows := query(); !reflect.DeepEqual(rows, validRows) {
+		t.Errorf("expected rows %q, got %q", validRows, rows)
+	}
+
+	// With --skip-errors, the records which were loaded before as well as the
+	// one with the type error are rejected.
+	out, err := load("--skip-errors", fmt.Sprintf("--rejects=%s", rejects))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := "1 rows loaded, 3 rejected,"; !strings.HasPrefix(out, e) {
+		t.Errorf("expected output to start with %q, got %q", e, out)
+	}
+	validRows = append(validRows, []string{"4", "NULL", "4", "NULL", "NULL"})
+	if rows := query(); !reflect.DeepEqual(rows, validRows) {
+		t.Errorf("expected rows %q, got %q", validRows, rows)
+	}
+	rejected, err := ioutil.ReadFile(rejects)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(rejected), header) || !strings.HasSuffix(string(rejected), badRecord) ||
+		strings.Count(string(rejected), "\n") != 5 {
+		t.Errorf("expected the header and the rejected records, got %q", rejected)
+	}
+}
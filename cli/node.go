@@ -0,0 +1,334 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+
+	"github.com/spf13/cobra"
+)
+
+// nodeLiveWindow is the time since its last status update within which a
+// node is considered live. Nodes update their status every 10s by default.
+const nodeLiveWindow = time.Minute
+
+// nodeStatusColumns are the columns of the table printed by "node status".
+var nodeStatusColumns = []string{
+	"id", "address", "sql_address", "build", "started_at", "updated_at", "live",
+	"ranges", "leaders", "capacity_used", "capacity_available",
+}
+
+// A lsNodesCmd command lists the nodes in the cluster.
+var lsNodesCmd = &cobra.Command{
+	Use:   "ls [options]",
+	Short: "lists the IDs of all nodes in the cluster",
+	Long: `
+Lists the IDs of all nodes in the cluster.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runLsNodes),
+}
+
+func runLsNodes(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		mustUsage(cmd)
+		return
+	}
+
+	var nodeStatuses []status.NodeStatus
+	if err := getStatusJSON("nodes/", &nodeStatuses); err != nil {
+		panicf("unable to get node statuses: %s\n", err)
+	}
+	rows := make([][]string, 0, len(nodeStatuses))
+	for _, nodeStatus := range nodeStatuses {
+		rows = append(rows, []string{nodeStatus.Desc.NodeID.String()})
+	}
+	if err := printTable(os.Stdout, nodeFormat, []string{"id"}, rows); err != nil {
+		panic(err)
+	}
+}
+
+// A statusNodeCmd command shows the status of nodes.
+var statusNodeCmd = &cobra.Command{
+	Use:   "status [options] [<node-id>]",
+	Short: "shows the status of a node or all nodes",
+	Long: `
+Shows the address, SQL address, build, start and last update times, liveness, range and
+leader counts, and used and available capacity of the given node, or of all
+nodes if no node ID is specified. A node is considered live if it has updated
+its status within the last minute.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runStatusNode),
+}
+
+func runStatusNode(cmd *cobra.Command, args []string) {
+	var nodeStatuses []status.NodeStatus
+	switch len(args) {
+	case 0:
+		if err := getStatusJSON("nodes/", &nodeStatuses); err != nil {
+			panicf("unable to get node statuses: %s\n", err)
+		}
+	case 1:
+		if _, err := strconv.ParseInt(args[0], 10, 32); err != nil {
+			panicf("invalid node ID %q: %s\n", args[0], err)
+		}
+		var nodeStatus status.NodeStatus
+		if err := getStatusJSON("nodes/"+args[0], &nodeStatus); err != nil {
+			panicf("unable to get status of node %s: %s\n", args[0], err)
+		}
+		if nodeStatus.Desc.NodeID == 0 {
+			panicf("node %s not found\n", args[0])
+		}
+		nodeStatuses = append(nodeStatuses, nodeStatus)
+	default:
+		mustUsage(cmd)
+		return
+	}
+
+	// Capacity is only reported by the stores, so it is summed over the
+	// stores of each node.
+	var storeStatuses []storage.StoreStatus
+	if err := getStatusJSON("stores/", &storeStatuses); err != nil {
+		panicf("unable to get store statuses: %s\n", err)
+	}
+	capacities := map[roachpb.NodeID]roachpb.StoreCapacity{}
+	for _, storeStatus := range storeStatuses {
+		c := capacities[storeStatus.NodeID]
+		c.Capacity += storeStatus.Desc.Capacity.Capacity
+		c.Available += storeStatus.Desc.Capacity.Available
+		capacities[storeStatus.NodeID] = c
+	}
+
+	now := time.Now()
+	rows := make([][]string, 0, len(nodeStatuses))
+	for _, nodeStatus := range nodeStatuses {
+		updatedAt := time.Unix(0, nodeStatus.UpdatedAt)
+		c := capacities[nodeStatus.Desc.NodeID]
+		rows = append(rows, []string{
+			nodeStatus.Desc.NodeID.String(),
+			nodeStatus.Desc.Address.String(),
+			nodeStatus.Desc.SQLAddress.String(),
+			nodeStatus.BuildTag,
+			formatNodeTime(nodeStatus.StartedAt),
+			formatNodeTime(nodeStatus.UpdatedAt),
+			strconv.FormatBool(now.Sub(updatedAt) < nodeLiveWindow),
+			strconv.Itoa(int(nodeStatus.RangeCount)),
+			strconv.Itoa(int(nodeStatus.LeaderRangeCount)),
+			strconv.FormatInt(c.Capacity-c.Available, 10),
+			strconv.FormatInt(c.Available, 10),
+		})
+	}
+	if err := printTable(os.Stdout, nodeFormat, nodeStatusColumns, rows); err != nil {
+		panic(err)
+	}
+}
+
+// formatNodeTime formats a timestamp in nanoseconds since the epoch.
+func formatNodeTime(nanos int64) string {
+	return time.Unix(0, nanos).UTC().Format("2006-01-02 15:04:05")
+}
+
+// getStatusJSON issues a GET request for the given path below the status
+// endpoint of the node and decodes the JSON response into v.
+func getStatusJSON(path string, v interface{}) error {
+	httpClient, err := context.GetHTTPClient()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s/_status/%s",
+		context.HTTPRequestScheme(), context.Addr, path), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add(util.AcceptHeader, util.JSONContentType)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return util.Errorf("%s %s: got %s: %s", req.Method, req.URL, resp.Status, body)
+	}
+	return json.Unmarshal(body, v)
+}
+
+// printTable writes the rows to w in the given format: "pretty" renders a
+// table, while "tsv" and "csv" render a header line followed by one line per
+// row.
+func printTable(w io.Writer, format string, cols []string, rows [][]string) error {
+	var comma rune
+	switch format {
+	case "pretty":
+		if err := printQueryOutput(w, cols, rows); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(w, "%d result(s)\n", len(rows))
+		return err
+	case "tsv":
+		comma = '\t'
+	case "csv":
+		comma = ','
+	default:
+		return util.Errorf("unknown format %q; expected pretty, tsv or csv", format)
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if err := cw.Write(cols); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// A decommissionNodeCmd command decommissions nodes.
+var decommissionNodeCmd = &cobra.Command{
+	Use:   "decommission [options] <node-id> [<node-id>...]",
+	Short: "decommissions nodes",
+	Long: `
+Marks the nodes as decommissioning. Their replicas are moved to other nodes,
+after which they are removed from the cluster. Use "node decommission-status" to
+follow the progress.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runDecommissionNode),
+}
+
+func runDecommissionNode(cmd *cobra.Command, args []string) {
+	runCommissionNode(cmd, args, client.Decommission)
+}
+
+// A recommissionNodeCmd command recommissions nodes.
+var recommissionNodeCmd = &cobra.Command{
+	Use:   "recommission [options] <node-id> [<node-id>...]",
+	Short: "recommissions nodes",
+	Long: `
+Cancels the decommissioning of the nodes. Nodes which have already been
+removed from the cluster cannot be recommissioned.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runRecommissionNode),
+}
+
+func runRecommissionNode(cmd *cobra.Command, args []string) {
+	runCommissionNode(cmd, args, client.Recommission)
+}
+
+func runCommissionNode(cmd *cobra.Command, args []string, configType string) {
+	if len(args) == 0 {
+		mustUsage(cmd)
+		return
+	}
+	for _, arg := range args {
+		if _, err := strconv.ParseInt(arg, 10, 32); err != nil {
+			panicf("invalid node ID %q: %s\n", arg, err)
+		}
+	}
+
+	admin := client.NewAdminClient(&context.Context, context.Addr, configType)
+	if _, err := admin.PostWithParams(url.Values{
+		"nodes": {strings.Join(args, ",")},
+	}); err != nil {
+		panicf("%s failed: %s\n", cmd.Name(), err)
+	}
+	fmt.Println("ok")
+}
+
+// A decommissionStatusCmd command shows the progress of decommissioning
+// nodes.
+var decommissionStatusCmd = &cobra.Command{
+	Use:   "decommission-status [options]",
+	Short: "shows the progress of decommissioning nodes",
+	Long: `
+Lists the nodes which are being, or have been, decommissioned along with the
+number of replicas remaining on each of their stores.
+`,
+	SilenceUsage: true,
+	RunE:         panicGuard(runDecommissionStatus),
+}
+
+func runDecommissionStatus(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		mustUsage(cmd)
+		return
+	}
+
+	admin := client.NewAdminClient(&context.Context, context.Addr, client.Decommission)
+	body, err := admin.Get()
+	if err != nil {
+		panicf("unable to get decommission status: %s\n", err)
+	}
+	var resp struct {
+		Nodes []server.DecommissionProgress `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		panicf("unable to parse decommission status %q: %s\n", body, err)
+	}
+
+	for _, node := range resp.Nodes {
+		if node.Decommissioned {
+			fmt.Printf("node-id=%d decommissioned\n", node.NodeID)
+			continue
+		}
+		fmt.Printf("node-id=%d decommissioning replicas=%d\n", node.NodeID, node.Replicas)
+		for _, store := range node.Stores {
+			fmt.Printf("\tstore-id=%d replicas=%d\n", store.StoreID, store.Replicas)
+		}
+	}
+	fmt.Printf("%d result(s)\n", len(resp.Nodes))
+}
+
+var nodeCmds = []*cobra.Command{
+	lsNodesCmd,
+	statusNodeCmd,
+	decommissionNodeCmd,
+	recommissionNodeCmd,
+	decommissionStatusCmd,
+}
+
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "list nodes, show their status, and decommission them\n",
+	Run: func(cmd *cobra.Command, args []string) {
+		mustUsage(cmd)
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeCmds...)
+}
@@ -17,10 +17,16 @@
 package cli
 
 import (
+	"database/sql"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/log"
 
 	"github.com/peterh/liner"
 	"github.com/spf13/cobra"
@@ -29,8 +35,19 @@ import (
 const (
 	infoMessage = `# Welcome to the cockroach SQL interface.
 # All statements must be terminated by a semicolon.
-# To exit: CTRL + D.
+# To exit: CTRL + D or \q. For a list of commands: \?.
+`
+
+	helpMessage = `\q         exit the shell.
+\! CMD     run CMD through the system shell.
+\d         list the tables of the current database.
+\timing    toggle the display of statement execution times.
+\?         show this help.
 `
+
+	// historyFile is the name of the file in the user's home directory
+	// which the shell's history is persisted to.
+	historyFile = ".cockroachdb_history"
 )
 
 // sqlShellCmd opens a sql shell.
@@ -43,6 +60,27 @@ Open a sql shell running against the cockroach database at --addr.
 	Run: runTerm, // TODO(tschottdorf): should be able to return err code when reading from stdin
 }
 
+// lineReader is the subset of liner.State used by the shell. It allows
+// the shell to be driven by scripted input in tests.
+type lineReader interface {
+	Prompt(prompt string) (string, error)
+	AppendHistory(item string)
+}
+
+// sqlShell is an interactive SQL shell reading its input from a
+// lineReader.
+type sqlShell struct {
+	db  *sql.DB
+	in  lineReader
+	out io.Writer
+
+	fullPrompt     string
+	continuePrompt string
+
+	// timing is set when the execution time of each statement is printed.
+	timing bool
+}
+
 func runTerm(cmd *cobra.Command, args []string) {
 	if len(args) != 0 {
 		mustUsage(cmd)
@@ -52,10 +90,32 @@ func runTerm(cmd *cobra.Command, args []string) {
 	db := makeSQLClient()
 	defer func() { _ = db.Close() }()
 
-	liner := liner.NewLiner()
+	line := liner.NewLiner()
 	defer func() {
-		_ = liner.Close()
+		_ = line.Close()
 	}()
+	// CTRL + C clears the current input rather than exiting the shell.
+	line.SetCtrlCAborts(true)
+
+	if path := historyPath(); path != "" {
+		if f, err := os.Open(path); err == nil {
+			if _, err := line.ReadHistory(f); err != nil {
+				log.Warningf("unable to read history from %s: %s", path, err)
+			}
+			_ = f.Close()
+		}
+		defer func() {
+			f, err := os.Create(path)
+			if err != nil {
+				log.Warningf("unable to save history to %s: %s", path, err)
+				return
+			}
+			defer func() { _ = f.Close() }()
+			if _, err := line.WriteHistory(f); err != nil {
+				log.Warningf("unable to save history to %s: %s", path, err)
+			}
+		}()
+	}
 
 	fmt.Print(infoMessage)
 
@@ -68,55 +128,200 @@ func runTerm(cmd *cobra.Command, args []string) {
 	}
 	continuePrompt := strings.Repeat(" ", len(fullPrompt)-1) + "-"
 
-	fullPrompt += "> "
-	continuePrompt += "> "
-
-	// TODO(marc): allow passing statements on the command line,
-	// or specifying a flag. This would make testing much simpler.
-	// TODO(marc): detect if we're actually on a terminal. If not,
-	// we may want to repeat the statements on stdout.
-	// TODO(marc): add test.
-	var stmt []string
-	var l string
-	var err error
+	shell := &sqlShell{
+		db:             db,
+		in:             line,
+		out:            os.Stdout,
+		fullPrompt:     fullPrompt + "> ",
+		continuePrompt: continuePrompt + "> ",
+	}
+	if err := shell.run(); err != nil {
+		fmt.Fprintf(osStderr, "Input error: %s\n", err)
+	}
+}
+
+// historyPath returns the path of the history file, or an empty string if
+// the home directory is unknown.
+func historyPath() string {
+	home := os.Getenv("HOME")
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, historyFile)
+}
+
+// run reads and executes statements and commands until the input is
+// exhausted or the shell is exited with \q. Input is buffered until it
+// contains a complete statement terminated by a semicolon.
+func (s *sqlShell) run() error {
+	// pending holds the input following the last complete statement.
+	var pending string
 	for {
-		if len(stmt) == 0 {
-			l, err = liner.Prompt(fullPrompt)
-		} else {
-			l, err = liner.Prompt(continuePrompt)
+		prompt := s.fullPrompt
+		if pending != "" {
+			prompt = s.continuePrompt
+		}
+		l, err := s.in.Prompt(prompt)
+		if err == liner.ErrPromptAborted {
+			// Discard the statement being entered.
+			pending = ""
+			continue
 		}
 		if err != nil {
-			if err != io.EOF {
-				fmt.Fprintf(osStderr, "Input error: %s\n", err)
+			if err == io.EOF {
+				return nil
 			}
-			break
+			return err
 		}
 
-		stmt = append(stmt, l)
-
-		// See if we have a semicolon at the end of the line (ignoring
-		// trailing whitespace).
-		if !strings.HasSuffix(strings.TrimSpace(l), ";") {
-			// No semicolon: read some more.
+		// Commands are only recognized at the start of a statement.
+		if pending == "" && strings.HasPrefix(strings.TrimSpace(l), `\`) {
+			cmd := strings.TrimSpace(l)
+			s.in.AppendHistory(cmd)
+			if !s.runCommand(cmd) {
+				return nil
+			}
 			continue
 		}
 
 		// We always insert a newline when continuing statements.
-		// However, it causes problems with lines in the middle of:
-		// - qualified names (eg: database.<newline>table)
-		// - quoted strings (eg: 'foo<newline>bar')
-		// This also makes the history replay horrible.
 		// mysql replaces newlines with spaces in the history, which works
 		// because string concatenation with newlines can also be done with spaces.
 		// postgres keeps the line intact in the history.
-		fullStmt := strings.Join(stmt, "\n")
-		liner.AppendHistory(fullStmt)
+		input := l
+		if pending != "" {
+			input = pending + "\n" + l
+		}
+		stmts, remainder := splitStatements(input)
+		if consumed := strings.TrimSpace(input[:len(input)-len(remainder)]); consumed != "" {
+			s.in.AppendHistory(consumed)
+		}
+		pending = remainder
+		for _, stmt := range stmts {
+			s.runStatement(stmt)
+		}
+	}
+}
+
+// runStatement executes a single statement, printing its results or error.
+func (s *sqlShell) runStatement(stmt string) {
+	start := time.Now()
+	if err := runPrettyQuery(s.db, s.out, stmt); err != nil {
+		fmt.Fprintln(s.out, err)
+	}
+	if s.timing {
+		fmt.Fprintf(s.out, "Time: %s\n", time.Since(start))
+	}
+}
 
-		if err := runPrettyQuery(db, os.Stdout, fullStmt); err != nil {
-			fmt.Println(err)
+// runCommand executes a backslash command. It returns false if the shell
+// should exit.
+func (s *sqlShell) runCommand(cmd string) bool {
+	name, arg := cmd, ""
+	if i := strings.IndexAny(cmd, " \t"); i >= 0 {
+		name, arg = cmd[:i], strings.TrimSpace(cmd[i:])
+	}
+	switch name {
+	case `\q`:
+		return false
+	case `\!`:
+		c := exec.Command("/bin/sh", "-c", arg)
+		c.Stdin = os.Stdin
+		c.Stdout = s.out
+		c.Stderr = s.out
+		if err := c.Run(); err != nil {
+			fmt.Fprintln(s.out, err)
 		}
+	case `\d`:
+		s.runStatement("SHOW TABLES")
+	case `\timing`:
+		s.timing = !s.timing
+		if s.timing {
+			fmt.Fprintln(s.out, "Timing is on.")
+		} else {
+			fmt.Fprintln(s.out, "Timing is off.")
+		}
+	case `\?`:
+		fmt.Fprint(s.out, helpMessage)
+	default:
+		fmt.Fprintf(s.out, "Invalid command %s. Try \\? for help.\n", name)
+	}
+	return true
+}
 
-		// Clear the saved statement.
-		stmt = stmt[:0]
+// splitStatements splits input into the complete statements it contains
+// and returns them along with the remainder of the input following the last
+// terminating semicolon. Semicolons within quoted strings, quoted
+// identifiers and comments do not terminate a statement. Statements are
+// returned without their semicolon and surrounding whitespace; statements
+// which consist only of whitespace and comments are dropped, and the
+// remainder is empty if that is all it consists of.
+func splitStatements(input string) (stmts []string, remainder string) {
+	const (
+		inCode = iota
+		inString
+		inIdent
+		inLineComment
+		inBlockComment
+	)
+	state := inCode
+	start := 0
+	// empty is set while the current statement has no content other than
+	// whitespace and comments.
+	empty := true
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		var next byte
+		if i+1 < len(input) {
+			next = input[i+1]
+		}
+		switch state {
+		case inCode:
+			switch {
+			case c == ';':
+				if !empty {
+					stmts = append(stmts, strings.TrimSpace(input[start:i]))
+				}
+				start = i + 1
+				empty = true
+			case c == '-' && next == '-':
+				state = inLineComment
+				i++
+			case c == '/' && next == '*':
+				state = inBlockComment
+				i++
+			case c == ' ', c == '\t', c == '\n', c == '\r':
+			default:
+				empty = false
+				if c == '\'' {
+					state = inString
+				} else if c == '"' {
+					state = inIdent
+				}
+			}
+		case inString:
+			// An escaped quote ('') is handled as the end of one string
+			// immediately followed by the start of another.
+			if c == '\'' {
+				state = inCode
+			}
+		case inIdent:
+			if c == '"' {
+				state = inCode
+			}
+		case inLineComment:
+			if c == '\n' {
+				state = inCode
+			}
+		case inBlockComment:
+			if c == '*' && next == '/' {
+				state = inCode
+				i++
+			}
+		}
+	}
+	if empty && state != inString && state != inIdent && state != inBlockComment {
+		return stmts, ""
 	}
+	return stmts, input[start:]
 }
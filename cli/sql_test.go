@@ -0,0 +1,201 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+
+	"github.com/peterh/liner"
+)
+
+func TestSplitStatements(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testCases := []struct {
+		input     string
+		stmts     []string
+		remainder string
+	}{
+		{``, nil, ``},
+		{`SELECT 1`, nil, `SELECT 1`},
+		{`SELECT 1;`, []string{`SELECT 1`}, ``},
+		{` SELECT 1 ; SELECT 2;`, []string{`SELECT 1`, `SELECT 2`}, ``},
+		{`SELECT 1; SELECT`, []string{`SELECT 1`}, ` SELECT`},
+		{"SELECT\n1\n;", []string{"SELECT\n1"}, ``},
+		{`;;`, nil, ``},
+		{`SELECT 'a;b';`, []string{`SELECT 'a;b'`}, ``},
+		{`SELECT 'it''s;';`, []string{`SELECT 'it''s;'`}, ``},
+		{`SELECT 'a;`, nil, `SELECT 'a;`},
+		{`SELECT "a;b" FROM t;`, []string{`SELECT "a;b" FROM t`}, ``},
+		{"SELECT 1 -- one; two\n;", []string{"SELECT 1 -- one; two"}, ``},
+		{`SELECT 1 -- one;`, nil, `SELECT 1 -- one;`},
+		{`SELECT /* ; */ 1;`, []string{`SELECT /* ; */ 1`}, ``},
+		{`SELECT 1 - -1;`, []string{`SELECT 1 - -1`}, ``},
+		{`SELECT 4 / 2;`, []string{`SELECT 4 / 2`}, ``},
+		// Input consisting only of comments yields neither statements nor a
+		// remainder, unless a block comment is still open.
+		{"-- comment;\n", nil, ``},
+		{`/* comment; */;`, nil, ``},
+		{`SELECT 1; -- comment`, []string{`SELECT 1`}, ``},
+		{`SELECT 1; /* comment`, []string{`SELECT 1`}, ` /* comment`},
+	}
+
+	for i, tc := range testCases {
+		stmts, remainder := splitStatements(tc.input)
+		if !reflect.DeepEqual(stmts, tc.stmts) || remainder != tc.remainder {
+			t.Errorf("%d: splitStatements(%q) = %q, %q; expected %q, %q",
+				i, tc.input, stmts, remainder, tc.stmts, tc.remainder)
+		}
+	}
+}
+
+// scriptedInput is a lineReader returning a fixed sequence of lines and
+// recording the prompts and history entries.
+type scriptedInput struct {
+	lines   []string
+	prompts []string
+	history []string
+}
+
+// ctrlC is replaced with liner.ErrPromptAborted by scriptedInput.
+const ctrlC = "<ctrl-c>"
+
+func (in *scriptedInput) Prompt(prompt string) (string, error) {
+	in.prompts = append(in.prompts, prompt)
+	if len(in.lines) == 0 {
+		return "", io.EOF
+	}
+	l := in.lines[0]
+	in.lines = in.lines[1:]
+	if l == ctrlC {
+		return "", liner.ErrPromptAborted
+	}
+	return l, nil
+}
+
+func (in *scriptedInput) AppendHistory(item string) {
+	in.history = append(in.history, item)
+}
+
+func TestSQLShell(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(nil)
+	db := makeTestDBClient(t, s)
+
+	defer db.Close()
+	defer s.Stop()
+
+	in := &scriptedInput{lines: []string{
+		`CREATE DATABASE t; CREATE TABLE t.kv (`,
+		`  k STRING PRIMARY KEY, -- the key;`,
+		`  v STRING`,
+		`);`,
+		`INSERT INTO t.kv VALUES ('a;b', 'c');`,
+		`SELECT * FROM`,
+		ctrlC,
+		`SET DATABASE = t;`,
+		`\d`,
+		`SELECT * FROM kv WHERE k = 'a;b' OR v = 'x`,
+		`y'; SELECT`,
+		`1;`,
+		`SELECT bogus FROM kv;`,
+		`\! echo hello`,
+		`\bogus`,
+		`\q`,
+		`SELECT 2;`,
+	}}
+	var out bytes.Buffer
+	shell := &sqlShell{
+		db:             db,
+		in:             in,
+		out:            &out,
+		fullPrompt:     "> ",
+		continuePrompt: "-> ",
+	}
+	if err := shell.run(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+OK
+OK
+OK
+OK
++-------+
+| Table |
++-------+
+| kv    |
++-------+
++-----+---+
+|  k  | v |
++-----+---+
+| a;b | c |
++-----+---+
++---+
+| 1 |
++---+
+| 1 |
++---+
+query error: qualified name "bogus" not found
+hello
+Invalid command \bogus. Try \? for help.
+`
+	if a, e := out.String(), expected[1:]; a != e {
+		t.Errorf("expected output:\n%s\ngot:\n%s", e, a)
+	}
+
+	expectedPrompts := []string{
+		"> ", "-> ", "-> ", "-> ", "> ", "> ", "-> ", "> ",
+		"> ", "> ", "-> ", "-> ", "> ", "> ", "> ", "> ",
+	}
+	if !reflect.DeepEqual(in.prompts, expectedPrompts) {
+		t.Errorf("expected prompts %q, got %q", expectedPrompts, in.prompts)
+	}
+
+	expectedHistory := []string{
+		"CREATE DATABASE t;",
+		"CREATE TABLE t.kv (\n  k STRING PRIMARY KEY, -- the key;\n  v STRING\n);",
+		"INSERT INTO t.kv VALUES ('a;b', 'c');",
+		"SET DATABASE = t;",
+		`\d`,
+		"SELECT * FROM kv WHERE k = 'a;b' OR v = 'x\ny';",
+		"SELECT\n1;",
+		"SELECT bogus FROM kv;",
+		`\! echo hello`,
+		`\bogus`,
+		`\q`,
+	}
+	if !reflect.DeepEqual(in.history, expectedHistory) {
+		t.Errorf("expected history %q, got %q", expectedHistory, in.history)
+	}
+
+	// The execution time is printed after each statement once timing is
+	// enabled, until it is disabled again.
+	out.Reset()
+	in.lines = []string{`\timing`, `SET DATABASE = t;`, `\timing`, `SET DATABASE = t;`}
+	if err := shell.run(); err != nil {
+		t.Fatal(err)
+	}
+	if a, e := out.String(), "^Timing is on.\nOK\nTime: .+\nTiming is off.\nOK\n$"; !regexp.MustCompile(e).MatchString(a) {
+		t.Errorf("expected output matching %q, got %q", e, a)
+	}
+}
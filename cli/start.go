@@ -20,8 +20,10 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -44,6 +46,9 @@ var context = server.NewContext()
 
 var errMissingParams = errors.New("missing or invalid parameters")
 
+// drainTimeout bounds each phase of the drain performed by the quit command.
+var drainTimeout = server.DefaultDrainOptions.SQLTimeout
+
 // panicGuard wraps an errorless command into one wrapping panics into errors.
 // This simplifies error handling for many commands for which more elaborate
 // error handling isn't needed and would otherwise bloat the code.
@@ -188,6 +193,22 @@ func runStart(_ *cobra.Command, _ []string) error {
 	// TODO(spencer): move this behind a build tag.
 	signal.Notify(signalCh, syscall.SIGTERM)
 
+	// SIGHUP reloads the certificates, e.g. after they've been rotated.
+	reloadCh := make(chan os.Signal, 1)
+	signal.Notify(reloadCh, syscall.SIGHUP)
+	stopper.RunWorker(func() {
+		for {
+			select {
+			case <-reloadCh:
+				if err := s.ReloadCertificates(); err != nil {
+					log.Warningf("failed to reload certificates: %s", err)
+				}
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+
 	// Block until one of the signals above is received or the stopper
 	// is stopped externally (for example, via the quit endpoint).
 	select {
@@ -266,22 +287,31 @@ var quitCmd = &cobra.Command{
 	Use:   "quit",
 	Short: "drain and shutdown node\n",
 	Long: `
-Shutdown the server. The first stage is drain, where any new requests
-will be ignored by the server. When all extant requests have been
-completed, the server exits.
+Shutdown the server. The first stage is drain, where new SQL connections
+are refused, open transactions are given up to --drain-timeout to finish
+and the node's stores stop acquiring leader leases. Once the final status
+of the node has been recorded, the server exits.
 `,
 	SilenceUsage: true,
 	RunE:         panicGuard(runQuit),
 }
 
-// runQuit accesses the quit shutdown path.
+// runQuit accesses the drain and shutdown path.
 func runQuit(_ *cobra.Command, _ []string) {
-	admin := client.NewAdminClient(&context.Context, context.Addr, client.Quit)
-	body, err := admin.Get()
+	admin := client.NewAdminClient(&context.Context, context.Addr, client.Drain)
+	body, err := admin.PostWithParams(url.Values{
+		"sql_timeout":   {drainTimeout.String()},
+		"lease_timeout": {drainTimeout.String()},
+	})
 	// TODO(tschottdorf): needs cleanup. An error here can happen if the shutdown
 	// happened faster than the HTTP request made it back.
 	if err != nil {
 		panicf("shutdown node error: %s", err)
 	}
-	fmt.Printf("node drained and shutdown: %s\n", body)
+	// The response lists the drain phases, followed by the final status.
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	for _, line := range lines[:len(lines)-1] {
+		log.Info(line)
+	}
+	fmt.Printf("node drained and shutdown: %s\n", lines[len(lines)-1])
 }
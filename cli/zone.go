@@ -18,160 +18,128 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
-	"os"
-	"strconv"
 
-	"github.com/cockroachdb/cockroach/config"
-	"github.com/cockroachdb/cockroach/util/log"
-	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v1"
 
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/server"
+
 	"github.com/spf13/cobra"
 )
 
-// zoneProtoToYAMLString takes a marshalled proto and returns
-// its yaml representation.
-func zoneProtoToYAMLString(val []byte) (string, error) {
-	var zone config.ZoneConfig
-	if err := proto.Unmarshal(val, &zone); err != nil {
-		return "", err
-	}
-	ret, err := yaml.Marshal(zone)
-	if err != nil {
-		return "", err
-	}
-	return string(ret), nil
-}
+// zoneNameHelp describes the object names accepted by the zone commands.
+const zoneNameHelp = `
+Objects are named "<database>" or "<database>.<table>". The default zone
+config, which applies to all objects without a zone config of their own, is
+named ".default".
+`
 
-// formatZone is a callback used to format the raw zone config
-// protobuf in a sql.Rows column for pretty printing.
-func formatZone(val interface{}) string {
-	if raw, ok := val.([]byte); ok {
-		if ret, err := zoneProtoToYAMLString(raw); err == nil {
-			return ret
-		}
-	}
-	// Fallback to raw string in case of problems.
-	return fmt.Sprintf("%#v", val)
-}
-
-// A getZoneCmd command displays the zone config for the specified ID.
+// A getZoneCmd command displays the zone config for the specified object.
 var getZoneCmd = &cobra.Command{
-	Use:   "get [options] <object-ID>",
+	Use:   "get [options] <object-name>",
 	Short: "fetches and displays the zone config",
 	Long: `
-Fetches and displays the zone configuration for <object-id>.
-`,
+Fetches and displays the zone configuration for <object-name>. If the object
+has no zone config of its own, the zone config it inherits from its database
+or the default zone config is displayed along with its source.
+` + zoneNameHelp,
 	SilenceUsage: true,
 	RunE:         panicGuard(runGetZone),
 }
 
-// runGetZone retrieves the zone config for a given object id,
-// and if present, outputs its YAML representation.
-// TODO(marc): accept db/table names rather than IDs.
+// runGetZone retrieves the zone config which applies to the given object
+// and outputs its YAML representation.
 func runGetZone(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
 		mustUsage(cmd)
 		return
 	}
-	id, err := strconv.Atoi(args[0])
+
+	admin := client.NewAdminClient(&context.Context, context.Addr, client.Zones)
+	body, err := admin.GetJSON(args[0])
 	if err != nil {
-		log.Errorf("could not parse object ID %s", args[0])
-		return
+		panicf("unable to get zone config of %s: %s", args[0], err)
 	}
-
-	db := makeSQLClient()
-	defer func() { _ = db.Close() }()
-	_, rows, err := runQueryWithFormat(db, fmtMap{"config": formatZone},
-		`SELECT * FROM system.zones WHERE id=$1`, id)
+	var resp server.ZoneConfigResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		panicf("unable to parse zone config %q: %s", body, err)
+	}
+	out, err := yaml.Marshal(resp.Zone)
 	if err != nil {
-		log.Error(err)
-		return
+		panicf("unable to format zone config: %s", err)
 	}
-
-	if len(rows) == 0 {
-		log.Errorf("Object %d: no zone config found", id)
-		return
+	if resp.Source != args[0] {
+		fmt.Printf("# inherited from %s\n", resp.Source)
 	}
-	fmt.Println(rows[0][1])
+	fmt.Print(string(out))
 }
 
-// A lsZonesCmd command displays a list of zone configs by object ID.
+// A lsZonesCmd command lists the objects with zone configs.
 var lsZonesCmd = &cobra.Command{
 	Use:   "ls [options]",
-	Short: "list all zone configs by object ID",
+	Short: "list all objects with zone configs",
 	Long: `
-List zone configs.
+Lists the names of all objects which have a zone config of their own.
 `,
 	SilenceUsage: true,
 	RunE:         panicGuard(runLsZones),
 }
 
-// TODO(marc): return db/table names rather than IDs.
 func runLsZones(cmd *cobra.Command, args []string) {
 	if len(args) > 0 {
 		mustUsage(cmd)
 		return
 	}
-	db := makeSQLClient()
-	defer func() { _ = db.Close() }()
-	_, rows, err := runQueryWithFormat(db, fmtMap{"config": formatZone}, `SELECT * FROM system.zones`)
-	if err != nil {
-		log.Error(err)
-		return
-	}
 
-	if len(rows) == 0 {
-		log.Error("No zone configs found")
-		return
+	admin := client.NewAdminClient(&context.Context, context.Addr, client.Zones)
+	names, err := admin.List()
+	if err != nil {
+		panicf("unable to list zone configs: %s", err)
 	}
-	for _, r := range rows {
-		fmt.Printf("Object %s:\n%s\n", r[0], r[1])
+	for _, name := range names {
+		fmt.Println(name)
 	}
 }
 
-// A rmZoneCmd command removes a zone config by ID.
+// A rmZoneCmd command removes the zone config of an object.
 var rmZoneCmd = &cobra.Command{
-	Use:   "rm [options] <object-id>",
-	Short: "remove a zone config by object ID",
+	Use:   "rm [options] <object-name>",
+	Short: "remove the zone config of an object",
 	Long: `
-Remove an existing zone config by object ID. No action is taken if no
-zone configuration exists for the specified object ID.
-`,
+Removes the zone config of <object-name>, which then inherits the zone config
+of its database or the default zone config. No action is taken if the object
+has no zone config of its own. The default zone config cannot be removed.
+` + zoneNameHelp,
 	SilenceUsage: true,
 	RunE:         panicGuard(runRmZone),
 }
 
-// TODO(marc): accept db/table names rather than IDs.
 func runRmZone(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
 		mustUsage(cmd)
 		return
 	}
-	id, err := strconv.Atoi(args[0])
-	if err != nil {
-		log.Errorf("could not parse object ID %s", args[0])
-		return
+	if args[0] == server.DefaultZoneName {
+		panicf("the default zone config cannot be removed")
 	}
 
-	db := makeSQLClient()
-	defer func() { _ = db.Close() }()
-	err = runPrettyQuery(db, os.Stdout, `DELETE FROM system.zones WHERE id=$1`, id)
-	if err != nil {
-		log.Error(err)
-		return
+	admin := client.NewAdminClient(&context.Context, context.Addr, client.Zones)
+	if err := admin.Delete(args[0]); err != nil {
+		panicf("unable to remove zone config of %s: %s", args[0], err)
 	}
+	fmt.Println("ok")
 }
 
 // A setZoneCmd command creates a new or updates an existing zone config.
 var setZoneCmd = &cobra.Command{
-	Use:   "set [options] <object-id> <zone-config>",
-	Short: "create or update zone config for object ID",
+	Use:   "set [options] <object-name> <zone-config>",
+	Short: "create or update the zone config of an object",
 	Long: `
-Create or update a zone config for the specified object ID (first argument: <object-id>)
-to the specified zone-config (second argument: <zone-config>).
-
+Create or update the zone config of the specified object (first argument:
+<object-name>) to the specified zone-config (second argument: <zone-config>).
+` + zoneNameHelp + `
 The zone config format has the following YAML schema:
 
   replicas:
@@ -179,9 +147,12 @@ The zone config format has the following YAML schema:
     - attrs:  ...
   range_min_bytes: <size-in-bytes>
   range_max_bytes: <size-in-bytes>
+  gc:
+    ttlseconds: <time-in-seconds>
+  min_failure_domains: <count>
 
-For example, to set the zone config for object 100, run:
-cockroach zone set 100 "replicas:
+For example, to set the zone config of table "bar" in database "foo", run:
+cockroach zone set foo.bar "replicas:
 - attrs: [us-east-1a, ssd]
 - attrs: [us-east-1b, ssd]
 - attrs: [us-west-1b, ssd]
@@ -192,48 +163,19 @@ range_max_bytes: 67108864"
 	RunE:         panicGuard(runSetZone),
 }
 
-// runSetZone parses the yaml input file, converts it to proto,
-// and inserts it in the system.zones table.
-// TODO(marc): accept db/table names rather than IDs.
+// runSetZone sends the YAML zone config to the node, which validates it and
+// stores it in the system.zones table.
 func runSetZone(cmd *cobra.Command, args []string) {
 	if len(args) != 2 {
 		mustUsage(cmd)
 		return
 	}
-	id, err := strconv.Atoi(args[0])
-	if err != nil {
-		log.Errorf("could not parse object ID %s", args[0])
-		return
-	}
 
-	// Convert it to proto and marshal it again to put into the table.
-	// This is a bit more tedious than taking protos directly,
-	// but yaml is a more widely understood format.
-	var pbZoneConfig config.ZoneConfig
-	if err := yaml.Unmarshal([]byte(args[1]), &pbZoneConfig); err != nil {
-		log.Errorf("unable to parse zone config file %q: %s", args[1], err)
-		return
-	}
-
-	if err := pbZoneConfig.Validate(); err != nil {
-		log.Error(err)
-		return
-	}
-
-	buf, err := proto.Marshal(&pbZoneConfig)
-	if err != nil {
-		log.Errorf("unable to parse zone config file %q: %s", args[1], err)
-		return
-	}
-
-	db := makeSQLClient()
-	defer func() { _ = db.Close() }()
-	// TODO(marc): switch to UPSERT.
-	err = runPrettyQuery(db, os.Stdout, `INSERT INTO system.zones VALUES ($1, $2)`, id, buf)
-	if err != nil {
-		log.Error(err)
-		return
+	admin := client.NewAdminClient(&context.Context, context.Addr, client.Zones)
+	if err := admin.SetYAML(args[0], args[1]); err != nil {
+		panicf("unable to set zone config of %s: %s", args[0], err)
 	}
+	fmt.Println("ok")
 }
 
 var zoneCmds = []*cobra.Command{
@@ -245,7 +187,7 @@ var zoneCmds = []*cobra.Command{
 
 var zoneCmd = &cobra.Command{
 	Use:   "zone",
-	Short: "get, set, list and remove zones\n",
+	Short: "get, set, list and remove zones",
 	Run: func(cmd *cobra.Command, args []string) {
 		mustUsage(cmd)
 	},
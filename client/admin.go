@@ -34,6 +34,19 @@ const (
 
 	// Quit only handles Get requests.
 	Quit = "quit"
+	// Drain only handles Get requests.
+	Drain = "drain"
+	// Decommission handles Get requests for progress and Post requests to
+	// decommission nodes.
+	Decommission = "v1/decommission"
+	// Recommission only handles Post requests.
+	Recommission = "v1/recommission"
+	// Maintenance handles Get requests for whether the node is in
+	// maintenance mode and Post requests to set it.
+	Maintenance = "v1/maintenance"
+	// Zones handles Get requests listing the objects with zone configs and
+	// Get, Post and Delete requests for the zone config of a named object.
+	Zones = "v1/zones"
 )
 
 // AdminClient issues http requests to admin endpoints.
@@ -69,6 +82,26 @@ func (a *AdminClient) Get() (string, error) {
 	return string(body), nil
 }
 
+// GetWithParams is the same as Get, but appends the supplied query parameters
+// to the request URI.
+func (a *AdminClient) GetWithParams(params url.Values) (string, error) {
+	body, err := a.do("GET", a.adminURI()+"?"+params.Encode(), "", util.PlaintextContentType, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// PostWithParams issues a POST with the supplied query parameters appended to
+// the request URI and returns the plain-text body.
+func (a *AdminClient) PostWithParams(params url.Values) (string, error) {
+	body, err := a.do("POST", a.adminURI()+"?"+params.Encode(), "", util.PlaintextContentType, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
 // GetJSON issues a GET request and returns a json-encoded response.
 func (a *AdminClient) GetJSON(key string) (string, error) {
 	body, err := a.do("GET", a.adminURIWithKey(key), "", util.JSONContentType, nil)
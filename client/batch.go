@@ -161,6 +161,7 @@ func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) *roa
 			case *roachpb.EndTransactionRequest:
 			case *roachpb.AdminMergeRequest:
 			case *roachpb.AdminSplitRequest:
+			case *roachpb.AdminScatterRequest:
 			case *roachpb.HeartbeatTxnRequest:
 			case *roachpb.GCRequest:
 			case *roachpb.PushTxnRequest:
@@ -170,6 +171,8 @@ func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) *roa
 			case *roachpb.MergeRequest:
 			case *roachpb.TruncateLogRequest:
 			case *roachpb.LeaderLeaseRequest:
+			case *roachpb.RaftStatusRequest:
+			case *roachpb.RangeStatsRequest:
 				// Nothing to do for these methods as they do not generate any
 				// rows.
 
@@ -412,3 +415,54 @@ func (b *Batch) adminSplit(splitKey interface{}) {
 	b.reqs = append(b.reqs, req)
 	b.initResult(1, 0, nil)
 }
+
+// adminScatter is only exported on DB. It is here for symmetry with the
+// other operations.
+func (b *Batch) adminScatter(key interface{}) {
+	k, err := marshalKey(key)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	req := &roachpb.AdminScatterRequest{
+		Span: roachpb.Span{
+			Key: k,
+		},
+	}
+	b.reqs = append(b.reqs, req)
+	b.initResult(1, 0, nil)
+}
+
+// rangeStats is only exported on DB. It is here for symmetry with the
+// other operations.
+func (b *Batch) rangeStats(key interface{}) {
+	k, err := marshalKey(key)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	req := &roachpb.RangeStatsRequest{
+		Span: roachpb.Span{
+			Key: k,
+		},
+	}
+	b.reqs = append(b.reqs, req)
+	b.initResult(1, 0, nil)
+}
+
+// raftStatus is only exported on DB. It is here for symmetry with the
+// other operations.
+func (b *Batch) raftStatus(key interface{}) {
+	k, err := marshalKey(key)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	req := &roachpb.RaftStatusRequest{
+		Span: roachpb.Span{
+			Key: k,
+		},
+	}
+	b.reqs = append(b.reqs, req)
+	b.initResult(1, 0, nil)
+}
@@ -18,14 +18,19 @@ package client
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math/big"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/net/context"
 
 	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/retry"
@@ -407,6 +412,480 @@ func (db *DB) AdminSplit(splitKey interface{}) *roachpb.Error {
 	return pErr
 }
 
+// AdminScatter moves one of the replicas of the range containing key, other
+// than that of the replica holding the range's leader lease, to a node which
+// holds no replica of the range, and returns the updated range descriptor.
+// The range is left in place if it has no other replica or there is no such
+// node.
+//
+// key can be either a byte slice or a string.
+func (db *DB) AdminScatter(key interface{}) (roachpb.RangeDescriptor, *roachpb.Error) {
+	b := db.NewBatch()
+	b.adminScatter(key)
+	br, pErr := db.RunWithResponse(b)
+	if pErr != nil {
+		return roachpb.RangeDescriptor{}, pErr
+	}
+	return br.Responses[0].GetInner().(*roachpb.AdminScatterResponse).Desc, nil
+}
+
+// RaftStatus returns the Raft status of the range containing key, as seen by
+// the replica holding the range's leader lease.
+//
+// key can be either a byte slice or a string.
+func (db *DB) RaftStatus(key interface{}) (*roachpb.RaftStatusResponse, *roachpb.Error) {
+	b := db.NewBatch()
+	b.raftStatus(key)
+	br, pErr := db.RunWithResponse(b)
+	if pErr != nil {
+		return nil, pErr
+	}
+	return br.Responses[0].GetInner().(*roachpb.RaftStatusResponse), nil
+}
+
+// WaitForReplication blocks until the range containing key has at least the
+// given number of replicas which have caught up with the range's Raft log,
+// polling the range's Raft status. An error containing the observed number of
+// replicas is returned if this does not happen within the timeout.
+//
+// key can be either a byte slice or a string.
+func (db *DB) WaitForReplication(key interface{}, replicas int, timeout time.Duration) *roachpb.Error {
+	retryOpts := retry.Options{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     250 * time.Millisecond,
+		Multiplier:     2,
+	}
+	deadline := time.Now().Add(timeout)
+	var observed int
+	for r := retry.Start(retryOpts); r.Next(); {
+		status, pErr := db.RaftStatus(key)
+		if pErr != nil {
+			return pErr
+		}
+		observed = 0
+		for _, progress := range status.Progress {
+			if progress.Match > 0 {
+				observed++
+			}
+		}
+		if observed >= replicas {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+	}
+	return roachpb.NewErrorf("range containing key %q has %d replicas after %s, expected %d",
+		key, observed, timeout, replicas)
+}
+
+// WaitForApplied blocks until all replicas of the range containing key have
+// caught up with the range's committed Raft log entries, polling the range's
+// Raft status: the match index of every replica must have reached the commit
+// index, and the serving replica must have applied the committed entries. The
+// progress of the replicas is only known to the Raft leader, so the replica
+// holding the range's leader lease must also be the Raft leader. An error
+// naming the lagging replicas is returned if this does not happen within the
+// timeout, which may be the case if the range keeps receiving writes.
+//
+// key can be either a byte slice or a string.
+func (db *DB) WaitForApplied(key interface{}, timeout time.Duration) *roachpb.Error {
+	retryOpts := retry.Options{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     250 * time.Millisecond,
+		Multiplier:     2,
+	}
+	deadline := time.Now().Add(timeout)
+	var lagging []string
+	for r := retry.Start(retryOpts); r.Next(); {
+		status, pErr := db.RaftStatus(key)
+		if pErr != nil {
+			return pErr
+		}
+		lagging = lagging[:0]
+		if len(status.Progress) == 0 {
+			lagging = append(lagging, fmt.Sprintf("all (replica %d is %s)", status.Replica.ReplicaID, status.State))
+		}
+		if status.Applied < status.Commit {
+			lagging = append(lagging, fmt.Sprintf("%d (applied %d < commit %d)",
+				status.Replica.ReplicaID, status.Applied, status.Commit))
+		}
+		for _, progress := range status.Progress {
+			if progress.Match < status.Commit {
+				lagging = append(lagging, fmt.Sprintf("%d (match %d < commit %d)",
+					progress.ReplicaID, progress.Match, status.Commit))
+			}
+		}
+		if len(lagging) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+	}
+	return roachpb.NewErrorf("replicas of the range containing key %q have not caught up after %s: %s",
+		key, timeout, strings.Join(lagging, ", "))
+}
+
+// ReplicaHealth describes how far a replica of a range is behind the range's
+// committed Raft log entries.
+type ReplicaHealth string
+
+const (
+	// ReplicaUpToDate is the health of a replica which has all the committed
+	// log entries of the range.
+	ReplicaUpToDate ReplicaHealth = "up-to-date"
+	// ReplicaLagging is the health of a replica which lacks committed log
+	// entries of the range, which the Raft leader will send it.
+	ReplicaLagging ReplicaHealth = "lagging"
+	// ReplicaNeedsSnapshot is the health of a replica which lacks log entries
+	// the Raft leader has truncated, and needs a snapshot of the range to
+	// catch up.
+	ReplicaNeedsSnapshot ReplicaHealth = "needs-snapshot"
+	// ReplicaHealthUnknown is the health of a replica whose progress isn't
+	// tracked by the replica holding the range's leader lease, e.g. because
+	// it isn't the Raft leader.
+	ReplicaHealthUnknown ReplicaHealth = "unknown"
+)
+
+// raftProgressStateSnapshot is the state of the progress of a follower
+// which needs a snapshot, as reported in roachpb.RaftProgress.
+const raftProgressStateSnapshot = "ProgressStateSnapshot"
+
+// RangeReplica is a replica of a range along with its health.
+type RangeReplica struct {
+	roachpb.ReplicaDescriptor
+	Health ReplicaHealth
+	// Match is the index of the highest log entry known to be replicated to
+	// the replica, or zero if its health is unknown.
+	Match uint64
+}
+
+// RangeReplicas returns the replicas of the range containing key, as listed
+// by the descriptor of the replica holding the range's leader lease, along
+// with their health according to the Raft status of that replica. The
+// progress of the replicas is only known to the Raft leader, so the health
+// of all the replicas is unknown unless the replica holding the leader lease
+// is also the Raft leader.
+//
+// key can be either a byte slice or a string.
+func (db *DB) RangeReplicas(key interface{}) ([]RangeReplica, *roachpb.Error) {
+	b := db.NewBatch()
+	b.rangeStats(key)
+	b.raftStatus(key)
+	br, pErr := db.RunWithResponse(b)
+	if pErr != nil {
+		return nil, pErr
+	}
+	desc := br.Responses[0].GetInner().(*roachpb.RangeStatsResponse).Desc
+	status := br.Responses[1].GetInner().(*roachpb.RaftStatusResponse)
+
+	progress := make(map[roachpb.ReplicaID]roachpb.RaftProgress, len(status.Progress))
+	for _, p := range status.Progress {
+		progress[p.ReplicaID] = p
+	}
+	replicas := make([]RangeReplica, 0, len(desc.Replicas))
+	for _, replica := range desc.Replicas {
+		r := RangeReplica{ReplicaDescriptor: replica, Health: ReplicaHealthUnknown}
+		if p, ok := progress[replica.ReplicaID]; ok {
+			r.Match = p.Match
+			switch {
+			case p.State == raftProgressStateSnapshot:
+				r.Health = ReplicaNeedsSnapshot
+			case p.Match >= status.Commit:
+				r.Health = ReplicaUpToDate
+			default:
+				r.Health = ReplicaLagging
+			}
+		}
+		replicas = append(replicas, r)
+	}
+	return replicas, nil
+}
+
+// RangeStats returns the descriptor and MVCC statistics of the range
+// containing key, as seen by the replica holding the range's leader lease.
+//
+// key can be either a byte slice or a string.
+func (db *DB) RangeStats(key interface{}) (*roachpb.RangeStatsResponse, *roachpb.Error) {
+	b := db.NewBatch()
+	b.rangeStats(key)
+	br, pErr := db.RunWithResponse(b)
+	if pErr != nil {
+		return nil, pErr
+	}
+	return br.Responses[0].GetInner().(*roachpb.RangeStatsResponse), nil
+}
+
+// GCThreshold returns the GC threshold of the range containing key, as seen
+// by the replica holding the range's leader lease. Reads of the range at
+// older timestamps are rejected, as the versions they would observe may
+// already have been garbage collected. The threshold is zero if the range's
+// zone has no GC TTL.
+//
+// key can be either a byte slice or a string.
+func (db *DB) GCThreshold(key interface{}) (roachpb.Timestamp, *roachpb.Error) {
+	stats, pErr := db.RangeStats(key)
+	if pErr != nil {
+		return roachpb.ZeroTimestamp, pErr
+	}
+	return stats.GCThreshold, nil
+}
+
+// DecommissionProgress describes how far along the decommissioning of a node
+// is.
+type DecommissionProgress struct {
+	NodeID roachpb.NodeID
+	// Decommissioned is set once the nodes have recorded that the node holds
+	// no more replicas.
+	Decommissioned bool
+	// Replicas is the number of replicas remaining on the node.
+	Replicas int
+}
+
+// Decommission marks the node as decommissioning, unless it is already, and
+// returns the progress of its decommissioning. The nodes periodically pick up
+// the change, move the node's replicas to other nodes and, once it holds no
+// more replicas, mark it decommissioned. Calling Decommission repeatedly
+// reports the replicas remaining on the node until there are none.
+func (db *DB) Decommission(nodeID roachpb.NodeID) (DecommissionProgress, *roachpb.Error) {
+	progress := DecommissionProgress{NodeID: nodeID}
+	if pErr := db.Txn(func(txn *Txn) *roachpb.Error {
+		var status roachpb.DecommissionStatus
+		if pErr := txn.GetProto(keys.DecommissionStatusKey, &status); pErr != nil {
+			return pErr
+		}
+		progress.Decommissioned = status.IsDecommissioned(nodeID)
+		if progress.Decommissioned || status.IsDecommissioning(nodeID) {
+			return nil
+		}
+		status.Decommissioning = append(status.Decommissioning, nodeID)
+		return txn.Put(keys.DecommissionStatusKey, &status)
+	}); pErr != nil {
+		return DecommissionProgress{}, pErr
+	}
+	if progress.Decommissioned {
+		return progress, nil
+	}
+
+	// Replicas are counted using the range descriptors rather than the stores
+	// themselves, since removed replicas are only garbage collected lazily.
+	rows, pErr := db.Scan(keys.Meta2Prefix, keys.MetaMax, 0)
+	if pErr != nil {
+		return DecommissionProgress{}, pErr
+	}
+	for _, row := range rows {
+		var desc roachpb.RangeDescriptor
+		if err := row.ValueProto(&desc); err != nil {
+			return DecommissionProgress{}, roachpb.NewErrorf("%s: unable to unmarshal range descriptor: %s", row.Key, err)
+		}
+		for _, repl := range desc.Replicas {
+			if repl.NodeID == nodeID {
+				progress.Replicas++
+			}
+		}
+	}
+	return progress, nil
+}
+
+// DumpRangeDescriptors writes the descriptors of all ranges, including their
+// replicas, to w as newline-delimited JSON, in key order, and returns the
+// number of descriptors written. The meta2 records are scanned pageSize at a
+// time, so a large cluster doesn't have to fit into a single response; each
+// page is read separately and the dump is not a consistent snapshot.
+func (db *DB) DumpRangeDescriptors(w io.Writer, pageSize int64) (int, *roachpb.Error) {
+	if pageSize < 1 {
+		return 0, roachpb.NewErrorf("invalid page size %d", pageSize)
+	}
+	enc := json.NewEncoder(w)
+	count := 0
+	for key := keys.Meta2Prefix; ; {
+		rows, pErr := db.Scan(key, keys.MetaMax, pageSize)
+		if pErr != nil {
+			return count, pErr
+		}
+		for _, row := range rows {
+			var desc roachpb.RangeDescriptor
+			if err := row.ValueProto(&desc); err != nil {
+				return count, roachpb.NewErrorf("%s: unable to unmarshal range descriptor: %s", row.Key, err)
+			}
+			if err := enc.Encode(&desc); err != nil {
+				return count, roachpb.NewError(err)
+			}
+			count++
+		}
+		if int64(len(rows)) < pageSize {
+			return count, nil
+		}
+		key = rows[len(rows)-1].Key.Next()
+	}
+}
+
+// PrepareForImport splits the span into splitCount ranges of roughly equal
+// key width and scatters them, in preparation for a bulk import into the
+// span. Existing range boundaries at the computed split keys are reused, so
+// the call can be retried. Each of the ranges covering the span then has one
+// of its replicas moved by AdminScatter, which spreads the ranges created by
+// the splits, initially placed on the replicas of the range they were split
+// from, across the cluster. The descriptors of the ranges covering the span
+// are returned; their replicas describe the placement of the span's data.
+func (db *DB) PrepareForImport(span roachpb.Span, splitCount int) ([]roachpb.RangeDescriptor, *roachpb.Error) {
+	if splitCount < 1 {
+		return nil, roachpb.NewErrorf("invalid split count %d", splitCount)
+	}
+	if bytes.Compare(span.Key, span.EndKey) >= 0 {
+		return nil, roachpb.NewErrorf("invalid span [%s, %s)", span.Key, span.EndKey)
+	}
+	for _, splitKey := range importSplitKeys(span.Key, span.EndKey, splitCount) {
+		stats, pErr := db.RangeStats(splitKey)
+		if pErr != nil {
+			return nil, pErr
+		}
+		if stats.Desc.StartKey.Equal(splitKey) {
+			continue
+		}
+		if pErr := db.AdminSplit(splitKey); pErr != nil {
+			return nil, pErr
+		}
+	}
+
+	var descs []roachpb.RangeDescriptor
+	for key := span.Key; bytes.Compare(key, span.EndKey) < 0; {
+		desc, pErr := db.AdminScatter(key)
+		if pErr != nil {
+			return nil, pErr
+		}
+		descs = append(descs, desc)
+		key = roachpb.Key(desc.EndKey)
+	}
+	return descs, nil
+}
+
+// ExportSnapshot returns all key-values in the span as of the given
+// timestamp, which makes it a consistent point-in-time snapshot of the span.
+// The span is read one range at a time. An error is returned if the timestamp
+// is below the GC threshold of a range, as versions visible at the timestamp
+// may already have been garbage collected.
+func (db *DB) ExportSnapshot(span roachpb.Span, asOf roachpb.Timestamp) ([]KeyValue, *roachpb.Error) {
+	if asOf.Equal(roachpb.ZeroTimestamp) {
+		return nil, roachpb.NewErrorf("export of [%s, %s) requires a timestamp", span.Key, span.EndKey)
+	}
+	var rows []KeyValue
+	for key := span.Key; bytes.Compare(key, span.EndKey) < 0; {
+		stats, pErr := db.RangeStats(key)
+		if pErr != nil {
+			return nil, pErr
+		}
+		if asOf.Less(stats.GCThreshold) {
+			return nil, roachpb.NewErrorf("export as of %s is below the GC threshold %s of range %d",
+				asOf, stats.GCThreshold, stats.RangeID)
+		}
+		end := roachpb.Key(stats.Desc.EndKey)
+		if bytes.Compare(end, span.EndKey) > 0 {
+			end = span.EndKey
+		}
+
+		ba := roachpb.BatchRequest{}
+		ba.Timestamp = asOf
+		ba.Add(&roachpb.ScanRequest{Span: roachpb.Span{Key: key, EndKey: end}})
+		br, pErr := db.sender.Send(context.TODO(), ba)
+		if pErr != nil {
+			return nil, pErr
+		}
+
+		// A scan which spans ranges is retried in a transaction at the current
+		// timestamp. If the range was split while it was scanned, discard the
+		// result and read the range again.
+		if stats, pErr = db.RangeStats(key); pErr != nil {
+			return nil, pErr
+		}
+		if bytes.Compare(stats.Desc.EndKey, end) < 0 {
+			continue
+		}
+
+		for _, row := range br.Responses[0].GetInner().(*roachpb.ScanResponse).Rows {
+			value := row.Value
+			rows = append(rows, KeyValue{Key: row.Key, Value: &value})
+		}
+		key = end
+	}
+	return rows, nil
+}
+
+// Barrier returns once the writes to the span which were acknowledged, or
+// were already being evaluated by the leader lease holders of the span's
+// ranges, when it was called have been applied by those lease holders. Reads
+// of the span issued after Barrier returns, from any client, observe these
+// writes.
+//
+// The barrier is a consistent scan of each of the span's ranges, which waits
+// in the range's command queue for the overlapping commands ahead of it. Only
+// a single row is read per range.
+func (db *DB) Barrier(span roachpb.Span) *roachpb.Error {
+	if bytes.Compare(span.Key, span.EndKey) >= 0 {
+		return roachpb.NewErrorf("invalid span [%s, %s)", span.Key, span.EndKey)
+	}
+	for key := span.Key; bytes.Compare(key, span.EndKey) < 0; {
+		stats, pErr := db.RangeStats(key)
+		if pErr != nil {
+			return pErr
+		}
+		end := roachpb.Key(stats.Desc.EndKey)
+		if bytes.Compare(end, span.EndKey) > 0 {
+			end = span.EndKey
+		}
+		if _, pErr := db.Scan(key, end, 1); pErr != nil {
+			return pErr
+		}
+
+		// A scan which spans ranges stops at the first range returning a row.
+		// If the range was split before it was scanned, only the left-hand
+		// side of the split is known to be covered.
+		if stats, pErr = db.RangeStats(key); pErr != nil {
+			return pErr
+		}
+		if bytes.Compare(stats.Desc.EndKey, end) < 0 {
+			end = roachpb.Key(stats.Desc.EndKey)
+		}
+		key = end
+	}
+	return nil
+}
+
+// importSplitKeys returns the keys at which [start, end) is split into n
+// parts of equal width, including start and, unless it is the end of the
+// keyspace, end. The keys are interpreted as fractions by padding them to a
+// common length, with one extra byte to leave room between adjacent keys.
+func importSplitKeys(start, end roachpb.Key, n int) []roachpb.Key {
+	width := len(start)
+	if len(end) > width {
+		width = len(end)
+	}
+	width++
+	toInt := func(k roachpb.Key) *big.Int {
+		padded := make([]byte, width)
+		copy(padded, k)
+		return new(big.Int).SetBytes(padded)
+	}
+	lo, hi := toInt(start), toInt(end)
+	step := new(big.Int).Div(new(big.Int).Sub(hi, lo), big.NewInt(int64(n)))
+
+	splitKeys := []roachpb.Key{start}
+	for i := 1; i < n; i++ {
+		v := new(big.Int).Mul(step, big.NewInt(int64(i)))
+		b := v.Add(v, lo).Bytes()
+		k := make(roachpb.Key, width)
+		copy(k[width-len(b):], b)
+		if splitKey := roachpb.Key(bytes.TrimRight(k, "\x00")); bytes.Compare(splitKey, splitKeys[len(splitKeys)-1]) > 0 {
+			splitKeys = append(splitKeys, splitKey)
+		}
+	}
+	if !end.Equal(roachpb.KeyMax) {
+		splitKeys = append(splitKeys, end)
+	}
+	return splitKeys
+}
+
 // sendAndFill is a helper which sends the given batch and fills its results,
 // returning the appropriate error which is either from the first failing call,
 // or an "internal" error.
@@ -429,6 +908,23 @@ func sendAndFill(send func(...roachpb.Request) (*roachpb.BatchResponse, *roachpb
 	return br, nil
 }
 
+// Now returns the current timestamp of the hybrid logical clock of the node
+// the client is connected to. Reading the clock forwards it, so successive
+// calls return increasing timestamps, which can be used as read timestamps or
+// to order events across clients.
+func (db *DB) Now() (roachpb.Timestamp, *roachpb.Error) {
+	// An inconsistent read without a timestamp is assigned the current
+	// timestamp of the node's clock, which is returned in the response.
+	ba := roachpb.BatchRequest{}
+	ba.ReadConsistency = roachpb.INCONSISTENT
+	ba.Add(&roachpb.GetRequest{Span: roachpb.Span{Key: keys.Meta1Prefix}})
+	br, pErr := db.sender.Send(context.TODO(), ba)
+	if pErr != nil {
+		return roachpb.ZeroTimestamp, pErr
+	}
+	return br.Timestamp, nil
+}
+
 // Run executes the operations queued up within a batch. Before executing any
 // of the operations the batch is first checked to see if there were any errors
 // during its construction (e.g. failure to marshal a proto message).
@@ -17,15 +17,23 @@
 package client_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/testutils/testcluster"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/caller"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -333,6 +341,552 @@ func TestDebugName(t *testing.T) {
 	})
 }
 
+// TestRaftStatus verifies that the Raft status of the range containing a key
+// can be read and that it reflects the state of the only replica.
+func TestRaftStatus(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	if pErr := db.Put("a", "1"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	status, pErr := db.RaftStatus("a")
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	if status.RangeID != 1 {
+		t.Errorf("expected range 1, got %d", status.RangeID)
+	}
+	if status.State != "StateLeader" {
+		t.Errorf("expected the only replica to be the leader, got %s", status.State)
+	}
+	if status.Lead != status.Replica.ReplicaID {
+		t.Errorf("expected leader %d, got %d", status.Replica.ReplicaID, status.Lead)
+	}
+	if status.Term == 0 || status.Commit == 0 || status.Applied == 0 {
+		t.Errorf("expected term, commit and applied index to be set: %+v", status)
+	}
+	if len(status.Progress) != 1 {
+		t.Fatalf("expected progress of a single replica, got %+v", status.Progress)
+	}
+	if progress := status.Progress[0]; progress.ReplicaID != status.Replica.ReplicaID || progress.Match == 0 {
+		t.Errorf("unexpected progress %+v for replica %+v", progress, status.Replica)
+	}
+}
+
+func TestWaitForReplication(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{})
+	defer tc.Stop()
+	db := tc.DBs[0]
+
+	if pErr := db.AdminSplit("m"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	// The range created by the split is up-replicated to the default
+	// replication factor by the replicate queue.
+	if pErr := db.WaitForReplication("m", 3, 10*time.Second); pErr != nil {
+		t.Fatal(pErr)
+	}
+	// The cluster can't hold more replicas than it has nodes.
+	pErr := db.WaitForReplication("m", 4, 100*time.Millisecond)
+	if pErr == nil || !strings.Contains(pErr.GoError().Error(), "has 3 replicas") {
+		t.Fatalf("expected timeout with 3 replicas, got %v", pErr)
+	}
+}
+
+// TestWaitForApplied writes to a range with three replicas and waits for all
+// of them to catch up, then stops one of them and verifies that waiting for
+// it times out.
+func TestWaitForApplied(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{
+		ReplicationMode: testcluster.ReplicationManual,
+	})
+	defer tc.Stop()
+	db := tc.DBs[0]
+
+	key := roachpb.Key("m")
+	if pErr := db.AdminSplit(key); pErr != nil {
+		t.Fatal(pErr)
+	}
+	desc, err := tc.RelocateRange(key, tc.Target(0), tc.Target(1), tc.Target(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pErr := db.Put(key, "1"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	status, pErr := db.RaftStatus(key)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	if pErr := db.WaitForApplied(key, 10*time.Second); pErr != nil {
+		t.Fatal(pErr)
+	}
+	// All the replicas have caught up with the write.
+	caughtUp, pErr := db.RaftStatus(key)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	if len(caughtUp.Progress) != 3 {
+		t.Fatalf("expected progress of 3 replicas, got %+v", caughtUp.Progress)
+	}
+	for i, progress := range caughtUp.Progress {
+		if i > 0 && progress.ReplicaID <= caughtUp.Progress[i-1].ReplicaID {
+			t.Errorf("expected progress sorted by replica ID, got %+v", caughtUp.Progress)
+		}
+		if progress.Match < status.Commit {
+			t.Errorf("expected replica %d to match commit index %d, got %d",
+				progress.ReplicaID, status.Commit, progress.Match)
+		}
+	}
+
+	// Stop a node which doesn't hold the lease; the remaining replicas can
+	// still commit writes, but the stopped one can't catch up with them.
+	leaseHolder, err := tc.FindRangeLeaseHolder(desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopped := 2
+	if leaseHolder == tc.Target(stopped) {
+		stopped = 1
+	}
+	tc.StopNode(stopped)
+	if pErr := db.Put(key, "2"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	pErr = db.WaitForApplied(key, 100*time.Millisecond)
+	if pErr == nil || !strings.Contains(pErr.GoError().Error(), "have not caught up") {
+		t.Fatalf("expected timeout, got %v", pErr)
+	}
+}
+
+// TestRangeReplicas reads the replicas of a range with three replicas once
+// they have caught up, and verifies that each of them is reported up to
+// date, then stops one of them and verifies that it is reported lagging
+// behind the following writes.
+func TestRangeReplicas(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{
+		ReplicationMode: testcluster.ReplicationManual,
+	})
+	defer tc.Stop()
+	db := tc.DBs[0]
+
+	key := roachpb.Key("m")
+	if pErr := db.AdminSplit(key); pErr != nil {
+		t.Fatal(pErr)
+	}
+	desc, err := tc.RelocateRange(key, tc.Target(0), tc.Target(1), tc.Target(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pErr := db.Put(key, "1"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	if pErr := db.WaitForApplied(key, 10*time.Second); pErr != nil {
+		t.Fatal(pErr)
+	}
+	replicas, pErr := db.RangeReplicas(key)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	if len(replicas) != 3 {
+		t.Fatalf("expected 3 replicas, got %+v", replicas)
+	}
+	for i, replica := range replicas {
+		if replica.ReplicaDescriptor != desc.Replicas[i] {
+			t.Errorf("%d: expected replica %+v, got %+v", i, desc.Replicas[i], replica.ReplicaDescriptor)
+		}
+		if replica.Health != client.ReplicaUpToDate || replica.Match == 0 {
+			t.Errorf("%d: expected up-to-date replica, got %+v", i, replica)
+		}
+	}
+
+	// Stop a node which doesn't hold the lease; its replica can't catch up
+	// with the following writes.
+	leaseHolder, err := tc.FindRangeLeaseHolder(desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopped := 2
+	if leaseHolder == tc.Target(stopped) {
+		stopped = 1
+	}
+	tc.StopNode(stopped)
+	if pErr := db.Put(key, "2"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		replicas, pErr := db.RangeReplicas(key)
+		if pErr != nil {
+			return pErr.GoError()
+		}
+		for _, replica := range replicas {
+			expected := client.ReplicaUpToDate
+			if replica.StoreID == tc.Target(stopped).StoreID {
+				expected = client.ReplicaLagging
+			}
+			if replica.Health != expected {
+				return util.Errorf("expected replica %d to be %s, got %s", replica.ReplicaID, expected, replica.Health)
+			}
+		}
+		return nil
+	})
+}
+
+func TestNow(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	var prev roachpb.Timestamp
+	for i := 0; i < 10; i++ {
+		now, pErr := db.Now()
+		if pErr != nil {
+			t.Fatal(pErr)
+		}
+		if now.Equal(roachpb.ZeroTimestamp) {
+			t.Fatalf("%d: expected a timestamp", i)
+		}
+		if now.Less(prev) {
+			t.Errorf("%d: timestamp %s is before previous timestamp %s", i, now, prev)
+		}
+		prev = now
+	}
+}
+
+// TestPrepareForImport prepares a span whose range is replicated to three of
+// five nodes and verifies that the span is split into the requested number of
+// ranges, each of which has had one of its replicas moved to the other nodes.
+func TestPrepareForImport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	const numNodes = 5
+	tc := testcluster.StartTestCluster(t, numNodes, testcluster.ClusterArgs{
+		ReplicationMode: testcluster.ReplicationManual,
+	})
+	defer tc.Stop()
+	db := tc.DBs[0]
+
+	span := roachpb.Span{Key: roachpb.Key("a"), EndKey: roachpb.Key("b")}
+	if pErr := db.AdminSplit(span.Key); pErr != nil {
+		t.Fatal(pErr)
+	}
+	if _, err := tc.RelocateRange(span.Key, tc.Target(0), tc.Target(1), tc.Target(2)); err != nil {
+		t.Fatal(err)
+	}
+	original := map[roachpb.StoreID]struct{}{}
+	for i := 0; i < 3; i++ {
+		original[tc.Target(i).StoreID] = struct{}{}
+	}
+	// The scatter can only move replicas to the stores the allocator knows of.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		descs, err := tc.Servers[0].Gossip().GetStoreDescriptors()
+		if err != nil {
+			return err
+		}
+		if len(descs) != numNodes {
+			return util.Errorf("expected %d gossiped stores, got %d", numNodes, len(descs))
+		}
+		return nil
+	})
+
+	descs, pErr := db.PrepareForImport(span, 4)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	if len(descs) != 4 {
+		t.Fatalf("expected 4 ranges, got %d: %+v", len(descs), descs)
+	}
+	key := roachpb.RKey(span.Key)
+	for i, desc := range descs {
+		if !desc.StartKey.Equal(key) {
+			t.Errorf("%d: expected range to start at %s, got %s", i, key, desc.StartKey)
+		}
+		key = desc.EndKey
+		if len(desc.Replicas) != 3 {
+			t.Errorf("%d: expected 3 replicas of range %d, got %+v", i, desc.RangeID, desc.Replicas)
+		}
+		// The split ranges start out on the stores of the original range, one
+		// of which is replaced by the scatter.
+		moved := 0
+		for _, repl := range desc.Replicas {
+			if _, ok := original[repl.StoreID]; !ok {
+				moved++
+			}
+		}
+		if moved != 1 {
+			t.Errorf("%d: expected one replica of range %d to be moved, got %+v", i, desc.RangeID, desc.Replicas)
+		}
+		// The returned placement is that of the range.
+		actual, err := tc.LookupRange(roachpb.Key(desc.StartKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(desc, actual) {
+			t.Errorf("%d: expected range %+v, got %+v", i, actual, desc)
+		}
+	}
+	if !key.Equal(span.EndKey) {
+		t.Errorf("expected ranges to end at %s, got %s", span.EndKey, key)
+	}
+
+	// Preparing the span again reuses the existing ranges.
+	again, pErr := db.PrepareForImport(span, 4)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	if len(again) != len(descs) {
+		t.Fatalf("expected %d ranges, got %d: %+v", len(descs), len(again), again)
+	}
+	for i := range descs {
+		if again[i].RangeID != descs[i].RangeID {
+			t.Errorf("%d: expected range %d, got %d", i, descs[i].RangeID, again[i].RangeID)
+		}
+	}
+}
+
+func TestDecommission(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &server.TestServer{StoresPerNode: 3}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	db := createTestClientForUser(t, s.Stopper(), s.ServingAddr(), security.NodeUser)
+
+	if pErr := db.AdminSplit("m"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	rows, pErr := db.Scan(keys.Meta2Prefix, keys.MetaMax, 0)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	// The node holds a replica of every range, and keeps holding them since
+	// there is no other node to move them to.
+	nodeID := s.Gossip().GetNodeID()
+	for i := 0; i < 2; i++ {
+		progress, pErr := db.Decommission(nodeID)
+		if pErr != nil {
+			t.Fatal(pErr)
+		}
+		if e := (client.DecommissionProgress{NodeID: nodeID, Replicas: len(rows)}); progress != e {
+			t.Errorf("%d: expected progress %+v, got %+v", i, e, progress)
+		}
+	}
+	var status roachpb.DecommissionStatus
+	if pErr := db.GetProto(keys.DecommissionStatusKey, &status); pErr != nil {
+		t.Fatal(pErr)
+	}
+	if !reflect.DeepEqual(status.Decommissioning, []roachpb.NodeID{nodeID}) {
+		t.Errorf("expected node %d to be decommissioning once, got %+v", nodeID, status)
+	}
+
+	// A node without replicas has none left to move.
+	progress, pErr := db.Decommission(nodeID + 1)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	if e := (client.DecommissionProgress{NodeID: nodeID + 1}); progress != e {
+		t.Errorf("expected progress %+v, got %+v", e, progress)
+	}
+}
+
+func TestDumpRangeDescriptors(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	for _, key := range []string{"c", "f", "m"} {
+		if pErr := db.AdminSplit(key); pErr != nil {
+			t.Fatal(pErr)
+		}
+	}
+	rows, pErr := db.Scan(keys.Meta2Prefix, keys.MetaMax, 0)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	var expected []roachpb.RangeDescriptor
+	for _, row := range rows {
+		var desc roachpb.RangeDescriptor
+		if err := row.ValueProto(&desc); err != nil {
+			t.Fatal(err)
+		}
+		expected = append(expected, desc)
+	}
+
+	// Page sizes smaller than, dividing and exceeding the number of ranges.
+	for _, pageSize := range []int64{1, 2, int64(len(expected)), 100} {
+		var buf bytes.Buffer
+		count, pErr := db.DumpRangeDescriptors(&buf, pageSize)
+		if pErr != nil {
+			t.Fatal(pErr)
+		}
+		if count != len(expected) {
+			t.Errorf("%d: expected %d descriptors, got %d", pageSize, len(expected), count)
+		}
+		var descs []roachpb.RangeDescriptor
+		dec := json.NewDecoder(&buf)
+		for dec.More() {
+			var desc roachpb.RangeDescriptor
+			if err := dec.Decode(&desc); err != nil {
+				t.Fatal(err)
+			}
+			descs = append(descs, desc)
+		}
+		if !reflect.DeepEqual(descs, expected) {
+			t.Errorf("%d: expected descriptors %+v, got %+v", pageSize, expected, descs)
+		}
+	}
+
+	if _, pErr := db.DumpRangeDescriptors(&bytes.Buffer{}, 0); pErr == nil || !testutils.IsError(pErr.GoError(), "invalid page size") {
+		t.Errorf("expected invalid page size error, got %v", pErr)
+	}
+}
+
+func TestExportSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	// Export a span covering two ranges.
+	if pErr := db.AdminSplit("b"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	span := roachpb.Span{Key: roachpb.Key("a"), EndKey: roachpb.Key("d")}
+	export := func(asOf roachpb.Timestamp) map[string]string {
+		rows, pErr := db.ExportSnapshot(span, asOf)
+		if pErr != nil {
+			t.Fatal(pErr)
+		}
+		m := map[string]string{}
+		for _, row := range rows {
+			m[string(row.Key)] = string(row.ValueBytes())
+		}
+		return m
+	}
+
+	for _, kv := range [][2]string{{"a", "1"}, {"b", "1"}} {
+		if pErr := db.Put(kv[0], kv[1]); pErr != nil {
+			t.Fatal(pErr)
+		}
+	}
+	before, pErr := db.Now()
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	for _, kv := range [][2]string{{"a", "2"}, {"c", "2"}} {
+		if pErr := db.Put(kv[0], kv[1]); pErr != nil {
+			t.Fatal(pErr)
+		}
+	}
+	if pErr := db.Del("b"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	after, pErr := db.Now()
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	if a, e := export(before), map[string]string{"a": "1", "b": "1"}; !reflect.DeepEqual(a, e) {
+		t.Errorf("expected %v as of %s, got %v", e, before, a)
+	}
+	if a, e := export(after), map[string]string{"a": "2", "c": "2"}; !reflect.DeepEqual(a, e) {
+		t.Errorf("expected %v as of %s, got %v", e, after, a)
+	}
+
+	// Versions older than the GC TTL of the default zone may have been
+	// garbage collected.
+	old := roachpb.Timestamp{WallTime: after.WallTime - 2*int64(24*time.Hour)}
+	if _, pErr := db.ExportSnapshot(span, old); pErr == nil || !strings.Contains(pErr.GoError().Error(), "below the GC threshold") {
+		t.Errorf("expected GC threshold error, got %v", pErr)
+	}
+}
+
+func TestGCThreshold(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	if pErr := db.AdminSplit("b"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	before, pErr := db.Now()
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	threshold, pErr := db.GCThreshold("b")
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	after, pErr := db.Now()
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	// Nothing has been garbage collected from the fresh range, but reads are
+	// rejected once they are older than the GC TTL of the default zone. The
+	// clock may be slightly ahead of the physical clock the threshold is
+	// based on.
+	ttl := int64(config.DefaultZoneConfig.GC.TTLSeconds) * int64(time.Second)
+	if min, max := before.WallTime-ttl-int64(time.Second), after.WallTime-ttl; threshold.WallTime < min || threshold.WallTime > max {
+		t.Errorf("expected a GC threshold between %d and %d, got %s", min, max, threshold)
+	}
+	if _, pErr := db.ExportSnapshot(roachpb.Span{Key: roachpb.Key("b"), EndKey: roachpb.Key("c")},
+		threshold.Add(-1, 0)); pErr == nil || !strings.Contains(pErr.GoError().Error(), "below the GC threshold") {
+		t.Errorf("expected GC threshold error, got %v", pErr)
+	}
+}
+
+func TestBarrier(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	// Write to a span covering three ranges.
+	for _, splitKey := range []string{"b", "c"} {
+		if pErr := db.AdminSplit(splitKey); pErr != nil {
+			t.Fatal(pErr)
+		}
+	}
+	expected := map[string]string{}
+	for i, key := range []string{"a", "a1", "b", "c", "c1"} {
+		expected[key] = fmt.Sprint(i)
+		if pErr := db.Put(key, expected[key]); pErr != nil {
+			t.Fatal(pErr)
+		}
+	}
+	span := roachpb.Span{Key: roachpb.Key("a"), EndKey: roachpb.Key("d")}
+	if pErr := db.Barrier(span); pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	// A fresh client observes all the writes.
+	fresh, err := client.Open(s.Stopper(), fmt.Sprintf("rpcs://%s@%s?certs=%s",
+		security.NodeUser, s.ServingAddr(), security.EmbeddedCertsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, pErr := fresh.Scan(span.Key, span.EndKey, 0)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	actual := map[string]string{}
+	for _, row := range rows {
+		actual[string(row.Key)] = string(row.ValueBytes())
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if pErr := db.Barrier(roachpb.Span{Key: roachpb.Key("d"), EndKey: roachpb.Key("a")}); pErr == nil {
+		t.Error("expected an invalid span to be rejected")
+	}
+}
+
 func TestCommonMethods(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	batchType := reflect.TypeOf(&client.Batch{})
@@ -355,11 +909,22 @@ func TestCommonMethods(t *testing.T) {
 
 		key{batchType, "InternalAddRequest"}:      {},
 		key{dbType, "AdminMerge"}:                 {},
+		key{dbType, "AdminScatter"}:               {},
 		key{dbType, "AdminSplit"}:                 {},
+		key{dbType, "Barrier"}:                    {},
+		key{dbType, "ExportSnapshot"}:             {},
+		key{dbType, "GCThreshold"}:                {},
 		key{dbType, "NewBatch"}:                   {},
+		key{dbType, "Now"}:                        {},
+		key{dbType, "PrepareForImport"}:           {},
+		key{dbType, "RaftStatus"}:                 {},
+		key{dbType, "RangeReplicas"}:              {},
+		key{dbType, "RangeStats"}:                 {},
 		key{dbType, "Run"}:                        {},
 		key{dbType, "RunWithResponse"}:            {},
 		key{dbType, "Txn"}:                        {},
+		key{dbType, "WaitForApplied"}:             {},
+		key{dbType, "WaitForReplication"}:         {},
 		key{dbType, "GetSender"}:                  {},
 		key{txnType, "Commit"}:                    {},
 		key{txnType, "CommitBy"}:                  {},
@@ -84,6 +84,9 @@ type Txn struct {
 	// systemConfigTrigger is set to true when modifying keys from the SystemConfig
 	// span. This sets the SystemConfigTrigger on EndTransactionRequest.
 	systemConfigTrigger bool
+	// onCommitFns are invoked once the transaction has successfully
+	// committed. See OnCommit.
+	onCommitFns []func()
 }
 
 // NewTxn returns a new txn.
@@ -145,6 +148,15 @@ func (txn *Txn) SystemConfigTrigger() bool {
 	return txn.systemConfigTrigger
 }
 
+// OnCommit registers a callback to be invoked once the transaction has been
+// successfully committed. Callbacks are not invoked if the transaction is
+// aborted or rolled back. When the transaction is run using DB.Txn, callbacks
+// registered during an attempt which is subsequently retried are discarded, so
+// only those registered by the successful attempt are invoked.
+func (txn *Txn) OnCommit(fn func()) {
+	txn.onCommitFns = append(txn.onCommitFns, fn)
+}
+
 // NewBatch creates and returns a new empty batch object for use with the Txn.
 func (txn *Txn) NewBatch() *Batch {
 	return &Batch{DB: &txn.db, txn: txn}
@@ -378,6 +390,8 @@ func (txn *Txn) exec(retryable func(txn *Txn) *roachpb.Error) *roachpb.Error {
 	// error condition this loop isn't capable of handling.
 	var pErr *roachpb.Error
 	for r := retry.Start(txn.db.txnRetryOptions); r.Next(); {
+		// Discard any commit callbacks registered by a previous attempt.
+		txn.onCommitFns = nil
 		pErr = retryable(txn)
 		if pErr == nil && txn.Proto.Status == roachpb.PENDING {
 			// retryable succeeded, but didn't commit.
@@ -406,6 +420,15 @@ func (txn *Txn) exec(retryable func(txn *Txn) *roachpb.Error) *roachpb.Error {
 	return pErr
 }
 
+// runOnCommitFns invokes and clears the callbacks registered via OnCommit.
+func (txn *Txn) runOnCommitFns() {
+	fns := txn.onCommitFns
+	txn.onCommitFns = nil
+	for _, fn := range fns {
+		fn()
+	}
+}
+
 // send runs the specified calls synchronously in a single batch and
 // returns any errors. If the transaction is read-only or has already
 // been successfully committed or aborted, a potential trailing
@@ -476,6 +499,9 @@ func (txn *Txn) send(reqs ...roachpb.Request) (*roachpb.BatchResponse, *roachpb.
 			txn.Proto.Status = roachpb.ABORTED
 		}
 	}
+	if pErr == nil && txn.Proto.Status == roachpb.COMMITTED {
+		txn.runOnCommitFns()
+	}
 
 	// If we inserted a begin transaction request, remove it here.
 	if needBeginTxn {
@@ -464,6 +464,61 @@ func TestRunTransactionRetryOnErrors(t *testing.T) {
 	}
 }
 
+// TestTxnOnCommit verifies that commit callbacks are invoked exactly once
+// when a transaction commits, including after retries, and never when it is
+// rolled back.
+func TestTxnOnCommit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testCases := []struct {
+		write    bool // Perform a write (otherwise EndTransaction is elided)?
+		retry    bool // Fail the first attempt with a retryable error?
+		rollback bool // Return an error from the txn closure?
+		expCount int
+	}{
+		{write: true, expCount: 1},
+		{write: false, expCount: 1},
+		{write: true, retry: true, expCount: 1},
+		{write: true, rollback: true, expCount: 0},
+		{write: false, rollback: true, expCount: 0},
+	}
+
+	for i, test := range testCases {
+		attempts := 0
+		db := newDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+			if _, ok := ba.GetArg(roachpb.Put); ok && test.retry && attempts == 1 {
+				return nil, roachpb.NewError(&roachpb.TransactionRetryError{})
+			}
+			return ba.CreateReply(), nil
+		}, nil))
+		db.txnRetryOptions.InitialBackoff = 1 * time.Millisecond
+
+		count := 0
+		pErr := db.Txn(func(txn *Txn) *roachpb.Error {
+			attempts++
+			txn.OnCommit(func() { count++ })
+			if test.write {
+				if pErr := txn.Put("a", "b"); pErr != nil {
+					return pErr
+				}
+			}
+			if test.rollback {
+				return roachpb.NewErrorf("rollback")
+			}
+			return nil
+		})
+		if test.rollback != (pErr != nil) {
+			t.Errorf("%d: unexpected error: %v", i, pErr)
+		}
+		if test.retry && attempts != 2 {
+			t.Errorf("%d: expected 2 attempts; got %d", i, attempts)
+		}
+		if count != test.expCount {
+			t.Errorf("%d: expected %d commit callbacks; got %d", i, test.expCount, count)
+		}
+	}
+}
+
 // TestAbortTransactionOnCommitErrors verifies that non-exec transactions are
 // aborted on the correct errors.
 func TestAbortTransactionOnCommitErrors(t *testing.T) {
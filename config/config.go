@@ -91,13 +91,13 @@ func TestingTableSplitsDisabled() bool {
 // This should be used to validate user input when setting a new zone config.
 func (z ZoneConfig) Validate() error {
 	if len(z.ReplicaAttrs) == 0 {
-		return util.Errorf("attributes for at least one replica must be specified in zone config")
+		return util.Errorf("replicas: attributes for at least one replica must be specified in zone config")
 	}
 	if z.RangeMaxBytes < minRangeMaxBytes {
-		return util.Errorf("RangeMaxBytes %d less than minimum allowed %d", z.RangeMaxBytes, minRangeMaxBytes)
+		return util.Errorf("range_max_bytes: %d less than minimum allowed %d", z.RangeMaxBytes, minRangeMaxBytes)
 	}
 	if z.RangeMinBytes >= z.RangeMaxBytes {
-		return util.Errorf("RangeMinBytes %d is greater than or equal to RangeMaxBytes %d",
+		return util.Errorf("range_min_bytes: %d is greater than or equal to range_max_bytes %d",
 			z.RangeMinBytes, z.RangeMaxBytes)
 	}
 	return nil
@@ -269,7 +269,19 @@ func (s SystemConfig) GetZoneConfigForKey(key roachpb.RKey) (*ZoneConfig, error)
 		return s.getZoneConfigForID(objectID)
 	}
 	// Not in the structured data namespace.
-	return DefaultZoneConfig, nil
+	return s.getDefaultZoneConfig()
+}
+
+// getDefaultZoneConfig returns the zone config of the root namespace if one
+// has been set, and DefaultZoneConfig otherwise.
+func (s SystemConfig) getDefaultZoneConfig() (*ZoneConfig, error) {
+	testingLock.Lock()
+	hook := ZoneConfigHook
+	testingLock.Unlock()
+	if hook == nil {
+		return DefaultZoneConfig, nil
+	}
+	return hook(s, keys.RootNamespaceID)
 }
 
 // getZoneConfigForID looks up the zone config for the object (table or database)
@@ -283,7 +295,7 @@ func (s SystemConfig) getZoneConfigForID(id uint32) (*ZoneConfig, error) {
 	}
 	// For now, only user databases and tables get custom zone configs.
 	if id <= keys.MaxReservedDescID {
-		return DefaultZoneConfig, nil
+		return hook(s, keys.RootNamespaceID)
 	}
 	return hook(s, id)
 }
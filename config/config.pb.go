@@ -58,6 +58,10 @@ type ZoneConfig struct {
 	// If GC policy is not set, uses the next highest, non-null policy
 	// in the zone config hierarchy, up to the default policy if necessary.
 	GC *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
+	// MinFailureDomains is the minimum number of failure domains the replicas
+	// of each range in the zone should span. Nodes with the same attributes
+	// are considered to share a failure domain. Zero disables the requirement.
+	MinFailureDomains int32 `protobuf:"varint,5,opt,name=min_failure_domains" json:"min_failure_domains" yaml:"min_failure_domains,omitempty"`
 }
 
 func (m *ZoneConfig) Reset()         { *m = ZoneConfig{} }
@@ -141,6 +145,9 @@ func (m *ZoneConfig) MarshalTo(data []byte) (int, error) {
 		}
 		i += n1
 	}
+	data[i] = 0x28
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.MinFailureDomains))
 	return i, nil
 }
 
@@ -223,6 +230,7 @@ func (m *ZoneConfig) Size() (n int) {
 		l = m.GC.Size()
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	n += 1 + sovConfig(uint64(m.MinFailureDomains))
 	return n
 }
 
@@ -451,6 +459,25 @@ func (m *ZoneConfig) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFailureDomains", wireType)
+			}
+			m.MinFailureDomains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MinFailureDomains |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(data[iNdEx:])
@@ -0,0 +1,170 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// SettingType is the type of the value of a cluster setting, as stored in
+// the system.settings table.
+type SettingType string
+
+// Types of cluster setting values.
+const (
+	StringSetting   SettingType = "s"
+	IntSetting      SettingType = "i"
+	FloatSetting    SettingType = "f"
+	BoolSetting     SettingType = "b"
+	DurationSetting SettingType = "d"
+)
+
+// RawSetting is a cluster setting as stored in the system.settings table.
+type RawSetting struct {
+	Value string
+	Type  SettingType
+}
+
+// parse returns the typed value of the setting.
+func (r RawSetting) parse() (interface{}, error) {
+	switch r.Type {
+	case StringSetting:
+		return r.Value, nil
+	case IntSetting:
+		return strconv.ParseInt(r.Value, 10, 64)
+	case FloatSetting:
+		return strconv.ParseFloat(r.Value, 64)
+	case BoolSetting:
+		return strconv.ParseBool(r.Value)
+	case DurationSetting:
+		return time.ParseDuration(r.Value)
+	}
+	return nil, util.Errorf("unknown setting type %q", r.Type)
+}
+
+// Settings holds the values of the cluster settings as last read from the
+// system.settings table. The typed accessors return the supplied default for
+// settings which are not set, could not be parsed or are of another type, so
+// that a bad value in the table never prevents a node from working.
+type Settings struct {
+	mu     sync.RWMutex
+	values map[string]interface{}
+}
+
+// NewSettings returns a Settings with no values set.
+func NewSettings() *Settings {
+	return &Settings{values: map[string]interface{}{}}
+}
+
+// Update replaces the values of the settings with the supplied raw settings,
+// keyed by name. Settings which cannot be parsed are logged and skipped.
+func (s *Settings) Update(raw map[string]RawSetting) {
+	values := make(map[string]interface{}, len(raw))
+	for name, r := range raw {
+		v, err := r.parse()
+		if err != nil {
+			log.Warningf("ignoring cluster setting %s=%q: %s", name, r.Value, err)
+			continue
+		}
+		values[name] = v
+	}
+	s.mu.Lock()
+	s.values = values
+	s.mu.Unlock()
+}
+
+// get returns the value of the named setting, if it is set.
+func (s *Settings) get(name string) (interface{}, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.values[name]
+	return v, ok
+}
+
+// mismatch logs that the named setting is not of the expected type.
+func mismatch(name string, v interface{}, expected SettingType) {
+	log.Warningf("cluster setting %s has value %v of type %T, expected type %q; using default",
+		name, v, v, expected)
+}
+
+// String returns the value of the named string setting, or def.
+func (s *Settings) String(name string, def string) string {
+	v, ok := s.get(name)
+	if !ok {
+		return def
+	}
+	if str, ok := v.(string); ok {
+		return str
+	}
+	mismatch(name, v, StringSetting)
+	return def
+}
+
+// Int returns the value of the named integer setting, or def.
+func (s *Settings) Int(name string, def int64) int64 {
+	v, ok := s.get(name)
+	if !ok {
+		return def
+	}
+	if i, ok := v.(int64); ok {
+		return i
+	}
+	mismatch(name, v, IntSetting)
+	return def
+}
+
+// Float returns the value of the named float setting, or def.
+func (s *Settings) Float(name string, def float64) float64 {
+	v, ok := s.get(name)
+	if !ok {
+		return def
+	}
+	if f, ok := v.(float64); ok {
+		return f
+	}
+	mismatch(name, v, FloatSetting)
+	return def
+}
+
+// Bool returns the value of the named boolean setting, or def.
+func (s *Settings) Bool(name string, def bool) bool {
+	v, ok := s.get(name)
+	if !ok {
+		return def
+	}
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	mismatch(name, v, BoolSetting)
+	return def
+}
+
+// Duration returns the value of the named duration setting, or def.
+func (s *Settings) Duration(name string, def time.Duration) time.Duration {
+	v, ok := s.get(name)
+	if !ok {
+		return def
+	}
+	if d, ok := v.(time.Duration); ok {
+		return d
+	}
+	mismatch(name, v, DurationSetting)
+	return def
+}
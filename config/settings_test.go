@@ -0,0 +1,66 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestSettings(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := config.NewSettings()
+	s.Update(map[string]config.RawSetting{
+		"s":         {Value: "foo", Type: config.StringSetting},
+		"i":         {Value: "7", Type: config.IntSetting},
+		"f":         {Value: "1.5", Type: config.FloatSetting},
+		"b":         {Value: "true", Type: config.BoolSetting},
+		"d":         {Value: "90s", Type: config.DurationSetting},
+		"malformed": {Value: "seven", Type: config.IntSetting},
+		"untyped":   {Value: "7", Type: "x"},
+	})
+
+	if a, e := s.String("s", "def"), "foo"; a != e {
+		t.Errorf("expected %q, got %q", e, a)
+	}
+	if a, e := s.Int("i", 1), int64(7); a != e {
+		t.Errorf("expected %d, got %d", e, a)
+	}
+	if a, e := s.Float("f", 1), 1.5; a != e {
+		t.Errorf("expected %f, got %f", e, a)
+	}
+	if a, e := s.Bool("b", false), true; a != e {
+		t.Errorf("expected %t, got %t", e, a)
+	}
+	if a, e := s.Duration("d", time.Second), 90*time.Second; a != e {
+		t.Errorf("expected %s, got %s", e, a)
+	}
+
+	// Unknown, malformed, untyped and mistyped settings use the default.
+	for _, name := range []string{"unknown", "malformed", "untyped", "d"} {
+		if a, e := s.Int(name, 3), int64(3); a != e {
+			t.Errorf("%s: expected default %d, got %d", name, e, a)
+		}
+	}
+
+	// Settings which are no longer present revert to the default.
+	s.Update(nil)
+	if a, e := s.String("s", "def"), "def"; a != e {
+		t.Errorf("expected %q, got %q", e, a)
+	}
+}
@@ -155,6 +155,7 @@ func (c *client) handleGossip(g *Gossip, call *netrpc.Call) error {
 
 	// Combine remote node's infostore delta with ours.
 	if reply.Delta != nil {
+		g.pulled += len(reply.Delta)
 		freshCount, err := g.is.combine(reply.Delta, reply.NodeID)
 		if err != nil {
 			log.Warningf("node %d failed to fully combine delta from node %d: %s", g.is.NodeID, reply.NodeID, err)
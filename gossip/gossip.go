@@ -58,6 +58,8 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -96,6 +98,12 @@ const (
 	// sentinel gossip info.
 	defaultBootstrapInterval = 1 * time.Second
 
+	// maxBootstrapBackoff is the maximum backoff of a bootstrap address
+	// which failed to connect. The backoff starts at the bootstrap interval
+	// and doubles on every failure, so that a dead address doesn't take
+	// the turn of the other bootstrap addresses.
+	maxBootstrapBackoff = 10 * time.Second
+
 	// defaultCullInterval is the default interval for culling the least
 	// "useful" outgoing gossip connection to free up space for a more
 	// efficiently targeted connection to the most distant node.
@@ -132,10 +140,12 @@ type Gossip struct {
 	storage       Storage             // Persistent storage interface
 	bootstrapInfo BootstrapInfo       // BootstrapInfo proto for persistent storage
 	bootstrapping map[string]struct{} // Set of active bootstrap clients
+	backoffs      map[string]*backoff // Backoffs of failed bootstrap addresses
 	clientsMu     sync.Mutex          // Mutex protects the clients slice
 	clients       []*client           // Slice of clients
 	disconnected  chan *client        // Channel of disconnected clients
 	stalled       chan struct{}       // Channel to wakeup stalled bootstrap
+	pulled        int                 // Count of infos received by outgoing clients
 
 	stallInterval     time.Duration
 	bootstrapInterval time.Duration
@@ -165,6 +175,7 @@ func New(rpcContext *rpc.Context, resolvers []resolver.Resolver) *Gossip {
 		server:            newServer(),
 		outgoing:          makeNodeSet(minPeers),
 		bootstrapping:     map[string]struct{}{},
+		backoffs:          map[string]*backoff{},
 		clients:           []*client{},
 		disconnected:      make(chan *client, 10),
 		stalled:           make(chan struct{}, 1),
@@ -189,6 +200,8 @@ func New(rpcContext *rpc.Context, resolvers []resolver.Resolver) *Gossip {
 	g.is.registerCallback(KeySystemConfig, g.updateSystemConfig)
 	// Add ourselves as a node descriptor watcher.
 	g.is.registerCallback(MakePrefixPattern(KeyNodeIDPrefix), g.updateNodeAddress)
+	// Add ourselves as a decommission status watcher.
+	g.is.registerCallback(KeyDecommissionStatus, g.updateDecommissionStatus)
 
 	return g
 }
@@ -358,6 +371,57 @@ func (g *Gossip) GetNodeDescriptor(nodeID roachpb.NodeID) (*roachpb.NodeDescript
 	return nodeDescriptor, nil
 }
 
+// NodeIDs returns the IDs of the nodes whose descriptors have been received
+// through gossip, including this node, in ascending order. Node descriptors
+// don't expire, so the nodes aren't necessarily live.
+func (g *Gossip) NodeIDs() []roachpb.NodeID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	nodeIDs := make([]roachpb.NodeID, 0, len(g.nodesSeen))
+	for nodeID := range g.nodesSeen {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Sort(roachpb.NodeIDSlice(nodeIDs))
+	return nodeIDs
+}
+
+// GetStoreDescriptors returns the descriptors of the stores whose gossiped
+// infos haven't expired, in ascending order of store ID.
+func (g *Gossip) GetStoreDescriptors() ([]roachpb.StoreDescriptor, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	descsByID := map[roachpb.StoreID]roachpb.StoreDescriptor{}
+	var storeIDs []roachpb.StoreID
+	prefix := MakeKey(KeyStorePrefix, "")
+	if err := g.is.visitInfos(func(key string, i *Info) error {
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		if err := i.Value.Verify([]byte(key)); err != nil {
+			return err
+		}
+		bytes, err := i.Value.GetBytes()
+		if err != nil {
+			return err
+		}
+		var desc roachpb.StoreDescriptor
+		if err := proto.Unmarshal(bytes, &desc); err != nil {
+			return err
+		}
+		descsByID[desc.StoreID] = desc
+		storeIDs = append(storeIDs, desc.StoreID)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Sort(roachpb.StoreIDSlice(storeIDs))
+	descs := make([]roachpb.StoreDescriptor, 0, len(storeIDs))
+	for _, storeID := range storeIDs {
+		descs = append(descs, descsByID[storeID])
+	}
+	return descs, nil
+}
+
 // EnableSimulationCycler is for TESTING PURPOSES ONLY. It sets a
 // condition variable which is signaled at each cycle of the
 // simulation via SimulationCycle(). The gossip server makes each
@@ -626,6 +690,53 @@ func (g *Gossip) updateSystemConfig(key string, content roachpb.Value) {
 	}
 }
 
+// updateDecommissionStatus is a gossip callback which fires with each update
+// to the set of decommissioned nodes. Connections to decommissioned nodes are
+// closed, and they are no longer gossiped with.
+func (g *Gossip) updateDecommissionStatus(_ string, content roachpb.Value) {
+	var status roachpb.DecommissionStatus
+	if err := content.GetProto(&status); err != nil {
+		log.Error(err)
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.decommissioned = make(map[roachpb.NodeID]struct{}, len(status.Decommissioned))
+	for _, nodeID := range status.Decommissioned {
+		if nodeID == g.is.NodeID {
+			continue
+		}
+		g.decommissioned[nodeID] = struct{}{}
+		g.closeClient(nodeID)
+	}
+}
+
+// Stats describes a node's connections to the gossip network.
+type Stats struct {
+	// Connections is the number of incoming and outgoing connections.
+	Connections int
+	// InfosReceived is the total number of infos received from other
+	// nodes, whether pushed by incoming clients or pulled by outgoing ones.
+	InfosReceived int64
+	// Connected is true if the node has at least one connection and has
+	// received the sentinel info. A node which is not connected may be
+	// partitioned from the rest of the cluster.
+	Connected bool
+}
+
+// Stats returns a summary of the node's connections to the gossip network.
+func (g *Gossip) Stats() Stats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	connections := g.outgoing.len() + g.incoming.len()
+	return Stats{
+		Connections:   connections,
+		InfosReceived: int64(g.received + g.pulled),
+		Connected:     connections > 0 && g.is.getInfo(KeySentinel) != nil,
+	}
+}
+
 // Incoming returns a slice of incoming gossip client connection
 // node IDs.
 func (g *Gossip) Incoming() []roachpb.NodeID {
@@ -696,34 +807,79 @@ func (g *Gossip) filterExtant(nodes nodeSet) nodeSet {
 	})
 }
 
+// backoff is the backoff of a bootstrap address which failed to connect.
+type backoff struct {
+	wait  time.Duration // Backoff after the last failure
+	until time.Time     // The address is skipped until then
+}
+
 // getNextBootstrapAddress returns the next available bootstrap
 // address by consulting the first non-exhausted resolver from the
 // slice supplied to the constructor or set using setBootstrap().
-// The lock is assumed held.
+// Resolvers are asked for an address on every attempt, so that
+// changes of the addresses they resolve to are picked up, and may
+// expand to several addresses (e.g. the targets of SRV records),
+// which are tried in turn. Addresses backing off after failing to
+// connect are skipped. The lock is assumed held.
 func (g *Gossip) getNextBootstrapAddress() net.Addr {
+	now := time.Now()
 	// Run through resolvers round robin starting at last resolved index.
 	for i := 0; i < len(g.resolvers); i++ {
 		g.resolverIdx = (g.resolverIdx + 1) % len(g.resolvers)
 		g.resolversTried[g.resolverIdx] = struct{}{}
 		resolver := g.resolvers[g.resolverIdx]
-		addr, err := resolver.GetAddress()
-		if err != nil {
-			log.Errorf("invalid bootstrap address: %+v, %v", resolver, err)
-			continue
-		} else if addr.String() == g.is.NodeAddr.String() {
-			// Skip our own node address.
-			continue
-		}
-		_, addrActive := g.bootstrapping[addr.String()]
-		if !resolver.IsExhausted() || !addrActive {
-			g.bootstrapping[addr.String()] = struct{}{}
-			return addr
+		// Run through the addresses of the resolver until one of them
+		// is available or they repeat.
+		seen := map[string]struct{}{}
+		for {
+			addr, err := resolver.GetAddress()
+			if err != nil {
+				log.Errorf("invalid bootstrap address: %+v, %v", resolver, err)
+				break
+			}
+			if _, ok := seen[addr.String()]; ok {
+				break
+			}
+			seen[addr.String()] = struct{}{}
+			if addr.String() == g.is.NodeAddr.String() {
+				// Skip our own node address.
+				continue
+			}
+			if b, ok := g.backoffs[addr.String()]; ok && now.Before(b.until) {
+				continue
+			}
+			_, addrActive := g.bootstrapping[addr.String()]
+			if !resolver.IsExhausted() || !addrActive {
+				g.bootstrapping[addr.String()] = struct{}{}
+				return addr
+			}
 		}
 	}
 
 	return nil
 }
 
+// updateBackoff updates the backoff of a bootstrap address after a
+// client to it disconnected. The backoff is reset if the client
+// connected, and doubled otherwise. The lock is assumed held.
+func (g *Gossip) updateBackoff(addr net.Addr, connected bool) {
+	if connected {
+		delete(g.backoffs, addr.String())
+		return
+	}
+	b, ok := g.backoffs[addr.String()]
+	if !ok {
+		b = &backoff{wait: g.bootstrapInterval}
+		g.backoffs[addr.String()] = b
+	} else {
+		b.wait *= 2
+	}
+	if b.wait > maxBootstrapBackoff {
+		b.wait = maxBootstrapBackoff
+	}
+	b.until = time.Now().Add(b.wait)
+}
+
 // bootstrap connects the node to the gossip network. Bootstrapping
 // commences in the event there are no connected clients or the
 // sentinel gossip info is not available. After a successful bootstrap
@@ -813,6 +969,9 @@ func (g *Gossip) jitteredInterval(interval time.Duration) time.Duration {
 func (g *Gossip) tightenNetwork(stopper *stop.Stopper, distantNodeID roachpb.NodeID) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
+	if _, ok := g.decommissioned[distantNodeID]; ok {
+		return
+	}
 	if g.outgoing.hasSpace() {
 		if nodeAddr, err := g.getNodeIDAddressLocked(distantNodeID); err != nil {
 			log.Errorf("node %d: %s", distantNodeID, err)
@@ -849,6 +1008,10 @@ func (g *Gossip) cullNetwork() {
 func (g *Gossip) doDisconnected(stopper *stop.Stopper, c *client) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
+	if _, ok := g.bootstrapping[c.addr.String()]; ok {
+		// The client doesn't learn the ID of its peer unless it connects.
+		g.updateBackoff(c.addr, c.peerID != 0)
+	}
 	g.removeClient(c)
 
 	// If the client was disconnected with a forwarding address, connect now.
@@ -19,6 +19,8 @@ package gossip
 import (
 	"bytes"
 	"errors"
+	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -102,6 +104,118 @@ func TestGossipGetNextBootstrapAddress(t *testing.T) {
 	}
 }
 
+// TestGossipBootstrapBackoff verifies that bootstrap addresses which
+// failed to connect are skipped until their backoff expires, without
+// holding up the other addresses.
+func TestGossipBootstrapBackoff(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var resolvers []resolver.Resolver
+	for _, rs := range []string{"127.0.0.1:9000", "127.0.0.1:9001"} {
+		r, err := resolver.NewResolver(&base.Context{}, rs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resolvers = append(resolvers, r)
+	}
+	g := New(nil, resolvers)
+	g.SetBootstrapInterval(3 * time.Second)
+
+	addr := g.getNextBootstrapAddress()
+	if addr == nil || addr.String() != "127.0.0.1:9000" {
+		t.Fatalf("expected 127.0.0.1:9000, got %v", addr)
+	}
+	// The failed address backs off, while the other one is available.
+	g.updateBackoff(addr, false)
+	delete(g.bootstrapping, addr.String())
+	if addr := g.getNextBootstrapAddress(); addr == nil || addr.String() != "127.0.0.1:9001" {
+		t.Fatalf("expected 127.0.0.1:9001, got %v", addr)
+	}
+	if addr := g.getNextBootstrapAddress(); addr != nil {
+		t.Fatalf("expected no address, got %s", addr)
+	}
+	// The backoff doubles on every failure, up to maxBootstrapBackoff.
+	for _, expected := range []time.Duration{3 * time.Second, 6 * time.Second, maxBootstrapBackoff} {
+		if b := g.backoffs[addr.String()]; b.wait != expected {
+			t.Errorf("expected a backoff of %s, got %s", expected, b.wait)
+		}
+		g.updateBackoff(addr, false)
+	}
+
+	// A successful connection resets the backoff.
+	g.updateBackoff(addr, true)
+	if addr := g.getNextBootstrapAddress(); addr == nil || addr.String() != "127.0.0.1:9000" {
+		t.Fatalf("expected 127.0.0.1:9000, got %v", addr)
+	}
+}
+
+// changingResolver is a resolver whose address can be changed, like a
+// DNS name whose answers change.
+type changingResolver struct {
+	mu   sync.Mutex
+	addr net.Addr
+}
+
+func (r *changingResolver) Type() string { return "tcp" }
+func (r *changingResolver) Addr() string { return "changing" }
+func (r *changingResolver) GetAddress() (net.Addr, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.addr, nil
+}
+func (r *changingResolver) IsExhausted() bool { return true }
+
+func (r *changingResolver) setAddr(addr net.Addr) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.addr = addr
+}
+
+// TestGossipBootstrapChangingAddress verifies that a node bootstraps to
+// the new address of a resolver once the previous one fails to connect.
+func TestGossipBootstrapChangingAddress(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+
+	// The resolver first resolves to an address nobody listens on.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadAddr := util.MakeUnresolvedAddr("tcp", ln.Addr().String())
+	if err := ln.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r := &changingResolver{addr: deadAddr}
+
+	peer := startGossip(2, stopper, t)
+	local := startGossip(1, stopper, t)
+	local.SetResolvers([]resolver.Resolver{r})
+	local.SetBootstrapInterval(time.Millisecond)
+	local.SetStallInterval(time.Millisecond)
+	local.bootstrap(stopper)
+	local.manage(stopper)
+
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		local.mu.Lock()
+		defer local.mu.Unlock()
+		if _, ok := local.backoffs[deadAddr.String()]; !ok {
+			return util.Errorf("%s is not backing off", deadAddr)
+		}
+		return nil
+	})
+
+	r.setAddr(peer.is.NodeAddr)
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		local.mu.Lock()
+		defer local.mu.Unlock()
+		if !local.outgoing.hasNode(2) {
+			return util.Errorf("not connected to node 2")
+		}
+		return nil
+	})
+}
+
 // TestGossipCullNetwork verifies that a client will be culled from
 // the network periodically (at cullInterval duration intervals).
 func TestGossipCullNetwork(t *testing.T) {
@@ -18,9 +18,11 @@ package gossip
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
 )
 
 // separator is used to separate the non-prefix components of a
@@ -64,6 +66,16 @@ const (
 	// The value if a config.SystemConfig which holds all key/value
 	// pairs in the system DB span.
 	KeySystemConfig = "system-db"
+
+	// KeyDecommissionStatus is the gossip key for the nodes which are being,
+	// or have been, removed from the cluster. The value is a
+	// roachpb.DecommissionStatus.
+	KeyDecommissionStatus = "decommission-status"
+
+	// KeyMaintenancePrefix is the key prefix for gossiping whether nodes are
+	// in maintenance mode. The suffix is a node ID and the value is "true" or
+	// "false".
+	KeyMaintenancePrefix = "maintenance"
 )
 
 // MakeKey creates a canonical key under which to gossip a piece of
@@ -86,6 +98,26 @@ func MakeNodeIDKey(nodeID roachpb.NodeID) string {
 	return MakeKey(KeyNodeIDPrefix, nodeID.String())
 }
 
+// MakeNodeMaintenanceKey returns the gossip key for the maintenance mode of
+// the given node.
+func MakeNodeMaintenanceKey(nodeID roachpb.NodeID) string {
+	return MakeKey(KeyMaintenancePrefix, nodeID.String())
+}
+
+// NodeIDFromMaintenanceKey returns the node ID of a key created by
+// MakeNodeMaintenanceKey.
+func NodeIDFromMaintenanceKey(key string) (roachpb.NodeID, error) {
+	trimmed := strings.TrimPrefix(key, KeyMaintenancePrefix+separator)
+	if trimmed == key {
+		return 0, util.Errorf("%q is not a maintenance key", key)
+	}
+	nodeID, err := strconv.ParseInt(trimmed, 10, 32)
+	if err != nil {
+		return 0, util.Errorf("%q is not a maintenance key: %s", key, err)
+	}
+	return roachpb.NodeID(nodeID), nil
+}
+
 // MakeStoreKey returns the gossip key for the given store.
 func MakeStoreKey(storeID roachpb.StoreID) string {
 	return MakeKey(KeyStorePrefix, storeID.String())
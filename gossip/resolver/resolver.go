@@ -37,6 +37,7 @@ var validTypes = map[string]struct{}{
 	"tcp":     {},
 	"lb":      {},
 	"unix":    {},
+	"srv":     {},
 	"http-lb": {},
 }
 
@@ -46,6 +47,7 @@ var validTypes = map[string]struct{}{
 // - tcp: plain hostname of ip address
 // - lb: load balancer host name or ip: points to an unknown number of backends
 // - unix: unix sockets
+// - srv: DNS name whose SRV records list the hosts and ports of the nodes
 // - http-lb: http load balancer: queries http(s)://<lb>/_status/details/local
 //   for node addresses
 // If "network type" is not specified, "tcp" is assumed.
@@ -74,15 +76,19 @@ func NewResolver(context *base.Context, spec string) (Resolver, error) {
 			"valid types are %s", typ, spec, validTypes)
 	}
 
-	// For non-unix resolvers, make sure we fill in the host when not specified (eg: ":26257")
-	if typ != "unix" {
+	// For socket resolvers, make sure we fill in the host when not specified (eg: ":26257").
+	// The ports of the "srv" resolvers are those of the SRV records.
+	if typ != "unix" && typ != "srv" {
 		// Ensure addr has port and host set.
 		addr = util.EnsureHostPort(addr)
 	}
 
 	// Create the actual resolver.
-	if typ == "http-lb" {
+	switch typ {
+	case "http-lb":
 		return &nodeLookupResolver{context: context, typ: typ, addr: addr}, nil
+	case "srv":
+		return &srvResolver{typ: typ, addr: addr}, nil
 	}
 	return &socketResolver{typ: typ, addr: addr}, nil
 }
@@ -92,6 +98,9 @@ func NewResolverFromAddress(addr net.Addr) (Resolver, error) {
 	switch addr.Network() {
 	case "tcp", "unix":
 		return &socketResolver{typ: addr.Network(), addr: addr.String()}, nil
+	case "srv":
+		// The bootstrap info persists the DNS names of "srv" resolvers.
+		return &srvResolver{typ: addr.Network(), addr: addr.String()}, nil
 	default:
 		return nil, util.Errorf("unknown address network %q for %v", addr.Network(), addr)
 	}
@@ -17,6 +17,8 @@
 package resolver
 
 import (
+	"fmt"
+	"net"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/testutils"
@@ -45,6 +47,8 @@ func TestParseResolverSpec(t *testing.T) {
 		{"http-lb=newhost:1234", true, "http-lb", "newhost:1234"},
 		{"http-lb=:26257", true, "http-lb", def},
 		{"http-lb=:", true, "http-lb", def},
+		{"srv=cockroach.example.com", true, "srv", "cockroach.example.com"},
+		{"srv=", false, "", ""},
 		{"", false, "", ""},
 		{"foo=127.0.0.1", false, "", ""},
 		{"lb=", false, "", ""},
@@ -112,3 +116,62 @@ func TestGetAddress(t *testing.T) {
 		}
 	}
 }
+
+// TestSRVResolver verifies that a "srv" resolver returns the targets of
+// the SRV records in turn, and looks them up again once they have all been
+// returned, picking up changes of the DNS answers.
+func TestSRVResolver(t *testing.T) {
+	var records []*net.SRV
+	var lookups int
+	defer func(f func(string, string, string) (string, []*net.SRV, error)) { lookupSRV = f }(lookupSRV)
+	lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		if name != "cockroach.example.com" {
+			t.Errorf("unexpected lookup of %q", name)
+		}
+		lookups++
+		if len(records) == 0 {
+			return "", nil, fmt.Errorf("no such host")
+		}
+		return name, records, nil
+	}
+
+	r, err := NewResolver(nodeTestBaseContext, "srv=cockroach.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.GetAddress(); err == nil {
+		t.Error("expected an error without SRV records")
+	}
+
+	records = []*net.SRV{
+		{Target: "node1.example.com.", Port: 26257},
+		{Target: "node2.example.com.", Port: 26258},
+	}
+	expected := []string{"node1.example.com:26257", "node2.example.com:26258"}
+	checkAddresses := func() {
+		for i, e := range expected {
+			addr, err := r.GetAddress()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if addr.Network() != "tcp" || addr.String() != e {
+				t.Errorf("%d: expected address %s, got %s", i, e, addr)
+			}
+			if !r.IsExhausted() {
+				t.Errorf("%d: expected exhausted resolver", i)
+			}
+		}
+	}
+	checkAddresses()
+	if lookups != 2 {
+		t.Errorf("expected 2 lookups, got %d", lookups)
+	}
+
+	// The records are looked up again for the next pass.
+	records = []*net.SRV{{Target: "node3.example.com.", Port: 26257}}
+	expected = []string{"node3.example.com:26257"}
+	checkAddresses()
+	if lookups != 3 {
+		t.Errorf("expected 3 lookups, got %d", lookups)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resolver
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// lookupSRV looks up the SRV records of a DNS name. Tests replace it to
+// simulate changing DNS answers.
+var lookupSRV = net.LookupSRV
+
+// srvResolver expands a DNS name into the targets of its SRV records, in
+// the order of their priority and weight. The records are looked up again
+// once all the targets of the previous lookup have been returned, so that
+// changes of the DNS answers are picked up by the following connection
+// attempts.
+type srvResolver struct {
+	typ     string
+	addr    string
+	targets []net.Addr // Targets of the last lookup not yet returned
+}
+
+// Type returns the resolver type.
+func (sr *srvResolver) Type() string { return sr.typ }
+
+// Addr returns the resolver address.
+func (sr *srvResolver) Addr() string { return sr.addr }
+
+// GetAddress returns the next target of the SRV records, looking them up
+// if all the targets of the previous lookup have been returned.
+func (sr *srvResolver) GetAddress() (net.Addr, error) {
+	if len(sr.targets) == 0 {
+		_, srvs, err := lookupSRV("", "", sr.addr)
+		if err != nil {
+			return nil, err
+		}
+		if len(srvs) == 0 {
+			return nil, util.Errorf("no SRV records found for %q", sr.addr)
+		}
+		for _, srv := range srvs {
+			host := strings.TrimSuffix(srv.Target, ".")
+			sr.targets = append(sr.targets,
+				util.MakeUnresolvedAddr("tcp", net.JoinHostPort(host, strconv.Itoa(int(srv.Port)))))
+		}
+	}
+	addr := sr.targets[0]
+	sr.targets = sr.targets[1:]
+	return addr, nil
+}
+
+// IsExhausted returns whether the resolver can yield further
+// addresses. Each target is a single host, which, as for "tcp" resolvers,
+// is not worth returning again while a client to it is active; the
+// following targets are returned by the following calls to GetAddress.
+func (sr *srvResolver) IsExhausted() bool { return true }
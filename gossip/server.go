@@ -52,6 +52,10 @@ type server struct {
 	received int                       // Count of infos received from clients
 	ready    *sync.Cond                // Broadcasts wakeup to waiting gossip requests
 
+	// decommissioned is the set of nodes removed from the cluster, whose
+	// gossip is refused.
+	decommissioned map[roachpb.NodeID]struct{}
+
 	simulationCycler *sync.Cond // Used when simulating the network to signal next cycle
 }
 
@@ -63,6 +67,8 @@ func newServer() *server {
 		lAddrMap: map[string]clientInfo{},
 		nodeMap:  map[roachpb.NodeID]string{},
 		tighten:  make(chan roachpb.NodeID, 1),
+
+		decommissioned: map[roachpb.NodeID]struct{}{},
 	}
 	s.ready = sync.NewCond(&s.mu)
 	return s
@@ -92,6 +98,10 @@ func (s *server) Gossip(argsI proto.Message) (proto.Message, error) {
 		return nil, util.Errorf("node %d: connection already closed from node %d (%s); ignoring gossip", s.is.NodeID, args.NodeID, lAddr)
 	}
 
+	if _, ok := s.decommissioned[args.NodeID]; ok {
+		return nil, util.Errorf("node %d: refusing gossip from decommissioned node %d", s.is.NodeID, args.NodeID)
+	}
+
 	reply.NodeID = s.is.NodeID
 
 	// Decide whether or not we can accept the incoming connection
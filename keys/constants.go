@@ -146,6 +146,12 @@ var (
 	SystemPrefix = roachpb.Key("\x04")
 	SystemMax    = roachpb.Key("\x05")
 
+	// ClusterVersionKey stores the version of the cluster, recorded when the
+	// cluster is bootstrapped.
+	ClusterVersionKey = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("cluster-version")))
+	// DecommissionStatusKey stores the roachpb.DecommissionStatus recording the
+	// nodes which are being, or have been, removed from the cluster.
+	DecommissionStatusKey = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("decommission-status")))
 	// DescIDGenerator is the global descriptor ID generator sequence used for
 	// table and namespace IDs.
 	DescIDGenerator = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("desc-idgen")))
@@ -206,4 +212,5 @@ const (
 	DescriptorTableID = 3
 	UsersTableID      = 4
 	ZonesTableID      = 5
+	SettingsTableID   = 6
 )
@@ -82,6 +82,51 @@ func RaftLogKey(rangeID roachpb.RangeID, logIndex uint64) roachpb.Key {
 		encoding.EncodeUint64(nil, logIndex))
 }
 
+// DecodeRangeIDKey decodes the range-ID local key into the range ID,
+// metadata key suffix and optional detail (may be nil).
+func DecodeRangeIDKey(key roachpb.Key) (rangeID roachpb.RangeID, suffix, detail roachpb.Key, err error) {
+	if !bytes.HasPrefix(key, LocalRangeIDPrefix) {
+		return 0, nil, nil, util.Errorf("key %q does not have %q prefix",
+			key, LocalRangeIDPrefix)
+	}
+	// Cut the prefix and the Range ID.
+	b := key[len(LocalRangeIDPrefix):]
+	b, id, err := encoding.DecodeUvarint(b)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if len(b) < localSuffixLength {
+		return 0, nil, nil, util.Errorf("key %q does not have suffix of length %d",
+			key, localSuffixLength)
+	}
+	// Cut the suffix.
+	return roachpb.RangeID(id), b[:localSuffixLength], b[localSuffixLength:], nil
+}
+
+// DecodeRaftLogKey decodes a Raft log entry key into the range ID and the
+// log index.
+func DecodeRaftLogKey(key roachpb.Key) (roachpb.RangeID, uint64, error) {
+	rangeID, suffix, detail, err := DecodeRangeIDKey(key)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !bytes.Equal(suffix, localRaftLogSuffix) {
+		return 0, 0, util.Errorf("key %q is not a raft log key", key)
+	}
+	logIndex, err := decodeRaftLogIndex(detail)
+	if err != nil {
+		return 0, 0, err
+	}
+	return rangeID, logIndex, nil
+}
+
+// decodeRaftLogIndex decodes the log index from the detail of a Raft log
+// entry key.
+func decodeRaftLogIndex(detail roachpb.Key) (uint64, error) {
+	_, logIndex, err := encoding.DecodeUint64(detail)
+	return logIndex, err
+}
+
 // RaftLogPrefix returns the system-local prefix shared by all entries in a Raft log.
 func RaftLogPrefix(rangeID roachpb.RangeID) roachpb.Key {
 	return MakeRangeIDKey(rangeID, localRaftLogSuffix, roachpb.RKey{})
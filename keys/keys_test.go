@@ -353,6 +353,28 @@ func TestBatchRange(t *testing.T) {
 	}
 }
 
+func TestDecodeRaftLogKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	const rangeID, logIndex = roachpb.RangeID(1000), uint64(12345)
+	id, index, err := DecodeRaftLogKey(RaftLogKey(rangeID, logIndex))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != rangeID || index != logIndex {
+		t.Errorf("expected range %d, index %d; got range %d, index %d", rangeID, logIndex, id, index)
+	}
+
+	for _, key := range []roachpb.Key{
+		RaftHardStateKey(rangeID),
+		RangeDescriptorKey(roachpb.RKey("a")),
+		roachpb.Key("a"),
+	} {
+		if _, _, err := DecodeRaftLogKey(key); err == nil {
+			t.Errorf("expected error decoding %q", key)
+		}
+	}
+}
+
 func TestMakeColumnKey(t *testing.T) {
 	const maxColID = math.MaxUint32
 	key := MakeColumnKey(nil, maxColID)
@@ -19,9 +19,12 @@ package keys
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
 )
 
@@ -63,13 +66,16 @@ var (
 		name   string
 		suffix []byte
 		ppFunc func(key roachpb.Key) string
+		// parse the output of ppFunc back into the key, without the prefix
+		// and the suffix
+		parseFunc func(input string) (roachpb.Key, error)
 	}{
-		{name: "SequenceCache", suffix: LocalSequenceCacheSuffix, ppFunc: sequenceCacheKeyPrint},
+		{name: "SequenceCache", suffix: LocalSequenceCacheSuffix, ppFunc: sequenceCacheKeyPrint, parseFunc: sequenceCacheKeyParse},
 		{name: "RaftLeaderLease", suffix: localRaftLeaderLeaseSuffix},
 		{name: "RaftTombstone", suffix: localRaftTombstoneSuffix},
 		{name: "RaftHardState", suffix: localRaftHardStateSuffix},
 		{name: "RaftAppliedIndex", suffix: localRaftAppliedIndexSuffix},
-		{name: "RaftLog", suffix: localRaftLogSuffix, ppFunc: raftLogKeyPrint},
+		{name: "RaftLog", suffix: localRaftLogSuffix, ppFunc: raftLogKeyPrint, parseFunc: raftLogKeyParse},
 		{name: "RaftTruncatedState", suffix: localRaftTruncatedStateSuffix},
 		{name: "RaftLastIndex", suffix: localRaftLastIndexSuffix},
 		{name: "RangeLastVerificationTimestamp", suffix: localRangeLastVerificationTimestampSuffix},
@@ -98,9 +104,7 @@ func localStoreKeyPrint(key roachpb.Key) string {
 }
 
 func raftLogKeyPrint(key roachpb.Key) string {
-	var logIndex uint64
-	var err error
-	key, logIndex, err = encoding.DecodeUint64(key)
+	logIndex, err := decodeRaftLogIndex(key)
 	if err != nil {
 		return fmt.Sprintf("/err<%v:%q>", err, []byte(key))
 	}
@@ -323,3 +327,396 @@ func PrettyPrint(key roachpb.Key) string {
 func init() {
 	roachpb.PrettyPrintKey = PrettyPrint
 }
+
+// UglyPrint is the inverse of PrettyPrint: it parses a key in the human
+// readable format back into the key, so that tools can accept keys in that
+// format. The components of the keys in the SQL table key space, and of
+// the keys of the status of nodes and stores, are encoded according to
+// their syntax: integers, floats, quoted strings, NULL (/NULL), the
+// non-NULL marker (/#) and times in the format of time.UnixDate. Other
+// quoted components are taken as raw bytes. The key of a range addressing
+// record can also be given in the human readable format, e.g.
+// /Meta2/Table/60.
+//
+// The key of any output of PrettyPrint is returned, except for the errors
+// it reports and the store-local keys whose details it drops. As PrettyPrint
+// prints floats with six decimals and times to the second, the keys which
+// round-trip are those of the floats and times it prints exactly. Raw bytes
+// in the SQL table key space are printed like strings, and are parsed as
+// strings.
+func UglyPrint(input string) (roachpb.Key, error) {
+	key, err := uglyPrint(input)
+	if err != nil {
+		return nil, util.Errorf("cannot parse key %q: %s", input, err)
+	}
+	return key, nil
+}
+
+func uglyPrint(input string) (roachpb.Key, error) {
+	switch input {
+	case "/Min":
+		return MinKey, nil
+	case "/Max":
+		return MaxKey, nil
+	}
+	if strings.HasPrefix(input, `"`) {
+		return unquoteKey(input)
+	}
+	if rest, ok := trimName(input, "/Local"); ok {
+		return localKeyParse(rest)
+	}
+	if rest, ok := trimName(input, "/Meta1"); ok {
+		return metaKeyParse(Meta1Prefix, rest)
+	}
+	if rest, ok := trimName(input, "/Meta2"); ok {
+		return metaKeyParse(Meta2Prefix, rest)
+	}
+	if rest, ok := trimName(input, "/System"); ok {
+		return systemKeyParse(rest)
+	}
+	if rest, ok := trimName(input, "/Table"); ok {
+		return tableKeyParse(rest)
+	}
+	return nil, util.Errorf("unknown key space")
+}
+
+// trimName returns the input without the given name, and whether the input
+// starts with the name as a whole, i.e. followed by nothing, a slash or a
+// quote.
+func trimName(input, name string) (string, bool) {
+	if !strings.HasPrefix(input, name) {
+		return input, false
+	}
+	rest := input[len(name):]
+	if rest != "" && rest[0] != '/' && rest[0] != '"' {
+		return input, false
+	}
+	return rest, true
+}
+
+// nextComponent splits the input, which must start with a slash, into its
+// first component and the rest. A component ends at the next slash, unless
+// it is a quoted string, which may contain slashes.
+func nextComponent(input string) (string, string, error) {
+	if !strings.HasPrefix(input, "/") {
+		return "", "", util.Errorf("expected / at %q", input)
+	}
+	input = input[1:]
+	if strings.HasPrefix(input, `"`) {
+		n, err := quotedLen(input)
+		if err != nil {
+			return "", "", err
+		}
+		return input[:n], input[n:], nil
+	}
+	if i := strings.IndexByte(input, '/'); i >= 0 {
+		return input[:i], input[i:], nil
+	}
+	return input, "", nil
+}
+
+// quotedLen returns the length of the quoted string at the start of the
+// input.
+func quotedLen(input string) (int, error) {
+	for i := 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, util.Errorf("unterminated quoted string %s", input)
+}
+
+// unquoteKey returns the raw bytes of the input, which must be a single
+// quoted string.
+func unquoteKey(input string) (roachpb.Key, error) {
+	n, err := quotedLen(input)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(input) {
+		return nil, util.Errorf("unexpected %q after quoted string", input[n:])
+	}
+	s, err := strconv.Unquote(input)
+	if err != nil {
+		return nil, util.Errorf("invalid quoted string %s: %s", input, err)
+	}
+	return roachpb.Key(s), nil
+}
+
+// unquoteComponent returns the raw bytes of the input, which must be a
+// single quoted component.
+func unquoteComponent(input string) (roachpb.Key, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, util.Errorf("expected / at %q", input)
+	}
+	return unquoteKey(input[1:])
+}
+
+func localKeyParse(input string) (roachpb.Key, error) {
+	if input == "/Max" {
+		return LocalMax, nil
+	}
+	if rest, ok := trimName(input, "/Store"); ok {
+		return localStoreKeyParse(rest)
+	}
+	if rest, ok := trimName(input, "/RangeID"); ok {
+		return localRangeIDKeyParse(rest)
+	}
+	if rest, ok := trimName(input, "/Range"); ok {
+		return localRangeKeyParse(rest)
+	}
+	key, err := unquoteComponent(input)
+	if err != nil {
+		return nil, err
+	}
+	return MakeKey(localPrefix, key), nil
+}
+
+func localStoreKeyParse(input string) (roachpb.Key, error) {
+	switch input {
+	case "/storeIdent":
+		return StoreIdentKey(), nil
+	case "/gossipBootstrap":
+		return StoreGossipKey(), nil
+	}
+	key, err := unquoteKey(input)
+	if err != nil {
+		return nil, err
+	}
+	return MakeKey(localStorePrefix, key), nil
+}
+
+func localRangeIDKeyParse(input string) (roachpb.Key, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, util.Errorf("expected / at %q", input)
+	}
+	// The range ID may be directly followed by a quoted string.
+	i := 1
+	for i < len(input) && input[i] != '/' && input[i] != '"' {
+		i++
+	}
+	rangeID, err := strconv.ParseInt(input[1:i], 10, 64)
+	if err != nil {
+		return nil, util.Errorf("invalid range ID %q", input[1:i])
+	}
+	prefix := MakeRangeIDPrefix(roachpb.RangeID(rangeID))
+	rest := input[i:]
+	if rest == "" {
+		return prefix, nil
+	}
+	if strings.HasPrefix(rest, `"`) {
+		key, err := unquoteKey(rest)
+		if err != nil {
+			return nil, err
+		}
+		return MakeKey(prefix, key), nil
+	}
+
+	for _, s := range rangeIDSuffixDict {
+		rest, ok := trimName(rest, "/"+s.name)
+		if !ok {
+			continue
+		}
+		var detail roachpb.Key
+		if s.parseFunc != nil && rest != "" {
+			detail, err = s.parseFunc(rest)
+		} else {
+			detail, err = decodeKeyParse(rest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return MakeKey(prefix, s.suffix, detail), nil
+	}
+	return nil, util.Errorf("unknown range ID key suffix at %q", rest)
+}
+
+func localRangeKeyParse(input string) (roachpb.Key, error) {
+	for _, s := range rangeSuffixDict {
+		rest, ok := trimName(input, "/"+s.name)
+		if !ok {
+			continue
+		}
+		if s.atEnd {
+			key, err := decodeKeyParse(rest)
+			if err != nil {
+				return nil, err
+			}
+			return MakeKey(LocalRangePrefix, key, s.suffix), nil
+		}
+		if rest, ok = trimName(rest, "/addrKey:"); !ok {
+			return nil, util.Errorf("expected /addrKey: at %q", rest)
+		}
+		var key []byte
+		for {
+			if strings.HasPrefix(rest, "/id:") {
+				id, err := unquoteKey(rest[len("/id:"):])
+				if err != nil {
+					return nil, err
+				}
+				return MakeKey(LocalRangePrefix, key, s.suffix, id), nil
+			}
+			comp, r, err := nextComponent(rest)
+			if err != nil {
+				return nil, err
+			}
+			if key, err = encodeComponent(key, comp); err != nil {
+				return nil, err
+			}
+			rest = r
+		}
+	}
+	key, err := decodeKeyParse(input)
+	if err != nil {
+		return nil, err
+	}
+	return MakeKey(LocalRangePrefix, key), nil
+}
+
+func sequenceCacheKeyParse(input string) (roachpb.Key, error) {
+	comp, rest, err := nextComponent(input)
+	if err != nil {
+		return nil, err
+	}
+	id, err := unquoteKey(comp)
+	if err != nil {
+		return nil, err
+	}
+	key := encoding.EncodeBytes(nil, id)
+	if rest == "" {
+		return key, nil
+	}
+	for _, name := range []string{"epoch:", "seq:"} {
+		if comp, rest, err = nextComponent(rest); err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(comp, name) {
+			return nil, util.Errorf("expected %s at %q", name, comp)
+		}
+		v, err := strconv.ParseUint(comp[len(name):], 10, 32)
+		if err != nil {
+			return nil, util.Errorf("invalid %s %q", name, comp)
+		}
+		key = encoding.EncodeUint32Decreasing(key, uint32(v))
+	}
+	if rest != "" {
+		return nil, util.Errorf("unexpected %q after sequence number", rest)
+	}
+	return key, nil
+}
+
+func raftLogKeyParse(input string) (roachpb.Key, error) {
+	if !strings.HasPrefix(input, "/logIndex:") {
+		return nil, util.Errorf("expected /logIndex: at %q", input)
+	}
+	logIndex, err := strconv.ParseUint(input[len("/logIndex:"):], 10, 64)
+	if err != nil {
+		return nil, util.Errorf("invalid log index %q", input)
+	}
+	return encoding.EncodeUint64(nil, logIndex), nil
+}
+
+func metaKeyParse(prefix roachpb.Key, input string) (roachpb.Key, error) {
+	if input == "" {
+		return prefix, nil
+	}
+	var key roachpb.Key
+	var err error
+	if strings.HasPrefix(input, `/"`) {
+		key, err = unquoteComponent(input)
+	} else {
+		// The addressed key is in the human readable format.
+		key, err = uglyPrint(input)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return MakeKey(prefix, key), nil
+}
+
+func systemKeyParse(input string) (roachpb.Key, error) {
+	if input == "/Max" {
+		return SystemMax, nil
+	}
+	for _, e := range []struct {
+		name   string
+		prefix roachpb.Key
+	}{
+		{name: "/StatusStore", prefix: StatusStorePrefix},
+		{name: "/StatusNode", prefix: StatusNodePrefix},
+	} {
+		if rest, ok := trimName(input, e.name); ok {
+			key, err := decodeKeyParse(rest)
+			if err != nil {
+				return nil, err
+			}
+			return MakeKey(e.prefix, key), nil
+		}
+	}
+	key, err := unquoteComponent(input)
+	if err != nil {
+		return nil, err
+	}
+	return MakeKey(SystemPrefix, key), nil
+}
+
+func tableKeyParse(input string) (roachpb.Key, error) {
+	if input == "/Max" {
+		return TableDataMax, nil
+	}
+	key, err := decodeKeyParse(input)
+	if err != nil {
+		return nil, err
+	}
+	if encoding.PeekType(key) != encoding.Int {
+		return nil, util.Errorf("table keys must start with a table ID")
+	}
+	return key, nil
+}
+
+// decodeKeyParse is the inverse of decodeKeyPrint: it encodes every
+// component of the input.
+func decodeKeyParse(input string) (roachpb.Key, error) {
+	var key []byte
+	for input != "" {
+		comp, rest, err := nextComponent(input)
+		if err != nil {
+			return nil, err
+		}
+		if key, err = encodeComponent(key, comp); err != nil {
+			return nil, err
+		}
+		input = rest
+	}
+	return key, nil
+}
+
+// encodeComponent appends the encoding of the component printed by
+// decodeKeyPrint to b.
+func encodeComponent(b []byte, comp string) ([]byte, error) {
+	switch {
+	case comp == "NULL":
+		return encoding.EncodeNull(b), nil
+	case comp == "#":
+		return encoding.EncodeNotNull(b), nil
+	case strings.HasPrefix(comp, `"`):
+		s, err := strconv.Unquote(comp)
+		if err != nil {
+			return nil, util.Errorf("invalid quoted string %s: %s", comp, err)
+		}
+		return encoding.EncodeString(b, s), nil
+	}
+	if i, err := strconv.ParseInt(comp, 10, 64); err == nil {
+		return encoding.EncodeVarint(b, i), nil
+	}
+	if f, err := strconv.ParseFloat(comp, 64); err == nil {
+		return encoding.EncodeFloat(b, f), nil
+	}
+	if t, err := time.Parse(time.UnixDate, comp); err == nil {
+		return encoding.EncodeTime(b, t), nil
+	}
+	return nil, util.Errorf("unparsable component %q", comp)
+}
@@ -17,12 +17,14 @@
 package keys
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/randutil"
 )
 
 func TestPrettyPrint(t *testing.T) {
@@ -92,3 +94,181 @@ func TestPrettyPrint(t *testing.T) {
 		}
 	}
 }
+
+func TestUglyPrint(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	tm, _ := time.Parse(time.UnixDate, "Sat Mar  7 11:06:39 UTC 2015")
+
+	testCases := []struct {
+		input string
+		key   roachpb.Key
+	}{
+		{"/Min", MinKey},
+		{"/Max", MaxKey},
+
+		// local
+		{"/Local/Store/storeIdent", StoreIdentKey()},
+		{"/Local/Store/gossipBootstrap", StoreGossipKey()},
+		{`/Local/RangeID/1000001/SequenceCache/"test0"`, SequenceCacheKeyPrefix(roachpb.RangeID(1000001), []byte("test0"))},
+		{`/Local/RangeID/1000001/SequenceCache/"test0"/epoch:111/seq:222`, SequenceCacheKey(roachpb.RangeID(1000001), []byte("test0"), uint32(111), uint32(222))},
+		{"/Local/RangeID/1000001/RaftLog/logIndex:200001", RaftLogKey(roachpb.RangeID(1000001), uint64(200001))},
+		{"/Local/RangeID/1000001/RaftLog", RaftLogPrefix(roachpb.RangeID(1000001))},
+		{"/Local/RangeID/1000001/RangeStats", RangeStatsKey(roachpb.RangeID(1000001))},
+		{`/Local/RangeID/1000001"zzzz"`, MakeKey(MakeRangeIDPrefix(roachpb.RangeID(1000001)), []byte("zzzz"))},
+		{`/Local/Range/"ok"`, MakeRangeKeyPrefix(roachpb.RKey("ok"))},
+		{`/Local/Range/RangeDescriptor/"111"`, RangeDescriptorKey(roachpb.RKey("111"))},
+		{`/Local/Range/RangeTreeNode/"111"`, RangeTreeNodeKey(roachpb.RKey("111"))},
+		{`/Local/Range/Transaction/addrKey:/"111"/id:"22/222"`, TransactionKey(roachpb.Key("111"), []byte("22/222"))},
+		{"/Local/Max", LocalMax},
+
+		// system
+		{`/Meta1/"foo"`, MakeKey(Meta1Prefix, []byte("foo"))},
+		{`/Meta2/"\xc4"`, MakeKey(Meta2Prefix, MakeTablePrefix(60))},
+		{"/Meta2/Table/60", MakeKey(Meta2Prefix, MakeTablePrefix(60))},
+		{"/Meta1/Max", Meta1KeyMax},
+		{"/Meta2/Max", Meta2KeyMax},
+		{"/System/StatusStore/2222", StoreStatusKey(2222)},
+		{"/System/StatusNode/1111", NodeStatusKey(1111)},
+		{`/System/"desc-idgen"`, DescIDGenerator},
+		{"/System/Max", SystemMax},
+
+		// table
+		{"/Table/51/1/42", MakeKey(MakeTablePrefix(51), encoding.EncodeVarint(nil, 1), encoding.EncodeVarint(nil, 42))},
+		{"/Table/42/-7/233.221112", MakeKey(MakeTablePrefix(42), encoding.EncodeVarint(nil, -7), encoding.EncodeFloat(nil, 233.221112))},
+		{`/Table/42/"a/b"/NULL/#`, MakeKey(MakeTablePrefix(42), encoding.EncodeString(nil, "a/b"), encoding.EncodeNull(nil), encoding.EncodeNotNull(nil))},
+		{`/Table/42/"\x01\x02\b\xff"`, MakeKey(MakeTablePrefix(42), encoding.EncodeBytes(nil, []byte{1, 2, 8, 255}))},
+		{"/Table/42/Sat Mar  7 11:06:39 UTC 2015", MakeKey(MakeTablePrefix(42), encoding.EncodeTime(nil, tm))},
+		{"/Table/Max", TableDataMax},
+
+		// others
+		{`"\x00foo"`, roachpb.Key("\x00foo")},
+	}
+	for i, test := range testCases {
+		key, err := UglyPrint(test.input)
+		if err != nil {
+			t.Errorf("%d: %s", i, err)
+			continue
+		}
+		if !bytes.Equal(key, test.key) {
+			t.Errorf("%d: expected %s to parse as %q, got %q", i, test.input, []byte(test.key), []byte(key))
+		}
+	}
+
+	for _, input := range []string{
+		"",
+		"Table/42",
+		"/Unknown/1",
+		"/TableX/1",
+		"/Table",
+		"/Table/foo",
+		"/Table/42/",
+		"/Table/42//1",
+		`/Table/42/"unterminated`,
+		`/Table/"string"`,
+		"/Table/42/<unknown escape>",
+		"/Local/RangeID/x/RangeStats",
+		"/Local/RangeID/1/Unknown",
+		"/Local/RangeID/1/RaftLog/index:3",
+		`/Local/RangeID/1/SequenceCache/"id"/seq:1`,
+		`/Local/Range/Transaction/addrKey:/"111"`,
+		`/Meta2/"foo"bar`,
+		`"foo`,
+	} {
+		if key, err := UglyPrint(input); err == nil {
+			t.Errorf("expected error parsing %q, got %q", input, []byte(key))
+		}
+	}
+}
+
+// TestPrettyPrintRoundTrip verifies that UglyPrint parses the output of
+// PrettyPrint back into the key, for random keys of all the key spaces.
+func TestPrettyPrintRoundTrip(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rng, _ := randutil.NewPseudoRand()
+
+	randBytes := func() []byte {
+		return randutil.RandBytes(rng, rng.Intn(8))
+	}
+	// randComponents returns the encoding of random components of the kinds
+	// which PrettyPrint prints exactly: floats with six decimals and times
+	// to the second.
+	randComponents := func() []byte {
+		var b []byte
+		for n := rng.Intn(5); n > 0; n-- {
+			switch rng.Intn(6) {
+			case 0:
+				b = encoding.EncodeNull(b)
+			case 1:
+				b = encoding.EncodeNotNull(b)
+			case 2:
+				b = encoding.EncodeVarint(b, rng.Int63()-rng.Int63())
+			case 3:
+				b = encoding.EncodeFloat(b, float64(rng.Int63n(2e15)-1e15)/1e6)
+			case 4:
+				b = encoding.EncodeString(b, string(randBytes()))
+			case 5:
+				b = encoding.EncodeTime(b, time.Unix(rng.Int63n(1<<33), 0))
+			}
+		}
+		return b
+	}
+	randRangeID := func() roachpb.RangeID {
+		return roachpb.RangeID(rng.Int63n(1<<40) + 1)
+	}
+
+	generators := []func() roachpb.Key{
+		func() roachpb.Key { return MakeKey(MakeTablePrefix(uint32(rng.Intn(1000))), randComponents()) },
+		func() roachpb.Key { return StoreStatusKey(rng.Int31()) },
+		func() roachpb.Key { return NodeStatusKey(rng.Int31()) },
+		func() roachpb.Key { return MakeKey(Meta1Prefix, randBytes()) },
+		func() roachpb.Key { return MakeKey(Meta2Prefix, randBytes()) },
+		func() roachpb.Key { return MakeKey(SystemPrefix, []byte("z"), randBytes()) },
+		func() roachpb.Key {
+			rangeID := randRangeID()
+			switch s := rangeIDSuffixDict[rng.Intn(len(rangeIDSuffixDict))]; s.name {
+			case "SequenceCache":
+				if rng.Intn(2) == 0 {
+					return SequenceCacheKeyPrefix(rangeID, randBytes())
+				}
+				return SequenceCacheKey(rangeID, randBytes(), rng.Uint32(), rng.Uint32())
+			case "RaftLog":
+				return RaftLogKey(rangeID, uint64(rng.Int63()))
+			default:
+				return MakeRangeIDKey(rangeID, s.suffix, nil)
+			}
+		},
+		func() roachpb.Key { return MakeKey(MakeRangeIDPrefix(randRangeID()), []byte("zzzz"), randBytes()) },
+		func() roachpb.Key {
+			addr := roachpb.RKey(randBytes())
+			switch rng.Intn(4) {
+			case 0:
+				return MakeRangeKeyPrefix(addr)
+			case 1:
+				return RangeDescriptorKey(addr)
+			case 2:
+				return RangeTreeNodeKey(addr)
+			default:
+				return MakeRangeKey(addr, localTransactionSuffix, roachpb.RKey(randBytes()))
+			}
+		},
+		func() roachpb.Key { return MakeKey([]byte{0}, randBytes()) },
+		func() roachpb.Key {
+			keys := []roachpb.Key{MinKey, MaxKey, StoreIdentKey(), StoreGossipKey(), LocalMax,
+				Meta1KeyMax, Meta2KeyMax, SystemMax, TableDataMax}
+			return keys[rng.Intn(len(keys))]
+		},
+	}
+
+	for i := 0; i < 10000; i++ {
+		key := generators[rng.Intn(len(generators))]()
+		pretty := PrettyPrint(key)
+		actual, err := UglyPrint(pretty)
+		if err != nil {
+			t.Fatalf("%q printed as %s: %s", []byte(key), pretty, err)
+		}
+		if !bytes.Equal(actual, key) {
+			t.Fatalf("%q printed as %s parsed as %q", []byte(key), pretty, []byte(actual))
+		}
+	}
+}
@@ -41,6 +41,9 @@ var allExternalMethods = [...]roachpb.Request{
 	roachpb.EndTransaction:   &roachpb.EndTransactionRequest{},
 	roachpb.AdminSplit:       &roachpb.AdminSplitRequest{},
 	roachpb.AdminMerge:       &roachpb.AdminMergeRequest{},
+	roachpb.RaftStatus:       &roachpb.RaftStatusRequest{},
+	roachpb.RangeStats:       &roachpb.RangeStatsRequest{},
+	roachpb.AdminScatter:     &roachpb.AdminScatterRequest{},
 }
 
 // A DBServer provides an HTTP server endpoint serving the key-value API.
@@ -51,8 +51,8 @@ const (
 	defaultRangeLookupMaxRanges = 8
 	// The default size of the leader cache.
 	defaultLeaderCacheSize = 1 << 16
-	// The default size of the range descriptor cache.
-	defaultRangeDescriptorCacheSize = 1 << 20
+	// The default byte budget of the range descriptor cache.
+	defaultRangeDescriptorCacheBytes = 64 << 20
 )
 
 var defaultRPCRetryOptions = retry.Options{
@@ -134,8 +134,10 @@ type rpcSendFn func(rpc.Options, string, []net.Addr,
 // DistSenderContext holds auxiliary objects that can be passed to
 // NewDistSender.
 type DistSenderContext struct {
-	Clock                    *hlc.Clock
-	RangeDescriptorCacheSize int32
+	Clock *hlc.Clock
+	// RangeDescriptorCacheBytes is the budget for the total size of the
+	// range descriptors held by the range descriptor cache.
+	RangeDescriptorCacheBytes int64
 	// RangeLookupMaxRanges sets how many ranges will be prefetched into the
 	// range descriptor cache when dispatching a range lookup request.
 	RangeLookupMaxRanges int32
@@ -171,15 +173,15 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 	if ctx.nodeDescriptor != nil {
 		atomic.StorePointer(&ds.nodeDescriptor, unsafe.Pointer(ctx.nodeDescriptor))
 	}
-	rcSize := ctx.RangeDescriptorCacheSize
-	if rcSize <= 0 {
-		rcSize = defaultRangeDescriptorCacheSize
+	rcBytes := ctx.RangeDescriptorCacheBytes
+	if rcBytes <= 0 {
+		rcBytes = defaultRangeDescriptorCacheBytes
 	}
 	rdb := ctx.RangeDescriptorDB
 	if rdb == nil {
 		rdb = ds
 	}
-	ds.rangeCache = newRangeDescriptorCache(rdb, int(rcSize))
+	ds.rangeCache = newRangeDescriptorCache(rdb, rcBytes)
 	lcSize := ctx.LeaderCacheSize
 	if lcSize <= 0 {
 		lcSize = defaultLeaderCacheSize
@@ -330,6 +332,9 @@ func (ds *DistSender) sendRPC(trace *tracer.Trace, rangeID roachpb.RangeID, repl
 	// still route the request appropriately by key, but won't receive
 	// RangeNotFoundErrors.
 	ba.RangeID = rangeID
+	// Let the node executing the request attach its trace to ours if it's
+	// the local node.
+	ba.TraceContext = trace.Context()
 
 	// Set RPC opts with stipulation that one of N RPCs must succeed.
 	rpcOpts := rpc.Options{
@@ -373,6 +378,7 @@ func (ds *DistSender) sendRPC(trace *tracer.Trace, rangeID roachpb.RangeID, repl
 	if err != nil {
 		return nil, roachpb.NewError(err)
 	}
+	trace.Event("received reply")
 	return replies[0].(*roachpb.BatchResponse), nil
 }
 
@@ -554,7 +560,7 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 		var desc *roachpb.RangeDescriptor
 		var needAnother bool
 		var pErr *roachpb.Error
-		for r := retry.Start(ds.rpcRetryOptions); r.Next(); {
+		for r := retry.StartWithCtx(ctx, ds.rpcRetryOptions); r.Next(); {
 			// Get range descriptor (or, when spanning range, descriptors). Our
 			// error handling below may clear them on certain errors, so we
 			// refresh (likely from the cache) on every retry.
@@ -709,6 +715,12 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 		if pErr != nil {
 			return nil, pErr, false
 		}
+		// The retry loop also ends once the context is done, possibly
+		// without a reply or an error.
+		if err := ctx.Err(); err != nil && curReply == nil {
+			return nil, roachpb.NewError(err), false
+		}
+		recordRange(ctx, desc.RangeID)
 
 		ba.Txn.Update(curReply.Txn)
 
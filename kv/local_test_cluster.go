@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/gogo/protobuf/proto"
 )
@@ -97,16 +98,17 @@ func (ltc *LocalTestCluster) Start(t util.Tester) {
 	}
 	ltc.distSender = NewDistSender(&DistSenderContext{
 		Clock: ltc.Clock,
-		RangeDescriptorCacheSize: defaultRangeDescriptorCacheSize,
-		RangeLookupMaxRanges:     defaultRangeLookupMaxRanges,
-		LeaderCacheSize:          defaultLeaderCacheSize,
-		RPCRetryOptions:          &defaultRPCRetryOptions,
-		nodeDescriptor:           nodeDesc,
-		RPCSend:                  rpcSend,    // defined above
-		RangeDescriptorDB:        ltc.stores, // for descriptor lookup
+		RangeDescriptorCacheBytes: defaultRangeDescriptorCacheBytes,
+		RangeLookupMaxRanges:      defaultRangeLookupMaxRanges,
+		LeaderCacheSize:           defaultLeaderCacheSize,
+		RPCRetryOptions:           &defaultRPCRetryOptions,
+		nodeDescriptor:            nodeDesc,
+		RPCSend:                   rpcSend,    // defined above
+		RangeDescriptorDB:         ltc.stores, // for descriptor lookup
 	}, ltc.Gossip)
 
-	ltc.Sender = NewTxnCoordSender(ltc.distSender, ltc.Clock, false /* !linearizable */, nil /* tracer */, ltc.Stopper)
+	ltc.Sender = NewTxnCoordSender(ltc.distSender, ltc.Clock, false /* !linearizable */, nil /* tracer */, ltc.Stopper,
+		NewTxnMetrics(metric.NewRegistry()))
 	ltc.DB = client.NewDB(ltc.Sender)
 
 	transport := storage.NewLocalRPCTransport(ltc.Stopper)
@@ -76,14 +76,17 @@ type rangeDescriptorCache struct {
 
 // newRangeDescriptorCache returns a new RangeDescriptorCache which
 // uses the given RangeDescriptorDB as the underlying source of range
-// descriptors.
-func newRangeDescriptorCache(db RangeDescriptorDB, size int) *rangeDescriptorCache {
+// descriptors. The least recently used descriptors are evicted to keep
+// the total size of the cached descriptors and their keys within
+// maxBytes.
+func newRangeDescriptorCache(db RangeDescriptorDB, maxBytes int64) *rangeDescriptorCache {
 	return &rangeDescriptorCache{
 		db: db,
 		rangeCache: cache.NewOrderedCache(cache.Config{
-			Policy: cache.CacheLRU,
-			ShouldEvict: func(n int, k, v interface{}) bool {
-				return n > size
+			Policy:   cache.CacheLRU,
+			MaxBytes: maxBytes,
+			SizeOf: func(k, v interface{}) int64 {
+				return int64(len(k.(rangeCacheKey)) + v.(*roachpb.RangeDescriptor).Size())
 			},
 		}),
 	}
@@ -159,7 +159,7 @@ func TestRangeCache(t *testing.T) {
 		}
 	}
 
-	db.cache = newRangeDescriptorCache(db, 2<<10)
+	db.cache = newRangeDescriptorCache(db, 2<<20)
 
 	doLookup(t, db.cache, "aa")
 	db.assertLookupCount(t, 2, "aa")
@@ -227,7 +227,7 @@ func TestRangeCacheClearOverlapping(t *testing.T) {
 		EndKey:   roachpb.RKeyMax,
 	}
 
-	cache := newRangeDescriptorCache(nil, 2<<10)
+	cache := newRangeDescriptorCache(nil, 2<<20)
 	cache.rangeCache.Add(rangeCacheKey(keys.RangeMetaKey(roachpb.RKeyMax)), defDesc)
 
 	// Now, add a new, overlapping set of descriptors.
@@ -302,7 +302,7 @@ func TestRangeCacheClearOverlappingMeta(t *testing.T) {
 		EndKey:   roachpb.RKeyMax,
 	}
 
-	cache := newRangeDescriptorCache(nil, 2<<10)
+	cache := newRangeDescriptorCache(nil, 2<<20)
 	cache.rangeCache.Add(rangeCacheKey(keys.RangeMetaKey(firstDesc.EndKey)),
 		firstDesc)
 	cache.rangeCache.Add(rangeCacheKey(keys.RangeMetaKey(restDesc.EndKey)),
@@ -334,7 +334,7 @@ func TestGetCachedRangeDescriptorInclusive(t *testing.T) {
 		{StartKey: roachpb.RKey("g"), EndKey: roachpb.RKey("z")},
 	}
 
-	cache := newRangeDescriptorCache(nil, 2<<10)
+	cache := newRangeDescriptorCache(nil, 2<<20)
 	for _, rd := range testData {
 		cache.rangeCache.Add(rangeCacheKey(keys.RangeMetaKey(rd.EndKey)), rd)
 	}
@@ -387,3 +387,61 @@ func TestGetCachedRangeDescriptorInclusive(t *testing.T) {
 	}
 
 }
+
+// TestRangeCacheByteBudget verifies that the oldest descriptors are evicted
+// to keep the total size of descriptors of mixed sizes within the cache's
+// byte budget.
+func TestRangeCacheByteBudget(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	makeDesc := func(start, end string, replicas int) *roachpb.RangeDescriptor {
+		desc := &roachpb.RangeDescriptor{
+			StartKey: roachpb.RKey(start),
+			EndKey:   roachpb.RKey(end),
+		}
+		for i := 1; i <= replicas; i++ {
+			desc.Replicas = append(desc.Replicas, roachpb.ReplicaDescriptor{
+				NodeID:    roachpb.NodeID(i),
+				StoreID:   roachpb.StoreID(i),
+				ReplicaID: roachpb.ReplicaID(i),
+			})
+		}
+		return desc
+	}
+	sizeOf := func(desc *roachpb.RangeDescriptor) int64 {
+		return int64(len(meta(desc.EndKey)) + desc.Size())
+	}
+	descs := []*roachpb.RangeDescriptor{
+		makeDesc("a", "b", 0),
+		makeDesc("b", "c", 3),
+		makeDesc("c", "d", 1),
+		makeDesc("d", "e", 4),
+	}
+	// The budget fits the first three descriptors, but not the fourth one,
+	// which is larger than the first one.
+	budget := sizeOf(descs[0]) + sizeOf(descs[1]) + sizeOf(descs[2])
+	if sizeOf(descs[3]) <= sizeOf(descs[0]) || sizeOf(descs[3]) > sizeOf(descs[0])+sizeOf(descs[1]) {
+		t.Fatalf("unexpected descriptor sizes %d, %d and %d",
+			sizeOf(descs[0]), sizeOf(descs[1]), sizeOf(descs[3]))
+	}
+	cache := newRangeDescriptorCache(nil, budget)
+	for _, desc := range descs[:3] {
+		cache.rangeCache.Add(rangeCacheKey(meta(desc.EndKey)), desc)
+	}
+	if s := cache.rangeCache.Stats(); s.Bytes != budget || s.Entries != 3 || s.Evictions != 0 {
+		t.Fatalf("expected %d bytes in 3 entries, got %+v", budget, s)
+	}
+
+	// Adding the fourth descriptor evicts the two oldest ones.
+	cache.rangeCache.Add(rangeCacheKey(meta(descs[3].EndKey)), descs[3])
+	for i, desc := range descs {
+		_, cached := cache.getCachedRangeDescriptor(desc.StartKey, false)
+		if evicted := i < 2; evicted != (cached == nil) {
+			t.Errorf("%d: expected eviction of %s to be %t, got cached descriptor %s", i, desc, evicted, cached)
+		}
+	}
+	expBytes := sizeOf(descs[2]) + sizeOf(descs[3])
+	if s := cache.rangeCache.Stats(); s.Bytes != expBytes || s.Entries != 2 || s.Evictions != 2 {
+		t.Errorf("expected %d bytes in 2 entries after 2 evictions, got %+v", expBytes, s)
+	}
+}
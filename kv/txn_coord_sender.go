@@ -26,6 +26,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/montanaflynn/stats"
 	"golang.org/x/net/context"
+	ntrace "golang.org/x/net/trace"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/roachpb"
@@ -34,6 +35,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/cache"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/cockroachdb/cockroach/util/tracer"
 )
@@ -85,6 +87,10 @@ type txnMetadata struct {
 	// txnEnd is closed when the transaction is aborted or committed,
 	// terminating the associated heartbeat instance.
 	txnEnd chan struct{}
+
+	// ranges holds the IDs of the ranges addressed by the transaction's
+	// batches through this coordinator, starting with its first write.
+	ranges map[roachpb.RangeID]struct{}
 }
 
 // addKeyRange adds the specified key range to the interval cache,
@@ -150,6 +156,86 @@ func (tm *txnMetadata) intentSpans() []roachpb.Span {
 	return intents
 }
 
+// rangeSetKey is the context key under which the rangeSet of a transactional
+// batch is stored.
+type rangeSetKey struct{}
+
+// A rangeSet collects the IDs of the ranges a batch was sent to. The
+// TxnCoordSender stores one in the context of each transactional batch, and
+// the DistSender adds to it as it sends the batch to individual ranges.
+type rangeSet map[roachpb.RangeID]struct{}
+
+// withRangeSet returns a context carrying the given rangeSet.
+func withRangeSet(ctx context.Context, rs rangeSet) context.Context {
+	return context.WithValue(ctx, rangeSetKey{}, rs)
+}
+
+// recordRange adds the given range to the rangeSet stored in the context,
+// if any.
+func recordRange(ctx context.Context, rangeID roachpb.RangeID) {
+	if rs, ok := ctx.Value(rangeSetKey{}).(rangeSet); ok {
+		rs[rangeID] = struct{}{}
+	}
+}
+
+// TxnMetrics holds the metrics tracked about the transactions coordinated by
+// a TxnCoordSender.
+type TxnMetrics struct {
+	// Ranges records the number of distinct ranges touched by each finished
+	// transaction.
+	Ranges *metric.Histogram
+	// PushAborts counts the transactions aborted by the push of a conflicting
+	// transaction. This is how a deadlock between transactions is broken:
+	// each pushes the other and the one with the lower priority is aborted.
+	PushAborts *metric.Counter
+}
+
+// NewTxnMetrics returns a new TxnMetrics whose metrics are added to the
+// given registry.
+func NewTxnMetrics(registry *metric.Registry) *TxnMetrics {
+	return &TxnMetrics{
+		Ranges:     registry.Histogram("sql.txn.ranges", time.Minute, 1000, 1),
+		PushAborts: registry.Counter("sql.txn.aborts.pushed"),
+	}
+}
+
+// recordRanges records the number of ranges touched by a finished
+// transaction. It is a no-op on a nil TxnMetrics.
+func (m *TxnMetrics) recordRanges(numRanges int) {
+	if m == nil {
+		return
+	}
+	m.Ranges.RecordValue(int64(numRanges))
+}
+
+// recordPushAborts counts the transactions aborted by the successful
+// PUSH_ABORT pushes of the given batch which were sent on behalf of a
+// transaction. Pushes of transactions whose heartbeat has expired are not
+// counted: those transactions were abandoned, not in conflict. It is a no-op
+// on a nil TxnMetrics.
+func (m *TxnMetrics) recordPushAborts(ba roachpb.BatchRequest, br *roachpb.BatchResponse) {
+	if m == nil {
+		return
+	}
+	for i, union := range ba.Requests {
+		args, ok := union.GetInner().(*roachpb.PushTxnRequest)
+		if !ok || args.PushType != roachpb.PUSH_ABORT || len(args.PusherTxn.ID) == 0 {
+			continue
+		}
+		pushee := br.Responses[i].GetInner().(*roachpb.PushTxnResponse).PusheeTxn
+		if pushee.Status != roachpb.ABORTED {
+			continue
+		}
+		// Mirror the expiration check of Replica.PushTxn.
+		expiry := args.Now
+		expiry.WallTime -= 2 * storage.DefaultHeartbeatInterval.Nanoseconds()
+		if pushee.LastHeartbeat != nil && pushee.LastHeartbeat.Less(expiry) {
+			continue
+		}
+		m.PushAborts.Inc(1)
+	}
+}
+
 // txnCoordStats tallies up statistics about the transactions which have
 // completed on this sender.
 type txnCoordStats struct {
@@ -180,13 +266,15 @@ type TxnCoordSender struct {
 	linearizable      bool                    // enables linearizable behaviour
 	tracer            *tracer.Tracer
 	stopper           *stop.Stopper
+	metrics           *TxnMetrics
 }
 
 var _ client.Sender = &TxnCoordSender{}
 
 // NewTxnCoordSender creates a new TxnCoordSender for use from a KV
-// distributed DB instance.
-func NewTxnCoordSender(wrapped client.Sender, clock *hlc.Clock, linearizable bool, tracer *tracer.Tracer, stopper *stop.Stopper) *TxnCoordSender {
+// distributed DB instance. The supplied metrics may be nil, in which case no
+// metrics are recorded.
+func NewTxnCoordSender(wrapped client.Sender, clock *hlc.Clock, linearizable bool, tracer *tracer.Tracer, stopper *stop.Stopper, metrics *TxnMetrics) *TxnCoordSender {
 	tc := &TxnCoordSender{
 		wrapped:           wrapped,
 		clock:             clock,
@@ -196,6 +284,7 @@ func NewTxnCoordSender(wrapped client.Sender, clock *hlc.Clock, linearizable boo
 		linearizable:      linearizable,
 		tracer:            tracer,
 		stopper:           stopper,
+		metrics:           metrics,
 	}
 
 	tc.stopper.RunWorker(tc.startStats)
@@ -312,6 +401,8 @@ func (tc *TxnCoordSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*r
 	if ba.Txn != nil {
 		// If this request is part of a transaction...
 		id = string(ba.Txn.ID)
+		// Have the wrapped sender record the ranges the batch is sent to.
+		ctx = withRangeSet(ctx, rangeSet{})
 		// Verify that if this Transaction is not read-only, we have it on
 		// file. If not, refuse writes - the client must have issued a write on
 		// another coordinator previously.
@@ -405,6 +496,7 @@ func (tc *TxnCoordSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*r
 			return nil, pErr
 		}
 	}
+	tc.metrics.recordPushAborts(ba, br)
 
 	if br.Txn == nil {
 		return br, nil
@@ -504,6 +596,7 @@ func (tc *TxnCoordSender) cleanupTxn(trace *tracer.Trace, txn roachpb.Transactio
 	// The supplied txn may be newer than the one in txnMeta, which is relevant
 	// for stats.
 	txnMeta.txn = txn
+	tc.metrics.recordRanges(len(txnMeta.ranges))
 	// Trigger heartbeat shutdown.
 	close(txnMeta.txnEnd)
 }
@@ -550,12 +643,17 @@ func (tc *TxnCoordSender) heartbeatLoop(id string) {
 
 	var closer <-chan struct{}
 	var trace *tracer.Trace
+	var eventLog ntrace.EventLog
 	{
 		tc.Lock()
 		txnMeta := tc.txns[id] // do not leak to outer scope
 		closer = txnMeta.txnEnd
 		trace = tc.tracer.NewTrace(tracer.Coord, &txnMeta.txn)
 		defer trace.Finalize()
+		// The heartbeats of a long-running transaction are also visible
+		// at /debug/events while it's running.
+		eventLog = ntrace.NewEventLog("txn heartbeat", txnMeta.txn.TraceName())
+		defer eventLog.Finish()
 		tc.Unlock()
 	}
 	if closer == nil {
@@ -568,7 +666,7 @@ func (tc *TxnCoordSender) heartbeatLoop(id string) {
 	for {
 		select {
 		case <-tickChan:
-			if !tc.heartbeat(id, trace, ctx) {
+			if !tc.heartbeat(id, trace, eventLog, ctx) {
 				return
 			}
 		case <-closer:
@@ -581,7 +679,7 @@ func (tc *TxnCoordSender) heartbeatLoop(id string) {
 	}
 }
 
-func (tc *TxnCoordSender) heartbeat(id string, trace *tracer.Trace, ctx context.Context) bool {
+func (tc *TxnCoordSender) heartbeat(id string, trace *tracer.Trace, eventLog ntrace.EventLog, ctx context.Context) bool {
 	tc.Lock()
 	proceed := true
 	txnMeta := tc.txns[id]
@@ -597,6 +695,7 @@ func (tc *TxnCoordSender) heartbeat(id string, trace *tracer.Trace, ctx context.
 			log.Infof("transaction %s abandoned; stopping heartbeat",
 				txnMeta.txn)
 		}
+		eventLog.Printf("transaction abandoned; stopping heartbeat")
 		proceed = false
 	}
 	// txnMeta.txn is possibly replaced concurrently,
@@ -622,6 +721,9 @@ func (tc *TxnCoordSender) heartbeat(id string, trace *tracer.Trace, ctx context.
 	// write intents accordingly.
 	if err != nil {
 		log.Warningf("heartbeat to %s failed: %s", txn, err)
+		eventLog.Errorf("heartbeat failed: %s", err)
+	} else {
+		eventLog.Printf("heartbeat at %s", ba.Timestamp)
 	}
 	// TODO(bdarnell): once we have gotten a heartbeat response with
 	// Status != PENDING, future heartbeats are useless. However, we
@@ -739,6 +841,7 @@ func (tc *TxnCoordSender) updateState(ctx context.Context, ba roachpb.BatchReque
 					lastUpdateNanos:  tc.clock.PhysicalNow(),
 					timeoutDuration:  tc.clientTimeout,
 					txnEnd:           make(chan struct{}),
+					ranges:           map[roachpb.RangeID]struct{}{},
 				}
 				tc.txns[id] = txnMeta
 				// If the transaction is already over, there's no point in
@@ -775,6 +878,11 @@ func (tc *TxnCoordSender) updateState(ctx context.Context, ba roachpb.BatchReque
 			for _, intent := range intents {
 				txnMeta.addKeyRange(intent.Key, intent.EndKey)
 			}
+			if rs, ok := ctx.Value(rangeSetKey{}).(rangeSet); ok {
+				for rangeID := range rs {
+					txnMeta.ranges[rangeID] = struct{}{}
+				}
+			}
 		}
 		if pErr == nil {
 			// For successful transactional requests, always send the updated txn
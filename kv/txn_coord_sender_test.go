@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -29,11 +30,13 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
 
@@ -377,6 +380,40 @@ func TestTxnCoordSenderEndTxn(t *testing.T) {
 	}
 }
 
+// TestTxnCoordSenderRangesMetric verifies that the number of ranges touched
+// by a transaction spanning multiple ranges is recorded.
+func TestTxnCoordSenderRangesMetric(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := createTestDB(t)
+	defer s.Stop()
+
+	if pErr := s.DB.AdminSplit("b"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	// Start from fresh metrics so that the split transaction is not counted.
+	metrics := NewTxnMetrics(metric.NewRegistry())
+	s.Sender.Lock()
+	s.Sender.metrics = metrics
+	s.Sender.Unlock()
+
+	if pErr := s.DB.Txn(func(txn *client.Txn) *roachpb.Error {
+		b := txn.NewBatch()
+		b.Put("a", "value")
+		b.Put("c", "value")
+		return txn.CommitInBatch(b)
+	}); pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	current := metrics.Ranges.Current()
+	if a, e := current.TotalCount(), int64(1); a != e {
+		t.Fatalf("expected %d recorded transaction, got %d", e, a)
+	}
+	if max := current.Max(); max <= 1 {
+		t.Errorf("expected the transaction to touch more than one range, got %d", max)
+	}
+}
+
 // TestTxnCoordSenderAddIntentOnError verifies that intents are tracked if
 // the transaction is, even on error.
 func TestTxnCoordSenderAddIntentOnError(t *testing.T) {
@@ -443,6 +480,73 @@ func TestTxnCoordSenderCleanupOnAborted(t *testing.T) {
 	verifyCleanup(key, s.Sender, s.Eng, t)
 }
 
+// TestTxnCoordSenderPushAbortsMetric verifies that when two transactions
+// deadlock, each waiting on an intent of the other, the transaction aborted
+// to break the cycle is counted.
+func TestTxnCoordSenderPushAbortsMetric(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	// Record which transactions pushed which, to verify that the two
+	// transactions push each other.
+	var mu sync.Mutex
+	pushes := map[string]string{}
+	storage.TestingCommandFilter = func(_ roachpb.StoreID, args roachpb.Request, _ roachpb.Header) error {
+		if pArgs, ok := args.(*roachpb.PushTxnRequest); ok && len(pArgs.PusherTxn.ID) != 0 {
+			mu.Lock()
+			pushes[string(pArgs.PusherTxn.ID)] = string(pArgs.PusheeTxn.ID)
+			mu.Unlock()
+		}
+		return nil
+	}
+	defer func() { storage.TestingCommandFilter = nil }()
+
+	s := createTestDB(t)
+	defer s.Stop()
+	pushAborts := s.Sender.metrics.PushAborts
+	base := pushAborts.Count()
+
+	txn1 := client.NewTxn(*s.DB)
+	txn1.InternalSetPriority(2)
+	if pErr := txn1.Put("a", "value"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	txn2 := client.NewTxn(*s.DB)
+	txn2.InternalSetPriority(1)
+	if pErr := txn2.Put("b", "value"); pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	// The lower priority txn2 can't push txn1 out of its way.
+	if pErr := txn2.Put("a", "value2"); pErr == nil {
+		t.Fatal("expected txn2 to fail to push txn1")
+	} else if _, ok := pErr.GoError().(*roachpb.TransactionPushError); !ok {
+		t.Fatalf("expected a transaction push error; got %s", pErr)
+	}
+	if a := pushAborts.Count() - base; a != 0 {
+		t.Fatalf("expected no aborted transaction after a failed push; got %d", a)
+	}
+
+	// txn1 breaks the deadlock by aborting txn2.
+	if pErr := txn1.Put("b", "value1"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	if pErr := txn1.Commit(); pErr != nil {
+		t.Fatal(pErr)
+	}
+	if _, ok := txn2.Commit().GoError().(*roachpb.TransactionAbortedError); !ok {
+		t.Fatal("expected txn2 to be aborted")
+	}
+
+	mu.Lock()
+	id1, id2 := string(txn1.Proto.ID), string(txn2.Proto.ID)
+	if pushes[id1] != id2 || pushes[id2] != id1 {
+		t.Errorf("expected the transactions to push each other; got %v", pushes)
+	}
+	mu.Unlock()
+	if a := pushAborts.Count() - base; a != 1 {
+		t.Errorf("expected 1 transaction aborted by a push; got %d", a)
+	}
+}
+
 // TestTxnCoordSenderGC verifies that the coordinator cleans up extant
 // transactions after the lastUpdateNanos exceeds the timeout.
 func TestTxnCoordSenderGC(t *testing.T) {
@@ -557,7 +661,7 @@ func TestTxnCoordSenderTxnUpdatedOnError(t *testing.T) {
 				reply = ba.CreateReply()
 			}
 			return reply, test.pErr
-		}), clock, false, nil, stopper)
+		}), clock, false, nil, stopper, nil)
 		db := client.NewDB(ts)
 		txn := client.NewTxn(*db)
 		txn.InternalSetPriority(1)
@@ -659,7 +763,7 @@ func TestTxnCoordSenderSingleRoundtripTxn(t *testing.T) {
 		br.Txn = ba.Txn.Clone()
 		br.Txn.Writing = true
 		return br, nil
-	}), clock, false, nil, stopper)
+	}), clock, false, nil, stopper, nil)
 
 	// Stop the stopper manually, prior to trying the transaction. This has the
 	// effect of returning a NodeUnavailableError for any attempts at launching
@@ -692,7 +796,7 @@ func TestTxnCoordSenderErrorWithIntent(t *testing.T) {
 		txn := ba.Txn.Clone()
 		txn.Writing = true
 		return nil, roachpb.NewError(roachpb.NewTransactionRetryError(txn))
-	}), clock, false, nil, stopper)
+	}), clock, false, nil, stopper, nil)
 	defer stopper.Stop()
 
 	var ba roachpb.BatchRequest
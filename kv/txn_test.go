@@ -203,7 +203,7 @@ func verifyUncertainty(concurrency int, maxOffset time.Duration, t *testing.T) {
 			// higher values require roughly offset/5 restarts.
 			txnClock.SetMaxOffset(maxOffset)
 
-			sender := NewTxnCoordSender(s.distSender, txnClock, false, nil, s.Stopper)
+			sender := NewTxnCoordSender(s.distSender, txnClock, false, nil, s.Stopper, nil)
 			txnDB := client.NewDB(sender)
 
 			if pErr := txnDB.Txn(func(txn *client.Txn) *roachpb.Error {
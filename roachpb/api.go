@@ -338,6 +338,15 @@ func (*TruncateLogRequest) Method() Method { return TruncateLog }
 // Method implements the Request interface.
 func (*LeaderLeaseRequest) Method() Method { return LeaderLease }
 
+// Method implements the Request interface.
+func (*RaftStatusRequest) Method() Method { return RaftStatus }
+
+// Method implements the Request interface.
+func (*RangeStatsRequest) Method() Method { return RangeStats }
+
+// Method implements the Request interface.
+func (*AdminScatterRequest) Method() Method { return AdminScatter }
+
 // CreateReply implements the Request interface.
 func (*GetRequest) CreateReply() Response { return &GetResponse{} }
 
@@ -406,6 +415,15 @@ func (*TruncateLogRequest) CreateReply() Response { return &TruncateLogResponse{
 // CreateReply implements the Request interface.
 func (*LeaderLeaseRequest) CreateReply() Response { return &LeaderLeaseResponse{} }
 
+// CreateReply implements the Request interface.
+func (*RaftStatusRequest) CreateReply() Response { return &RaftStatusResponse{} }
+
+// CreateReply implements the Request interface.
+func (*RangeStatsRequest) CreateReply() Response { return &RangeStatsResponse{} }
+
+// CreateReply implements the Request interface.
+func (*AdminScatterRequest) CreateReply() Response { return &AdminScatterResponse{} }
+
 // NewGet returns a Request initialized to get the value at key.
 func NewGet(key Key) Request {
 	return &GetRequest{
@@ -531,3 +549,6 @@ func (*NoopRequest) flags() int               { return isRead } // slightly spec
 func (*MergeRequest) flags() int              { return isWrite }
 func (*TruncateLogRequest) flags() int        { return isWrite }
 func (*LeaderLeaseRequest) flags() int        { return isWrite }
+func (*RaftStatusRequest) flags() int         { return isRead }
+func (*RangeStatsRequest) flags() int         { return isRead }
+func (*AdminScatterRequest) flags() int       { return isAdmin | isAlone }
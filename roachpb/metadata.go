@@ -181,3 +181,22 @@ func (s StoreDescriptor) CombinedAttrs() *Attributes {
 	a = append(a, s.Attrs.Attrs...)
 	return &Attributes{Attrs: a}
 }
+
+// IsDecommissioning returns whether the given node is being decommissioned.
+func (s DecommissionStatus) IsDecommissioning(nodeID NodeID) bool {
+	return containsNodeID(s.Decommissioning, nodeID)
+}
+
+// IsDecommissioned returns whether the given node has been decommissioned.
+func (s DecommissionStatus) IsDecommissioned(nodeID NodeID) bool {
+	return containsNodeID(s.Decommissioned, nodeID)
+}
+
+func containsNodeID(nodeIDs []NodeID, nodeID NodeID) bool {
+	for _, id := range nodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
+}
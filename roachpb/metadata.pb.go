@@ -106,6 +106,8 @@ type NodeDescriptor struct {
 	NodeID  NodeID                        `protobuf:"varint,1,opt,name=node_id,casttype=NodeID" json:"node_id"`
 	Address cockroach_util.UnresolvedAddr `protobuf:"bytes,2,opt,name=address" json:"address"`
 	Attrs   Attributes                    `protobuf:"bytes,3,opt,name=attrs" json:"attrs"`
+	// sql_address is the address on which the node serves SQL clients.
+	SQLAddress cockroach_util.UnresolvedAddr `protobuf:"bytes,4,opt,name=sql_address" json:"sql_address"`
 }
 
 func (m *NodeDescriptor) Reset()         { *m = NodeDescriptor{} }
@@ -125,6 +127,21 @@ func (m *StoreDescriptor) Reset()         { *m = StoreDescriptor{} }
 func (m *StoreDescriptor) String() string { return proto.CompactTextString(m) }
 func (*StoreDescriptor) ProtoMessage()    {}
 
+// DecommissionStatus records the nodes which are being, or have been,
+// permanently removed from the cluster. It is stored in a system key and
+// gossiped to all nodes.
+type DecommissionStatus struct {
+	// decommissioning are the nodes whose replicas are being moved to other
+	// nodes.
+	Decommissioning []NodeID `protobuf:"varint,1,rep,name=decommissioning,casttype=NodeID" json:"decommissioning,omitempty"`
+	// decommissioned are the nodes which no longer hold any replicas.
+	Decommissioned []NodeID `protobuf:"varint,2,rep,name=decommissioned,casttype=NodeID" json:"decommissioned,omitempty"`
+}
+
+func (m *DecommissionStatus) Reset()         { *m = DecommissionStatus{} }
+func (m *DecommissionStatus) String() string { return proto.CompactTextString(m) }
+func (*DecommissionStatus) ProtoMessage()    {}
+
 func init() {
 	proto.RegisterType((*Attributes)(nil), "cockroach.roachpb.Attributes")
 	proto.RegisterType((*ReplicaDescriptor)(nil), "cockroach.roachpb.ReplicaDescriptor")
@@ -134,6 +151,7 @@ func init() {
 	proto.RegisterType((*StoreCapacity)(nil), "cockroach.roachpb.StoreCapacity")
 	proto.RegisterType((*NodeDescriptor)(nil), "cockroach.roachpb.NodeDescriptor")
 	proto.RegisterType((*StoreDescriptor)(nil), "cockroach.roachpb.StoreDescriptor")
+	proto.RegisterType((*DecommissionStatus)(nil), "cockroach.roachpb.DecommissionStatus")
 }
 func (m *Attributes) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -378,6 +396,14 @@ func (m *NodeDescriptor) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n2
+	data[i] = 0x22
+	i++
+	i = encodeVarintMetadata(data, i, uint64(m.SQLAddress.Size()))
+	n3, err := m.SQLAddress.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
 	return i, nil
 }
 
@@ -402,27 +428,59 @@ func (m *StoreDescriptor) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x12
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.Attrs.Size()))
-	n3, err := m.Attrs.MarshalTo(data[i:])
+	n4, err := m.Attrs.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	data[i] = 0x1a
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.Node.Size()))
-	n4, err := m.Node.MarshalTo(data[i:])
+	n5, err := m.Node.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	data[i] = 0x22
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.Capacity.Size()))
-	n5, err := m.Capacity.MarshalTo(data[i:])
+	n6, err := m.Capacity.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
+	return i, nil
+}
+
+func (m *DecommissionStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DecommissionStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decommissioning) > 0 {
+		for _, num := range m.Decommissioning {
+			data[i] = 0x8
+			i++
+			i = encodeVarintMetadata(data, i, uint64(num))
+		}
+	}
+	if len(m.Decommissioned) > 0 {
+		for _, num := range m.Decommissioned {
+			data[i] = 0x10
+			i++
+			i = encodeVarintMetadata(data, i, uint64(num))
+		}
+	}
 	return i, nil
 }
 
@@ -546,6 +604,8 @@ func (m *NodeDescriptor) Size() (n int) {
 	n += 1 + l + sovMetadata(uint64(l))
 	l = m.Attrs.Size()
 	n += 1 + l + sovMetadata(uint64(l))
+	l = m.SQLAddress.Size()
+	n += 1 + l + sovMetadata(uint64(l))
 	return n
 }
 
@@ -562,6 +622,22 @@ func (m *StoreDescriptor) Size() (n int) {
 	return n
 }
 
+func (m *DecommissionStatus) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Decommissioning) > 0 {
+		for _, e := range m.Decommissioning {
+			n += 1 + sovMetadata(uint64(e))
+		}
+	}
+	if len(m.Decommissioned) > 0 {
+		for _, e := range m.Decommissioned {
+			n += 1 + sovMetadata(uint64(e))
+		}
+	}
+	return n
+}
+
 func sovMetadata(x uint64) (n int) {
 	for {
 		n++
@@ -1411,6 +1487,36 @@ func (m *NodeDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQLAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SQLAddress.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(data[iNdEx:])
@@ -1591,6 +1697,96 @@ func (m *StoreDescriptor) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *DecommissionStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecommissionStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecommissionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decommissioning", wireType)
+			}
+			var v NodeID
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decommissioning = append(m.Decommissioning, v)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decommissioned", wireType)
+			}
+			var v NodeID
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decommissioned = append(m.Decommissioned, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMetadata(data []byte) (n int, err error) {
 	l := len(data)
 	iNdEx := 0
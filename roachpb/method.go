@@ -102,6 +102,12 @@ const (
 	TruncateLog
 	// LeaderLease requests a leader lease for a replica.
 	LeaderLease
+	// RaftStatus returns the Raft status of a range.
+	RaftStatus
+	// RangeStats returns the MVCC statistics of a range.
+	RangeStats
+	// AdminScatter moves a replica of a range to another node.
+	AdminScatter
 	// Batch implements batch processing of commands. This is a
 	// superset of the Batch method.
 	Batch
@@ -92,6 +92,9 @@ type Client struct {
 	remoteOffset      RemoteOffset
 	heartbeatInterval time.Duration
 	heartbeatTimeout  time.Duration
+	// getTLSConfig, if set, returns the current TLS config, which replaces
+	// tlsConfig when (re)connecting so that reloaded certificates are used.
+	getTLSConfig func() (*tls.Config, error)
 }
 
 // NewClient returns a client RPC stub for the specified address
@@ -129,6 +132,7 @@ func NewClient(addr net.Addr, context *Context) *Client {
 		key:               key,
 		addr:              unresolvedAddr,
 		tlsConfig:         tlsConfig,
+		getTLSConfig:      context.GetClientTLSConfig,
 		disableReconnects: context.DisableReconnects,
 		clock:             context.localClock,
 		remoteClocks:      context.RemoteClocks,
@@ -183,6 +187,13 @@ func (c *Client) internalConn() *internalConn {
 
 // connect attempts a single connection attempt. On success, updates `c.conn`.
 func (c *Client) connect() error {
+	if c.getTLSConfig != nil {
+		tlsConfig, err := c.getTLSConfig()
+		if err != nil {
+			return err
+		}
+		c.tlsConfig = tlsConfig
+	}
 	conn, err := codec.TLSDialHTTP(
 		c.addr.NetworkField, c.addr.AddressField, base.NetworkTimeout, c.tlsConfig)
 	if err != nil {
@@ -398,6 +409,9 @@ func (c *Client) heartbeat(closer <-chan struct{}) error {
 		remoteTimeNow := response.ServerTime + c.remoteOffset.Uncertainty
 		c.remoteOffset.Offset = remoteTimeNow - receiveTime
 		c.remoteClocks.UpdateOffset(c.RemoteAddr().String(), c.remoteOffset)
+		// The remote clock is ahead of ours by at least the offset less its
+		// uncertainty.
+		c.clock.CheckOffset(time.Duration(c.remoteOffset.Offset - c.remoteOffset.Uncertainty))
 	}
 
 	return nil
@@ -73,6 +73,7 @@ func (hs *HeartbeatService) Ping(argsI proto.Message) (proto.Message, error) {
 	// The server offset should be the opposite of the client offset.
 	serverOffset.Offset = -serverOffset.Offset
 	hs.remoteClockMonitor.UpdateOffset(args.Addr, serverOffset)
+	hs.clock.CheckOffset(time.Duration(serverOffset.Offset - serverOffset.Uncertainty))
 	reply.ServerTime = hs.clock.PhysicalNow()
 	return reply, nil
 }
@@ -32,7 +32,7 @@ import (
 )
 
 func init() {
-	security.SetReadFileFn(securitytest.Asset)
+	security.PushCertSource(securitytest.Asset)
 }
 
 //go:generate ../util/leaktest/add-leaktest.sh *_test.go
@@ -55,7 +55,8 @@ func LogTLSState(method string, tlsState *tls.ConnectionState) {
 	log.Printf("%s: peer certs: %v, chain: %v\n", method, peerCerts, verifiedChain)
 }
 
-// GetCertificateUser extract the username from a client certificate.
+// GetCertificateUser extract the username from a client certificate. A
+// revoked certificate yields a RevokedError if revocation checks are enabled.
 func GetCertificateUser(tlsState *tls.ConnectionState) (string, error) {
 	if tlsState == nil {
 		return "", util.Errorf("request is not using TLS")
@@ -63,10 +64,15 @@ func GetCertificateUser(tlsState *tls.ConnectionState) (string, error) {
 	if len(tlsState.PeerCertificates) == 0 {
 		return "", util.Errorf("no client certificates in request")
 	}
-	if len(tlsState.VerifiedChains) != len(tlsState.PeerCertificates) {
-		// TODO(marc): can this happen? Should we require exactly one?
+	// The peer certificates include the intermediate CAs which issued the
+	// client certificate, so they aren't matched one-to-one by the verified
+	// chains.
+	if len(tlsState.VerifiedChains) == 0 {
 		return "", util.Errorf("client cerficates not verified")
 	}
+	if err := checkRevocation(tlsState.VerifiedChains[0]); err != nil {
+		return "", err
+	}
 	return tlsState.PeerCertificates[0].Subject.CommonName, nil
 }
 
@@ -100,9 +106,38 @@ func ProtoAuthHook(insecureMode bool, tlsState *tls.ConnectionState) (
 	}, nil
 }
 
+// impersonatingUsers are the certificate users which may act on behalf of
+// any other user in requests.
+var impersonatingUsers = map[string]bool{
+	NodeUser: true,
+}
+
+// sqlImpersonatingUsers are the certificate users which may open SQL sessions
+// on behalf of any other user.
+var sqlImpersonatingUsers = map[string]bool{
+	NodeUser: true,
+	RootUser: true,
+}
+
 // UserAuthHook builds an authentication hook based on the security
 // mode and client certificate.
 func UserAuthHook(insecureMode bool, tlsState *tls.ConnectionState) (
+	func(string, bool) error, error) {
+	return userAuthHook(insecureMode, tlsState, impersonatingUsers)
+}
+
+// SQLUserAuthHook builds an authentication hook for SQL sessions based on
+// the security mode and client certificate. It differs from UserAuthHook in
+// that the root user may also open sessions on behalf of other users.
+func SQLUserAuthHook(insecureMode bool, tlsState *tls.ConnectionState) (
+	func(string, bool) error, error) {
+	return userAuthHook(insecureMode, tlsState, sqlImpersonatingUsers)
+}
+
+// userAuthHook builds an authentication hook which verifies that the
+// requested user matches the certificate user, unless the certificate user
+// is one of the impersonating users.
+func userAuthHook(insecureMode bool, tlsState *tls.ConnectionState, impersonators map[string]bool) (
 	func(string, bool) error, error) {
 	var certUser string
 
@@ -130,9 +165,9 @@ func UserAuthHook(insecureMode bool, tlsState *tls.ConnectionState) (
 		}
 
 		// The client certificate user must match the requested user,
-		// except if the certificate user is NodeUser, which is allowed to
-		// act on behalf of all other users.
-		if !(certUser == NodeUser || certUser == requestedUser) {
+		// except if the certificate user is allowed to act on behalf of all
+		// other users.
+		if !(impersonators[certUser] || certUser == requestedUser) {
 			return util.Errorf("requested user is %s, but certificate is for %s", requestedUser, certUser)
 		}
 
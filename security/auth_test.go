@@ -62,11 +62,18 @@ func TestGetCertificateUser(t *testing.T) {
 		t.Error("unexpected success")
 	}
 
-	// len(certs) != len(chains)
-	if _, err := security.GetCertificateUser(makeFakeTLSState([]string{"foo"}, []int{1, 1})); err == nil {
+	// No verified chain.
+	if _, err := security.GetCertificateUser(makeFakeTLSState([]string{"foo"}, nil)); err == nil {
 		t.Error("unexpected success")
 	}
 
+	// Good request: certificate issued by an intermediate CA.
+	if name, err := security.GetCertificateUser(makeFakeTLSState([]string{"foo", "intermediate"}, []int{3})); err != nil {
+		t.Error(err)
+	} else if name != "foo" {
+		t.Errorf("expected name: foo, got: %s", name)
+	}
+
 	// Good request: single certificate.
 	if name, err := security.GetCertificateUser(makeFakeTLSState([]string{"foo"}, []int{2})); err != nil {
 		t.Error(err)
@@ -130,3 +137,36 @@ func TestAuthenticationHook(t *testing.T) {
 		}
 	}
 }
+
+func TestSQLAuthenticationHook(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		certUser      string
+		requestedUser string
+		success       bool
+	}{
+		{"foo", "foo", true},
+		{"foo", security.RootUser, false},
+		{"foo", "bar", false},
+		{security.RootUser, "foo", true},
+		{security.NodeUser, "foo", true},
+	}
+
+	for tcNum, tc := range testCases {
+		hook, err := security.SQLUserAuthHook(false, makeFakeTLSState([]string{tc.certUser}, []int{1}))
+		if err != nil {
+			t.Fatalf("#%d: expected success, got err=%v", tcNum, err)
+		}
+		if err := hook(tc.requestedUser, true /*public*/); (err == nil) != tc.success {
+			t.Errorf("#%d: expected success=%t, got err=%v", tcNum, tc.success, err)
+		}
+	}
+	// Only SQL sessions may be opened by root on behalf of other users.
+	hook, err := security.UserAuthHook(false, makeFakeTLSState([]string{security.RootUser}, []int{1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hook("foo", true /*public*/); err == nil {
+		t.Error("expected root to be denied acting on behalf of another user")
+	}
+}
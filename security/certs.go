@@ -21,12 +21,22 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/util"
 )
 
+const (
+	// caPrefix is the file name prefix of the root CA certificate and key.
+	caPrefix = "ca"
+	// intermediateCAPrefix is the file name prefix of the intermediate CA
+	// certificate and key.
+	intermediateCAPrefix = "ca.intermediate"
+)
+
 // ClientCertPath returns a path to a certificate file for the given
 // username in the given certDir.
 func ClientCertPath(certDir, username string) string {
@@ -39,12 +49,13 @@ func ClientKeyPath(certDir, username string) string {
 	return filepath.Join(certDir, username+".client.key")
 }
 
-// loadCACertAndKey loads the certificate and key files in the specified
-// directory, parses them, and returns the x509 certificate and private key.
-func loadCACertAndKey(certsDir string) (*x509.Certificate, crypto.PrivateKey, error) {
+// loadCACertAndKey loads the certificate and key files of the CA with the
+// given file name prefix in the specified directory, parses them, and returns
+// the x509 certificate and private key.
+func loadCACertAndKey(certsDir, prefix string) (*x509.Certificate, crypto.PrivateKey, error) {
 	// Load the CA certificate.
-	caCertPath := filepath.Join(certsDir, "ca.crt")
-	caKeyPath := filepath.Join(certsDir, "ca.key")
+	caCertPath := filepath.Join(certsDir, prefix+".crt")
+	caKeyPath := filepath.Join(certsDir, prefix+".key")
 
 	// LoadX509KeyPair does a bunch of validation, including len(Certificates) != 0.
 	caCert, err := tls.LoadX509KeyPair(caCertPath, caKeyPath)
@@ -61,16 +72,38 @@ func loadCACertAndKey(certsDir string) (*x509.Certificate, crypto.PrivateKey, er
 	return x509Cert, caCert.PrivateKey, nil
 }
 
+// loadSigningCertAndKey loads the CA which signs node and client
+// certificates: the intermediate CA if requested by the options and the root
+// CA otherwise. The certificate of the intermediate CA is also returned as
+// the chain to include with the signed certificates.
+func loadSigningCertAndKey(certsDir string, opts CertOptions) (
+	*x509.Certificate, crypto.PrivateKey, [][]byte, error) {
+	if !opts.Intermediate {
+		caCert, caKey, err := loadCACertAndKey(certsDir, caPrefix)
+		return caCert, caKey, nil, err
+	}
+	caCert, caKey, err := loadCACertAndKey(certsDir, intermediateCAPrefix)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return caCert, caKey, [][]byte{caCert.Raw}, nil
+}
+
 // writeCertificateAndKey takes a x509 certificate and key and writes
 // them out to the individual files.
-// The certificate is written to <prefix>.crt and the key to <prefix>.key.
+// The certificate is written to <prefix>.crt, followed by the certificates of
+// the intermediate CAs in chain, and the key to <prefix>.key.
 // TODO(marc): figure out how to include the plaintext certificate in the .crt file.
 func writeCertificateAndKey(certsDir string, prefix string,
-	certificate []byte, key crypto.PrivateKey) error {
-	// Get PEM blocks for certificate and private key.
-	certBlock, err := certificatePEMBlock(certificate)
-	if err != nil {
-		return err
+	certificate []byte, chain [][]byte, key crypto.PrivateKey) error {
+	// Get PEM blocks for certificates and private key.
+	var certBlocks []*pem.Block
+	for _, cert := range append([][]byte{certificate}, chain...) {
+		certBlock, err := certificatePEMBlock(cert)
+		if err != nil {
+			return err
+		}
+		certBlocks = append(certBlocks, certBlock)
 	}
 
 	keyBlock, err := privateKeyPEMBlock(key)
@@ -85,9 +118,10 @@ func writeCertificateAndKey(certsDir string, prefix string,
 		return util.Errorf("error creating certificate file %s: %s", certFilePath, err)
 	}
 
-	err = pem.Encode(certFile, certBlock)
-	if err != nil {
-		return util.Errorf("error encoding certificate: %s", err)
+	for _, certBlock := range certBlocks {
+		if err := pem.Encode(certFile, certBlock); err != nil {
+			return util.Errorf("error encoding certificate: %s", err)
+		}
 	}
 
 	err = certFile.Close()
@@ -117,7 +151,7 @@ func writeCertificateAndKey(certsDir string, prefix string,
 
 // RunCreateCACert is the entry-point from the command-line interface
 // to generate CA cert and key.
-func RunCreateCACert(certsDir string, keySize int) error {
+func RunCreateCACert(certsDir string, opts CertOptions) error {
 	if certsDir == "" {
 		return util.Errorf("no certs directory specified, use --certs")
 	}
@@ -129,12 +163,28 @@ func RunCreateCACert(certsDir string, keySize int) error {
 	}
 
 	// Generate certificate.
-	certificate, key, err := GenerateCA(keySize)
+	certificate, key, err := GenerateCA(opts)
 	if err != nil {
 		return util.Errorf("error creating CA certificate and key: %s", err)
 	}
 
-	return writeCertificateAndKey(certsDir, "ca", certificate, key)
+	if err := writeCertificateAndKey(certsDir, caPrefix, certificate, nil, key); err != nil {
+		return err
+	}
+	if !opts.Intermediate {
+		return nil
+	}
+
+	// Generate the intermediate CA, signed by the root CA.
+	caCert, err := x509.ParseCertificate(certificate)
+	if err != nil {
+		return util.Errorf("error parsing CA certificate: %s", err)
+	}
+	intermediateCert, intermediateKey, err := GenerateIntermediateCA(caCert, key, opts)
+	if err != nil {
+		return util.Errorf("error creating intermediate CA certificate and key: %s", err)
+	}
+	return writeCertificateAndKey(certsDir, intermediateCAPrefix, intermediateCert, nil, intermediateKey)
 }
 
 // RunCreateNodeCert is the entry-point from the command-line interface
@@ -142,7 +192,7 @@ func RunCreateCACert(certsDir string, keySize int) error {
 // - node.server.{crt,key}: server cert with list of dns/ip addresses
 // - node.client.{crt,key}: client cert with "node" as the Common Name.
 // We intentionally generate distinct keys for each cert.
-func RunCreateNodeCert(certsDir string, keySize int, hosts []string) error {
+func RunCreateNodeCert(certsDir string, opts CertOptions, hosts []string) error {
 	if certsDir == "" {
 		return util.Errorf("no certs directory specified, use --certs")
 	}
@@ -150,33 +200,33 @@ func RunCreateNodeCert(certsDir string, keySize int, hosts []string) error {
 		return util.Errorf("no hosts specified. Need at least one")
 	}
 
-	caCert, caKey, err := loadCACertAndKey(certsDir)
+	caCert, caKey, chain, err := loadSigningCertAndKey(certsDir, opts)
 	if err != nil {
 		return err
 	}
 
 	// Generate certificates and keys.
-	serverCert, serverKey, err := GenerateServerCert(caCert, caKey, keySize, hosts)
+	serverCert, serverKey, err := GenerateServerCert(caCert, caKey, opts, hosts)
 	if err != nil {
 		return util.Errorf("error creating node server certificate and key: %s", err)
 	}
-	clientCert, clientKey, err := GenerateClientCert(caCert, caKey, keySize, NodeUser)
+	clientCert, clientKey, err := GenerateClientCert(caCert, caKey, opts, NodeUser)
 	if err != nil {
 		return util.Errorf("error creating node client certificate and key: %s", err)
 	}
 
 	// TODO(marc): we fail if files already exist. At this point, we're checking four
 	// different files, and should really make this more atomic (or at least check for existence first).
-	err = writeCertificateAndKey(certsDir, NodeUser+".server", serverCert, serverKey)
+	err = writeCertificateAndKey(certsDir, NodeUser+".server", serverCert, chain, serverKey)
 	if err != nil {
 		return err
 	}
-	return writeCertificateAndKey(certsDir, NodeUser+".client", clientCert, clientKey)
+	return writeCertificateAndKey(certsDir, NodeUser+".client", clientCert, chain, clientKey)
 }
 
 // RunCreateClientCert is the entry-point from the command-line interface
 // to generate a client cert and key.
-func RunCreateClientCert(certsDir string, keySize int, username string) error {
+func RunCreateClientCert(certsDir string, opts CertOptions, username string) error {
 	if certsDir == "" {
 		return util.Errorf("no certs directory specified, use --certs")
 	}
@@ -184,16 +234,57 @@ func RunCreateClientCert(certsDir string, keySize int, username string) error {
 		return util.Errorf("no username specified.")
 	}
 
-	caCert, caKey, err := loadCACertAndKey(certsDir)
+	caCert, caKey, chain, err := loadSigningCertAndKey(certsDir, opts)
 	if err != nil {
 		return err
 	}
 
 	// Generate certificate.
-	certificate, key, err := GenerateClientCert(caCert, caKey, keySize, username)
+	certificate, key, err := GenerateClientCert(caCert, caKey, opts, username)
 	if err != nil {
 		return util.Errorf("error creating client certificate and key: %s", err)
 	}
 
-	return writeCertificateAndKey(certsDir, username+".client", certificate, key)
+	return writeCertificateAndKey(certsDir, username+".client", certificate, chain, key)
+}
+
+// CertInfo describes a certificate file in a certs directory.
+type CertInfo struct {
+	// Filename is the name of the file within the certs directory.
+	Filename string
+	Cert     *x509.Certificate
+}
+
+// ListCertificates parses the certificate files, ending in ".crt", in the
+// given directory and returns them sorted by file name.
+func ListCertificates(certsDir string) ([]CertInfo, error) {
+	if certsDir == "" {
+		return nil, util.Errorf("no certs directory specified, use --certs")
+	}
+	files, err := ioutil.ReadDir(certsDir)
+	if err != nil {
+		return nil, util.Errorf("error reading certs directory %s: %s", certsDir, err)
+	}
+
+	var certs []CertInfo
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".crt") {
+			continue
+		}
+		certPath := filepath.Join(certsDir, file.Name())
+		certPEM, err := ioutil.ReadFile(certPath)
+		if err != nil {
+			return nil, util.Errorf("error reading certificate %s: %s", certPath, err)
+		}
+		block, _ := pem.Decode(certPEM)
+		if block == nil || block.Type != "CERTIFICATE" {
+			return nil, util.Errorf("no certificate found in %s", certPath)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, util.Errorf("error parsing certificate %s: %s", certPath, err)
+		}
+		certs = append(certs, CertInfo{Filename: file.Name(), Cert: cert})
+	}
+	return certs, nil
 }
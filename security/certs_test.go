@@ -17,8 +17,20 @@
 package security_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
@@ -31,36 +43,33 @@ import (
 // This is just the mechanics of certs generation.
 func TestGenerateCerts(t *testing.T) {
 	defer leaktest.AfterTest(t)
-	// Do not mock cert access for this test.
-	security.ResetReadFileFn()
-	defer ResetTest()
 
 	certsDir := util.CreateTempDir(t, "certs_test")
 	defer util.CleanupDir(certsDir)
 
 	// Try certs generation with empty Certs dir argument.
-	err := security.RunCreateCACert("", 512)
+	err := security.RunCreateCACert("", security.CertOptions{KeySize: 512})
 	if err == nil {
 		t.Fatalf("Expected error, but got none")
 	}
-	err = security.RunCreateNodeCert("", 512, []string{"localhost"})
+	err = security.RunCreateNodeCert("", security.CertOptions{KeySize: 512}, []string{"localhost"})
 	if err == nil {
 		t.Fatalf("Expected error, but got none")
 	}
 
 	// Try generating node certs without CA certs present.
-	err = security.RunCreateNodeCert(certsDir, 512, []string{"localhost"})
+	err = security.RunCreateNodeCert(certsDir, security.CertOptions{KeySize: 512}, []string{"localhost"})
 	if err == nil {
 		t.Fatalf("Expected error, but got none")
 	}
 
 	// Now try in the proper order.
-	err = security.RunCreateCACert(certsDir, 512)
+	err = security.RunCreateCACert(certsDir, security.CertOptions{KeySize: 512})
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
 
-	err = security.RunCreateNodeCert(certsDir, 512, []string{"localhost"})
+	err = security.RunCreateNodeCert(certsDir, security.CertOptions{KeySize: 512}, []string{"localhost"})
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
@@ -70,23 +79,20 @@ func TestGenerateCerts(t *testing.T) {
 // We construct SSL server and clients and use the generated certs.
 func TestUseCerts(t *testing.T) {
 	defer leaktest.AfterTest(t)
-	// Do not mock cert access for this test.
-	security.ResetReadFileFn()
-	defer ResetTest()
 	certsDir := util.CreateTempDir(t, "certs_test")
 	defer util.CleanupDir(certsDir)
 
-	err := security.RunCreateCACert(certsDir, 512)
+	err := security.RunCreateCACert(certsDir, security.CertOptions{KeySize: 512})
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
 
-	err = security.RunCreateNodeCert(certsDir, 512, []string{"127.0.0.1"})
+	err = security.RunCreateNodeCert(certsDir, security.CertOptions{KeySize: 512}, []string{"127.0.0.1"})
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
 
-	err = security.RunCreateClientCert(certsDir, 512, security.RootUser)
+	err = security.RunCreateClientCert(certsDir, security.CertOptions{KeySize: 512}, security.RootUser)
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
@@ -186,3 +192,346 @@ func TestUseCerts(t *testing.T) {
 		t.Fatalf("Expected OK, got: %d", resp.StatusCode)
 	}
 }
+
+// TestUseCertsWithOptions generates ECDSA certificates with a custom lifetime
+// and several hosts, verifies their fields and uses them to connect to a
+// server as the root user.
+func TestUseCertsWithOptions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	opts := security.CertOptions{Curve: "P256", Lifetime: 48 * time.Hour}
+	if err := security.RunCreateCACert(certsDir, opts); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	hosts := []string{"127.0.0.1", "localhost", "::1", "node.example.com"}
+	if err := security.RunCreateNodeCert(certsDir, opts, hosts); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateClientCert(certsDir, opts, security.RootUser); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateClientCert(certsDir, security.CertOptions{Curve: "P999"}, "foo"); err == nil {
+		t.Fatalf("Expected error for unknown curve, got none")
+	}
+
+	certs, err := security.ListCertificates(certsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, c := range certs {
+		names = append(names, c.Filename)
+		if _, ok := c.Cert.PublicKey.(*ecdsa.PublicKey); !ok {
+			t.Errorf("%s: expected ECDSA key, got %T", c.Filename, c.Cert.PublicKey)
+		}
+		if lifetime := c.Cert.NotAfter.Sub(time.Now()); lifetime > opts.Lifetime || lifetime < opts.Lifetime-time.Hour {
+			t.Errorf("%s: expected to expire in %s, expires at %s", c.Filename, opts.Lifetime, c.Cert.NotAfter)
+		}
+		switch c.Filename {
+		case "node.server.crt":
+			if e := []string{"localhost", "node.example.com"}; !reflect.DeepEqual(c.Cert.DNSNames, e) {
+				t.Errorf("expected DNS names %s, got %s", e, c.Cert.DNSNames)
+			}
+			if len(c.Cert.IPAddresses) != 2 || !c.Cert.IPAddresses[0].Equal(net.ParseIP("127.0.0.1")) ||
+				!c.Cert.IPAddresses[1].Equal(net.ParseIP("::1")) {
+				t.Errorf("expected IP addresses 127.0.0.1 and ::1, got %s", c.Cert.IPAddresses)
+			}
+		case "root.client.crt":
+			if cn := c.Cert.Subject.CommonName; cn != security.RootUser {
+				t.Errorf("expected common name %s, got %s", security.RootUser, cn)
+			}
+		}
+	}
+	if e := []string{"ca.crt", "node.client.crt", "node.server.crt", "root.client.crt"}; !reflect.DeepEqual(names, e) {
+		t.Errorf("expected certificates %s, got %s", e, names)
+	}
+
+	testCtx := server.NewContext()
+	testCtx.Certs = certsDir
+	testCtx.User = security.NodeUser
+	testCtx.Addr = "127.0.0.1:0"
+	testCtx.PGAddr = "127.0.0.1:0"
+	s := &server.TestServer{Ctx: testCtx}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	db, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=%s",
+		security.RootUser, s.ServingAddr(), certsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("SELECT 1"); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+}
+
+// TestUseCertsWithIntermediate generates node and client certificates signed
+// by an intermediate CA and verifies that the server and its clients build
+// the chains to the root CA through the intermediate.
+func TestUseCertsWithIntermediate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	opts := security.CertOptions{KeySize: 512, Intermediate: true}
+	if err := security.RunCreateCACert(certsDir, opts); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateNodeCert(certsDir, opts, []string{"127.0.0.1"}); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateClientCert(certsDir, opts, security.RootUser); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+
+	// The certificates include the intermediate CA, and ca.crt only the root.
+	for _, name := range []string{"node.server", "node.client", "root.client"} {
+		cert, err := tls.LoadX509KeyPair(filepath.Join(certsDir, name+".crt"), filepath.Join(certsDir, name+".key"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cert.Certificate) != 2 {
+			t.Errorf("%s: expected certificate and intermediate CA, got %d certificates", name, len(cert.Certificate))
+		}
+	}
+	caCerts, err := security.LoadCACertificates(certsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(caCerts) != 1 {
+		t.Fatalf("expected the root CA only, got %d certificates", len(caCerts))
+	}
+
+	testCtx := server.NewContext()
+	testCtx.Certs = certsDir
+	testCtx.User = security.NodeUser
+	testCtx.Addr = "127.0.0.1:0"
+	testCtx.PGAddr = "127.0.0.1:0"
+	s := &server.TestServer{Ctx: testCtx}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	// The server presents the full chain, which verifies against the root CA.
+	clientConfig, err := security.LoadClientTLSConfig(certsDir, security.RootUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := tls.Dial("tcp", s.ServingAddr(), clientConfig)
+	if err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	state := conn.ConnectionState()
+	conn.Close()
+	if len(state.PeerCertificates) != 2 || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) != 3 {
+		t.Fatalf("expected a chain through the intermediate CA, got %d peer certificates and chains %v",
+			len(state.PeerCertificates), state.VerifiedChains)
+	}
+
+	// The server verifies the client certificate through the intermediate CA.
+	db, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=%s",
+		security.RootUser, s.ServingAddr(), certsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("SELECT 1"); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+
+	// Signing with an intermediate CA requires one.
+	otherDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(otherDir)
+	if err := security.RunCreateCACert(otherDir, security.CertOptions{KeySize: 512}); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateClientCert(otherDir, opts, security.RootUser); err == nil {
+		t.Fatal("expected error without an intermediate CA, got none")
+	}
+}
+
+// TestServerCertHosts verifies that clients connecting to a server verify
+// its certificate against the IP addresses, DNS names and wildcard names it
+// was generated for.
+func TestServerCertHosts(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	opts := security.CertOptions{KeySize: 512}
+	if err := security.RunCreateCACert(certsDir, opts); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	for _, host := range []string{"*", "a.*.local", "*.127.0.0.1"} {
+		if err := security.RunCreateNodeCert(certsDir, opts, []string{host}); err == nil {
+			t.Errorf("%s: expected invalid host error, got none", host)
+		}
+	}
+	hosts := []string{"127.0.0.1", "localhost", "*.roach.local"}
+	if err := security.RunCreateNodeCert(certsDir, opts, hosts); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateClientCert(certsDir, opts, security.RootUser); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+
+	testCtx := server.NewContext()
+	testCtx.Certs = certsDir
+	testCtx.User = security.NodeUser
+	testCtx.Addr = "127.0.0.1:0"
+	testCtx.PGAddr = "127.0.0.1:0"
+	s := &server.TestServer{Ctx: testCtx}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	_, port, err := net.SplitHostPort(s.ServingAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientConfig, err := security.LoadClientTLSConfig(certsDir, security.RootUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		addr, serverName string
+		success          bool
+	}{
+		{net.JoinHostPort("127.0.0.1", port), "", true},
+		{net.JoinHostPort("localhost", port), "", true},
+		{s.ServingAddr(), "node1.roach.local", true},
+		{s.ServingAddr(), "roach.local", false},
+		{s.ServingAddr(), "node1.example.com", false},
+	}
+	for i, tc := range testCases {
+		config := *clientConfig
+		config.ServerName = tc.serverName
+		conn, err := tls.Dial("tcp", tc.addr, &config)
+		if err == nil {
+			conn.Close()
+		}
+		if tc.success && err != nil {
+			t.Errorf("%d: %s (%s): expected success, got %v", i, tc.addr, tc.serverName, err)
+		} else if !tc.success {
+			if _, ok := err.(x509.HostnameError); !ok {
+				t.Errorf("%d: %s (%s): expected certificate hostname error, got %v", i, tc.addr, tc.serverName, err)
+			}
+		}
+	}
+}
+
+// TestReloadCertificates replaces the node certificates of a running server
+// and verifies that new connections use them once they're reloaded, unless
+// they're invalid.
+func TestReloadCertificates(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	opts := security.CertOptions{KeySize: 512}
+	if err := security.RunCreateCACert(certsDir, opts); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateNodeCert(certsDir, opts, []string{"127.0.0.1"}); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if err := security.RunCreateClientCert(certsDir, opts, security.RootUser); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+
+	testCtx := server.NewContext()
+	testCtx.Certs = certsDir
+	testCtx.User = security.NodeUser
+	testCtx.Addr = "127.0.0.1:0"
+	testCtx.PGAddr = "127.0.0.1:0"
+	s := &server.TestServer{Ctx: testCtx}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	// servedSerial returns the serial number of the certificate presented by
+	// the server on a new connection.
+	servedSerial := func() string {
+		conn, err := tls.Dial("tcp", s.ServingAddr(), &tls.Config{InsecureSkipVerify: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		return conn.ConnectionState().PeerCertificates[0].SerialNumber.String()
+	}
+	oldSerial := servedSerial()
+
+	// Rotate the node certificates.
+	for _, name := range []string{"node.server.crt", "node.server.key", "node.client.crt", "node.client.key"} {
+		if err := os.Remove(filepath.Join(certsDir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := security.RunCreateNodeCert(certsDir, opts, []string{"127.0.0.1"}); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if serial := servedSerial(); serial != oldSerial {
+		t.Fatalf("expected certificate %s until reloaded, got %s", oldSerial, serial)
+	}
+	if err := s.ReloadCertificates(); err != nil {
+		t.Fatal(err)
+	}
+	newSerial := servedSerial()
+	if newSerial == oldSerial {
+		t.Fatalf("expected a new certificate after reloading, got %s", newSerial)
+	}
+
+	// The loaded certificates are exposed with their expiration.
+	clientContext := testutils.NewNodeTestBaseContext()
+	clientContext.Certs = certsDir
+	httpClient, err := clientContext.GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := httpClient.Get("https://" + s.ServingAddr() + "/_status/certificates/local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var certs server.CertificatesResponse
+	if err := json.Unmarshal(body, &certs); err != nil {
+		t.Fatalf("could not unmarshal %q: %s", body, err)
+	}
+	var found bool
+	for _, c := range certs.Certificates {
+		if c.Filename == "node.server.crt" {
+			found = c.SerialNumber == newSerial && c.NotAfter.After(time.Now())
+		}
+	}
+	if !found {
+		t.Errorf("expected node certificate %s, got %+v", newSerial, certs.Certificates)
+	}
+
+	// A key which doesn't match the certificate is rejected and the current
+	// certificates are kept.
+	keyPEM, err := ioutil.ReadFile(filepath.Join(certsDir, "root.client.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(certsDir, "node.server.key"), keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ReloadCertificates(); err == nil {
+		t.Fatal("expected mismatched key to be rejected")
+	}
+	if serial := servedSerial(); serial != newSerial {
+		t.Errorf("expected certificate %s to be kept, got %s", newSerial, serial)
+	}
+}
@@ -25,14 +25,10 @@ import (
 	_ "github.com/cockroachdb/cockroach/util/log" // for flags
 )
 
+// The embedded EmbeddedCertsDir folder is consulted before the file system
+// when loading certificates.
 func init() {
-	ResetTest()
-}
-
-// ResetTest sets up the test environment. In particular, it embeds the
-// EmbeddedCertsDir folder and makes the tls package load from there.
-func ResetTest() {
-	security.SetReadFileFn(securitytest.Asset)
+	security.PushCertSource(securitytest.Asset)
 }
 
 //go:generate ../util/leaktest/add-leaktest.sh *_test.go
@@ -0,0 +1,190 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// RevokedError is returned when a client certificate is listed as revoked by
+// the certificate revocation list of its issuer.
+type RevokedError struct {
+	User      string
+	Serial    string
+	RevokedAt time.Time
+}
+
+func (e *RevokedError) Error() string {
+	return fmt.Sprintf("certificate of user %s (serial %s) was revoked at %s", e.User, e.Serial, e.RevokedAt)
+}
+
+// A RevocationChecker checks client certificates against a certificate
+// revocation list (CRL). The CRL file is read again whenever it changes. A
+// CRL which cannot be read, isn't signed by the issuer of a certificate or is
+// past its next update fails the check, unless the checker fails open.
+type RevocationChecker struct {
+	path     string
+	failOpen bool
+
+	checks   *metric.Counter
+	revoked  *metric.Counter
+	failures *metric.Counter
+
+	mu struct {
+		sync.Mutex
+		modTime time.Time
+		size    int64
+		crl     *pkix.CertificateList
+		err     error
+		// revokedAt holds the revocation times keyed by serial number.
+		revokedAt map[string]time.Time
+		// issuers caches whether the CRL is signed by an issuer, keyed by the
+		// issuer's raw certificate.
+		issuers map[string]bool
+	}
+}
+
+// NewRevocationChecker returns a RevocationChecker of the CRL file at path,
+// whose checks, revoked certificates and failures are counted in registry.
+func NewRevocationChecker(path string, failOpen bool, registry *metric.Registry) *RevocationChecker {
+	return &RevocationChecker{
+		path:     path,
+		failOpen: failOpen,
+		checks:   registry.Counter("certs.revocation.checks"),
+		revoked:  registry.Counter("certs.revocation.revoked"),
+		failures: registry.Counter("certs.revocation.failures"),
+	}
+}
+
+// Check returns a RevokedError if the leaf of the verified chain was revoked
+// by its issuer, which is the next certificate of the chain. A chain without
+// an issuer is that of a self-signed certificate and cannot be revoked.
+func (rc *RevocationChecker) Check(chain []*x509.Certificate) error {
+	rc.checks.Inc(1)
+	if len(chain) < 2 {
+		return nil
+	}
+	cert, issuer := chain[0], chain[1]
+	revokedAt, revoked, err := rc.lookup(issuer, cert.SerialNumber.String())
+	if err != nil {
+		rc.failures.Inc(1)
+		if rc.failOpen {
+			log.Warningf("accepting certificate of user %s: %s", cert.Subject.CommonName, err)
+			return nil
+		}
+		return util.Errorf("unable to check revocation of certificate of user %s: %s", cert.Subject.CommonName, err)
+	}
+	if revoked {
+		rc.revoked.Inc(1)
+		return &RevokedError{
+			User:      cert.Subject.CommonName,
+			Serial:    cert.SerialNumber.String(),
+			RevokedAt: revokedAt,
+		}
+	}
+	return nil
+}
+
+// lookup returns whether the serial number is revoked by the CRL of issuer.
+func (rc *RevocationChecker) lookup(issuer *x509.Certificate, serial string) (time.Time, bool, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.maybeReloadLocked()
+	if rc.mu.err != nil {
+		return time.Time{}, false, rc.mu.err
+	}
+	if rc.mu.crl.HasExpired(time.Now()) {
+		return time.Time{}, false, util.Errorf("CRL %s expired at %s", rc.path, rc.mu.crl.TBSCertList.NextUpdate)
+	}
+	signed, ok := rc.mu.issuers[string(issuer.Raw)]
+	if !ok {
+		signed = issuer.CheckCRLSignature(rc.mu.crl) == nil
+		rc.mu.issuers[string(issuer.Raw)] = signed
+	}
+	if !signed {
+		return time.Time{}, false, util.Errorf("CRL %s is not signed by issuer %s", rc.path, issuer.Subject.CommonName)
+	}
+	revokedAt, revoked := rc.mu.revokedAt[serial]
+	return revokedAt, revoked, nil
+}
+
+// maybeReloadLocked reads the CRL file again if its modification time or size
+// changed since it was last read.
+func (rc *RevocationChecker) maybeReloadLocked() {
+	info, err := os.Stat(rc.path)
+	if err != nil {
+		rc.mu.crl, rc.mu.err = nil, err
+		rc.mu.modTime, rc.mu.size = time.Time{}, 0
+		return
+	}
+	if !rc.mu.modTime.IsZero() && info.ModTime().Equal(rc.mu.modTime) && info.Size() == rc.mu.size {
+		return
+	}
+	rc.mu.modTime, rc.mu.size = info.ModTime(), info.Size()
+	rc.mu.issuers = map[string]bool{}
+	rc.mu.crl, rc.mu.err = nil, nil
+	crlBytes, err := ioutil.ReadFile(rc.path)
+	if err != nil {
+		rc.mu.err = err
+		return
+	}
+	crl, err := x509.ParseCRL(crlBytes)
+	if err != nil {
+		rc.mu.err = util.Errorf("error parsing CRL %s: %s", rc.path, err)
+		return
+	}
+	rc.mu.crl = crl
+	rc.mu.revokedAt = make(map[string]time.Time, len(crl.TBSCertList.RevokedCertificates))
+	for _, revoked := range crl.TBSCertList.RevokedCertificates {
+		rc.mu.revokedAt[revoked.SerialNumber.String()] = revoked.RevocationTime
+	}
+	log.Infof("loaded CRL %s listing %d revoked certificates", rc.path, len(rc.mu.revokedAt))
+}
+
+// revocationChecker is consulted by GetCertificateUser if set.
+var revocationChecker struct {
+	sync.RWMutex
+	checker *RevocationChecker
+}
+
+// SetRevocationChecker makes GetCertificateUser reject revoked certificates,
+// as reported by checker. A nil checker disables revocation checks.
+func SetRevocationChecker(checker *RevocationChecker) {
+	revocationChecker.Lock()
+	defer revocationChecker.Unlock()
+	revocationChecker.checker = checker
+}
+
+// checkRevocation checks the verified chain with the revocation checker, if
+// any.
+func checkRevocation(chain []*x509.Certificate) error {
+	revocationChecker.RLock()
+	checker := revocationChecker.checker
+	revocationChecker.RUnlock()
+	if checker == nil {
+		return nil
+	}
+	return checker.Check(chain)
+}
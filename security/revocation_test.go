@@ -0,0 +1,146 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// TestRevocationChecker verifies that client certificates listed in a CRL are
+// rejected by GetCertificateUser, that changes to the CRL are picked up, and
+// that a missing CRL rejects certificates unless the checker fails open.
+func TestRevocationChecker(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	certsDir := util.CreateTempDir(t, "revocation_test")
+	defer util.CleanupDir(certsDir)
+
+	opts := security.CertOptions{KeySize: 512}
+	caDER, caKey, err := security.GenerateCA(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherDER, otherKey, err := security.GenerateCA(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCA, err := x509.ParseCertificate(otherDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	makeTLSState := func(user string) *tls.ConnectionState {
+		der, _, err := security.GenerateClientCert(caCert, caKey, opts, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+			VerifiedChains:   [][]*x509.Certificate{{cert, caCert}},
+		}
+	}
+	revokedState := makeTLSState("revoked")
+	validState := makeTLSState("valid")
+
+	crlPath := filepath.Join(certsDir, "ca.crl")
+	// writeCRL writes a CRL signed by the given CA, listing the certificates
+	// of the supplied connections as revoked.
+	writeCRL := func(issuer *x509.Certificate, key crypto.PrivateKey, states ...*tls.ConnectionState) {
+		var revoked []pkix.RevokedCertificate
+		for _, state := range states {
+			revoked = append(revoked, pkix.RevokedCertificate{
+				SerialNumber:   state.PeerCertificates[0].SerialNumber,
+				RevocationTime: time.Now(),
+			})
+		}
+		der, err := issuer.CreateCRL(rand.Reader, key, revoked, time.Now(), time.Now().Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		crlPEM := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+		if err := ioutil.WriteFile(crlPath, crlPEM, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, failOpen := range []bool{false, true} {
+		registry := metric.NewRegistry()
+		security.SetRevocationChecker(security.NewRevocationChecker(crlPath, failOpen, registry))
+		counts := func() [3]int64 {
+			var c [3]int64
+			for i, name := range []string{"checks", "revoked", "failures"} {
+				c[i] = registry.GetCounter("certs.revocation." + name).Count()
+			}
+			return c
+		}
+		// check verifies whether the certificate of the connection is accepted.
+		check := func(state *tls.ConnectionState, accepted bool) error {
+			user, err := security.GetCertificateUser(state)
+			if accepted && err != nil {
+				t.Errorf("failOpen=%t: expected certificate of %s to be accepted, got %s",
+					failOpen, state.PeerCertificates[0].Subject.CommonName, err)
+			} else if !accepted && err == nil {
+				t.Errorf("failOpen=%t: expected certificate of %s to be rejected", failOpen, user)
+			}
+			return err
+		}
+
+		writeCRL(caCert, caKey, revokedState)
+		if err := check(revokedState, false); err != nil {
+			if _, ok := err.(*security.RevokedError); !ok {
+				t.Errorf("failOpen=%t: expected a RevokedError, got %T: %s", failOpen, err, err)
+			}
+		}
+		check(validState, true)
+
+		// An updated CRL is picked up.
+		writeCRL(caCert, caKey)
+		check(revokedState, true)
+
+		// A CRL of another issuer, or a missing CRL, fails the checks.
+		writeCRL(otherCA, otherKey, revokedState)
+		check(validState, failOpen)
+		if err := os.Remove(crlPath); err != nil {
+			t.Fatal(err)
+		}
+		check(validState, failOpen)
+
+		if c, e := counts(), [3]int64{5, 1, 2}; c != e {
+			t.Errorf("failOpen=%t: expected checks, revoked and failures %v, got %v", failOpen, e, c)
+		}
+	}
+	security.SetRevocationChecker(nil)
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// A CertSource reads certificate related files by path, such as the embedded
+// test certificates. It returns an error for the files it doesn't have.
+type CertSource func(path string) ([]byte, error)
+
+// certSources holds the sources consulted, most recently pushed first, before
+// the file system when reading certificate related files. Each source is
+// kept behind a pointer so that it can be removed even if the same function
+// was pushed more than once.
+var certSources struct {
+	sync.Mutex
+	sources []*CertSource
+}
+
+// PushCertSource makes certificate related files be read from source before
+// the sources pushed previously and the file system. It returns a function
+// which removes source again, regardless of the sources pushed since; tests
+// should defer it.
+func PushCertSource(source CertSource) func() {
+	certSources.Lock()
+	defer certSources.Unlock()
+	s := &source
+	certSources.sources = append(certSources.sources, s)
+	return func() {
+		certSources.Lock()
+		defer certSources.Unlock()
+		for i, other := range certSources.sources {
+			if other == s {
+				certSources.sources = append(certSources.sources[:i], certSources.sources[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// DirCertSource returns a CertSource which serves the files of certsDir from
+// dir instead, where they exist. It allows tests to override some of the
+// files of a certificate directory, such as EmbeddedCertsDir, with files
+// generated on the fly.
+func DirCertSource(certsDir, dir string) CertSource {
+	certsDir = filepath.Clean(certsDir)
+	return func(path string) ([]byte, error) {
+		path = filepath.Clean(path)
+		if filepath.Dir(path) != certsDir {
+			return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+		}
+		return ioutil.ReadFile(filepath.Join(dir, filepath.Base(path)))
+	}
+}
+
+// readFile reads a certificate related file from the first source which has
+// it, falling back to the file system.
+func readFile(path string) ([]byte, error) {
+	certSources.Lock()
+	sources := make([]*CertSource, len(certSources.sources))
+	copy(sources, certSources.sources)
+	certSources.Unlock()
+
+	for i := len(sources) - 1; i >= 0; i-- {
+		if contents, err := (*sources[i])(path); err == nil {
+			return contents, nil
+		}
+	}
+	return ioutil.ReadFile(path)
+}
@@ -0,0 +1,93 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestCertSources verifies that the most recently pushed certificate source
+// which has a file takes precedence, and that removing a source restores the
+// sources pushed before it, in any order.
+func TestCertSources(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	// loadCA returns the raw CA certificate read from the embedded
+	// certificates directory.
+	loadCA := func() []byte {
+		certs, err := security.LoadCACertificates(security.EmbeddedCertsDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return certs[0].Raw
+	}
+	// makeCADir creates a directory holding a new CA certificate, which is
+	// returned with the directory.
+	makeCADir := func() (string, []byte) {
+		dir := util.CreateTempDir(t, "source_test")
+		if err := security.RunCreateCACert(dir, security.CertOptions{KeySize: 512}); err != nil {
+			t.Fatal(err)
+		}
+		certs, err := security.LoadCACertificates(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dir, certs[0].Raw
+	}
+	dir1, ca1 := makeCADir()
+	defer util.CleanupDir(dir1)
+	dir2, ca2 := makeCADir()
+	defer util.CleanupDir(dir2)
+
+	embedded := loadCA()
+	if bytes.Equal(embedded, ca1) || bytes.Equal(embedded, ca2) {
+		t.Fatal("expected the generated CA certificates to differ from the embedded one")
+	}
+
+	pop1 := security.PushCertSource(security.DirCertSource(security.EmbeddedCertsDir, dir1))
+	if !bytes.Equal(loadCA(), ca1) {
+		t.Error("expected the CA certificate of the first override")
+	}
+	// The files which aren't overridden are still read from the embedded
+	// certificates.
+	if _, err := security.LoadClientTLSConfig(security.EmbeddedCertsDir, security.RootUser); err != nil {
+		t.Error(err)
+	}
+
+	pop2 := security.PushCertSource(security.DirCertSource(security.EmbeddedCertsDir, dir2))
+	if !bytes.Equal(loadCA(), ca2) {
+		t.Error("expected the CA certificate of the second override")
+	}
+
+	// Removing the first source leaves the second in place.
+	pop1()
+	if !bytes.Equal(loadCA(), ca2) {
+		t.Error("expected the CA certificate of the second override after removing the first")
+	}
+	pop2()
+	if !bytes.Equal(loadCA(), embedded) {
+		t.Error("expected the embedded CA certificate after removing the overrides")
+	}
+	// Removing a source again has no effect.
+	pop1()
+	if !bytes.Equal(loadCA(), embedded) {
+		t.Error("expected the embedded CA certificate")
+	}
+}
@@ -22,8 +22,10 @@ package security
 import (
 	"crypto/tls"
 	"crypto/x509"
-	"io/ioutil"
+	"encoding/pem"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/util"
 )
@@ -33,39 +35,122 @@ const (
 	EmbeddedCertsDir = "test_certs"
 )
 
-// readFileFn is used to mock out file system access during tests.
-var readFileFn = ioutil.ReadFile
+// tlsVersions maps the names of the TLS versions which can be required to
+// their identifiers.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+}
+
+// cipherSuites maps the names of the cipher suites which can be allowed to
+// their identifiers.
+var cipherSuites = map[string]uint16{
+	"TLS_RSA_WITH_RC4_128_SHA":                tls.TLS_RSA_WITH_RC4_128_SHA,
+	"TLS_RSA_WITH_3DES_EDE_CBC_SHA":           tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
+	"TLS_RSA_WITH_AES_128_CBC_SHA":            tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_RSA_WITH_AES_256_CBC_SHA":            tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_RC4_128_SHA":        tls.TLS_ECDHE_ECDSA_WITH_RC4_128_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_RC4_128_SHA":          tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA,
+	"TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA":     tls.TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":   tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256": tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":   tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384": tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+}
+
+// defaultCipherSuites are the cipher suites allowed unless TLSOptions specify
+// otherwise: the AEAD suites with forward secrecy, for RSA and ECDSA keys.
+var defaultCipherSuites = []uint16{
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+}
+
+// TLSOptions restrict the protocol versions and cipher suites of the TLS
+// connections made and accepted with a TLS config. By default, TLS 1.2 and
+// the AEAD suites with forward secrecy are required.
+type TLSOptions struct {
+	// MinVersion is the name of the minimum TLS version, "1.0", "1.1" or
+	// "1.2". If empty, the default applies.
+	MinVersion string
+	// CipherSuites is a comma-separated list of the names of the allowed
+	// cipher suites, such as "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". If
+	// empty, the default applies.
+	CipherSuites string
+	// Compat replaces the defaults by TLS 1.0 and all the cipher suites
+	// supported by Go, for compatibility with older clients.
+	Compat bool
+}
+
+// Apply restricts the versions and cipher suites of the TLS config according
+// to the options. Unknown version or suite names yield an error listing the
+// known ones.
+func (o TLSOptions) Apply(config *tls.Config) error {
+	if o.MinVersion != "" {
+		version, ok := tlsVersions[o.MinVersion]
+		if !ok {
+			return util.Errorf("unknown TLS version %q; supported versions: %s",
+				o.MinVersion, strings.Join(sortedKeys(tlsVersions), ", "))
+		}
+		config.MinVersion = version
+	} else if o.Compat {
+		config.MinVersion = tls.VersionTLS10
+	}
 
-// SetReadFileFn allows to switch out ioutil.ReadFile by a mock
-// for testing purposes.
-func SetReadFileFn(f func(string) ([]byte, error)) {
-	readFileFn = f
+	if o.CipherSuites != "" {
+		var suites []uint16
+		for _, name := range strings.Split(o.CipherSuites, ",") {
+			name = strings.TrimSpace(name)
+			suite, ok := cipherSuites[name]
+			if !ok {
+				return util.Errorf("unknown cipher suite %q; supported suites: %s",
+					name, strings.Join(sortedKeys(cipherSuites), ", "))
+			}
+			suites = append(suites, suite)
+		}
+		config.CipherSuites = suites
+	} else if o.Compat {
+		// Nil selects all the suites supported by Go.
+		config.CipherSuites = nil
+	}
+	return nil
 }
 
-// ResetReadFileFn is the counterpart to SetReadFileFn, restoring the
-// original behaviour for loading certificate related data from disk.
-func ResetReadFileFn() {
-	readFileFn = ioutil.ReadFile
+// sortedKeys returns the sorted keys of the map.
+func sortedKeys(m map[string]uint16) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // LoadServerTLSConfig creates a server TLSConfig by loading our keys and certs from the
 // specified directory. The directory must contain the following files:
-// - ca.crt   -- the certificate of the cluster CA
+// - ca.crt   -- the certificate of the cluster CA; may be a bundle of several
 // - node.server.crt -- the server certificate of this node; should be signed by the CA
 // - node.server.key -- the certificate key
+// A certificate issued by intermediate CAs is followed by their certificates.
 // If the path is prefixed with "embedded=", load the embedded certs.
 // We should never have username != "node", but this is a good way to
 // catch tests that use the wrong users.
 func LoadServerTLSConfig(certDir, username string) (*tls.Config, error) {
-	certPEM, err := readFileFn(filepath.Join(certDir, username+".server.crt"))
+	certPEM, err := readFile(filepath.Join(certDir, username+".server.crt"))
 	if err != nil {
 		return nil, err
 	}
-	keyPEM, err := readFileFn(filepath.Join(certDir, username+".server.key"))
+	keyPEM, err := readFile(filepath.Join(certDir, username+".server.key"))
 	if err != nil {
 		return nil, err
 	}
-	caPEM, err := readFileFn(filepath.Join(certDir, "ca.crt"))
+	caPEM, err := readFile(filepath.Join(certDir, "ca.crt"))
 	if err != nil {
 		return nil, err
 	}
@@ -96,18 +181,72 @@ func newServerTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
 		RootCAs:    certPool,
 		ClientCAs:  certPool,
 
-		// Use the default cipher suite from golang (RC4 is going away in 1.5).
-		// Prefer the server-specified suite.
+		// Restrict the cipher suites to modern AEAD suites unless TLSOptions
+		// relax them. Prefer the server-specified suite.
+		CipherSuites:             defaultCipherSuites,
 		PreferServerCipherSuites: true,
 
-		// TLS 1.1 and 1.2 support is crappy out there. Let's use 1.0.
-		MinVersion: tls.VersionTLS10,
+		MinVersion: tls.VersionTLS12,
 
 		// Should we disable session resumption? This may break forward secrecy.
 		// SessionTicketsDisabled: true,
 	}, nil
 }
 
+// LoadCACertificates parses the certificates of the cluster CA, ca.crt, in the
+// specified directory. The file may contain several certificates, for
+// instance while the CA is being replaced.
+func LoadCACertificates(certDir string) ([]*x509.Certificate, error) {
+	caPath := filepath.Join(certDir, "ca.crt")
+	caPEM, err := readFile(caPath)
+	if err != nil {
+		return nil, err
+	}
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(caPEM); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, util.Errorf("error parsing CA certificate %s: %s", caPath, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, util.Errorf("no CA certificate found in %s", caPath)
+	}
+	return certs, nil
+}
+
+// VerifyTLSConfig verifies that the certificates of a TLS config created by
+// LoadServerTLSConfig or LoadClientTLSConfig are valid and signed by its CA.
+// That the certificates match their keys is verified when loading them.
+func VerifyTLSConfig(config *tls.Config) error {
+	for _, cert := range config.Certificates {
+		certs := make([]*x509.Certificate, 0, len(cert.Certificate))
+		for _, der := range cert.Certificate {
+			c, err := x509.ParseCertificate(der)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, c)
+		}
+		opts := x509.VerifyOptions{
+			Roots:         config.RootCAs,
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}
+		for _, c := range certs[1:] {
+			opts.Intermediates.AddCert(c)
+		}
+		if _, err := certs[0].Verify(opts); err != nil {
+			return util.Errorf("invalid certificate %q: %s", certs[0].Subject.CommonName, err)
+		}
+	}
+	return nil
+}
+
 // LoadInsecureTLSConfig creates a TLSConfig that disables TLS.
 func LoadInsecureTLSConfig() *tls.Config {
 	return nil
@@ -120,15 +259,15 @@ func LoadInsecureTLSConfig() *tls.Config {
 // - <username>.client.key -- the certificate key
 // If the path is prefixed with "embedded=", load the embedded certs.
 func LoadClientTLSConfig(certDir, username string) (*tls.Config, error) {
-	certPEM, err := readFileFn(ClientCertPath(certDir, username))
+	certPEM, err := readFile(ClientCertPath(certDir, username))
 	if err != nil {
 		return nil, err
 	}
-	keyPEM, err := readFileFn(ClientKeyPath(certDir, username))
+	keyPEM, err := readFile(ClientKeyPath(certDir, username))
 	if err != nil {
 		return nil, err
 	}
-	caPEM, err := readFileFn(filepath.Join(certDir, "ca.crt"))
+	caPEM, err := readFile(filepath.Join(certDir, "ca.crt"))
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +295,7 @@ func newClientTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
 	return &tls.Config{
 		Certificates: []tls.Certificate{cert},
 		RootCAs:      certPool,
+		CipherSuites: defaultCipherSuites,
 		MinVersion:   tls.VersionTLS12,
 	}, nil
 }
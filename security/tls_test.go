@@ -17,10 +17,12 @@
 package security_test
 
 import (
+	"crypto/tls"
 	"crypto/x509"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -67,3 +69,123 @@ func verifyX509Cert(cert *x509.Certificate, dnsName string, roots *x509.CertPool
 	_, err := cert.Verify(verifyOptions)
 	return err
 }
+
+func TestTLSOptionsApply(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		options      security.TLSOptions
+		minVersion   uint16
+		cipherSuites []uint16
+		success      bool
+	}{
+		{security.TLSOptions{}, tls.VersionTLS12, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}, true},
+		{security.TLSOptions{Compat: true}, tls.VersionTLS10, nil, true},
+		{security.TLSOptions{MinVersion: "1.1", Compat: true}, tls.VersionTLS11, nil, true},
+		{security.TLSOptions{
+			CipherSuites: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+			Compat:       true,
+		}, tls.VersionTLS10, []uint16{
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		}, true},
+		{security.TLSOptions{MinVersion: "1.3"}, 0, nil, false},
+		{security.TLSOptions{CipherSuites: "TLS_RSA_WITH_NULL_SHA"}, 0, nil, false},
+	}
+	for i, tc := range testCases {
+		config := &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+		}
+		err := tc.options.Apply(config)
+		if (err == nil) != tc.success {
+			t.Errorf("%d: expected success=%t, got err=%v", i, tc.success, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if config.MinVersion != tc.minVersion {
+			t.Errorf("%d: expected min version %x, got %x", i, tc.minVersion, config.MinVersion)
+		}
+		if len(config.CipherSuites) != len(tc.cipherSuites) {
+			t.Errorf("%d: expected cipher suites %v, got %v", i, tc.cipherSuites, config.CipherSuites)
+			continue
+		}
+		for j := range tc.cipherSuites {
+			if config.CipherSuites[j] != tc.cipherSuites[j] {
+				t.Errorf("%d: expected cipher suites %v, got %v", i, tc.cipherSuites, config.CipherSuites)
+				break
+			}
+		}
+	}
+}
+
+// TestTLSOptionsNegotiation verifies that servers reject clients which don't
+// support the configured TLS version and negotiate the configured cipher
+// suites.
+func TestTLSOptionsNegotiation(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	clientConfig, err := security.LoadClientTLSConfig(security.EmbeddedCertsDir, security.RootUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// dial connects to the server with a client config allowing any version
+	// up to maxVersion and any cipher suite.
+	dial := func(s *server.TestServer, maxVersion uint16) (tls.ConnectionState, error) {
+		config := *clientConfig
+		config.MinVersion = tls.VersionTLS10
+		config.MaxVersion = maxVersion
+		config.CipherSuites = nil
+		conn, err := tls.Dial("tcp", s.ServingAddr(), &config)
+		if err != nil {
+			return tls.ConnectionState{}, err
+		}
+		defer conn.Close()
+		return conn.ConnectionState(), nil
+	}
+
+	testCases := []struct {
+		options security.TLSOptions
+		// A client limited to TLS 1.0 connects only if it is allowed.
+		tls10 bool
+		// The cipher suites which may be negotiated with a TLS 1.2 client.
+		suites []uint16
+	}{
+		{security.TLSOptions{}, false, []uint16{
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		}},
+		{security.TLSOptions{CipherSuites: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"}, false, []uint16{
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		}},
+		{security.TLSOptions{Compat: true, CipherSuites: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA"}, true, []uint16{
+			tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		}},
+	}
+	for i, tc := range testCases {
+		ctx := server.NewTestContext()
+		ctx.TLSOptions = tc.options
+		s := &server.TestServer{Ctx: ctx}
+		if err := s.Start(); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := dial(s, tls.VersionTLS10); (err == nil) != tc.tls10 {
+			t.Errorf("%d: expected TLS 1.0 client success=%t, got err=%v", i, tc.tls10, err)
+		}
+		state, err := dial(s, tls.VersionTLS12)
+		if err != nil {
+			t.Errorf("%d: expected TLS 1.2 client success, got %v", i, err)
+		} else {
+			negotiated := false
+			for _, suite := range tc.suites {
+				negotiated = negotiated || state.CipherSuite == suite
+			}
+			if state.Version != tls.VersionTLS12 || !negotiated {
+				t.Errorf("%d: expected TLS 1.2 with one of the cipher suites %x, got version %x and suite %x",
+					i, tc.suites, state.Version, state.CipherSuite)
+			}
+		}
+		s.Stop()
+	}
+}
@@ -19,6 +19,7 @@ package security
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -26,6 +27,7 @@ import (
 	"encoding/pem"
 	"math/big"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -33,25 +35,65 @@ import (
 
 // Utility to generate x509 certificates, both CA and not.
 // This is mostly based on http://golang.org/src/crypto/tls/generate_cert.go
-// Most fields and settings are hard-coded. TODO(marc): allow customization.
+// The key type and size as well as the lifetime of certificates are given by
+// CertOptions, the remaining fields and settings are hard-coded.
 
 const (
 	// Make certs valid a day before to handle clock issues, specifically
 	// boot2docker: https://github.com/boot2docker/boot2docker/issues/69
-	validFrom     = -time.Hour * 24
-	validFor      = time.Hour * 24 * 365
-	maxPathLength = 1
-	caCommonName  = "Cockroach CA"
+	validFrom                = -time.Hour * 24
+	maxPathLength            = 1
+	caCommonName             = "Cockroach CA"
+	intermediateCACommonName = "Cockroach Intermediate CA"
+
+	// DefaultCertLifetime is the lifetime of certificates generated without
+	// an explicit lifetime.
+	DefaultCertLifetime = time.Hour * 24 * 365
 )
 
-// generateKeyPair returns a random 'keySize' bit RSA key pair.
-func generateKeyPair(keySize int) (crypto.PrivateKey, crypto.PublicKey, error) {
-	private, err := rsa.GenerateKey(rand.Reader, keySize)
+// curves are the elliptic curves ECDSA keys can be generated for.
+var curves = map[string]elliptic.Curve{
+	"P224": elliptic.P224(),
+	"P256": elliptic.P256(),
+	"P384": elliptic.P384(),
+	"P521": elliptic.P521(),
+}
+
+// CertOptions specify the keys and lifetime of generated certificates.
+type CertOptions struct {
+	// KeySize is the size in bits of generated RSA keys.
+	KeySize int
+	// Curve is the name of the elliptic curve of generated ECDSA keys, one of
+	// "P224", "P256", "P384" or "P521". RSA keys are generated if it is empty.
+	Curve string
+	// Lifetime is the duration from now for which generated certificates are
+	// valid. If zero, DefaultCertLifetime is used.
+	Lifetime time.Duration
+	// Intermediate specifies that node and client certificates are signed by
+	// the intermediate CA instead of the root CA, and that an intermediate CA
+	// is created along with the root CA.
+	Intermediate bool
+}
+
+// generateKeyPair returns a random key pair: an ECDSA key pair if a curve is
+// specified and an RSA key pair of the specified size otherwise.
+func generateKeyPair(opts CertOptions) (crypto.PrivateKey, crypto.PublicKey, error) {
+	if opts.Curve == "" {
+		private, err := rsa.GenerateKey(rand.Reader, opts.KeySize)
+		if err != nil {
+			return nil, nil, err
+		}
+		return private, private.Public(), nil
+	}
+	curve, ok := curves[opts.Curve]
+	if !ok {
+		return nil, nil, util.Errorf("unknown curve %q", opts.Curve)
+	}
+	private, err := ecdsa.GenerateKey(curve, rand.Reader)
 	if err != nil {
 		return nil, nil, err
 	}
-	public := private.Public()
-	return private, public, err
+	return private, private.Public(), nil
 }
 
 // privateKeyPEMBlock generates a PEM block from a private key.
@@ -77,7 +119,13 @@ func certificatePEMBlock(cert []byte) (*pem.Block, error) {
 
 // newTemplate returns a partially-filled template.
 // It should be further populated based on whether the cert is for a CA or node.
-func newTemplate(commonName string) (*x509.Certificate, error) {
+func newTemplate(commonName string, lifetime time.Duration) (*x509.Certificate, error) {
+	if lifetime == 0 {
+		lifetime = DefaultCertLifetime
+	} else if lifetime < 0 {
+		return nil, util.Errorf("invalid certificate lifetime %s", lifetime)
+	}
+
 	// Generate a random serial number.
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
@@ -85,8 +133,9 @@ func newTemplate(commonName string) (*x509.Certificate, error) {
 		return nil, err
 	}
 
-	notBefore := time.Now().Add(validFrom)
-	notAfter := notBefore.Add(validFor)
+	now := time.Now()
+	notBefore := now.Add(validFrom)
+	notAfter := now.Add(lifetime)
 
 	cert := &x509.Certificate{
 		SerialNumber: serialNumber,
@@ -105,13 +154,13 @@ func newTemplate(commonName string) (*x509.Certificate, error) {
 
 // GenerateCA generates a CA certificate and returns the cert bytes as
 // well as the private key used to generate the certificate.
-func GenerateCA(keySize int) ([]byte, crypto.PrivateKey, error) {
-	privateKey, publicKey, err := generateKeyPair(keySize)
+func GenerateCA(opts CertOptions) ([]byte, crypto.PrivateKey, error) {
+	privateKey, publicKey, err := generateKeyPair(opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	template, err := newTemplate(caCommonName)
+	template, err := newTemplate(caCommonName, opts.Lifetime)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,32 +180,57 @@ func GenerateCA(keySize int) ([]byte, crypto.PrivateKey, error) {
 	return certBytes, privateKey, nil
 }
 
+// GenerateIntermediateCA generates an intermediate CA certificate signed by
+// the given CA and returns the cert bytes as well as the private key used to
+// generate the certificate. The intermediate CA can only sign end-entity
+// certificates.
+func GenerateIntermediateCA(caCert *x509.Certificate, caKey crypto.PrivateKey, opts CertOptions) (
+	[]byte, crypto.PrivateKey, error) {
+	privateKey, publicKey, err := generateKeyPair(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := newTemplate(intermediateCACommonName, opts.Lifetime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Set CA-specific fields.
+	template.BasicConstraintsValid = true
+	template.IsCA = true
+	template.MaxPathLen = 0
+	template.MaxPathLenZero = true
+	template.KeyUsage |= x509.KeyUsageCertSign
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, caCert, publicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certBytes, privateKey, nil
+}
+
 // GenerateServerCert generates a server certificate and returns the cert bytes as
 // well as the private key used to generate the certificate.
-// Takes in the CA cert and key, the size of the key to generate, and the list
-// of hosts/ip addresses this certificate applies to.
-func GenerateServerCert(caCert *x509.Certificate, caKey crypto.PrivateKey, keySize int, hosts []string) (
+// Takes in the CA cert and key, the options of the certificate, and the list
+// of hosts/ip addresses this certificate applies to (see addHosts).
+func GenerateServerCert(caCert *x509.Certificate, caKey crypto.PrivateKey, opts CertOptions, hosts []string) (
 	[]byte, crypto.PrivateKey, error) {
-	privateKey, publicKey, err := generateKeyPair(keySize)
+	privateKey, publicKey, err := generateKeyPair(opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	template, err := newTemplate(NodeUser)
+	template, err := newTemplate(NodeUser, opts.Lifetime)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Only server authentication is allowed.
 	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
-	if hosts != nil {
-		for _, h := range hosts {
-			if ip := net.ParseIP(h); ip != nil {
-				template.IPAddresses = append(template.IPAddresses, ip)
-			} else {
-				template.DNSNames = append(template.DNSNames, h)
-			}
-		}
+	if err := addHosts(template, hosts); err != nil {
+		return nil, nil, err
 	}
 
 	certBytes, err := x509.CreateCertificate(rand.Reader, template, caCert, publicKey, caKey)
@@ -167,14 +241,37 @@ func GenerateServerCert(caCert *x509.Certificate, caKey crypto.PrivateKey, keySi
 	return certBytes, privateKey, nil
 }
 
+// addHosts places the hosts in the subject alternative names of the
+// template, as IP addresses or DNS names. Clients verify the hostname they
+// connect to against these names only, so at least one host is required. A
+// DNS name may start with a "*" label, which matches any single label.
+func addHosts(template *x509.Certificate, hosts []string) error {
+	if len(hosts) == 0 {
+		return util.Errorf("no hosts specified")
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+			continue
+		}
+		name := strings.TrimPrefix(h, "*.")
+		if name == "" || strings.Contains(name, "*") || net.ParseIP(name) != nil {
+			return util.Errorf("invalid host %q", h)
+		}
+		template.DNSNames = append(template.DNSNames, h)
+	}
+	return nil
+}
+
 // GenerateClientCert generates a client certificate and returns the cert bytes as
 // well as the private key used to generate the certificate.
 // The CA cert and private key should be passed in.
-// 'user' is the unique username stored in the Subject.CommonName field.
-func GenerateClientCert(caCert *x509.Certificate, caKey crypto.PrivateKey, keySize int, name string) (
+// 'name' is the unique username stored in the Subject.CommonName field, which
+// is where GetCertificateUser expects it.
+func GenerateClientCert(caCert *x509.Certificate, caKey crypto.PrivateKey, opts CertOptions, name string) (
 	[]byte, crypto.PrivateKey, error) {
 
-	privateKey, publicKey, err := generateKeyPair(keySize)
+	privateKey, publicKey, err := generateKeyPair(opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -184,7 +281,7 @@ func GenerateClientCert(caCert *x509.Certificate, caKey crypto.PrivateKey, keySi
 		return nil, nil, util.Errorf("name cannot be empty")
 	}
 
-	template, err := newTemplate(name)
+	template, err := newTemplate(name, opts.Lifetime)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -18,11 +18,17 @@
 package server
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	// This is imported for its side-effect of registering expvar
 	// endpoints with the http.DefaultServeMux.
 	_ "expvar"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	// Register the net/trace endpoint with http.DefaultServeMux.
@@ -32,7 +38,15 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
 
@@ -54,10 +68,37 @@ const (
 	// debugEndpoint is the prefix of golang's standard debug functionality
 	// for access to exported vars and pprof tools.
 	debugEndpoint = "/debug/"
+	// debugStopperPath is the endpoint listing the tasks running on the
+	// server's stopper.
+	debugStopperPath = debugEndpoint + "stopper"
 	// healthPath is the health endpoint.
 	healthPath = adminEndpoint + "health"
 	// quitPath is the quit endpoint.
 	quitPath = adminEndpoint + "quit"
+	// drainPath is the endpoint which drains the node before shutting it down.
+	drainPath = adminEndpoint + "drain"
+	// eventsPath is the endpoint for retrieving cluster events.
+	eventsPath = adminEndpoint + "v1/events"
+	// timeSeriesPath is the endpoint for querying cluster-wide time series.
+	timeSeriesPath = adminEndpoint + "v1/timeseries"
+	// decommissionPath is the endpoint for decommissioning nodes and
+	// querying the progress of decommissioning.
+	decommissionPath = adminEndpoint + "v1/decommission"
+	// recommissionPath is the endpoint for recommissioning nodes.
+	recommissionPath = adminEndpoint + "v1/recommission"
+	// maintenancePath is the endpoint for querying and setting the
+	// maintenance mode of the node.
+	maintenancePath = adminEndpoint + "v1/maintenance"
+	// zonesPath is the endpoint for listing zone configs and, below it,
+	// getting, setting and removing the zone config of an object by name.
+	zonesPath = adminEndpoint + "v1/zones"
+
+	// defaultEventsLimit is the number of events returned by the events
+	// endpoint when no limit is specified.
+	defaultEventsLimit = 100
+	// maxEventsLimit is the maximum number of events which can be returned by
+	// a single request to the events endpoint.
+	maxEventsLimit = 1000
 )
 
 // An actionHandler is an interface which provides Get, Put & Delete
@@ -71,23 +112,64 @@ type actionHandler interface {
 // A adminServer provides a RESTful HTTP API to administration of
 // the cockroach cluster.
 type adminServer struct {
-	db      *client.DB    // Key-value database client
-	stopper *stop.Stopper // Used to shutdown the server
-	mux     *http.ServeMux
+	db       *client.DB    // Key-value database client
+	stopper  *stop.Stopper // Used to shutdown the server
+	executor sql.InternalExecutor
+	tsDB     *ts.DB                                           // Time series database
+	drain    func(DrainOptions, func(string, ...interface{})) // Drains the server
+	mux      *http.ServeMux
+	debug    http.Handler // Serves /debug/, possibly behind authentication
+
+	// decommission marks nodes as decommissioning or recommissions them.
+	decommission func([]roachpb.NodeID, bool) error
+	// decommissionProgress reports the progress of decommissioning nodes.
+	decommissionProgress func() ([]DecommissionProgress, error)
+	// setMaintenance puts the node into, or takes it out of, maintenance mode.
+	setMaintenance func(bool)
+	// inMaintenance returns whether the node is in maintenance mode.
+	inMaintenance func() bool
 }
 
 // newAdminServer allocates and returns a new REST server for
-// administrative APIs.
-func newAdminServer(db *client.DB, stopper *stop.Stopper) *adminServer {
+// administrative APIs. Requests are authorized by auth.
+func newAdminServer(db *client.DB, stopper *stop.Stopper, leaseMgr *sql.LeaseManager,
+	tsDB *ts.DB, auth *authorizer, unsafeDebugEndpoints bool, drain func(DrainOptions, func(string, ...interface{})),
+	decommission func([]roachpb.NodeID, bool) error,
+	decommissionProgress func() ([]DecommissionProgress, error),
+	setMaintenance func(bool), inMaintenance func() bool) *adminServer {
 	server := &adminServer{
-		db:      db,
-		stopper: stopper,
-		mux:     http.NewServeMux(),
+		db:       db,
+		stopper:  stopper,
+		executor: sql.InternalExecutor{LeaseManager: leaseMgr},
+		tsDB:     tsDB,
+		drain:    drain,
+		mux:      http.NewServeMux(),
+
+		decommission:         decommission,
+		decommissionProgress: decommissionProgress,
+		setMaintenance:       setMaintenance,
+		inMaintenance:        inMaintenance,
+	}
+	debugMux := http.NewServeMux()
+	debugMux.Handle(debugEndpoint, http.DefaultServeMux)
+	debugMux.HandleFunc(debugStopperPath, server.handleDebugStopper)
+	server.debug = debugMux
+	if !unsafeDebugEndpoints {
+		server.debug = requireClientCert(server.debug)
 	}
 
 	server.mux.HandleFunc(debugEndpoint, server.handleDebug)
-	server.mux.HandleFunc(healthPath, server.handleHealth)
-	server.mux.HandleFunc(quitPath, server.handleQuit)
+	server.mux.Handle(healthPath, auth.requireFunc(publicAccess, server.handleHealth))
+	server.mux.Handle(quitPath, auth.requireFunc(adminAccess, server.handleQuit))
+	server.mux.Handle(drainPath, auth.requireFunc(adminAccess, server.handleDrain))
+	server.mux.Handle(eventsPath, auth.requireFunc(readAccess, server.handleEvents))
+	// Time series queries are POSTed, but don't modify anything.
+	server.mux.Handle(timeSeriesPath, auth.requireFunc(readAccess, server.handleTimeSeries))
+	server.mux.Handle(decommissionPath, auth.requireFunc(readWriteAccess, server.handleDecommission))
+	server.mux.Handle(recommissionPath, auth.requireFunc(adminAccess, server.handleRecommission))
+	server.mux.Handle(maintenancePath, auth.requireFunc(readWriteAccess, server.handleMaintenance))
+	server.mux.Handle(zonesPath, auth.requireFunc(readWriteAccess, server.handleZones))
+	server.mux.Handle(zonesPath+"/", auth.requireFunc(readWriteAccess, server.handleZones))
 	return server
 }
 
@@ -113,10 +195,568 @@ func (s *adminServer) handleQuit(w http.ResponseWriter, r *http.Request) {
 	}()
 }
 
+// handleDrain drains the server and then shuts it down in response to a POST
+// request. The progress of each phase of the drain is streamed in the
+// response, which is terminated by "ok" once the server is about to stop. The
+// "sql_timeout", "lease_timeout" and "flush_timeout" query parameters override
+// the bounds of the corresponding phases (see DefaultDrainOptions) and are
+// parsed by time.ParseDuration.
+func (s *adminServer) handleDrain(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	opts := DefaultDrainOptions
+	for _, param := range []struct {
+		name string
+		dest *time.Duration
+	}{
+		{"sql_timeout", &opts.SQLTimeout},
+		{"lease_timeout", &opts.LeaseTimeout},
+		{"flush_timeout", &opts.FlushTimeout},
+	} {
+		if str := r.URL.Query().Get(param.name); str != "" {
+			d, err := time.ParseDuration(str)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid %s: %s", param.name, err), http.StatusBadRequest)
+				return
+			}
+			*param.dest = d
+		}
+	}
+
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	flusher, _ := w.(http.Flusher)
+	s.drain(opts, func(format string, args ...interface{}) {
+		log.Infof(format, args...)
+		fmt.Fprintf(w, format+"\n", args...)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	})
+	fmt.Fprintln(w, "ok")
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		s.stopper.Stop()
+	}()
+}
+
+// decommissionResponse is the response to a decommission progress request.
+type decommissionResponse struct {
+	Nodes []DecommissionProgress `json:"nodes"`
+}
+
+// handleDecommission marks the nodes listed in the comma-separated "nodes"
+// query parameter of a POST request as decommissioning. A GET request returns
+// the number of replicas remaining on the stores of each node which is being,
+// or has been, decommissioned.
+func (s *adminServer) handleDecommission(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		progress, err := s.decommissionProgress()
+		if err != nil {
+			log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp := decommissionResponse{Nodes: progress}
+		if resp.Nodes == nil {
+			resp.Nodes = []DecommissionProgress{}
+		}
+		respondAsJSON(w, r, resp)
+	case "POST":
+		s.handleCommission(w, r, true)
+	default:
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+	}
+}
+
+// handleRecommission recommissions the nodes listed in the comma-separated
+// "nodes" query parameter of a POST request. Nodes which have already been
+// fully decommissioned cannot be recommissioned.
+func (s *adminServer) handleRecommission(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	s.handleCommission(w, r, false)
+}
+
+func (s *adminServer) handleCommission(w http.ResponseWriter, r *http.Request, decommission bool) {
+	nodeIDs, err := parseNodeIDs(r.URL.Query().Get("nodes"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("nodes could not be parsed: %s", err), http.StatusBadRequest)
+		return
+	}
+	if err := s.decommission(nodeIDs, decommission); err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	fmt.Fprintln(w, "ok")
+}
+
+// parseNodeIDs parses a non-empty, comma-separated list of node IDs.
+func parseNodeIDs(str string) ([]roachpb.NodeID, error) {
+	if len(str) == 0 {
+		return nil, util.Errorf("no nodes specified")
+	}
+	var nodeIDs []roachpb.NodeID
+	for _, s := range strings.Split(str, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		if id <= 0 {
+			return nil, util.Errorf("invalid node ID %d", id)
+		}
+		nodeIDs = append(nodeIDs, roachpb.NodeID(id))
+	}
+	return nodeIDs, nil
+}
+
+// handleMaintenance responds to GET requests with whether the node is in
+// maintenance mode ("true" or "false"). POST requests set the mode to the
+// value of the "enable" query parameter.
+func (s *adminServer) handleMaintenance(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+	case "POST":
+		enable, err := strconv.ParseBool(r.URL.Query().Get("enable"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("enable could not be parsed: %s", err), http.StatusBadRequest)
+			return
+		}
+		s.setMaintenance(enable)
+	default:
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	fmt.Fprintln(w, strconv.FormatBool(s.inMaintenance()))
+}
+
 // handleDebug passes requests with the debugPathPrefix onto the default
 // serve mux, which is preconfigured (by import of expvar and net/http/pprof)
 // to serve endpoints which access exported variables and pprof tools.
+// Unless the server was started with unsafe debug endpoints, the request
+// must carry a valid client certificate.
 func (s *adminServer) handleDebug(w http.ResponseWriter, r *http.Request) {
-	handler, _ := http.DefaultServeMux.Handler(r)
-	handler.ServeHTTP(w, r)
+	s.debug.ServeHTTP(w, r)
+}
+
+// handleDebugStopper lists the number of running tasks of the server's
+// stopper by name, which helps to find the tasks holding up a shutdown.
+func (s *adminServer) handleDebugStopper(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	fmt.Fprintf(w, "%d tasks running:\n%s\n", s.stopper.NumTasks(), s.stopper.RunningTasks())
+}
+
+// requireClientCert wraps handler, rejecting requests which were not made
+// with a verified client certificate. Wrapping the whole mux (rather than
+// each handler) covers everything registered with it, including handlers
+// registered by imported packages.
+func requireClientCert(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := security.GetCertificateUser(r.TLS); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// eventEntry is a single event returned by the events endpoint. Details
+// contains the decoded info for event types with a known info format.
+type eventEntry struct {
+	Timestamp   time.Time        `json:"timestamp"`
+	EventType   sql.EventLogType `json:"eventType"`
+	TargetID    int64            `json:"targetID"`
+	ReportingID int64            `json:"reportingID"`
+	Info        string           `json:"info,omitempty"`
+	Details     interface{}      `json:"details,omitempty"`
+}
+
+// eventsResponse is the response of the events endpoint. If more events may
+// be available, Next is set to the cursor which should be passed as the
+// "before" parameter to retrieve the next (older) page of events.
+type eventsResponse struct {
+	Events []eventEntry `json:"events"`
+	Next   string       `json:"next,omitempty"`
+}
+
+// formatEventsCursor returns the cursor of the events page which ends with
+// the event of the given timestamp and unique ID. Events recorded in the same
+// transaction share its timestamp, so the cursor includes the unique ID which
+// orders them in the event log.
+func formatEventsCursor(timestamp time.Time, uniqueID []byte) string {
+	return fmt.Sprintf("%d_%s", timestamp.UnixNano(), hex.EncodeToString(uniqueID))
+}
+
+// parseEventsCursor parses a cursor formatted by formatEventsCursor. A cursor
+// without a unique ID, expressed in nanoseconds since the epoch, is accepted
+// too and has an empty unique ID.
+func parseEventsCursor(cursor string) (time.Time, []byte, error) {
+	nanosStr, uniqueIDStr := cursor, ""
+	if i := strings.IndexByte(cursor, '_'); i >= 0 {
+		nanosStr, uniqueIDStr = cursor[:i], cursor[i+1:]
+	}
+	nanos, err := strconv.ParseInt(nanosStr, 10, 64)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	uniqueID, err := hex.DecodeString(uniqueIDStr)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return time.Unix(0, nanos).UTC(), uniqueID, nil
+}
+
+// handleEvents returns recent events from the cluster event log in reverse
+// chronological order. The "type" query parameter filters the events to those
+// of the given type, and "target_id" to those with the given target ID (e.g. a
+// table ID for table events). The "before" query parameter is a cursor, made
+// of a timestamp in nanoseconds since the epoch optionally followed by an
+// underscore and the hex-encoded unique ID of an event, which limits the
+// result to events ordered strictly before it; the "next" field of a response
+// holds the cursor for the next page. The "limit" query parameter is the maximum number
+// of events to return, defaulting to defaultEventsLimit and capped at
+// maxEventsLimit.
+func (s *adminServer) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	query := r.URL.Query()
+
+	limit, err := parseInt64WithDefault(query.Get("limit"), defaultEventsLimit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("limit could not be parsed: %s", err), http.StatusBadRequest)
+		return
+	}
+	if limit < 1 {
+		http.Error(w, fmt.Sprintf("limit: %d should be set to a value greater than 0", limit),
+			http.StatusBadRequest)
+		return
+	}
+	if limit > maxEventsLimit {
+		limit = maxEventsLimit
+	}
+
+	var conditions []string
+	var args []interface{}
+	addCondition := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(cond, len(args)))
+	}
+	if eventType := query.Get("type"); len(eventType) > 0 {
+		addCondition("eventType = $%d", eventType)
+	}
+	if targetIDStr := query.Get("target_id"); len(targetIDStr) > 0 {
+		targetID, err := parseInt64WithDefault(targetIDStr, 0)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("target_id could not be parsed: %s", err), http.StatusBadRequest)
+			return
+		}
+		addCondition("targetID = $%d", targetID)
+	}
+	if beforeStr := query.Get("before"); len(beforeStr) > 0 {
+		before, uniqueID, err := parseEventsCursor(beforeStr)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("before could not be parsed: %s", err), http.StatusBadRequest)
+			return
+		}
+		// The events are ordered by (timestamp, uniqueID), the primary key of
+		// the event log. The comparison of the pair is spelled out, as tuple
+		// inequalities are not supported.
+		args = append(args, before, before, parser.DBytes(uniqueID))
+		conditions = append(conditions, fmt.Sprintf("(timestamp < $%d OR (timestamp = $%d AND uniqueID < $%d))",
+			len(args)-2, len(args)-1, len(args)))
+	}
+
+	stmt := `SELECT timestamp, eventType, targetID, reportingID, info, uniqueID FROM system.eventlog`
+	if len(conditions) > 0 {
+		stmt += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	stmt += fmt.Sprintf(" ORDER BY timestamp DESC, uniqueID DESC LIMIT %d", limit)
+
+	var rows []parser.DTuple
+	if pErr := s.db.Txn(func(txn *client.Txn) *roachpb.Error {
+		var pErr *roachpb.Error
+		rows, pErr = s.executor.QueryRowsInTransaction(txn, stmt, args...)
+		return pErr
+	}); pErr != nil {
+		log.Error(pErr)
+		http.Error(w, pErr.GoError().Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := eventsResponse{Events: make([]eventEntry, 0, len(rows))}
+	var uniqueID parser.DBytes
+	for _, row := range rows {
+		if len(row) != 6 {
+			err := util.Errorf("event log row has %d columns, expected 6", len(row))
+			log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		event, err := makeEventEntry(row[:5])
+		if err != nil {
+			log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Events = append(resp.Events, event)
+		var ok bool
+		if uniqueID, ok = row[5].(parser.DBytes); !ok {
+			err := util.Errorf("uniqueID is of unexpected type %T", row[5])
+			log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if n := len(resp.Events); int64(n) == limit {
+		resp.Next = formatEventsCursor(resp.Events[n-1].Timestamp, []byte(uniqueID))
+	}
+	respondAsJSON(w, r, resp)
+}
+
+// makeEventEntry converts a row retrieved from the event log into an
+// eventEntry, decoding the info column for known event types.
+func makeEventEntry(row parser.DTuple) (eventEntry, error) {
+	var event eventEntry
+	if len(row) != 5 {
+		return event, util.Errorf("event log row has %d columns, expected 5", len(row))
+	}
+	ts, ok := row[0].(parser.DTimestamp)
+	if !ok {
+		return event, util.Errorf("timestamp is of unexpected type %T", row[0])
+	}
+	event.Timestamp = ts.Time
+	eventType, ok := row[1].(parser.DString)
+	if !ok {
+		return event, util.Errorf("eventType is of unexpected type %T", row[1])
+	}
+	event.EventType = sql.EventLogType(eventType)
+	targetID, ok := row[2].(parser.DInt)
+	if !ok {
+		return event, util.Errorf("targetID is of unexpected type %T", row[2])
+	}
+	event.TargetID = int64(targetID)
+	reportingID, ok := row[3].(parser.DInt)
+	if !ok {
+		return event, util.Errorf("reportingID is of unexpected type %T", row[3])
+	}
+	event.ReportingID = int64(reportingID)
+	if row[4] == parser.DNull {
+		return event, nil
+	}
+	info, ok := row[4].(parser.DString)
+	if !ok {
+		return event, util.Errorf("info is of unexpected type %T", row[4])
+	}
+	event.Info = string(info)
+
+	var details interface{}
+	switch event.EventType {
+	case sql.EventLogCreateDatabase, sql.EventLogDropDatabase:
+		details = &sql.EventLogDatabaseInfo{}
+	case sql.EventLogCreateTable, sql.EventLogDropTable:
+		details = &sql.EventLogTableInfo{}
+	case sql.EventLogNodeJoin, sql.EventLogNodeRestart:
+		details = &sql.EventLogNodeInfo{}
+	default:
+		// Unknown event types are returned with their raw info only.
+		return event, nil
+	}
+	if err := json.Unmarshal([]byte(event.Info), details); err != nil {
+		// Malformed info shouldn't prevent the event from being returned.
+		log.Warningf("could not decode info of %s event: %s", event.EventType, err)
+		return event, nil
+	}
+	event.Details = details
+	return event, nil
+}
+
+const (
+	// timeSeriesLevelNode queries the node-level variant of a metric, with one
+	// source per node.
+	timeSeriesLevelNode = "node"
+	// timeSeriesLevelStore queries the store-level variant of a metric, with
+	// one source per store.
+	timeSeriesLevelStore = "store"
+)
+
+// timeSeriesQuery is a single query of the time series endpoint. Name is the
+// name of the metric without its level prefix (e.g. "capacity"), Level is
+// either "node" or "store" and determines the sources whose data is summed,
+// and Downsampler is either "avg" (the default) or "avg_rate".
+type timeSeriesQuery struct {
+	Name        string `json:"name"`
+	Level       string `json:"level"`
+	Downsampler string `json:"downsampler,omitempty"`
+}
+
+// timeSeriesRequest is the request body of the time series endpoint. The
+// queried time span is either given explicitly in nanoseconds since the epoch
+// or as a Window (parsed by time.ParseDuration) ending now.
+type timeSeriesRequest struct {
+	StartNanos int64             `json:"start_nanos,omitempty"`
+	EndNanos   int64             `json:"end_nanos,omitempty"`
+	Window     string            `json:"window,omitempty"`
+	Queries    []timeSeriesQuery `json:"queries"`
+}
+
+// timeSeriesResult is the result of a single timeSeriesQuery. Sources lists
+// every node or store known to the cluster at the time of the query, while
+// ContributingSources lists those which actually had data within the queried
+// time span; a node which joined during the span contributes only to the
+// datapoints after it joined.
+type timeSeriesResult struct {
+	Name                string                    `json:"name"`
+	Level               string                    `json:"level"`
+	Downsampler         string                    `json:"downsampler"`
+	Sources             []string                  `json:"sources"`
+	ContributingSources []string                  `json:"contributing_sources"`
+	Datapoints          []*ts.TimeSeriesDatapoint `json:"datapoints"`
+}
+
+// timeSeriesResponse is the response of the time series endpoint, containing
+// one result per query in the same order.
+type timeSeriesResponse struct {
+	StartNanos int64              `json:"start_nanos"`
+	EndNanos   int64              `json:"end_nanos"`
+	Results    []timeSeriesResult `json:"results"`
+}
+
+// handleTimeSeries answers POST requests for cluster-wide time series. Each
+// query is expanded to the set of nodes or stores currently recorded in the
+// cluster's status summaries, and the data of all of them is summed by the
+// time series query layer.
+func (s *adminServer) handleTimeSeries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var req timeSeriesRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, fmt.Sprintf("request could not be parsed: %s", err), http.StatusBadRequest)
+		return
+	}
+	if len(req.Queries) == 0 {
+		http.Error(w, "time series requests must specify at least one query", http.StatusBadRequest)
+		return
+	}
+	if req.Window != "" {
+		if req.StartNanos != 0 || req.EndNanos != 0 {
+			http.Error(w, "window cannot be combined with start_nanos or end_nanos", http.StatusBadRequest)
+			return
+		}
+		window, err := time.ParseDuration(req.Window)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid window: %s", err), http.StatusBadRequest)
+			return
+		}
+		req.EndNanos = time.Now().UnixNano()
+		req.StartNanos = req.EndNanos - window.Nanoseconds()
+	}
+	if req.StartNanos >= req.EndNanos {
+		http.Error(w, fmt.Sprintf("start_nanos %d must precede end_nanos %d", req.StartNanos, req.EndNanos),
+			http.StatusBadRequest)
+		return
+	}
+
+	nodeSources, storeSources, err := s.timeSeriesSources()
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := timeSeriesResponse{
+		StartNanos: req.StartNanos,
+		EndNanos:   req.EndNanos,
+		Results:    make([]timeSeriesResult, 0, len(req.Queries)),
+	}
+	for _, q := range req.Queries {
+		tsQuery := ts.TimeSeriesQueryRequest_Query{}
+		switch q.Level {
+		case timeSeriesLevelNode:
+			tsQuery.Name = "cr.node." + q.Name
+			tsQuery.Sources = nodeSources
+		case timeSeriesLevelStore:
+			tsQuery.Name = "cr.store." + q.Name
+			tsQuery.Sources = storeSources
+		default:
+			http.Error(w, fmt.Sprintf("invalid level %q for %s", q.Level, q.Name), http.StatusBadRequest)
+			return
+		}
+		if q.Downsampler == "" {
+			q.Downsampler = "avg"
+		}
+		agg, ok := ts.TimeSeriesQueryAggregator_value[strings.ToUpper(q.Downsampler)]
+		if !ok {
+			http.Error(w, fmt.Sprintf("invalid downsampler %q for %s", q.Downsampler, q.Name), http.StatusBadRequest)
+			return
+		}
+		tsQuery.Aggregator = ts.TimeSeriesQueryAggregator(agg).Enum()
+
+		result := timeSeriesResult{
+			Name:        tsQuery.Name,
+			Level:       q.Level,
+			Downsampler: q.Downsampler,
+			Sources:     tsQuery.Sources,
+		}
+		// A cluster without any recorded statuses has no sources; querying
+		// the time series layer without sources would query all of them.
+		if len(tsQuery.Sources) > 0 {
+			result.Datapoints, result.ContributingSources, err = s.tsDB.Query(tsQuery, ts.Resolution10s,
+				req.StartNanos, req.EndNanos)
+			if err != nil {
+				log.Error(err)
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			sort.Strings(result.ContributingSources)
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	respondAsJSON(w, r, resp)
+}
+
+// timeSeriesSources returns the time series sources of all nodes and stores
+// recorded in the cluster's node status summaries, in ascending order of
+// their IDs.
+func (s *adminServer) timeSeriesSources() ([]string, []string, error) {
+	rows, pErr := s.db.Scan(keys.StatusNodePrefix, keys.StatusNodePrefix.PrefixEnd(), 0)
+	if pErr != nil {
+		return nil, nil, pErr.GoError()
+	}
+	var nodeIDs, storeIDs []int
+	for _, row := range rows {
+		nodeStatus := &status.NodeStatus{}
+		if err := row.ValueProto(nodeStatus); err != nil {
+			return nil, nil, err
+		}
+		nodeIDs = append(nodeIDs, int(nodeStatus.Desc.NodeID))
+		for _, storeID := range nodeStatus.StoreIDs {
+			storeIDs = append(storeIDs, int(storeID))
+		}
+	}
+	toSources := func(ids []int) []string {
+		sort.Ints(ids)
+		sources := make([]string, 0, len(ids))
+		for _, id := range ids {
+			sources = append(sources, strconv.Itoa(id))
+		}
+		return sources
+	}
+	return toSources(nodeIDs), toSources(storeIDs), nil
 }
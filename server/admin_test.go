@@ -18,12 +18,25 @@ package server
 
 import (
 	"bytes"
+	gosql "database/sql"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/ts"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
 )
 
 // getText fetches the HTTP response body as text in the form of a
@@ -117,3 +130,380 @@ func TestAdminNetTrace(t *testing.T) {
 		}
 	}
 }
+
+// TestAdminDebugStopper verifies that the running tasks of the server's
+// stopper are listed by name via /debug/stopper.
+func TestAdminDebugStopper(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	unblock := make(chan struct{})
+	defer close(unblock)
+	if !s.Stopper().RunAsyncNamedTask("debug stopper test", func() {
+		<-unblock
+	}) {
+		t.Fatal("expected task to be started")
+	}
+
+	body, err := getText(s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + debugStopperPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "1      debug stopper test"; !bytes.Contains(body, []byte(exp)) {
+		t.Errorf("expected %q to be contained in %s", exp, body)
+	}
+}
+
+// TestAdminAPIEvents verifies that the events endpoint returns cluster events
+// in reverse chronological order with the supplied filters applied.
+func TestAdminAPIEvents(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer config.TestingDisableTableSplits()()
+
+	// Use an engine which outlives the first server so that the node can be
+	// restarted.
+	engineStopper := stop.NewStopper()
+	defer engineStopper.Stop()
+	engines := []engine.Engine{engine.NewInMem(roachpb.Attributes{}, 100<<20, engineStopper)}
+
+	s := &TestServer{Ctx: NewTestContext()}
+	s.Ctx.Engines = engines
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := gosql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=%s",
+		security.RootUser, s.ServingAddr(), security.EmbeddedCertsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE DATABASE api_test`,
+		`CREATE TABLE api_test.tbl1 (a INT PRIMARY KEY)`,
+		`CREATE TABLE api_test.tbl2 (a INT PRIMARY KEY)`,
+		`DROP TABLE api_test.tbl1`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var tbl2ID int64
+	if err := db.QueryRow(`SELECT id FROM system.namespace WHERE name = 'tbl2'`).Scan(&tbl2ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s.Stop()
+
+	// Restart the node using the same engine.
+	s = &TestServer{Ctx: NewTestContext(), SkipBootstrap: true}
+	s.Ctx.Engines = engines
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	getEvents := func(query string) eventsResponse {
+		url := s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + eventsPath + query
+		body, err := getText(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp eventsResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			t.Fatalf("could not unmarshal %q: %s", body, err)
+		}
+		return resp
+	}
+
+	// The restart event is recorded asynchronously.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if resp := getEvents("?type=" + string(sql.EventLogNodeRestart)); len(resp.Events) != 1 {
+			return util.Errorf("expected 1 restart event, got %+v", resp.Events)
+		}
+		return nil
+	})
+
+	testCases := []struct {
+		query    string
+		expected []sql.EventLogType
+	}{
+		{"", []sql.EventLogType{
+			sql.EventLogNodeRestart,
+			sql.EventLogDropTable,
+			sql.EventLogCreateTable,
+			sql.EventLogCreateTable,
+			sql.EventLogCreateDatabase,
+			sql.EventLogNodeJoin,
+		}},
+		{"?type=create_table", []sql.EventLogType{
+			sql.EventLogCreateTable,
+			sql.EventLogCreateTable,
+		}},
+		{fmt.Sprintf("?type=create_table&target_id=%d", tbl2ID), []sql.EventLogType{
+			sql.EventLogCreateTable,
+		}},
+		{"?type=node_join", []sql.EventLogType{
+			sql.EventLogNodeJoin,
+		}},
+		{"?limit=2", []sql.EventLogType{
+			sql.EventLogNodeRestart,
+			sql.EventLogDropTable,
+		}},
+	}
+	for i, tc := range testCases {
+		resp := getEvents(tc.query)
+		var actual []sql.EventLogType
+		for j, event := range resp.Events {
+			actual = append(actual, event.EventType)
+			if j > 0 && event.Timestamp.After(resp.Events[j-1].Timestamp) {
+				t.Errorf("%d: events not in reverse chronological order: %+v", i, resp.Events)
+			}
+			if event.Details == nil {
+				t.Errorf("%d: expected details to be decoded for event %+v", i, event)
+			}
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(tc.expected) {
+			t.Errorf("%d: expected events %v, got %v", i, tc.expected, actual)
+		}
+		if tc.query == fmt.Sprintf("?type=create_table&target_id=%d", tbl2ID) && len(resp.Events) == 1 {
+			if a, e := resp.Events[0].TargetID, tbl2ID; a != e {
+				t.Errorf("%d: expected target ID %d, got %d", i, e, a)
+			}
+		}
+	}
+
+	// Page through all events two at a time using the cursor.
+	var paged []sql.EventLogType
+	query := "?limit=2"
+	for {
+		resp := getEvents(query)
+		for _, event := range resp.Events {
+			paged = append(paged, event.EventType)
+		}
+		if resp.Next == "" {
+			break
+		}
+		query = "?limit=2&before=" + resp.Next
+	}
+	if a, e := fmt.Sprint(paged), fmt.Sprint(testCases[0].expected); a != e {
+		t.Errorf("expected paged events %s, got %s", e, a)
+	}
+
+	// Events recorded in the same transaction share its timestamp. Paging
+	// through more of them than fit in a page returns each of them once.
+	const sameTimestampTargetID = 1 << 20
+	const sameTimestampEvents = 5
+	eventLogger := sql.MakeEventLogger(s.leaseMgr)
+	if pErr := s.db.Txn(func(txn *client.Txn) *roachpb.Error {
+		// Read a key to assign the transaction's timestamp to all the events.
+		if _, pErr := txn.Get("a"); pErr != nil {
+			return pErr
+		}
+		for i := 0; i < sameTimestampEvents; i++ {
+			if pErr := eventLogger.InsertEventRecord(txn, sql.EventLogCreateTable,
+				sameTimestampTargetID, int32(s.Gossip().GetNodeID()), nil); pErr != nil {
+				return pErr
+			}
+		}
+		return nil
+	}); pErr != nil {
+		t.Fatal(pErr)
+	}
+	var timestamps []time.Time
+	query = fmt.Sprintf("?limit=2&target_id=%d", sameTimestampTargetID)
+	for {
+		resp := getEvents(query)
+		for _, event := range resp.Events {
+			timestamps = append(timestamps, event.Timestamp)
+		}
+		if resp.Next == "" {
+			break
+		}
+		query = fmt.Sprintf("?limit=2&target_id=%d&before=%s", sameTimestampTargetID, resp.Next)
+	}
+	if len(timestamps) != sameTimestampEvents {
+		t.Fatalf("expected %d paged events, got %d", sameTimestampEvents, len(timestamps))
+	}
+	for _, ts := range timestamps[1:] {
+		if !ts.Equal(timestamps[0]) {
+			t.Errorf("expected events with the same timestamp, got %v", timestamps)
+		}
+	}
+}
+
+// TestAdminAPITimeSeries verifies that the time series endpoint sums a store
+// metric across all of the stores of the cluster.
+func TestAdminAPITimeSeries(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &TestServer{StoresPerNode: 2}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	client, err := testutils.NewTestBaseContext(TestUser).GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqBody, err := json.Marshal(timeSeriesRequest{
+		Window:  "1h",
+		Queries: []timeSeriesQuery{{Name: "capacity", Level: timeSeriesLevelStore}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + timeSeriesPath
+
+	// Persist the status summaries and time series data of both stores; the
+	// stores may not have been picked up by the status monitor yet.
+	var resp timeSeriesResponse
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if err := s.flushStatus(); err != nil {
+			return err
+		}
+		httpResp, err := client.Post(url, util.JSONContentType, bytes.NewReader(reqBody))
+		if err != nil {
+			return err
+		}
+		defer httpResp.Body.Close()
+		body, err := ioutil.ReadAll(httpResp.Body)
+		if err != nil {
+			return err
+		}
+		resp = timeSeriesResponse{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return util.Errorf("could not unmarshal %q: %s", body, err)
+		}
+		if len(resp.Results) != 1 {
+			return util.Errorf("expected a single result, got %+v", resp.Results)
+		}
+		if a, e := resp.Results[0].ContributingSources, []string{"1", "2"}; !reflect.DeepEqual(a, e) {
+			return util.Errorf("expected contributing sources %v, got %v", e, a)
+		}
+		return nil
+	})
+
+	result := resp.Results[0]
+	if a, e := result.Sources, []string{"1", "2"}; !reflect.DeepEqual(a, e) {
+		t.Errorf("expected sources %v, got %v", e, a)
+	}
+	if len(result.Datapoints) == 0 {
+		t.Fatal("expected datapoints for summed capacity")
+	}
+
+	// Sum the capacity of the individual stores manually.
+	expected := make(map[int64]float64)
+	for _, source := range []string{"1", "2"} {
+		query := ts.TimeSeriesQueryRequest_Query{Name: "cr.store.capacity", Sources: []string{source}}
+		datapoints, _, err := s.tsDB.Query(query, ts.Resolution10s, resp.StartNanos, resp.EndNanos)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, dp := range datapoints {
+			expected[dp.TimestampNanos] += dp.Value
+		}
+	}
+	for _, dp := range result.Datapoints {
+		e, ok := expected[dp.TimestampNanos]
+		if !ok {
+			t.Errorf("unexpected datapoint at %d", dp.TimestampNanos)
+		} else if dp.Value != e {
+			t.Errorf("expected summed capacity %f at %d, got %f", e, dp.TimestampNanos, dp.Value)
+		}
+		if dp.Value == 0 {
+			t.Errorf("expected non-zero capacity at %d", dp.TimestampNanos)
+		}
+	}
+}
+
+// TestAdminAPIZones verifies that zone configs are resolved, validated and
+// inherited by object name.
+func TestAdminAPIZones(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	db, err := gosql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=%s",
+		security.RootUser, s.ServingAddr(), security.EmbeddedCertsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, stmt := range []string{
+		`CREATE DATABASE zones_test`,
+		`CREATE TABLE zones_test.tbl (a INT PRIMARY KEY)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Changing zone configs requires an admin user.
+	admin := client.NewAdminClient(testutils.NewTestBaseContext(security.RootUser), s.ServingAddr(), client.Zones)
+	getZone := func(name string) ZoneConfigResponse {
+		body, err := admin.GetJSON(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp ZoneConfigResponse
+		if err := json.Unmarshal([]byte(body), &resp); err != nil {
+			t.Fatalf("could not unmarshal %q: %s", body, err)
+		}
+		return resp
+	}
+
+	// Without any zone configs, all objects inherit the default zone config.
+	if resp := getZone("zones_test.tbl"); resp.Source != DefaultZoneName ||
+		resp.Zone.RangeMaxBytes != config.DefaultZoneConfig.RangeMaxBytes {
+		t.Errorf("expected default zone config, got %+v", resp)
+	}
+
+	dbZone := "replicas:\n- attrs: [db]\nrange_min_bytes: 1048576\nrange_max_bytes: 67108864\n"
+	if err := admin.SetYAML("zones_test", dbZone); err != nil {
+		t.Fatal(err)
+	}
+	expected := config.ZoneConfig{
+		ReplicaAttrs:  []roachpb.Attributes{{Attrs: []string{"db"}}},
+		RangeMinBytes: 1 << 20,
+		RangeMaxBytes: 64 << 20,
+	}
+	for _, name := range []string{"zones_test", "zones_test.tbl"} {
+		if resp := getZone(name); resp.Source != "zones_test" || !reflect.DeepEqual(resp.Zone, expected) {
+			t.Errorf("%s: expected zone config %+v from zones_test, got %+v", name, expected, resp)
+		}
+	}
+	if names, err := admin.List(); err != nil {
+		t.Fatal(err)
+	} else if e := []string{"zones_test"}; !reflect.DeepEqual(names, e) {
+		t.Errorf("expected zones %s, got %s", e, names)
+	}
+
+	if err := admin.Delete("zones_test"); err != nil {
+		t.Fatal(err)
+	}
+	if resp := getZone("zones_test.tbl"); resp.Source != DefaultZoneName {
+		t.Errorf("expected default zone config, got %+v", resp)
+	}
+
+	testCases := []struct {
+		name, zone, expErr string
+	}{
+		{"zones_test.tbl", "range_min_bytes: 1048576\nrange_max_bytes: 1024\n",
+			"range_max_bytes: 1024 less than minimum allowed"},
+		{"zones_test.tbl", "replicas:\n- attrs: [a]\nrange_maxbytes: 67108864\n",
+			"range_maxbytes: unknown field"},
+		{"zones_test.missing", dbZone, `table "zones_test.missing" does not exist`},
+		{"missing", dbZone, `database "missing" does not exist`},
+	}
+	for i, test := range testCases {
+		if err := admin.SetYAML(test.name, test.zone); !testutils.IsError(err, test.expErr) {
+			t.Errorf("%d: expected error %q, got %v", i, test.expErr, err)
+		}
+	}
+	if err := admin.Delete(DefaultZoneName); !testutils.IsError(err, "cannot be removed") {
+		t.Errorf("expected removal of the default zone config to fail, got %v", err)
+	}
+}
@@ -103,25 +103,35 @@ func TestSSLEnforcement(t *testing.T) {
 		{"GET", healthPath, nil, noCertsContext, true, http.StatusOK},
 		{"GET", healthPath, nil, insecureContext, false, -1},
 
-		// /debug/: server.adminServer: no auth.
+		// /debug/: server.adminServer: client certs required.
 		{"GET", debugEndpoint + "vars", nil, rootCertsContext, true, http.StatusOK},
 		{"GET", debugEndpoint + "vars", nil, nodeCertsContext, true, http.StatusOK},
 		{"GET", debugEndpoint + "vars", nil, testCertsContext, true, http.StatusOK},
-		{"GET", debugEndpoint + "vars", nil, noCertsContext, true, http.StatusOK},
+		{"GET", debugEndpoint + "vars", nil, noCertsContext, true, http.StatusUnauthorized},
 		{"GET", debugEndpoint + "vars", nil, insecureContext, false, -1},
-
-		// /_status/nodes: server.statusServer: no auth.
+		{"GET", debugEndpoint + "pprof/goroutine", nil, rootCertsContext, true, http.StatusOK},
+		{"GET", debugEndpoint + "pprof/goroutine", nil, nodeCertsContext, true, http.StatusOK},
+		{"GET", debugEndpoint + "pprof/goroutine", nil, testCertsContext, true, http.StatusOK},
+		{"GET", debugEndpoint + "pprof/goroutine", nil, noCertsContext, true, http.StatusUnauthorized},
+		{"GET", debugEndpoint + "pprof/goroutine", nil, insecureContext, false, -1},
+
+		// /health: server.statusServer: no auth.
+		{"GET", healthEndpoint, nil, rootCertsContext, true, http.StatusOK},
+		{"GET", healthEndpoint, nil, testCertsContext, true, http.StatusOK},
+		{"GET", healthEndpoint, nil, noCertsContext, true, http.StatusOK},
+
+		// /_status/nodes: server.statusServer: client certs required.
 		{"GET", statusNodesPrefix, nil, rootCertsContext, true, http.StatusOK},
 		{"GET", statusNodesPrefix, nil, nodeCertsContext, true, http.StatusOK},
 		{"GET", statusNodesPrefix, nil, testCertsContext, true, http.StatusOK},
-		{"GET", statusNodesPrefix, nil, noCertsContext, true, http.StatusOK},
+		{"GET", statusNodesPrefix, nil, noCertsContext, true, http.StatusUnauthorized},
 		{"GET", statusNodesPrefix, nil, insecureContext, false, -1},
 
-		// /ts/: ts.Server: no auth.
+		// /ts/: ts.Server: client certs required.
 		{"GET", ts.URLPrefix, nil, rootCertsContext, true, http.StatusNotFound},
 		{"GET", ts.URLPrefix, nil, nodeCertsContext, true, http.StatusNotFound},
 		{"GET", ts.URLPrefix, nil, testCertsContext, true, http.StatusNotFound},
-		{"GET", ts.URLPrefix, nil, noCertsContext, true, http.StatusNotFound},
+		{"GET", ts.URLPrefix, nil, noCertsContext, true, http.StatusUnauthorized},
 		{"GET", ts.URLPrefix, nil, insecureContext, false, -1},
 
 		// /sql/: sql.Server. These are proto reqs. The important field is header.User.
@@ -158,3 +168,43 @@ func TestSSLEnforcement(t *testing.T) {
 		}
 	}
 }
+
+// TestUnsafeDebugEndpoints verifies that the /debug/ endpoints can be served
+// without client certificates when explicitly requested, and only then.
+func TestUnsafeDebugEndpoints(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	for _, unsafe := range []bool{false, true} {
+		s := &TestServer{}
+		s.Ctx = NewTestContext()
+		s.Ctx.UnsafeDebugEndpoints = unsafe
+		if err := s.Start(); err != nil {
+			t.Fatal(err)
+		}
+
+		// HTTPS without client certs.
+		noCertsContext := testutils.NewTestBaseContext(TestUser)
+		noCertsContext.Certs = ""
+		client, err := noCertsContext.GetHTTPClient()
+		if err != nil {
+			s.Stop()
+			t.Fatal(err)
+		}
+		resp, err := doHTTPReq(t, client, "GET",
+			fmt.Sprintf("%s://%s%spprof/goroutine", noCertsContext.HTTPRequestScheme(), s.ServingAddr(), debugEndpoint),
+			nil)
+		s.Stop()
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		expected := http.StatusUnauthorized
+		if unsafe {
+			expected = http.StatusOK
+		}
+		if resp.StatusCode != expected {
+			t.Errorf("unsafe=%t: expected status code %d, got %d", unsafe, expected, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/julienschmidt/httprouter"
+)
+
+// adminUsersSetting is the cluster setting holding the comma-separated list
+// of users which, besides root and node, are admin users of the HTTP API.
+const adminUsersSetting = "server.admin_users"
+
+// An accessLevel is the access to the HTTP API required to be served by an
+// endpoint.
+type accessLevel int
+
+const (
+	// publicAccess endpoints serve every request, including requests made
+	// without a client certificate.
+	publicAccess accessLevel = iota
+	// readAccess endpoints serve the requests of every authenticated user.
+	readAccess
+	// readWriteAccess endpoints serve GET and HEAD requests of every
+	// authenticated user, and other requests of admin users only.
+	readWriteAccess
+	// adminAccess endpoints serve the requests of admin users only.
+	adminAccess
+)
+
+// An authorizer decides whether HTTP requests are served, based on the user
+// of the verified client certificate of the request. In insecure mode there
+// are no certificates to verify and every request is served.
+type authorizer struct {
+	insecure bool
+	settings *config.Settings
+}
+
+// isAdmin returns whether the user is an admin user of the HTTP API.
+func (a *authorizer) isAdmin(user string) bool {
+	if user == security.RootUser || user == security.NodeUser {
+		return true
+	}
+	for _, admin := range strings.Split(a.settings.String(adminUsersSetting, ""), ",") {
+		if strings.TrimSpace(admin) == user {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize returns the status code and error with which a request to an
+// endpoint with the given access level is rejected, or a zero status code if
+// the request is to be served.
+func (a *authorizer) authorize(level accessLevel, r *http.Request) (int, error) {
+	if a.insecure || level == publicAccess {
+		return 0, nil
+	}
+	user, err := security.GetCertificateUser(r.TLS)
+	if err != nil {
+		return http.StatusUnauthorized, err
+	}
+	if level == readWriteAccess && (r.Method == "GET" || r.Method == "HEAD") {
+		level = readAccess
+	}
+	if level != readAccess && !a.isAdmin(user) {
+		return http.StatusForbidden, fmt.Errorf("user %s is not an admin", user)
+	}
+	return 0, nil
+}
+
+// require wraps handler, rejecting requests which don't have the given level
+// of access.
+func (a *authorizer) require(level accessLevel, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if code, err := a.authorize(level, r); code != 0 {
+			http.Error(w, err.Error(), code)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// requireFunc is like require, for handler functions.
+func (a *authorizer) requireFunc(level accessLevel, handler http.HandlerFunc) http.Handler {
+	return a.require(level, handler)
+}
+
+// requireHandle is like require, for httprouter handles.
+func (a *authorizer) requireHandle(level accessLevel, handle httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if code, err := a.authorize(level, r); code != 0 {
+			http.Error(w, err.Error(), code)
+			return
+		}
+		handle(w, r, ps)
+	}
+}
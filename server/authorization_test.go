@@ -0,0 +1,88 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestHTTPAuthorization verifies that users other than root may only use the
+// read-only endpoints of the HTTP API, unless they are listed as admin users.
+func TestHTTPAuthorization(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	rootCertsContext := testutils.NewTestBaseContext(security.RootUser)
+	testCertsContext := testutils.NewTestBaseContext(TestUser)
+	noCertsContext := testutils.NewTestBaseContext(TestUser)
+	noCertsContext.Certs = ""
+
+	// Removing the default zone config is rejected by the handler itself, so
+	// it is a mutating request which changes nothing once authorized.
+	deleteZone := zonesPath + "/" + DefaultZoneName
+	testCases := []struct {
+		method, path string
+		ctx          *base.Context
+		code         int
+	}{
+		{"GET", healthEndpoint, noCertsContext, http.StatusOK},
+		{"GET", healthPath, noCertsContext, http.StatusOK},
+		{"GET", zonesPath, noCertsContext, http.StatusUnauthorized},
+		{"GET", statusNodesPrefix, noCertsContext, http.StatusUnauthorized},
+
+		{"GET", zonesPath, testCertsContext, http.StatusOK},
+		{"GET", statusNodesPrefix, testCertsContext, http.StatusOK},
+		{"DELETE", deleteZone, testCertsContext, http.StatusForbidden},
+		{"GET", quitPath, testCertsContext, http.StatusForbidden},
+
+		{"GET", zonesPath, rootCertsContext, http.StatusOK},
+		{"DELETE", deleteZone, rootCertsContext, http.StatusBadRequest},
+	}
+	check := func(tcNum int, method, path string, ctx *base.Context, code int) {
+		client, err := ctx.GetHTTPClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := doHTTPReq(t, client, method,
+			fmt.Sprintf("%s://%s%s", ctx.HTTPRequestScheme(), s.ServingAddr(), path), nil)
+		if err != nil {
+			t.Fatalf("[%d]: %s %s: %s", tcNum, method, path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != code {
+			t.Errorf("[%d]: %s %s as %s: expected status code %d, got %d",
+				tcNum, method, path, ctx.User, code, resp.StatusCode)
+		}
+	}
+	for tcNum, tc := range testCases {
+		check(tcNum, tc.method, tc.path, tc.ctx, tc.code)
+	}
+
+	// Users listed in the admin users cluster setting may use the mutating
+	// endpoints.
+	s.Settings().Update(map[string]config.RawSetting{
+		adminUsersSetting: {Value: "foo, " + TestUser, Type: config.StringSetting},
+	})
+	check(len(testCases), "DELETE", deleteZone, testCertsContext, http.StatusBadRequest)
+}
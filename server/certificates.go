@@ -0,0 +1,167 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/stop"
+	"github.com/julienschmidt/httprouter"
+)
+
+// certCheckInterval is the interval at which the expiration of the loaded
+// certificates is checked.
+const certCheckInterval = time.Minute
+
+// CertificateDetails describes a certificate loaded by a node.
+type CertificateDetails struct {
+	// Filename is the name of the file the certificate was loaded from.
+	Filename     string    `json:"filename"`
+	CommonName   string    `json:"commonName"`
+	SerialNumber string    `json:"serialNumber"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+	// ExpiresSoon is set if the certificate expires within the node's
+	// certificate expiry warning period, or has expired.
+	ExpiresSoon bool `json:"expiresSoon"`
+}
+
+// CertificatesResponse holds the certificates loaded by a node: those of the
+// CA, followed by the node's server and client certificates.
+type CertificatesResponse struct {
+	Certificates []CertificateDetails `json:"certificates"`
+}
+
+// handleCertificates handles GET requests for the certificates loaded by a
+// node.
+func (s *statusServer) handleCertificates(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !local {
+		s.proxyRequest(nodeID, w, r)
+		return
+	}
+
+	certs, err := s.ctx.LoadedCertificates()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := CertificatesResponse{Certificates: []CertificateDetails{}}
+	for _, c := range certs {
+		resp.Certificates = append(resp.Certificates, CertificateDetails{
+			Filename:     c.Filename,
+			CommonName:   c.Cert.Subject.CommonName,
+			SerialNumber: c.Cert.SerialNumber.String(),
+			NotBefore:    c.Cert.NotBefore,
+			NotAfter:     c.Cert.NotAfter,
+			ExpiresSoon:  c.Cert.NotAfter.Sub(time.Now()) < s.ctx.CertExpiryWarning,
+		})
+	}
+	respondAsJSON(w, r, resp)
+}
+
+// certMonitor records the time remaining until the expiration of each
+// certificate file loaded by the node in a certs.<name>.expiry-seconds gauge
+// and logs warnings about the certificates expiring soon. The certificates
+// are inspected as currently loaded, so reloaded certificates are picked up.
+type certMonitor struct {
+	ctx      *Context
+	registry *metric.Registry
+
+	mu sync.Mutex
+	// gauges are keyed by the name of the certificate file.
+	gauges map[string]*metric.Gauge
+}
+
+func newCertMonitor(ctx *Context, registry *metric.Registry) *certMonitor {
+	return &certMonitor{
+		ctx:      ctx,
+		registry: registry,
+		gauges:   map[string]*metric.Gauge{},
+	}
+}
+
+// start checks the certificates now and then every certCheckInterval.
+func (cm *certMonitor) start(stopper *stop.Stopper) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(certCheckInterval)
+		defer ticker.Stop()
+		for {
+			if _, err := cm.check(time.Now()); err != nil {
+				log.Error(err)
+			}
+			select {
+			case <-ticker.C:
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// check updates the gauges with the time remaining at now until the
+// expiration of the loaded certificates and returns the names of the files
+// whose certificates expire within the expiry warning period. A file holding
+// several certificates, such as a CA bundle, expires with the first of them.
+func (cm *certMonitor) check(now time.Time) ([]string, error) {
+	certs, err := cm.ctx.LoadedCertificates()
+	if err != nil {
+		return nil, err
+	}
+	expirations := map[string]time.Time{}
+	var filenames []string
+	for _, c := range certs {
+		notAfter, ok := expirations[c.Filename]
+		if !ok {
+			filenames = append(filenames, c.Filename)
+		}
+		if !ok || c.Cert.NotAfter.Before(notAfter) {
+			expirations[c.Filename] = c.Cert.NotAfter
+		}
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	var expiring []string
+	for _, filename := range filenames {
+		notAfter := expirations[filename]
+		gauge, ok := cm.gauges[filename]
+		if !ok {
+			name := "certs." + strings.TrimSuffix(filename, ".crt") + ".expiry-seconds"
+			gauge = cm.registry.Gauge(name)
+			cm.gauges[filename] = gauge
+		}
+		remaining := notAfter.Sub(now)
+		gauge.Update(int64(remaining / time.Second))
+		if remaining < cm.ctx.CertExpiryWarning {
+			expiring = append(expiring, filename)
+			if remaining <= 0 {
+				log.Warningf("certificate %s expired at %s", filename, notAfter)
+			} else {
+				log.Warningf("certificate %s expires in %s, at %s", filename, remaining, notAfter)
+			}
+		}
+	}
+	return expiring, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// TestCertMonitor loads node certificates expiring soon and verifies that
+// the monitor records their expiration and flags them until certificates
+// valid for longer are reloaded.
+func TestCertMonitor(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	opts := security.CertOptions{KeySize: 512}
+	if err := security.RunCreateCACert(certsDir, opts); err != nil {
+		t.Fatal(err)
+	}
+	shortOpts := security.CertOptions{KeySize: 512, Lifetime: time.Hour}
+	if err := security.RunCreateNodeCert(certsDir, shortOpts, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewContext()
+	ctx.Certs = certsDir
+	ctx.User = security.NodeUser
+	if _, err := ctx.GetServerTLSConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.GetClientTLSConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := metric.NewRegistry()
+	cm := newCertMonitor(ctx, registry)
+	// check verifies the certificates flagged by the monitor and returns the
+	// recorded seconds until expiration by metric name.
+	check := func(expectedExpiring []string) map[string]int64 {
+		expiring, err := cm.check(time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expiring, expectedExpiring) {
+			t.Errorf("expected expiring certificates %v, got %v", expectedExpiring, expiring)
+		}
+		seconds := map[string]int64{}
+		registry.Each(func(name string, val interface{}) {
+			seconds[name] = val.(*metric.Gauge).Value()
+		})
+		return seconds
+	}
+
+	seconds := check([]string{"node.server.crt", "node.client.crt"})
+	if len(seconds) != 3 {
+		t.Fatalf("expected a gauge per certificate file, got %v", seconds)
+	}
+	if s := seconds["certs.ca.expiry-seconds"]; s < int64(ctx.CertExpiryWarning/time.Second) {
+		t.Errorf("expected the CA to expire after the warning period, got %d seconds", s)
+	}
+	for _, name := range []string{"certs.node.server.expiry-seconds", "certs.node.client.expiry-seconds"} {
+		if s := seconds[name]; s <= 0 || s > int64(time.Hour/time.Second) {
+			t.Errorf("%s: expected expiration within an hour, got %d seconds", name, s)
+		}
+	}
+
+	// Rotated certificates are picked up once reloaded.
+	for _, name := range []string{"node.server.crt", "node.server.key", "node.client.crt", "node.client.key"} {
+		if err := os.Remove(filepath.Join(certsDir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := security.RunCreateNodeCert(certsDir, opts, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReloadCertificates(); err != nil {
+		t.Fatal(err)
+	}
+	seconds = check(nil)
+	if s := seconds["certs.node.server.expiry-seconds"]; s < int64(ctx.CertExpiryWarning/time.Second) {
+		t.Errorf("expected the reloaded certificate to expire after the warning period, got %d seconds", s)
+	}
+}
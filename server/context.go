@@ -27,10 +27,13 @@ import (
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/gossip/resolver"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/server/status"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/log/syslog"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
 
@@ -46,6 +49,7 @@ const (
 	defaultMetricsFrequency   = 10 * time.Second
 	defaultTimeUntilStoreDead = 5 * time.Minute
 	defaultBalanceMode        = storage.BalanceModeUsage
+	defaultCertExpiryWarning  = 30 * 24 * time.Hour
 )
 
 // Context holds parameters needed to setup a server.
@@ -105,6 +109,52 @@ type Context struct {
 	// BalanceMode determines how this node makes balancing decisions.
 	BalanceMode storage.BalanceMode
 
+	// UnsafeDebugEndpoints disables client certificate authentication of
+	// the /debug/ endpoints. Intended for insecure development clusters,
+	// which have no certificates to authenticate with.
+	UnsafeDebugEndpoints bool
+
+	// SkipVersionCheck disables the verification that this node's binary
+	// supports the version of the cluster it joins. For development only.
+	SkipVersionCheck bool
+
+	// ListeningURLFile, if set, is the path of a file to which the node's
+	// ID, cluster ID and the addresses it serves on are written as JSON once
+	// the node is ready to serve. The file is removed on shutdown.
+	ListeningURLFile string
+
+	// ManualClock, if set, replaces the system clock as the physical clock of
+	// the server. It may only be set in tests; see TestServer.AdvanceClock.
+	ManualClock *hlc.ManualClock
+
+	// ProfileDir is the directory in which profiles captured through the
+	// status server are stored. Profile capture is disabled if empty.
+	ProfileDir string
+
+	// EventWebhookURL, if set, is the URL to which cluster events observed
+	// by the node are POSTed as JSON.
+	EventWebhookURL string
+
+	// EventWebhookSecret, if set, is the secret with which requests to the
+	// event webhook are signed.
+	EventWebhookSecret string
+
+	// EventWebhookTypes is a comma-separated list of the types of the events
+	// forwarded to the event webhook. All events are forwarded if empty.
+	EventWebhookTypes string
+
+	// SyslogAddr, if set, is the address of the syslog daemon to which a
+	// copy of the log entries is forwarded, e.g. "udp://localhost:514".
+	SyslogAddr string
+
+	// SyslogFacility is the name of the facility of the log entries
+	// forwarded to the syslog daemon.
+	SyslogFacility string
+
+	// SyslogSeverity is the name of the minimum severity of the log entries
+	// forwarded to the syslog daemon.
+	SyslogSeverity string
+
 	// Parsed values.
 
 	// Engines is the storage instances specified by Stores.
@@ -133,6 +183,27 @@ type Context struct {
 	// TimeUntilStoreDead is the time after which if there is no new gossiped
 	// information about a store, it is considered dead.
 	TimeUntilStoreDead time.Duration
+
+	// SlowRequestThreshold is the latency above which a request to the node
+	// is counted as slow. Zero disables the count.
+	SlowRequestThreshold time.Duration
+
+	// MaxTimeSeries caps the number of time series recorded by the node at
+	// each interval. The series of the node are kept before those of its
+	// stores. Zero means no cap.
+	MaxTimeSeries int
+
+	// CertExpiryWarning is how long before their expiration warnings are
+	// logged about the certificates loaded by the node.
+	CertExpiryWarning time.Duration
+
+	// CRLFile is the path of a certificate revocation list against which
+	// client certificates are checked. Empty disables revocation checks.
+	CRLFile string
+
+	// CRLFailOpen accepts client certificates when the CRL cannot be read,
+	// isn't signed by their issuer or has expired, rather than rejecting them.
+	CRLFailOpen bool
 }
 
 // NewContext returns a Context with default values.
@@ -154,7 +225,11 @@ func (ctx *Context) InitDefaults() {
 	ctx.ScanMaxIdleTime = defaultScanMaxIdleTime
 	ctx.MetricsFrequency = defaultMetricsFrequency
 	ctx.TimeUntilStoreDead = defaultTimeUntilStoreDead
+	ctx.SlowRequestThreshold = status.DefaultSlowRequestThreshold
+	ctx.CertExpiryWarning = defaultCertExpiryWarning
 	ctx.BalanceMode = defaultBalanceMode
+	ctx.SyslogFacility = syslog.DefaultFacility
+	ctx.SyslogSeverity = "INFO"
 }
 
 // Get the stores on both start and init.
@@ -0,0 +1,230 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// decommissionInterval is the interval at which the decommission status is
+// re-gossiped and the progress of decommissioning nodes is checked.
+const decommissionInterval = 10 * time.Second
+
+// StoreReplicaCount is the number of replicas remaining on a store.
+type StoreReplicaCount struct {
+	StoreID  roachpb.StoreID `json:"storeID"`
+	Replicas int             `json:"replicas"`
+}
+
+// DecommissionProgress describes how far along the decommissioning of a node
+// is.
+type DecommissionProgress struct {
+	NodeID roachpb.NodeID `json:"nodeID"`
+	// Decommissioned is set once the node holds no more replicas.
+	Decommissioned bool `json:"decommissioned"`
+	// Replicas is the total number of replicas remaining on the node.
+	Replicas int `json:"replicas"`
+	// Stores lists the replicas remaining on each of the node's stores.
+	Stores []StoreReplicaCount `json:"stores"`
+}
+
+// readDecommissionStatus reads the decommission status record. A missing
+// record is returned as an empty status.
+func readDecommissionStatus(txn *client.Txn) (roachpb.DecommissionStatus, *roachpb.Error) {
+	var status roachpb.DecommissionStatus
+	pErr := txn.GetProto(keys.DecommissionStatusKey, &status)
+	return status, pErr
+}
+
+// updateDecommissionStatus applies fn to the decommission status record in a
+// transaction and gossips the result.
+func (s *Server) updateDecommissionStatus(fn func(*roachpb.DecommissionStatus) error) error {
+	var status roachpb.DecommissionStatus
+	if pErr := s.db.Txn(func(txn *client.Txn) *roachpb.Error {
+		var pErr *roachpb.Error
+		if status, pErr = readDecommissionStatus(txn); pErr != nil {
+			return pErr
+		}
+		if err := fn(&status); err != nil {
+			return roachpb.NewError(err)
+		}
+		return txn.Put(keys.DecommissionStatusKey, &status)
+	}); pErr != nil {
+		return pErr.GoError()
+	}
+	return s.gossip.AddInfoProto(gossip.KeyDecommissionStatus, &status, 0)
+}
+
+// Decommission marks the given nodes as decommissioning, causing their
+// replicas to be moved to other nodes, or, if decommission is false,
+// recommissions them. A node can only be recommissioned until it has been
+// fully decommissioned.
+func (s *Server) Decommission(nodeIDs []roachpb.NodeID, decommission bool) error {
+	return s.updateDecommissionStatus(func(status *roachpb.DecommissionStatus) error {
+		for _, nodeID := range nodeIDs {
+			if status.IsDecommissioned(nodeID) {
+				return util.Errorf("node %d is already decommissioned", nodeID)
+			}
+			if decommission {
+				if !status.IsDecommissioning(nodeID) {
+					status.Decommissioning = append(status.Decommissioning, nodeID)
+				}
+				continue
+			}
+			status.Decommissioning = removeNodeID(status.Decommissioning, nodeID)
+		}
+		return nil
+	})
+}
+
+// DecommissionProgress returns the number of replicas remaining on each of the
+// nodes which are being, or have been, decommissioned. Decommissioning nodes
+// which no longer hold any replicas are marked as decommissioned.
+func (s *Server) DecommissionProgress() ([]DecommissionProgress, error) {
+	var status roachpb.DecommissionStatus
+	if pErr := s.db.Txn(func(txn *client.Txn) *roachpb.Error {
+		var pErr *roachpb.Error
+		status, pErr = readDecommissionStatus(txn)
+		return pErr
+	}); pErr != nil {
+		return nil, pErr.GoError()
+	}
+	if len(status.Decommissioning) == 0 && len(status.Decommissioned) == 0 {
+		return nil, nil
+	}
+
+	// Replicas are counted using the range descriptors rather than the stores
+	// themselves, since removed replicas are only garbage collected lazily.
+	rows, pErr := s.db.Scan(keys.Meta2Prefix, keys.MetaMax, 0)
+	if pErr != nil {
+		return nil, pErr.GoError()
+	}
+	counts := map[roachpb.NodeID]map[roachpb.StoreID]int{}
+	for _, row := range rows {
+		var desc roachpb.RangeDescriptor
+		if err := row.ValueProto(&desc); err != nil {
+			return nil, util.Errorf("%s: unable to unmarshal range descriptor: %s", row.Key, err)
+		}
+		for _, repl := range desc.Replicas {
+			if !status.IsDecommissioning(repl.NodeID) {
+				continue
+			}
+			if counts[repl.NodeID] == nil {
+				counts[repl.NodeID] = map[roachpb.StoreID]int{}
+			}
+			counts[repl.NodeID][repl.StoreID]++
+		}
+	}
+
+	var progress []DecommissionProgress
+	var drained []roachpb.NodeID
+	for _, nodeID := range status.Decommissioning {
+		p := DecommissionProgress{NodeID: nodeID}
+		for storeID, n := range counts[nodeID] {
+			p.Stores = append(p.Stores, StoreReplicaCount{StoreID: storeID, Replicas: n})
+			p.Replicas += n
+		}
+		sort.Sort(storeReplicaCounts(p.Stores))
+		if p.Replicas == 0 {
+			p.Decommissioned = true
+			drained = append(drained, nodeID)
+		}
+		progress = append(progress, p)
+	}
+	for _, nodeID := range status.Decommissioned {
+		progress = append(progress, DecommissionProgress{NodeID: nodeID, Decommissioned: true})
+	}
+
+	if len(drained) > 0 {
+		if err := s.updateDecommissionStatus(func(status *roachpb.DecommissionStatus) error {
+			for _, nodeID := range drained {
+				// The node may have been recommissioned in the meantime.
+				if !status.IsDecommissioning(nodeID) {
+					continue
+				}
+				status.Decommissioning = removeNodeID(status.Decommissioning, nodeID)
+				status.Decommissioned = append(status.Decommissioned, nodeID)
+				log.Infof("node %d has been decommissioned", nodeID)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return progress, nil
+}
+
+// startDecommissionMonitor begins periodically gossiping the decommission
+// status and checking the progress of decommissioning nodes. Gossiping the
+// status from every node ensures it survives the loss of the node which
+// last updated it.
+func (s *Server) startDecommissionMonitor() {
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(decommissionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.stopper.RunTask(func() {
+					if err := s.checkDecommissionStatus(); err != nil {
+						log.Error(err)
+					}
+				})
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// checkDecommissionStatus gossips the decommission status and, if any nodes
+// are decommissioning, updates their progress.
+func (s *Server) checkDecommissionStatus() error {
+	var status roachpb.DecommissionStatus
+	if pErr := s.db.GetProto(keys.DecommissionStatusKey, &status); pErr != nil {
+		return pErr.GoError()
+	}
+	if err := s.gossip.AddInfoProto(gossip.KeyDecommissionStatus, &status, 0); err != nil {
+		return err
+	}
+	if len(status.Decommissioning) == 0 {
+		return nil
+	}
+	_, err := s.DecommissionProgress()
+	return err
+}
+
+func removeNodeID(nodeIDs []roachpb.NodeID, nodeID roachpb.NodeID) []roachpb.NodeID {
+	for i, id := range nodeIDs {
+		if id == nodeID {
+			return append(nodeIDs[:i], nodeIDs[i+1:]...)
+		}
+	}
+	return nodeIDs
+}
+
+type storeReplicaCounts []StoreReplicaCount
+
+func (s storeReplicaCounts) Len() int           { return len(s) }
+func (s storeReplicaCounts) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s storeReplicaCounts) Less(i, j int) bool { return s[i].StoreID < s[j].StoreID }
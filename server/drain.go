@@ -0,0 +1,103 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/ts"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// DrainOptions bounds the duration of each phase of a drain.
+type DrainOptions struct {
+	// SQLTimeout bounds the time spent waiting for open SQL transactions to
+	// finish before their connections are closed.
+	SQLTimeout time.Duration
+	// LeaseTimeout bounds the time spent waiting for the leader leases held by
+	// the node to expire so that other replicas can acquire them.
+	LeaseTimeout time.Duration
+	// FlushTimeout bounds the time spent persisting the final status summaries
+	// and time series data.
+	FlushTimeout time.Duration
+}
+
+// DefaultDrainOptions are the drain options used when none are specified.
+var DefaultDrainOptions = DrainOptions{
+	SQLTimeout:   10 * time.Second,
+	LeaseTimeout: 10 * time.Second,
+	FlushTimeout: 5 * time.Second,
+}
+
+// drainPollInterval is the interval at which the lease phase of a drain
+// checks for remaining leader leases.
+const drainPollInterval = 10 * time.Millisecond
+
+// Drain prepares the server for shutdown in phases: new SQL connections are
+// refused and existing ones are closed once their transactions finish, the
+// stores stop acquiring leader leases, and the final status summaries and time
+// series data are written. Each phase is bounded by the corresponding timeout
+// in opts. The progress function is called as each phase begins and ends.
+// Drain does not stop the server.
+func (s *Server) Drain(opts DrainOptions, progress func(format string, args ...interface{})) {
+	progress("draining SQL connections")
+	if n := s.pgServer.Drain(opts.SQLTimeout); n > 0 {
+		progress("closed %d SQL connection(s) with open transactions", n)
+	}
+
+	progress("draining leader leases")
+	s.node.SetDraining(true)
+	deadline := time.Now().Add(opts.LeaseTimeout)
+	for {
+		n := s.node.leaderLeaseCount()
+		if n == 0 {
+			break
+		}
+		if !time.Now().Before(deadline) {
+			progress("%d leader lease(s) still held", n)
+			break
+		}
+		time.Sleep(drainPollInterval)
+	}
+
+	progress("flushing status summaries and time series")
+	if err := runWithTimeout(opts.FlushTimeout, s.flushStatus); err != nil {
+		progress("unable to flush: %s", err)
+	}
+}
+
+// flushStatus persists the current status summaries and time series data.
+func (s *Server) flushStatus() error {
+	if err := s.writeSummaries(); err != nil {
+		return err
+	}
+	return s.tsDB.StoreData(ts.Resolution10s, s.recorder.GetTimeSeriesData())
+}
+
+// runWithTimeout runs fn, returning its error or an error if it does not
+// complete within the given timeout. In the latter case, fn keeps running in
+// the background.
+func runWithTimeout(timeout time.Duration, fn func() error) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fn()
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(timeout):
+		return util.Errorf("timed out after %s", timeout)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server_test
+
+import (
+	"database/sql"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils/sqlutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestDrain verifies that draining a server closes SQL connections with idle
+// transactions once the SQL timeout expires, reports the progress of each
+// phase and finally stops the server.
+func TestDrain(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &server.TestServer{}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	pgURL, cleanupFn := sqlutils.PGUrl(t, s, security.RootUser, os.TempDir(), "TestDrain")
+	defer cleanupFn()
+	db, err := sql.Open("postgres", pgURL.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// Leave a transaction open on a connection.
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`SELECT 1`); err != nil {
+		t.Fatal(err)
+	}
+
+	admin := client.NewAdminClient(&s.Ctx.Context, s.ServingAddr(), client.Drain)
+	params := url.Values{
+		"sql_timeout":   {"100ms"},
+		"lease_timeout": {"1s"},
+	}
+	// Draining shuts the server down, so it isn't done on a GET.
+	if _, err := admin.GetWithParams(params); err == nil || !strings.Contains(err.Error(), "405") {
+		t.Fatalf("expected GET to be rejected, got %v", err)
+	}
+	body, err := admin.PostWithParams(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"draining SQL connections",
+		"closed 1 SQL connection(s) with open transactions",
+		"draining leader leases",
+		"flushing status summaries and time series",
+		"ok",
+	}
+	if actual := strings.Split(strings.TrimSpace(body), "\n"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected drain phases %q, got %q", expected, actual)
+	}
+
+	// The transaction's connection was closed by the drain.
+	if err := tx.Commit(); err == nil {
+		t.Errorf("expected commit of drained transaction to fail")
+	}
+
+	select {
+	case <-s.Stopper().IsStopped():
+	case <-time.After(10 * time.Second):
+		t.Fatal("server was not stopped after drain")
+	}
+}
@@ -0,0 +1,395 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+const (
+	// EventTypeRangesUnderReplicated is the type of the events forwarded when
+	// the number of under-replicated ranges led by a store changes.
+	EventTypeRangesUnderReplicated = "ranges_underreplicated"
+
+	// webhookSignatureHeader is the header of webhook requests which holds
+	// the hex encoded HMAC-SHA256 of the request body, keyed by the shared
+	// secret.
+	webhookSignatureHeader = "X-Cockroach-Signature"
+
+	defaultEventSinkMaxQueued     = 1000
+	defaultEventSinkMaxBatch      = 100
+	defaultEventSinkFlushInterval = time.Second
+	defaultEventSinkRetryInterval = 5 * time.Second
+	defaultEventSinkTimeout       = 10 * time.Second
+	// eventLogPollLimit is the maximum number of events read from the event
+	// log at a time.
+	eventLogPollLimit = 100
+)
+
+// eventLogPollInterval is the interval at which the events recorded by a
+// node in the event log are read to be forwarded to the webhook.
+var eventLogPollInterval = 10 * time.Second
+
+// webhookEventTypes are the types of the events which can be forwarded to the
+// webhook.
+var webhookEventTypes = []string{
+	EventTypeRangesUnderReplicated,
+	string(sql.EventLogCreateDatabase),
+	string(sql.EventLogDropDatabase),
+	string(sql.EventLogCreateTable),
+	string(sql.EventLogDropTable),
+	string(sql.EventLogNodeJoin),
+	string(sql.EventLogNodeRestart),
+}
+
+// WebhookEvent is a cluster event as delivered to the event webhook.
+type WebhookEvent struct {
+	Type      string         `json:"type"`
+	Timestamp time.Time      `json:"timestamp"`
+	NodeID    roachpb.NodeID `json:"nodeID"`
+	// TargetID is the ID of the object an event log event applies to, e.g. a
+	// table ID for table events.
+	TargetID int64       `json:"targetID,omitempty"`
+	Details  interface{} `json:"details,omitempty"`
+}
+
+// WebhookRequest is the JSON body of a request to the event webhook.
+type WebhookRequest struct {
+	Events []WebhookEvent `json:"events"`
+}
+
+// RangesUnderReplicatedDetails are the details of a ranges_underreplicated
+// event.
+type RangesUnderReplicatedDetails struct {
+	StoreID roachpb.StoreID `json:"storeID"`
+	// Ranges is the number of ranges led by the store which have fewer
+	// replicas than their zone requires.
+	Ranges int64 `json:"ranges"`
+}
+
+// eventSink forwards cluster events to a webhook. Events are queued and
+// POSTed in batches by a single worker. While the webhook can't be reached,
+// the oldest queued events are dropped so that at most maxQueued remain.
+type eventSink struct {
+	url    string
+	secret []byte
+	// types are the types of the forwarded events; all are forwarded if nil.
+	types  map[string]struct{}
+	nodeID roachpb.NodeID
+	client *http.Client
+
+	maxQueued     int
+	maxBatch      int
+	flushInterval time.Duration
+	retryInterval time.Duration
+
+	delivered *metric.Counter
+	dropped   *metric.Counter
+	failures  *metric.Counter
+
+	// notifyC signals the worker that events were queued.
+	notifyC chan struct{}
+
+	mu struct {
+		sync.Mutex
+		queue []WebhookEvent
+		// underReplicated is the last number of under-replicated ranges
+		// reported by each store.
+		underReplicated map[roachpb.StoreID]int64
+	}
+}
+
+// newEventSink returns a sink forwarding the events of the given
+// comma-separated types, or of all types if empty, to the webhook at the
+// given URL. Requests are signed with the secret unless it is empty. The
+// sink's metrics are added to the registry.
+func newEventSink(webhookURL, secret, types string, nodeID roachpb.NodeID, registry *metric.Registry) (*eventSink, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, util.Errorf("invalid event webhook URL %q: %s", webhookURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, util.Errorf("event webhook URL %q must use http or https", webhookURL)
+	}
+	es := &eventSink{
+		url:           webhookURL,
+		secret:        []byte(secret),
+		nodeID:        nodeID,
+		client:        &http.Client{Timeout: defaultEventSinkTimeout},
+		maxQueued:     defaultEventSinkMaxQueued,
+		maxBatch:      defaultEventSinkMaxBatch,
+		flushInterval: defaultEventSinkFlushInterval,
+		retryInterval: defaultEventSinkRetryInterval,
+		delivered:     registry.Counter("events.webhook.delivered"),
+		dropped:       registry.Counter("events.webhook.dropped"),
+		failures:      registry.Counter("events.webhook.failures"),
+		notifyC:       make(chan struct{}, 1),
+	}
+	es.mu.underReplicated = map[roachpb.StoreID]int64{}
+	if types != "" {
+		es.types = map[string]struct{}{}
+		for _, typ := range strings.Split(types, ",") {
+			typ = strings.TrimSpace(typ)
+			if !isWebhookEventType(typ) {
+				return nil, util.Errorf("unknown event type %q; expected one of %s",
+					typ, strings.Join(webhookEventTypes, ", "))
+			}
+			es.types[typ] = struct{}{}
+		}
+	}
+	return es, nil
+}
+
+func isWebhookEventType(typ string) bool {
+	for _, t := range webhookEventTypes {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueue queues the event for delivery, unless events of its type aren't
+// forwarded.
+func (es *eventSink) enqueue(event WebhookEvent) {
+	if es.types != nil {
+		if _, ok := es.types[event.Type]; !ok {
+			return
+		}
+	}
+	es.mu.Lock()
+	es.mu.queue = append(es.mu.queue, event)
+	es.trimLocked()
+	es.mu.Unlock()
+	select {
+	case es.notifyC <- struct{}{}:
+	default:
+	}
+}
+
+// trimLocked drops the oldest queued events beyond maxQueued.
+func (es *eventSink) trimLocked() {
+	if n := len(es.mu.queue) - es.maxQueued; n > 0 {
+		es.mu.queue = es.mu.queue[n:]
+		es.dropped.Inc(int64(n))
+	}
+}
+
+// processFeedEvent queues the events of interest among those published on
+// the node's event feed.
+func (es *eventSink) processFeedEvent(event interface{}) {
+	switch e := event.(type) {
+	case *storage.ReplicationStatusEvent:
+		ranges := e.LeaderRangeCount - e.ReplicatedRangeCount
+		es.mu.Lock()
+		prev := es.mu.underReplicated[e.StoreID]
+		es.mu.underReplicated[e.StoreID] = ranges
+		es.mu.Unlock()
+		if ranges != prev {
+			es.enqueue(WebhookEvent{
+				Type:      EventTypeRangesUnderReplicated,
+				Timestamp: time.Now(),
+				NodeID:    es.nodeID,
+				Details: RangesUnderReplicatedDetails{
+					StoreID: e.StoreID,
+					Ranges:  ranges,
+				},
+			})
+		}
+	}
+}
+
+// start subscribes the sink to the event feed and starts the worker which
+// delivers the queued events. Once notified of new events, the worker waits
+// for flushInterval to let further events join the batch.
+func (es *eventSink) start(feed *util.Feed, stopper *stop.Stopper) {
+	feed.Subscribe(es.processFeedEvent)
+	stopper.RunWorker(func() {
+		for {
+			select {
+			case <-es.notifyC:
+			case <-stopper.ShouldStop():
+				return
+			}
+			select {
+			case <-time.After(es.flushInterval):
+			case <-stopper.ShouldStop():
+				return
+			}
+			for {
+				more, err := es.deliverBatch()
+				if err != nil {
+					es.failures.Inc(1)
+					log.Warningf("unable to deliver events to webhook: %s", err)
+					select {
+					case <-time.After(es.retryInterval):
+						continue
+					case <-stopper.ShouldStop():
+						return
+					}
+				}
+				if !more {
+					break
+				}
+			}
+		}
+	})
+}
+
+// deliverBatch posts up to maxBatch of the oldest queued events to the
+// webhook. If they can't be delivered, they are returned to the front of the
+// queue. It returns whether events remain queued.
+func (es *eventSink) deliverBatch() (bool, error) {
+	es.mu.Lock()
+	n := len(es.mu.queue)
+	if n > es.maxBatch {
+		n = es.maxBatch
+	}
+	batch := es.mu.queue[:n:n]
+	es.mu.queue = es.mu.queue[n:]
+	es.mu.Unlock()
+	if n == 0 {
+		return false, nil
+	}
+
+	if err := es.post(batch); err != nil {
+		es.mu.Lock()
+		es.mu.queue = append(batch, es.mu.queue...)
+		es.trimLocked()
+		es.mu.Unlock()
+		return true, err
+	}
+	es.delivered.Inc(int64(n))
+
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	return len(es.mu.queue) > 0, nil
+}
+
+// post sends the events to the webhook in a single request.
+func (es *eventSink) post(events []WebhookEvent) error {
+	body, err := json.Marshal(WebhookRequest{Events: events})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", es.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(util.ContentTypeHeader, util.JSONContentType)
+	if len(es.secret) > 0 {
+		req.Header.Set(webhookSignatureHeader, signWebhookBody(es.secret, body))
+	}
+	resp, err := es.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return util.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// signWebhookBody returns the signature of a webhook request with the given
+// body: the hex encoded HMAC-SHA256 of the body keyed by the secret.
+func signWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// startEventLogPoller periodically queues the events which this node has
+// recorded in the event log since the poller was started. Events are read in
+// timestamp order, so an event whose transaction commits after an event with
+// a later timestamp has been read is not forwarded.
+func (es *eventSink) startEventLogPoller(db *client.DB, executor sql.InternalExecutor, stopper *stop.Stopper) {
+	since := time.Now()
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(eventLogPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				stopper.RunTask(func() {
+					var err error
+					if since, err = es.pollEventLog(db, executor, since); err != nil {
+						log.Warningf("unable to read the event log: %s", err)
+					}
+				})
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// pollEventLog queues the events recorded by this node in the event log after
+// the given time. It returns the timestamp of the last event read.
+func (es *eventSink) pollEventLog(db *client.DB, executor sql.InternalExecutor, since time.Time) (time.Time, error) {
+	stmt := fmt.Sprintf(`SELECT timestamp, eventType, targetID, reportingID, info FROM system.eventlog `+
+		`WHERE timestamp > $1 AND reportingID = $2 ORDER BY timestamp LIMIT %d`, eventLogPollLimit)
+	var rows []parser.DTuple
+	if pErr := db.Txn(func(txn *client.Txn) *roachpb.Error {
+		var pErr *roachpb.Error
+		rows, pErr = executor.QueryRowsInTransaction(txn, stmt, since.UTC(), int64(es.nodeID))
+		return pErr
+	}); pErr != nil {
+		return since, pErr.GoError()
+	}
+	for _, row := range rows {
+		entry, err := makeEventEntry(row)
+		if err != nil {
+			return since, err
+		}
+		event := WebhookEvent{
+			Type:      string(entry.EventType),
+			Timestamp: entry.Timestamp,
+			NodeID:    es.nodeID,
+			TargetID:  entry.TargetID,
+			Details:   entry.Details,
+		}
+		if event.Details == nil && entry.Info != "" {
+			event.Details = entry.Info
+		}
+		es.enqueue(event)
+		since = entry.Timestamp
+	}
+	return since, nil
+}
@@ -0,0 +1,264 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	gosql "database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// webhookReceiver is an in-process event webhook which passes the requests it
+// receives on requestC after verifying their signature. While stalled,
+// requests block until the receiver is unstalled and then fail.
+type webhookReceiver struct {
+	*httptest.Server
+	requestC chan WebhookRequest
+	stalled  int32
+	releaseC chan struct{}
+}
+
+func newWebhookReceiver(t *testing.T, secret string) *webhookReceiver {
+	wr := &webhookReceiver{
+		requestC: make(chan WebhookRequest, 100),
+		releaseC: make(chan struct{}),
+	}
+	wr.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&wr.stalled) == 1 {
+			<-wr.releaseC
+			http.Error(w, "stalled", http.StatusServiceUnavailable)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if secret != "" {
+			if a, e := r.Header.Get(webhookSignatureHeader), signWebhookBody([]byte(secret), body); a != e {
+				t.Errorf("expected signature %s, got %s", e, a)
+			}
+		}
+		var req WebhookRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Error(err)
+			return
+		}
+		wr.requestC <- req
+	}))
+	return wr
+}
+
+func (wr *webhookReceiver) stall() {
+	atomic.StoreInt32(&wr.stalled, 1)
+}
+
+func (wr *webhookReceiver) unstall() {
+	atomic.StoreInt32(&wr.stalled, 0)
+	close(wr.releaseC)
+}
+
+// nextRequest returns the next request received by the webhook.
+func (wr *webhookReceiver) nextRequest(t *testing.T) WebhookRequest {
+	select {
+	case req := <-wr.requestC:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a webhook request")
+		return WebhookRequest{}
+	}
+}
+
+// targetIDs returns the target IDs of the events.
+func targetIDs(events []WebhookEvent) []int64 {
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.TargetID)
+	}
+	return ids
+}
+
+// TestEventSinkDelivery verifies that events of the configured types are
+// delivered to the webhook in batches.
+func TestEventSinkDelivery(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	const secret = "s3cr3t"
+	wr := newWebhookReceiver(t, secret)
+	defer wr.Close()
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+
+	types := string(sql.EventLogCreateTable) + "," + EventTypeRangesUnderReplicated
+	es, err := newEventSink(wr.URL, secret, types, 1, metric.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	es.maxBatch = 2
+	es.flushInterval = 50 * time.Millisecond
+	feed := util.NewFeed(stopper)
+	es.start(feed, stopper)
+
+	es.enqueue(WebhookEvent{Type: string(sql.EventLogCreateTable), TargetID: 1})
+	es.enqueue(WebhookEvent{Type: string(sql.EventLogDropTable), TargetID: 2})
+	es.enqueue(WebhookEvent{Type: string(sql.EventLogCreateTable), TargetID: 3})
+	es.enqueue(WebhookEvent{Type: string(sql.EventLogCreateTable), TargetID: 4})
+	for _, expected := range [][]int64{{1, 3}, {4}} {
+		if a := targetIDs(wr.nextRequest(t).Events); !reflect.DeepEqual(a, expected) {
+			t.Errorf("expected batch of events %v, got %v", expected, a)
+		}
+	}
+
+	// Changes in the number of under-replicated ranges are forwarded.
+	for _, replicated := range []int64{3, 1, 1, 3} {
+		feed.Publish(&storage.ReplicationStatusEvent{
+			StoreID:              2,
+			LeaderRangeCount:     3,
+			ReplicatedRangeCount: replicated,
+		})
+	}
+	feed.Flush()
+	var events []WebhookEvent
+	for len(events) < 2 {
+		events = append(events, wr.nextRequest(t).Events...)
+	}
+	for i, ranges := range []float64{2, 0} {
+		details, ok := events[i].Details.(map[string]interface{})
+		if events[i].Type != EventTypeRangesUnderReplicated || !ok ||
+			details["storeID"] != float64(2) || details["ranges"] != ranges {
+			t.Errorf("%d: expected %s event for %v ranges of store 2, got %+v",
+				i, EventTypeRangesUnderReplicated, ranges, events[i])
+		}
+	}
+	if a, e := es.delivered.Count(), int64(5); a != e {
+		t.Errorf("expected %d delivered events, got %d", e, a)
+	}
+
+	if _, err := newEventSink(wr.URL, "", "create_table,nonsense", 1, metric.NewRegistry()); err == nil {
+		t.Error("expected unknown event type to be rejected")
+	}
+	if _, err := newEventSink("ftp://localhost", "", "", 1, metric.NewRegistry()); err == nil {
+		t.Error("expected non-HTTP URL to be rejected")
+	}
+}
+
+// TestEventSinkStalledReceiver verifies that the oldest events are dropped
+// while the webhook doesn't respond, and the others are delivered once it
+// recovers.
+func TestEventSinkStalledReceiver(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	wr := newWebhookReceiver(t, "")
+	defer wr.Close()
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+
+	es, err := newEventSink(wr.URL, "", "", 1, metric.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	es.maxQueued = 3
+	es.flushInterval = time.Millisecond
+	es.retryInterval = 10 * time.Millisecond
+	es.client.Timeout = 50 * time.Millisecond
+	es.start(util.NewFeed(stopper), stopper)
+
+	wr.stall()
+	for i := 1; i <= 3; i++ {
+		es.enqueue(WebhookEvent{Type: string(sql.EventLogCreateTable), TargetID: int64(i)})
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if es.failures.Count() == 0 {
+			return util.Errorf("no failed delivery yet")
+		}
+		return nil
+	})
+	for i := 4; i <= 6; i++ {
+		es.enqueue(WebhookEvent{Type: string(sql.EventLogCreateTable), TargetID: int64(i)})
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if a, e := es.dropped.Count(), int64(3); a != e {
+			return util.Errorf("expected %d dropped events, got %d", e, a)
+		}
+		return nil
+	})
+
+	wr.unstall()
+	var events []WebhookEvent
+	for len(events) < 3 {
+		events = append(events, wr.nextRequest(t).Events...)
+	}
+	if a, e := targetIDs(events), []int64{4, 5, 6}; !reflect.DeepEqual(a, e) {
+		t.Errorf("expected events %v to be delivered, got %v", e, a)
+	}
+	if a, e := es.delivered.Count(), int64(3); a != e {
+		t.Errorf("expected %d delivered events, got %d", e, a)
+	}
+}
+
+// TestEventSinkEventLog verifies that a server forwards the events it records
+// in the event log to the configured webhook.
+func TestEventSinkEventLog(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	wr := newWebhookReceiver(t, "")
+	defer wr.Close()
+	defer func(d time.Duration) { eventLogPollInterval = d }(eventLogPollInterval)
+	eventLogPollInterval = 10 * time.Millisecond
+
+	s := &TestServer{Ctx: NewTestContext()}
+	s.Ctx.EventWebhookURL = wr.URL
+	s.Ctx.EventWebhookTypes = string(sql.EventLogNodeJoin) + "," + string(sql.EventLogCreateDatabase)
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	db, err := gosql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=%s",
+		security.RootUser, s.ServingAddr(), security.EmbeddedCertsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE DATABASE webhook_test`); err != nil {
+		t.Fatal(err)
+	}
+
+	events := map[string]WebhookEvent{}
+	for len(events) < 2 {
+		for _, event := range wr.nextRequest(t).Events {
+			events[event.Type] = event
+		}
+	}
+	if event, ok := events[string(sql.EventLogNodeJoin)]; !ok || event.NodeID != s.Gossip().GetNodeID() {
+		t.Errorf("expected node join event of node %d, got %+v", s.Gossip().GetNodeID(), events)
+	}
+	event := events[string(sql.EventLogCreateDatabase)]
+	if details, ok := event.Details.(map[string]interface{}); !ok || details["DatabaseName"] != "webhook_test" {
+		t.Errorf("expected create database event for webhook_test, got %+v", events)
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	// defaultHeatMapBuckets is the number of buckets of a heat map for which
+	// no number is requested.
+	defaultHeatMapBuckets = 100
+	// maxHeatMapBuckets bounds the number of buckets of a heat map, and thus
+	// the size of the response regardless of the number of ranges.
+	maxHeatMapBuckets = 1000
+	// heatMapMetaScanBatch is the number of range addressing records read at
+	// a time while walking meta2.
+	heatMapMetaScanBatch = 1000
+)
+
+// RangeLeaseStats are the MVCC stats of a range as reported by the replica
+// holding its leader lease.
+type RangeLeaseStats struct {
+	RangeID   roachpb.RangeID `json:"rangeID"`
+	NodeID    roachpb.NodeID  `json:"nodeID"`
+	LiveBytes int64           `json:"liveBytes"`
+	KeyCount  int64           `json:"keyCount"`
+}
+
+// HeatMapBucket aggregates the MVCC stats of contiguous ranges.
+type HeatMapBucket struct {
+	StartKey   roachpb.RKey `json:"startKey"`
+	EndKey     roachpb.RKey `json:"endKey"`
+	RangeCount int          `json:"rangeCount"`
+	LiveBytes  int64        `json:"liveBytes"`
+	KeyCount   int64        `json:"keyCount"`
+	// LeaseHolder is the node holding the leader leases of most of the
+	// bucket's ranges, or zero if the stats of none of them are known.
+	LeaseHolder roachpb.NodeID `json:"leaseHolder"`
+}
+
+// HeatMapResponse is the response of the heat map endpoint.
+type HeatMapResponse struct {
+	Buckets []HeatMapBucket `json:"buckets"`
+	// RangeCount is the total number of ranges covered by the buckets.
+	RangeCount int `json:"rangeCount"`
+	// UnreachableNodes are the nodes whose lease holder stats couldn't be
+	// retrieved. Ranges whose leases they hold are counted with zero stats.
+	UnreachableNodes []roachpb.NodeID `json:"unreachableNodes,omitempty"`
+}
+
+// parseHeatMapSpan returns the span given by the "start" and "end" query
+// parameters, which default to the bounds of the table data key space. The
+// bounds are either raw keys or, if they start with a slash, keys in their
+// pretty-printed form (e.g. "/Table/51/1").
+func parseHeatMapSpan(r *http.Request) (roachpb.RSpan, error) {
+	query := r.URL.Query()
+	span := roachpb.RSpan{
+		Key:    roachpb.RKey(keys.TableDataMin),
+		EndKey: roachpb.RKey(keys.TableDataMax),
+	}
+	var err error
+	if start := query.Get("start"); start != "" {
+		if span.Key, err = parseHeatMapKey(start); err != nil {
+			return span, err
+		}
+	}
+	if end := query.Get("end"); end != "" {
+		if span.EndKey, err = parseHeatMapKey(end); err != nil {
+			return span, err
+		}
+	}
+	if !span.Key.Less(span.EndKey) {
+		return span, util.Errorf("invalid span [%s, %s)", span.Key, span.EndKey)
+	}
+	return span, nil
+}
+
+// parseHeatMapKey parses a bound of the span of a heat map, which is a key in
+// its pretty-printed form if it starts with a slash and a raw key otherwise.
+func parseHeatMapKey(s string) (roachpb.RKey, error) {
+	if !strings.HasPrefix(s, "/") {
+		return roachpb.RKey(s), nil
+	}
+	key, err := keys.UglyPrint(s)
+	if err != nil {
+		return nil, err
+	}
+	return roachpb.RKey(key), nil
+}
+
+// handleHeatMap handles GET requests for the MVCC stats of a span of the key
+// space, given by the "start" and "end" query parameters and defaulting to
+// the table data. The ranges overlapping the span are coalesced into at most
+// "buckets" buckets of contiguous ranges; the buckets' bounds are those of
+// their ranges. The stats of each range are those of the replica holding its
+// leader lease, retrieved from every node holding replicas of the ranges.
+func (s *statusServer) handleHeatMap(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	span, err := parseHeatMapSpan(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	maxBuckets, err := parseInt64WithDefault(r.URL.Query().Get("buckets"), defaultHeatMapBuckets)
+	if err != nil || maxBuckets < 1 {
+		http.Error(w, fmt.Sprintf("buckets must be a positive number, got %q", r.URL.Query().Get("buckets")),
+			http.StatusBadRequest)
+		return
+	}
+	if maxBuckets > maxHeatMapBuckets {
+		maxBuckets = maxHeatMapBuckets
+	}
+
+	descs, err := lookupRangeDescriptors(s.db, span)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	nodes := map[roachpb.NodeID]struct{}{}
+	for _, desc := range descs {
+		for _, replica := range desc.Replicas {
+			nodes[replica.NodeID] = struct{}{}
+		}
+	}
+	stats, unreachable := s.fetchLeaseHolderStats(nodes, span)
+	respondAsJSON(w, r, HeatMapResponse{
+		Buckets:          makeHeatMapBuckets(descs, stats, int(maxBuckets)),
+		RangeCount:       len(descs),
+		UnreachableNodes: unreachable,
+	})
+}
+
+// lookupRangeDescriptors returns the descriptors of the ranges overlapping the
+// span in key order, read from their meta2 addressing records.
+func lookupRangeDescriptors(db *client.DB, span roachpb.RSpan) ([]roachpb.RangeDescriptor, error) {
+	var descs []roachpb.RangeDescriptor
+	// Addressing records are keyed by the end key of their range.
+	start := keys.MakeKey(keys.Meta2Prefix, span.Key.Next())
+	for {
+		rows, pErr := db.Scan(start, keys.MetaMax, heatMapMetaScanBatch)
+		if pErr != nil {
+			return nil, pErr.GoError()
+		}
+		for _, row := range rows {
+			var desc roachpb.RangeDescriptor
+			if err := row.ValueProto(&desc); err != nil {
+				return nil, err
+			}
+			if !desc.StartKey.Less(span.EndKey) {
+				return descs, nil
+			}
+			descs = append(descs, desc)
+		}
+		if len(rows) < heatMapMetaScanBatch {
+			return descs, nil
+		}
+		start = rows[len(rows)-1].Key.Next()
+	}
+}
+
+// fetchLeaseHolderStats retrieves the stats of the ranges overlapping the span
+// for which each of the nodes holds the leader lease, keyed by range ID. The
+// nodes are queried concurrently; those which can't be reached are returned
+// in ascending order.
+func (s *statusServer) fetchLeaseHolderStats(nodes map[roachpb.NodeID]struct{}, span roachpb.RSpan) (
+	map[roachpb.RangeID]RangeLeaseStats, []roachpb.NodeID) {
+	var mu sync.Mutex
+	stats := map[roachpb.RangeID]RangeLeaseStats{}
+	var unreachable []roachpb.NodeID
+	var wg sync.WaitGroup
+	for nodeID := range nodes {
+		wg.Add(1)
+		go func(nodeID roachpb.NodeID) {
+			defer wg.Done()
+			var nodeStats []RangeLeaseStats
+			var err error
+			if nodeID == s.gossip.GetNodeID() {
+				nodeStats, err = s.localLeaseHolderStats(span)
+			} else {
+				nodeStats, err = s.remoteLeaseHolderStats(nodeID, span)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Warningf("unable to retrieve lease holder stats of node %d: %s", nodeID, err)
+				unreachable = append(unreachable, nodeID)
+				return
+			}
+			for _, rangeStats := range nodeStats {
+				stats[rangeStats.RangeID] = rangeStats
+			}
+		}(nodeID)
+	}
+	wg.Wait()
+	sort.Sort(roachpb.NodeIDSlice(unreachable))
+	return stats, unreachable
+}
+
+// remoteLeaseHolderStats retrieves the lease holder stats of another node
+// from its status server.
+func (s *statusServer) remoteLeaseHolderStats(nodeID roachpb.NodeID, span roachpb.RSpan) ([]RangeLeaseStats, error) {
+	addr, err := s.gossip.GetNodeIDAddress(nodeID)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("start", string(span.Key))
+	query.Set("end", string(span.EndKey))
+	requestURL := fmt.Sprintf("%s://%s%sleaseholders/%d?%s",
+		s.ctx.HTTPRequestScheme(), addr, statusPrefix, nodeID, query.Encode())
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(util.AcceptHeader, util.JSONContentType)
+	resp, err := s.proxyClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.Errorf("status server responded with %s", resp.Status)
+	}
+	var stats struct {
+		Data []RangeLeaseStats `json:"d"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, err
+	}
+	return stats.Data, nil
+}
+
+// localLeaseHolderStats returns the stats of the ranges overlapping the span
+// for which the local stores hold an active leader lease.
+func (s *statusServer) localLeaseHolderStats(span roachpb.RSpan) ([]RangeLeaseStats, error) {
+	nodeID := s.gossip.GetNodeID()
+	stats := []RangeLeaseStats{}
+	err := s.stores.VisitStores(func(store *storage.Store) error {
+		now := store.Clock().Now()
+		store.VisitReplicas(func(rng *storage.Replica) bool {
+			desc := rng.Desc()
+			if !desc.StartKey.Less(span.EndKey) || !span.Key.Less(desc.EndKey) {
+				return true
+			}
+			if lease := rng.GetLeaderLease(); lease == nil || !lease.Covers(now) || !lease.OwnedBy(store.StoreID()) {
+				return true
+			}
+			ms := rng.GetMVCCStats()
+			stats = append(stats, RangeLeaseStats{
+				RangeID:   desc.RangeID,
+				NodeID:    nodeID,
+				LiveBytes: ms.LiveBytes,
+				KeyCount:  ms.KeyCount,
+			})
+			return true
+		})
+		return nil
+	})
+	return stats, err
+}
+
+// handleLeaseHolders handles GET requests for the stats of the ranges
+// overlapping the span given by the "start" and "end" query parameters for
+// which a node holds the leader lease.
+func (s *statusServer) handleLeaseHolders(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !local {
+		s.proxyRequest(nodeID, w, r)
+		return
+	}
+	span, err := parseHeatMapSpan(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	stats, err := s.localLeaseHolderStats(span)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	respondAsJSON(w, r, stats)
+}
+
+// makeHeatMapBuckets coalesces the ranges with the given descriptors, which
+// must be contiguous and in key order, into at most maxBuckets buckets of an
+// equal number of ranges, except for the last one. Ranges without stats are
+// counted with zero stats.
+func makeHeatMapBuckets(descs []roachpb.RangeDescriptor, stats map[roachpb.RangeID]RangeLeaseStats,
+	maxBuckets int) []HeatMapBucket {
+	buckets := []HeatMapBucket{}
+	perBucket := (len(descs) + maxBuckets - 1) / maxBuckets
+	for i := 0; i < len(descs); i += perBucket {
+		end := i + perBucket
+		if end > len(descs) {
+			end = len(descs)
+		}
+		bucket := HeatMapBucket{
+			StartKey:   descs[i].StartKey,
+			EndKey:     descs[end-1].EndKey,
+			RangeCount: end - i,
+		}
+		leases := map[roachpb.NodeID]int{}
+		for _, desc := range descs[i:end] {
+			rangeStats, ok := stats[desc.RangeID]
+			if !ok {
+				continue
+			}
+			bucket.LiveBytes += rangeStats.LiveBytes
+			bucket.KeyCount += rangeStats.KeyCount
+			leases[rangeStats.NodeID]++
+			if leases[rangeStats.NodeID] > leases[bucket.LeaseHolder] {
+				bucket.LeaseHolder = rangeStats.NodeID
+			}
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
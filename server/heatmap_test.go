@@ -0,0 +1,141 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestStatusHeatMap splits a span into ranges and verifies that the heat map
+// coalesces them into buckets whose stats add up to the data written.
+func TestStatusHeatMap(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ts := StartTestServer(t)
+	defer ts.Stop()
+
+	for _, splitKey := range []string{"a", "b", "c", "d", "e", "z"} {
+		if err := ts.db.AdminSplit(splitKey); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Ranges [a,b), [b,c), [c,d), [d,e) and [e,z) hold 1, 2, 0, 1 and 3 keys.
+	for _, key := range []string{"a1", "b1", "b2", "d1", "e1", "f1", "y1"} {
+		if pErr := ts.db.Put(key, "value"); pErr != nil {
+			t.Fatal(pErr)
+		}
+	}
+
+	heatMap := func(query string) HeatMapResponse {
+		var resp HeatMapResponse
+		if err := json.Unmarshal(getRequest(t, *ts, statusHeatMapPattern+query), &resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	nodeID := ts.Gossip().GetNodeID()
+
+	resp := heatMap("?start=a&end=z&buckets=2")
+	if resp.RangeCount != 5 || len(resp.Buckets) != 2 || len(resp.UnreachableNodes) != 0 {
+		t.Fatalf("expected 5 ranges in 2 buckets, got %+v", resp)
+	}
+	for i, expected := range []struct {
+		start, end string
+		rangeCount int
+		keyCount   int64
+	}{
+		{"a", "d", 3, 3},
+		{"d", "z", 2, 4},
+	} {
+		bucket := resp.Buckets[i]
+		if string(bucket.StartKey) != expected.start || string(bucket.EndKey) != expected.end ||
+			bucket.RangeCount != expected.rangeCount || bucket.KeyCount != expected.keyCount {
+			t.Errorf("%d: expected bucket [%s,%s) of %d ranges with %d keys, got %+v", i,
+				expected.start, expected.end, expected.rangeCount, expected.keyCount, bucket)
+		}
+		if bucket.LiveBytes <= 0 || bucket.LeaseHolder != nodeID {
+			t.Errorf("%d: expected live bytes with lease holder %d, got %+v", i, nodeID, bucket)
+		}
+	}
+
+	// Fewer ranges than buckets leave a bucket per range, with totals unchanged.
+	resp = heatMap("?start=a&end=z&buckets=10")
+	if len(resp.Buckets) != 5 {
+		t.Fatalf("expected a bucket per range, got %+v", resp)
+	}
+	var keyCount int64
+	for i, expected := range []int64{1, 2, 0, 1, 3} {
+		if bucket := resp.Buckets[i]; bucket.RangeCount != 1 || bucket.KeyCount != expected {
+			t.Errorf("%d: expected a range with %d keys, got %+v", i, expected, bucket)
+		}
+		keyCount += resp.Buckets[i].KeyCount
+	}
+	if keyCount != 7 {
+		t.Errorf("expected 7 keys in total, got %d", keyCount)
+	}
+
+	// A span within a range is covered by that range.
+	if resp := heatMap("?start=b1&end=b3"); resp.RangeCount != 1 || resp.Buckets[0].KeyCount != 2 {
+		t.Errorf("expected range [b,c) with 2 keys, got %+v", resp)
+	}
+
+	// Bounds can be given in their pretty-printed form.
+	if resp := heatMap("?start=e&end=/Max&buckets=1"); resp.RangeCount != 2 || resp.Buckets[0].KeyCount != 3 {
+		t.Errorf("expected ranges [e,z) and [z,/Max) with 3 keys, got %+v", resp)
+	}
+
+	for _, query := range []string{"?buckets=0", "?buckets=x", "?start=z&end=a", "?start=/Table/x"} {
+		req, err := http.NewRequest("GET", statusHeatMapPattern+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		ts.status.handleHeatMap(w, req, nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+// TestMakeHeatMapBuckets verifies that ranges whose stats are unknown are
+// counted with zero stats and don't determine the lease holder.
+func TestMakeHeatMapBuckets(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	descs := []roachpb.RangeDescriptor{
+		{RangeID: 1, StartKey: roachpb.RKey("a"), EndKey: roachpb.RKey("b")},
+		{RangeID: 2, StartKey: roachpb.RKey("b"), EndKey: roachpb.RKey("c")},
+		{RangeID: 3, StartKey: roachpb.RKey("c"), EndKey: roachpb.RKey("d")},
+	}
+	stats := map[roachpb.RangeID]RangeLeaseStats{
+		1: {RangeID: 1, NodeID: 2, LiveBytes: 10, KeyCount: 1},
+		3: {RangeID: 3, NodeID: 2, LiveBytes: 20, KeyCount: 2},
+	}
+	buckets := makeHeatMapBuckets(descs, stats, 1)
+	if len(buckets) != 1 {
+		t.Fatalf("expected a single bucket, got %+v", buckets)
+	}
+	if b := buckets[0]; string(b.StartKey) != "a" || string(b.EndKey) != "d" || b.RangeCount != 3 ||
+		b.LiveBytes != 30 || b.KeyCount != 3 || b.LeaseHolder != 2 {
+		t.Errorf("unexpected bucket %+v", b)
+	}
+	if buckets := makeHeatMapBuckets(descs[1:2], stats, 1); buckets[0].LeaseHolder != 0 || buckets[0].KeyCount != 0 {
+		t.Errorf("expected a bucket without stats, got %+v", buckets[0])
+	}
+}
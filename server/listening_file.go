@@ -0,0 +1,81 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// ListeningInfo describes a started node and the addresses it serves
+// clients on. It is written as JSON to the file given by
+// Context.ListeningURLFile once the node is ready to serve.
+type ListeningInfo struct {
+	NodeID    roachpb.NodeID `json:"node_id"`
+	ClusterID string         `json:"cluster_id"`
+	RPCAddr   string         `json:"rpc_addr"`
+	HTTPURL   string         `json:"http_url"`
+	SQLAddr   string         `json:"sql_addr"`
+}
+
+// writeListeningFile writes the node's ListeningInfo to
+// Context.ListeningURLFile, if set, and removes the file when the server
+// stops. The file is written to a temporary file first and renamed, so
+// readers never observe a partial document.
+func (s *Server) writeListeningFile() error {
+	path := s.ctx.ListeningURLFile
+	if path == "" {
+		return nil
+	}
+	info := ListeningInfo{
+		NodeID:    s.node.Descriptor.NodeID,
+		ClusterID: s.node.ClusterID,
+		RPCAddr:   s.listener.Addr().String(),
+		HTTPURL:   s.ctx.HTTPRequestScheme() + "://" + s.listener.Addr().String(),
+		SQLAddr:   s.pgServer.Addr().String(),
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+	_, err = f.Write(append(data, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	s.stopper.AddCloser(stop.CloserFn(func() {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.Warningf("unable to remove listening file %s: %s", path, err)
+		}
+	}))
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestListeningURLFile starts a server in the background and polls for its
+// listening file, the way an orchestration script would.
+func TestListeningURLFile(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir := util.CreateTempDir(t, "listening_file")
+	defer util.CleanupDir(dir)
+	path := filepath.Join(dir, "listening.json")
+
+	ctx := NewTestContext()
+	ctx.ListeningURLFile = path
+	s := &TestServer{Ctx: ctx}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.Start()
+	}()
+
+	var data []byte
+	util.SucceedsWithin(t, 10*time.Second, func() error {
+		var err error
+		data, err = ioutil.ReadFile(path)
+		return err
+	})
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	var info ListeningInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		t.Fatalf("unable to parse %q: %s", data, err)
+	}
+	expected := ListeningInfo{
+		NodeID:    s.Gossip().GetNodeID(),
+		ClusterID: s.node.ClusterID,
+		RPCAddr:   s.ServingAddr(),
+		HTTPURL:   ctx.HTTPRequestScheme() + "://" + s.ServingAddr(),
+		SQLAddr:   s.PGAddr(),
+	}
+	if info != expected {
+		t.Errorf("expected %+v, got %+v", expected, info)
+	}
+	if info.NodeID == 0 || info.ClusterID == "" {
+		t.Errorf("expected the node and cluster IDs to be set, got %+v", info)
+	}
+
+	s.Stop()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed on shutdown, got %v", path, err)
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"container/list"
 	"fmt"
 	"net"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/context"
@@ -38,6 +40,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/cockroachdb/cockroach/util/tracer"
 	"github.com/gogo/protobuf/proto"
@@ -69,6 +72,12 @@ type Node struct {
 	feed       status.NodeEventFeed   // Feed publisher for local events
 	status     *status.NodeStatusMonitor
 	startedAt  int64
+	// initialBoot is true if this node allocated its NodeID while starting,
+	// i.e. this is the first time it has joined the cluster.
+	initialBoot bool
+	// maintenance is 1 while the node is in maintenance mode. Accessed
+	// atomically; see SetMaintenance.
+	maintenance int32
 }
 
 // allocateNodeID increments the node id generator key to allocate
@@ -97,6 +106,7 @@ func allocateStoreIDs(nodeID roachpb.NodeID, inc int64, db *client.DB) (roachpb.
 func GetBootstrapSchema() sql.MetadataSchema {
 	schema := sql.MakeMetadataSchema()
 	storage.AddEventLogToMetadataSchema(&schema)
+	sql.AddEventLogToMetadataSchema(&schema)
 	return schema
 }
 
@@ -112,7 +122,7 @@ func BootstrapCluster(clusterID string, engines []engine.Engine, stopper *stop.S
 	ctx.Clock = hlc.NewClock(hlc.UnixNano)
 	// Create a KV DB with a local sender.
 	stores := storage.NewStores(ctx.Clock)
-	sender := kv.NewTxnCoordSender(stores, ctx.Clock, false, nil, stopper)
+	sender := kv.NewTxnCoordSender(stores, ctx.Clock, false, nil, stopper, nil)
 	ctx.DB = client.NewDB(sender)
 	ctx.Transport = storage.NewLocalRPCTransport(stopper)
 	for i, eng := range engines {
@@ -140,6 +150,11 @@ func BootstrapCluster(clusterID string, engines []engine.Engine, stopper *stop.S
 		// first store.
 		if i == 0 {
 			initialValues := GetBootstrapSchema().GetInitialValues()
+			cvValue, err := clusterVersionValue()
+			if err != nil {
+				return nil, err
+			}
+			initialValues = append(initialValues, cvValue)
 			if err := s.BootstrapRange(initialValues); err != nil {
 				return nil, err
 			}
@@ -165,6 +180,51 @@ func BootstrapCluster(clusterID string, engines []engine.Engine, stopper *stop.S
 	return ctx.DB, nil
 }
 
+// clusterVersionValue returns the initial value of the cluster version, which
+// is the version of the binary bootstrapping the cluster.
+func clusterVersionValue() (roachpb.KeyValue, error) {
+	info := util.GetBuildInfo()
+	cv := status.ClusterVersion{
+		Version:   info.Version,
+		BinaryTag: info.Tag,
+	}
+	value := roachpb.Value{}
+	if err := value.SetProto(&cv); err != nil {
+		return roachpb.KeyValue{}, err
+	}
+	return roachpb.KeyValue{Key: keys.ClusterVersionKey, Value: value}, nil
+}
+
+// readClusterVersion reads the version recorded when the cluster was
+// bootstrapped. The version is zero for clusters bootstrapped before the
+// version was recorded.
+func readClusterVersion(db *client.DB) (status.ClusterVersion, error) {
+	var cv status.ClusterVersion
+	if pErr := db.GetProto(keys.ClusterVersionKey, &cv); pErr != nil {
+		return status.ClusterVersion{}, pErr.GoError()
+	}
+	return cv, nil
+}
+
+// verifyClusterVersion returns an error if a binary with the given build
+// information can't join a cluster of the given version. Clusters which
+// didn't record their version are not checked.
+func verifyClusterVersion(cv status.ClusterVersion, info util.BuildInfo) error {
+	if cv.Version == 0 {
+		log.Warningf("cluster version is not recorded; skipping version check")
+		return nil
+	}
+	if cv.Version < info.MinimumSupportedVersion {
+		return util.Errorf("cluster version %d (bootstrapped by binary %q) is older than the minimum version %d "+
+			"supported by this binary (%q)", cv.Version, cv.BinaryTag, info.MinimumSupportedVersion, info.Tag)
+	}
+	if cv.Version > info.Version {
+		return util.Errorf("cluster version %d (bootstrapped by binary %q) is newer than the version %d "+
+			"of this binary (%q)", cv.Version, cv.BinaryTag, info.Version, info.Tag)
+	}
+	return nil
+}
+
 // NewNode returns a new instance of Node.
 func NewNode(ctx storage.StoreContext, metaRegistry *metric.Registry, stopper *stop.Stopper) *Node {
 	return &Node{
@@ -182,8 +242,11 @@ func (n *Node) context() context.Context {
 
 // initDescriptor initializes the node descriptor with the server
 // address and the node attributes.
-func (n *Node) initDescriptor(addr net.Addr, attrs roachpb.Attributes) {
+func (n *Node) initDescriptor(addr, sqlAddr net.Addr, attrs roachpb.Attributes) {
 	n.Descriptor.Address = util.MakeUnresolvedAddr(addr.Network(), addr.String())
+	if sqlAddr != nil {
+		n.Descriptor.SQLAddress = util.MakeUnresolvedAddr(sqlAddr.Network(), sqlAddr.String())
+	}
 	n.Descriptor.Attrs = attrs
 }
 
@@ -215,6 +278,7 @@ func (n *Node) initNodeID(id roachpb.NodeID) {
 		if id == 0 {
 			log.Fatal("new node allocated illegal ID 0")
 		}
+		n.initialBoot = true
 		n.ctx.Gossip.SetNodeID(id)
 	} else {
 		log.Infof("node ID %d initialized", id)
@@ -229,9 +293,9 @@ func (n *Node) initNodeID(id roachpb.NodeID) {
 // start starts the node by registering the storage instance for the
 // RPC service "Node" and initializing stores for each specified
 // engine. Launches periodic store gossiping in a goroutine.
-func (n *Node) start(rpcServer *rpc.Server, addr net.Addr, engines []engine.Engine,
+func (n *Node) start(rpcServer *rpc.Server, addr, sqlAddr net.Addr, engines []engine.Engine,
 	attrs roachpb.Attributes) error {
-	n.initDescriptor(addr, attrs)
+	n.initDescriptor(addr, sqlAddr, attrs)
 	const method = "Node.Batch"
 	if err := rpcServer.Register(method, n.executeCmd, &roachpb.BatchRequest{}); err != nil {
 		log.Fatalf("unable to register node service with RPC server: %s", err)
@@ -424,7 +488,8 @@ func (n *Node) startGossip(stopper *stop.Stopper) {
 	})
 }
 
-// gossipStores broadcasts each store to the gossip network.
+// gossipStores broadcasts each store, and whether the node is in
+// maintenance mode, to the gossip network.
 func (n *Node) gossipStores() {
 	if err := n.stores.VisitStores(func(s *storage.Store) error {
 		s.GossipStore()
@@ -432,6 +497,16 @@ func (n *Node) gossipStores() {
 	}); err != nil {
 		panic(err)
 	}
+	n.gossipMaintenance()
+}
+
+// gossipMaintenance broadcasts whether the node is in maintenance mode.
+func (n *Node) gossipMaintenance() {
+	key := gossip.MakeNodeMaintenanceKey(n.Descriptor.NodeID)
+	val := strconv.FormatBool(n.InMaintenance())
+	if err := n.ctx.Gossip.AddInfo(key, []byte(val), 0); err != nil {
+		log.Warningf("unable to gossip maintenance mode of node %d: %s", n.Descriptor.NodeID, err)
+	}
 }
 
 // startPublishStatuses starts a loop which periodically instructs each store to
@@ -462,6 +537,95 @@ func (n *Node) publishStoreStatuses() error {
 	})
 }
 
+// SetDraining sets the draining mode on all of the node's stores; see
+// storage.Store.SetDraining.
+func (n *Node) SetDraining(drain bool) {
+	if err := n.stores.VisitStores(func(s *storage.Store) error {
+		s.SetDraining(drain)
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// SetMaintenance puts the node into, or takes it out of, maintenance mode.
+// While in maintenance mode, no new replicas are allocated to the node's
+// stores and the stores don't acquire new leader leases; see
+// storage.Store.SetMaintenance. The mode is gossiped so that the rest of the
+// cluster learns of it immediately.
+func (n *Node) SetMaintenance(maintenance bool) {
+	var v int32
+	if maintenance {
+		v = 1
+	}
+	atomic.StoreInt32(&n.maintenance, v)
+	if err := n.stores.VisitStores(func(s *storage.Store) error {
+		s.SetMaintenance(maintenance)
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+	n.status.SetMaintenance(maintenance)
+	n.gossipMaintenance()
+}
+
+// InMaintenance returns whether the node is in maintenance mode; see
+// SetMaintenance.
+func (n *Node) InMaintenance() bool {
+	return atomic.LoadInt32(&n.maintenance) == 1
+}
+
+// leaderLeaseCount returns the number of leader leases held by the node's
+// stores on ranges which have other replicas.
+func (n *Node) leaderLeaseCount() int {
+	count := 0
+	if err := n.stores.VisitStores(func(s *storage.Store) error {
+		count += s.LeaderLeaseCount()
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// recordJoinEvent records an event in the event log indicating that this node
+// has either joined the cluster for the first time or has restarted and
+// rejoined it. The event is written asynchronously and retried until it
+// succeeds or the node is stopped.
+func (n *Node) recordJoinEvent() {
+	logEventType := sql.EventLogNodeRestart
+	if n.initialBoot {
+		logEventType = sql.EventLogNodeJoin
+	}
+	eventLogger := sql.EventLogger{InternalExecutor: n.ctx.SQLExecutor}
+	n.stopper.RunWorker(func() {
+		retryOpts := retry.Options{
+			InitialBackoff: 50 * time.Millisecond,
+			MaxBackoff:     5 * time.Second,
+			Multiplier:     2,
+			Closer:         n.stopper.ShouldStop(),
+		}
+		for r := retry.Start(retryOpts); r.Next(); {
+			pErr := n.ctx.DB.Txn(func(txn *client.Txn) *roachpb.Error {
+				return eventLogger.InsertEventRecord(txn,
+					logEventType,
+					int32(n.Descriptor.NodeID),
+					int32(n.Descriptor.NodeID),
+					sql.EventLogNodeInfo{
+						Descriptor: n.Descriptor,
+						ClusterID:  n.ClusterID,
+						StartedAt:  n.startedAt,
+					},
+				)
+			})
+			if pErr == nil {
+				return
+			}
+			log.Warningf("unable to log %s event for node %d: %s", logEventType, n.Descriptor.NodeID, pErr)
+		}
+	})
+}
+
 // executeCmd interprets the given message as a *roachpb.BatchRequest and sends it
 // via the local sender.
 func (n *Node) executeCmd(argsI proto.Message) (proto.Message, error) {
@@ -471,7 +635,8 @@ func (n *Node) executeCmd(argsI proto.Message) (proto.Message, error) {
 	f := func() {
 		// TODO(tschottdorf) get a hold of the client's ID, add it to the
 		// context before dispatching, and create an ID for tracing the request.
-		trace := n.ctx.Tracer.NewTrace(tracer.Node, ba)
+		// If the client is on this node, the trace is attached to its trace.
+		trace := n.ctx.Tracer.JoinTrace(tracer.Node, ba, ba.TraceContext)
 		defer trace.Finalize()
 		defer trace.Epoch("node")()
 		ctx := tracer.ToCtx((*Node)(n).context(), trace)
@@ -22,6 +22,7 @@ import (
 	"net"
 	"reflect"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -41,6 +42,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
+	"github.com/cockroachdb/cockroach/util/tracer"
 )
 
 // createTestNode creates an rpc server using the specified address,
@@ -92,7 +94,7 @@ func createTestNode(addr net.Addr, engines []engine.Engine, gossipBS net.Addr, t
 func createAndStartTestNode(addr net.Addr, engines []engine.Engine, gossipBS net.Addr, t *testing.T) (
 	*rpc.Server, net.Addr, *Node, *stop.Stopper) {
 	rpcServer, addr, _, node, stopper := createTestNode(addr, engines, gossipBS, t)
-	if err := node.start(rpcServer, addr, engines, roachpb.Attributes{}); err != nil {
+	if err := node.start(rpcServer, addr, nil, engines, roachpb.Attributes{}); err != nil {
 		t.Fatal(err)
 	}
 	return rpcServer, addr, node, stopper
@@ -137,6 +139,7 @@ func TestBootstrapCluster(t *testing.T) {
 	var expectedKeys = keySlice{
 		roachpb.MakeKey(roachpb.Key("\x02"), roachpb.KeyMax),
 		roachpb.MakeKey(roachpb.Key("\x03"), roachpb.KeyMax),
+		roachpb.Key("\x04cluster-version"),
 		roachpb.Key("\x04node-idgen"),
 		roachpb.Key("\x04range-tree-root"),
 		roachpb.Key("\x04store-idgen"),
@@ -278,7 +281,7 @@ func TestCorruptedClusterID(t *testing.T) {
 	engines := []engine.Engine{e}
 	server, serverAddr, _, node, stopper := createTestNode(util.CreateTestAddr("tcp"), engines, nil, t)
 	stopper.Stop()
-	if err := node.start(server, serverAddr, engines, roachpb.Attributes{}); !testutils.IsError(err, "unidentified store") {
+	if err := node.start(server, serverAddr, nil, engines, roachpb.Attributes{}); !testutils.IsError(err, "unidentified store") {
 		t.Errorf("unexpected error %v", err)
 	}
 }
@@ -615,3 +618,132 @@ func TestStatusSummaries(t *testing.T) {
 	compareNodeStatus(t, ts, expectedNodeStatus, 3)
 	compareStoreStatus(t, ts, s, expectedStoreStatus, 3)
 }
+
+func TestVerifyClusterVersion(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	info := util.BuildInfo{Tag: "v2", Version: 3, MinimumSupportedVersion: 2}
+	testCases := []struct {
+		version int32
+		err     string
+	}{
+		{0, ""},
+		{1, "is older than the minimum version 2"},
+		{2, ""},
+		{3, ""},
+		{4, "is newer than the version 3"},
+	}
+	for i, tc := range testCases {
+		err := verifyClusterVersion(status.ClusterVersion{Version: tc.version, BinaryTag: "v1"}, info)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err)
+			}
+		} else if !testutils.IsError(err, tc.err) {
+			t.Errorf("%d: expected error %q, got %v", i, tc.err, err)
+		}
+	}
+}
+
+// TestClusterVersionCheck verifies that a node refuses to join a cluster
+// bootstrapped with a version its binary doesn't support, unless the check
+// is skipped.
+func TestClusterVersionCheck(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	// Simulate a binary which no longer supports the cluster's version.
+	origGetBuildInfo := util.GetBuildInfo
+	defer func() { util.GetBuildInfo = origGetBuildInfo }()
+	util.GetBuildInfo = func() util.BuildInfo {
+		info := origGetBuildInfo()
+		info.Tag = "future"
+		info.Version++
+		info.MinimumSupportedVersion = info.Version
+		return info
+	}
+
+	for _, skip := range []bool{false, true} {
+		ctx := NewTestContext()
+		r, err := resolver.NewResolver(&ctx.Context, s.ServingAddr())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx.GossipBootstrapResolvers = []resolver.Resolver{r}
+		ctx.SkipVersionCheck = skip
+		joiner := &TestServer{Ctx: ctx, SkipBootstrap: true}
+		err = joiner.Start()
+		joiner.Stop()
+		if skip {
+			if err != nil {
+				t.Errorf("expected node to start when skipping the version check, got %s", err)
+			}
+		} else if !testutils.IsError(err, `cluster version \d+ \(bootstrapped by binary ".*"\) is older than the minimum version \d+ supported by this binary \("future"\)`) {
+			t.Errorf("expected version check to fail, got %v", err)
+		}
+	}
+}
+
+// TestNodeTraceJoin verifies that the trace of a request sent to the local
+// node contains the events recorded by both the client and the replica.
+func TestNodeTraceJoin(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ts := StartTestServer(t)
+	defer ts.Stop()
+
+	var mu sync.Mutex
+	var traces []*tracer.Trace
+	ts.EventFeed().Subscribe(func(event interface{}) {
+		if trace, ok := event.(*tracer.Trace); ok {
+			mu.Lock()
+			traces = append(traces, trace)
+			mu.Unlock()
+		}
+	})
+
+	if pErr := ts.db.Put("traced", "value"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	ts.EventFeed().Flush()
+
+	// Other requests may have been traced concurrently, but one of the client
+	// traces must contain the events recorded by the client layers the Put
+	// went through and, nested in its RPC, the events recorded by the node
+	// and the replica which executed it.
+	clientEvents := []string{"sending batch", "sending RPC", "received reply"}
+	replicaEvents := []string{"node", "command queue", "raft", "applying batch"}
+	mu.Lock()
+	defer mu.Unlock()
+	var clientTraces int
+	for _, trace := range traces {
+		// Record the position of the first occurrence of each event.
+		pos := map[string]int{}
+		for i, item := range trace.Content {
+			if _, ok := pos[item.Name]; !ok {
+				pos[item.Name] = i
+			}
+		}
+		found := true
+		for _, name := range clientEvents {
+			_, ok := pos[name]
+			found = found && ok
+		}
+		if !found {
+			continue
+		}
+		clientTraces++
+		for _, name := range replicaEvents {
+			i, ok := pos[name]
+			found = found && ok && pos["sending RPC"] < i && i < pos["received reply"]
+		}
+		if found {
+			return
+		}
+	}
+	if clientTraces == 0 {
+		t.Fatalf("no trace among %d published traces contains the client events %v",
+			len(traces), clientEvents)
+	}
+	t.Fatalf("none of %d client traces contains the replica events %v within its RPC",
+		clientTraces, replicaEvents)
+}
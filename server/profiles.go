@@ -0,0 +1,284 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	// profileSuffix is the file name suffix of stored profiles.
+	profileSuffix = ".pprof"
+	// profileTimeFormat is the format of the capture time in the file names
+	// of stored profiles.
+	profileTimeFormat = "20060102T150405.000000000"
+	// defaultCPUProfileDuration is the duration of CPU profiles for which no
+	// duration is requested.
+	defaultCPUProfileDuration = 30 * time.Second
+	// maxCPUProfileDuration bounds the duration of CPU profiles.
+	maxCPUProfileDuration = 5 * time.Minute
+)
+
+var (
+	// maxStoredProfiles is the maximum number of profiles kept in the
+	// profile directory.
+	maxStoredProfiles = 20
+	// maxStoredProfileBytes is the maximum total size of the profiles kept in
+	// the profile directory.
+	maxStoredProfileBytes int64 = 256 << 20
+)
+
+// ProfileInfo describes a profile stored on a node.
+type ProfileInfo struct {
+	Name         string
+	SizeBytes    int64
+	ModTimeNanos int64
+}
+
+// profileStore captures profiles of the process to files in a directory,
+// evicting the oldest profiles to stay within maxStoredProfiles and
+// maxStoredProfileBytes.
+type profileStore struct {
+	dir string
+	// cpuProfiling is set while a CPU profile is being captured. Accessed
+	// atomically.
+	cpuProfiling int32
+}
+
+// capture writes a profile of the given type to a new file in the store's
+// directory and returns its description. CPU profiles are captured over the
+// given duration; only one of them may be captured at a time.
+func (ps *profileStore) capture(typ string, duration time.Duration) (ProfileInfo, error) {
+	var write func(io.Writer) error
+	switch typ {
+	case "heap":
+		write = func(w io.Writer) error {
+			// Report the heap as of the last GC, which is this one.
+			runtime.GC()
+			return pprof.Lookup("heap").WriteTo(w, 0)
+		}
+	case "goroutine":
+		write = func(w io.Writer) error {
+			return pprof.Lookup("goroutine").WriteTo(w, 0)
+		}
+	case "cpu":
+		if !atomic.CompareAndSwapInt32(&ps.cpuProfiling, 0, 1) {
+			return ProfileInfo{}, errCPUProfileInProgress
+		}
+		defer atomic.StoreInt32(&ps.cpuProfiling, 0)
+		write = func(w io.Writer) error {
+			if err := pprof.StartCPUProfile(w); err != nil {
+				return err
+			}
+			time.Sleep(duration)
+			pprof.StopCPUProfile()
+			return nil
+		}
+	default:
+		return ProfileInfo{}, util.Errorf("unknown profile type %q", typ)
+	}
+
+	if err := os.MkdirAll(ps.dir, 0755); err != nil {
+		return ProfileInfo{}, err
+	}
+	name := typ + "." + time.Now().UTC().Format(profileTimeFormat) + profileSuffix
+	f, err := ioutil.TempFile(ps.dir, name)
+	if err != nil {
+		return ProfileInfo{}, err
+	}
+	tmpPath := f.Name()
+	err = write(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, filepath.Join(ps.dir, name))
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return ProfileInfo{}, err
+	}
+
+	if err := ps.evict(name); err != nil {
+		log.Warningf("unable to evict old profiles: %s", err)
+	}
+	fi, err := os.Stat(filepath.Join(ps.dir, name))
+	if err != nil {
+		return ProfileInfo{}, err
+	}
+	return makeProfileInfo(fi), nil
+}
+
+// errCPUProfileInProgress is returned when a CPU profile is requested while
+// another one is being captured.
+var errCPUProfileInProgress = util.Errorf("a CPU profile is already being captured")
+
+// list returns the stored profiles, oldest first.
+func (ps *profileStore) list() ([]ProfileInfo, error) {
+	infos, err := ioutil.ReadDir(ps.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var profiles []ProfileInfo
+	for _, fi := range infos {
+		if fi.Mode().IsRegular() && strings.HasSuffix(fi.Name(), profileSuffix) {
+			profiles = append(profiles, makeProfileInfo(fi))
+		}
+	}
+	sort.Sort(profilesByAge(profiles))
+	return profiles, nil
+}
+
+// evict removes the oldest profiles until the stored profiles are within
+// the retention limits. The profile named keep is never removed.
+func (ps *profileStore) evict(keep string) error {
+	profiles, err := ps.list()
+	if err != nil {
+		return err
+	}
+	var totalBytes int64
+	for _, p := range profiles {
+		totalBytes += p.SizeBytes
+	}
+	count := len(profiles)
+	for _, p := range profiles {
+		if count <= maxStoredProfiles && totalBytes <= maxStoredProfileBytes {
+			break
+		}
+		if p.Name == keep {
+			continue
+		}
+		if err := os.Remove(filepath.Join(ps.dir, p.Name)); err != nil {
+			return err
+		}
+		count--
+		totalBytes -= p.SizeBytes
+	}
+	return nil
+}
+
+func makeProfileInfo(fi os.FileInfo) ProfileInfo {
+	return ProfileInfo{
+		Name:         fi.Name(),
+		SizeBytes:    fi.Size(),
+		ModTimeNanos: fi.ModTime().UnixNano(),
+	}
+}
+
+// profilesByAge sorts profiles by modification time, then name.
+type profilesByAge []ProfileInfo
+
+func (p profilesByAge) Len() int      { return len(p) }
+func (p profilesByAge) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p profilesByAge) Less(i, j int) bool {
+	if p[i].ModTimeNanos != p[j].ModTimeNanos {
+		return p[i].ModTimeNanos < p[j].ModTimeNanos
+	}
+	return p[i].Name < p[j].Name
+}
+
+// handleProfileCapture handles POST requests to capture a profile of the type
+// given in the URL. CPU profiles are captured over the number of seconds given
+// by the "seconds" query parameter.
+func (s *statusServer) handleProfileCapture(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if s.profiles == nil {
+		http.Error(w, "profile capture requires --profile-dir", http.StatusServiceUnavailable)
+		return
+	}
+	typ := ps.ByName("name")
+	switch typ {
+	case "heap", "goroutine", "cpu":
+	default:
+		http.Error(w, fmt.Sprintf("unknown profile type: %s", typ), http.StatusBadRequest)
+		return
+	}
+	duration := defaultCPUProfileDuration
+	if secs := r.URL.Query().Get("seconds"); secs != "" {
+		n, err := parseInt64WithDefault(secs, 0)
+		if err != nil || n <= 0 || time.Duration(n)*time.Second > maxCPUProfileDuration {
+			http.Error(w, fmt.Sprintf("seconds must be between 1 and %d, got %q",
+				maxCPUProfileDuration/time.Second, secs), http.StatusBadRequest)
+			return
+		}
+		duration = time.Duration(n) * time.Second
+	}
+
+	info, err := s.profiles.capture(typ, duration)
+	switch {
+	case err == errCPUProfileInProgress:
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	respondAsJSON(w, r, info)
+}
+
+// handleProfilesList handles GET requests for the list of stored profiles.
+func (s *statusServer) handleProfilesList(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if s.profiles == nil {
+		http.Error(w, "profile capture requires --profile-dir", http.StatusServiceUnavailable)
+		return
+	}
+	profiles, err := s.profiles.list()
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	respondAsJSON(w, r, profiles)
+}
+
+// handleProfile handles GET requests for the contents of a stored profile.
+func (s *statusServer) handleProfile(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if s.profiles == nil {
+		http.Error(w, "profile capture requires --profile-dir", http.StatusServiceUnavailable)
+		return
+	}
+	name := ps.ByName("name")
+	// Only basenames of stored profiles may be requested.
+	if name != filepath.Base(name) || !strings.HasSuffix(name, profileSuffix) {
+		http.Error(w, fmt.Sprintf("invalid profile name: %s", name), http.StatusBadRequest)
+		return
+	}
+	f, err := os.Open(filepath.Join(s.profiles.dir, name))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	w.Header().Set(util.ContentTypeHeader, "application/octet-stream")
+	http.ServeContent(w, r, name, time.Time{}, f)
+}
@@ -0,0 +1,169 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/julienschmidt/httprouter"
+)
+
+// TestStatusProfiles captures a heap profile on a server and fetches it back
+// through the status endpoints.
+func TestStatusProfiles(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir := util.CreateTempDir(t, "profiles")
+	defer util.CleanupDir(dir)
+
+	ts := &TestServer{Ctx: NewTestContext()}
+	ts.Ctx.ProfileDir = dir
+	if err := ts.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Stop()
+
+	httpClient, err := ts.Ctx.GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	do := func(method, path string) (int, []byte) {
+		url := ts.Ctx.HTTPRequestScheme() + "://" + ts.ServingAddr() + path
+		req, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(util.AcceptHeader, util.JSONContentType)
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, body
+	}
+
+	code, body := do("POST", statusProfilesPrefix+"heap")
+	if code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d: %s", http.StatusOK, code, body)
+	}
+	var captured ProfileInfo
+	if err := json.Unmarshal(body, &captured); err != nil {
+		t.Fatal(err)
+	}
+	if captured.SizeBytes == 0 || filepath.Dir(filepath.Join(dir, captured.Name)) != dir {
+		t.Fatalf("unexpected captured profile %+v", captured)
+	}
+
+	code, body = do("GET", statusProfilesPrefix)
+	if code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d: %s", http.StatusOK, code, body)
+	}
+	var profiles struct {
+		Data []ProfileInfo `json:"d"`
+	}
+	if err := json.Unmarshal(body, &profiles); err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles.Data) != 1 || profiles.Data[0] != captured {
+		t.Fatalf("expected only %+v, got %+v", captured, profiles.Data)
+	}
+
+	code, body = do("GET", statusProfilesPrefix+captured.Name)
+	if code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d: %s", http.StatusOK, code, body)
+	}
+	if int64(len(body)) != captured.SizeBytes {
+		t.Errorf("expected %d bytes, got %d", captured.SizeBytes, len(body))
+	}
+
+	for _, name := range []string{"threadcreate", "cpu?seconds=0", "cpu?seconds=x"} {
+		if code, body := do("POST", statusProfilesPrefix+name); code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d: %s", name, http.StatusBadRequest, code, body)
+		}
+	}
+
+	// Path traversal is rejected.
+	for _, name := range []string{"..", "../" + filepath.Base(dir) + "/" + captured.Name, "/etc/passwd"} {
+		req, err := http.NewRequest("GET", "/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		ts.status.handleProfile(w, req, httprouter.Params{{Key: "name", Value: name}})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", name, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+// TestProfileStoreRetention verifies that the oldest profiles are evicted to
+// stay within the retention limits.
+func TestProfileStoreRetention(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir := util.CreateTempDir(t, "profiles")
+	defer util.CleanupDir(dir)
+
+	defer func(count int, bytes int64) {
+		maxStoredProfiles, maxStoredProfileBytes = count, bytes
+	}(maxStoredProfiles, maxStoredProfileBytes)
+	maxStoredProfiles = 2
+	maxStoredProfileBytes = 1 << 30
+
+	ps := &profileStore{dir: dir}
+	var names []string
+	for i := 0; i < 3; i++ {
+		info, err := ps.capture("goroutine", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, info.Name)
+	}
+	profiles, err := ps.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 2 || profiles[0].Name != names[1] || profiles[1].Name != names[2] {
+		t.Fatalf("expected %s to be retained, got %+v", names[1:], profiles)
+	}
+
+	// A byte limit smaller than a single profile keeps only the newest.
+	maxStoredProfileBytes = 1
+	info, err := ps.capture("heap", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profiles, err = ps.list(); err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 1 || profiles[0] != info {
+		t.Fatalf("expected only %+v, got %+v", info, profiles)
+	}
+
+	// Only one CPU profile may be captured at a time.
+	ps.cpuProfiling = 1
+	if _, err := ps.capture("cpu", 0); err != errCPUProfileInProgress {
+		t.Errorf("expected %s, got %v", errCPUProfileInProgress, err)
+	}
+}
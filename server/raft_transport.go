@@ -27,6 +27,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft/raftpb"
 	"github.com/gogo/protobuf/proto"
 
 	gorpc "net/rpc"
@@ -52,6 +53,8 @@ type rpcTransport struct {
 	mu         sync.Mutex
 	handlers   map[roachpb.StoreID]storage.RaftMessageHandler
 	queues     map[roachpb.StoreID]chan *storage.RaftMessageRequest
+	// snapshots counts the queued snapshots by sending store.
+	snapshots map[roachpb.StoreID]int64
 }
 
 // newRPCTransport creates a new rpcTransport with specified gossip and rpc server.
@@ -63,6 +66,7 @@ func newRPCTransport(gossip *gossip.Gossip, rpcServer *rpc.Server, rpcContext *r
 		rpcContext: rpcContext,
 		handlers:   make(map[roachpb.StoreID]storage.RaftMessageHandler),
 		queues:     make(map[roachpb.StoreID]chan *storage.RaftMessageRequest),
+		snapshots:  make(map[roachpb.StoreID]int64),
 	}
 
 	if t.rpcServer != nil {
@@ -128,11 +132,22 @@ func (t *rpcTransport) processQueue(nodeID roachpb.NodeID, storeID roachpb.Store
 	if !ok {
 		return
 	}
-	// Clean-up when the loop below shuts down.
+	// Clean-up when the loop below shuts down. The messages remaining in the
+	// queue are lost.
 	defer func() {
 		t.mu.Lock()
+		defer t.mu.Unlock()
 		delete(t.queues, storeID)
-		t.mu.Unlock()
+		for {
+			select {
+			case req := <-ch:
+				if req != nil {
+					t.dequeuedLocked(req)
+				}
+			default:
+				return
+			}
+		}
 	}()
 
 	addr, err := t.gossip.GetNodeIDAddress(nodeID)
@@ -182,6 +197,9 @@ func (t *rpcTransport) processQueue(nodeID roachpb.NodeID, storeID roachpb.Store
 			return
 		}
 
+		t.mu.Lock()
+		t.dequeuedLocked(req)
+		t.mu.Unlock()
 		client.Go(raftMessageName, req, protoResp, done)
 	}
 }
@@ -189,22 +207,42 @@ func (t *rpcTransport) processQueue(nodeID roachpb.NodeID, storeID roachpb.Store
 // Send a message to the recipient specified in the request.
 func (t *rpcTransport) Send(req *storage.RaftMessageRequest) error {
 	t.mu.Lock()
+	defer t.mu.Unlock()
 	ch, ok := t.queues[req.ToReplica.StoreID]
 	if !ok {
 		ch = make(chan *storage.RaftMessageRequest, raftSendBufferSize)
 		t.queues[req.ToReplica.StoreID] = ch
 		go t.processQueue(req.ToReplica.NodeID, req.ToReplica.StoreID)
 	}
-	t.mu.Unlock()
 
+	// The message is queued while holding the lock, so that the queue can't
+	// be discarded by processQueue without accounting for it.
 	select {
 	case ch <- req:
 	default:
-		return util.Errorf("queue for node %d is full", req.Message.To)
+		return &storage.RaftQueueFullError{StoreID: req.ToReplica.StoreID}
+	}
+	if req.Message.Type == raftpb.MsgSnap {
+		t.snapshots[req.FromReplica.StoreID]++
 	}
 	return nil
 }
 
+// dequeuedLocked accounts for a message which was taken off its queue, either
+// to be sent or because the queue is discarded. t.mu must be held.
+func (t *rpcTransport) dequeuedLocked(req *storage.RaftMessageRequest) {
+	if req.Message.Type == raftpb.MsgSnap {
+		t.snapshots[req.FromReplica.StoreID]--
+	}
+}
+
+// QueuedSnapshots implements the storage.RaftTransport interface.
+func (t *rpcTransport) QueuedSnapshots(id roachpb.StoreID) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.snapshots[id]
+}
+
 // Close shuts down an rpcTransport.
 func (t *rpcTransport) Close() {
 	// No-op since we share the global cache of client connections.
@@ -229,6 +229,50 @@ func TestSendAndReceive(t *testing.T) {
 	}
 }
 
+// TestQueuedSnapshots verifies that the snapshots queued by a store are
+// counted until they are taken off the queue.
+func TestQueuedSnapshots(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	transport := &rpcTransport{
+		queues:    make(map[roachpb.StoreID]chan *storage.RaftMessageRequest),
+		snapshots: make(map[roachpb.StoreID]int64),
+	}
+	// Registering the queue up front keeps Send from starting processQueue,
+	// so the queued messages stay put.
+	ch := make(chan *storage.RaftMessageRequest, 2)
+	transport.queues[2] = ch
+
+	newReq := func(msgType raftpb.MessageType) *storage.RaftMessageRequest {
+		return &storage.RaftMessageRequest{
+			Message:     raftpb.Message{Type: msgType},
+			FromReplica: roachpb.ReplicaDescriptor{NodeID: 1, StoreID: 1},
+			ToReplica:   roachpb.ReplicaDescriptor{NodeID: 2, StoreID: 2},
+		}
+	}
+	if err := transport.Send(newReq(raftpb.MsgSnap)); err != nil {
+		t.Fatal(err)
+	}
+	if err := transport.Send(newReq(raftpb.MsgHeartbeat)); err != nil {
+		t.Fatal(err)
+	}
+	// The queue is full, so the snapshot is dropped rather than queued.
+	if err := transport.Send(newReq(raftpb.MsgSnap)); err == nil {
+		t.Fatal("expected the snapshot to be dropped")
+	}
+	if q := transport.QueuedSnapshots(1); q != 1 {
+		t.Fatalf("expected 1 queued snapshot, got %d", q)
+	}
+
+	for len(ch) > 0 {
+		transport.mu.Lock()
+		transport.dequeuedLocked(<-ch)
+		transport.mu.Unlock()
+	}
+	if q := transport.QueuedSnapshots(1); q != 0 {
+		t.Fatalf("expected no queued snapshots, got %d", q)
+	}
+}
+
 // TestInOrderDelivery verifies that for a given pair of nodes, raft
 // messages are delivered in order.
 func TestInOrderDelivery(t *testing.T) {
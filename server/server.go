@@ -19,7 +19,9 @@ package server
 
 import (
 	"compress/gzip"
+	"crypto/tls"
 	"encoding/json"
+	"flag"
 	"io"
 	"net"
 	"net/http"
@@ -30,11 +32,13 @@ import (
 
 	snappy "github.com/cockroachdb/c-snappy"
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/gossip/resolver"
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/kv"
 	crpc "github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server/status"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/sql/driver"
@@ -45,6 +49,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/log/syslog"
 	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/cockroachdb/cockroach/util/tracer"
@@ -86,6 +91,8 @@ type Server struct {
 	sqlExecutor         *sql.Executor
 	leaseMgr            *sql.LeaseManager
 	schemaChangeManager *sql.SchemaChangeManager
+	settings            *config.Settings
+	auth                *authorizer
 }
 
 // NewServer creates a Server from a server.Context.
@@ -109,10 +116,19 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		return nil, err
 	}
 
+	clock := hlc.NewClock(hlc.UnixNano)
+	if ctx.ManualClock != nil {
+		// The testing package registers its flags in test binaries only.
+		if flag.Lookup("test.v") == nil {
+			return nil, util.Errorf("a manual clock may only be used in tests")
+		}
+		clock = hlc.NewClock(ctx.ManualClock.UnixNano)
+	}
+
 	s := &Server{
 		ctx:          ctx,
 		mux:          http.NewServeMux(),
-		clock:        hlc.NewClock(hlc.UnixNano),
+		clock:        clock,
 		metaRegistry: metric.NewRegistry(),
 		stopper:      stopper,
 	}
@@ -149,7 +165,8 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		RPCContext:      s.rpcContext,
 		RPCRetryOptions: &retryOpts,
 	}, s.gossip)
-	sender := kv.NewTxnCoordSender(ds, s.clock, ctx.Linearizable, tracer, s.stopper)
+	sender := kv.NewTxnCoordSender(ds, s.clock, ctx.Linearizable, tracer, s.stopper,
+		kv.NewTxnMetrics(s.metaRegistry))
 	s.db = client.NewDB(sender)
 
 	var err error
@@ -166,18 +183,15 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 
 	s.leaseMgr = sql.NewLeaseManager(0, *s.db, s.clock)
 	s.leaseMgr.RefreshLeases(s.stopper, s.db, s.gossip)
-	s.sqlExecutor = sql.NewExecutor(*s.db, s.gossip, s.leaseMgr, s.metaRegistry, s.stopper)
+	s.settings = config.NewSettings()
+	sql.RefreshSettings(s.stopper, s.gossip, s.settings)
+	s.auth = &authorizer{insecure: s.ctx.Insecure, settings: s.settings}
+	s.sqlExecutor = sql.NewExecutor(*s.db, s.gossip, s.leaseMgr, s.clock, s.metaRegistry, s.stopper)
 	s.sqlServer = sql.MakeServer(&s.ctx.Context, s.sqlExecutor)
 	if err := s.sqlServer.RegisterRPC(s.rpc); err != nil {
 		return nil, err
 	}
 
-	s.pgServer = pgwire.NewServer(&pgwire.Context{
-		Context:  &s.ctx.Context,
-		Executor: s.sqlServer.Executor,
-		Stopper:  stopper,
-	})
-
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
 		Clock:           s.clock,
@@ -199,9 +213,16 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		},
 	}
 	s.node = NewNode(nCtx, s.metaRegistry, s.stopper)
-	s.admin = newAdminServer(s.db, s.stopper)
-	s.tsDB = ts.NewDB(s.db)
+	s.node.status.SetSlowRequestThreshold(s.ctx.SlowRequestThreshold)
+	s.pgServer = pgwire.NewServer(&pgwire.Context{
+		Context:  &s.ctx.Context,
+		Executor: s.sqlServer.Executor,
+		Stopper:  stopper,
+	}, s.node.status.Registry())
+	s.tsDB = ts.NewDB(s.db, s.node.status.Registry())
 	s.tsServer = ts.NewServer(s.tsDB)
+	s.admin = newAdminServer(s.db, s.stopper, s.leaseMgr, s.tsDB, s.auth, s.ctx.UnsafeDebugEndpoints, s.Drain,
+		s.Decommission, s.DecommissionProgress, s.node.SetMaintenance, s.node.InMaintenance)
 
 	return s, nil
 }
@@ -210,13 +231,27 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 // selfBootstrap is true, uses the rpc server's address as the gossip
 // bootstrap), and starts the node using the supplied engines slice.
 func (s *Server) Start(selfBootstrap bool) error {
-	tlsConfig, err := s.ctx.GetServerTLSConfig()
-	if err != nil {
+	if _, err := s.ctx.GetServerTLSConfig(); err != nil {
 		return err
 	}
 
+	// Client certificates are checked against the CRL, if one is configured,
+	// before any connection is served.
+	if s.ctx.CRLFile != "" {
+		security.SetRevocationChecker(security.NewRevocationChecker(
+			s.ctx.CRLFile, s.ctx.CRLFailOpen, s.node.status.Registry()))
+		s.stopper.AddCloser(stop.CloserFn(func() { security.SetRevocationChecker(nil) }))
+	}
+
+	// The TLS config is retrieved for every connection so that connections
+	// use the certificates reloaded by ReloadCertificates.
+	getTLSConfig := func() *tls.Config {
+		// The config has been loaded above, so this can't fail.
+		tlsConfig, _ := s.ctx.GetServerTLSConfig()
+		return tlsConfig
+	}
 	unresolvedAddr := util.MakeUnresolvedAddr("tcp", s.ctx.Addr)
-	ln, err := util.ListenAndServe(s.stopper, s, unresolvedAddr, tlsConfig)
+	ln, err := util.ListenAndServeReloadable(s.stopper, s, unresolvedAddr, getTLSConfig)
 	if err != nil {
 		return err
 	}
@@ -236,9 +271,28 @@ func (s *Server) Start(selfBootstrap bool) error {
 	}
 	s.gossip.Start(s.rpc, addr, s.stopper)
 
-	if err := s.node.start(s.rpc, addr, s.ctx.Engines, s.ctx.NodeAttributes); err != nil {
+	// The SQL address is bound before the node starts so that it can be
+	// advertised in the node's descriptor. SQL clients are only served once
+	// the server has started.
+	if err := s.pgServer.Listen(util.MakeUnresolvedAddr("tcp", s.ctx.PGAddr)); err != nil {
+		return err
+	}
+
+	if err := s.node.start(s.rpc, addr, s.pgServer.Addr(), s.ctx.Engines, s.ctx.NodeAttributes); err != nil {
+		return err
+	}
+
+	// Refuse to join a cluster whose on-disk and wire formats this binary
+	// doesn't support.
+	clusterVersion, err := readClusterVersion(s.db)
+	if err != nil {
 		return err
 	}
+	if !s.ctx.SkipVersionCheck {
+		if err := verifyClusterVersion(clusterVersion, util.GetBuildInfo()); err != nil {
+			return err
+		}
+	}
 
 	// Begin recording runtime statistics.
 	runtime := status.NewRuntimeStatRecorder(s.node.Descriptor.NodeID, s.clock)
@@ -246,23 +300,65 @@ func (s *Server) Start(selfBootstrap bool) error {
 
 	// Begin recording time series data collected by the status monitor.
 	s.recorder = status.NewNodeStatusRecorder(s.node.status, s.clock)
+	s.recorder.SetMaxSeries(s.ctx.MaxTimeSeries)
 	s.tsDB.PollSource(s.recorder, s.ctx.MetricsFrequency, ts.Resolution10s, s.stopper)
 
 	// Begin recording status summaries.
 	s.startWriteSummaries()
 
+	// Begin gossiping the decommission status and tracking decommissioning
+	// nodes.
+	s.startDecommissionMonitor()
+
+	// Begin recording the expiration of the loaded certificates.
+	newCertMonitor(s.ctx, s.node.status.Registry()).start(s.stopper)
+
 	s.sqlServer.SetNodeID(s.node.Descriptor.NodeID)
+
+	// Begin forwarding log entries to the syslog daemon, if one is configured.
+	if s.ctx.SyslogAddr != "" {
+		severity, ok := log.SeverityByName(s.ctx.SyslogSeverity)
+		if !ok {
+			return util.Errorf("unknown syslog severity %q", s.ctx.SyslogSeverity)
+		}
+		sink, err := syslog.NewSink(s.ctx.SyslogAddr, s.ctx.SyslogFacility, severity, s.node.status.Registry())
+		if err != nil {
+			return err
+		}
+		sink.Start(s.stopper)
+	}
+
+	// Begin forwarding events to the webhook, if one is configured. This
+	// precedes recording the join event so that it is forwarded.
+	if s.ctx.EventWebhookURL != "" {
+		sink, err := newEventSink(s.ctx.EventWebhookURL, s.ctx.EventWebhookSecret, s.ctx.EventWebhookTypes,
+			s.node.Descriptor.NodeID, s.node.status.Registry())
+		if err != nil {
+			return err
+		}
+		sink.start(s.node.ctx.EventFeed, s.stopper)
+		sink.startEventLogPoller(s.db, sql.InternalExecutor{LeaseManager: s.leaseMgr}, s.stopper)
+	}
+
+	// Record that this node joined the cluster in the event log. Since this
+	// executes a SQL query, this must be done after the SQL layer is ready.
+	s.node.recordJoinEvent()
 	// Create and start the schema change manager only after a NodeID
 	// has been assigned.
-	s.schemaChangeManager = sql.NewSchemaChangeManager(*s.db, s.gossip, s.leaseMgr)
+	s.schemaChangeManager = sql.NewSchemaChangeManager(*s.db, s.gossip, s.leaseMgr, s.sqlExecutor.SchemaChangeMetrics())
 	s.schemaChangeManager.Start(s.stopper)
 
-	s.status = newStatusServer(s.db, s.gossip, s.metaRegistry, s.ctx)
+	s.status = newStatusServer(s.db, s.gossip, s.metaRegistry, s.pgServer, s.node.stores, s.ctx, s.auth,
+		clusterVersion)
 
 	log.Infof("starting %s server at %s", s.ctx.HTTPRequestScheme(), addr)
 	s.initHTTP()
 
-	return s.pgServer.Start(util.MakeUnresolvedAddr("tcp", s.ctx.PGAddr))
+	s.pgServer.Start()
+
+	// The listening file is written last since its existence signals that
+	// the node is serving.
+	return s.writeListeningFile()
 }
 
 // initHTTP registers http prefixes.
@@ -272,14 +368,17 @@ func (s *Server) initHTTP() {
 	s.mux.Handle("/", http.FileServer(
 		&assetfs.AssetFS{Asset: ui.Asset, AssetDir: ui.AssetDir, AssetInfo: ui.AssetInfo}))
 
-	// The admin server handles both /debug/ and /_admin/
+	// The admin server handles both /debug/ and /_admin/. The /debug/
+	// endpoints require a client certificate unless explicitly disabled.
+	// The admin and status servers authorize each of their endpoints; the
+	// time series endpoints are read-only.
 	// TODO(marc): when cookie-based authentication exists,
 	// apply it for all web endpoints.
 	s.mux.Handle(adminEndpoint, s.admin)
 	s.mux.Handle(debugEndpoint, s.admin)
 	s.mux.Handle(statusPrefix, s.status)
 	s.mux.Handle(healthEndpoint, s.status)
-	s.mux.Handle(ts.URLPrefix, s.tsServer)
+	s.mux.Handle(ts.URLPrefix, s.auth.require(readAccess, s.tsServer))
 
 	// The SQL endpoints handles its own authentication, verifying user
 	// credentials against the requested user.
@@ -287,7 +386,7 @@ func (s *Server) initHTTP() {
 }
 
 // startWriteSummaries begins periodically persisting status summaries for the
-// node and its stores.
+// node and its stores. The gossip metrics are updated at the same interval.
 func (s *Server) startWriteSummaries() {
 	s.stopper.RunWorker(func() {
 		ticker := time.NewTicker(s.ctx.MetricsFrequency)
@@ -296,6 +395,7 @@ func (s *Server) startWriteSummaries() {
 			select {
 			case <-ticker.C:
 				s.stopper.RunTask(func() {
+					s.node.status.UpdateGossipStats(s.gossip.Stats())
 					if err := s.writeSummaries(); err != nil {
 						log.Error(err)
 					}
@@ -341,6 +441,30 @@ func (s *Server) writeSummaries() error {
 	return nil
 }
 
+// ReloadCertificates reloads the certificates of the node from its certs
+// directory. Connections established from now on, both incoming and
+// outgoing, use the new certificates; existing connections are unaffected.
+// If the new certificates are invalid, the current ones are kept and an error
+// is returned.
+func (s *Server) ReloadCertificates() error {
+	if err := s.ctx.ReloadCertificates(); err != nil {
+		return err
+	}
+	// The RPC context holds a copy of the base context, including its TLS
+	// configs.
+	serverTLSConfig, err := s.ctx.GetServerTLSConfig()
+	if err != nil {
+		return err
+	}
+	clientTLSConfig, err := s.ctx.GetClientTLSConfig()
+	if err != nil {
+		return err
+	}
+	s.rpcContext.SetTLSConfigs(serverTLSConfig, clientTLSConfig)
+	log.Infof("reloaded certificates from %s", s.ctx.Certs)
+	return nil
+}
+
 // Stop stops the server.
 func (s *Server) Stop() {
 	s.stopper.Stop()
@@ -393,6 +517,19 @@ func (w *gzipResponseWriter) Write(b []byte) (int, error) {
 	return w.WriteCloser.Write(b)
 }
 
+// Flush implements http.Flusher, allowing handlers to stream responses.
+func (w *gzipResponseWriter) Flush() {
+	if gz, ok := w.WriteCloser.(*gzip.Writer); ok {
+		if err := gz.Flush(); err != nil {
+			log.Warning(err)
+			return
+		}
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func (w *gzipResponseWriter) Close() {
 	if w.WriteCloser != nil {
 		w.WriteCloser.Close()
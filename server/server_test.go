@@ -300,7 +300,7 @@ func TestMultiRangeScanDeleteRange(t *testing.T) {
 	s := StartTestServer(t)
 	defer s.Stop()
 	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.Clock(), RPCContext: s.RPCContext()}, s.Gossip())
-	tds := kv.NewTxnCoordSender(ds, s.Clock(), testContext.Linearizable, nil, s.stopper)
+	tds := kv.NewTxnCoordSender(ds, s.Clock(), testContext.Linearizable, nil, s.stopper, nil)
 
 	if err := s.node.ctx.DB.AdminSplit("m"); err != nil {
 		t.Fatal(err)
@@ -392,7 +392,7 @@ func TestMultiRangeScanWithMaxResults(t *testing.T) {
 		s := StartTestServer(t)
 		defer s.Stop()
 		ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.Clock(), RPCContext: s.RPCContext()}, s.Gossip())
-		tds := kv.NewTxnCoordSender(ds, s.Clock(), testContext.Linearizable, nil, s.stopper)
+		tds := kv.NewTxnCoordSender(ds, s.Clock(), testContext.Linearizable, nil, s.stopper, nil)
 
 		for _, sk := range tc.splitKeys {
 			if err := s.node.ctx.DB.AdminSplit(sk); err != nil {
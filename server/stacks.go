@@ -0,0 +1,133 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	// stackTraceApproxSize is the approximate size of a goroutine stack trace.
+	stackTraceApproxSize = 1024
+	// maxStackTraceSize caps the size of the stack traces of a node; the
+	// traces of the goroutines beyond it are truncated.
+	maxStackTraceSize = 64 << 20
+	// stacksNodeTimeout bounds the time spent retrieving the stack traces of
+	// a single node for a request of every node's stack traces.
+	stacksNodeTimeout = 10 * time.Second
+	// maxConcurrentStacksRequests is the number of nodes whose stack traces
+	// are retrieved concurrently.
+	maxConcurrentStacksRequests = 8
+)
+
+// StacksResponse holds the stack traces of the nodes of the cluster, keyed
+// by node ID. Nodes whose stack traces couldn't be retrieved appear in Errors
+// instead.
+type StacksResponse struct {
+	Stacks map[string]string `json:"stacks"`
+	Errors map[string]string `json:"errors"`
+}
+
+// captureStacks returns the stack traces of all goroutines, truncated to
+// maxStackTraceSize.
+func captureStacks() []byte {
+	bufSize := runtime.NumGoroutine() * stackTraceApproxSize
+	for {
+		if bufSize > maxStackTraceSize {
+			bufSize = maxStackTraceSize
+		}
+		buf := make([]byte, bufSize)
+		length := runtime.Stack(buf, true)
+		// If this wasn't large enough to accommodate the full set of
+		// stack traces, increase by 2 and try again.
+		if length == bufSize && bufSize < maxStackTraceSize {
+			bufSize = bufSize * 2
+			continue
+		}
+		return buf[:length]
+	}
+}
+
+// handleStacksAll handles GET requests for the stack traces of every node
+// known to gossip. The nodes are queried concurrently, each within
+// stacksNodeTimeout; a node which doesn't respond in time yields an error.
+func (s *statusServer) handleStacksAll(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	client := *s.proxyClient
+	client.Timeout = stacksNodeTimeout
+
+	resp := StacksResponse{
+		Stacks: map[string]string{},
+		Errors: map[string]string{},
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentStacksRequests)
+	for _, nodeID := range s.gossip.NodeIDs() {
+		wg.Add(1)
+		go func(nodeID roachpb.NodeID) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var stacks []byte
+			var err error
+			if nodeID == s.gossip.GetNodeID() {
+				stacks = captureStacks()
+			} else {
+				stacks, err = s.fetchStacks(&client, nodeID)
+			}
+			key := strconv.FormatInt(int64(nodeID), 10)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				resp.Errors[key] = err.Error()
+			} else {
+				resp.Stacks[key] = string(stacks)
+			}
+		}(nodeID)
+	}
+	wg.Wait()
+	respondAsJSON(w, r, resp)
+}
+
+// fetchStacks retrieves the stack traces of another node from its status
+// server.
+func (s *statusServer) fetchStacks(client *http.Client, nodeID roachpb.NodeID) ([]byte, error) {
+	addr, err := s.gossip.GetNodeIDAddress(nodeID)
+	if err != nil {
+		return nil, err
+	}
+	requestURL := fmt.Sprintf("%s://%s%sstacks/%d", s.ctx.HTTPRequestScheme(), addr, statusPrefix, nodeID)
+	resp, err := client.Get(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.Errorf("status server responded with %s", resp.Status)
+	}
+	return ioutil.ReadAll(io.LimitReader(resp.Body, maxStackTraceSize))
+}
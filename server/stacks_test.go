@@ -0,0 +1,94 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils/testcluster"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestStatusStacksAll verifies that the stack traces of every node are
+// returned, with an error for a stopped node, and only to admin users.
+func TestStatusStacksAll(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{
+		ReplicationMode: testcluster.ReplicationManual,
+	})
+	defer tc.Stop()
+
+	var nodeIDs []string
+	for i := range tc.Servers {
+		nodeIDs = append(nodeIDs, strconv.Itoa(int(tc.Target(i).NodeID)))
+	}
+	stopped := nodeIDs[2]
+	tc.StopNode(2)
+
+	get := func(ctx *base.Context) (int, []byte) {
+		httpClient, err := ctx.GetHTTPClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		url := ctx.HTTPRequestScheme() + "://" + tc.ServingAddr(0) + "/_status/stacks/all"
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(util.AcceptHeader, util.JSONContentType)
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, body
+	}
+
+	code, body := get(&tc.Servers[0].Ctx.Context)
+	if code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d: %s", http.StatusOK, code, body)
+	}
+	var resp server.StacksResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatal(err)
+	}
+	for _, nodeID := range nodeIDs[:2] {
+		if stacks := resp.Stacks[nodeID]; !strings.Contains(stacks, "goroutine") {
+			t.Errorf("expected stack traces of node %s, got %q", nodeID, stacks)
+		}
+	}
+	if _, ok := resp.Stacks[stopped]; ok || len(resp.Errors) != 1 || resp.Errors[stopped] == "" {
+		t.Errorf("expected only an error for stopped node %s, got %v", stopped, resp.Errors)
+	}
+
+	// Stack traces are only exposed to admin users.
+	nonAdmin := &base.Context{Certs: security.EmbeddedCertsDir, User: server.TestUser}
+	if code, body := get(nonAdmin); code != http.StatusForbidden {
+		t.Errorf("expected status code %d, got %d: %s", http.StatusForbidden, code, body)
+	}
+}
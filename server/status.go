@@ -20,8 +20,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path/filepath"
 	"regexp"
-	"runtime"
 	"strconv"
 	"time"
 
@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/sql/pgwire"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -51,10 +52,31 @@ const (
 		/_status/logs/:node_id           - log entries from a specific node
 		/_status/stacks/:node_id		 - exposes stack traces of running
 										   goroutines
+		/_status/stacks/all              - stack traces of every node, for
+										   admin users only
 		/_status/nodes				     - all nodes' status
 		/_status/nodes/:node_id		     - a specific node's status
+		/_status/nodes/:node_id/logfiles - list log files with sizes and
+										   modification times
+		/_status/nodes/:node_id/logfiles/:file
+										 - streams the raw contents of a
+										   specific log file
+		/_status/nodes/:node_id/logs     - recent in-memory log entries
 		/_status/stores                  - all stores' status
 		/_status/stores/:store_id        - a specific store's status
+		/_status/sessions/:node_id       - SQL client sessions of a specific node
+		/_status/profile/                - profiles stored on this node
+		/_status/profile/:name           - POST with heap, goroutine or cpu
+										   (?seconds=N) captures a profile;
+										   GET streams a stored profile
+		/_status/heatmap                 - MVCC stats of buckets of contiguous
+										   ranges (?start=&end=&buckets=N)
+		/_status/leaseholders/:node_id   - MVCC stats of the ranges for which
+										   a specific node holds the lease
+		/_status/certificates/:node_id   - certificates loaded by a specific
+										   node and their expiration
+		/_status/topology                - all nodes known to gossip and their
+										   stores
 	*/
 
 	// statusPrefix is the root of the cluster statistics and metrics API.
@@ -78,13 +100,20 @@ const (
 
 	// statusStacksPattern exposes the stack traces of running goroutines.
 	statusStacksPattern = statusPrefix + "stacks/:node_id"
-	// stackTraceApproxSize is the approximate size of a goroutine stack trace.
-	stackTraceApproxSize = 1024
+	// allNodesParam is the node_id of statusStacksPattern which requests the
+	// stack traces of every node.
+	allNodesParam = "all"
 
 	// statusNodesPrefix exposes status for all nodes in the cluster.
 	statusNodesPrefix = statusPrefix + "nodes/"
 	// statusNodePattern exposes status for a single node.
 	statusNodePattern = statusPrefix + "nodes/:node_id"
+	// statusNodeLogFilesPattern exposes a list of a node's log files.
+	statusNodeLogFilesPattern = statusNodePattern + "/logfiles"
+	// statusNodeLogFilePattern exposes the raw contents of a log file.
+	statusNodeLogFilePattern = statusNodeLogFilesPattern + "/:file"
+	// statusNodeLogsPattern exposes a node's recent in-memory log entries.
+	statusNodeLogsPattern = statusNodePattern + "/logs"
 
 	// statusStoresPrefix exposes status for all stores in the cluster.
 	statusStoresPrefix = statusPrefix + "stores/"
@@ -93,6 +122,28 @@ const (
 
 	statusMetricsPattern = statusPrefix + "metrics/:store_id"
 
+	// statusSessionsPattern exposes the SQL client sessions of a node.
+	statusSessionsPattern = statusPrefix + "sessions/:node_id"
+
+	// statusProfilesPrefix exposes the list of profiles stored on this node.
+	statusProfilesPrefix = statusPrefix + "profile/"
+	// statusProfilePattern captures a profile of the given type (POST) or
+	// exposes a stored profile (GET).
+	statusProfilePattern = statusProfilesPrefix + ":name"
+
+	// statusHeatMapPattern exposes the MVCC stats of a span of the key space,
+	// aggregated into buckets of contiguous ranges.
+	statusHeatMapPattern = statusPrefix + "heatmap"
+	// statusLeaseHoldersPattern exposes the MVCC stats of the ranges for
+	// which a node holds the leader lease.
+	statusLeaseHoldersPattern = statusPrefix + "leaseholders/:node_id"
+
+	// statusCertificatesPattern exposes the certificates loaded by a node.
+	statusCertificatesPattern = statusPrefix + "certificates/:node_id"
+
+	// statusTopologyPattern exposes the nodes of the cluster and their stores.
+	statusTopologyPattern = statusPrefix + "topology"
+
 	// healthEndpoint is a shortcut for local details, intended for use by
 	// monitoring processes to verify that the server is up.
 	healthEndpoint = "/health"
@@ -106,46 +157,74 @@ type statusServer struct {
 	db           *client.DB
 	gossip       *gossip.Gossip
 	metaRegistry *metric.Registry
+	pgServer     *pgwire.Server
+	stores       *storage.Stores
 	router       *httprouter.Router
 	ctx          *Context
 	proxyClient  *http.Client
+	auth         *authorizer
+	// clusterVersion is the version of the cluster read when the node
+	// started.
+	clusterVersion status.ClusterVersion
+	// profiles is nil unless a profile directory is configured.
+	profiles *profileStore
 }
 
-// newStatusServer allocates and returns a statusServer.
-func newStatusServer(db *client.DB, gossip *gossip.Gossip, metaRegistry *metric.Registry, ctx *Context) *statusServer {
+// newStatusServer allocates and returns a statusServer. Requests are
+// authorized by auth.
+func newStatusServer(db *client.DB, gossip *gossip.Gossip, metaRegistry *metric.Registry,
+	pgServer *pgwire.Server, stores *storage.Stores, ctx *Context, auth *authorizer,
+	clusterVersion status.ClusterVersion) *statusServer {
 	// Create an http client with a timeout
-	tlsConfig, err := ctx.GetClientTLSConfig()
-	if err != nil {
+	if _, err := ctx.GetClientTLSConfig(); err != nil {
 		log.Error(err)
 		return nil
 	}
 	httpClient := &http.Client{
-		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Transport: ctx.NewHTTPTransport(),
 		Timeout:   base.NetworkTimeout,
 	}
 
 	server := &statusServer{
-		db:           db,
-		gossip:       gossip,
-		metaRegistry: metaRegistry,
-		router:       httprouter.New(),
-		ctx:          ctx,
-		proxyClient:  httpClient,
-	}
-
-	server.router.GET(statusGossipPattern, server.handleGossip)
-	server.router.GET(statusDetailsPattern, server.handleDetails)
-	server.router.GET(statusLogFilesListPattern, server.handleLogFilesList)
-	server.router.GET(statusLogFilePattern, server.handleLogFile)
-	server.router.GET(statusLogsPattern, server.handleLogs)
-	server.router.GET(statusStacksPattern, server.handleStacks)
-	server.router.GET(statusNodesPrefix, server.handleNodesStatus)
-	server.router.GET(statusNodePattern, server.handleNodeStatus)
-	server.router.GET(statusStoresPrefix, server.handleStoresStatus)
-	server.router.GET(statusStorePattern, server.handleStoreStatus)
-	server.router.GET(statusMetricsPattern, server.handleMetrics)
-
-	server.router.GET(healthEndpoint, server.handleDetailsLocal)
+		db:             db,
+		gossip:         gossip,
+		metaRegistry:   metaRegistry,
+		pgServer:       pgServer,
+		stores:         stores,
+		router:         httprouter.New(),
+		ctx:            ctx,
+		proxyClient:    httpClient,
+		auth:           auth,
+		clusterVersion: clusterVersion,
+	}
+	if ctx.ProfileDir != "" {
+		server.profiles = &profileStore{dir: ctx.ProfileDir}
+	}
+
+	server.router.GET(statusGossipPattern, auth.requireHandle(readAccess, server.handleGossip))
+	server.router.GET(statusDetailsPattern, auth.requireHandle(readAccess, server.handleDetails))
+	server.router.GET(statusLogFilesListPattern, auth.requireHandle(readAccess, server.handleLogFilesList))
+	server.router.GET(statusLogFilePattern, auth.requireHandle(readAccess, server.handleLogFile))
+	server.router.GET(statusLogsPattern, auth.requireHandle(readAccess, server.handleLogs))
+	server.router.GET(statusStacksPattern, auth.requireHandle(readAccess, server.handleStacks))
+	server.router.GET(statusNodesPrefix, auth.requireHandle(readAccess, server.handleNodesStatus))
+	server.router.GET(statusNodePattern, auth.requireHandle(readAccess, server.handleNodeStatus))
+	server.router.GET(statusNodeLogFilesPattern, auth.requireHandle(readAccess, server.handleLogFilesList))
+	server.router.GET(statusNodeLogFilePattern, auth.requireHandle(readAccess, server.handleRawLogFile))
+	server.router.GET(statusNodeLogsPattern, auth.requireHandle(readAccess, server.handleRecentLogs))
+	server.router.GET(statusStoresPrefix, auth.requireHandle(readAccess, server.handleStoresStatus))
+	server.router.GET(statusStorePattern, auth.requireHandle(readAccess, server.handleStoreStatus))
+	server.router.GET(statusMetricsPattern, auth.requireHandle(readAccess, server.handleMetrics))
+	server.router.GET(statusSessionsPattern, auth.requireHandle(readAccess, server.handleSessions))
+	server.router.GET(statusProfilesPrefix, auth.requireHandle(readAccess, server.handleProfilesList))
+	server.router.GET(statusProfilePattern, auth.requireHandle(readAccess, server.handleProfile))
+	server.router.POST(statusProfilePattern, auth.requireHandle(adminAccess, server.handleProfileCapture))
+	server.router.GET(statusHeatMapPattern, auth.requireHandle(readAccess, server.handleHeatMap))
+	server.router.GET(statusLeaseHoldersPattern, auth.requireHandle(readAccess, server.handleLeaseHolders))
+	server.router.GET(statusCertificatesPattern, auth.requireHandle(readAccess, server.handleCertificates))
+	server.router.GET(statusTopologyPattern, auth.requireHandle(readAccess, server.handleTopology))
+
+	server.router.GET(healthEndpoint, auth.requireHandle(publicAccess, server.handleDetailsLocal))
 	return server
 }
 
@@ -192,6 +271,9 @@ func (s *statusServer) proxyRequest(nodeID roachpb.NodeID, w http.ResponseWriter
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if rangeHeader := r.Header.Get("Range"); rangeHeader != "" {
+		req.Header.Set("Range", rangeHeader)
+	}
 	resp, err := s.proxyClient.Do(req)
 	if err != nil {
 		log.Error(err)
@@ -199,10 +281,14 @@ func (s *statusServer) proxyRequest(nodeID roachpb.NodeID, w http.ResponseWriter
 		return
 	}
 	w.Header().Set(util.ContentTypeHeader, resp.Header.Get(util.ContentTypeHeader))
+	if contentRange := resp.Header.Get("Content-Range"); contentRange != "" {
+		w.Header().Set("Content-Range", contentRange)
+	}
 
 	// Only pass through a whitelisted set of status codes.
 	switch resp.StatusCode {
-	case http.StatusOK, http.StatusNotFound, http.StatusBadRequest, http.StatusInternalServerError:
+	case http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable,
+		http.StatusNotFound, http.StatusBadRequest, http.StatusInternalServerError:
 		w.WriteHeader(resp.StatusCode)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
@@ -246,12 +332,14 @@ func (s *statusServer) handleGossip(w http.ResponseWriter, r *http.Request, ps h
 // handleDetailsLocal handles local requests for node details.
 func (s *statusServer) handleDetailsLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	local := struct {
-		NodeID    roachpb.NodeID      `json:"nodeID"`
-		Address   util.UnresolvedAddr `json:"address"`
-		BuildInfo util.BuildInfo      `json:"buildInfo"`
+		NodeID         roachpb.NodeID        `json:"nodeID"`
+		Address        util.UnresolvedAddr   `json:"address"`
+		BuildInfo      util.BuildInfo        `json:"buildInfo"`
+		ClusterVersion status.ClusterVersion `json:"clusterVersion"`
 	}{
-		NodeID:    s.gossip.GetNodeID(),
-		BuildInfo: util.GetBuildInfo(),
+		NodeID:         s.gossip.GetNodeID(),
+		BuildInfo:      util.GetBuildInfo(),
+		ClusterVersion: s.clusterVersion,
 	}
 	if addr, err := s.gossip.GetNodeIDAddress(s.gossip.GetNodeID()); err == nil {
 		local.Address = addr.(util.UnresolvedAddr)
@@ -349,6 +437,90 @@ func (s *statusServer) handleLogFile(w http.ResponseWriter, r *http.Request, ps
 	}
 }
 
+// handleRawLogFileLocal handles local requests for the raw contents of a
+// single log file. Byte ranges may be requested through the Range header.
+// Alternatively, the "tail" query parameter requests only the last N entries
+// of the file, which are returned in structured format as JSON.
+func (s *statusServer) handleRawLogFileLocal(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	log.Flush()
+	file := ps.ByName("file")
+	// Only basenames of files in the log directory may be requested.
+	if file != filepath.Base(file) || file == "." || file == ".." {
+		http.Error(w, fmt.Sprintf("invalid log file name: %s", file), http.StatusBadRequest)
+		return
+	}
+
+	tail, err := parseInt64WithDefault(r.URL.Query().Get("tail"), 0)
+	if err != nil || tail < 0 {
+		http.Error(w,
+			fmt.Sprintf("tail could not be parsed: %s", r.URL.Query().Get("tail")),
+			http.StatusBadRequest)
+		return
+	}
+
+	reader, err := log.GetLogReader(file, true /* restricted */)
+	if reader == nil || err != nil {
+		log.Errorf("log file %s could not be opened: %s", file, err)
+		http.NotFound(w, r)
+		return
+	}
+	defer reader.Close()
+
+	if tail == 0 {
+		content, ok := reader.(io.ReadSeeker)
+		if !ok {
+			http.Error(w, fmt.Sprintf("log file %s is not seekable", file), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(util.ContentTypeHeader, "application/octet-stream")
+		http.ServeContent(w, r, file, time.Time{}, content)
+		return
+	}
+
+	// Keep the last tail entries in a ring buffer while decoding.
+	entries := make([]log.LogEntry, 0, tail)
+	var next int
+	decoder := log.NewEntryDecoder(reader)
+	for {
+		entry := log.LogEntry{}
+		if err := decoder.Decode(&entry); err != nil {
+			if err == io.EOF {
+				break
+			}
+			log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if int64(len(entries)) < tail {
+			entries = append(entries, entry)
+		} else {
+			entries[next] = entry
+		}
+		next = (next + 1) % int(tail)
+	}
+	if len(entries) == int(tail) {
+		entries = append(entries[next:], entries[:next]...)
+	}
+
+	respondAsJSON(w, r, entries)
+}
+
+// handleRawLogFile handles GET requests for the raw contents of a single log
+// file.
+func (s *statusServer) handleRawLogFile(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if local {
+		s.handleRawLogFileLocal(w, r, ps)
+	} else {
+		s.proxyRequest(nodeID, w, r)
+	}
+}
+
 // parseInt64WithDefault attempts to parse the passed in string. If an empty
 // string is supplied or parsing results in an error the default value is
 // returned.  If an error does occur during parsing, the error is returned as
@@ -380,19 +552,10 @@ func parseInt64WithDefault(s string, defaultValue int64) (int64, error) {
 func (s *statusServer) handleLogsLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	log.Flush()
 
-	level := r.URL.Query().Get("level")
-	var sev log.Severity
-	if len(level) == 0 {
-		sev = log.InfoLog
-	} else {
-		var sevFound bool
-		sev, sevFound = log.SeverityByName(level)
-		if !sevFound {
-			http.Error(w,
-				fmt.Sprintf("level could not be determined: %s", level),
-				http.StatusBadRequest)
-			return
-		}
+	sev, maxEntries, regex, err := parseLogFilters(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
 	startTimestamp, err := parseInt64WithDefault(
@@ -422,40 +585,74 @@ func (s *statusServer) handleLogsLocal(w http.ResponseWriter, r *http.Request, _
 		return
 	}
 
+	entries, err := log.FetchEntriesFromFiles(sev, startTimestamp, endTimestamp, maxEntries, regex)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	respondAsJSON(w, r, entries)
+}
+
+// parseLogFilters parses the "level", "max" and "pattern" query parameters
+// which filter the log entries returned by the logs endpoints.
+func parseLogFilters(r *http.Request) (log.Severity, int, *regexp.Regexp, error) {
+	level := r.URL.Query().Get("level")
+	sev := log.InfoLog
+	if len(level) > 0 {
+		var sevFound bool
+		if sev, sevFound = log.SeverityByName(level); !sevFound {
+			return 0, 0, nil, fmt.Errorf("level could not be determined: %s", level)
+		}
+	}
+
 	maxEntries, err := parseInt64WithDefault(
 		r.URL.Query().Get("max"),
 		defaultMaxLogEntries)
 	if err != nil {
-		http.Error(w,
-			fmt.Sprintf("max could not be parsed: %s", err),
-			http.StatusBadRequest)
-		return
+		return 0, 0, nil, fmt.Errorf("max could not be parsed: %s", err)
 	}
 	if maxEntries < 1 {
-		http.Error(w,
-			fmt.Sprintf("max: %d should be set to a value greater than 0", maxEntries),
-			http.StatusBadRequest)
-		return
+		return 0, 0, nil, fmt.Errorf("max: %d should be set to a value greater than 0", maxEntries)
 	}
 
 	pattern := r.URL.Query().Get("pattern")
 	var regex *regexp.Regexp
 	if len(pattern) > 0 {
 		if regex, err = regexp.Compile(pattern); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			fmt.Fprintf(w, "regex pattern could not be compiled: %s", err)
-			return
+			return 0, 0, nil, fmt.Errorf("regex pattern could not be compiled: %s", err)
 		}
 	}
+	return sev, int(maxEntries), regex, nil
+}
 
-	entries, err := log.FetchEntriesFromFiles(sev, startTimestamp, endTimestamp, int(maxEntries), regex)
+// handleRecentLogsLocal returns the most recent log entries held in memory,
+// in reverse chronological order. Unlike handleLogsLocal, this works
+// regardless of whether the node logs to files. The "level", "pattern" and
+// "max" query parameters filter the entries as for handleLogsLocal.
+func (s *statusServer) handleRecentLogsLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	sev, maxEntries, regex, err := parseLogFilters(r)
 	if err != nil {
-		log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	respondAsJSON(w, r, log.FetchRecentEntries(sev, maxEntries, regex))
+}
+
+// handleRecentLogs handles GET requests for recent in-memory log entries.
+func (s *statusServer) handleRecentLogs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	respondAsJSON(w, r, entries)
+	if local {
+		s.handleRecentLogsLocal(w, r, ps)
+	} else {
+		s.proxyRequest(nodeID, w, r)
+	}
 }
 
 // handleLogs handles GET requests for log entires.
@@ -475,26 +672,20 @@ func (s *statusServer) handleLogs(w http.ResponseWriter, r *http.Request, ps htt
 
 // handleStacksLocal handles local requests for goroutines stack traces.
 func (s *statusServer) handleStacksLocal(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
-	bufSize := runtime.NumGoroutine() * stackTraceApproxSize
-	for {
-		buf := make([]byte, bufSize)
-		length := runtime.Stack(buf, true)
-		// If this wasn't large enough to accommodate the full set of
-		// stack traces, increase by 2 and try again.
-		if length == bufSize {
-			bufSize = bufSize * 2
-			continue
-		}
-		w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
-		if _, err := w.Write(buf[:length]); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	if _, err := w.Write(captureStacks()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
 
-// handleStacksLocal handles GET requests for goroutine stack traces.
+// handleStacks handles GET requests for goroutine stack traces. The
+// node ID "all" requests the stack traces of every node, which are only
+// exposed to admin users.
 func (s *statusServer) handleStacks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if ps.ByName("node_id") == allNodesParam {
+		s.auth.requireHandle(adminAccess, s.handleStacksAll)(w, r, ps)
+		return
+	}
 	nodeID, local, err := s.extractNodeID(ps)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -612,6 +803,21 @@ func (s *statusServer) handleMetrics(w http.ResponseWriter, r *http.Request, ps
 	respondAsJSON(w, r, s.metaRegistry)
 }
 
+// handleSessions handles GET requests for the SQL client sessions of a node.
+func (s *statusServer) handleSessions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !local {
+		s.proxyRequest(nodeID, w, r)
+		return
+	}
+	respondAsJSON(w, r, s.pgServer.Sessions())
+}
+
 func respondAsJSON(w http.ResponseWriter, r *http.Request, response interface{}) {
 	b, contentType, err := util.MarshalResponse(r, response, []util.EncodingType{util.JSONEncoding})
 	if err != nil {
@@ -18,7 +18,10 @@ package status
 
 import (
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
@@ -28,6 +31,10 @@ import (
 	"github.com/cockroachdb/cockroach/util/tracer"
 )
 
+// DefaultSlowRequestThreshold is the latency above which a call is counted as
+// slow, unless configured otherwise with SetSlowRequestThreshold.
+const DefaultSlowRequestThreshold = time.Second
+
 // NodeStatusMonitor monitors the status of a server node. Status information
 // is collected from event feeds provided by lower level components.
 //
@@ -35,9 +42,24 @@ import (
 // interesting subsets of data on the node. NodeStatusMonitor is responsible
 // for passing event feed data to these subset structures for accumulation.
 type NodeStatusMonitor struct {
-	mLatency metric.Histograms
-	mSuccess metric.Rates
-	mError   metric.Rates
+	mLatency     metric.Histograms
+	mSuccess     metric.Rates
+	mError       metric.Rates
+	mSlow        *metric.Counter
+	mMaintenance *metric.Gauge // 1 while the node is in maintenance mode
+
+	mGossipConnections   *metric.Gauge
+	mGossipInfosReceived *metric.Counter
+	mGossipConnected     *metric.Gauge // 1 while connected to the gossip network
+
+	// latencySampleRate causes only one in this many call latencies to be
+	// recorded. Sampled latencies are recorded with a count equal to the
+	// rate, so that counts and quantiles remain representative.
+	latencySampleRate int64
+	latencyCalls      int64 // Accessed atomically
+	// slowRequestThreshold is the latency above which a call is counted as
+	// slow.
+	slowRequestThreshold time.Duration
 
 	sync.RWMutex // Mutex to guard the following fields
 	registry     *metric.Registry
@@ -55,10 +77,85 @@ func NewNodeStatusMonitor(metaRegistry *metric.Registry) *NodeStatusMonitor {
 		metaRegistry: metaRegistry,
 		registry:     registry,
 
-		mLatency: registry.Latency("exec.latency"),
-		mSuccess: registry.Rates("exec.success"),
-		mError:   registry.Rates("exec.error"),
+		mLatency:     registry.Latency("exec.latency"),
+		mSuccess:     registry.Rates("exec.success"),
+		mError:       registry.Rates("exec.error"),
+		mSlow:        registry.Counter("exec.slow-count"),
+		mMaintenance: registry.Gauge("sys.maintenance"),
+
+		mGossipConnections:   registry.Gauge("sys.gossip.connections"),
+		mGossipInfosReceived: registry.Counter("sys.gossip.infos.received"),
+		mGossipConnected:     registry.Gauge("sys.gossip.connected"),
+
+		latencySampleRate:    1,
+		slowRequestThreshold: DefaultSlowRequestThreshold,
+	}
+}
+
+// Registry returns the registry of the node's metrics, which are recorded as
+// the node's time series.
+func (nsm *NodeStatusMonitor) Registry() *metric.Registry {
+	return nsm.registry
+}
+
+// SetLatencySampleRate configures the monitor to record only one in every
+// rate call latencies into the exec latency histograms, which reduces the
+// overhead of recording on nodes serving a very high rate of requests.
+// A rate of one (the default) records every latency. This must be called
+// before the monitor starts receiving events.
+func (nsm *NodeStatusMonitor) SetLatencySampleRate(rate int64) {
+	if rate < 1 {
+		rate = 1
 	}
+	nsm.latencySampleRate = rate
+}
+
+// SetSlowRequestThreshold configures the latency above which a call is
+// counted as slow. A threshold of zero disables the count. This must be called
+// before the monitor starts receiving events.
+func (nsm *NodeStatusMonitor) SetSlowRequestThreshold(threshold time.Duration) {
+	nsm.slowRequestThreshold = threshold
+}
+
+// SetMaintenance updates the gauge recording whether the node is in
+// maintenance mode.
+func (nsm *NodeStatusMonitor) SetMaintenance(maintenance bool) {
+	var v int64
+	if maintenance {
+		v = 1
+	}
+	nsm.mMaintenance.Update(v)
+}
+
+// UpdateGossipStats updates the gossip metrics from the given statistics of
+// the node's gossip instance.
+func (nsm *NodeStatusMonitor) UpdateGossipStats(stats gossip.Stats) {
+	nsm.mGossipConnections.Update(int64(stats.Connections))
+	// The gossip instance reports the total number of infos received, so the
+	// counter is advanced by the difference to its current count.
+	nsm.mGossipInfosReceived.Inc(stats.InfosReceived - nsm.mGossipInfosReceived.Count())
+	var connected int64
+	if stats.Connected {
+		connected = 1
+	}
+	nsm.mGossipConnected.Update(connected)
+}
+
+// recordLatency records the duration of a call into the exec latency
+// histograms, subject to the configured sample rate. Slow calls are counted
+// regardless of sampling.
+func (nsm *NodeStatusMonitor) recordLatency(d time.Duration) {
+	if nsm.slowRequestThreshold > 0 && d > nsm.slowRequestThreshold {
+		nsm.mSlow.Inc(1)
+	}
+	if nsm.latencySampleRate == 1 {
+		nsm.mLatency.RecordValue(d.Nanoseconds())
+		return
+	}
+	if atomic.AddInt64(&nsm.latencyCalls, 1)%nsm.latencySampleRate != 0 {
+		return
+	}
+	nsm.mLatency.RecordValues(d.Nanoseconds(), nsm.latencySampleRate)
 }
 
 // GetStoreMonitor is a helper method which retrieves the StoreStatusMonitor for the
@@ -98,10 +195,13 @@ func (nsm *NodeStatusMonitor) visitStoreMonitors(visitor func(*StoreStatusMonito
 // supplied Subscription. The goroutine will continue running until the
 // Subscription's Events feed is closed.
 func (nsm *NodeStatusMonitor) StartMonitorFeed(feed *util.Feed) {
-	feed.Subscribe(func(event interface{}) {
-		ProcessNodeEvent(nsm, event)
-		storage.ProcessStoreEvent(nsm, event)
-	})
+	feed.Subscribe(nsm.ProcessEvent)
+}
+
+// ProcessEvent dispatches a node or store event on the monitor.
+func (nsm *NodeStatusMonitor) ProcessEvent(event interface{}) {
+	ProcessNodeEvent(nsm, event)
+	storage.ProcessStoreEvent(nsm, event)
 }
 
 // OnRegisterRange receives RegisterRangeEvents retrieved from a storage event
@@ -174,6 +274,7 @@ func (nsm *NodeStatusMonitor) OnStoreStatus(event *storage.StoreStatusEvent) {
 	ssm.Lock()
 	defer ssm.Unlock()
 	ssm.desc = event.Desc
+	ssm.device = event.Device
 	// Update capacity gauges on the store monitor.
 	ssm.capacity.Update(ssm.desc.Capacity.Capacity)
 	ssm.available.Update(ssm.desc.Capacity.Available)
@@ -189,6 +290,54 @@ func (nsm *NodeStatusMonitor) OnReplicationStatus(event *storage.ReplicationStat
 	ssm.leaderRangeCount.Update(event.LeaderRangeCount)
 	ssm.replicatedRangeCount.Update(event.ReplicatedRangeCount)
 	ssm.availableRangeCount.Update(event.AvailableRangeCount)
+	ssm.lowDiversityRanges.Update(event.LowDiversityRangeCount)
+}
+
+// OnRaftSnapshotStatus receives RaftSnapshotStatusEvents retrieved from a
+// storage event subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnRaftSnapshotStatus(event *storage.RaftSnapshotStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.queuedSnapshots.Update(event.QueuedCount)
+	ssm.failedSnapshots.Inc(event.FailedCount)
+}
+
+// OnRaftMessageStatus receives RaftMessageStatusEvents retrieved from a
+// storage event subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnRaftMessageStatus(event *storage.RaftMessageStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.droppedRaftMessages.Inc(event.DroppedCount)
+}
+
+// OnWriteStallStatus receives WriteStallStatusEvents retrieved from a storage
+// event subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnWriteStallStatus(event *storage.WriteStallStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	if event.StallCount <= 0 {
+		return
+	}
+	ssm.writeStalls.Inc(event.StallCount)
+	// Only the total duration of the stalls is known, so each stall is
+	// recorded with the average duration.
+	ssm.writeStallNanos.RecordValues(event.StallNanos/event.StallCount, event.StallCount)
+}
+
+// OnGCStatus receives GCStatusEvents retrieved from a storage event
+// subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnGCStatus(event *storage.GCStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.gcOldestVersionAge.Update(event.OldestVersionAgeNanos)
 }
 
 // OnStartNode receives StartNodeEvents from a node event subscription. This
@@ -207,14 +356,14 @@ func (nsm *NodeStatusMonitor) OnStartNode(event *StartNodeEvent) {
 // method is part of the implementation of NodeEventListener.
 func (nsm *NodeStatusMonitor) OnCallSuccess(event *CallSuccessEvent) {
 	nsm.mSuccess.Add(1.0)
-	nsm.mLatency.RecordValue(event.Duration.Nanoseconds())
+	nsm.recordLatency(event.Duration)
 }
 
 // OnCallError receives CallErrorEvents from a node event subscription. This
 // method is part of the implementation of NodeEventListener.
 func (nsm *NodeStatusMonitor) OnCallError(event *CallErrorEvent) {
 	nsm.mError.Add(1.0)
-	nsm.mLatency.RecordValue(event.Duration.Nanoseconds())
+	nsm.recordLatency(event.Duration)
 }
 
 // OnTrace receives Trace objects from a node event subscription. This method
@@ -226,6 +375,58 @@ func (nsm *NodeStatusMonitor) OnTrace(trace *tracer.Trace) {
 }
 
 // StoreStatusMonitor monitors the status of a single store on the server.
+// OnRebalanceStatus receives RebalanceStatusEvents retrieved from a storage
+// event subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnRebalanceStatus(event *storage.RebalanceStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.rebalancesRejected.Inc(event.RejectedConstraintsCount)
+	ssm.rebalanceBytesMoved.Inc(event.BytesMoved)
+}
+
+// OnLeaseStatus receives LeaseStatusEvents retrieved from a storage event
+// subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnLeaseStatus(event *storage.LeaseStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.leaseExpirations.Inc(event.ExpirationCount)
+}
+
+// OnStatsStatus receives StatsStatusEvents retrieved from a storage event
+// subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnStatsStatus(event *storage.StatsStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.divergentRanges.Update(event.DivergentRangeCount)
+}
+
+// OnScanStatus receives ScanStatusEvents retrieved from a storage event
+// subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnScanStatus(event *storage.ScanStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.slowScans.Inc(event.SlowCount)
+}
+
+// OnIntentResolutionStatus receives IntentResolutionStatusEvents retrieved
+// from a storage event subscription. This method is part of the
+// implementation of store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnIntentResolutionStatus(event *storage.IntentResolutionStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.intentsResolvedAsync.Inc(event.AsyncCount)
+	ssm.intentsResolvedSync.Inc(event.SyncCount)
+}
+
 // Status information is collected from event feeds provided by lower level
 // components.
 type StoreStatusMonitor struct {
@@ -234,6 +435,38 @@ type StoreStatusMonitor struct {
 	leaderRangeCount     *metric.Gauge
 	replicatedRangeCount *metric.Gauge
 	availableRangeCount  *metric.Gauge
+	lowDiversityRanges   *metric.Gauge
+	splitCount           *metric.Counter
+	mergeCount           *metric.Counter
+
+	// Raft metrics.
+	queuedSnapshots     *metric.Gauge
+	failedSnapshots     *metric.Counter
+	droppedRaftMessages *metric.Counter
+
+	// Engine metrics.
+	writeStalls     *metric.Counter
+	writeStallNanos *metric.Histogram
+
+	// GC metrics.
+	gcOldestVersionAge *metric.Gauge
+
+	// Rebalancing metrics.
+	rebalancesRejected  *metric.Counter
+	rebalanceBytesMoved *metric.Counter
+
+	// Lease metrics.
+	leaseExpirations *metric.Counter
+
+	// Consistency metrics.
+	divergentRanges *metric.Gauge
+
+	// Scan metrics.
+	slowScans *metric.Counter
+
+	// Intent resolution metrics.
+	intentsResolvedAsync *metric.Counter
+	intentsResolvedSync  *metric.Counter
 
 	// Storage metrics.
 	liveBytes       *metric.Gauge
@@ -255,6 +488,7 @@ type StoreStatusMonitor struct {
 	stats      engine.MVCCStats
 	ID         roachpb.StoreID
 	desc       *roachpb.StoreDescriptor
+	device     string
 	startedAt  int64
 }
 
@@ -271,6 +505,22 @@ func NewStoreStatusMonitor(id roachpb.StoreID, metaRegistry *metric.Registry) *S
 		leaderRangeCount:     registry.Gauge("ranges.leader"),
 		replicatedRangeCount: registry.Gauge("ranges.replicated"),
 		availableRangeCount:  registry.Gauge("ranges.available"),
+		lowDiversityRanges:   registry.Gauge("ranges.diversity.low"),
+		splitCount:           registry.Counter("splits"),
+		mergeCount:           registry.Counter("merges"),
+		queuedSnapshots:      registry.Gauge("raft.snapshots.queued"),
+		failedSnapshots:      registry.Counter("raft.snapshots.failed"),
+		droppedRaftMessages:  registry.Counter("raft.messages.dropped"),
+		writeStalls:          registry.Counter("rocksdb.write.stalls"),
+		writeStallNanos:      registry.Histogram("rocksdb.write.stall.nanos", time.Minute, int64(time.Minute), 2),
+		gcOldestVersionAge:   registry.Gauge("gc.oldest.version.age.nanos"),
+		rebalancesRejected:   registry.Counter("rebalance.rejected.constraints"),
+		rebalanceBytesMoved:  registry.Counter("rebalance.bytes.moved"),
+		leaseExpirations:     registry.Counter("leases.expirations"),
+		divergentRanges:      registry.Gauge("stats.divergent.ranges"),
+		slowScans:            registry.Counter("scan.slow-count"),
+		intentsResolvedAsync: registry.Counter("intents.resolved.async"),
+		intentsResolvedSync:  registry.Counter("intents.resolved.sync"),
 		liveBytes:            registry.Gauge("livebytes"),
 		keyBytes:             registry.Gauge("keybytes"),
 		valBytes:             registry.Gauge("valbytes"),
@@ -312,10 +562,12 @@ func (ssm *StoreStatusMonitor) removeRange(event *storage.RemoveRangeEvent) {
 
 func (ssm *StoreStatusMonitor) splitRange(event *storage.SplitRangeEvent) {
 	ssm.rangeCount.Inc(1)
+	ssm.splitCount.Inc(1)
 }
 
 func (ssm *StoreStatusMonitor) mergeRange(event *storage.MergeRangeEvent) {
 	ssm.rangeCount.Dec(1)
+	ssm.mergeCount.Inc(1)
 }
 
 func (ssm *StoreStatusMonitor) updateStorageGaugesLocked() {
@@ -17,8 +17,10 @@
 package status
 
 import (
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/kr/pretty"
 
@@ -162,6 +164,12 @@ func TestNodeStatusMonitor(t *testing.T) {
 		if a, e := store.rangeCount.Count(), int64(2); a != e {
 			t.Errorf("monitored range count for store %d did not match expectation: %d != %d", id, a, e)
 		}
+		if a, e := store.splitCount.Count(), int64(1); a != e {
+			t.Errorf("monitored split count for store %d did not match expectation: %d != %d", id, a, e)
+		}
+		if a, e := store.mergeCount.Count(), int64(0); a != e {
+			t.Errorf("monitored merge count for store %d did not match expectation: %d != %d", id, a, e)
+		}
 	}
 
 	if a, e := monitor.mSuccess.Count(), int64(6); a != e {
@@ -171,3 +179,95 @@ func TestNodeStatusMonitor(t *testing.T) {
 		t.Errorf("monitored stats for node recorded wrong number of errors %d, expected %d", a, e)
 	}
 }
+
+// TestNodeStatusMonitorLatencySampling verifies that sampling call latencies
+// keeps the recorded counts and quantiles representative.
+func TestNodeStatusMonitorLatencySampling(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	monitor.SetLatencySampleRate(2)
+
+	const numCalls = 10000
+	for i := 1; i <= numCalls; i++ {
+		monitor.OnCallSuccess(&CallSuccessEvent{
+			NodeID:   roachpb.NodeID(1),
+			Method:   roachpb.Get,
+			Duration: time.Duration(i) * time.Microsecond,
+		})
+	}
+
+	for _, h := range monitor.mLatency {
+		current := h.Current()
+		if a, e := current.TotalCount(), int64(numCalls); a != e {
+			t.Errorf("expected %d latencies to be accounted for, got %d", e, a)
+		}
+		for _, q := range []float64{25, 50, 90, 99} {
+			e := q / 100 * numCalls * float64(time.Microsecond)
+			a := float64(current.ValueAtQuantile(q))
+			if math.Abs(a-e)/e > 0.02 {
+				t.Errorf("expected quantile %.0f to be approximately %.0f, got %.0f", q, e, a)
+			}
+		}
+	}
+}
+
+// TestNodeStatusMonitorSlowRequests verifies that only calls slower than the
+// configured threshold are counted as slow.
+func TestNodeStatusMonitorSlowRequests(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	monitor.SetSlowRequestThreshold(100 * time.Millisecond)
+
+	monitor.OnCallSuccess(&CallSuccessEvent{NodeID: 1, Method: roachpb.Get, Duration: 10 * time.Millisecond})
+	monitor.OnCallSuccess(&CallSuccessEvent{NodeID: 1, Method: roachpb.Put, Duration: 100 * time.Millisecond})
+	monitor.OnCallSuccess(&CallSuccessEvent{NodeID: 1, Method: roachpb.Get, Duration: 150 * time.Millisecond})
+	monitor.OnCallError(&CallErrorEvent{NodeID: 1, Method: roachpb.Scan, Duration: time.Second})
+	if a, e := monitor.mSlow.Count(), int64(2); a != e {
+		t.Errorf("expected %d slow requests, got %d", e, a)
+	}
+
+	// A threshold of zero disables the count.
+	monitor.SetSlowRequestThreshold(0)
+	monitor.OnCallSuccess(&CallSuccessEvent{NodeID: 1, Method: roachpb.Get, Duration: time.Minute})
+	if a, e := monitor.mSlow.Count(), int64(2); a != e {
+		t.Errorf("expected %d slow requests, got %d", e, a)
+	}
+}
+
+// TestNodeStatusMonitorWriteStalls verifies that write stall events are
+// accumulated into the store's stall counter and duration histogram.
+func TestNodeStatusMonitorWriteStalls(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+
+	id := roachpb.StoreID(1)
+	for _, event := range []*storage.WriteStallStatusEvent{
+		{StoreID: id, StallCount: 2, StallNanos: int64(2 * time.Second)},
+		// Intervals without stalls are ignored.
+		{StoreID: id, StallCount: 0, StallNanos: 0},
+		{StoreID: id, StallCount: 1, StallNanos: int64(4 * time.Second)},
+	} {
+		monitor.OnWriteStallStatus(event)
+	}
+
+	ssm := monitor.GetStoreMonitor(id)
+	if a, e := ssm.writeStalls.Count(), int64(3); a != e {
+		t.Errorf("expected %d write stalls, got %d", e, a)
+	}
+	current := ssm.writeStallNanos.Current()
+	if a, e := current.TotalCount(), int64(3); a != e {
+		t.Errorf("expected %d write stall durations, got %d", e, a)
+	}
+	for _, tc := range []struct {
+		quantile float64
+		expected time.Duration
+	}{
+		{50, time.Second},
+		{100, 4 * time.Second},
+	} {
+		a := time.Duration(current.ValueAtQuantile(tc.quantile))
+		if math.Abs(float64(a-tc.expected))/float64(tc.expected) > 0.01 {
+			t.Errorf("expected quantile %.0f to be approximately %s, got %s", tc.quantile, tc.expected, a)
+		}
+	}
+}
@@ -18,10 +18,12 @@ package status
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/ts"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/metric"
@@ -38,8 +40,56 @@ const (
 	// runtimeStatTimeSeriesFmt is the current format for time series keys which
 	// record runtime system stats on a node.
 	runtimeStatTimeSeriesNameFmt = "cr.node.sys.%s"
+	// snapshotNanosName is the name of the node metric which records the
+	// duration of the recorder's most recent snapshot.
+	snapshotNanosName = "internal.recorder.snapshot.nanos"
+	// seriesTruncatedName is the name of the node metric which counts the
+	// time series dropped by the recorder to respect its cap on the number of
+	// series.
+	seriesTruncatedName = "internal.tsd.series.truncated"
+	// clockSkewWallName and clockSkewLogicalName are the names of the node
+	// metrics which record how far the node's hybrid logical clock is ahead
+	// of its physical clock.
+	clockSkewWallName    = "clock.skew.wall.nanos"
+	clockSkewLogicalName = "clock.skew.logical"
+	// clockBackwardJumpsName is the name of the node metric which counts the
+	// backward jumps of the node's physical clock.
+	clockBackwardJumpsName = "clock.backward.jumps"
+	// deviceLabel is the name of the label which identifies the device
+	// backing a store on its time series.
+	deviceLabel = "device"
+	// scopeLabel is the name of the label which identifies the Scope of a
+	// time series.
+	scopeLabel = "scope"
 )
 
+// A Scope is the kind of source for which a time series is recorded. It is
+// attached to every time series returned by GetTimeSeriesData as the value
+// of its scope label, so that consumers don't have to derive it from the
+// name of the series.
+type Scope string
+
+const (
+	// NodeScope is the scope of the time series of a node.
+	NodeScope Scope = "node"
+	// StoreScope is the scope of the time series of a store.
+	StoreScope Scope = "store"
+	// ClusterScope is the scope of time series describing the cluster as a
+	// whole. None are recorded by the NodeStatusRecorder.
+	ClusterScope Scope = "cluster"
+)
+
+// SeriesScope returns the scope of a time series, as attached by the
+// recorder. It returns false if the time series has no scope.
+func SeriesScope(data ts.TimeSeriesData) (Scope, bool) {
+	for _, label := range data.Labels {
+		if label.Name == scopeLabel {
+			return Scope(label.Value), true
+		}
+	}
+	return "", false
+}
+
 type quantile struct {
 	suffix   string
 	quantile float64
@@ -64,16 +114,77 @@ type NodeStatusRecorder struct {
 	source           string // Source string used when storing time series data for this node.
 	lastDataCount    int
 	lastSummaryCount int
+	// timeScales are the time scales for which windowed histograms are
+	// recorded. If nil, all time scales are recorded.
+	timeScales []metric.TimeScale
+	// snapshotNanos is the duration of the most recent call to
+	// GetTimeSeriesData or GetStatusSummaries. As the time series data of a
+	// snapshot is collected before it completes, it records the duration of
+	// the previous snapshot.
+	snapshotNanos *metric.Gauge
+	// maxSeries caps the number of series returned by GetTimeSeriesData. Zero
+	// means no cap.
+	maxSeries int
+	// seriesTruncated counts the series dropped to respect maxSeries.
+	seriesTruncated *metric.Counter
+	// clockSkewWall and clockSkewLogical are sampled from the clock when
+	// time series data is collected.
+	clockSkewWall    *metric.Gauge
+	clockSkewLogical *metric.Gauge
+	// significantFigures is the number of significant figures to which the
+	// float values of metrics, such as rates, are rounded. Zero means no
+	// rounding.
+	significantFigures int
 }
 
 // NewNodeStatusRecorder instantiates a recorder for the supplied monitor.
 func NewNodeStatusRecorder(monitor *NodeStatusMonitor, clock *hlc.Clock) *NodeStatusRecorder {
+	monitor.registry.MustAdd(clockBackwardJumpsName, clock.BackwardJumps())
 	return &NodeStatusRecorder{
 		NodeStatusMonitor: monitor,
 		clock:             clock,
+		snapshotNanos:     monitor.registry.Gauge(snapshotNanosName),
+		seriesTruncated:   monitor.registry.Counter(seriesTruncatedName),
+		clockSkewWall:     monitor.registry.Gauge(clockSkewWallName),
+		clockSkewLogical:  monitor.registry.Gauge(clockSkewLogicalName),
 	}
 }
 
+// recordSnapshotDuration records the time elapsed on the recorder's clock
+// since the given start time as the duration of the most recent snapshot.
+func (nsr *NodeStatusRecorder) recordSnapshotDuration(startNanos int64) {
+	nsr.snapshotNanos.Update(nsr.clock.PhysicalNow() - startNanos)
+}
+
+// SetTimeScales restricts the windowed histograms, such as the execution
+// latencies, for which time series data is recorded to those of the given
+// time scales. By default, the histograms of all metric.DefaultTimeScales are
+// recorded.
+func (nsr *NodeStatusRecorder) SetTimeScales(scales []metric.TimeScale) {
+	nsr.Lock()
+	defer nsr.Unlock()
+	nsr.timeScales = scales
+}
+
+// SetMaxSeries caps the number of series returned by GetTimeSeriesData. The
+// series of the node come before those of its stores, so that they are the
+// last to be dropped. Zero removes the cap.
+func (nsr *NodeStatusRecorder) SetMaxSeries(maxSeries int) {
+	nsr.Lock()
+	defer nsr.Unlock()
+	nsr.maxSeries = maxSeries
+}
+
+// SetSignificantFigures rounds the float values of metrics, such as rates,
+// to the given number of significant figures in the time series data.
+// Counters and gauges hold exact integers and are never rounded. Zero
+// disables rounding.
+func (nsr *NodeStatusRecorder) SetSignificantFigures(figures int) {
+	nsr.Lock()
+	defer nsr.Unlock()
+	nsr.significantFigures = figures
+}
+
 // GetTimeSeriesData returns a slice of interesting TimeSeriesData from the
 // encapsulated NodeStatusMonitor.
 func (nsr *NodeStatusRecorder) GetTimeSeriesData() []ts.TimeSeriesData {
@@ -87,19 +198,27 @@ func (nsr *NodeStatusRecorder) GetTimeSeriesData() []ts.TimeSeriesData {
 		}
 		return nil
 	}
+	defer nsr.recordSnapshotDuration(nsr.clock.PhysicalNow())
 	if nsr.source == "" {
 		nsr.source = strconv.FormatInt(int64(nsr.desc.NodeID), 10)
 	}
 
 	data := make([]ts.TimeSeriesData, 0, nsr.lastDataCount)
 
+	wallSkew, logicalSkew := nsr.clock.Skew()
+	nsr.clockSkewWall.Update(int64(wallSkew))
+	nsr.clockSkewLogical.Update(int64(logicalSkew))
+
 	// Record node stats.
 	now := nsr.clock.PhysicalNow()
 	recorder := registryRecorder{
-		registry:       nsr.registry,
-		prefix:         nodeTimeSeriesPrefix,
-		source:         nsr.source,
-		timestampNanos: now,
+		registry:           nsr.registry,
+		prefix:             nodeTimeSeriesPrefix,
+		source:             nsr.source,
+		labels:             []ts.TimeSeriesLabel{{Name: scopeLabel, Value: string(NodeScope)}},
+		timestampNanos:     now,
+		timeScales:         nsr.timeScales,
+		significantFigures: nsr.significantFigures,
 	}
 	recorder.record(&data)
 
@@ -107,13 +226,23 @@ func (nsr *NodeStatusRecorder) GetTimeSeriesData() []ts.TimeSeriesData {
 	nsr.visitStoreMonitors(func(ssm *StoreStatusMonitor) {
 		now := nsr.clock.PhysicalNow()
 		storeRecorder := registryRecorder{
-			registry:       ssm.registry,
-			prefix:         storeTimeSeriesPrefix,
-			source:         strconv.FormatInt(int64(ssm.ID), 10),
-			timestampNanos: now,
+			registry:           ssm.registry,
+			prefix:             storeTimeSeriesPrefix,
+			source:             strconv.FormatInt(int64(ssm.ID), 10),
+			labels:             []ts.TimeSeriesLabel{{Name: scopeLabel, Value: string(StoreScope)}},
+			timestampNanos:     now,
+			timeScales:         nsr.timeScales,
+			significantFigures: nsr.significantFigures,
+		}
+		if ssm.device != "" {
+			storeRecorder.labels = append(storeRecorder.labels, ts.TimeSeriesLabel{Name: deviceLabel, Value: ssm.device})
 		}
 		storeRecorder.record(&data)
 	})
+	if nsr.maxSeries > 0 && len(data) > nsr.maxSeries {
+		nsr.seriesTruncated.Inc(int64(len(data) - nsr.maxSeries))
+		data = data[:nsr.maxSeries]
+	}
 	nsr.lastDataCount = len(data)
 	return data
 }
@@ -131,15 +260,19 @@ func (nsr *NodeStatusRecorder) GetStatusSummaries() (*NodeStatus, []storage.Stor
 		}
 		return nil, nil
 	}
+	defer nsr.recordSnapshotDuration(nsr.clock.PhysicalNow())
 
 	now := nsr.clock.PhysicalNow()
 
 	// Generate an node status with no store data.
+	buildInfo := util.GetBuildInfo()
 	nodeStat := &NodeStatus{
-		Desc:      nsr.desc,
-		UpdatedAt: now,
-		StartedAt: nsr.startedAt,
-		StoreIDs:  make([]roachpb.StoreID, 0, nsr.lastSummaryCount),
+		Desc:          nsr.desc,
+		UpdatedAt:     now,
+		StartedAt:     nsr.startedAt,
+		StoreIDs:      make([]roachpb.StoreID, 0, nsr.lastSummaryCount),
+		BinaryVersion: buildInfo.Version,
+		BuildTag:      buildInfo.Tag,
 	}
 
 	storeStats := make([]storage.StoreStatus, 0, nsr.lastSummaryCount)
@@ -178,13 +311,52 @@ func (nsr *NodeStatusRecorder) GetStatusSummaries() (*NodeStatus, []storage.Stor
 	return nodeStat, storeStats
 }
 
+// GetNodeSummary returns the status summaries generated by
+// GetStatusSummaries as a single NodeSummary protocol buffer, which is more
+// efficient to transmit between nodes than the equivalent JSON. It returns nil
+// if the recorder has not yet been initialized.
+func (nsr *NodeStatusRecorder) GetNodeSummary() *NodeSummary {
+	nodeStatus, storeStatuses := nsr.GetStatusSummaries()
+	if nodeStatus == nil {
+		return nil
+	}
+	return &NodeSummary{
+		NodeStatus:    *nodeStatus,
+		StoreStatuses: storeStatuses,
+	}
+}
+
 // registryRecorder is a helper class for recording time series datapoints
 // from a metrics Registry.
 type registryRecorder struct {
-	registry       *metric.Registry
-	prefix         string
-	source         string
-	timestampNanos int64
+	registry           *metric.Registry
+	prefix             string
+	source             string
+	labels             []ts.TimeSeriesLabel
+	timestampNanos     int64
+	timeScales         []metric.TimeScale
+	significantFigures int
+}
+
+// histogramEnabled returns whether the histogram with the given name should
+// be recorded. Windowed histograms are named with their time scale as a
+// suffix and are only recorded if their time scale is enabled.
+func (rr registryRecorder) histogramEnabled(name string) bool {
+	if rr.timeScales == nil {
+		return true
+	}
+	for _, scale := range metric.DefaultTimeScales {
+		if !strings.HasSuffix(name, "-"+scale.Name()) {
+			continue
+		}
+		for _, enabled := range rr.timeScales {
+			if enabled == scale {
+				return true
+			}
+		}
+		return false
+	}
+	return true
 }
 
 func (rr registryRecorder) record(dest *[]ts.TimeSeriesData) {
@@ -192,6 +364,7 @@ func (rr registryRecorder) record(dest *[]ts.TimeSeriesData) {
 		data := ts.TimeSeriesData{
 			Name:   rr.prefix + name,
 			Source: rr.source,
+			Labels: rr.labels,
 			Datapoints: []*ts.TimeSeriesDatapoint{
 				{
 					TimestampNanos: rr.timestampNanos,
@@ -202,7 +375,7 @@ func (rr registryRecorder) record(dest *[]ts.TimeSeriesData) {
 		// TODO(tschottdorf): should make this based on interfaces.
 		switch mtr := m.(type) {
 		case float64:
-			data.Datapoints[0].Value = mtr
+			data.Datapoints[0].Value = roundSignificant(mtr, rr.significantFigures)
 		case *metric.Rates:
 			data.Datapoints[0].Value = float64(mtr.Count())
 		case *metric.Counter:
@@ -210,6 +383,9 @@ func (rr registryRecorder) record(dest *[]ts.TimeSeriesData) {
 		case *metric.Gauge:
 			data.Datapoints[0].Value = float64(mtr.Value())
 		case *metric.Histogram:
+			if !rr.histogramEnabled(name) {
+				return
+			}
 			h := mtr.Current()
 			for _, pt := range recordHistogramQuantiles {
 				d := *proto.Clone(&data).(*ts.TimeSeriesData)
@@ -225,3 +401,16 @@ func (rr registryRecorder) record(dest *[]ts.TimeSeriesData) {
 		*dest = append(*dest, data)
 	})
 }
+
+// roundSignificant rounds v to the given number of significant figures. If
+// figures is zero, v is returned unchanged.
+func roundSignificant(v float64, figures int) float64 {
+	if figures <= 0 {
+		return v
+	}
+	r, err := strconv.ParseFloat(strconv.FormatFloat(v, 'g', figures, 64), 64)
+	if err != nil {
+		return v
+	}
+	return r
+}
@@ -21,14 +21,19 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kr/pretty"
 
+	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/testutils/statusutils"
 	"github.com/cockroachdb/cockroach/ts"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/metric"
@@ -184,37 +189,131 @@ func TestNodeStatusRecorder(t *testing.T) {
 		Desc:    desc1,
 		Delta:   stats,
 	})
-	// Periodically published events.
-	monitor.OnReplicationStatus(&storage.ReplicationStatusEvent{
-		StoreID:              roachpb.StoreID(1),
-		LeaderRangeCount:     1,
-		AvailableRangeCount:  2,
-		ReplicatedRangeCount: 0,
-	})
-	monitor.OnReplicationStatus(&storage.ReplicationStatusEvent{
-		StoreID:              roachpb.StoreID(2),
-		LeaderRangeCount:     1,
-		AvailableRangeCount:  2,
-		ReplicatedRangeCount: 0,
-	})
-	// Node Events.
-	monitor.OnCallSuccess(&CallSuccessEvent{
-		NodeID: roachpb.NodeID(1),
-		Method: roachpb.Get,
-	})
-	monitor.OnCallSuccess(&CallSuccessEvent{
-		NodeID: roachpb.NodeID(1),
-		Method: roachpb.Put,
-	})
-	monitor.OnCallError(&CallErrorEvent{
-		NodeID: roachpb.NodeID(1),
-		Method: roachpb.Scan,
-	})
+	monitor.SetMaintenance(true)
+	monitor.UpdateGossipStats(gossip.Stats{Connections: 2, InfosReceived: 5})
+	monitor.UpdateGossipStats(gossip.Stats{Connections: 3, InfosReceived: 12, Connected: true})
+
+	// Periodically published store events and node events.
+	actual := statusutils.Replay(recorder, monitor.ProcessEvent,
+		&storage.ReplicationStatusEvent{
+			StoreID:                roachpb.StoreID(1),
+			LeaderRangeCount:       1,
+			AvailableRangeCount:    2,
+			ReplicatedRangeCount:   0,
+			LowDiversityRangeCount: 1,
+		},
+		&storage.ReplicationStatusEvent{
+			StoreID:              roachpb.StoreID(2),
+			LeaderRangeCount:     1,
+			AvailableRangeCount:  2,
+			ReplicatedRangeCount: 0,
+		},
+		&storage.RaftSnapshotStatusEvent{
+			StoreID:     roachpb.StoreID(1),
+			QueuedCount: 3,
+			FailedCount: 2,
+		},
+		&storage.RaftSnapshotStatusEvent{
+			StoreID:     roachpb.StoreID(2),
+			QueuedCount: 1,
+			FailedCount: 1,
+		},
+		// Failures accumulate across events while the queue length is replaced.
+		&storage.RaftSnapshotStatusEvent{
+			StoreID:     roachpb.StoreID(2),
+			QueuedCount: 0,
+			FailedCount: 0,
+		},
+		// Dropped raft messages accumulate across events.
+		&storage.RaftMessageStatusEvent{
+			StoreID:      roachpb.StoreID(1),
+			DroppedCount: 4,
+		},
+		&storage.RaftMessageStatusEvent{
+			StoreID:      roachpb.StoreID(1),
+			DroppedCount: 3,
+		},
+		&storage.WriteStallStatusEvent{
+			StoreID:    roachpb.StoreID(1),
+			StallCount: 2,
+			StallNanos: 300,
+		},
+		&storage.GCStatusEvent{
+			StoreID:               roachpb.StoreID(1),
+			OldestVersionAgeNanos: 4000,
+		},
+		// Rejected rebalances and moved bytes accumulate across events.
+		&storage.RebalanceStatusEvent{
+			StoreID:                  roachpb.StoreID(1),
+			RejectedConstraintsCount: 2,
+			BytesMoved:               4096,
+		},
+		&storage.RebalanceStatusEvent{
+			StoreID:                  roachpb.StoreID(1),
+			RejectedConstraintsCount: 3,
+			BytesMoved:               1024,
+		},
+		// Lease expirations accumulate across events.
+		&storage.LeaseStatusEvent{
+			StoreID:         roachpb.StoreID(2),
+			ExpirationCount: 1,
+		},
+		&storage.LeaseStatusEvent{
+			StoreID:         roachpb.StoreID(2),
+			ExpirationCount: 3,
+		},
+		// The divergent range count is replaced by each event.
+		&storage.StatsStatusEvent{
+			StoreID:             roachpb.StoreID(1),
+			DivergentRangeCount: 2,
+		},
+		&storage.StatsStatusEvent{
+			StoreID:             roachpb.StoreID(1),
+			DivergentRangeCount: 1,
+		},
+		// Slow scans accumulate across events.
+		&storage.ScanStatusEvent{
+			StoreID:   roachpb.StoreID(1),
+			SlowCount: 2,
+		},
+		&storage.ScanStatusEvent{
+			StoreID:   roachpb.StoreID(1),
+			SlowCount: 5,
+		},
+		// Resolved intents accumulate across events.
+		&storage.IntentResolutionStatusEvent{
+			StoreID:    roachpb.StoreID(1),
+			AsyncCount: 4,
+			SyncCount:  1,
+		},
+		&storage.IntentResolutionStatusEvent{
+			StoreID:    roachpb.StoreID(1),
+			AsyncCount: 2,
+		},
+		&storage.IntentResolutionStatusEvent{
+			StoreID:   roachpb.StoreID(2),
+			SyncCount: 3,
+		},
+		// Node Events.
+		&CallSuccessEvent{
+			NodeID: roachpb.NodeID(1),
+			Method: roachpb.Get,
+		},
+		&CallSuccessEvent{
+			NodeID: roachpb.NodeID(1),
+			Method: roachpb.Put,
+		},
+		&CallErrorEvent{
+			NodeID: roachpb.NodeID(1),
+			Method: roachpb.Scan,
+		},
+	)
 
 	generateNodeData := func(nodeId int, name string, time, val int64) ts.TimeSeriesData {
 		return ts.TimeSeriesData{
 			Name:   nodeTimeSeriesPrefix + name,
 			Source: strconv.FormatInt(int64(nodeId), 10),
+			Labels: []ts.TimeSeriesLabel{{Name: scopeLabel, Value: string(NodeScope)}},
 			Datapoints: []*ts.TimeSeriesDatapoint{
 				{
 					TimestampNanos: time,
@@ -228,6 +327,7 @@ func TestNodeStatusRecorder(t *testing.T) {
 		return ts.TimeSeriesData{
 			Name:   storeTimeSeriesPrefix + name,
 			Source: strconv.FormatInt(int64(storeId), 10),
+			Labels: []ts.TimeSeriesLabel{{Name: scopeLabel, Value: string(StoreScope)}},
 			Datapoints: []*ts.TimeSeriesDatapoint{
 				{
 					TimestampNanos: time,
@@ -257,6 +357,21 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(1, "ranges.leader", 100, 1),
 		generateStoreData(1, "ranges.available", 100, 2),
 		generateStoreData(1, "ranges.replicated", 100, 0),
+		generateStoreData(1, "ranges.diversity.low", 100, 1),
+		generateStoreData(1, "splits", 100, 0),
+		generateStoreData(1, "merges", 100, 0),
+		generateStoreData(1, "raft.snapshots.queued", 100, 3),
+		generateStoreData(1, "raft.snapshots.failed", 100, 2),
+		generateStoreData(1, "raft.messages.dropped", 100, 7),
+		generateStoreData(1, "rocksdb.write.stalls", 100, 2),
+		generateStoreData(1, "gc.oldest.version.age.nanos", 100, 4000),
+		generateStoreData(1, "rebalance.rejected.constraints", 100, 5),
+		generateStoreData(1, "rebalance.bytes.moved", 100, 5120),
+		generateStoreData(1, "leases.expirations", 100, 0),
+		generateStoreData(1, "stats.divergent.ranges", 100, 1),
+		generateStoreData(1, "scan.slow-count", 100, 7),
+		generateStoreData(1, "intents.resolved.async", 100, 6),
+		generateStoreData(1, "intents.resolved.sync", 100, 1),
 		generateStoreData(1, "capacity", 100, 100),
 		generateStoreData(1, "capacity.available", 100, 50),
 
@@ -276,6 +391,21 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(2, "ranges.leader", 100, 1),
 		generateStoreData(2, "ranges.available", 100, 2),
 		generateStoreData(2, "ranges.replicated", 100, 0),
+		generateStoreData(2, "ranges.diversity.low", 100, 0),
+		generateStoreData(2, "splits", 100, 0),
+		generateStoreData(2, "merges", 100, 0),
+		generateStoreData(2, "raft.snapshots.queued", 100, 0),
+		generateStoreData(2, "raft.snapshots.failed", 100, 1),
+		generateStoreData(2, "raft.messages.dropped", 100, 0),
+		generateStoreData(2, "rocksdb.write.stalls", 100, 0),
+		generateStoreData(2, "gc.oldest.version.age.nanos", 100, 0),
+		generateStoreData(2, "rebalance.rejected.constraints", 100, 0),
+		generateStoreData(2, "rebalance.bytes.moved", 100, 0),
+		generateStoreData(2, "leases.expirations", 100, 4),
+		generateStoreData(2, "stats.divergent.ranges", 100, 0),
+		generateStoreData(2, "scan.slow-count", 100, 0),
+		generateStoreData(2, "intents.resolved.async", 100, 0),
+		generateStoreData(2, "intents.resolved.sync", 100, 3),
 		generateStoreData(2, "capacity", 100, 200),
 		generateStoreData(2, "capacity.available", 100, 75),
 
@@ -288,9 +418,28 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateNodeData(1, "exec.error-10m", 100, 0),
 		generateNodeData(1, "exec.success-1m", 100, 0),
 		generateNodeData(1, "exec.error-1m", 100, 0),
+		generateNodeData(1, "exec.slow-count", 100, 0),
+		generateNodeData(1, "sys.maintenance", 100, 1),
+		generateNodeData(1, "sys.gossip.connections", 100, 3),
+		generateNodeData(1, "sys.gossip.infos.received", 100, 12),
+		generateNodeData(1, "sys.gossip.connected", 100, 1),
+		// The manual clock doesn't advance while the recorder takes a
+		// snapshot.
+		generateNodeData(1, snapshotNanosName, 100, 0),
+		generateNodeData(1, seriesTruncatedName, 100, 0),
+		generateNodeData(1, clockSkewWallName, 100, 0),
+		generateNodeData(1, clockSkewLogicalName, 100, 0),
+		generateNodeData(1, clockBackwardJumpsName, 100, 0),
 	}
 
-	actual := recorder.GetTimeSeriesData()
+	// Each of the two stalls on store 1 is recorded with the average
+	// duration.
+	for _, q := range recordHistogramQuantiles {
+		expected = append(expected,
+			generateStoreData(1, "rocksdb.write.stall.nanos"+q.suffix, 100, 150),
+			generateStoreData(2, "rocksdb.write.stall.nanos"+q.suffix, 100, 0),
+		)
+	}
 
 	var actNumLatencyMetrics int
 	expNumLatencyMetrics := len(recordHistogramQuantiles) * len(metric.DefaultTimeScales)
@@ -313,9 +462,11 @@ func TestNodeStatusRecorder(t *testing.T) {
 	}
 
 	expectedNodeSummary := &NodeStatus{
-		Desc:      nodeDesc,
-		StartedAt: 50,
-		UpdatedAt: 100,
+		Desc:          nodeDesc,
+		StartedAt:     50,
+		UpdatedAt:     100,
+		BinaryVersion: util.GetBuildInfo().Version,
+		BuildTag:      util.GetBuildInfo().Tag,
 		StoreIDs: []roachpb.StoreID{
 			roachpb.StoreID(1),
 			roachpb.StoreID(2),
@@ -365,4 +516,351 @@ func TestNodeStatusRecorder(t *testing.T) {
 	if a, e := storeSummaries, expectedStoreSummaries; !reflect.DeepEqual(a, e) {
 		t.Errorf("recorder did not produce expected StoreSummaries; diff:\n %v", pretty.Diff(e, a))
 	}
+
+	summary := recorder.GetNodeSummary()
+	sort.Sort(byStoreDescID(summary.StoreStatuses))
+	sort.Sort(byStoreID(summary.NodeStatus.StoreIDs))
+	if a, e := summary.NodeStatus, *expectedNodeSummary; !reflect.DeepEqual(a, e) {
+		t.Errorf("recorder did not produce expected NodeSummary node status; diff:\n %v", pretty.Diff(e, a))
+	}
+	if a, e := summary.StoreStatuses, expectedStoreSummaries; !reflect.DeepEqual(a, e) {
+		t.Errorf("recorder did not produce expected NodeSummary store statuses; diff:\n %v", pretty.Diff(e, a))
+	}
+}
+
+// TestNodeStatusRecorderTimeScales verifies that only the latency histograms
+// of the enabled time scales are recorded.
+func TestNodeStatusRecorderTimeScales(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	manual := hlc.NewManualClock(100)
+	recorder := NewNodeStatusRecorder(monitor, hlc.NewClock(manual.UnixNano))
+	scale := metric.DefaultTimeScales[0]
+	recorder.SetTimeScales([]metric.TimeScale{scale})
+
+	monitor.OnStartNode(&StartNodeEvent{
+		Desc:      roachpb.NodeDescriptor{NodeID: roachpb.NodeID(1)},
+		StartedAt: 50,
+	})
+	monitor.OnCallSuccess(&CallSuccessEvent{
+		NodeID:   roachpb.NodeID(1),
+		Method:   roachpb.Get,
+		Duration: time.Millisecond,
+	})
+
+	var names []string
+	for _, item := range recorder.GetTimeSeriesData() {
+		if strings.HasPrefix(item.Name, "cr.node.exec.latency") {
+			names = append(names, item.Name)
+		}
+	}
+	var expected []string
+	for _, q := range recordHistogramQuantiles {
+		expected = append(expected, "cr.node.exec.latency-"+scale.Name()+q.suffix)
+	}
+	sort.Strings(names)
+	sort.Strings(expected)
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected latency series %s, got %s", expected, names)
+	}
+}
+
+// TestNodeStatusRecorderSnapshotDuration verifies that the recorder reports
+// the duration of its most recent snapshot as a node time series.
+func TestNodeStatusRecorderSnapshotDuration(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	// Every reading of the clock advances it by a nanosecond.
+	var nowNanos int64
+	recorder := NewNodeStatusRecorder(monitor, hlc.NewClock(func() int64 {
+		nowNanos++
+		return nowNanos
+	}))
+	monitor.OnStartNode(&StartNodeEvent{
+		Desc:      roachpb.NodeDescriptor{NodeID: roachpb.NodeID(1)},
+		StartedAt: 50,
+	})
+
+	snapshotNanos := func() (float64, bool) {
+		for _, item := range recorder.GetTimeSeriesData() {
+			if item.Name == nodeTimeSeriesPrefix+snapshotNanosName {
+				return item.Datapoints[0].Value, true
+			}
+		}
+		return 0, false
+	}
+	if _, ok := snapshotNanos(); !ok {
+		t.Fatalf("expected a %s time series", snapshotNanosName)
+	}
+	// The duration of the first snapshot is reported by the second.
+	if value, ok := snapshotNanos(); !ok || value <= 0 {
+		t.Errorf("expected a positive snapshot duration, got %f", value)
+	}
+}
+
+// TestNodeStatusRecorderDeviceLabel verifies that the time series of a store
+// are labeled with the device backing the store.
+func TestNodeStatusRecorderDeviceLabel(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	manual := hlc.NewManualClock(100)
+	recorder := NewNodeStatusRecorder(monitor, hlc.NewClock(manual.UnixNano))
+
+	monitor.OnStartNode(&StartNodeEvent{
+		Desc:      roachpb.NodeDescriptor{NodeID: roachpb.NodeID(1)},
+		StartedAt: 50,
+	})
+	for _, storeID := range []roachpb.StoreID{1, 2} {
+		monitor.OnStoreStatus(&storage.StoreStatusEvent{
+			Desc: &roachpb.StoreDescriptor{
+				StoreID:  storeID,
+				Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50},
+			},
+			Device: "2049",
+		})
+	}
+
+	expected := []ts.TimeSeriesLabel{
+		{Name: scopeLabel, Value: string(StoreScope)},
+		{Name: deviceLabel, Value: "2049"},
+	}
+	expectedNode := []ts.TimeSeriesLabel{{Name: scopeLabel, Value: string(NodeScope)}}
+	var sources []string
+	for _, item := range recorder.GetTimeSeriesData() {
+		switch {
+		case item.Name == storeTimeSeriesPrefix+"capacity":
+			if !reflect.DeepEqual(item.Labels, expected) {
+				t.Errorf("store %s: expected labels %v, got %v", item.Source, expected, item.Labels)
+			}
+			sources = append(sources, item.Source)
+		case strings.HasPrefix(item.Name, nodeTimeSeriesPrefix):
+			if !reflect.DeepEqual(item.Labels, expectedNode) {
+				t.Errorf("%s: expected labels %v, got %v", item.Name, expectedNode, item.Labels)
+			}
+		}
+	}
+	sort.Strings(sources)
+	if e := []string{"1", "2"}; !reflect.DeepEqual(sources, e) {
+		t.Errorf("expected capacity series of stores %v, got %v", e, sources)
+	}
+}
+
+// TestNodeStatusRecorderScope verifies that the time series of the stores
+// report the store scope and those of the node report the node scope.
+func TestNodeStatusRecorderScope(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	manual := hlc.NewManualClock(100)
+	recorder := NewNodeStatusRecorder(monitor, hlc.NewClock(manual.UnixNano))
+
+	monitor.OnStartNode(&StartNodeEvent{
+		Desc:      roachpb.NodeDescriptor{NodeID: roachpb.NodeID(1)},
+		StartedAt: 50,
+	})
+	monitor.OnStoreStatus(&storage.StoreStatusEvent{
+		Desc: &roachpb.StoreDescriptor{
+			StoreID:  1,
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50},
+		},
+		Device: "2049",
+	})
+
+	counts := map[Scope]int{}
+	for _, item := range recorder.GetTimeSeriesData() {
+		scope, ok := SeriesScope(item)
+		if !ok {
+			t.Errorf("%s: expected a scope", item.Name)
+			continue
+		}
+		var expected Scope
+		switch {
+		case strings.HasPrefix(item.Name, storeTimeSeriesPrefix):
+			expected = StoreScope
+		case strings.HasPrefix(item.Name, nodeTimeSeriesPrefix):
+			expected = NodeScope
+		}
+		if scope != expected {
+			t.Errorf("%s: expected scope %q, got %q", item.Name, expected, scope)
+		}
+		counts[scope]++
+	}
+	if counts[NodeScope] == 0 || counts[StoreScope] == 0 {
+		t.Errorf("expected series of both the node and the store, got %v", counts)
+	}
+}
+
+// TestNodeStatusRecorderMaxSeries verifies that the recorder drops the series
+// of the stores before those of the node to respect its cap on the number of
+// series, and counts the dropped series.
+func TestNodeStatusRecorderMaxSeries(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	manual := hlc.NewManualClock(100)
+	recorder := NewNodeStatusRecorder(monitor, hlc.NewClock(manual.UnixNano))
+
+	monitor.OnStartNode(&StartNodeEvent{
+		Desc:      roachpb.NodeDescriptor{NodeID: roachpb.NodeID(1)},
+		StartedAt: 50,
+	})
+	monitor.OnStoreStatus(&storage.StoreStatusEvent{
+		Desc: &roachpb.StoreDescriptor{
+			StoreID:  1,
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50},
+		},
+	})
+
+	var nodeSeries int
+	all := recorder.GetTimeSeriesData()
+	for _, item := range all {
+		if strings.HasPrefix(item.Name, nodeTimeSeriesPrefix) {
+			nodeSeries++
+		}
+	}
+	if nodeSeries == len(all) {
+		t.Fatalf("expected store series, got %v", all)
+	}
+
+	// Some of the store series are dropped, then some of the node series.
+	var truncated int64
+	for _, maxSeries := range []int{nodeSeries + 1, nodeSeries - 1} {
+		recorder.SetMaxSeries(maxSeries)
+		data := recorder.GetTimeSeriesData()
+		if len(data) != maxSeries {
+			t.Fatalf("expected %d series, got %d", maxSeries, len(data))
+		}
+		// The order of the series of the node, and of those of a store, is
+		// that of the registry and thus arbitrary.
+		for i, item := range data {
+			if isNode := strings.HasPrefix(item.Name, nodeTimeSeriesPrefix); isNode != (i < nodeSeries) {
+				t.Errorf("%d: unexpected series %s of %s", i, item.Name, item.Source)
+			}
+		}
+		truncated += int64(len(all) - maxSeries)
+		if count := monitor.registry.GetCounter(seriesTruncatedName).Count(); count != truncated {
+			t.Errorf("expected %d truncated series, got %d", truncated, count)
+		}
+	}
+
+	// Without a cap, all series are returned again.
+	recorder.SetMaxSeries(0)
+	if data := recorder.GetTimeSeriesData(); len(data) != len(all) {
+		t.Errorf("expected %d series, got %d", len(all), len(data))
+	}
+}
+
+// floatMetric is a metric with a constant float value.
+type floatMetric float64
+
+// Each implements metric.Iterable.
+func (f floatMetric) Each(fn func(string, interface{})) { fn("", float64(f)) }
+
+// TestNodeStatusRecorderSignificantFigures verifies that the float values of
+// metrics are rounded to the configured number of significant figures, and
+// that counters are not.
+func TestNodeStatusRecorderSignificantFigures(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	monitor := NewNodeStatusMonitor(metric.NewRegistry())
+	manual := hlc.NewManualClock(100)
+	recorder := NewNodeStatusRecorder(monitor, hlc.NewClock(manual.UnixNano))
+	monitor.registry.MustAdd("test.fraction", floatMetric(1.0/3))
+	monitor.registry.Counter("test.count").Inc(123456789)
+
+	monitor.OnStartNode(&StartNodeEvent{
+		Desc:      roachpb.NodeDescriptor{NodeID: roachpb.NodeID(1)},
+		StartedAt: 50,
+	})
+
+	values := func() (float64, float64) {
+		var fraction, count float64
+		for _, item := range recorder.GetTimeSeriesData() {
+			switch item.Name {
+			case nodeTimeSeriesPrefix + "test.fraction":
+				fraction = item.Datapoints[0].Value
+			case nodeTimeSeriesPrefix + "test.count":
+				count = item.Datapoints[0].Value
+			}
+		}
+		return fraction, count
+	}
+
+	if fraction, _ := values(); fraction != 1.0/3 {
+		t.Errorf("expected unrounded fraction %v, got %v", 1.0/3, fraction)
+	}
+	recorder.SetSignificantFigures(3)
+	if fraction, count := values(); fraction != 0.333 || count != 123456789 {
+		t.Errorf("expected fraction 0.333 and count 123456789, got %v and %v", fraction, count)
+	}
+}
+
+// TestNodeSummaryMarshalRoundTrip verifies that every field of a NodeSummary
+// survives a round trip through its protocol buffer encoding.
+func TestNodeSummaryMarshalRoundTrip(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stats := engine.MVCCStats{
+		LiveBytes:       1,
+		KeyBytes:        2,
+		ValBytes:        3,
+		IntentBytes:     4,
+		LiveCount:       5,
+		KeyCount:        6,
+		ValCount:        7,
+		IntentCount:     8,
+		IntentAge:       9,
+		GCBytesAge:      10,
+		LastUpdateNanos: 1 * 1E9,
+	}
+	nodeDesc := roachpb.NodeDescriptor{
+		NodeID:  roachpb.NodeID(1),
+		Address: util.MakeUnresolvedAddr("tcp", "localhost:26257"),
+		Attrs:   roachpb.Attributes{Attrs: []string{"us-east-1a"}},
+	}
+	makeStoreStatus := func(id int) storage.StoreStatus {
+		return storage.StoreStatus{
+			Desc: roachpb.StoreDescriptor{
+				StoreID: roachpb.StoreID(id),
+				Attrs:   roachpb.Attributes{Attrs: []string{"ssd"}},
+				Node:    nodeDesc,
+				Capacity: roachpb.StoreCapacity{
+					Capacity:  100,
+					Available: 50,
+				},
+			},
+			NodeID:               nodeDesc.NodeID,
+			RangeCount:           int32(id),
+			StartedAt:            60,
+			UpdatedAt:            100,
+			Stats:                stats,
+			LeaderRangeCount:     1,
+			ReplicatedRangeCount: 2,
+			AvailableRangeCount:  3,
+		}
+	}
+	expected := NodeSummary{
+		NodeStatus: NodeStatus{
+			Desc:                 nodeDesc,
+			StoreIDs:             []roachpb.StoreID{1, 2},
+			RangeCount:           3,
+			StartedAt:            50,
+			UpdatedAt:            100,
+			Stats:                stats,
+			LeaderRangeCount:     2,
+			ReplicatedRangeCount: 4,
+			AvailableRangeCount:  6,
+			BinaryVersion:        1,
+			BuildTag:             "tag",
+		},
+		StoreStatuses: []storage.StoreStatus{makeStoreStatus(1), makeStoreStatus(2)},
+	}
+
+	data, err := expected.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual NodeSummary
+	if err := actual.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("NodeSummary did not survive marshaling; diff:\n %v", pretty.Diff(expected, actual))
+	}
 }
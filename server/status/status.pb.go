@@ -10,6 +10,8 @@
 
 	It has these top-level messages:
 		NodeStatus
+		NodeSummary
+		ClusterVersion
 */
 package status
 
@@ -18,6 +20,7 @@ import fmt "fmt"
 import math "math"
 import cockroach_roachpb "github.com/cockroachdb/cockroach/roachpb"
 import cockroach_storage_engine "github.com/cockroachdb/cockroach/storage/engine"
+import cockroach_storage "github.com/cockroachdb/cockroach/storage"
 
 // skipping weak import gogoproto "github.com/cockroachdb/gogoproto"
 
@@ -42,14 +45,45 @@ type NodeStatus struct {
 	LeaderRangeCount     int32                                              `protobuf:"varint,7,opt,name=leader_range_count" json:"leader_range_count"`
 	ReplicatedRangeCount int32                                              `protobuf:"varint,8,opt,name=replicated_range_count" json:"replicated_range_count"`
 	AvailableRangeCount  int32                                              `protobuf:"varint,9,opt,name=available_range_count" json:"available_range_count"`
+	// binary_version is the version of the on-disk and wire formats used by
+	// the node's binary.
+	BinaryVersion int32 `protobuf:"varint,10,opt,name=binary_version" json:"binary_version"`
+	// build_tag is the build tag of the node's binary.
+	BuildTag string `protobuf:"bytes,11,opt,name=build_tag" json:"build_tag"`
 }
 
 func (m *NodeStatus) Reset()         { *m = NodeStatus{} }
 func (m *NodeStatus) String() string { return proto.CompactTextString(m) }
 func (*NodeStatus) ProtoMessage()    {}
 
+// NodeSummary contains the status summaries of a node and each of its stores,
+// allowing them to be collected from the node as a single message.
+type NodeSummary struct {
+	NodeStatus    NodeStatus                      `protobuf:"bytes,1,opt,name=node_status" json:"node_status"`
+	StoreStatuses []cockroach_storage.StoreStatus `protobuf:"bytes,2,rep,name=store_statuses" json:"store_statuses"`
+}
+
+func (m *NodeSummary) Reset()         { *m = NodeSummary{} }
+func (m *NodeSummary) String() string { return proto.CompactTextString(m) }
+func (*NodeSummary) ProtoMessage()    {}
+
+// ClusterVersion is recorded when a cluster is bootstrapped. Nodes verify
+// that their binary supports it before joining the cluster.
+type ClusterVersion struct {
+	// version is the version of the on-disk and wire formats of the cluster.
+	Version int32 `protobuf:"varint,1,opt,name=version" json:"version"`
+	// binary_tag is the build tag of the binary which bootstrapped the cluster.
+	BinaryTag string `protobuf:"bytes,2,opt,name=binary_tag" json:"binary_tag"`
+}
+
+func (m *ClusterVersion) Reset()         { *m = ClusterVersion{} }
+func (m *ClusterVersion) String() string { return proto.CompactTextString(m) }
+func (*ClusterVersion) ProtoMessage()    {}
+
 func init() {
 	proto.RegisterType((*NodeStatus)(nil), "cockroach.server.status.NodeStatus")
+	proto.RegisterType((*NodeSummary)(nil), "cockroach.server.status.NodeSummary")
+	proto.RegisterType((*ClusterVersion)(nil), "cockroach.server.status.ClusterVersion")
 }
 func (m *NodeStatus) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -107,6 +141,76 @@ func (m *NodeStatus) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x48
 	i++
 	i = encodeVarintStatus(data, i, uint64(m.AvailableRangeCount))
+	data[i] = 0x50
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.BinaryVersion))
+	data[i] = 0x5a
+	i++
+	i = encodeVarintStatus(data, i, uint64(len(m.BuildTag)))
+	i += copy(data[i:], m.BuildTag)
+	return i, nil
+}
+
+func (m *NodeSummary) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeSummary) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.NodeStatus.Size()))
+	n3, err := m.NodeStatus.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.StoreStatuses) > 0 {
+		for _, msg := range m.StoreStatuses {
+			data[i] = 0x12
+			i++
+			i = encodeVarintStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ClusterVersion) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ClusterVersion) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Version))
+	data[i] = 0x12
+	i++
+	i = encodeVarintStatus(data, i, uint64(len(m.BinaryTag)))
+	i += copy(data[i:], m.BinaryTag)
 	return i, nil
 }
 
@@ -155,6 +259,32 @@ func (m *NodeStatus) Size() (n int) {
 	n += 1 + sovStatus(uint64(m.LeaderRangeCount))
 	n += 1 + sovStatus(uint64(m.ReplicatedRangeCount))
 	n += 1 + sovStatus(uint64(m.AvailableRangeCount))
+	n += 1 + sovStatus(uint64(m.BinaryVersion))
+	l = len(m.BuildTag)
+	n += 1 + l + sovStatus(uint64(l))
+	return n
+}
+
+func (m *NodeSummary) Size() (n int) {
+	var l int
+	_ = l
+	l = m.NodeStatus.Size()
+	n += 1 + l + sovStatus(uint64(l))
+	if len(m.StoreStatuses) > 0 {
+		for _, e := range m.StoreStatuses {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterVersion) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStatus(uint64(m.Version))
+	l = len(m.BinaryTag)
+	n += 1 + l + sovStatus(uint64(l))
 	return n
 }
 
@@ -394,6 +524,263 @@ func (m *NodeStatus) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryVersion", wireType)
+			}
+			m.BinaryVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BinaryVersion |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildTag = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSummary) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NodeStatus.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreStatuses = append(m.StoreStatuses, cockroach_storage.StoreStatus{})
+			if err := m.StoreStatuses[len(m.StoreStatuses)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterVersion) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryTag = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(data[iNdEx:])
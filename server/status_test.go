@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"runtime"
@@ -40,6 +42,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/retry"
+	"github.com/julienschmidt/httprouter"
 )
 
 // TestStatusLocalStacks verifies that goroutine stack traces are available
@@ -453,6 +456,145 @@ func TestStatusLocalLogs(t *testing.T) {
 	}
 }
 
+// TestStatusNodeLogFiles verifies the nodes/local/logfiles,
+// nodes/local/logfiles/{filename} and nodes/local/logs endpoints.
+func TestStatusNodeLogFiles(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir, err := ioutil.TempDir("", "node_log_files_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.EnableLogFileOutput(dir)
+	defer func() {
+		log.DisableLogFileOutput()
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	ts := StartTestServer(t)
+	defer ts.Stop()
+
+	log.Infof("TestStatusNodeLogFiles test message-Info")
+	log.Warningf("TestStatusNodeLogFiles test message-Warning")
+	log.Flush()
+
+	var logs struct {
+		Data []log.FileInfo `json:"d"`
+	}
+	if err := json.Unmarshal(getRequest(t, *ts, "/_status/nodes/local/logfiles"), &logs); err != nil {
+		t.Fatal(err)
+	}
+	var infoFile string
+	for _, file := range logs.Data {
+		if file.SizeBytes == 0 || file.ModTimeNanos == 0 {
+			t.Errorf("expected size and modification time of %s to be set: %+v", file.Name, file)
+		}
+		if strings.Contains(file.Name, "log.INFO") {
+			infoFile = file.Name
+		}
+	}
+	if infoFile == "" {
+		t.Fatalf("expected an INFO log file, got %+v", logs.Data)
+	}
+
+	httpClient, err := testContext.GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileURL := testContext.HTTPRequestScheme() + "://" + ts.ServingAddr() + "/_status/nodes/local/logfiles/" + infoFile
+	get := func(url, byteRange string) (int, []byte) {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, body
+	}
+
+	// The raw file is streamed as written to the log directory. The file may
+	// keep growing, so only compare the returned prefix.
+	code, body := get(fileURL, "")
+	if code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d: %s", http.StatusOK, code, body)
+	}
+	onDisk, err := ioutil.ReadFile(filepath.Join(dir, infoFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(body) == 0 || !bytes.HasPrefix(onDisk, body) {
+		t.Errorf("expected streamed log file to match the file on disk")
+	}
+	var found bool
+	decoder := log.NewEntryDecoder(bytes.NewReader(body))
+	for entry := (log.LogEntry{}); decoder.Decode(&entry) == nil; {
+		if entry.Format == "TestStatusNodeLogFiles test message-Info" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected to find test message in streamed log file")
+	}
+
+	// Byte ranges are supported.
+	code, body = get(fileURL, "bytes=0-9")
+	if code != http.StatusPartialContent {
+		t.Fatalf("expected status code %d, got %d: %s", http.StatusPartialContent, code, body)
+	}
+	if !bytes.Equal(body, onDisk[:10]) {
+		t.Errorf("expected first 10 bytes %q, got %q", onDisk[:10], body)
+	}
+
+	// The tail mode returns the last entries of the file.
+	var tail struct {
+		Data []log.LogEntry `json:"d"`
+	}
+	if err := json.Unmarshal(getRequest(t, *ts, "/_status/nodes/local/logfiles/"+infoFile+"?tail=2"), &tail); err != nil {
+		t.Fatal(err)
+	}
+	if a, e := len(tail.Data), 2; a != e {
+		t.Fatalf("expected %d entries, got %d: %+v", e, a, tail.Data)
+	}
+	if tail.Data[0].Time > tail.Data[1].Time {
+		t.Errorf("expected tail entries in chronological order: %+v", tail.Data)
+	}
+
+	// Path traversal is rejected.
+	for _, name := range []string{"..", "../" + filepath.Base(dir) + "/" + infoFile, "/etc/passwd"} {
+		req, err := http.NewRequest("GET", "/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		ts.status.handleRawLogFileLocal(w, req, httprouter.Params{{Key: "file", Value: name}})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", name, http.StatusBadRequest, w.Code)
+		}
+	}
+
+	// Recent entries are filtered by severity and pattern.
+	var recent struct {
+		Data []log.LogEntry `json:"d"`
+	}
+	if err := json.Unmarshal(getRequest(t, *ts, "/_status/nodes/local/logs?level=WARNING&pattern=TestStatusNodeLogFiles"), &recent); err != nil {
+		t.Fatal(err)
+	}
+	if len(recent.Data) != 1 || recent.Data[0].Format != "TestStatusNodeLogFiles test message-Warning" {
+		t.Errorf("expected only the warning test message, got %+v", recent.Data)
+	}
+}
+
 // TestNodeStatusResponse verifies that node status returns the expected
 // results.
 func TestNodeStatusResponse(t *testing.T) {
@@ -544,6 +686,36 @@ func TestStoreStatusResponse(t *testing.T) {
 	}
 }
 
+// TestStatusTopology verifies that the topology endpoint returns the single
+// node of the test server along with its stores.
+func TestStatusTopology(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ts := startServer(t)
+	defer ts.Stop()
+
+	nodeID := ts.Gossip().GetNodeID()
+	// Stores are gossiped asynchronously after the node starts.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		var topology TopologyResponse
+		if err := json.Unmarshal(getRequest(t, ts, statusTopologyPattern), &topology); err != nil {
+			t.Fatal(err)
+		}
+		nodes := topology.Nodes
+		if len(nodes) != 1 || nodes[0].Desc.NodeID != nodeID {
+			return util.Errorf("expected node %d, got %+v", nodeID, nodes)
+		}
+		if a, e := len(nodes[0].Stores), ts.node.stores.GetStoreCount(); a != e {
+			return util.Errorf("expected %d stores, got %d", e, a)
+		}
+		for _, store := range nodes[0].Stores {
+			if store.Node.NodeID != nodeID {
+				t.Errorf("expected store %d on node %d, got %d", store.StoreID, nodeID, store.Node.NodeID)
+			}
+		}
+		return nil
+	})
+}
+
 // TestMetricsRecording verifies that Node statistics are periodically recorded
 // as time series data.
 func TestMetricsRecording(t *testing.T) {
@@ -18,6 +18,8 @@ package server
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -34,6 +36,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
@@ -46,6 +49,9 @@ const (
 	// occur on a freshly started server.
 	// Note: this needs to be fairly high or tests become flaky.
 	initialSplitsTimeout = 10 * time.Second
+	// systemConfigTimeout is the amount of time to wait for a SystemConfig
+	// satisfying a condition to be delivered by gossip.
+	systemConfigTimeout = 10 * time.Second
 )
 
 // StartTestServer starts a in-memory test server.
@@ -73,7 +79,7 @@ func NewTestContext() *Context {
 	ctx.MaxOffset = 50 * time.Millisecond
 
 	// Load test certs. In addition, the tests requiring certs
-	// need to call security.SetReadFileFn(securitytest.Asset)
+	// need to call security.PushCertSource(securitytest.Asset)
 	// in their init to mock out the file system calls for calls to AssetFS,
 	// which has the test certs compiled in. Typically this is done
 	// once per package, in main_test.go.
@@ -89,6 +95,21 @@ func NewTestContext() *Context {
 	return ctx
 }
 
+// defaultTestStoreSize is the size of the in-memory stores of a TestServer
+// for which no size is specified.
+const defaultTestStoreSize = 100 << 20
+
+// A StoreSpec describes a store of a TestServer.
+type StoreSpec struct {
+	// OnDisk selects a RocksDB engine in a temporary directory, which is
+	// removed when the server is stopped. Otherwise the store is in-memory.
+	OnDisk bool
+	// SizeInBytes is the size of an in-memory store. Defaults to 100MB.
+	SizeInBytes int64
+	// Attrs are the attributes of the store.
+	Attrs roachpb.Attributes
+}
+
 // A TestServer encapsulates an in-memory instantiation of a cockroach
 // node with a single store. Example usage of a TestServer follows:
 //
@@ -101,7 +122,14 @@ type TestServer struct {
 	SkipBootstrap bool
 	// server is the embedded Cockroach server struct.
 	*Server
+	// StoresPerNode is the number of in-memory stores of the server, used if
+	// StoreSpecs is not specified.
 	StoresPerNode int
+	// StoreSpecs describe the stores of the server. Engines already present in
+	// Ctx.Engines take the place of the first specs.
+	StoreSpecs []StoreSpec
+	// tempDirs are the directories of the server's on-disk stores.
+	tempDirs []string
 }
 
 // Stopper returns the embedded server's Stopper.
@@ -125,6 +153,14 @@ func (ts *TestServer) Clock() *hlc.Clock {
 	return nil
 }
 
+// AdvanceClock advances the server's manual clock by the given duration and
+// wakes the components which wait for physical time to pass. The server must
+// have been started with Ctx.ManualClock set.
+func (ts *TestServer) AdvanceClock(d time.Duration) {
+	ts.Ctx.ManualClock.Increment(d.Nanoseconds())
+	ts.storePool.Wake()
+}
+
 // RPCContext returns the rpc context used by the TestServer.
 func (ts *TestServer) RPCContext() *rpc.Context {
 	if ts != nil {
@@ -149,6 +185,55 @@ func (ts *TestServer) DB() *client.DB {
 	return nil
 }
 
+// LeaseManager returns the sql.LeaseManager used by the TestServer.
+func (ts *TestServer) LeaseManager() *sql.LeaseManager {
+	if ts != nil {
+		return ts.leaseMgr
+	}
+	return nil
+}
+
+// MetaRegistry returns the registry of node-level metrics used by the
+// TestServer.
+func (ts *TestServer) MetaRegistry() *metric.Registry {
+	if ts != nil {
+		return ts.metaRegistry
+	}
+	return nil
+}
+
+// Settings returns the cluster settings as seen by this TestServer's node.
+func (ts *TestServer) Settings() *config.Settings {
+	if ts != nil {
+		return ts.settings
+	}
+	return nil
+}
+
+// Stores returns the collection of stores from this TestServer's node.
+func (ts *TestServer) Stores() *storage.Stores {
+	if ts != nil {
+		return ts.node.stores
+	}
+	return nil
+}
+
+// GetStore returns the store with the given ID from this TestServer's node.
+func (ts *TestServer) GetStore(storeID roachpb.StoreID) (*storage.Store, error) {
+	store, pErr := ts.node.stores.GetStore(storeID)
+	return store, pErr.GoError()
+}
+
+// GetEngine returns the engine of the store with the given ID from this
+// TestServer's node.
+func (ts *TestServer) GetEngine(storeID roachpb.StoreID) (engine.Engine, error) {
+	store, err := ts.GetStore(storeID)
+	if err != nil {
+		return nil, err
+	}
+	return store.Engine(), nil
+}
+
 // EventFeed returns the event feed that the server uses to publish events.
 func (ts *TestServer) EventFeed() *util.Feed {
 	if ts != nil {
@@ -187,13 +272,21 @@ func (ts *TestServer) StartWithStopper(stopper *stop.Stopper) error {
 		return err
 	}
 
-	// Ensure we have the correct number of engines. Add in in-memory ones where
-	// needed.  There must be at least one store/engine.
-	if ts.StoresPerNode < 1 {
-		ts.StoresPerNode = 1
+	// Ensure we have the correct number of engines. Add in the specified ones
+	// where needed, defaulting to in-memory ones. There must be at least one
+	// store/engine.
+	if len(ts.StoreSpecs) == 0 {
+		if ts.StoresPerNode < 1 {
+			ts.StoresPerNode = 1
+		}
+		ts.StoreSpecs = make([]StoreSpec, ts.StoresPerNode)
 	}
-	for i := len(ts.Ctx.Engines); i < ts.StoresPerNode; i++ {
-		ts.Ctx.Engines = append(ts.Ctx.Engines, engine.NewInMem(roachpb.Attributes{}, 100<<20, ts.Server.stopper))
+	for i := len(ts.Ctx.Engines); i < len(ts.StoreSpecs); i++ {
+		eng, err := ts.newEngine(ts.StoreSpecs[i])
+		if err != nil {
+			return err
+		}
+		ts.Ctx.Engines = append(ts.Ctx.Engines, eng)
 	}
 
 	if !ts.SkipBootstrap {
@@ -204,7 +297,9 @@ func (ts *TestServer) StartWithStopper(stopper *stop.Stopper) error {
 		}
 		stopper.Stop()
 	}
-	if err := ts.Server.Start(true); err != nil {
+	// A server with no gossip bootstrap addresses is the cluster's first node
+	// and must be its own gossip bootstrap host.
+	if err := ts.Server.Start(len(ts.Ctx.GossipBootstrapResolvers) == 0); err != nil {
 		return err
 	}
 
@@ -222,6 +317,35 @@ func (ts *TestServer) StartWithStopper(stopper *stop.Stopper) error {
 	return nil
 }
 
+// newEngine creates an engine as described by spec which is closed when the
+// server is stopped.
+func (ts *TestServer) newEngine(spec StoreSpec) (engine.Engine, error) {
+	if !spec.OnDisk {
+		size := spec.SizeInBytes
+		if size == 0 {
+			size = defaultTestStoreSize
+		}
+		return engine.NewInMem(spec.Attrs, size, ts.Server.stopper), nil
+	}
+	dir, err := ioutil.TempDir("", "test_server_store")
+	if err != nil {
+		return nil, err
+	}
+	ts.tempDirs = append(ts.tempDirs, dir)
+	eng := engine.NewRocksDB(spec.Attrs, dir, ts.Ctx.CacheSize, ts.Ctx.MemtableBudget, ts.Server.stopper)
+	// Open the engine now so that it is closed before its directory is
+	// removed; the stopper runs its closers in order.
+	if err := eng.Open(); err != nil {
+		return nil, err
+	}
+	ts.Server.stopper.AddCloser(stop.CloserFn(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warningf("could not remove store directory %s: %s", dir, err)
+		}
+	}))
+	return eng, nil
+}
+
 // ExpectedInitialRangeCount returns the expected number of ranges that should
 // be on the server after initial (asynchronous) splits have been completed,
 // assuming no additional information is added outside of the normal bootstrap
@@ -238,29 +362,98 @@ func (ts *TestServer) OpenDBClient(user string) (*client.DB, error) {
 	return client.Open(ts.Stopper(), connString)
 }
 
+// expectedInitialSplitKeys returns the keys at which the server is expected
+// to split its ranges after startup: the table prefix of each system table
+// outside of the system config span.
+func expectedInitialSplitKeys() []roachpb.RKey {
+	var splitKeys []roachpb.RKey
+	for i := 0; i < ExpectedInitialRangeCount()-1; i++ {
+		id := uint32(keys.MaxSystemConfigDescID + 1 + i)
+		splitKeys = append(splitKeys, roachpb.RKey(keys.MakeNonColumnKey(keys.MakeTablePrefix(id))))
+	}
+	return splitKeys
+}
+
 // WaitForInitialSplits waits for the server to complete its expected initial
-// splits at startup. If the expected range count is not reached within a
-// configured timeout, an error is returned.
+// splits at startup. If the expected splits are not found within a configured
+// timeout, an error listing the missing split keys is returned.
 func (ts *TestServer) WaitForInitialSplits() error {
 	kvDB, err := ts.OpenDBClient(security.NodeUser)
 	if err != nil {
 		return err
 	}
 
-	expectedRanges := ExpectedInitialRangeCount()
-	return util.RetryForDuration(initialSplitsTimeout, func() error {
-		// Scan all keys in the Meta2Prefix; we only need a count.
+	splitKeys := expectedInitialSplitKeys()
+	return ts.retryUntil(initialSplitsTimeout, func() error {
+		// Scan all keys in the Meta2Prefix to find the start key of each range.
 		rows, pErr := kvDB.Scan(keys.Meta2Prefix, keys.MetaMax, 0)
 		if pErr != nil {
 			return pErr.GoError()
 		}
-		if a, e := len(rows), expectedRanges; a != e {
-			return util.Errorf("had %d ranges at startup, expected %d", a, e)
+		startKeys := make(map[string]struct{}, len(rows))
+		for _, row := range rows {
+			var desc roachpb.RangeDescriptor
+			if err := row.ValueProto(&desc); err != nil {
+				return err
+			}
+			startKeys[string(desc.StartKey)] = struct{}{}
+		}
+		var missing []string
+		for _, key := range splitKeys {
+			if _, ok := startKeys[string(key)]; !ok {
+				missing = append(missing, key.String())
+			}
+		}
+		if a, e := len(rows), len(splitKeys)+1; a != e || len(missing) > 0 {
+			return util.Errorf("had %d ranges at startup, expected %d; missing splits: %v", a, e, missing)
 		}
 		return nil
 	})
 }
 
+// WaitForSystemConfig waits for gossip to deliver a SystemConfig for which
+// cond returns true and returns it. If no such SystemConfig is received within
+// a configured timeout, an error is returned.
+func (ts *TestServer) WaitForSystemConfig(cond func(*config.SystemConfig) bool) (*config.SystemConfig, error) {
+	var cfg *config.SystemConfig
+	err := ts.retryUntil(systemConfigTimeout, func() error {
+		if cfg = ts.Gossip().GetSystemConfig(); cfg == nil {
+			return util.Errorf("system config not yet available")
+		}
+		if !cond(cfg) {
+			return util.Errorf("system config does not satisfy condition")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// retryUntil invokes fn with an exponential backoff until it returns nil, the
+// timeout expires or the server is stopped. In the latter two cases, the last
+// error returned by fn is returned.
+func (ts *TestServer) retryUntil(timeout time.Duration, fn func() error) error {
+	opts := retry.Options{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     100 * time.Millisecond,
+		Multiplier:     2,
+		Closer:         ts.Server.stopper.ShouldStop(),
+	}
+	deadline := time.Now().Add(timeout)
+	var err error
+	for r := retry.Start(opts); r.Next(); {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return util.Errorf("condition not met within %s: %s", timeout, err)
+		}
+	}
+	return util.Errorf("server stopped before condition was met: %v", err)
+}
+
 // ServingAddr returns the rpc server's address. Should be used by clients.
 func (ts *TestServer) ServingAddr() string {
 	return ts.listener.Addr().String()
@@ -0,0 +1,230 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// startTwoStoreServer starts a TestServer with an in-memory store and an
+// on-disk store.
+func startTwoStoreServer(t *testing.T) *TestServer {
+	ts := &TestServer{
+		StoreSpecs: []StoreSpec{
+			{SizeInBytes: 50 << 20, Attrs: roachpb.Attributes{Attrs: []string{"mem"}}},
+			{OnDisk: true, Attrs: roachpb.Attributes{Attrs: []string{"ssd"}}},
+		},
+	}
+	if err := ts.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+// TestServerStoreSpecs verifies that a TestServer creates the engines
+// described by its store specs and removes the directories of its on-disk
+// stores when stopped.
+func TestServerStoreSpecs(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ts := startTwoStoreServer(t)
+	stopped := false
+	defer func() {
+		if !stopped {
+			ts.Stop()
+		}
+	}()
+
+	if a, e := ts.Stores().GetStoreCount(), 2; a != e {
+		t.Fatalf("expected %d stores, got %d", e, a)
+	}
+	memEngine, err := ts.GetEngine(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := memEngine.(engine.InMem); !ok {
+		t.Errorf("expected store 1 to be in-memory, got %T", memEngine)
+	}
+	diskEngine, err := ts.GetEngine(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := diskEngine.(*engine.RocksDB); !ok {
+		t.Errorf("expected store 2 to be on disk, got %T", diskEngine)
+	}
+	for i, e := range []string{"mem", "ssd"} {
+		store, err := ts.GetStore(roachpb.StoreID(i + 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a := store.Attrs().Attrs; !reflect.DeepEqual(a, []string{e}) {
+			t.Errorf("expected store %d to have attributes [%s], got %s", i+1, e, a)
+		}
+	}
+	if _, err := ts.GetStore(3); err == nil {
+		t.Errorf("expected error fetching nonexistent store")
+	}
+
+	if len(ts.tempDirs) != 1 {
+		t.Fatalf("expected a single store directory, got %s", ts.tempDirs)
+	}
+	dir := ts.tempDirs[0]
+	if _, err := os.Stat(dir); err != nil {
+		t.Fatal(err)
+	}
+	ts.Stop()
+	stopped = true
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("expected store directory %s to be removed, got %v", dir, err)
+	}
+}
+
+// TestServerMultiStoreStatus verifies that the status recorder of a server
+// with two stores summarizes both of them.
+func TestServerMultiStoreStatus(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ts := startTwoStoreServer(t)
+	defer ts.Stop()
+
+	if err := ts.node.publishStoreStatuses(); err != nil {
+		t.Fatal(err)
+	}
+	ts.EventFeed().Flush()
+
+	summary := ts.recorder.GetNodeSummary()
+	if summary == nil {
+		t.Fatal("expected node summary")
+	}
+	storeIDs := append([]roachpb.StoreID(nil), summary.NodeStatus.StoreIDs...)
+	sort.Sort(roachpb.StoreIDSlice(storeIDs))
+	if a, e := storeIDs, []roachpb.StoreID{1, 2}; !reflect.DeepEqual(a, e) {
+		t.Errorf("expected store IDs %v, got %v", e, a)
+	}
+	if a, e := len(summary.StoreStatuses), 2; a != e {
+		t.Fatalf("expected %d store statuses, got %d", e, a)
+	}
+	rangeCounts := map[roachpb.StoreID]int32{}
+	for _, ss := range summary.StoreStatuses {
+		eng, err := ts.GetEngine(ss.Desc.StoreID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a, e := ss.Desc.Attrs, eng.Attrs(); !reflect.DeepEqual(a, e) {
+			t.Errorf("store %d: expected attributes %s, got %s", ss.Desc.StoreID, e, a)
+		}
+		rangeCounts[ss.Desc.StoreID] = ss.RangeCount
+	}
+	// All ranges are bootstrapped on the first store.
+	if a, e := rangeCounts, map[roachpb.StoreID]int32{1: summary.NodeStatus.RangeCount, 2: 0}; !reflect.DeepEqual(a, e) {
+		t.Errorf("expected range counts by store %v, got %v", e, a)
+	}
+}
+
+// TestServerMultiStoreAllocator verifies that the allocator considers the
+// stores of a multi-store node as targets for new ranges, but never places two
+// replicas of a range on the same node.
+func TestServerMultiStoreAllocator(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ts := startTwoStoreServer(t)
+	defer ts.Stop()
+
+	a := storage.MakeAllocator(ts.storePool, storage.AllocatorOptions{
+		Mode: storage.BalanceModeRangeCount,
+	})
+
+	// Once both stores have been gossiped, the empty second store is the best
+	// target for a new range.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		target, err := a.AllocateTarget(roachpb.Attributes{}, nil, false, nil)
+		if err != nil {
+			return err
+		}
+		if target.StoreID != 2 {
+			return util.Errorf("expected store 2 as target, got store %d", target.StoreID)
+		}
+		return nil
+	})
+
+	// Attributes select the matching local store.
+	target, err := a.AllocateTarget(roachpb.Attributes{Attrs: []string{"mem"}}, nil, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.StoreID != 1 {
+		t.Errorf("expected store 1 as target for attributes [mem], got store %d", target.StoreID)
+	}
+
+	// The second store is on the same node as an existing replica.
+	existing := []roachpb.ReplicaDescriptor{{NodeID: ts.node.Descriptor.NodeID, StoreID: 1}}
+	if target, err := a.AllocateTarget(roachpb.Attributes{}, existing, true, nil); err == nil {
+		t.Errorf("expected no target for a range replicated on the only node, got store %d", target.StoreID)
+	}
+}
+
+// TestServerManualClock verifies that a server started with a manual clock
+// uses it for leader leases, so that advancing the clock expires them.
+func TestServerManualClock(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := NewTestContext()
+	ctx.ManualClock = hlc.NewManualClock(time.Now().UnixNano())
+	ts := &TestServer{Ctx: ctx}
+	if err := ts.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Stop()
+
+	store, pErr := ts.Stores().GetStore(roachpb.StoreID(1))
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	rng := store.LookupReplica(roachpb.RKeyMin, nil)
+	if _, err := ts.db.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	lease := rng.GetLeaderLease()
+	if lease == nil || !lease.Covers(ts.Clock().Now()) {
+		t.Fatalf("expected a current lease, got %s", lease)
+	}
+
+	// The lease does not expire while the manual clock stands still.
+	time.Sleep(2 * storage.DefaultLeaderLeaseDuration)
+	if !lease.Covers(ts.Clock().Now()) {
+		t.Fatalf("expected lease %s to be current at %s", lease, ts.Clock().Now())
+	}
+
+	ts.AdvanceClock(lease.Expiration.GoTime().Sub(ts.Clock().Now().GoTime()) + time.Nanosecond)
+	if lease.Covers(ts.Clock().Now()) {
+		t.Fatalf("expected lease %s to have expired at %s", lease, ts.Clock().Now())
+	}
+
+	// The next request acquires a new lease as of the manual clock's time.
+	if _, err := ts.db.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	newLease := rng.GetLeaderLease()
+	if !newLease.Covers(ts.Clock().Now()) || !lease.Expiration.Less(newLease.Expiration) {
+		t.Errorf("expected a new lease after %s, got %s", lease, newLease)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/julienschmidt/httprouter"
+)
+
+// NodeTopology describes a node of the cluster along with its stores. The
+// locality of the node and of its stores is described by their attributes.
+type NodeTopology struct {
+	Desc   roachpb.NodeDescriptor    `json:"desc"`
+	Stores []roachpb.StoreDescriptor `json:"stores"`
+}
+
+// TopologyResponse is the response of the topology endpoint.
+type TopologyResponse struct {
+	// Nodes are the nodes known to gossip, in ascending order of node ID.
+	Nodes []NodeTopology `json:"nodes"`
+}
+
+// handleTopology handles GET requests for the nodes of the cluster and their
+// stores, as currently known to this node's gossip instance. Stores whose
+// gossiped descriptors have expired are omitted, as are stores whose node
+// descriptor hasn't been received yet.
+func (s *statusServer) handleTopology(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	storeDescs, err := s.gossip.GetStoreDescriptors()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := TopologyResponse{Nodes: []NodeTopology{}}
+	for _, nodeID := range s.gossip.NodeIDs() {
+		nodeDesc, err := s.gossip.GetNodeDescriptor(nodeID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		node := NodeTopology{Desc: *nodeDesc, Stores: []roachpb.StoreDescriptor{}}
+		for _, storeDesc := range storeDescs {
+			if storeDesc.Node.NodeID == nodeID {
+				node.Stores = append(node.Stores, storeDesc)
+			}
+		}
+		resp.Nodes = append(resp.Nodes, node)
+	}
+	respondAsJSON(w, r, resp)
+}
@@ -0,0 +1,346 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	yaml "gopkg.in/yaml.v1"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// DefaultZoneName is the name of the default zone config, which applies to
+// all objects without a zone config of their own. It is stored as the zone
+// config of the root namespace.
+const DefaultZoneName = ".default"
+
+// zoneConfigFields are the fields of the YAML representation of a zone
+// config.
+var zoneConfigFields = map[string]struct{}{
+	"replicas":        {},
+	"range_min_bytes": {},
+	"range_max_bytes": {},
+	"gc":              {},
+}
+
+// ZoneConfigResponse is the response to a request for the zone config of an
+// object.
+type ZoneConfigResponse struct {
+	// Source is the name of the object the zone config was set on. It differs
+	// from the requested object if the zone config is inherited.
+	Source string            `json:"source"`
+	Zone   config.ZoneConfig `json:"zone"`
+}
+
+// A zoneTarget is an object which can have a zone config.
+type zoneTarget struct {
+	name string
+	id   uint32
+}
+
+// handleZones responds to GET requests on zonesPath with the names of all
+// objects which have a zone config. Requests below zonesPath address the zone
+// config of the object with the name given by the remainder of the path,
+// either "<database>", "<database>.<table>" or DefaultZoneName: GET requests
+// return the zone config which applies to the object along with the name of
+// the object it was set on, POST requests set the object's zone config to
+// the YAML or JSON encoded request body and DELETE requests remove it.
+func (s *adminServer) handleZones(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, zonesPath), "/")
+	if name == "" {
+		if r.Method != "GET" {
+			http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		var names []string
+		if pErr := s.db.Txn(func(txn *client.Txn) *roachpb.Error {
+			var err error
+			names, err = s.listZones(txn)
+			return roachpb.NewError(err)
+		}); pErr != nil {
+			log.Error(pErr)
+			http.Error(w, pErr.GoError().Error(), http.StatusInternalServerError)
+			return
+		}
+		respondAsJSON(w, r, names)
+		return
+	}
+
+	var zone config.ZoneConfig
+	switch r.Method {
+	case "GET", "DELETE":
+	case "POST":
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if zone, err = unmarshalZoneConfig(r, body); err != nil {
+			http.Error(w, fmt.Sprintf("invalid zone config: %s", err), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	if r.Method == "DELETE" && name == DefaultZoneName {
+		http.Error(w, "the default zone config cannot be removed", http.StatusBadRequest)
+		return
+	}
+
+	var resp ZoneConfigResponse
+	if pErr := s.db.Txn(func(txn *client.Txn) *roachpb.Error {
+		targets, err := s.resolveZoneName(txn, name)
+		if err != nil {
+			return roachpb.NewError(err)
+		}
+		switch r.Method {
+		case "GET":
+			resp, err = s.getZone(txn, targets)
+			return roachpb.NewError(err)
+		case "POST":
+			return s.setZone(txn, targets[0].id, zone)
+		default:
+			_, pErr := s.executor.ExecuteStatementInTransaction(txn,
+				`DELETE FROM system.zones WHERE id = $1`, targets[0].id)
+			return pErr
+		}
+	}); pErr != nil {
+		// Names which can not be resolved are reported as bad requests.
+		if _, ok := pErr.GoError().(*zoneNameError); ok {
+			http.Error(w, pErr.GoError().Error(), http.StatusBadRequest)
+			return
+		}
+		log.Error(pErr)
+		http.Error(w, pErr.GoError().Error(), http.StatusInternalServerError)
+		return
+	}
+	if r.Method != "GET" {
+		w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+		fmt.Fprintln(w, "ok")
+		return
+	}
+	respondAsJSON(w, r, resp)
+}
+
+// zoneNameError is returned for object names which can not be resolved.
+type zoneNameError struct {
+	msg string
+}
+
+func (e *zoneNameError) Error() string {
+	return e.msg
+}
+
+// unmarshalZoneConfig decodes and validates the zone config in the body of
+// the request. YAML encoded zone configs may only contain known fields.
+func unmarshalZoneConfig(r *http.Request, body []byte) (config.ZoneConfig, error) {
+	var zone config.ZoneConfig
+	switch util.GetContentType(r) {
+	case util.YAMLContentType, util.AltYAMLContentType:
+		var fields map[string]interface{}
+		if err := yaml.Unmarshal(body, &fields); err != nil {
+			return zone, err
+		}
+		for field := range fields {
+			if _, ok := zoneConfigFields[field]; !ok {
+				return zone, util.Errorf("%s: unknown field", field)
+			}
+		}
+		if err := yaml.Unmarshal(body, &zone); err != nil {
+			return zone, err
+		}
+	default:
+		if err := util.UnmarshalRequest(r, body, &zone, []util.EncodingType{util.JSONEncoding}); err != nil {
+			return zone, err
+		}
+	}
+	return zone, zone.Validate()
+}
+
+// resolveZoneName returns the object with the given name followed by the
+// objects it inherits its zone config from, most specific first: a table
+// inherits from its database and all objects from the default zone config.
+func (s *adminServer) resolveZoneName(txn *client.Txn, name string) ([]zoneTarget, error) {
+	targets := []zoneTarget{{name: DefaultZoneName, id: keys.RootNamespaceID}}
+	if name == DefaultZoneName {
+		return targets, nil
+	}
+	dbName, tableName := name, ""
+	if i := strings.Index(name, "."); i >= 0 {
+		dbName, tableName = name[:i], name[i+1:]
+		if tableName == "" {
+			return nil, &zoneNameError{fmt.Sprintf("invalid object name %q", name)}
+		}
+	}
+	if dbName == "" {
+		return nil, &zoneNameError{fmt.Sprintf("invalid object name %q", name)}
+	}
+
+	dbID, err := s.lookupNamespaceID(txn, keys.RootNamespaceID, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if dbID == 0 {
+		return nil, &zoneNameError{fmt.Sprintf("database %q does not exist", dbName)}
+	}
+	targets = append([]zoneTarget{{name: dbName, id: dbID}}, targets...)
+	if tableName == "" {
+		return targets, nil
+	}
+
+	tableID, err := s.lookupNamespaceID(txn, dbID, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if tableID == 0 {
+		return nil, &zoneNameError{fmt.Sprintf("table %q does not exist", name)}
+	}
+	return append([]zoneTarget{{name: name, id: tableID}}, targets...), nil
+}
+
+// lookupNamespaceID returns the ID of the object with the given name and
+// parent, or 0 if there is none.
+func (s *adminServer) lookupNamespaceID(txn *client.Txn, parentID uint32, name string) (uint32, error) {
+	rows, pErr := s.executor.QueryRowsInTransaction(txn,
+		`SELECT id FROM system.namespace WHERE parentID = $1 AND name = $2`, parentID, name)
+	if pErr != nil {
+		return 0, pErr.GoError()
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	id, ok := rows[0][0].(parser.DInt)
+	if !ok {
+		return 0, util.Errorf("namespace entry for %q has unexpected ID %s", name, rows[0][0])
+	}
+	return uint32(id), nil
+}
+
+// getZone returns the zone config of the first of the targets which has
+// one, falling back to the built-in default zone config.
+func (s *adminServer) getZone(txn *client.Txn, targets []zoneTarget) (ZoneConfigResponse, error) {
+	for _, target := range targets {
+		rows, pErr := s.executor.QueryRowsInTransaction(txn,
+			`SELECT config FROM system.zones WHERE id = $1`, target.id)
+		if pErr != nil {
+			return ZoneConfigResponse{}, pErr.GoError()
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		resp := ZoneConfigResponse{Source: target.name}
+		if err := unmarshalZoneRow(rows[0][0], &resp.Zone); err != nil {
+			return ZoneConfigResponse{}, err
+		}
+		return resp, nil
+	}
+	return ZoneConfigResponse{Source: DefaultZoneName, Zone: *config.DefaultZoneConfig}, nil
+}
+
+// setZone replaces the zone config of the object with the given ID.
+func (s *adminServer) setZone(txn *client.Txn, id uint32, zone config.ZoneConfig) *roachpb.Error {
+	buf, err := proto.Marshal(&zone)
+	if err != nil {
+		return roachpb.NewError(err)
+	}
+	// There is no UPSERT, so any existing zone config is deleted first.
+	if _, pErr := s.executor.ExecuteStatementInTransaction(txn,
+		`DELETE FROM system.zones WHERE id = $1`, id); pErr != nil {
+		return pErr
+	}
+	_, pErr := s.executor.ExecuteStatementInTransaction(txn,
+		`INSERT INTO system.zones VALUES ($1, $2)`, id, buf)
+	return pErr
+}
+
+// listZones returns the sorted names of all objects which have a zone
+// config.
+func (s *adminServer) listZones(txn *client.Txn) ([]string, error) {
+	rows, pErr := s.executor.QueryRowsInTransaction(txn, `SELECT id FROM system.zones`)
+	if pErr != nil {
+		return nil, pErr.GoError()
+	}
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		id, ok := row[0].(parser.DInt)
+		if !ok {
+			return nil, util.Errorf("zone config has unexpected ID %s", row[0])
+		}
+		name, err := s.zoneName(txn, uint32(id))
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// zoneName returns the name of the object with the given ID in the form
+// accepted by resolveZoneName. Objects which no longer exist are named by
+// their ID.
+func (s *adminServer) zoneName(txn *client.Txn, id uint32) (string, error) {
+	if id == keys.RootNamespaceID {
+		return DefaultZoneName, nil
+	}
+	var name string
+	for {
+		rows, pErr := s.executor.QueryRowsInTransaction(txn,
+			`SELECT parentID, name FROM system.namespace WHERE id = $1`, id)
+		if pErr != nil {
+			return "", pErr.GoError()
+		}
+		if len(rows) == 0 {
+			return strconv.FormatUint(uint64(id), 10), nil
+		}
+		parentID, ok1 := rows[0][0].(parser.DInt)
+		objName, ok2 := rows[0][1].(parser.DString)
+		if !ok1 || !ok2 {
+			return "", util.Errorf("unexpected namespace entry %s for %d", rows[0], id)
+		}
+		if name == "" {
+			name = string(objName)
+		} else {
+			name = string(objName) + "." + name
+		}
+		if parentID == keys.RootNamespaceID {
+			return name, nil
+		}
+		id = uint32(parentID)
+	}
+}
+
+// unmarshalZoneRow decodes the config column of a row of the zones table.
+func unmarshalZoneRow(datum parser.Datum, zone *config.ZoneConfig) error {
+	buf, ok := datum.(parser.DBytes)
+	if !ok {
+		return util.Errorf("zone config has unexpected type %s", datum.Type())
+	}
+	return proto.Unmarshal([]byte(buf), zone)
+}
@@ -26,8 +26,12 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
 
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
+	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/testutils/sqlutils"
 )
 
@@ -316,3 +320,119 @@ func BenchmarkScan100_Cockroach(b *testing.B) {
 func BenchmarkScan100_Postgres(b *testing.B) {
 	benchmarkPostgres(b, runBenchmarkScan100)
 }
+
+// selectLargeCount is the number of rows returned by the large SELECT
+// benchmarks.
+const selectLargeCount = 10000
+
+// createBenchmarkSelectLarge creates a table containing selectLargeCount
+// rows for the large SELECT benchmarks.
+func createBenchmarkSelectLarge(b *testing.B, db *sql.DB) {
+	if _, err := db.Exec(`DROP TABLE IF EXISTS bench.large`); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE bench.large (k INT PRIMARY KEY, v STRING)`); err != nil {
+		b.Fatal(err)
+	}
+	const batchSize = 1000
+	for i := 0; i < selectLargeCount; i += batchSize {
+		var buf bytes.Buffer
+		buf.WriteString(`INSERT INTO bench.large VALUES `)
+		for j := i; j < i+batchSize; j++ {
+			if j > i {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(&buf, "(%d, 'value-%d')", j, j)
+		}
+		if _, err := db.Exec(buf.String()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// runBenchmarkSelectLarge benchmarks reading the rows of a large SELECT
+// through database/sql.
+func runBenchmarkSelectLarge(b *testing.B, db *sql.DB) {
+	createBenchmarkSelectLarge(b, db)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rows, err := db.Query(`SELECT k, v FROM bench.large`)
+		if err != nil {
+			b.Fatal(err)
+		}
+		n := 0
+		for rows.Next() {
+			var k int
+			var v string
+			if err := rows.Scan(&k, &v); err != nil {
+				b.Fatal(err)
+			}
+			n++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			b.Fatal(err)
+		}
+		if n != selectLargeCount {
+			b.Fatalf("unexpected result count: %d != %d", selectLargeCount, n)
+		}
+	}
+	b.StopTimer()
+}
+
+func BenchmarkSelectLarge_Cockroach(b *testing.B) {
+	benchmarkCockroach(b, runBenchmarkSelectLarge)
+}
+
+// BenchmarkSelectLarge_Internal benchmarks streaming the rows of the same
+// SELECT as BenchmarkSelectLarge_Cockroach directly from the sql package,
+// bypassing database/sql and the wire protocol.
+func BenchmarkSelectLarge_Internal(b *testing.B) {
+	s := server.StartTestServer(b)
+	defer s.Stop()
+
+	pgUrl, cleanupFn := sqlutils.PGUrl(b, s, security.RootUser, os.TempDir(), "BenchmarkSelectLarge_Internal")
+	defer cleanupFn()
+
+	db, err := sql.Open("postgres", pgUrl.String())
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE DATABASE IF NOT EXISTS bench`); err != nil {
+		b.Fatal(err)
+	}
+	createBenchmarkSelectLarge(b, db)
+
+	ie := csql.InternalExecutor{LeaseManager: s.LeaseManager()}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var n int
+		if pErr := s.DB().Txn(func(txn *client.Txn) *roachpb.Error {
+			n = 0
+			rows, pErr := ie.QueryInTransaction(txn, `SELECT k, v FROM bench.large`)
+			if pErr != nil {
+				return pErr
+			}
+			for rows.Next() {
+				values := rows.Values()
+				if _, ok := values[0].(parser.DInt); !ok {
+					return roachpb.NewErrorf("unexpected key %s", values[0])
+				}
+				if _, ok := values[1].(parser.DString); !ok {
+					return roachpb.NewErrorf("unexpected value %s", values[1])
+				}
+				n++
+			}
+			return rows.PErr()
+		}); pErr != nil {
+			b.Fatal(pErr)
+		}
+		if n != selectLargeCount {
+			b.Fatalf("unexpected result count: %d != %d", selectLargeCount, n)
+		}
+	}
+	b.StopTimer()
+}
@@ -16,7 +16,10 @@
 
 package sql
 
-import "github.com/cockroachdb/cockroach/config"
+import (
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+)
 
 func init() {
 	// TODO(marc): we use a hook to avoid a dependency on the sql package. We
@@ -51,6 +54,10 @@ func GetZoneConfig(cfg config.SystemConfig, id uint32) (*config.ZoneConfig, erro
 	}
 
 	// No descriptor or not a table. This table/db could have been deleted, just
-	// return the default config.
+	// return the default config, which may have been overridden by a zone
+	// config for the root namespace.
+	if id != keys.RootNamespaceID {
+		return GetZoneConfig(cfg, keys.RootNamespaceID)
+	}
 	return config.DefaultZoneConfig, nil
 }
@@ -19,7 +19,6 @@ package sql_test
 import (
 	"reflect"
 	"testing"
-	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/config"
@@ -27,7 +26,6 @@ import (
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/sql"
-	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/gogo/protobuf/proto"
 )
@@ -62,19 +60,15 @@ func forceNewConfig(t *testing.T, s *server.TestServer) (*config.SystemConfig, e
 
 func waitForConfigChange(t *testing.T, s *server.TestServer) (*config.SystemConfig, error) {
 	var foundDesc sql.Descriptor
-	var cfg *config.SystemConfig
-	return cfg, util.IsTrueWithin(func() bool {
-		if cfg = s.Gossip().GetSystemConfig(); cfg != nil {
-			if val := cfg.GetValue(configDescKey); val != nil {
-				if err := val.GetProto(&foundDesc); err != nil {
-					t.Fatal(err)
-				}
-				return foundDesc.GetDatabase().GetID() == configID
+	return s.WaitForSystemConfig(func(cfg *config.SystemConfig) bool {
+		if val := cfg.GetValue(configDescKey); val != nil {
+			if err := val.GetProto(&foundDesc); err != nil {
+				t.Fatal(err)
 			}
+			return foundDesc.GetDatabase().GetID() == configID
 		}
-
 		return false
-	}, 10*time.Second)
+	})
 }
 
 // TestGetZoneConfig exercises config.GetZoneConfig and the sql hook for it.
@@ -208,6 +202,45 @@ func TestGetZoneConfig(t *testing.T) {
 		{keys.MakeTablePrefix(tb22), *config.DefaultZoneConfig},
 	}
 
+	for tcNum, tc := range testCases {
+		zoneCfg, err := cfg.GetZoneConfigForKey(tc.key)
+		if err != nil {
+			t.Fatalf("#%d: err=%s", tcNum, err)
+		}
+
+		if !reflect.DeepEqual(*zoneCfg, tc.zoneCfg) {
+			t.Errorf("#%d: bad zone config.\nexpected: %+v\ngot: %+v", tcNum, tc.zoneCfg, zoneCfg)
+		}
+	}
+	// A zone config for the root namespace replaces the default zone config
+	// for all objects without a zone config of their own.
+	rootCfg := config.ZoneConfig{ReplicaAttrs: []roachpb.Attributes{{[]string{"root"}}}}
+	buf, err := proto.Marshal(&rootCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sqlDB.Exec(`INSERT INTO system.zones VALUES ($1, $2)`, keys.RootNamespaceID, buf); err != nil {
+		t.Fatalf("problem writing zone %+v: %s", rootCfg, err)
+	}
+
+	cfg, err = forceNewConfig(t, s)
+	if err != nil {
+		t.Fatalf("failed to get latest system config: %s", err)
+	}
+
+	testCases = []struct {
+		key     roachpb.RKey
+		zoneCfg config.ZoneConfig
+	}{
+		{roachpb.RKeyMin, rootCfg},
+		{keys.MakeTablePrefix(1), rootCfg},
+		{keys.MakeTablePrefix(db1), db1Cfg},
+		{keys.MakeTablePrefix(db2), rootCfg},
+		{keys.MakeTablePrefix(tb12), db1Cfg},
+		{keys.MakeTablePrefix(tb21), tb21Cfg},
+		{keys.MakeTablePrefix(tb22), rootCfg},
+	}
+
 	for tcNum, tc := range testCases {
 		zoneCfg, err := cfg.GetZoneConfigForKey(tc.key)
 		if err != nil {
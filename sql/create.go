@@ -41,6 +41,22 @@ func (p *planner) CreateDatabase(n *parser.CreateDatabase) (planNode, *roachpb.E
 	if err := p.createDescriptor(databaseKey{string(n.Name)}, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
+	// A zero ID indicates that IF NOT EXISTS was specified and the database
+	// already existed; nothing was created, so there is nothing to log.
+	if desc.ID != 0 {
+		if pErr := MakeEventLogger(p.leaseMgr).InsertEventRecord(p.txn,
+			EventLogCreateDatabase,
+			int32(desc.ID),
+			int32(p.evalCtx.NodeID),
+			EventLogDatabaseInfo{
+				DatabaseName: string(n.Name),
+				Statement:    n.String(),
+				User:         p.user,
+			},
+		); pErr != nil {
+			return nil, pErr
+		}
+	}
 	return &valuesNode{}, nil
 }
 
@@ -153,5 +169,20 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, *roachpb.Error)
 	if pErr := p.createDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, n.IfNotExists); pErr != nil {
 		return nil, pErr
 	}
+	// createDescriptor leaves the ID unset if the table already existed.
+	if desc.ID != 0 {
+		if pErr := MakeEventLogger(p.leaseMgr).InsertEventRecord(p.txn,
+			EventLogCreateTable,
+			int32(desc.ID),
+			int32(p.evalCtx.NodeID),
+			EventLogTableInfo{
+				TableName: n.Table.String(),
+				Statement: n.String(),
+				User:      p.user,
+			},
+		); pErr != nil {
+			return nil, pErr
+		}
+	}
 	return &valuesNode{}, nil
 }
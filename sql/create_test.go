@@ -18,13 +18,16 @@ package sql_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
 )
 
 func TestDatabaseDescriptor(t *testing.T) {
@@ -135,3 +138,50 @@ func TestDatabaseDescriptor(t *testing.T) {
 		t.Fatal("key is missing")
 	}
 }
+
+// TestDescriptorMetrics verifies that creating tables increases the number
+// and the total size of the schema descriptors reported by the node.
+func TestDescriptorMetrics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	getMetric := func(name string) int64 {
+		var value int64
+		s.MetaRegistry().Each(func(n string, v interface{}) {
+			if g, ok := v.(*metric.Gauge); ok && n == name {
+				value = g.Value()
+			}
+		})
+		return value
+	}
+
+	// The system tables are always present.
+	var count, size int64
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if count, size = getMetric("sql.descriptors.count"), getMetric("sql.descriptors.bytes"); count == 0 || size == 0 {
+			return util.Errorf("no descriptors reported")
+		}
+		return nil
+	})
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.a (k INT PRIMARY KEY);
+CREATE TABLE t.b (k INT PRIMARY KEY);
+CREATE TABLE t.c (k INT PRIMARY KEY);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The database and the three tables have a descriptor each.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if c, e := getMetric("sql.descriptors.count"), count+4; c != e {
+			return util.Errorf("expected %d descriptors, got %d", e, c)
+		}
+		if b := getMetric("sql.descriptors.bytes"); b <= size {
+			return util.Errorf("expected more than %d bytes of descriptors, got %d", size, b)
+		}
+		return nil
+	})
+}
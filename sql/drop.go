@@ -97,6 +97,18 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, *roachpb.Error
 	if pErr := p.txn.Run(b); pErr != nil {
 		return nil, pErr
 	}
+	if pErr := MakeEventLogger(p.leaseMgr).InsertEventRecord(p.txn,
+		EventLogDropDatabase,
+		int32(dbDesc.ID),
+		int32(p.evalCtx.NodeID),
+		EventLogDatabaseInfo{
+			DatabaseName: string(n.Name),
+			Statement:    n.String(),
+			User:         p.user,
+		},
+	); pErr != nil {
+		return nil, pErr
+	}
 	return &valuesNode{}, nil
 }
 
@@ -232,6 +244,18 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, *roachpb.Error) {
 		if pErr := p.txn.Run(b); pErr != nil {
 			return nil, pErr
 		}
+		if pErr := MakeEventLogger(p.leaseMgr).InsertEventRecord(p.txn,
+			EventLogDropTable,
+			int32(tableDesc.ID),
+			int32(p.evalCtx.NodeID),
+			EventLogTableInfo{
+				TableName: tableQualifiedName.String(),
+				Statement: n.String(),
+				User:      p.user,
+			},
+		); pErr != nil {
+			return nil, pErr
+		}
 	}
 	return &valuesNode{}, nil
 }
@@ -0,0 +1,150 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+// EventLogType represents an event type that can be recorded in the event log.
+type EventLogType string
+
+// NOTE: When adding a new event type here, please also add it to the decoding
+// of event info performed by the events admin endpoint and to the types which
+// can be forwarded to the event webhook (server.webhookEventTypes).
+const (
+	// EventLogCreateDatabase is recorded when a database is created.
+	EventLogCreateDatabase EventLogType = "create_database"
+	// EventLogDropDatabase is recorded when a database is dropped.
+	EventLogDropDatabase EventLogType = "drop_database"
+	// EventLogCreateTable is recorded when a table is created.
+	EventLogCreateTable EventLogType = "create_table"
+	// EventLogDropTable is recorded when a table is dropped.
+	EventLogDropTable EventLogType = "drop_table"
+	// EventLogNodeJoin is recorded when a node joins the cluster for the
+	// first time.
+	EventLogNodeJoin EventLogType = "node_join"
+	// EventLogNodeRestart is recorded when an existing node rejoins the
+	// cluster after being offline.
+	EventLogNodeRestart EventLogType = "node_restart"
+)
+
+// eventTableSchema defines the schema of the event log table. It is currently
+// envisioned as a wide table; many different event types can be recorded to
+// the table. The uniqueID column disambiguates events recorded with identical
+// timestamps.
+const eventTableSchema = `
+CREATE TABLE system.eventlog (
+  timestamp    TIMESTAMP  NOT NULL,
+  eventType    STRING     NOT NULL,
+  targetID     INT        NOT NULL,
+  reportingID  INT        NOT NULL,
+  info         STRING,
+  uniqueID     BYTES      DEFAULT experimental_unique_bytes(),
+  PRIMARY KEY (timestamp, uniqueID)
+);`
+
+// EventLogDatabaseInfo is the info recorded for database-level events. It is
+// stored as JSON in the info column of the event log.
+type EventLogDatabaseInfo struct {
+	DatabaseName string
+	Statement    string
+	User         string
+}
+
+// EventLogTableInfo is the info recorded for table-level events. It is stored
+// as JSON in the info column of the event log.
+type EventLogTableInfo struct {
+	TableName string
+	Statement string
+	User      string
+}
+
+// EventLogNodeInfo is the info recorded for node-level events. It is stored as
+// JSON in the info column of the event log.
+type EventLogNodeInfo struct {
+	Descriptor roachpb.NodeDescriptor
+	ClusterID  string
+	StartedAt  int64
+}
+
+// An EventLogger exposes methods used to record events to the event table.
+type EventLogger struct {
+	InternalExecutor
+}
+
+// MakeEventLogger constructs a new EventLogger. A LeaseManager is required in
+// order to correctly execute SQL statements.
+func MakeEventLogger(leaseMgr *LeaseManager) EventLogger {
+	return EventLogger{InternalExecutor{
+		LeaseManager: leaseMgr,
+	}}
+}
+
+// InsertEventRecord inserts a single event into the event log as part of the
+// provided transaction. The info parameter, if non-nil, is marshaled into JSON
+// before being recorded. The event is timestamped with the transaction's
+// timestamp if one has already been assigned.
+func (ev EventLogger) InsertEventRecord(txn *client.Txn, eventType EventLogType, targetID, reportingID int32, info interface{}) *roachpb.Error {
+	const insertEventTableStmt = `
+INSERT INTO system.eventlog (
+  timestamp, eventType, targetID, reportingID, info
+)
+VALUES(
+  $1, $2, $3, $4, $5
+)
+`
+	timestamp := txn.Proto.Timestamp.GoTime()
+	if txn.Proto.Timestamp.Equal(roachpb.ZeroTimestamp) {
+		timestamp = time.Now()
+	}
+	args := []interface{}{
+		timestamp,
+		eventType,
+		targetID,
+		reportingID,
+		nil, // info
+	}
+	if info != nil {
+		infoBytes, err := json.Marshal(info)
+		if err != nil {
+			return roachpb.NewError(err)
+		}
+		args[4] = string(infoBytes)
+	}
+
+	rows, pErr := ev.ExecuteStatementInTransaction(txn, insertEventTableStmt, args...)
+	if pErr != nil {
+		return pErr
+	}
+	if rows != 1 {
+		return roachpb.NewErrorf("%d rows affected by log insertion; expected exactly one row affected.", rows)
+	}
+	return nil
+}
+
+// AddEventLogToMetadataSchema adds the event log table to the supplied
+// MetadataSchema.
+func AddEventLogToMetadataSchema(schema *MetadataSchema) {
+	allPrivileges := NewPrivilegeDescriptor(security.RootUser, privilege.List{privilege.ALL})
+	schema.AddTable(eventTableSchema, allPrivileges)
+}
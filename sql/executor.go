@@ -17,10 +17,12 @@
 package sql
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -30,9 +32,11 @@ import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/retry"
@@ -68,8 +72,14 @@ type Executor struct {
 	nodeID   roachpb.NodeID
 	reCache  *parser.RegexpCache
 	leaseMgr *LeaseManager
+	clock    *hlc.Clock
 
-	latency metric.Histograms
+	latency             metric.Histograms
+	schemaChangeMetrics *SchemaChangeMetrics
+	// descriptorCount and descriptorBytes are the number and the total size
+	// of the schema descriptors in the latest system config.
+	descriptorCount *metric.Gauge
+	descriptorBytes *metric.Gauge
 
 	// System Config and mutex.
 	systemConfig     config.SystemConfig
@@ -79,13 +89,18 @@ type Executor struct {
 
 // NewExecutor creates an Executor and registers a callback on the
 // system config.
-func NewExecutor(db client.DB, gossip *gossip.Gossip, leaseMgr *LeaseManager, metaRegistry *metric.Registry, stopper *stop.Stopper) *Executor {
+func NewExecutor(db client.DB, gossip *gossip.Gossip, leaseMgr *LeaseManager, clock *hlc.Clock,
+	metaRegistry *metric.Registry, stopper *stop.Stopper) *Executor {
 	exec := &Executor{
 		db:       db,
 		reCache:  parser.NewRegexpCache(512),
 		leaseMgr: leaseMgr,
+		clock:    clock,
 
-		latency: metaRegistry.Latency("sql.latency"),
+		latency:             metaRegistry.Latency("sql.latency"),
+		schemaChangeMetrics: NewSchemaChangeMetrics(metaRegistry),
+		descriptorCount:     metaRegistry.Gauge("sql.descriptors.count"),
+		descriptorBytes:     metaRegistry.Gauge("sql.descriptors.bytes"),
 	}
 	exec.systemConfigCond = sync.NewCond(&exec.systemConfigMu)
 
@@ -105,6 +120,18 @@ func NewExecutor(db client.DB, gossip *gossip.Gossip, leaseMgr *LeaseManager, me
 	return exec
 }
 
+// now returns the current physical time of the executor's clock, which is
+// used for the timestamps of statements and transactions.
+func (e *Executor) now() time.Time {
+	return time.Unix(0, e.clock.PhysicalNow())
+}
+
+// SchemaChangeMetrics returns the metrics of the schema changes executed on
+// this node.
+func (e *Executor) SchemaChangeMetrics() *SchemaChangeMetrics {
+	return e.schemaChangeMetrics
+}
+
 // SetNodeID sets the node ID for the SQL server. This method must be called
 // before actually using the Executor.
 func (e *Executor) SetNodeID(nodeID roachpb.NodeID) {
@@ -118,6 +145,25 @@ func (e *Executor) updateSystemConfig(cfg *config.SystemConfig) {
 	e.systemConfig = *cfg
 	e.systemConfigCond.Broadcast()
 	e.systemConfigMu.Unlock()
+	e.recordDescriptorMetrics(cfg)
+}
+
+// recordDescriptorMetrics updates the number and the total size of the
+// schema descriptors from the descriptor table entries of the system config.
+func (e *Executor) recordDescriptorMetrics(cfg *config.SystemConfig) {
+	lowBound := roachpb.Key(keys.MakeTablePrefix(keys.DescriptorTableID))
+	highBound := roachpb.Key(keys.MakeTablePrefix(keys.DescriptorTableID + 1))
+	// The values of the system config are sorted by key.
+	i := sort.Search(len(cfg.Values), func(i int) bool {
+		return bytes.Compare(cfg.Values[i].Key, lowBound) >= 0
+	})
+	var count, size int64
+	for ; i < len(cfg.Values) && bytes.Compare(cfg.Values[i].Key, highBound) < 0; i++ {
+		count++
+		size += int64(len(cfg.Values[i].Value.RawBytes))
+	}
+	e.descriptorCount.Update(count)
+	e.descriptorBytes.Update(size)
 }
 
 // getSystemConfig returns a pointer to the latest system config. May be nil,
@@ -148,7 +194,7 @@ func (e *Executor) StatementResult(user string, stmt parser.Statement, args pars
 		systemConfig: e.getSystemConfig(),
 	}
 
-	planMaker.evalCtx.StmtTimestamp = parser.DTimestamp{Time: time.Now()}
+	planMaker.evalCtx.StmtTimestamp = parser.DTimestamp{Time: e.now()}
 	plan, err := planMaker.makePlan(stmt, false)
 	if err != nil {
 		return nil, err
@@ -269,6 +315,7 @@ func (e *Executor) execStmts(sql string, planMaker *planner) driver.Response {
 				}
 				for _, sc := range planMaker.schemaChangers {
 					sc.db = e.db
+					sc.metrics = e.schemaChangeMetrics
 					for r := retry.Start(retryOpts); r.Next(); {
 						if done, err := sc.IsDone(); err != nil {
 							log.Warning(err)
@@ -303,7 +350,7 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 		}
 		// Start a transaction here and not in planMaker to prevent begin
 		// transaction from being called within an auto-transaction below.
-		planMaker.setTxn(client.NewTxn(e.db), time.Now())
+		planMaker.setTxn(client.NewTxn(e.db), e.now())
 		planMaker.txn.SetDebugName("sql", 0)
 	case *parser.CommitTransaction, *parser.RollbackTransaction:
 		if planMaker.txn == nil {
@@ -387,7 +434,7 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 
 	// If there is a pending transaction.
 	if planMaker.txn != nil {
-		pErr := f(time.Now(), false)
+		pErr := f(e.now(), false)
 		return result, pErr
 	}
 
@@ -410,7 +457,7 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 	// No transaction. Run the command as a retryable block in an
 	// auto-transaction.
 	if pErr := e.db.Txn(func(txn *client.Txn) *roachpb.Error {
-		timestamp := time.Now()
+		timestamp := e.now()
 		planMaker.setTxn(txn, timestamp)
 		pErr := f(timestamp, true)
 		planMaker.resetTxn()
@@ -20,6 +20,7 @@ import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
 )
 
 // InternalExecutor can be used internally by cockroach to execute SQL
@@ -36,3 +37,69 @@ func (ie InternalExecutor) ExecuteStatementInTransaction(txn *client.Txn, statem
 	p := planner{txn: txn, user: security.RootUser, leaseMgr: ie.LeaseManager}
 	return p.exec(statement, params...)
 }
+
+// QueryRowsInTransaction executes the supplied SQL statement as part of the
+// supplied transaction and returns the result rows. Statements are currently
+// executed as the root user.
+func (ie InternalExecutor) QueryRowsInTransaction(txn *client.Txn, statement string, params ...interface{}) ([]parser.DTuple, *roachpb.Error) {
+	it, pErr := ie.QueryInTransaction(txn, statement, params...)
+	if pErr != nil {
+		return nil, pErr
+	}
+	var rows []parser.DTuple
+	for it.Next() {
+		rows = append(rows, append(parser.DTuple(nil), it.Values()...))
+	}
+	if pErr := it.PErr(); pErr != nil {
+		return nil, pErr
+	}
+	return rows, nil
+}
+
+// QueryInTransaction executes the supplied SQL statement as part of the
+// supplied transaction and returns an iterator over the result rows. Unlike
+// QueryRowsInTransaction, the rows are produced as the iterator advances and
+// aren't copied, which avoids buffering large results. The iterator must not
+// be used once the transaction has completed. Statements are currently
+// executed as the root user.
+func (ie InternalExecutor) QueryInTransaction(txn *client.Txn, statement string, params ...interface{}) (*RowIterator, *roachpb.Error) {
+	p := planner{txn: txn, user: security.RootUser, leaseMgr: ie.LeaseManager}
+	plan, pErr := p.query(statement, params...)
+	if pErr != nil {
+		return nil, pErr
+	}
+	return &RowIterator{plan: plan}, nil
+}
+
+// RowIterator iterates over the result rows of a statement executed by
+// QueryInTransaction, in their native datum representation.
+type RowIterator struct {
+	plan planNode
+}
+
+// Columns returns the names of the result columns.
+func (it *RowIterator) Columns() []string {
+	cols := it.plan.Columns()
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = col.name
+	}
+	return names
+}
+
+// Next advances the iterator to the next row. It returns false once there are
+// no more rows or an error occurred, which is then returned by PErr.
+func (it *RowIterator) Next() bool {
+	return it.plan.Next()
+}
+
+// Values returns the values of the current row. The returned tuple is only
+// valid until the next call to Next, so callers which retain it must copy it.
+func (it *RowIterator) Values() parser.DTuple {
+	return it.plan.Values()
+}
+
+// PErr returns the error, if any, which ended the iteration.
+func (it *RowIterator) PErr() *roachpb.Error {
+	return it.plan.PErr()
+}
@@ -0,0 +1,89 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package pgwire
+
+import (
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// serverMetrics holds the connection and session metrics of a Server.
+type serverMetrics struct {
+	conns         *metric.Gauge
+	connsTotal    *metric.Counter
+	authFailures  *metric.Counter
+	activeQueries *metric.Gauge
+	bytesIn       *metric.Counter
+	bytesOut      *metric.Counter
+	connLifetime  *metric.Histogram
+
+	// numConns and numActiveQueries back the gauges above. Accessed
+	// atomically.
+	numConns         int64
+	numActiveQueries int64
+}
+
+// newServerMetrics returns a new serverMetrics whose metrics are added to the
+// given registry.
+func newServerMetrics(registry *metric.Registry) *serverMetrics {
+	return &serverMetrics{
+		conns:         registry.Gauge("sql.conns"),
+		connsTotal:    registry.Counter("sql.conns.total"),
+		authFailures:  registry.Counter("sql.conns.auth.failures"),
+		activeQueries: registry.Gauge("sql.queries.active"),
+		bytesIn:       registry.Counter("sql.bytesin"),
+		bytesOut:      registry.Counter("sql.bytesout"),
+		connLifetime:  registry.Histogram("sql.conns.lifetime", time.Minute, int64(24*time.Hour), 1),
+	}
+}
+
+func (m *serverMetrics) connOpened() {
+	m.connsTotal.Inc(1)
+	m.conns.Update(atomic.AddInt64(&m.numConns, 1))
+}
+
+func (m *serverMetrics) connClosed(lifetime time.Duration) {
+	m.conns.Update(atomic.AddInt64(&m.numConns, -1))
+	m.connLifetime.RecordValue(lifetime.Nanoseconds())
+}
+
+func (m *serverMetrics) queryStarted() {
+	m.activeQueries.Update(atomic.AddInt64(&m.numActiveQueries, 1))
+}
+
+func (m *serverMetrics) queryFinished() {
+	m.activeQueries.Update(atomic.AddInt64(&m.numActiveQueries, -1))
+}
+
+// countingConn counts the bytes read from and written to a connection.
+type countingConn struct {
+	net.Conn
+	metrics *serverMetrics
+}
+
+func (c countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.metrics.bytesIn.Inc(int64(n))
+	return n, err
+}
+
+func (c countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.metrics.bytesOut.Inc(int64(n))
+	return n, err
+}
@@ -20,10 +20,12 @@ import (
 	"crypto/tls"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 )
 
 // ErrSSLRequired is returned when a client attempts to connect to a
@@ -40,43 +42,58 @@ var (
 	sslUnsupported = []byte{'N'}
 )
 
+// maxSessionQueryLen is the length to which the statements reported by
+// Sessions are truncated.
+const maxSessionQueryLen = 256
+
 // Server implements the server side of the PostgreSQL wire protocol.
 type Server struct {
 	context  *Context
 	listener net.Listener
+	metrics  *serverMetrics
 	mu       sync.Mutex // Mutex protects the fields below
-	conns    map[net.Conn]struct{}
+	// conns maps each open connection to its v3Conn, which is nil until the
+	// connection's startup handshake has completed.
+	conns    map[net.Conn]*v3Conn
 	closing  bool
+	draining bool
 }
 
-// NewServer creates a Server.
-func NewServer(context *Context) *Server {
+// NewServer creates a Server whose metrics are added to the given registry.
+func NewServer(context *Context, registry *metric.Registry) *Server {
 	return &Server{
 		context: context,
-		conns:   make(map[net.Conn]struct{}),
+		metrics: newServerMetrics(registry),
+		conns:   make(map[net.Conn]*v3Conn),
 	}
 }
 
-// Start a server on the given address.
-func (s *Server) Start(addr net.Addr) error {
+// Listen binds the server to the given address without accepting
+// connections, which allows the address to be advertised before the server is
+// ready to serve clients.
+func (s *Server) Listen(addr net.Addr) error {
 	ln, err := net.Listen(addr.Network(), addr.String())
 	if err != nil {
 		return err
 	}
 	s.listener = ln
 
-	s.context.Stopper.RunWorker(func() {
-		s.serve(ln)
-	})
-
 	s.context.Stopper.RunWorker(func() {
 		<-s.context.Stopper.ShouldStop()
 		s.close()
 	})
-	log.Infof("starting postgres server at %s", ln.Addr())
 	return nil
 }
 
+// Start accepts connections on the address the server listens on. Listen
+// must have been called.
+func (s *Server) Start() {
+	s.context.Stopper.RunWorker(func() {
+		s.serve(s.listener)
+	})
+	log.Infof("starting postgres server at %s", s.listener.Addr())
+}
+
 // Addr returns this Server's address.
 func (s *Server) Addr() net.Addr {
 	return s.listener.Addr()
@@ -93,24 +110,34 @@ func (s *Server) serve(ln net.Listener) {
 			return
 		}
 
+		conn = countingConn{Conn: conn, metrics: s.metrics}
+
 		s.mu.Lock()
-		s.conns[conn] = struct{}{}
+		if s.draining {
+			// Refuse new connections while draining.
+			s.mu.Unlock()
+			conn.Close()
+			continue
+		}
+		s.conns[conn] = nil
 		s.mu.Unlock()
+		s.metrics.connOpened()
 
-		go func() {
+		go func(start time.Time) {
 			defer func() {
 				s.mu.Lock()
 				delete(s.conns, conn)
 				s.mu.Unlock()
 				conn.Close()
+				s.metrics.connClosed(time.Since(start))
 			}()
 
 			if err := s.serveConn(conn); err != nil {
-				if !s.isClosing() {
+				if !s.isClosing() && !s.isDraining() {
 					log.Error(err)
 				}
 			}
-		}()
+		}(time.Now())
 	}
 }
 
@@ -120,6 +147,72 @@ func (s *Server) isClosing() bool {
 	return s.closing
 }
 
+func (s *Server) isDraining() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.draining
+}
+
+// Drain places the server into draining mode, in which new connections are
+// refused. Connections which are not in the middle of a transaction are closed
+// immediately; the remaining connections are closed as soon as their
+// transactions finish. Connections still in a transaction after timeout are
+// closed regardless, abandoning their transactions. Drain returns the number
+// of connections closed this way.
+func (s *Server) Drain(timeout time.Duration) int {
+	s.mu.Lock()
+	s.draining = true
+	s.mu.Unlock()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		s.mu.Lock()
+		busy := 0
+		for conn, v3conn := range s.conns {
+			if v3conn == nil || v3conn.isIdle() {
+				conn.Close()
+			} else {
+				busy++
+			}
+		}
+		if busy == 0 || !time.Now().Before(deadline) {
+			for conn := range s.conns {
+				conn.Close()
+			}
+			s.mu.Unlock()
+			return busy
+		}
+		s.mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// SessionInfo describes a client session of a Server.
+type SessionInfo struct {
+	Username        string
+	ClientAddr      string
+	ApplicationName string
+	AgeNanos        int64
+	// ActiveQuery is the statement being executed, if any, truncated to
+	// maxSessionQueryLen bytes.
+	ActiveQuery string
+}
+
+// Sessions returns a description of each client session which has completed
+// its startup handshake.
+func (s *Server) Sessions() []SessionInfo {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions := make([]SessionInfo, 0, len(s.conns))
+	for _, v3conn := range s.conns {
+		if v3conn != nil {
+			sessions = append(sessions, v3conn.sessionInfo(now))
+		}
+	}
+	return sessions
+}
+
 // close this server, and all client connections.
 func (s *Server) close() {
 	s.listener.Close()
@@ -134,6 +227,7 @@ func (s *Server) close() {
 // serveConn serves a single connection, driving the handshake process
 // and delegating to the appropriate connection type.
 func (s *Server) serveConn(conn net.Conn) error {
+	origConn := conn
 	var buf readBuffer
 	if err := buf.readUntypedMsg(conn); err != nil {
 		return err
@@ -175,7 +269,7 @@ func (s *Server) serveConn(conn net.Conn) error {
 	}
 
 	if version == version30 {
-		v3conn := makeV3Conn(conn, s.context.Executor)
+		v3conn := makeV3Conn(conn, s.context.Executor, s.metrics)
 		// This is better than always flushing on error.
 		defer func() {
 			if err := v3conn.wr.Flush(); err != nil {
@@ -188,11 +282,17 @@ func (s *Server) serveConn(conn net.Conn) error {
 		if err := v3conn.parseOptions(buf.msg); err != nil {
 			return v3conn.sendError(err.Error())
 		}
+		s.mu.Lock()
+		if _, ok := s.conns[origConn]; ok {
+			s.conns[origConn] = &v3conn
+		}
+		s.mu.Unlock()
 		if tlsConn, ok := conn.(*tls.Conn); ok {
 			tlsState := tlsConn.ConnectionState()
-			authenticationHook, err := security.UserAuthHook(s.context.Insecure, &tlsState)
+			authenticationHook, err := security.SQLUserAuthHook(s.context.Insecure, &tlsState)
 			if err != nil {
-				return v3conn.sendError(err.Error())
+				s.metrics.authFailures.Inc(1)
+				return v3conn.sendAuthError(err)
 			}
 			return v3conn.serve(authenticationHook)
 		}
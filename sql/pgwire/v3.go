@@ -22,6 +22,9 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/lib/pq/oid"
 
@@ -74,6 +77,15 @@ const (
 	authOK int32 = 0
 )
 
+const (
+	// codeInternalError is the SQLSTATE of errors which aren't mapped to a
+	// more specific code.
+	codeInternalError = "XX000"
+	// codeInvalidAuthorization is the SQLSTATE of authentication failures
+	// ("invalid_authorization_specification").
+	codeInvalidAuthorization = "28000"
+)
+
 // preparedStatement is a SQL statement that has been parsed and the types
 // of arguments and results have been determined.
 type preparedStatement struct {
@@ -108,22 +120,66 @@ type v3Conn struct {
 	// specified in documentation. Consult the sources before you modify:
 	// https://github.com/postgres/postgres/blob/master/src/backend/tcop/postgres.c
 	doingExtendedQueryMessage, ignoreTillSync bool
+
+	// idle is set (atomically) to 1 while the connection is waiting for a new
+	// message from the client outside of any transaction.
+	idle int32
+
+	metrics    *serverMetrics
+	clientAddr string
+	start      time.Time
+
+	mu          sync.Mutex // Mutex protects the fields below
+	activeQuery string
 }
 
 type opts struct {
-	user, database string
+	user, database, applicationName string
 }
 
-func makeV3Conn(conn net.Conn, executor *sql.Executor) v3Conn {
+func makeV3Conn(conn net.Conn, executor *sql.Executor, metrics *serverMetrics) v3Conn {
 	return v3Conn{
 		rd:                 bufio.NewReader(conn),
 		wr:                 bufio.NewWriter(conn),
 		executor:           executor,
 		preparedStatements: make(map[string]preparedStatement),
 		preparedPortals:    make(map[string]preparedPortal),
+		metrics:            metrics,
+		clientAddr:         conn.RemoteAddr().String(),
+		start:              time.Now(),
 	}
 }
 
+// sessionInfo describes the connection's session as of now. The options of
+// the connection must have been parsed.
+func (c *v3Conn) sessionInfo(now time.Time) SessionInfo {
+	c.mu.Lock()
+	query := c.activeQuery
+	c.mu.Unlock()
+	if len(query) > maxSessionQueryLen {
+		query = query[:maxSessionQueryLen]
+	}
+	return SessionInfo{
+		Username:        c.opts.user,
+		ClientAddr:      c.clientAddr,
+		ApplicationName: c.opts.applicationName,
+		AgeNanos:        now.Sub(c.start).Nanoseconds(),
+		ActiveQuery:     query,
+	}
+}
+
+func (c *v3Conn) setActiveQuery(query string) {
+	c.mu.Lock()
+	c.activeQuery = query
+	c.mu.Unlock()
+}
+
+// isIdle returns whether the connection is waiting for a message from the
+// client outside of any transaction.
+func (c *v3Conn) isIdle() bool {
+	return atomic.LoadInt32(&c.idle) == 1
+}
+
 func (c *v3Conn) parseOptions(data []byte) error {
 	buf := readBuffer{msg: data}
 	for {
@@ -143,6 +199,8 @@ func (c *v3Conn) parseOptions(data []byte) error {
 			c.opts.database = value
 		case "user":
 			c.opts.user = value
+		case "application_name":
+			c.opts.applicationName = value
 		default:
 			log.Warningf("unrecognized configuration parameter %q", key)
 		}
@@ -152,7 +210,8 @@ func (c *v3Conn) parseOptions(data []byte) error {
 func (c *v3Conn) serve(authenticationHook func(string, bool) error) error {
 	if authenticationHook != nil {
 		if err := authenticationHook(c.opts.user, true /* public */); err != nil {
-			return c.sendError(err.Error())
+			c.metrics.authFailures.Inc(1)
+			return c.sendAuthError(err)
 		}
 	}
 	c.writeBuf.initMsg(serverMsgAuth)
@@ -165,6 +224,7 @@ func (c *v3Conn) serve(authenticationHook func(string, bool) error) error {
 	}
 
 	for {
+		var idle int32
 		if !c.doingExtendedQueryMessage {
 			c.writeBuf.initMsg(serverMsgReady)
 			var txnStatus byte = 'I'
@@ -181,6 +241,9 @@ func (c *v3Conn) serve(authenticationHook func(string, bool) error) error {
 			if log.V(2) {
 				log.Infof("pgwire: %s: %q", serverMsgReady, txnStatus)
 			}
+			if txnStatus == 'I' {
+				idle = 1
+			}
 			c.writeBuf.WriteByte(txnStatus)
 			if err := c.writeBuf.finishMsg(c.wr); err != nil {
 				return err
@@ -191,7 +254,9 @@ func (c *v3Conn) serve(authenticationHook func(string, bool) error) error {
 		if err := c.wr.Flush(); err != nil {
 			return err
 		}
+		atomic.StoreInt32(&c.idle, idle)
 		typ, err := c.readBuf.readTypedMsg(c.rd)
+		atomic.StoreInt32(&c.idle, 0)
 		if err != nil {
 			return err
 		}
@@ -522,7 +587,11 @@ func (c *v3Conn) handleExecute(buf *readBuffer) error {
 func (c *v3Conn) executeStatements(stmts string, params []driver.Datum, formatCodes []formatCode, sendDescription bool) error {
 	c.session.Database = c.opts.database
 
+	c.setActiveQuery(stmts)
+	c.metrics.queryStarted()
 	resp, _, err := c.executor.ExecuteStatements(c.opts.user, c.session, stmts, params)
+	c.metrics.queryFinished()
+	c.setActiveQuery("")
 	if err != nil {
 		return c.sendError(err.Error())
 	}
@@ -543,6 +612,19 @@ func (c *v3Conn) sendCommandComplete(tag []byte) error {
 }
 
 func (c *v3Conn) sendError(errToSend string) error {
+	// TODO(bdarnell): map our errors to appropriate postgres error
+	// codes as defined in
+	// http://www.postgresql.org/docs/9.4/static/errcodes-appendix.html
+	return c.sendErrorWithCode(codeInternalError, errToSend)
+}
+
+// sendAuthError sends an authentication failure, which terminates the
+// connection before any statement is executed.
+func (c *v3Conn) sendAuthError(err error) error {
+	return c.sendErrorWithCode(codeInvalidAuthorization, fmt.Sprintf("authentication failed: %s", err))
+}
+
+func (c *v3Conn) sendErrorWithCode(code, errToSend string) error {
 	if c.doingExtendedQueryMessage {
 		c.ignoreTillSync = true
 	}
@@ -557,11 +639,7 @@ func (c *v3Conn) sendError(errToSend string) error {
 	if err := c.writeBuf.WriteByte('C'); err != nil {
 		return err
 	}
-	// "XX000" is "internal error".
-	// TODO(bdarnell): map our errors to appropriate postgres error
-	// codes as defined in
-	// http://www.postgresql.org/docs/9.4/static/errcodes-appendix.html
-	if err := c.writeBuf.writeString("XX000"); err != nil {
+	if err := c.writeBuf.writeString(code); err != nil {
 		return err
 	}
 	if err := c.writeBuf.WriteByte('M'); err != nil {
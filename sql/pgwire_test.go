@@ -18,6 +18,7 @@ package sql_test
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/url"
@@ -160,6 +161,61 @@ func TestPGWire(t *testing.T) {
 	}
 }
 
+// TestPGWireCertUser verifies that the user of a session must match the user
+// of the client certificate, unless the certificate is for the node or root
+// user, and that mismatches fail authentication before any statement runs.
+func TestPGWireCertUser(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := setupTestServer(t)
+	defer cleanupTestServer(s)
+
+	host, port, err := net.SplitHostPort(s.PGAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		certUser, user string
+		success        bool
+	}{
+		{server.TestUser, server.TestUser, true},
+		{server.TestUser, security.RootUser, false},
+		{server.TestUser, security.NodeUser, false},
+		{security.RootUser, server.TestUser, true},
+		{security.NodeUser, server.TestUser, true},
+	}
+	for i, tc := range testCases {
+		certPath := security.ClientCertPath(security.EmbeddedCertsDir, tc.certUser)
+		keyPath := security.ClientKeyPath(security.EmbeddedCertsDir, tc.certUser)
+		tempCertPath, tempCertCleanup := securitytest.TempRestrictedCopy(t, certPath, os.TempDir(), "TestPGWireCertUser_cert")
+		tempKeyPath, tempKeyCleanup := securitytest.TempRestrictedCopy(t, keyPath, os.TempDir(), "TestPGWireCertUser_key")
+
+		pgURL := url.URL{
+			Scheme: "postgres",
+			Host:   net.JoinHostPort(host, port),
+			User:   url.User(tc.user),
+			RawQuery: fmt.Sprintf("sslmode=require&sslcert=%s&sslkey=%s",
+				url.QueryEscape(tempCertPath),
+				url.QueryEscape(tempKeyPath),
+			),
+		}
+		err := trivialQuery(pgURL)
+		tempCertCleanup()
+		tempKeyCleanup()
+
+		if tc.success {
+			if err != nil {
+				t.Errorf("%d: %s connecting as %s: expected success, got %v", i, tc.certUser, tc.user, err)
+			}
+			continue
+		}
+		if pqErr, ok := err.(*pq.Error); !ok || pqErr.Code != "28000" ||
+			!testutils.IsError(err, `authentication failed: requested user is \w+, but certificate is for \w+`) {
+			t.Errorf("%d: %s connecting as %s: expected authentication error, got %v", i, tc.certUser, tc.user, err)
+		}
+	}
+}
+
 type preparedTest struct {
 	params []interface{}
 	error  string
@@ -311,3 +367,109 @@ func TestPGPrepared(t *testing.T) {
 		}
 	}
 }
+
+// TestPGWireSQLAddress verifies that SQL clients are served on the address
+// advertised in the node descriptor and not on the node's RPC address.
+func TestPGWireSQLAddress(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := setupTestServer(t)
+	defer s.Stop()
+
+	if s.PGAddr() == s.ServingAddr() {
+		t.Fatalf("expected distinct SQL and RPC addresses, got %s", s.PGAddr())
+	}
+	desc, err := s.Gossip().GetNodeDescriptor(s.Gossip().GetNodeID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, e := desc.SQLAddress.String(), s.PGAddr(); a != e {
+		t.Errorf("expected advertised SQL address %s, got %s", e, a)
+	}
+
+	pgUrl, cleanupFn := sqlutils.PGUrl(t, s, security.RootUser, os.TempDir(), "TestPGWireSQLAddress")
+	defer cleanupFn()
+	if err := trivialQuery(pgUrl); err != nil {
+		t.Fatal(err)
+	}
+	pgUrl.Host = s.ServingAddr()
+	if err := trivialQuery(pgUrl); err == nil {
+		t.Fatalf("expected SQL connection to %s to fail", pgUrl.Host)
+	}
+}
+
+// TestPGWireMetrics verifies that client connections are reflected in the
+// node's metrics and session list.
+func TestPGWireMetrics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := setupTestServer(t)
+	defer s.Stop()
+
+	pgUrl, cleanupFn := sqlutils.PGUrl(t, s, security.RootUser, os.TempDir(), "TestPGWireMetrics")
+	defer cleanupFn()
+	query := pgUrl.Query()
+	query.Set("application_name", "metrics_test")
+	pgUrl.RawQuery = query.Encode()
+
+	const numConns = 3
+	for i := 0; i < numConns; i++ {
+		db, err := sql.Open("postgres", pgUrl.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		if _, err := db.Exec("SELECT 1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	httpClient, err := s.Ctx.GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func(path string, v interface{}) {
+		resp, err := httpClient.Get(s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var metrics map[string]interface{}
+	get("/_status/metrics/local", &metrics)
+	suffix := "." + s.Gossip().GetNodeID().String()
+	for name, min := range map[string]float64{
+		"sql.conns":       numConns,
+		"sql.conns.total": numConns,
+		"sql.bytesin":     1,
+		"sql.bytesout":    1,
+	} {
+		key := "cr.node." + name + suffix
+		if a, _ := metrics[key].(float64); a < min {
+			t.Errorf("expected %s to be at least %.0f, got %.0f", key, min, a)
+		}
+	}
+	if a, e := metrics["cr.node.sql.queries.active"+suffix], 0.0; a != e {
+		t.Errorf("expected %.0f active queries, got %.0f", e, a)
+	}
+
+	var sessions struct {
+		Data []pgwire.SessionInfo `json:"d"`
+	}
+	get("/_status/sessions/local", &sessions)
+	var found int
+	for _, session := range sessions.Data {
+		if session.ApplicationName != "metrics_test" {
+			continue
+		}
+		found++
+		if session.Username != security.RootUser || session.ClientAddr == "" || session.AgeNanos <= 0 {
+			t.Errorf("unexpected session %+v", session)
+		}
+	}
+	if found != numConns {
+		t.Errorf("expected %d sessions, got %+v", numConns, sessions.Data)
+	}
+}
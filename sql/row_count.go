@@ -0,0 +1,137 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// rowCountScanSize is the maximum number of keys read by each scan when
+// counting rows exactly.
+const rowCountScanSize = 10000
+
+// TableRowCount returns the number of rows in the given table of the given
+// database. If approximate is false, the rows are counted by scanning the
+// table's primary index, which takes time proportional to the number of rows.
+// Otherwise, the count is estimated from the MVCC statistics of the ranges
+// holding the table, which takes time proportional to the number of ranges.
+// The estimate assumes that those ranges hold no data of other tables and
+// that no column values are NULL.
+func TableRowCount(db *client.DB, database, table string, approximate bool) (int64, error) {
+	desc, err := getTableDescByName(db, database, table)
+	if err != nil {
+		return 0, err
+	}
+	if approximate {
+		return approximateRowCount(db, desc)
+	}
+	return exactRowCount(db, desc)
+}
+
+// getTableDescByName reads the descriptor of the given table of the given
+// database.
+func getTableDescByName(db *client.DB, database, table string) (*TableDescriptor, error) {
+	dbID, err := lookupDescID(db, keys.RootNamespaceID, database)
+	if err != nil {
+		return nil, err
+	}
+	if dbID == 0 {
+		return nil, util.Errorf("database %q does not exist", database)
+	}
+	tableID, err := lookupDescID(db, dbID, table)
+	if err != nil {
+		return nil, err
+	}
+	if tableID == 0 {
+		return nil, util.Errorf("table %q does not exist", table)
+	}
+	var desc Descriptor
+	if pErr := db.GetProto(MakeDescMetadataKey(tableID), &desc); pErr != nil {
+		return nil, pErr.GoError()
+	}
+	tableDesc := desc.GetTable()
+	if tableDesc == nil {
+		return nil, util.Errorf("%q is not a table", table)
+	}
+	return tableDesc, nil
+}
+
+// lookupDescID returns the ID of the descriptor with the given name and
+// parent, or 0 if there is none.
+func lookupDescID(db *client.DB, parentID ID, name string) (ID, error) {
+	gr, pErr := db.Get(MakeNameMetadataKey(parentID, name))
+	if pErr != nil {
+		return 0, pErr.GoError()
+	}
+	if !gr.Exists() {
+		return 0, nil
+	}
+	return ID(gr.ValueInt()), nil
+}
+
+// exactRowCount counts the rows of the table by scanning its primary index.
+// All keys of a row share the prefix which remains after stripping the column
+// ID, so rows are counted by counting distinct prefixes.
+func exactRowCount(db *client.DB, desc *TableDescriptor) (int64, error) {
+	start := roachpb.Key(MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID))
+	end := start.PrefixEnd()
+	var count int64
+	var rowPrefix roachpb.Key
+	for {
+		rows, pErr := db.Scan(start, end, rowCountScanSize)
+		if pErr != nil {
+			return 0, pErr.GoError()
+		}
+		for _, row := range rows {
+			if rowPrefix == nil || !bytes.HasPrefix(row.Key, rowPrefix) {
+				rowPrefix = stripColumnIDLength(row.Key)
+				count++
+			}
+		}
+		if len(rows) < rowCountScanSize {
+			return count, nil
+		}
+		start = rows[len(rows)-1].Key.Next()
+	}
+}
+
+// approximateRowCount estimates the number of rows of the table from the
+// number of live keys in the ranges holding the table. Each row has a
+// sentinel key, a key for each column which is not part of the primary key
+// and a key in each secondary index.
+func approximateRowCount(db *client.DB, desc *TableDescriptor) (int64, error) {
+	start := roachpb.Key(keys.MakeTablePrefix(uint32(desc.ID)))
+	end := start.PrefixEnd()
+	var liveCount int64
+	for key := start; ; {
+		stats, pErr := db.RangeStats(key)
+		if pErr != nil {
+			return 0, pErr.GoError()
+		}
+		liveCount += stats.LiveCount
+		endKey := stats.Desc.EndKey.AsRawKey()
+		if bytes.Compare(endKey, end) >= 0 {
+			break
+		}
+		key = endKey
+	}
+	keysPerRow := int64(1 + len(desc.Columns) - len(desc.PrimaryIndex.ColumnIDs) + len(desc.Indexes))
+	return liveCount / keysPerRow, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestTableRowCount(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, w INT, INDEX (v));
+`); err != nil {
+		t.Fatal(err)
+	}
+	const numRows = 100
+	for i := 0; i < numRows; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $2, $3)`, i, i, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Split off the table so that its range holds no data of other tables.
+	var tableID uint32
+	if err := sqlDB.QueryRow(`SELECT id FROM system.namespace WHERE name = 'kv'`).Scan(&tableID); err != nil {
+		t.Fatal(err)
+	}
+	if pErr := kvDB.AdminSplit(keys.MakeTablePrefix(tableID)); pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	for _, approximate := range []bool{false, true} {
+		count, err := sql.TableRowCount(kvDB, "t", "kv", approximate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != numRows {
+			t.Errorf("approximate=%t: expected %d rows, got %d", approximate, numRows, count)
+		}
+	}
+
+	// Deleted rows are no longer counted.
+	if _, err := sqlDB.Exec(`DELETE FROM t.kv WHERE k < 10`); err != nil {
+		t.Fatal(err)
+	}
+	for _, approximate := range []bool{false, true} {
+		count, err := sql.TableRowCount(kvDB, "t", "kv", approximate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != numRows-10 {
+			t.Errorf("approximate=%t: expected %d rows, got %d", approximate, numRows-10, count)
+		}
+	}
+
+	if _, err := sql.TableRowCount(kvDB, "t", "missing", false); !testutils.IsError(err, `table "missing" does not exist`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := sql.TableRowCount(kvDB, "missing", "kv", true); !testutils.IsError(err, `database "missing" does not exist`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
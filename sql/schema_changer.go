@@ -19,6 +19,7 @@ package sql
 import (
 	"bytes"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -28,6 +29,7 @@ import (
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
@@ -43,6 +45,46 @@ type SchemaChanger struct {
 	// The SchemaChangeManager can attempt to execute this schema
 	// changer after this time.
 	execAfter time.Time
+	metrics   *SchemaChangeMetrics
+}
+
+// SchemaChangeMetrics tracks the schema changes executed by a node, either
+// synchronously by the Executor or in the background by the
+// SchemaChangeManager.
+type SchemaChangeMetrics struct {
+	active    int64 // accessed atomically
+	gauge     *metric.Gauge
+	completed *metric.Counter
+}
+
+// NewSchemaChangeMetrics returns a new SchemaChangeMetrics whose metrics are
+// added to the given registry.
+func NewSchemaChangeMetrics(registry *metric.Registry) *SchemaChangeMetrics {
+	return &SchemaChangeMetrics{
+		gauge:     registry.Gauge("sql.schemachanges.active"),
+		completed: registry.Counter("sql.schemachanges.completed"),
+	}
+}
+
+// started records the start of a schema change. It is a no-op on a nil
+// SchemaChangeMetrics.
+func (m *SchemaChangeMetrics) started() {
+	if m == nil {
+		return
+	}
+	m.gauge.Update(atomic.AddInt64(&m.active, 1))
+}
+
+// finished records the end of a schema change, which is counted as completed
+// if it finished successfully. It is a no-op on a nil SchemaChangeMetrics.
+func (m *SchemaChangeMetrics) finished(completed bool) {
+	if m == nil {
+		return
+	}
+	m.gauge.Update(atomic.AddInt64(&m.active, -1))
+	if completed {
+		m.completed.Inc(1)
+	}
 }
 
 // applyMutations runs the backfill for the mutations.
@@ -187,6 +229,13 @@ func (sc SchemaChanger) exec() *roachpb.Error {
 		}
 	}(&lease)
 
+	// The schema change is in flight for as long as its lease is held.
+	completed := false
+	sc.metrics.started()
+	defer func() {
+		sc.metrics.finished(completed)
+	}()
+
 	// Increment the version and unset tableDescriptor.UpVersion.
 	if pErr := sc.MaybeIncrementVersion(); pErr != nil {
 		return pErr
@@ -203,6 +252,7 @@ func (sc SchemaChanger) exec() *roachpb.Error {
 
 	if sc.mutationID == invalidMutationID {
 		// Nothing more to do.
+		completed = true
 		return nil
 	}
 
@@ -224,7 +274,11 @@ func (sc SchemaChanger) exec() *roachpb.Error {
 	}
 
 	// Mark the mutations as completed.
-	return sc.done()
+	if pErr := sc.done(); pErr != nil {
+		return pErr
+	}
+	completed = true
+	return nil
 }
 
 // MaybeIncrementVersion increments the version if needed.
@@ -371,7 +425,10 @@ func (sc *SchemaChanger) purgeMutations(lease *TableDescriptor_SchemaChangeLease
 	}
 
 	// Mark the mutations as completed.
-	return sc.done()
+	if pErr := sc.done(); pErr != nil {
+		return pErr
+	}
+	return nil
 }
 
 // IsDone returns true if the work scheduled for the schema changer
@@ -410,13 +467,14 @@ type SchemaChangeManager struct {
 	db       client.DB
 	gossip   *gossip.Gossip
 	leaseMgr *LeaseManager
+	metrics  *SchemaChangeMetrics
 	// Create a schema changer for every outstanding schema change seen.
 	schemaChangers map[ID]SchemaChanger
 }
 
 // NewSchemaChangeManager returns a new SchemaChangeManager.
-func NewSchemaChangeManager(db client.DB, gossip *gossip.Gossip, leaseMgr *LeaseManager) *SchemaChangeManager {
-	return &SchemaChangeManager{db: db, gossip: gossip, leaseMgr: leaseMgr, schemaChangers: make(map[ID]SchemaChanger)}
+func NewSchemaChangeManager(db client.DB, gossip *gossip.Gossip, leaseMgr *LeaseManager, metrics *SchemaChangeMetrics) *SchemaChangeManager {
+	return &SchemaChangeManager{db: db, gossip: gossip, leaseMgr: leaseMgr, metrics: metrics, schemaChangers: make(map[ID]SchemaChanger)}
 }
 
 var (
@@ -495,6 +553,7 @@ func (s *SchemaChangeManager) Start(stopper *stop.Stopper) {
 					nodeID:   roachpb.NodeID(s.leaseMgr.nodeID),
 					db:       s.db,
 					leaseMgr: s.leaseMgr,
+					metrics:  s.metrics,
 				}
 				// Keep track of existing schema changers.
 				oldSchemaChangers := make(map[ID]struct{}, len(s.schemaChangers))
@@ -24,8 +24,10 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/gogo/protobuf/proto"
 )
@@ -406,3 +408,74 @@ ALTER INDEX t.test@foo RENAME TO ufo
 		_ = mTest.checkQueryResponse(indexQuery, [][]string{{"b"}, {"d"}})
 	}
 }
+
+// TestSchemaChangeMetrics verifies that a schema change is reflected in the
+// active schema changes gauge while it runs and in the completed schema
+// changes counter once it finishes.
+func TestSchemaChangeMetrics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	server, sqlDB, _ := setup(t)
+	defer cleanup(server, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.test (k CHAR PRIMARY KEY, v CHAR);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	getMetric := func(name string) int64 {
+		var value int64
+		server.MetaRegistry().Each(func(n string, v interface{}) {
+			if n != name {
+				return
+			}
+			switch m := v.(type) {
+			case *metric.Gauge:
+				value = m.Value()
+			case *metric.Counter:
+				value = m.Count()
+			}
+		})
+		return value
+	}
+	completed := getMetric("sql.schemachanges.completed")
+
+	// Hold a lease on the current version of the table in an open
+	// transaction. The schema change cannot make progress until the lease is
+	// released.
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`SELECT * FROM t.test`); err != nil {
+		t.Fatal(err)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := sqlDB.Exec(`ALTER TABLE t.test ADD COLUMN x CHAR`)
+		errCh <- err
+	}()
+
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if a := getMetric("sql.schemachanges.active"); a != 1 {
+			return util.Errorf("expected 1 active schema change, found %d", a)
+		}
+		return nil
+	})
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	if a := getMetric("sql.schemachanges.active"); a != 0 {
+		t.Errorf("expected no active schema changes, found %d", a)
+	}
+	if a, e := getMetric("sql.schemachanges.completed"), completed+1; a != e {
+		t.Errorf("expected %d completed schema changes, found %d", e, a)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// RefreshSettings starts a goroutine that updates the supplied settings from
+// the system.settings table whenever a new system configuration is received
+// via gossip.
+func RefreshSettings(s *stop.Stopper, gossip *gossip.Gossip, settings *config.Settings) {
+	s.RunWorker(func() {
+		gossipUpdateC := gossip.RegisterSystemConfigChannel()
+		for {
+			select {
+			case <-gossipUpdateC:
+				settings.Update(decodeSettings(*gossip.GetSystemConfig()))
+			case <-s.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// decodeSettings returns the rows of the system.settings table contained in
+// the system config. Rows which cannot be decoded are logged and skipped.
+func decodeSettings(cfg config.SystemConfig) map[string]config.RawSetting {
+	prefix := MakeIndexKeyPrefix(SettingsTable.ID, SettingsTable.PrimaryIndex.ID)
+	valueColID := uint64(SettingsTable.Columns[1].ID)
+	typeColID := uint64(SettingsTable.Columns[2].ID)
+
+	settings := map[string]config.RawSetting{}
+	for _, kv := range cfg.Values {
+		if !bytes.HasPrefix(kv.Key, prefix) {
+			continue
+		}
+		rest, name, err := encoding.DecodeString(kv.Key[len(prefix):], nil)
+		if err != nil {
+			log.Warningf("%s: unable to decode setting name: %s", kv.Key, err)
+			continue
+		}
+		// The sentinel key of each row has a zero column ID.
+		_, colID, err := encoding.DecodeUvarint(rest)
+		if err != nil {
+			log.Warningf("%s: unable to decode setting column: %s", kv.Key, err)
+			continue
+		}
+		if colID != valueColID && colID != typeColID {
+			continue
+		}
+		b, err := kv.Value.GetBytes()
+		if err != nil {
+			log.Warningf("%s: unable to decode setting %s: %s", kv.Key, name, err)
+			continue
+		}
+		setting := settings[name]
+		if colID == valueColID {
+			setting.Value = string(b)
+		} else {
+			setting.Type = config.SettingType(b)
+		}
+		settings[name] = setting
+	}
+	return settings
+}
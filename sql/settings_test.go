@@ -0,0 +1,55 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/testutils/testcluster"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestClusterSettings sets cluster settings through SQL on one node and
+// verifies that another node's settings are updated via gossip.
+func TestClusterSettings(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{})
+	defer tc.Stop()
+	settings := tc.Servers[2].Settings()
+
+	const name = "test.duration"
+	if _, err := tc.Conns[0].Exec(`INSERT INTO system.settings VALUES ($1, '90s', 'd', NOW())`, name); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if a, e := settings.Duration(name, time.Second), 90*time.Second; a != e {
+			return util.Errorf("expected %s, got %s", e, a)
+		}
+		return nil
+	})
+
+	// A malformed value falls back to the default.
+	if _, err := tc.Conns[1].Exec(`UPDATE system.settings SET value = 'soon' WHERE name = $1`, name); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if a, e := settings.Duration(name, time.Second), time.Second; a != e {
+			return util.Errorf("expected %s, got %s", e, a)
+		}
+		return nil
+	})
+}
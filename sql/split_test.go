@@ -79,6 +79,9 @@ func TestSplitOnTableBoundaries(t *testing.T) {
 	s, sqlDB, kvDB := setupWithContext(t, getFastScanContext())
 	defer cleanup(s, sqlDB)
 
+	if err := s.WaitForInitialSplits(); err != nil {
+		t.Fatal(err)
+	}
 	expectedInitialRanges := server.ExpectedInitialRangeCount()
 
 	if _, err := sqlDB.Exec(`CREATE DATABASE test`); err != nil {
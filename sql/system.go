@@ -61,6 +61,16 @@ CREATE TABLE system.zones (
   id     INT PRIMARY KEY,
   config BYTES
 );`
+
+	// Cluster settings, gossiped with the system config. valueType is one of
+	// the config.SettingType values.
+	settingsTableSchema = `
+CREATE TABLE system.settings (
+  name        STRING PRIMARY KEY,
+  value       STRING,
+  valueType   STRING,
+  lastUpdated TIMESTAMP
+);`
 )
 
 var (
@@ -85,6 +95,9 @@ var (
 	// ZonesTable is the descriptor for the zones table.
 	ZonesTable = createSystemTable(keys.ZonesTableID, zonesTableSchema)
 
+	// SettingsTable is the descriptor for the cluster settings table.
+	SettingsTable = createSystemTable(keys.SettingsTableID, settingsTableSchema)
+
 	// SystemAllowedPrivileges describes the privileges allowed for each
 	// system object. No user may have more than those privileges, and
 	// the root user must have exactly those privileges.
@@ -95,6 +108,7 @@ var (
 		keys.DescriptorTableID: privilege.ReadData,
 		keys.UsersTableID:      privilege.ReadWriteData,
 		keys.ZonesTableID:      privilege.ReadWriteData,
+		keys.SettingsTableID:   privilege.ReadWriteData,
 	}
 
 	// NumSystemDescriptors should be set to the number of system descriptors
@@ -148,6 +162,7 @@ func addSystemDatabaseToSchema(target *MetadataSchema) {
 	target.AddSystemDescriptor(keys.SystemDatabaseID, &DescriptorTable)
 	target.AddSystemDescriptor(keys.SystemDatabaseID, &UsersTable)
 	target.AddSystemDescriptor(keys.SystemDatabaseID, &ZonesTable)
+	target.AddSystemDescriptor(keys.SystemDatabaseID, &SettingsTable)
 
 	// Add other system tables.
 	target.AddTable(leaseTableSchema,
@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/duration"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/gogo/protobuf/proto"
 )
@@ -361,7 +362,11 @@ func encodeTableKey(b []byte, val parser.Datum) ([]byte, *roachpb.Error) {
 	case parser.DTimestamp:
 		return encoding.EncodeTime(b, t.Time), nil
 	case parser.DInterval:
-		return encoding.EncodeVarint(b, int64(t.Duration)), nil
+		key, err := encoding.EncodeDuration(b, duration.Duration{Nanos: int64(t.Duration)})
+		if err != nil {
+			return nil, roachpb.NewError(err)
+		}
+		return key, nil
 	}
 	return nil, roachpb.NewUErrorf("unable to encode table key: %T", val)
 }
@@ -482,8 +487,14 @@ func decodeTableKey(valType parser.Datum, key []byte) (parser.Datum, []byte, err
 		rkey, t, err := encoding.DecodeTime(key)
 		return parser.DTimestamp{Time: t}, rkey, err
 	case parser.DInterval:
-		rkey, d, err := encoding.DecodeVarint(key)
-		return parser.DInterval{Duration: time.Duration(d)}, rkey, err
+		rkey, d, err := encoding.DecodeDuration(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if d.Months != 0 {
+			return nil, nil, util.Errorf("unexpected months in interval key: %+v", d)
+		}
+		return parser.DInterval{Duration: time.Duration(d.Days)*24*time.Hour + time.Duration(d.Nanos)}, rkey, nil
 	default:
 		return nil, nil, util.Errorf("TODO(pmattis): decoded index key: %s", valType.Type())
 	}
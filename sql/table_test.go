@@ -17,8 +17,10 @@
 package sql
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/testutils"
@@ -238,3 +240,44 @@ func TestPrimaryKeyUnspecified(t *testing.T) {
 		t.Fatalf("unexpected error: %s", pErr)
 	}
 }
+
+// TestEncodeTableKeyTimes verifies that timestamps and intervals in keys
+// round-trip and sort like the values.
+func TestEncodeTableKeyTimes(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := [][]parser.Datum{
+		{
+			parser.DTimestamp{Time: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			parser.DTimestamp{Time: time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC)},
+			parser.DTimestamp{Time: time.Unix(0, 0)},
+			parser.DTimestamp{Time: time.Date(9999, time.December, 31, 23, 59, 59, 999999999, time.UTC)},
+		},
+		{
+			parser.DInterval{Duration: -240 * time.Hour},
+			parser.DInterval{Duration: -time.Nanosecond},
+			parser.DInterval{Duration: 0},
+			parser.DInterval{Duration: 24 * time.Hour},
+			parser.DInterval{Duration: 25*time.Hour + time.Second},
+		},
+	}
+	for _, datums := range testCases {
+		var last []byte
+		for _, d := range datums {
+			key, pErr := encodeTableKey(nil, d)
+			if pErr != nil {
+				t.Fatal(pErr)
+			}
+			if last != nil && bytes.Compare(last, key) >= 0 {
+				t.Errorf("expected [% x] to sort before [% x] of %s", last, key, d)
+			}
+			last = key
+			decoded, rem, err := decodeTableKey(d, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rem) != 0 || decoded.Compare(d) != 0 {
+				t.Errorf("expected %s, got %s with remainder [% x]", d, decoded, rem)
+			}
+		}
+	}
+}
@@ -209,12 +209,17 @@ func (a *Allocator) ComputeAction(zone config.ZoneConfig, desc *roachpb.RangeDes
 	// TODO(mrtracy): Handle non-homogenous and mismatched attribute sets.
 	need := len(zone.ReplicaAttrs)
 	have := len(desc.Replicas)
-	if have < need {
+	// Replicas on decommissioning nodes are about to be removed, so they
+	// don't count towards the replicas the range has when deciding whether to
+	// add a replica. Once replacements have been added, the range is
+	// over-replicated and the decommissioning replicas are removed.
+	staying := have - len(a.storePool.decommissioningReplicas(desc.Replicas))
+	if staying < need {
 		// Range is under-replicated, and should add an additional replica.
 		// Priority is adjusted by the difference between the current replica
 		// count and the quorum of the desired replica count.
 		neededQuorum := computeQuorum(need)
-		return AllocatorAdd, addMissingReplicaPriority + float64(neededQuorum-have)
+		return AllocatorAdd, addMissingReplicaPriority + float64(neededQuorum-staying)
 	}
 	if have > need {
 		// Range is over-replicated, and should remove a replica.
@@ -260,7 +265,7 @@ func (a *Allocator) AllocateTarget(required roachpb.Attributes, existing []roach
 
 // RemoveTarget returns a suitable replica to remove from the provided replica
 // set. It attempts to consider which of the provided replicas would be the best
-// candidate for removal.
+// candidate for removal, preferring replicas on decommissioning nodes.
 //
 // TODO(mrtracy): removeTarget eventually needs to accept the attributes from
 // the zone config associated with the provided replicas. This will allow it to
@@ -271,6 +276,11 @@ func (a Allocator) RemoveTarget(existing []roachpb.ReplicaDescriptor) (roachpb.R
 		return roachpb.ReplicaDescriptor{}, util.Errorf("must supply at least one replica to allocator.RemoveTarget()")
 	}
 
+	// Replicas on decommissioning nodes are always removed first.
+	if repls := a.storePool.decommissioningReplicas(existing); len(repls) > 0 {
+		return repls[0], nil
+	}
+
 	// Retrieve store descriptors for the provided replicas from the StorePool.
 	sl := StoreList{}
 	for i := range existing {
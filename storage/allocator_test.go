@@ -20,8 +20,10 @@ package storage
 
 import (
 	"fmt"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/config"
@@ -29,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/testutils/gossiputil"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/stop"
@@ -652,6 +655,118 @@ func TestAllocatorRemoveTarget(t *testing.T) {
 	}
 }
 
+// TestAllocatorDecommissioning verifies that replicas on decommissioning
+// nodes are replaced and then removed, and that their stores are not used as
+// allocation targets.
+func TestAllocatorDecommissioning(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, _, sp, a := createTestAllocator()
+	defer stopper.Stop()
+
+	mockStorePool(sp, []roachpb.StoreID{1, 2, 3, 4}, nil)
+	sp.mu.Lock()
+	for storeID, detail := range sp.stores {
+		detail.desc.Node.NodeID = roachpb.NodeID(storeID)
+	}
+	sp.decommissioning = map[roachpb.NodeID]struct{}{3: {}}
+	sp.mu.Unlock()
+
+	zone := config.ZoneConfig{
+		ReplicaAttrs:  []roachpb.Attributes{{}, {}, {}},
+		RangeMaxBytes: 64000,
+	}
+	replicas := []roachpb.ReplicaDescriptor{
+		{StoreID: 1, NodeID: 1, ReplicaID: 1},
+		{StoreID: 2, NodeID: 2, ReplicaID: 2},
+		{StoreID: 3, NodeID: 3, ReplicaID: 3},
+	}
+
+	// The replica on the decommissioning node doesn't count, so a replacement
+	// is added first.
+	desc := roachpb.RangeDescriptor{Replicas: replicas}
+	if action, _ := a.ComputeAction(zone, &desc); action != AllocatorAdd {
+		t.Errorf("expected AllocatorAdd, got %d", action)
+	}
+	// The decommissioning node's store is never an allocation target, even
+	// for ranges without a replica on it.
+	for i := 0; i < 10; i++ {
+		target, err := a.AllocateTarget(roachpb.Attributes{}, replicas[:2], false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target.StoreID != 4 {
+			t.Fatalf("expected store 4 to be the allocation target, got %d", target.StoreID)
+		}
+	}
+
+	// Once the replacement has been added, the replica on the decommissioning
+	// node is removed.
+	desc.Replicas = append(desc.Replicas, roachpb.ReplicaDescriptor{StoreID: 4, NodeID: 4, ReplicaID: 4})
+	if action, _ := a.ComputeAction(zone, &desc); action != AllocatorRemove {
+		t.Errorf("expected AllocatorRemove, got %d", action)
+	}
+	removeRepl, err := a.RemoveTarget(desc.Replicas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removeRepl != replicas[2] {
+		t.Errorf("expected replica %v to be removed, got %v", replicas[2], removeRepl)
+	}
+}
+
+func TestAllocatorMaintenance(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, sp, a := createTestAllocator()
+	defer stopper.Stop()
+
+	mockStorePool(sp, []roachpb.StoreID{1, 2, 3}, nil)
+	sp.mu.Lock()
+	for storeID, detail := range sp.stores {
+		detail.desc.Node.NodeID = roachpb.NodeID(storeID)
+	}
+	sp.mu.Unlock()
+
+	setMaintenance := func(nodeID roachpb.NodeID, maintenance bool) {
+		val := strconv.FormatBool(maintenance)
+		if err := g.AddInfo(gossip.MakeNodeMaintenanceKey(nodeID), []byte(val), 0); err != nil {
+			t.Fatal(err)
+		}
+		util.SucceedsWithin(t, time.Second, func() error {
+			sp.mu.RLock()
+			defer sp.mu.RUnlock()
+			if _, ok := sp.maintenance[nodeID]; ok != maintenance {
+				return util.Errorf("expected maintenance mode of node %d to be %t", nodeID, maintenance)
+			}
+			return nil
+		})
+	}
+
+	existing := []roachpb.ReplicaDescriptor{{StoreID: 1, NodeID: 1, ReplicaID: 1}}
+
+	// No new replicas are placed on a node in maintenance mode.
+	setMaintenance(3, true)
+	for i := 0; i < 10; i++ {
+		target, err := a.AllocateTarget(roachpb.Attributes{}, existing, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target.StoreID != 2 {
+			t.Fatalf("expected store 2 to be the allocation target, got %d", target.StoreID)
+		}
+	}
+
+	// Once maintenance mode is cleared, the node is a target again.
+	setMaintenance(3, false)
+	existing = append(existing, roachpb.ReplicaDescriptor{StoreID: 2, NodeID: 2, ReplicaID: 2})
+	target, err := a.AllocateTarget(roachpb.Attributes{}, existing, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.StoreID != 3 {
+		t.Fatalf("expected store 3 to be the allocation target, got %d", target.StoreID)
+	}
+}
+
 func TestAllocatorComputeAction(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper, _, sp, a := createTestAllocator()
@@ -33,6 +33,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/testutils/gossiputil"
+	"github.com/cockroachdb/cockroach/testutils/testcluster"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
@@ -536,34 +537,21 @@ func TestReplicateAfterTruncation(t *testing.T) {
 // under-replicated ranges and replicate them.
 func TestStoreRangeUpReplicate(t *testing.T) {
 	defer leaktest.AfterTest(t)
-	mtc := startMultiTestContext(t, 3)
-	defer mtc.Stop()
+	tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{})
+	defer tc.Stop()
 
-	// Initialize the gossip network.
-	var wg sync.WaitGroup
-	wg.Add(len(mtc.stores))
-	key := gossip.MakePrefixPattern(gossip.KeyStorePrefix)
-	mtc.stores[0].Gossip().RegisterCallback(key, func(_ string, _ roachpb.Value) { wg.Done() })
-	for _, s := range mtc.stores {
-		s.GossipStore()
+	// The replicate queue of the first node should replicate every range to
+	// the other nodes once it learns about their stores through gossip.
+	if err := tc.WaitForFullReplication(); err != nil {
+		t.Fatal(err)
 	}
-	wg.Wait()
-
-	// Once we know our peers, trigger a scan.
-	mtc.stores[0].ForceReplicationScan()
-
-	// The range should become available on every node.
-	if err := util.IsTrueWithin(func() bool {
-		for _, s := range mtc.stores {
-			r := s.LookupReplica(roachpb.RKey("a"), roachpb.RKey("b"))
-			if r == nil {
-				return false
-			}
-		}
-		return true
-	}, replicationTimeout); err != nil {
+	desc, err := tc.LookupRange(roachpb.Key("a"))
+	if err != nil {
 		t.Fatal(err)
 	}
+	if a, e := len(desc.Replicas), 3; a != e {
+		t.Fatalf("expected %d replicas, got %+v", e, desc.Replicas)
+	}
 }
 
 // getRangeMetadata retrieves the current range descriptor for the target
@@ -1431,6 +1419,43 @@ func TestReplicateReAddAfterDown(t *testing.T) {
 	mtc.waitForValues(roachpb.Key("a"), 3*time.Second, []int64{16, 16, 16})
 }
 
+// TestStoreDrainingLeaderLease verifies that a draining store does not acquire
+// leader leases for ranges which have other replicas, and that it resumes
+// acquiring them once it is no longer draining.
+func TestStoreDrainingLeaderLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	mtc := startMultiTestContext(t, 2)
+	defer mtc.Stop()
+	raftID := roachpb.RangeID(1)
+	mtc.replicateRange(raftID, 0, 1)
+
+	store := mtc.stores[0]
+	if a, e := store.LeaderLeaseCount(), 1; a != e {
+		t.Fatalf("expected %d leader leases, got %d", e, a)
+	}
+	store.SetDraining(true)
+
+	// Force the read command to request a new lease.
+	clock := mtc.clocks[0]
+	header := roachpb.Header{}
+	header.Timestamp = clock.Update(clock.Now().Add(int64(storage.DefaultLeaderLeaseDuration), 0))
+
+	getArgs := getArgs([]byte("a"))
+	_, pErr := client.SendWrappedWith(rg1(store), nil, header, &getArgs)
+	if _, ok := pErr.GoError().(*roachpb.NotLeaderError); !ok {
+		t.Fatalf("expected not leader error; got %v", pErr)
+	}
+	if a, e := store.LeaderLeaseCount(), 0; a != e {
+		t.Fatalf("expected %d leader leases, got %d", e, a)
+	}
+
+	store.SetDraining(false)
+	if _, pErr := client.SendWrappedWith(rg1(store), nil, header, &getArgs); pErr != nil {
+		t.Fatal(pErr)
+	}
+}
+
 // TestLeaderRemoveSelf verifies that a leader can remove itself
 // without panicking and future access to the range returns a
 // RangeNotFoundError (not RaftGroupDeletedError, and even before
@@ -119,7 +119,7 @@ func createTestStoreWithEngine(t *testing.T, eng engine.Engine, clock *hlc.Clock
 		RangeDescriptorDB: localSender, // for descriptor lookup
 	}, sCtx.Gossip)
 
-	sender := kv.NewTxnCoordSender(distSender, clock, false, nil, stopper)
+	sender := kv.NewTxnCoordSender(distSender, clock, false, nil, stopper, nil)
 	sCtx.Clock = clock
 	sCtx.DB = client.NewDB(sender)
 	sCtx.Transport = storage.NewLocalRPCTransport(stopper)
@@ -227,7 +227,7 @@ func (m *multiTestContext) Start(t *testing.T, numStores int) {
 			RangeDescriptorDB: m.senders[0],
 			RPCSend:           m.rpcSend,
 		}, m.gossip)
-		sender := kv.NewTxnCoordSender(m.distSender, m.clock, false, nil, m.clientStopper)
+		sender := kv.NewTxnCoordSender(m.distSender, m.clock, false, nil, m.clientStopper, nil)
 		m.db = client.NewDB(sender)
 	}
 
@@ -17,10 +17,11 @@
 package storage
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/cockroachdb/cockroach/roachpb"
-	"github.com/cockroachdb/cockroach/util/cache"
+	"github.com/cockroachdb/cockroach/util/interval"
 )
 
 // A CommandQueue maintains an interval tree of keys or key ranges for
@@ -47,30 +48,45 @@ import (
 //
 // CommandQueue is not thread safe.
 type CommandQueue struct {
-	cache *cache.IntervalCache
+	tree  interval.Tree
+	idGen int64
 }
 
+// cmd is an executing command, affecting the key range of one of the
+// spans supplied to Add().
 type cmd struct {
-	readOnly bool
-	pending  []*sync.WaitGroup // Pending commands gated on cmd
+	id         int64
+	start, end interval.Comparable
+	readOnly   bool
+	pending    []*sync.WaitGroup // Pending commands gated on cmd
 }
 
-// NewCommandQueue returns a new command queue.
-func NewCommandQueue() *CommandQueue {
-	cq := &CommandQueue{
-		cache: cache.NewIntervalCache(cache.Config{Policy: cache.CacheNone}),
-	}
-	cq.cache.OnEvicted = cq.onEvicted
-	return cq
+// ID implements interval.Interface.
+func (c *cmd) ID() uintptr {
+	return uintptr(c.id)
 }
 
-// onEvicted is called when any entry is removed from the interval
-// tree. This happens on calls to Remove() and to Clear().
-func (cq *CommandQueue) onEvicted(key, value interface{}) {
-	c := value.(*cmd)
-	for _, wg := range c.pending {
-		wg.Done()
-	}
+// Start implements interval.Range.
+func (c *cmd) Start() interval.Comparable {
+	return c.start
+}
+
+// End implements interval.Range.
+func (c *cmd) End() interval.Comparable {
+	return c.end
+}
+
+// cmdKeyRange is the interval.Range of a key range queried by GetWait().
+type cmdKeyRange struct {
+	start, end interval.Comparable
+}
+
+func (r *cmdKeyRange) Start() interval.Comparable { return r.start }
+func (r *cmdKeyRange) End() interval.Comparable   { return r.end }
+
+// NewCommandQueue returns a new command queue.
+func NewCommandQueue() *CommandQueue {
+	return &CommandQueue{}
 }
 
 // GetWait initializes the supplied wait group with the number of executing
@@ -80,6 +96,18 @@ func (cq *CommandQueue) onEvicted(key, value interface{}) {
 // call Add() to add the keys to the command queue. readOnly is true if the
 // requester is a read-only command; false for read-write.
 func (cq *CommandQueue) GetWait(readOnly bool, wg *sync.WaitGroup, spans ...roachpb.Span) {
+	// The overlapping commands are visited in place, without collecting
+	// them first.
+	gate := func(i interval.Interface) (done bool) {
+		c := i.(*cmd)
+		// Only add to the wait group if one of the commands isn't read-only.
+		if !readOnly || !c.readOnly {
+			c.pending = append(c.pending, wg)
+			wg.Add(1)
+		}
+		return false
+	}
+	var r cmdKeyRange
 	for _, span := range spans {
 		// This gives us a memory-efficient end key if end is empty.
 		start, end := span.Key, span.EndKey
@@ -87,14 +115,8 @@ func (cq *CommandQueue) GetWait(readOnly bool, wg *sync.WaitGroup, spans ...roac
 			end = start.Next()
 			start = end[:len(start)]
 		}
-		for _, c := range cq.cache.GetOverlaps(start, end) {
-			c := c.Value.(*cmd)
-			// Only add to the wait group if one of the commands isn't read-only.
-			if !readOnly || !c.readOnly {
-				c.pending = append(c.pending, wg)
-				wg.Add(1)
-			}
-		}
+		r.start, r.end = start, end
+		cq.tree.DoMatching(gate, &r)
 	}
 }
 
@@ -106,15 +128,22 @@ func (cq *CommandQueue) GetWait(readOnly bool, wg *sync.WaitGroup, spans ...roac
 // Add should be invoked after waiting on already-executing, overlapping
 // commands via the WaitGroup initialized through GetWait().
 func (cq *CommandQueue) Add(readOnly bool, spans ...roachpb.Span) []interface{} {
-	var r []interface{}
-	for _, span := range spans {
+	// The commands of all the spans are allocated and inserted at once.
+	cmds := make([]cmd, len(spans))
+	es := make([]interval.Interface, len(spans))
+	r := make([]interface{}, len(spans))
+	for i, span := range spans {
 		start, end := span.Key, span.EndKey
 		if len(end) == 0 {
 			end = start.Next()
 		}
-		key := cq.cache.NewKey(start, end)
-		cq.cache.Add(key, &cmd{readOnly: readOnly})
-		r = append(r, key)
+		cq.idGen++
+		c := &cmds[i]
+		c.id, c.start, c.end, c.readOnly = cq.idGen, start, end, readOnly
+		es[i], r[i] = c, c
+	}
+	if err := cq.tree.InsertMany(es); err != nil {
+		panic(fmt.Sprintf("invalid span in %s: %s", spans, err))
 	}
 	return r
 }
@@ -130,11 +159,26 @@ func (cq *CommandQueue) Add(readOnly bool, spans ...roachpb.Span) []interface{}
 // against the underlying state machine.
 func (cq *CommandQueue) Remove(keys []interface{}) {
 	for _, k := range keys {
-		cq.cache.Del(k)
+		c := k.(*cmd)
+		if cq.tree.Delete(c) {
+			c.signal()
+		}
 	}
 }
 
 // Clear removes all executing commands, signaling any waiting commands.
 func (cq *CommandQueue) Clear() {
-	cq.cache.Clear()
+	cq.tree.Do(func(i interval.Interface) (done bool) {
+		i.(*cmd).signal()
+		return false
+	})
+	cq.tree = interval.Tree{}
+}
+
+// signal signals the commands gated on the removed command.
+func (c *cmd) signal() {
+	for _, wg := range c.pending {
+		wg.Done()
+	}
+	c.pending = nil
 }
@@ -17,6 +17,8 @@
 package storage
 
 import (
+	"fmt"
+	"math/rand"
 	"sync"
 	"testing"
 	"time"
@@ -209,3 +211,51 @@ func TestCommandQueueSelfOverlap(t *testing.T) {
 	cq.Remove([]interface{}{k})
 	wg.Wait()
 }
+
+// benchmarkCommandQueue simulates a command queue holding n commands of
+// the given number of spans each, a quarter of which are key ranges. Every
+// iteration removes the oldest command, waits on the commands overlapping
+// its spans and adds it back.
+func benchmarkCommandQueue(b *testing.B, n, spansPerCmd int) {
+	rng := rand.New(rand.NewSource(int64(n)))
+	cq := NewCommandQueue()
+	cmds := make([][]roachpb.Span, n)
+	keys := make([][]interface{}, n)
+	for i := range cmds {
+		cmds[i] = make([]roachpb.Span, spansPerCmd)
+		for j := range cmds[i] {
+			k := rng.Intn(100 * n)
+			span := &cmds[i][j]
+			span.Key = roachpb.Key(fmt.Sprintf("%08d", k))
+			if rng.Intn(4) == 0 {
+				span.EndKey = roachpb.Key(fmt.Sprintf("%08d", k+1+rng.Intn(100)))
+			}
+		}
+		keys[i] = cq.Add(rng.Intn(2) == 0, cmds[i]...)
+	}
+	var wg sync.WaitGroup
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		j := i % n
+		readOnly := i%2 == 0
+		cq.Remove(keys[j])
+		cq.GetWait(readOnly, &wg, cmds[j]...)
+		keys[j] = cq.Add(readOnly, cmds[j]...)
+	}
+}
+
+func BenchmarkCommandQueue100Cmds1Span(b *testing.B) {
+	benchmarkCommandQueue(b, 100, 1)
+}
+
+func BenchmarkCommandQueue100Cmds10Spans(b *testing.B) {
+	benchmarkCommandQueue(b, 100, 10)
+}
+
+func BenchmarkCommandQueue10000Cmds1Span(b *testing.B) {
+	benchmarkCommandQueue(b, 10000, 1)
+}
+
+func BenchmarkCommandQueue10000Cmds10Spans(b *testing.B) {
+	benchmarkCommandQueue(b, 10000, 10)
+}
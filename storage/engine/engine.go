@@ -119,6 +119,11 @@ type Engine interface {
 	Merge(key MVCCKey, value []byte) error
 	// Capacity returns capacity details for the engine's available storage.
 	Capacity() (roachpb.StoreCapacity, error)
+	// GetStats returns the cumulative statistics of the engine.
+	GetStats() (*Stats, error)
+	// Device returns an identifier of the device backing the engine's
+	// storage, or an empty string for an in-memory engine.
+	Device() (string, error)
 	// ApproximateSize returns the approximate number of bytes the engine is
 	// using to store data for the given range of keys.
 	ApproximateSize(start, end MVCCKey) (uint64, error)
@@ -153,6 +158,15 @@ type Engine interface {
 	Defer(fn func())
 }
 
+// Stats is a set of cumulative engine statistics.
+type Stats struct {
+	// WriteStalls is the number of times writes were stalled or slowed down
+	// because the engine fell behind on flushes or compactions.
+	WriteStalls int64
+	// WriteStallNanos is the total time writes spent stalled.
+	WriteStallNanos int64
+}
+
 var bufferPool = sync.Pool{
 	New: func() interface{} {
 		return proto.NewBuffer(nil)
@@ -22,7 +22,9 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/cockroachdb/cockroach/storage/engine/rocksdb"
@@ -53,6 +55,7 @@ type RocksDB struct {
 	dir            string             // The data directory
 	cacheSize      int64              // Memory to use to cache values.
 	memtableBudget int64              // Memory to use for the memory table.
+	readOnly       bool               // Open the database read-only.
 	stopper        *stop.Stopper
 	deallocated    chan struct{} // Closed when the underlying handle is deallocated.
 }
@@ -73,6 +76,15 @@ func NewRocksDB(attrs roachpb.Attributes, dir string, cacheSize, memtableBudget
 	}
 }
 
+// NewReadOnlyRocksDB allocates and returns a new RocksDB object which
+// opens the existing database in dir read-only. Writes to it will fail.
+func NewReadOnlyRocksDB(attrs roachpb.Attributes, dir string, cacheSize, memtableBudget int64,
+	stopper *stop.Stopper) *RocksDB {
+	r := NewRocksDB(attrs, dir, cacheSize, memtableBudget, stopper)
+	r.readOnly = true
+	return r
+}
+
 func newMemRocksDB(attrs roachpb.Attributes, cacheSize, memtableBudget int64,
 	stopper *stop.Stopper) *RocksDB {
 	return &RocksDB{
@@ -111,6 +123,7 @@ func (r *RocksDB) Open() error {
 			memtable_budget: C.int64_t(r.memtableBudget),
 			allow_os_buffer: C.bool(true),
 			logging_enabled: C.bool(log.V(3)),
+			read_only:       C.bool(r.readOnly),
 		})
 	err := statusToError(status)
 	if err != nil {
@@ -212,6 +225,31 @@ func (r *RocksDB) Capacity() (roachpb.StoreCapacity, error) {
 	return capacity, nil
 }
 
+// GetStats retrieves the write stall statistics of the RocksDB instance.
+func (r *RocksDB) GetStats() (*Stats, error) {
+	var s C.DBStallStats
+	if err := statusToError(C.DBGetStallStats(r.rdb, &s)); err != nil {
+		return nil, err
+	}
+	return &Stats{
+		WriteStalls:     int64(s.stall_count),
+		WriteStallNanos: int64(s.stall_micros) * int64(time.Microsecond),
+	}, nil
+}
+
+// Device returns the ID of the device containing the RocksDB directory.
+// Engines sharing a device contend for its bandwidth.
+func (r *RocksDB) Device() (string, error) {
+	if r.dir == "" {
+		return "", nil
+	}
+	var st syscall.Stat_t
+	if err := syscall.Stat(r.dir, &st); err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(uint64(st.Dev), 10), nil
+}
+
 // CompactRange compacts the specified key range. Specifying nil for
 // the start key starts the compaction from the start of the database.
 // Similarly, specifying nil for the end key will compact through the
@@ -342,6 +380,16 @@ func (r *rocksDBSnapshot) Capacity() (roachpb.StoreCapacity, error) {
 	return r.parent.Capacity()
 }
 
+// GetStats returns the statistics of the underlying engine.
+func (r *rocksDBSnapshot) GetStats() (*Stats, error) {
+	return r.parent.GetStats()
+}
+
+// Device returns the device of the underlying engine.
+func (r *rocksDBSnapshot) Device() (string, error) {
+	return r.parent.Device()
+}
+
 // ApproximateSize returns the approximate number of bytes the engine is
 // using to store data for the given range of keys.
 func (r *rocksDBSnapshot) ApproximateSize(start, end MVCCKey) (uint64, error) {
@@ -436,6 +484,14 @@ func (r *rocksDBBatch) Capacity() (roachpb.StoreCapacity, error) {
 	return r.parent.Capacity()
 }
 
+func (r *rocksDBBatch) GetStats() (*Stats, error) {
+	return r.parent.GetStats()
+}
+
+func (r *rocksDBBatch) Device() (string, error) {
+	return r.parent.Device()
+}
+
 func (r *rocksDBBatch) ApproximateSize(start, end MVCCKey) (uint64, error) {
 	return r.parent.ApproximateSize(start, end)
 }
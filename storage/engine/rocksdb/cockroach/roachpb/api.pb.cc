@@ -97,6 +97,12 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* AdminMergeResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   AdminMergeResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminScatterRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminScatterRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminScatterResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminScatterResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* RangeLookupRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RangeLookupRequest_reflection_ = NULL;
@@ -160,6 +166,21 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* LeaderLeaseResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   LeaderLeaseResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RaftStatusRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RaftStatusRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RaftProgress_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RaftProgress_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RaftStatusResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RaftStatusResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RangeStatsRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RangeStatsRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RangeStatsResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RangeStatsResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* RequestUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RequestUnion_reflection_ = NULL;
@@ -585,7 +606,38 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(AdminMergeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeResponse, _internal_metadata_),
       -1);
-  RangeLookupRequest_descriptor_ = file->message_type(25);
+  AdminScatterRequest_descriptor_ = file->message_type(25);
+  static const int AdminScatterRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminScatterRequest, header_),
+  };
+  AdminScatterRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      AdminScatterRequest_descriptor_,
+      AdminScatterRequest::default_instance_,
+      AdminScatterRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminScatterRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(AdminScatterRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminScatterRequest, _internal_metadata_),
+      -1);
+  AdminScatterResponse_descriptor_ = file->message_type(26);
+  static const int AdminScatterResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminScatterResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminScatterResponse, desc_),
+  };
+  AdminScatterResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      AdminScatterResponse_descriptor_,
+      AdminScatterResponse::default_instance_,
+      AdminScatterResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminScatterResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(AdminScatterResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminScatterResponse, _internal_metadata_),
+      -1);
+  RangeLookupRequest_descriptor_ = file->message_type(27);
   static const int RangeLookupRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupRequest, max_ranges_),
@@ -603,7 +655,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(RangeLookupRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupRequest, _internal_metadata_),
       -1);
-  RangeLookupResponse_descriptor_ = file->message_type(26);
+  RangeLookupResponse_descriptor_ = file->message_type(28);
   static const int RangeLookupResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupResponse, ranges_),
@@ -619,7 +671,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(RangeLookupResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupResponse, _internal_metadata_),
       -1);
-  HeartbeatTxnRequest_descriptor_ = file->message_type(27);
+  HeartbeatTxnRequest_descriptor_ = file->message_type(29);
   static const int HeartbeatTxnRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnRequest, header_),
   };
@@ -634,7 +686,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(HeartbeatTxnRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnRequest, _internal_metadata_),
       -1);
-  HeartbeatTxnResponse_descriptor_ = file->message_type(28);
+  HeartbeatTxnResponse_descriptor_ = file->message_type(30);
   static const int HeartbeatTxnResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnResponse, header_),
   };
@@ -649,7 +701,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(HeartbeatTxnResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnResponse, _internal_metadata_),
       -1);
-  GCRequest_descriptor_ = file->message_type(29);
+  GCRequest_descriptor_ = file->message_type(31);
   static const int GCRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCRequest, keys_),
@@ -681,7 +733,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(GCRequest_GCKey),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCRequest_GCKey, _internal_metadata_),
       -1);
-  GCResponse_descriptor_ = file->message_type(30);
+  GCResponse_descriptor_ = file->message_type(32);
   static const int GCResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCResponse, header_),
   };
@@ -696,7 +748,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(GCResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCResponse, _internal_metadata_),
       -1);
-  PushTxnRequest_descriptor_ = file->message_type(31);
+  PushTxnRequest_descriptor_ = file->message_type(33);
   static const int PushTxnRequest_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnRequest, pusher_txn_),
@@ -716,7 +768,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(PushTxnRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnRequest, _internal_metadata_),
       -1);
-  PushTxnResponse_descriptor_ = file->message_type(32);
+  PushTxnResponse_descriptor_ = file->message_type(34);
   static const int PushTxnResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnResponse, pushee_txn_),
@@ -732,7 +784,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(PushTxnResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnResponse, _internal_metadata_),
       -1);
-  ResolveIntentRequest_descriptor_ = file->message_type(33);
+  ResolveIntentRequest_descriptor_ = file->message_type(35);
   static const int ResolveIntentRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRequest, intent_txn_),
@@ -749,7 +801,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRequest, _internal_metadata_),
       -1);
-  ResolveIntentResponse_descriptor_ = file->message_type(34);
+  ResolveIntentResponse_descriptor_ = file->message_type(36);
   static const int ResolveIntentResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentResponse, header_),
   };
@@ -764,7 +816,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentResponse, _internal_metadata_),
       -1);
-  ResolveIntentRangeRequest_descriptor_ = file->message_type(35);
+  ResolveIntentRangeRequest_descriptor_ = file->message_type(37);
   static const int ResolveIntentRangeRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeRequest, intent_txn_),
@@ -781,7 +833,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentRangeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeRequest, _internal_metadata_),
       -1);
-  NoopResponse_descriptor_ = file->message_type(36);
+  NoopResponse_descriptor_ = file->message_type(38);
   static const int NoopResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopResponse, header_),
   };
@@ -796,7 +848,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(NoopResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopResponse, _internal_metadata_),
       -1);
-  NoopRequest_descriptor_ = file->message_type(37);
+  NoopRequest_descriptor_ = file->message_type(39);
   static const int NoopRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopRequest, header_),
   };
@@ -811,7 +863,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(NoopRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopRequest, _internal_metadata_),
       -1);
-  ResolveIntentRangeResponse_descriptor_ = file->message_type(38);
+  ResolveIntentRangeResponse_descriptor_ = file->message_type(40);
   static const int ResolveIntentRangeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeResponse, header_),
   };
@@ -826,7 +878,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentRangeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeResponse, _internal_metadata_),
       -1);
-  MergeRequest_descriptor_ = file->message_type(39);
+  MergeRequest_descriptor_ = file->message_type(41);
   static const int MergeRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeRequest, value_),
@@ -842,7 +894,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(MergeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeRequest, _internal_metadata_),
       -1);
-  MergeResponse_descriptor_ = file->message_type(40);
+  MergeResponse_descriptor_ = file->message_type(42);
   static const int MergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeResponse, header_),
   };
@@ -857,7 +909,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(MergeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeResponse, _internal_metadata_),
       -1);
-  TruncateLogRequest_descriptor_ = file->message_type(41);
+  TruncateLogRequest_descriptor_ = file->message_type(43);
   static const int TruncateLogRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogRequest, index_),
@@ -874,7 +926,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(TruncateLogRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogRequest, _internal_metadata_),
       -1);
-  TruncateLogResponse_descriptor_ = file->message_type(42);
+  TruncateLogResponse_descriptor_ = file->message_type(44);
   static const int TruncateLogResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogResponse, header_),
   };
@@ -889,7 +941,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(TruncateLogResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogResponse, _internal_metadata_),
       -1);
-  LeaderLeaseRequest_descriptor_ = file->message_type(43);
+  LeaderLeaseRequest_descriptor_ = file->message_type(45);
   static const int LeaderLeaseRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseRequest, lease_),
@@ -905,7 +957,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(LeaderLeaseRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseRequest, _internal_metadata_),
       -1);
-  LeaderLeaseResponse_descriptor_ = file->message_type(44);
+  LeaderLeaseResponse_descriptor_ = file->message_type(46);
   static const int LeaderLeaseResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseResponse, header_),
   };
@@ -920,8 +972,100 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(LeaderLeaseResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseResponse, _internal_metadata_),
       -1);
-  RequestUnion_descriptor_ = file->message_type(45);
-  static const int RequestUnion_offsets_[22] = {
+  RaftStatusRequest_descriptor_ = file->message_type(47);
+  static const int RaftStatusRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusRequest, header_),
+  };
+  RaftStatusRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RaftStatusRequest_descriptor_,
+      RaftStatusRequest::default_instance_,
+      RaftStatusRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RaftStatusRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusRequest, _internal_metadata_),
+      -1);
+  RaftProgress_descriptor_ = file->message_type(48);
+  static const int RaftProgress_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftProgress, replica_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftProgress, match_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftProgress, next_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftProgress, state_),
+  };
+  RaftProgress_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RaftProgress_descriptor_,
+      RaftProgress::default_instance_,
+      RaftProgress_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftProgress, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RaftProgress),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftProgress, _internal_metadata_),
+      -1);
+  RaftStatusResponse_descriptor_ = file->message_type(49);
+  static const int RaftStatusResponse_offsets_[9] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, range_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, replica_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, term_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, commit_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, applied_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, lead_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, state_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, progress_),
+  };
+  RaftStatusResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RaftStatusResponse_descriptor_,
+      RaftStatusResponse::default_instance_,
+      RaftStatusResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RaftStatusResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftStatusResponse, _internal_metadata_),
+      -1);
+  RangeStatsRequest_descriptor_ = file->message_type(50);
+  static const int RangeStatsRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsRequest, header_),
+  };
+  RangeStatsRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RangeStatsRequest_descriptor_,
+      RangeStatsRequest::default_instance_,
+      RangeStatsRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RangeStatsRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsRequest, _internal_metadata_),
+      -1);
+  RangeStatsResponse_descriptor_ = file->message_type(51);
+  static const int RangeStatsResponse_offsets_[7] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, range_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, desc_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, live_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, live_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, key_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, gc_threshold_),
+  };
+  RangeStatsResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RangeStatsResponse_descriptor_,
+      RangeStatsResponse::default_instance_,
+      RangeStatsResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RangeStatsResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeStatsResponse, _internal_metadata_),
+      -1);
+  RequestUnion_descriptor_ = file->message_type(52);
+  static const int RequestUnion_offsets_[25] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, get_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, put_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, conditional_put_),
@@ -944,6 +1088,9 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, leader_lease_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, reverse_scan_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, noop_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, raft_status_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, range_stats_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, admin_scatter_),
   };
   RequestUnion_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -956,8 +1103,8 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(RequestUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, _internal_metadata_),
       -1);
-  ResponseUnion_descriptor_ = file->message_type(46);
-  static const int ResponseUnion_offsets_[22] = {
+  ResponseUnion_descriptor_ = file->message_type(53);
+  static const int ResponseUnion_offsets_[25] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, get_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, put_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, conditional_put_),
@@ -980,6 +1127,9 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, leader_lease_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, reverse_scan_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, noop_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, raft_status_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, range_stats_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, admin_scatter_),
   };
   ResponseUnion_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -992,14 +1142,15 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResponseUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, _internal_metadata_),
       -1);
-  Header_descriptor_ = file->message_type(47);
-  static const int Header_offsets_[6] = {
+  Header_descriptor_ = file->message_type(54);
+  static const int Header_offsets_[7] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, replica_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, range_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, user_priority_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, read_consistency_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, trace_context_),
   };
   Header_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -1012,7 +1163,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(Header),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, _internal_metadata_),
       -1);
-  BatchRequest_descriptor_ = file->message_type(48);
+  BatchRequest_descriptor_ = file->message_type(55);
   static const int BatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, requests_),
@@ -1028,7 +1179,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(BatchRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, _internal_metadata_),
       -1);
-  BatchResponse_descriptor_ = file->message_type(49);
+  BatchResponse_descriptor_ = file->message_type(56);
   static const int BatchResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, responses_),
@@ -1125,6 +1276,10 @@ void protobuf_RegisterTypes(const ::std::string&) {
       AdminMergeRequest_descriptor_, &AdminMergeRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       AdminMergeResponse_descriptor_, &AdminMergeResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      AdminScatterRequest_descriptor_, &AdminScatterRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      AdminScatterResponse_descriptor_, &AdminScatterResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       RangeLookupRequest_descriptor_, &RangeLookupRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -1167,6 +1322,16 @@ void protobuf_RegisterTypes(const ::std::string&) {
       LeaderLeaseRequest_descriptor_, &LeaderLeaseRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       LeaderLeaseResponse_descriptor_, &LeaderLeaseResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RaftStatusRequest_descriptor_, &RaftStatusRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RaftProgress_descriptor_, &RaftProgress::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RaftStatusResponse_descriptor_, &RaftStatusResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RangeStatsRequest_descriptor_, &RangeStatsRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RangeStatsResponse_descriptor_, &RangeStatsResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       RequestUnion_descriptor_, &RequestUnion::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -1234,6 +1399,10 @@ void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto() {
   delete AdminMergeRequest_reflection_;
   delete AdminMergeResponse::default_instance_;
   delete AdminMergeResponse_reflection_;
+  delete AdminScatterRequest::default_instance_;
+  delete AdminScatterRequest_reflection_;
+  delete AdminScatterResponse::default_instance_;
+  delete AdminScatterResponse_reflection_;
   delete RangeLookupRequest::default_instance_;
   delete RangeLookupRequest_reflection_;
   delete RangeLookupResponse::default_instance_;
@@ -1276,6 +1445,16 @@ void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto() {
   delete LeaderLeaseRequest_reflection_;
   delete LeaderLeaseResponse::default_instance_;
   delete LeaderLeaseResponse_reflection_;
+  delete RaftStatusRequest::default_instance_;
+  delete RaftStatusRequest_reflection_;
+  delete RaftProgress::default_instance_;
+  delete RaftProgress_reflection_;
+  delete RaftStatusResponse::default_instance_;
+  delete RaftStatusResponse_reflection_;
+  delete RangeStatsRequest::default_instance_;
+  delete RangeStatsRequest_reflection_;
+  delete RangeStatsResponse::default_instance_;
+  delete RangeStatsResponse_reflection_;
   delete RequestUnion::default_instance_;
   delete RequestUnion_reflection_;
   delete ResponseUnion::default_instance_;
@@ -1372,157 +1551,196 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
     "st\0221\n\006header\030\001 \001(\0132\027.cockroach.roachpb.S"
     "panB\010\310\336\037\000\320\336\037\001\"Q\n\022AdminMergeResponse\022;\n\006h"
     "eader\030\001 \001(\0132!.cockroach.roachpb.Response"
-    "HeaderB\010\310\336\037\000\320\336\037\001\"\230\001\n\022RangeLookupRequest\022"
+    "HeaderB\010\310\336\037\000\320\336\037\001\"H\n\023AdminScatterRequest\022"
     "1\n\006header\030\001 \001(\0132\027.cockroach.roachpb.Span"
-    "B\010\310\336\037\000\320\336\037\001\022\030\n\nmax_ranges\030\002 \001(\005B\004\310\336\037\000\022\036\n\020"
-    "consider_intents\030\003 \001(\010B\004\310\336\037\000\022\025\n\007reverse\030"
-    "\004 \001(\010B\004\310\336\037\000\"\214\001\n\023RangeLookupResponse\022;\n\006h"
+    "B\010\310\336\037\000\320\336\037\001\"\213\001\n\024AdminScatterResponse\022;\n\006h"
     "eader\030\001 \001(\0132!.cockroach.roachpb.Response"
-    "HeaderB\010\310\336\037\000\320\336\037\001\0228\n\006ranges\030\002 \003(\0132\".cockr"
-    "oach.roachpb.RangeDescriptorB\004\310\336\037\000\"H\n\023He"
-    "artbeatTxnRequest\0221\n\006header\030\001 \001(\0132\027.cock"
-    "roach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\"S\n\024Heartbea"
-    "tTxnResponse\022;\n\006header\030\001 \001(\0132!.cockroach"
-    ".roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\314\001\n\tGC"
-    "Request\0221\n\006header\030\001 \001(\0132\027.cockroach.roac"
-    "hpb.SpanB\010\310\336\037\000\320\336\037\001\0226\n\004keys\030\003 \003(\0132\".cockr"
-    "oach.roachpb.GCRequest.GCKeyB\004\310\336\037\000\032T\n\005GC"
-    "Key\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Key\0225\n\ttimestamp\030\002"
-    " \001(\0132\034.cockroach.roachpb.TimestampB\004\310\336\037\000"
-    "\"I\n\nGCResponse\022;\n\006header\030\001 \001(\0132!.cockroa"
-    "ch.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\326\002\n\016"
-    "PushTxnRequest\0221\n\006header\030\001 \001(\0132\027.cockroa"
-    "ch.roachpb.SpanB\010\310\336\037\000\320\336\037\001\0228\n\npusher_txn\030"
-    "\002 \001(\0132\036.cockroach.roachpb.TransactionB\004\310"
-    "\336\037\000\0228\n\npushee_txn\030\003 \001(\0132\036.cockroach.roac"
-    "hpb.TransactionB\004\310\336\037\000\0223\n\007push_to\030\004 \001(\0132\034"
-    ".cockroach.roachpb.TimestampB\004\310\336\037\000\022/\n\003no"
-    "w\030\005 \001(\0132\034.cockroach.roachpb.TimestampB\004\310"
-    "\336\037\000\0227\n\tpush_type\030\006 \001(\0162\036.cockroach.roach"
-    "pb.PushTxnTypeB\004\310\336\037\000\"\210\001\n\017PushTxnResponse"
-    "\022;\n\006header\030\001 \001(\0132!.cockroach.roachpb.Res"
-    "ponseHeaderB\010\310\336\037\000\320\336\037\001\0228\n\npushee_txn\030\002 \001("
-    "\0132\036.cockroach.roachpb.TransactionB\004\310\336\037\000\""
-    "\231\001\n\024ResolveIntentRequest\0221\n\006header\030\001 \001(\013"
-    "2\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\0228\n\ni"
-    "ntent_txn\030\002 \001(\0132\036.cockroach.roachpb.Tran"
-    "sactionB\004\310\336\037\000\022\024\n\006poison\030\003 \001(\010B\004\310\336\037\000\"T\n\025R"
-    "esolveIntentResponse\022;\n\006header\030\001 \001(\0132!.c"
-    "ockroach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037"
-    "\001\"\236\001\n\031ResolveIntentRangeRequest\0221\n\006heade"
-    "r\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336"
-    "\037\001\0228\n\nintent_txn\030\002 \001(\0132\036.cockroach.roach"
-    "pb.TransactionB\004\310\336\037\000\022\024\n\006poison\030\003 \001(\010B\004\310\336"
-    "\037\000\"K\n\014NoopResponse\022;\n\006header\030\001 \001(\0132!.coc"
-    "kroach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\""
-    "@\n\013NoopRequest\0221\n\006header\030\001 \001(\0132\027.cockroa"
-    "ch.roachpb.SpanB\010\310\336\037\000\320\336\037\001\"Y\n\032ResolveInte"
-    "ntRangeResponse\022;\n\006header\030\001 \001(\0132!.cockro"
-    "ach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"p\n\014"
-    "MergeRequest\0221\n\006header\030\001 \001(\0132\027.cockroach"
-    ".roachpb.SpanB\010\310\336\037\000\320\336\037\001\022-\n\005value\030\002 \001(\0132\030"
-    ".cockroach.roachpb.ValueB\004\310\336\037\000\"L\n\rMergeR"
-    "esponse\022;\n\006header\030\001 \001(\0132!.cockroach.roac"
-    "hpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\212\001\n\022Truncat"
-    "eLogRequest\0221\n\006header\030\001 \001(\0132\027.cockroach."
-    "roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030\002 \001(\004B\004\310"
-    "\336\037\000\022,\n\010range_id\030\003 \001(\003B\032\310\336\037\000\342\336\037\007RangeID\372\336"
-    "\037\007RangeID\"R\n\023TruncateLogResponse\022;\n\006head"
-    "er\030\001 \001(\0132!.cockroach.roachpb.ResponseHea"
-    "derB\010\310\336\037\000\320\336\037\001\"v\n\022LeaderLeaseRequest\0221\n\006h"
-    "eader\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336"
-    "\037\000\320\336\037\001\022-\n\005lease\030\002 \001(\0132\030.cockroach.roachp"
-    "b.LeaseB\004\310\336\037\000\"R\n\023LeaderLeaseResponse\022;\n\006"
+    "HeaderB\010\310\336\037\000\320\336\037\001\0226\n\004desc\030\002 \001(\0132\".cockroa"
+    "ch.roachpb.RangeDescriptorB\004\310\336\037\000\"\230\001\n\022Ran"
+    "geLookupRequest\0221\n\006header\030\001 \001(\0132\027.cockro"
+    "ach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\030\n\nmax_ranges"
+    "\030\002 \001(\005B\004\310\336\037\000\022\036\n\020consider_intents\030\003 \001(\010B\004"
+    "\310\336\037\000\022\025\n\007reverse\030\004 \001(\010B\004\310\336\037\000\"\214\001\n\023RangeLoo"
+    "kupResponse\022;\n\006header\030\001 \001(\0132!.cockroach."
+    "roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0228\n\006rang"
+    "es\030\002 \003(\0132\".cockroach.roachpb.RangeDescri"
+    "ptorB\004\310\336\037\000\"H\n\023HeartbeatTxnRequest\0221\n\006hea"
+    "der\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000"
+    "\320\336\037\001\"S\n\024HeartbeatTxnResponse\022;\n\006header\030\001"
+    " \001(\0132!.cockroach.roachpb.ResponseHeaderB"
+    "\010\310\336\037\000\320\336\037\001\"\314\001\n\tGCRequest\0221\n\006header\030\001 \001(\0132"
+    "\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\0226\n\004ke"
+    "ys\030\003 \003(\0132\".cockroach.roachpb.GCRequest.G"
+    "CKeyB\004\310\336\037\000\032T\n\005GCKey\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Ke"
+    "y\0225\n\ttimestamp\030\002 \001(\0132\034.cockroach.roachpb"
+    ".TimestampB\004\310\336\037\000\"I\n\nGCResponse\022;\n\006header"
+    "\030\001 \001(\0132!.cockroach.roachpb.ResponseHeade"
+    "rB\010\310\336\037\000\320\336\037\001\"\326\002\n\016PushTxnRequest\0221\n\006header"
+    "\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037"
+    "\001\0228\n\npusher_txn\030\002 \001(\0132\036.cockroach.roachp"
+    "b.TransactionB\004\310\336\037\000\0228\n\npushee_txn\030\003 \001(\0132"
+    "\036.cockroach.roachpb.TransactionB\004\310\336\037\000\0223\n"
+    "\007push_to\030\004 \001(\0132\034.cockroach.roachpb.Times"
+    "tampB\004\310\336\037\000\022/\n\003now\030\005 \001(\0132\034.cockroach.roac"
+    "hpb.TimestampB\004\310\336\037\000\0227\n\tpush_type\030\006 \001(\0162\036"
+    ".cockroach.roachpb.PushTxnTypeB\004\310\336\037\000\"\210\001\n"
+    "\017PushTxnResponse\022;\n\006header\030\001 \001(\0132!.cockr"
+    "oach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0228\n"
+    "\npushee_txn\030\002 \001(\0132\036.cockroach.roachpb.Tr"
+    "ansactionB\004\310\336\037\000\"\231\001\n\024ResolveIntentRequest"
+    "\0221\n\006header\030\001 \001(\0132\027.cockroach.roachpb.Spa"
+    "nB\010\310\336\037\000\320\336\037\001\0228\n\nintent_txn\030\002 \001(\0132\036.cockro"
+    "ach.roachpb.TransactionB\004\310\336\037\000\022\024\n\006poison\030"
+    "\003 \001(\010B\004\310\336\037\000\"T\n\025ResolveIntentResponse\022;\n\006"
     "header\030\001 \001(\0132!.cockroach.roachpb.Respons"
-    "eHeaderB\010\310\336\037\000\320\336\037\001\"\201\n\n\014RequestUnion\022*\n\003ge"
-    "t\030\001 \001(\0132\035.cockroach.roachpb.GetRequest\022*"
-    "\n\003put\030\002 \001(\0132\035.cockroach.roachpb.PutReque"
-    "st\022A\n\017conditional_put\030\003 \001(\0132(.cockroach."
-    "roachpb.ConditionalPutRequest\0226\n\tincreme"
-    "nt\030\004 \001(\0132#.cockroach.roachpb.IncrementRe"
-    "quest\0220\n\006delete\030\005 \001(\0132 .cockroach.roachp"
-    "b.DeleteRequest\022;\n\014delete_range\030\006 \001(\0132%."
-    "cockroach.roachpb.DeleteRangeRequest\022,\n\004"
-    "scan\030\007 \001(\0132\036.cockroach.roachpb.ScanReque"
-    "st\022E\n\021begin_transaction\030\010 \001(\0132*.cockroac"
-    "h.roachpb.BeginTransactionRequest\022A\n\017end"
-    "_transaction\030\t \001(\0132(.cockroach.roachpb.E"
-    "ndTransactionRequest\0229\n\013admin_split\030\n \001("
-    "\0132$.cockroach.roachpb.AdminSplitRequest\022"
-    "9\n\013admin_merge\030\013 \001(\0132$.cockroach.roachpb"
-    ".AdminMergeRequest\022=\n\rheartbeat_txn\030\014 \001("
-    "\0132&.cockroach.roachpb.HeartbeatTxnReques"
-    "t\022(\n\002gc\030\r \001(\0132\034.cockroach.roachpb.GCRequ"
-    "est\0223\n\010push_txn\030\016 \001(\0132!.cockroach.roachp"
-    "b.PushTxnRequest\022;\n\014range_lookup\030\017 \001(\0132%"
-    ".cockroach.roachpb.RangeLookupRequest\022\?\n"
-    "\016resolve_intent\030\020 \001(\0132\'.cockroach.roachp"
-    "b.ResolveIntentRequest\022J\n\024resolve_intent"
-    "_range\030\021 \001(\0132,.cockroach.roachpb.Resolve"
-    "IntentRangeRequest\022.\n\005merge\030\022 \001(\0132\037.cock"
-    "roach.roachpb.MergeRequest\022;\n\014truncate_l"
-    "og\030\023 \001(\0132%.cockroach.roachpb.TruncateLog"
-    "Request\022;\n\014leader_lease\030\024 \001(\0132%.cockroac"
-    "h.roachpb.LeaderLeaseRequest\022;\n\014reverse_"
-    "scan\030\025 \001(\0132%.cockroach.roachpb.ReverseSc"
-    "anRequest\022,\n\004noop\030\026 \001(\0132\036.cockroach.roac"
-    "hpb.NoopRequest:\004\310\240\037\001\"\230\n\n\rResponseUnion\022"
-    "+\n\003get\030\001 \001(\0132\036.cockroach.roachpb.GetResp"
-    "onse\022+\n\003put\030\002 \001(\0132\036.cockroach.roachpb.Pu"
-    "tResponse\022B\n\017conditional_put\030\003 \001(\0132).coc"
-    "kroach.roachpb.ConditionalPutResponse\0227\n"
-    "\tincrement\030\004 \001(\0132$.cockroach.roachpb.Inc"
-    "rementResponse\0221\n\006delete\030\005 \001(\0132!.cockroa"
-    "ch.roachpb.DeleteResponse\022<\n\014delete_rang"
-    "e\030\006 \001(\0132&.cockroach.roachpb.DeleteRangeR"
-    "esponse\022-\n\004scan\030\007 \001(\0132\037.cockroach.roachp"
-    "b.ScanResponse\022F\n\021begin_transaction\030\010 \001("
-    "\0132+.cockroach.roachpb.BeginTransactionRe"
-    "sponse\022B\n\017end_transaction\030\t \001(\0132).cockro"
-    "ach.roachpb.EndTransactionResponse\022:\n\013ad"
-    "min_split\030\n \001(\0132%.cockroach.roachpb.Admi"
-    "nSplitResponse\022:\n\013admin_merge\030\013 \001(\0132%.co"
-    "ckroach.roachpb.AdminMergeResponse\022>\n\rhe"
-    "artbeat_txn\030\014 \001(\0132\'.cockroach.roachpb.He"
-    "artbeatTxnResponse\022)\n\002gc\030\r \001(\0132\035.cockroa"
-    "ch.roachpb.GCResponse\0224\n\010push_txn\030\016 \001(\0132"
-    "\".cockroach.roachpb.PushTxnResponse\022<\n\014r"
-    "ange_lookup\030\017 \001(\0132&.cockroach.roachpb.Ra"
-    "ngeLookupResponse\022@\n\016resolve_intent\030\020 \001("
-    "\0132(.cockroach.roachpb.ResolveIntentRespo"
-    "nse\022K\n\024resolve_intent_range\030\021 \001(\0132-.cock"
-    "roach.roachpb.ResolveIntentRangeResponse"
-    "\022/\n\005merge\030\022 \001(\0132 .cockroach.roachpb.Merg"
-    "eResponse\022<\n\014truncate_log\030\023 \001(\0132&.cockro"
-    "ach.roachpb.TruncateLogResponse\022<\n\014leade"
-    "r_lease\030\024 \001(\0132&.cockroach.roachpb.Leader"
-    "LeaseResponse\022<\n\014reverse_scan\030\025 \001(\0132&.co"
-    "ckroach.roachpb.ReverseScanResponse\022-\n\004n"
-    "oop\030\026 \001(\0132\037.cockroach.roachpb.NoopRespon"
-    "se:\004\310\240\037\001\"\274\002\n\006Header\0225\n\ttimestamp\030\001 \001(\0132\034"
-    ".cockroach.roachpb.TimestampB\004\310\336\037\000\022;\n\007re"
-    "plica\030\002 \001(\0132$.cockroach.roachpb.ReplicaD"
-    "escriptorB\004\310\336\037\000\022,\n\010range_id\030\003 \001(\003B\032\310\336\037\000\342"
-    "\336\037\007RangeID\372\336\037\007RangeID\022\033\n\ruser_priority\030\004"
-    " \001(\001B\004\310\336\037\000\022+\n\003txn\030\005 \001(\0132\036.cockroach.roac"
-    "hpb.Transaction\022F\n\020read_consistency\030\006 \001("
-    "\0162&.cockroach.roachpb.ReadConsistencyTyp"
-    "eB\004\310\336\037\000\"\202\001\n\014BatchRequest\0223\n\006header\030\001 \001(\013"
-    "2\031.cockroach.roachpb.HeaderB\010\310\336\037\000\320\336\037\001\0227\n"
-    "\010requests\030\002 \003(\0132\037.cockroach.roachpb.Requ"
-    "estUnionB\004\310\336\037\000:\004\230\240\037\000\"\253\002\n\rBatchResponse\022A"
-    "\n\006header\030\001 \001(\0132\'.cockroach.roachpb.Batch"
-    "Response.HeaderB\010\310\336\037\000\320\336\037\001\0229\n\tresponses\030\002"
-    " \003(\0132 .cockroach.roachpb.ResponseUnionB\004"
-    "\310\336\037\000\032\225\001\n\006Header\022\'\n\005error\030\001 \001(\0132\030.cockroa"
-    "ch.roachpb.Error\0225\n\ttimestamp\030\002 \001(\0132\034.co"
-    "ckroach.roachpb.TimestampB\004\310\336\037\000\022+\n\003txn\030\003"
-    " \001(\0132\036.cockroach.roachpb.Transaction:\004\230\240"
-    "\037\000*L\n\023ReadConsistencyType\022\016\n\nCONSISTENT\020"
-    "\000\022\r\n\tCONSENSUS\020\001\022\020\n\014INCONSISTENT\020\002\032\004\210\243\036\000"
-    "*G\n\013PushTxnType\022\022\n\016PUSH_TIMESTAMP\020\000\022\016\n\nP"
-    "USH_ABORT\020\001\022\016\n\nPUSH_TOUCH\020\002\032\004\210\243\036\000B\tZ\007roa"
-    "chpbX\003", 8846);
+    "eHeaderB\010\310\336\037\000\320\336\037\001\"\236\001\n\031ResolveIntentRange"
+    "Request\0221\n\006header\030\001 \001(\0132\027.cockroach.roac"
+    "hpb.SpanB\010\310\336\037\000\320\336\037\001\0228\n\nintent_txn\030\002 \001(\0132\036"
+    ".cockroach.roachpb.TransactionB\004\310\336\037\000\022\024\n\006"
+    "poison\030\003 \001(\010B\004\310\336\037\000\"K\n\014NoopResponse\022;\n\006he"
+    "ader\030\001 \001(\0132!.cockroach.roachpb.ResponseH"
+    "eaderB\010\310\336\037\000\320\336\037\001\"@\n\013NoopRequest\0221\n\006header"
+    "\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037"
+    "\001\"Y\n\032ResolveIntentRangeResponse\022;\n\006heade"
+    "r\030\001 \001(\0132!.cockroach.roachpb.ResponseHead"
+    "erB\010\310\336\037\000\320\336\037\001\"p\n\014MergeRequest\0221\n\006header\030\001"
+    " \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022"
+    "-\n\005value\030\002 \001(\0132\030.cockroach.roachpb.Value"
+    "B\004\310\336\037\000\"L\n\rMergeResponse\022;\n\006header\030\001 \001(\0132"
+    "!.cockroach.roachpb.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\"\212\001\n\022TruncateLogRequest\0221\n\006header\030\001 "
+    "\001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\023"
+    "\n\005index\030\002 \001(\004B\004\310\336\037\000\022,\n\010range_id\030\003 \001(\003B\032\310"
+    "\336\037\000\342\336\037\007RangeID\372\336\037\007RangeID\"R\n\023TruncateLog"
+    "Response\022;\n\006header\030\001 \001(\0132!.cockroach.roa"
+    "chpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"v\n\022LeaderL"
+    "easeRequest\0221\n\006header\030\001 \001(\0132\027.cockroach."
+    "roachpb.SpanB\010\310\336\037\000\320\336\037\001\022-\n\005lease\030\002 \001(\0132\030."
+    "cockroach.roachpb.LeaseB\004\310\336\037\000\"R\n\023LeaderL"
+    "easeResponse\022;\n\006header\030\001 \001(\0132!.cockroach"
+    ".roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"F\n\021Raf"
+    "tStatusRequest\0221\n\006header\030\001 \001(\0132\027.cockroa"
+    "ch.roachpb.SpanB\010\310\336\037\000\320\336\037\001\"\200\001\n\014RaftProgre"
+    "ss\0222\n\nreplica_id\030\001 \001(\005B\036\310\336\037\000\342\336\037\tReplicaI"
+    "D\372\336\037\tReplicaID\022\023\n\005match\030\002 \001(\004B\004\310\336\037\000\022\022\n\004n"
+    "ext\030\003 \001(\004B\004\310\336\037\000\022\023\n\005state\030\004 \001(\tB\004\310\336\037\000\"\354\002\n"
+    "\022RaftStatusResponse\022;\n\006header\030\001 \001(\0132!.co"
+    "ckroach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001"
+    "\022,\n\010range_id\030\002 \001(\003B\032\310\336\037\000\342\336\037\007RangeID\372\336\037\007R"
+    "angeID\022;\n\007replica\030\003 \001(\0132$.cockroach.roac"
+    "hpb.ReplicaDescriptorB\004\310\336\037\000\022\022\n\004term\030\004 \001("
+    "\004B\004\310\336\037\000\022\024\n\006commit\030\005 \001(\004B\004\310\336\037\000\022\025\n\007applied"
+    "\030\006 \001(\004B\004\310\336\037\000\022\037\n\004lead\030\007 \001(\005B\021\310\336\037\000\372\336\037\tRepl"
+    "icaID\022\023\n\005state\030\010 \001(\tB\004\310\336\037\000\0227\n\010progress\030\t"
+    " \003(\0132\037.cockroach.roachpb.RaftProgressB\004\310"
+    "\336\037\000\"F\n\021RangeStatsRequest\0221\n\006header\030\001 \001(\013"
+    "2\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\"\315\002\n\022"
+    "RangeStatsResponse\022;\n\006header\030\001 \001(\0132!.coc"
+    "kroach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022"
+    ",\n\010range_id\030\002 \001(\003B\032\310\336\037\000\342\336\037\007RangeID\372\336\037\007Ra"
+    "ngeID\0226\n\004desc\030\003 \001(\0132\".cockroach.roachpb."
+    "RangeDescriptorB\004\310\336\037\000\022\030\n\nlive_bytes\030\004 \001("
+    "\003B\004\310\336\037\000\022\030\n\nlive_count\030\005 \001(\003B\004\310\336\037\000\022\027\n\tkey"
+    "_count\030\006 \001(\003B\004\310\336\037\000\022G\n\014gc_threshold\030\007 \001(\013"
+    "2\034.cockroach.roachpb.TimestampB\023\310\336\037\000\342\336\037\013"
+    "GCThreshold\"\266\013\n\014RequestUnion\022*\n\003get\030\001 \001("
+    "\0132\035.cockroach.roachpb.GetRequest\022*\n\003put\030"
+    "\002 \001(\0132\035.cockroach.roachpb.PutRequest\022A\n\017"
+    "conditional_put\030\003 \001(\0132(.cockroach.roachp"
+    "b.ConditionalPutRequest\0226\n\tincrement\030\004 \001"
+    "(\0132#.cockroach.roachpb.IncrementRequest\022"
+    "0\n\006delete\030\005 \001(\0132 .cockroach.roachpb.Dele"
+    "teRequest\022;\n\014delete_range\030\006 \001(\0132%.cockro"
+    "ach.roachpb.DeleteRangeRequest\022,\n\004scan\030\007"
+    " \001(\0132\036.cockroach.roachpb.ScanRequest\022E\n\021"
+    "begin_transaction\030\010 \001(\0132*.cockroach.roac"
+    "hpb.BeginTransactionRequest\022A\n\017end_trans"
+    "action\030\t \001(\0132(.cockroach.roachpb.EndTran"
+    "sactionRequest\0229\n\013admin_split\030\n \001(\0132$.co"
+    "ckroach.roachpb.AdminSplitRequest\0229\n\013adm"
+    "in_merge\030\013 \001(\0132$.cockroach.roachpb.Admin"
+    "MergeRequest\022=\n\rheartbeat_txn\030\014 \001(\0132&.co"
+    "ckroach.roachpb.HeartbeatTxnRequest\022(\n\002g"
+    "c\030\r \001(\0132\034.cockroach.roachpb.GCRequest\0223\n"
+    "\010push_txn\030\016 \001(\0132!.cockroach.roachpb.Push"
+    "TxnRequest\022;\n\014range_lookup\030\017 \001(\0132%.cockr"
+    "oach.roachpb.RangeLookupRequest\022\?\n\016resol"
+    "ve_intent\030\020 \001(\0132\'.cockroach.roachpb.Reso"
+    "lveIntentRequest\022J\n\024resolve_intent_range"
+    "\030\021 \001(\0132,.cockroach.roachpb.ResolveIntent"
+    "RangeRequest\022.\n\005merge\030\022 \001(\0132\037.cockroach."
+    "roachpb.MergeRequest\022;\n\014truncate_log\030\023 \001"
+    "(\0132%.cockroach.roachpb.TruncateLogReques"
+    "t\022;\n\014leader_lease\030\024 \001(\0132%.cockroach.roac"
+    "hpb.LeaderLeaseRequest\022;\n\014reverse_scan\030\025"
+    " \001(\0132%.cockroach.roachpb.ReverseScanRequ"
+    "est\022,\n\004noop\030\026 \001(\0132\036.cockroach.roachpb.No"
+    "opRequest\0229\n\013raft_status\030\027 \001(\0132$.cockroa"
+    "ch.roachpb.RaftStatusRequest\0229\n\013range_st"
+    "ats\030\030 \001(\0132$.cockroach.roachpb.RangeStats"
+    "Request\022=\n\radmin_scatter\030\031 \001(\0132&.cockroa"
+    "ch.roachpb.AdminScatterRequest:\004\310\240\037\001\"\320\013\n"
+    "\rResponseUnion\022+\n\003get\030\001 \001(\0132\036.cockroach."
+    "roachpb.GetResponse\022+\n\003put\030\002 \001(\0132\036.cockr"
+    "oach.roachpb.PutResponse\022B\n\017conditional_"
+    "put\030\003 \001(\0132).cockroach.roachpb.Conditiona"
+    "lPutResponse\0227\n\tincrement\030\004 \001(\0132$.cockro"
+    "ach.roachpb.IncrementResponse\0221\n\006delete\030"
+    "\005 \001(\0132!.cockroach.roachpb.DeleteResponse"
+    "\022<\n\014delete_range\030\006 \001(\0132&.cockroach.roach"
+    "pb.DeleteRangeResponse\022-\n\004scan\030\007 \001(\0132\037.c"
+    "ockroach.roachpb.ScanResponse\022F\n\021begin_t"
+    "ransaction\030\010 \001(\0132+.cockroach.roachpb.Beg"
+    "inTransactionResponse\022B\n\017end_transaction"
+    "\030\t \001(\0132).cockroach.roachpb.EndTransactio"
+    "nResponse\022:\n\013admin_split\030\n \001(\0132%.cockroa"
+    "ch.roachpb.AdminSplitResponse\022:\n\013admin_m"
+    "erge\030\013 \001(\0132%.cockroach.roachpb.AdminMerg"
+    "eResponse\022>\n\rheartbeat_txn\030\014 \001(\0132\'.cockr"
+    "oach.roachpb.HeartbeatTxnResponse\022)\n\002gc\030"
+    "\r \001(\0132\035.cockroach.roachpb.GCResponse\0224\n\010"
+    "push_txn\030\016 \001(\0132\".cockroach.roachpb.PushT"
+    "xnResponse\022<\n\014range_lookup\030\017 \001(\0132&.cockr"
+    "oach.roachpb.RangeLookupResponse\022@\n\016reso"
+    "lve_intent\030\020 \001(\0132(.cockroach.roachpb.Res"
+    "olveIntentResponse\022K\n\024resolve_intent_ran"
+    "ge\030\021 \001(\0132-.cockroach.roachpb.ResolveInte"
+    "ntRangeResponse\022/\n\005merge\030\022 \001(\0132 .cockroa"
+    "ch.roachpb.MergeResponse\022<\n\014truncate_log"
+    "\030\023 \001(\0132&.cockroach.roachpb.TruncateLogRe"
+    "sponse\022<\n\014leader_lease\030\024 \001(\0132&.cockroach"
+    ".roachpb.LeaderLeaseResponse\022<\n\014reverse_"
+    "scan\030\025 \001(\0132&.cockroach.roachpb.ReverseSc"
+    "anResponse\022-\n\004noop\030\026 \001(\0132\037.cockroach.roa"
+    "chpb.NoopResponse\022:\n\013raft_status\030\027 \001(\0132%"
+    ".cockroach.roachpb.RaftStatusResponse\022:\n"
+    "\013range_stats\030\030 \001(\0132%.cockroach.roachpb.R"
+    "angeStatsResponse\022>\n\radmin_scatter\030\031 \001(\013"
+    "2\'.cockroach.roachpb.AdminScatterRespons"
+    "e:\004\310\240\037\001\"\331\002\n\006Header\0225\n\ttimestamp\030\001 \001(\0132\034."
+    "cockroach.roachpb.TimestampB\004\310\336\037\000\022;\n\007rep"
+    "lica\030\002 \001(\0132$.cockroach.roachpb.ReplicaDe"
+    "scriptorB\004\310\336\037\000\022,\n\010range_id\030\003 \001(\003B\032\310\336\037\000\342\336"
+    "\037\007RangeID\372\336\037\007RangeID\022\033\n\ruser_priority\030\004 "
+    "\001(\001B\004\310\336\037\000\022+\n\003txn\030\005 \001(\0132\036.cockroach.roach"
+    "pb.Transaction\022F\n\020read_consistency\030\006 \001(\016"
+    "2&.cockroach.roachpb.ReadConsistencyType"
+    "B\004\310\336\037\000\022\033\n\rtrace_context\030\007 \001(\004B\004\310\336\037\000\"\202\001\n\014"
+    "BatchRequest\0223\n\006header\030\001 \001(\0132\031.cockroach"
+    ".roachpb.HeaderB\010\310\336\037\000\320\336\037\001\0227\n\010requests\030\002 "
+    "\003(\0132\037.cockroach.roachpb.RequestUnionB\004\310\336"
+    "\037\000:\004\230\240\037\000\"\253\002\n\rBatchResponse\022A\n\006header\030\001 \001"
+    "(\0132\'.cockroach.roachpb.BatchResponse.Hea"
+    "derB\010\310\336\037\000\320\336\037\001\0229\n\tresponses\030\002 \003(\0132 .cockr"
+    "oach.roachpb.ResponseUnionB\004\310\336\037\000\032\225\001\n\006Hea"
+    "der\022\'\n\005error\030\001 \001(\0132\030.cockroach.roachpb.E"
+    "rror\0225\n\ttimestamp\030\002 \001(\0132\034.cockroach.roac"
+    "hpb.TimestampB\004\310\336\037\000\022+\n\003txn\030\003 \001(\0132\036.cockr"
+    "oach.roachpb.Transaction:\004\230\240\037\000*L\n\023ReadCo"
+    "nsistencyType\022\016\n\nCONSISTENT\020\000\022\r\n\tCONSENS"
+    "US\020\001\022\020\n\014INCONSISTENT\020\002\032\004\210\243\036\000*G\n\013PushTxnT"
+    "ype\022\022\n\016PUSH_TIMESTAMP\020\000\022\016\n\nPUSH_ABORT\020\001\022"
+    "\016\n\nPUSH_TOUCH\020\002\032\004\210\243\036\000B\tZ\007roachpbX\003", 10434);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/roachpb/api.proto", &protobuf_RegisterTypes);
   ResponseHeader::default_instance_ = new ResponseHeader();
@@ -1550,6 +1768,8 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
   AdminSplitResponse::default_instance_ = new AdminSplitResponse();
   AdminMergeRequest::default_instance_ = new AdminMergeRequest();
   AdminMergeResponse::default_instance_ = new AdminMergeResponse();
+  AdminScatterRequest::default_instance_ = new AdminScatterRequest();
+  AdminScatterResponse::default_instance_ = new AdminScatterResponse();
   RangeLookupRequest::default_instance_ = new RangeLookupRequest();
   RangeLookupResponse::default_instance_ = new RangeLookupResponse();
   HeartbeatTxnRequest::default_instance_ = new HeartbeatTxnRequest();
@@ -1571,6 +1791,11 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
   TruncateLogResponse::default_instance_ = new TruncateLogResponse();
   LeaderLeaseRequest::default_instance_ = new LeaderLeaseRequest();
   LeaderLeaseResponse::default_instance_ = new LeaderLeaseResponse();
+  RaftStatusRequest::default_instance_ = new RaftStatusRequest();
+  RaftProgress::default_instance_ = new RaftProgress();
+  RaftStatusResponse::default_instance_ = new RaftStatusResponse();
+  RangeStatsRequest::default_instance_ = new RangeStatsRequest();
+  RangeStatsResponse::default_instance_ = new RangeStatsResponse();
   RequestUnion::default_instance_ = new RequestUnion();
   ResponseUnion::default_instance_ = new ResponseUnion();
   Header::default_instance_ = new Header();
@@ -1602,6 +1827,8 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
   AdminSplitResponse::default_instance_->InitAsDefaultInstance();
   AdminMergeRequest::default_instance_->InitAsDefaultInstance();
   AdminMergeResponse::default_instance_->InitAsDefaultInstance();
+  AdminScatterRequest::default_instance_->InitAsDefaultInstance();
+  AdminScatterResponse::default_instance_->InitAsDefaultInstance();
   RangeLookupRequest::default_instance_->InitAsDefaultInstance();
   RangeLookupResponse::default_instance_->InitAsDefaultInstance();
   HeartbeatTxnRequest::default_instance_->InitAsDefaultInstance();
@@ -1623,6 +1850,11 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
   TruncateLogResponse::default_instance_->InitAsDefaultInstance();
   LeaderLeaseRequest::default_instance_->InitAsDefaultInstance();
   LeaderLeaseResponse::default_instance_->InitAsDefaultInstance();
+  RaftStatusRequest::default_instance_->InitAsDefaultInstance();
+  RaftProgress::default_instance_->InitAsDefaultInstance();
+  RaftStatusResponse::default_instance_->InitAsDefaultInstance();
+  RangeStatsRequest::default_instance_->InitAsDefaultInstance();
+  RangeStatsResponse::default_instance_->InitAsDefaultInstance();
   RequestUnion::default_instance_->InitAsDefaultInstance();
   ResponseUnion::default_instance_->InitAsDefaultInstance();
   Header::default_instance_->InitAsDefaultInstance();
@@ -10326,105 +10558,84 @@ void AdminMergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHead
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int RangeLookupRequest::kHeaderFieldNumber;
-const int RangeLookupRequest::kMaxRangesFieldNumber;
-const int RangeLookupRequest::kConsiderIntentsFieldNumber;
-const int RangeLookupRequest::kReverseFieldNumber;
+const int AdminScatterRequest::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-RangeLookupRequest::RangeLookupRequest()
+AdminScatterRequest::AdminScatterRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.AdminScatterRequest)
 }
 
-void RangeLookupRequest::InitAsDefaultInstance() {
+void AdminScatterRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-RangeLookupRequest::RangeLookupRequest(const RangeLookupRequest& from)
+AdminScatterRequest::AdminScatterRequest(const AdminScatterRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.AdminScatterRequest)
 }
 
-void RangeLookupRequest::SharedCtor() {
+void AdminScatterRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  max_ranges_ = 0;
-  consider_intents_ = false;
-  reverse_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-RangeLookupRequest::~RangeLookupRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.RangeLookupRequest)
+AdminScatterRequest::~AdminScatterRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.AdminScatterRequest)
   SharedDtor();
 }
 
-void RangeLookupRequest::SharedDtor() {
+void AdminScatterRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void RangeLookupRequest::SetCachedSize(int size) const {
+void AdminScatterRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* RangeLookupRequest::descriptor() {
+const ::google::protobuf::Descriptor* AdminScatterRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return RangeLookupRequest_descriptor_;
+  return AdminScatterRequest_descriptor_;
 }
 
-const RangeLookupRequest& RangeLookupRequest::default_instance() {
+const AdminScatterRequest& AdminScatterRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-RangeLookupRequest* RangeLookupRequest::default_instance_ = NULL;
+AdminScatterRequest* AdminScatterRequest::default_instance_ = NULL;
 
-RangeLookupRequest* RangeLookupRequest::New(::google::protobuf::Arena* arena) const {
-  RangeLookupRequest* n = new RangeLookupRequest;
+AdminScatterRequest* AdminScatterRequest::New(::google::protobuf::Arena* arena) const {
+  AdminScatterRequest* n = new AdminScatterRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void RangeLookupRequest::Clear() {
-#define ZR_HELPER_(f) reinterpret_cast<char*>(\
-  &reinterpret_cast<RangeLookupRequest*>(16)->f)
-
-#define ZR_(first, last) do {\
-  ::memset(&first, 0,\
-           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
-} while (0)
-
-  if (_has_bits_[0 / 32] & 15u) {
-    ZR_(max_ranges_, reverse_);
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-    }
+void AdminScatterRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   }
-
-#undef ZR_HELPER_
-#undef ZR_
-
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool RangeLookupRequest::MergePartialFromCodedStream(
+bool AdminScatterRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.AdminScatterRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -10438,51 +10649,6 @@ bool RangeLookupRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_max_ranges;
-        break;
-      }
-
-      // optional int32 max_ranges = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_max_ranges:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &max_ranges_)));
-          set_has_max_ranges();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(24)) goto parse_consider_intents;
-        break;
-      }
-
-      // optional bool consider_intents = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_consider_intents:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &consider_intents_)));
-          set_has_consider_intents();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(32)) goto parse_reverse;
-        break;
-      }
-
-      // optional bool reverse = 4;
-      case 4: {
-        if (tag == 32) {
-         parse_reverse:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &reverse_)));
-          set_has_reverse();
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -10501,48 +10667,33 @@ bool RangeLookupRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.AdminScatterRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.AdminScatterRequest)
   return false;
 #undef DO_
 }
 
-void RangeLookupRequest::SerializeWithCachedSizes(
+void AdminScatterRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.AdminScatterRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional int32 max_ranges = 2;
-  if (has_max_ranges()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->max_ranges(), output);
-  }
-
-  // optional bool consider_intents = 3;
-  if (has_consider_intents()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->consider_intents(), output);
-  }
-
-  // optional bool reverse = 4;
-  if (has_reverse()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->reverse(), output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.AdminScatterRequest)
 }
 
-::google::protobuf::uint8* RangeLookupRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* AdminScatterRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.AdminScatterRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -10550,58 +10701,24 @@ void RangeLookupRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // optional int32 max_ranges = 2;
-  if (has_max_ranges()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->max_ranges(), target);
-  }
-
-  // optional bool consider_intents = 3;
-  if (has_consider_intents()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->consider_intents(), target);
-  }
-
-  // optional bool reverse = 4;
-  if (has_reverse()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->reverse(), target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RangeLookupRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.AdminScatterRequest)
   return target;
 }
 
-int RangeLookupRequest::ByteSize() const {
+int AdminScatterRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 15u) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional int32 max_ranges = 2;
-    if (has_max_ranges()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->max_ranges());
-    }
-
-    // optional bool consider_intents = 3;
-    if (has_consider_intents()) {
-      total_size += 1 + 1;
-    }
-
-    // optional bool reverse = 4;
-    if (has_reverse()) {
-      total_size += 1 + 1;
-    }
-
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -10613,10 +10730,10 @@ int RangeLookupRequest::ByteSize() const {
   return total_size;
 }
 
-void RangeLookupRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void AdminScatterRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const RangeLookupRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const RangeLookupRequest>(
+  const AdminScatterRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const AdminScatterRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -10625,102 +10742,90 @@ void RangeLookupRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void RangeLookupRequest::MergeFrom(const RangeLookupRequest& from) {
+void AdminScatterRequest::MergeFrom(const AdminScatterRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
-    if (from.has_max_ranges()) {
-      set_max_ranges(from.max_ranges());
-    }
-    if (from.has_consider_intents()) {
-      set_consider_intents(from.consider_intents());
-    }
-    if (from.has_reverse()) {
-      set_reverse(from.reverse());
-    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void RangeLookupRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void AdminScatterRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void RangeLookupRequest::CopyFrom(const RangeLookupRequest& from) {
+void AdminScatterRequest::CopyFrom(const AdminScatterRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool RangeLookupRequest::IsInitialized() const {
+bool AdminScatterRequest::IsInitialized() const {
 
   return true;
 }
 
-void RangeLookupRequest::Swap(RangeLookupRequest* other) {
+void AdminScatterRequest::Swap(AdminScatterRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void RangeLookupRequest::InternalSwap(RangeLookupRequest* other) {
+void AdminScatterRequest::InternalSwap(AdminScatterRequest* other) {
   std::swap(header_, other->header_);
-  std::swap(max_ranges_, other->max_ranges_);
-  std::swap(consider_intents_, other->consider_intents_);
-  std::swap(reverse_, other->reverse_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata RangeLookupRequest::GetMetadata() const {
+::google::protobuf::Metadata AdminScatterRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = RangeLookupRequest_descriptor_;
-  metadata.reflection = RangeLookupRequest_reflection_;
+  metadata.descriptor = AdminScatterRequest_descriptor_;
+  metadata.reflection = AdminScatterRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// RangeLookupRequest
+// AdminScatterRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-bool RangeLookupRequest::has_header() const {
+bool AdminScatterRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void RangeLookupRequest::set_has_header() {
+void AdminScatterRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void RangeLookupRequest::clear_has_header() {
+void AdminScatterRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void RangeLookupRequest::clear_header() {
+void AdminScatterRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& RangeLookupRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.header)
+const ::cockroach::roachpb::Span& AdminScatterRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminScatterRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* RangeLookupRequest::mutable_header() {
+::cockroach::roachpb::Span* AdminScatterRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminScatterRequest.header)
   return header_;
 }
-::cockroach::roachpb::Span* RangeLookupRequest::release_header() {
+::cockroach::roachpb::Span* AdminScatterRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void RangeLookupRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void AdminScatterRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -10728,79 +10833,7 @@ void RangeLookupRequest::set_allocated_header(::cockroach::roachpb::Span* header
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupRequest.header)
-}
-
-// optional int32 max_ranges = 2;
-bool RangeLookupRequest::has_max_ranges() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void RangeLookupRequest::set_has_max_ranges() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void RangeLookupRequest::clear_has_max_ranges() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void RangeLookupRequest::clear_max_ranges() {
-  max_ranges_ = 0;
-  clear_has_max_ranges();
-}
- ::google::protobuf::int32 RangeLookupRequest::max_ranges() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.max_ranges)
-  return max_ranges_;
-}
- void RangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
-  set_has_max_ranges();
-  max_ranges_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.max_ranges)
-}
-
-// optional bool consider_intents = 3;
-bool RangeLookupRequest::has_consider_intents() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-void RangeLookupRequest::set_has_consider_intents() {
-  _has_bits_[0] |= 0x00000004u;
-}
-void RangeLookupRequest::clear_has_consider_intents() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-void RangeLookupRequest::clear_consider_intents() {
-  consider_intents_ = false;
-  clear_has_consider_intents();
-}
- bool RangeLookupRequest::consider_intents() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.consider_intents)
-  return consider_intents_;
-}
- void RangeLookupRequest::set_consider_intents(bool value) {
-  set_has_consider_intents();
-  consider_intents_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.consider_intents)
-}
-
-// optional bool reverse = 4;
-bool RangeLookupRequest::has_reverse() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
-}
-void RangeLookupRequest::set_has_reverse() {
-  _has_bits_[0] |= 0x00000008u;
-}
-void RangeLookupRequest::clear_has_reverse() {
-  _has_bits_[0] &= ~0x00000008u;
-}
-void RangeLookupRequest::clear_reverse() {
-  reverse_ = false;
-  clear_has_reverse();
-}
- bool RangeLookupRequest::reverse() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.reverse)
-  return reverse_;
-}
- void RangeLookupRequest::set_reverse(bool value) {
-  set_has_reverse();
-  reverse_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.reverse)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminScatterRequest.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -10808,86 +10841,93 @@ void RangeLookupRequest::clear_reverse() {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int RangeLookupResponse::kHeaderFieldNumber;
-const int RangeLookupResponse::kRangesFieldNumber;
+const int AdminScatterResponse::kHeaderFieldNumber;
+const int AdminScatterResponse::kDescFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-RangeLookupResponse::RangeLookupResponse()
+AdminScatterResponse::AdminScatterResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.AdminScatterResponse)
 }
 
-void RangeLookupResponse::InitAsDefaultInstance() {
+void AdminScatterResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+  desc_ = const_cast< ::cockroach::roachpb::RangeDescriptor*>(&::cockroach::roachpb::RangeDescriptor::default_instance());
 }
 
-RangeLookupResponse::RangeLookupResponse(const RangeLookupResponse& from)
+AdminScatterResponse::AdminScatterResponse(const AdminScatterResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.AdminScatterResponse)
 }
 
-void RangeLookupResponse::SharedCtor() {
+void AdminScatterResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  desc_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-RangeLookupResponse::~RangeLookupResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.RangeLookupResponse)
+AdminScatterResponse::~AdminScatterResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.AdminScatterResponse)
   SharedDtor();
 }
 
-void RangeLookupResponse::SharedDtor() {
+void AdminScatterResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete desc_;
   }
 }
 
-void RangeLookupResponse::SetCachedSize(int size) const {
+void AdminScatterResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* RangeLookupResponse::descriptor() {
+const ::google::protobuf::Descriptor* AdminScatterResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return RangeLookupResponse_descriptor_;
+  return AdminScatterResponse_descriptor_;
 }
 
-const RangeLookupResponse& RangeLookupResponse::default_instance() {
+const AdminScatterResponse& AdminScatterResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-RangeLookupResponse* RangeLookupResponse::default_instance_ = NULL;
+AdminScatterResponse* AdminScatterResponse::default_instance_ = NULL;
 
-RangeLookupResponse* RangeLookupResponse::New(::google::protobuf::Arena* arena) const {
-  RangeLookupResponse* n = new RangeLookupResponse;
+AdminScatterResponse* AdminScatterResponse::New(::google::protobuf::Arena* arena) const {
+  AdminScatterResponse* n = new AdminScatterResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void RangeLookupResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void AdminScatterResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+    }
+    if (has_desc()) {
+      if (desc_ != NULL) desc_->::cockroach::roachpb::RangeDescriptor::Clear();
+    }
   }
-  ranges_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool RangeLookupResponse::MergePartialFromCodedStream(
+bool AdminScatterResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.AdminScatterResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -10901,23 +10941,19 @@ bool RangeLookupResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_ranges;
+        if (input->ExpectTag(18)) goto parse_desc;
         break;
       }
 
-      // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
+      // optional .cockroach.roachpb.RangeDescriptor desc = 2;
       case 2: {
         if (tag == 18) {
-         parse_ranges:
-          DO_(input->IncrementRecursionDepth());
-         parse_loop_ranges:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
-                input, add_ranges()));
+         parse_desc:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_desc()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_loop_ranges;
-        input->UnsafeDecrementRecursionDepth();
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -10936,39 +10972,39 @@ bool RangeLookupResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.AdminScatterResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.AdminScatterResponse)
   return false;
 #undef DO_
 }
 
-void RangeLookupResponse::SerializeWithCachedSizes(
+void AdminScatterResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.AdminScatterResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
-  for (unsigned int i = 0, n = this->ranges_size(); i < n; i++) {
+  // optional .cockroach.roachpb.RangeDescriptor desc = 2;
+  if (has_desc()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->ranges(i), output);
+      2, *this->desc_, output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.AdminScatterResponse)
 }
 
-::google::protobuf::uint8* RangeLookupResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* AdminScatterResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.AdminScatterResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -10976,39 +11012,40 @@ void RangeLookupResponse::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
-  for (unsigned int i = 0, n = this->ranges_size(); i < n; i++) {
+  // optional .cockroach.roachpb.RangeDescriptor desc = 2;
+  if (has_desc()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, this->ranges(i), target);
+        2, *this->desc_, target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RangeLookupResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.AdminScatterResponse)
   return target;
 }
 
-int RangeLookupResponse::ByteSize() const {
+int AdminScatterResponse::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 3u) {
+    // optional .cockroach.roachpb.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
 
-  // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
-  total_size += 1 * this->ranges_size();
-  for (int i = 0; i < this->ranges_size(); i++) {
-    total_size +=
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->ranges(i));
-  }
+    // optional .cockroach.roachpb.RangeDescriptor desc = 2;
+    if (has_desc()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->desc_);
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -11020,10 +11057,10 @@ int RangeLookupResponse::ByteSize() const {
   return total_size;
 }
 
-void RangeLookupResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void AdminScatterResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const RangeLookupResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const RangeLookupResponse>(
+  const AdminScatterResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const AdminScatterResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -11032,92 +11069,94 @@ void RangeLookupResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void RangeLookupResponse::MergeFrom(const RangeLookupResponse& from) {
+void AdminScatterResponse::MergeFrom(const AdminScatterResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  ranges_.MergeFrom(from.ranges_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_desc()) {
+      mutable_desc()->::cockroach::roachpb::RangeDescriptor::MergeFrom(from.desc());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void RangeLookupResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void AdminScatterResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void RangeLookupResponse::CopyFrom(const RangeLookupResponse& from) {
+void AdminScatterResponse::CopyFrom(const AdminScatterResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool RangeLookupResponse::IsInitialized() const {
+bool AdminScatterResponse::IsInitialized() const {
 
   return true;
 }
 
-void RangeLookupResponse::Swap(RangeLookupResponse* other) {
+void AdminScatterResponse::Swap(AdminScatterResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void RangeLookupResponse::InternalSwap(RangeLookupResponse* other) {
+void AdminScatterResponse::InternalSwap(AdminScatterResponse* other) {
   std::swap(header_, other->header_);
-  ranges_.UnsafeArenaSwap(&other->ranges_);
+  std::swap(desc_, other->desc_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata RangeLookupResponse::GetMetadata() const {
+::google::protobuf::Metadata AdminScatterResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = RangeLookupResponse_descriptor_;
-  metadata.reflection = RangeLookupResponse_reflection_;
+  metadata.descriptor = AdminScatterResponse_descriptor_;
+  metadata.reflection = AdminScatterResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// RangeLookupResponse
+// AdminScatterResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-bool RangeLookupResponse::has_header() const {
+bool AdminScatterResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void RangeLookupResponse::set_has_header() {
+void AdminScatterResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void RangeLookupResponse::clear_has_header() {
+void AdminScatterResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void RangeLookupResponse::clear_header() {
+void AdminScatterResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::ResponseHeader& RangeLookupResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.header)
+const ::cockroach::roachpb::ResponseHeader& AdminScatterResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminScatterResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::ResponseHeader* RangeLookupResponse::mutable_header() {
+::cockroach::roachpb::ResponseHeader* AdminScatterResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminScatterResponse.header)
   return header_;
 }
-::cockroach::roachpb::ResponseHeader* RangeLookupResponse::release_header() {
+::cockroach::roachpb::ResponseHeader* AdminScatterResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-void RangeLookupResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+void AdminScatterResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -11125,37 +11164,50 @@ void RangeLookupResponse::set_allocated_header(::cockroach::roachpb::ResponseHea
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminScatterResponse.header)
 }
 
-// repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
-int RangeLookupResponse::ranges_size() const {
-  return ranges_.size();
+// optional .cockroach.roachpb.RangeDescriptor desc = 2;
+bool AdminScatterResponse::has_desc() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-void RangeLookupResponse::clear_ranges() {
-  ranges_.Clear();
+void AdminScatterResponse::set_has_desc() {
+  _has_bits_[0] |= 0x00000002u;
 }
-const ::cockroach::roachpb::RangeDescriptor& RangeLookupResponse::ranges(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_.Get(index);
+void AdminScatterResponse::clear_has_desc() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::mutable_ranges(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_.Mutable(index);
+void AdminScatterResponse::clear_desc() {
+  if (desc_ != NULL) desc_->::cockroach::roachpb::RangeDescriptor::Clear();
+  clear_has_desc();
 }
-::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::add_ranges() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_.Add();
+const ::cockroach::roachpb::RangeDescriptor& AdminScatterResponse::desc() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminScatterResponse.desc)
+  return desc_ != NULL ? *desc_ : *default_instance_->desc_;
 }
-::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >*
-RangeLookupResponse::mutable_ranges() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.RangeLookupResponse.ranges)
-  return &ranges_;
+::cockroach::roachpb::RangeDescriptor* AdminScatterResponse::mutable_desc() {
+  set_has_desc();
+  if (desc_ == NULL) {
+    desc_ = new ::cockroach::roachpb::RangeDescriptor;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminScatterResponse.desc)
+  return desc_;
 }
-const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >&
-RangeLookupResponse::ranges() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_;
+::cockroach::roachpb::RangeDescriptor* AdminScatterResponse::release_desc() {
+  clear_has_desc();
+  ::cockroach::roachpb::RangeDescriptor* temp = desc_;
+  desc_ = NULL;
+  return temp;
+}
+void AdminScatterResponse::set_allocated_desc(::cockroach::roachpb::RangeDescriptor* desc) {
+  delete desc_;
+  desc_ = desc;
+  if (desc) {
+    set_has_desc();
+  } else {
+    clear_has_desc();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminScatterResponse.desc)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -11163,84 +11215,105 @@ RangeLookupResponse::ranges() const {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int HeartbeatTxnRequest::kHeaderFieldNumber;
+const int RangeLookupRequest::kHeaderFieldNumber;
+const int RangeLookupRequest::kMaxRangesFieldNumber;
+const int RangeLookupRequest::kConsiderIntentsFieldNumber;
+const int RangeLookupRequest::kReverseFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-HeartbeatTxnRequest::HeartbeatTxnRequest()
+RangeLookupRequest::RangeLookupRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.RangeLookupRequest)
 }
 
-void HeartbeatTxnRequest::InitAsDefaultInstance() {
+void RangeLookupRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-HeartbeatTxnRequest::HeartbeatTxnRequest(const HeartbeatTxnRequest& from)
+RangeLookupRequest::RangeLookupRequest(const RangeLookupRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RangeLookupRequest)
 }
 
-void HeartbeatTxnRequest::SharedCtor() {
+void RangeLookupRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  max_ranges_ = 0;
+  consider_intents_ = false;
+  reverse_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-HeartbeatTxnRequest::~HeartbeatTxnRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.HeartbeatTxnRequest)
+RangeLookupRequest::~RangeLookupRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.RangeLookupRequest)
   SharedDtor();
 }
 
-void HeartbeatTxnRequest::SharedDtor() {
+void RangeLookupRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void HeartbeatTxnRequest::SetCachedSize(int size) const {
+void RangeLookupRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* HeartbeatTxnRequest::descriptor() {
+const ::google::protobuf::Descriptor* RangeLookupRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return HeartbeatTxnRequest_descriptor_;
+  return RangeLookupRequest_descriptor_;
 }
 
-const HeartbeatTxnRequest& HeartbeatTxnRequest::default_instance() {
+const RangeLookupRequest& RangeLookupRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-HeartbeatTxnRequest* HeartbeatTxnRequest::default_instance_ = NULL;
+RangeLookupRequest* RangeLookupRequest::default_instance_ = NULL;
 
-HeartbeatTxnRequest* HeartbeatTxnRequest::New(::google::protobuf::Arena* arena) const {
-  HeartbeatTxnRequest* n = new HeartbeatTxnRequest;
+RangeLookupRequest* RangeLookupRequest::New(::google::protobuf::Arena* arena) const {
+  RangeLookupRequest* n = new RangeLookupRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void HeartbeatTxnRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-  }
-  ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  if (_internal_metadata_.have_unknown_fields()) {
-    mutable_unknown_fields()->Clear();
-  }
-}
+void RangeLookupRequest::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<RangeLookupRequest*>(16)->f)
 
-bool HeartbeatTxnRequest::MergePartialFromCodedStream(
-    ::google::protobuf::io::CodedInputStream* input) {
-#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 15u) {
+    ZR_(max_ranges_, reverse_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+  }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool RangeLookupRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RangeLookupRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -11254,6 +11327,51 @@ bool HeartbeatTxnRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_max_ranges;
+        break;
+      }
+
+      // optional int32 max_ranges = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_ranges:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &max_ranges_)));
+          set_has_max_ranges();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_consider_intents;
+        break;
+      }
+
+      // optional bool consider_intents = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_consider_intents:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &consider_intents_)));
+          set_has_consider_intents();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_reverse;
+        break;
+      }
+
+      // optional bool reverse = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_reverse:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &reverse_)));
+          set_has_reverse();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -11272,33 +11390,48 @@ bool HeartbeatTxnRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RangeLookupRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RangeLookupRequest)
   return false;
 #undef DO_
 }
 
-void HeartbeatTxnRequest::SerializeWithCachedSizes(
+void RangeLookupRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RangeLookupRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
+  // optional int32 max_ranges = 2;
+  if (has_max_ranges()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->max_ranges(), output);
+  }
+
+  // optional bool consider_intents = 3;
+  if (has_consider_intents()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->consider_intents(), output);
+  }
+
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->reverse(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RangeLookupRequest)
 }
 
-::google::protobuf::uint8* HeartbeatTxnRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RangeLookupRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RangeLookupRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -11306,24 +11439,58 @@ void HeartbeatTxnRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
+  // optional int32 max_ranges = 2;
+  if (has_max_ranges()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->max_ranges(), target);
+  }
+
+  // optional bool consider_intents = 3;
+  if (has_consider_intents()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->consider_intents(), target);
+  }
+
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->reverse(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.HeartbeatTxnRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RangeLookupRequest)
   return target;
 }
 
-int HeartbeatTxnRequest::ByteSize() const {
+int RangeLookupRequest::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.Span header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 15u) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional int32 max_ranges = 2;
+    if (has_max_ranges()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->max_ranges());
+    }
+
+    // optional bool consider_intents = 3;
+    if (has_consider_intents()) {
+      total_size += 1 + 1;
+    }
 
+    // optional bool reverse = 4;
+    if (has_reverse()) {
+      total_size += 1 + 1;
+    }
+
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -11335,10 +11502,10 @@ int HeartbeatTxnRequest::ByteSize() const {
   return total_size;
 }
 
-void HeartbeatTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void RangeLookupRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const HeartbeatTxnRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const HeartbeatTxnRequest>(
+  const RangeLookupRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RangeLookupRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -11347,90 +11514,102 @@ void HeartbeatTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void HeartbeatTxnRequest::MergeFrom(const HeartbeatTxnRequest& from) {
+void RangeLookupRequest::MergeFrom(const RangeLookupRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
+    if (from.has_max_ranges()) {
+      set_max_ranges(from.max_ranges());
+    }
+    if (from.has_consider_intents()) {
+      set_consider_intents(from.consider_intents());
+    }
+    if (from.has_reverse()) {
+      set_reverse(from.reverse());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void HeartbeatTxnRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void RangeLookupRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void HeartbeatTxnRequest::CopyFrom(const HeartbeatTxnRequest& from) {
+void RangeLookupRequest::CopyFrom(const RangeLookupRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool HeartbeatTxnRequest::IsInitialized() const {
+bool RangeLookupRequest::IsInitialized() const {
 
   return true;
 }
 
-void HeartbeatTxnRequest::Swap(HeartbeatTxnRequest* other) {
+void RangeLookupRequest::Swap(RangeLookupRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void HeartbeatTxnRequest::InternalSwap(HeartbeatTxnRequest* other) {
+void RangeLookupRequest::InternalSwap(RangeLookupRequest* other) {
   std::swap(header_, other->header_);
+  std::swap(max_ranges_, other->max_ranges_);
+  std::swap(consider_intents_, other->consider_intents_);
+  std::swap(reverse_, other->reverse_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata HeartbeatTxnRequest::GetMetadata() const {
+::google::protobuf::Metadata RangeLookupRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = HeartbeatTxnRequest_descriptor_;
-  metadata.reflection = HeartbeatTxnRequest_reflection_;
+  metadata.descriptor = RangeLookupRequest_descriptor_;
+  metadata.reflection = RangeLookupRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// HeartbeatTxnRequest
+// RangeLookupRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-bool HeartbeatTxnRequest::has_header() const {
+bool RangeLookupRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void HeartbeatTxnRequest::set_has_header() {
+void RangeLookupRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void HeartbeatTxnRequest::clear_has_header() {
+void RangeLookupRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void HeartbeatTxnRequest::clear_header() {
+void RangeLookupRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& HeartbeatTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnRequest.header)
+const ::cockroach::roachpb::Span& RangeLookupRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* HeartbeatTxnRequest::mutable_header() {
+::cockroach::roachpb::Span* RangeLookupRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupRequest.header)
   return header_;
 }
-::cockroach::roachpb::Span* HeartbeatTxnRequest::release_header() {
+::cockroach::roachpb::Span* RangeLookupRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void HeartbeatTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void RangeLookupRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -11438,92 +11617,166 @@ void HeartbeatTxnRequest::set_allocated_header(::cockroach::roachpb::Span* heade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupRequest.header)
 }
 
-#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
-
-// ===================================================================
-
-#if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int HeartbeatTxnResponse::kHeaderFieldNumber;
-#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
-
-HeartbeatTxnResponse::HeartbeatTxnResponse()
-  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
-  SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.HeartbeatTxnResponse)
+// optional int32 max_ranges = 2;
+bool RangeLookupRequest::has_max_ranges() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-
-void HeartbeatTxnResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+void RangeLookupRequest::set_has_max_ranges() {
+  _has_bits_[0] |= 0x00000002u;
 }
-
-HeartbeatTxnResponse::HeartbeatTxnResponse(const HeartbeatTxnResponse& from)
-  : ::google::protobuf::Message(),
-    _internal_metadata_(NULL) {
-  SharedCtor();
-  MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.HeartbeatTxnResponse)
+void RangeLookupRequest::clear_has_max_ranges() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-
-void HeartbeatTxnResponse::SharedCtor() {
-  _cached_size_ = 0;
-  header_ = NULL;
-  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+void RangeLookupRequest::clear_max_ranges() {
+  max_ranges_ = 0;
+  clear_has_max_ranges();
 }
-
-HeartbeatTxnResponse::~HeartbeatTxnResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.HeartbeatTxnResponse)
-  SharedDtor();
+ ::google::protobuf::int32 RangeLookupRequest::max_ranges() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.max_ranges)
+  return max_ranges_;
 }
-
-void HeartbeatTxnResponse::SharedDtor() {
+ void RangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
+  set_has_max_ranges();
+  max_ranges_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.max_ranges)
+}
+
+// optional bool consider_intents = 3;
+bool RangeLookupRequest::has_consider_intents() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void RangeLookupRequest::set_has_consider_intents() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void RangeLookupRequest::clear_has_consider_intents() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void RangeLookupRequest::clear_consider_intents() {
+  consider_intents_ = false;
+  clear_has_consider_intents();
+}
+ bool RangeLookupRequest::consider_intents() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.consider_intents)
+  return consider_intents_;
+}
+ void RangeLookupRequest::set_consider_intents(bool value) {
+  set_has_consider_intents();
+  consider_intents_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.consider_intents)
+}
+
+// optional bool reverse = 4;
+bool RangeLookupRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void RangeLookupRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void RangeLookupRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void RangeLookupRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
+}
+ bool RangeLookupRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.reverse)
+  return reverse_;
+}
+ void RangeLookupRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.reverse)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int RangeLookupResponse::kHeaderFieldNumber;
+const int RangeLookupResponse::kRangesFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+RangeLookupResponse::RangeLookupResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.RangeLookupResponse)
+}
+
+void RangeLookupResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+}
+
+RangeLookupResponse::RangeLookupResponse(const RangeLookupResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RangeLookupResponse)
+}
+
+void RangeLookupResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RangeLookupResponse::~RangeLookupResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.RangeLookupResponse)
+  SharedDtor();
+}
+
+void RangeLookupResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void HeartbeatTxnResponse::SetCachedSize(int size) const {
+void RangeLookupResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* HeartbeatTxnResponse::descriptor() {
+const ::google::protobuf::Descriptor* RangeLookupResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return HeartbeatTxnResponse_descriptor_;
+  return RangeLookupResponse_descriptor_;
 }
 
-const HeartbeatTxnResponse& HeartbeatTxnResponse::default_instance() {
+const RangeLookupResponse& RangeLookupResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-HeartbeatTxnResponse* HeartbeatTxnResponse::default_instance_ = NULL;
+RangeLookupResponse* RangeLookupResponse::default_instance_ = NULL;
 
-HeartbeatTxnResponse* HeartbeatTxnResponse::New(::google::protobuf::Arena* arena) const {
-  HeartbeatTxnResponse* n = new HeartbeatTxnResponse;
+RangeLookupResponse* RangeLookupResponse::New(::google::protobuf::Arena* arena) const {
+  RangeLookupResponse* n = new RangeLookupResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void HeartbeatTxnResponse::Clear() {
+void RangeLookupResponse::Clear() {
   if (has_header()) {
     if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   }
+  ranges_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool HeartbeatTxnResponse::MergePartialFromCodedStream(
+bool RangeLookupResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.HeartbeatTxnResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RangeLookupResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -11537,6 +11790,23 @@ bool HeartbeatTxnResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_ranges;
+        break;
+      }
+
+      // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_ranges:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_ranges:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_ranges()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_ranges;
+        input->UnsafeDecrementRecursionDepth();
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -11555,33 +11825,39 @@ bool HeartbeatTxnResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.HeartbeatTxnResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RangeLookupResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.HeartbeatTxnResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RangeLookupResponse)
   return false;
 #undef DO_
 }
 
-void HeartbeatTxnResponse::SerializeWithCachedSizes(
+void RangeLookupResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.HeartbeatTxnResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RangeLookupResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
+  // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
+  for (unsigned int i = 0, n = this->ranges_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->ranges(i), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.HeartbeatTxnResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RangeLookupResponse)
 }
 
-::google::protobuf::uint8* HeartbeatTxnResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RangeLookupResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.HeartbeatTxnResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RangeLookupResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -11589,15 +11865,22 @@ void HeartbeatTxnResponse::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
+  // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
+  for (unsigned int i = 0, n = this->ranges_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->ranges(i), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.HeartbeatTxnResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RangeLookupResponse)
   return target;
 }
 
-int HeartbeatTxnResponse::ByteSize() const {
+int RangeLookupResponse::ByteSize() const {
   int total_size = 0;
 
   // optional .cockroach.roachpb.ResponseHeader header = 1;
@@ -11607,6 +11890,14 @@ int HeartbeatTxnResponse::ByteSize() const {
         *this->header_);
   }
 
+  // repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
+  total_size += 1 * this->ranges_size();
+  for (int i = 0; i < this->ranges_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->ranges(i));
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -11618,10 +11909,10 @@ int HeartbeatTxnResponse::ByteSize() const {
   return total_size;
 }
 
-void HeartbeatTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void RangeLookupResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const HeartbeatTxnResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const HeartbeatTxnResponse>(
+  const RangeLookupResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RangeLookupResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -11630,8 +11921,9 @@ void HeartbeatTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void HeartbeatTxnResponse::MergeFrom(const HeartbeatTxnResponse& from) {
+void RangeLookupResponse::MergeFrom(const RangeLookupResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  ranges_.MergeFrom(from.ranges_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
@@ -11642,78 +11934,79 @@ void HeartbeatTxnResponse::MergeFrom(const HeartbeatTxnResponse& from) {
   }
 }
 
-void HeartbeatTxnResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void RangeLookupResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void HeartbeatTxnResponse::CopyFrom(const HeartbeatTxnResponse& from) {
+void RangeLookupResponse::CopyFrom(const RangeLookupResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool HeartbeatTxnResponse::IsInitialized() const {
+bool RangeLookupResponse::IsInitialized() const {
 
   return true;
 }
 
-void HeartbeatTxnResponse::Swap(HeartbeatTxnResponse* other) {
+void RangeLookupResponse::Swap(RangeLookupResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void HeartbeatTxnResponse::InternalSwap(HeartbeatTxnResponse* other) {
+void RangeLookupResponse::InternalSwap(RangeLookupResponse* other) {
   std::swap(header_, other->header_);
+  ranges_.UnsafeArenaSwap(&other->ranges_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata HeartbeatTxnResponse::GetMetadata() const {
+::google::protobuf::Metadata RangeLookupResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = HeartbeatTxnResponse_descriptor_;
-  metadata.reflection = HeartbeatTxnResponse_reflection_;
+  metadata.descriptor = RangeLookupResponse_descriptor_;
+  metadata.reflection = RangeLookupResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// HeartbeatTxnResponse
+// RangeLookupResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-bool HeartbeatTxnResponse::has_header() const {
+bool RangeLookupResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void HeartbeatTxnResponse::set_has_header() {
+void RangeLookupResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void HeartbeatTxnResponse::clear_has_header() {
+void RangeLookupResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void HeartbeatTxnResponse::clear_header() {
+void RangeLookupResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::ResponseHeader& HeartbeatTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnResponse.header)
+const ::cockroach::roachpb::ResponseHeader& RangeLookupResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::mutable_header() {
+::cockroach::roachpb::ResponseHeader* RangeLookupResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.header)
   return header_;
 }
-::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::release_header() {
+::cockroach::roachpb::ResponseHeader* RangeLookupResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-void HeartbeatTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+void RangeLookupResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -11721,7 +12014,37 @@ void HeartbeatTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHe
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupResponse.header)
+}
+
+// repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
+int RangeLookupResponse::ranges_size() const {
+  return ranges_.size();
+}
+void RangeLookupResponse::clear_ranges() {
+  ranges_.Clear();
+}
+const ::cockroach::roachpb::RangeDescriptor& RangeLookupResponse::ranges(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_.Get(index);
+}
+::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::mutable_ranges(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_.Mutable(index);
+}
+::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::add_ranges() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_.Add();
+}
+::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >*
+RangeLookupResponse::mutable_ranges() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.RangeLookupResponse.ranges)
+  return &ranges_;
+}
+const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >&
+RangeLookupResponse::ranges() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_;
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -11729,81 +12052,72 @@ void HeartbeatTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHe
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GCRequest_GCKey::kKeyFieldNumber;
-const int GCRequest_GCKey::kTimestampFieldNumber;
+const int HeartbeatTxnRequest::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-GCRequest_GCKey::GCRequest_GCKey()
+HeartbeatTxnRequest::HeartbeatTxnRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.GCRequest.GCKey)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.HeartbeatTxnRequest)
 }
 
-void GCRequest_GCKey::InitAsDefaultInstance() {
-  timestamp_ = const_cast< ::cockroach::roachpb::Timestamp*>(&::cockroach::roachpb::Timestamp::default_instance());
+void HeartbeatTxnRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-GCRequest_GCKey::GCRequest_GCKey(const GCRequest_GCKey& from)
+HeartbeatTxnRequest::HeartbeatTxnRequest(const HeartbeatTxnRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.GCRequest.GCKey)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.HeartbeatTxnRequest)
 }
 
-void GCRequest_GCKey::SharedCtor() {
-  ::google::protobuf::internal::GetEmptyString();
+void HeartbeatTxnRequest::SharedCtor() {
   _cached_size_ = 0;
-  key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  timestamp_ = NULL;
+  header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-GCRequest_GCKey::~GCRequest_GCKey() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.GCRequest.GCKey)
+HeartbeatTxnRequest::~HeartbeatTxnRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.HeartbeatTxnRequest)
   SharedDtor();
 }
 
-void GCRequest_GCKey::SharedDtor() {
-  key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+void HeartbeatTxnRequest::SharedDtor() {
   if (this != default_instance_) {
-    delete timestamp_;
+    delete header_;
   }
 }
 
-void GCRequest_GCKey::SetCachedSize(int size) const {
+void HeartbeatTxnRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* GCRequest_GCKey::descriptor() {
+const ::google::protobuf::Descriptor* HeartbeatTxnRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return GCRequest_GCKey_descriptor_;
+  return HeartbeatTxnRequest_descriptor_;
 }
 
-const GCRequest_GCKey& GCRequest_GCKey::default_instance() {
+const HeartbeatTxnRequest& HeartbeatTxnRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-GCRequest_GCKey* GCRequest_GCKey::default_instance_ = NULL;
+HeartbeatTxnRequest* HeartbeatTxnRequest::default_instance_ = NULL;
 
-GCRequest_GCKey* GCRequest_GCKey::New(::google::protobuf::Arena* arena) const {
-  GCRequest_GCKey* n = new GCRequest_GCKey;
+HeartbeatTxnRequest* HeartbeatTxnRequest::New(::google::protobuf::Arena* arena) const {
+  HeartbeatTxnRequest* n = new HeartbeatTxnRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void GCRequest_GCKey::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
-    if (has_key()) {
-      key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    }
-    if (has_timestamp()) {
-      if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
-    }
+void HeartbeatTxnRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -11811,34 +12125,21 @@ void GCRequest_GCKey::Clear() {
   }
 }
 
-bool GCRequest_GCKey::MergePartialFromCodedStream(
+bool HeartbeatTxnRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.GCRequest.GCKey)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.HeartbeatTxnRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional bytes key = 1;
+      // optional .cockroach.roachpb.Span header = 1;
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_key()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_timestamp;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Timestamp timestamp = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_timestamp:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_timestamp()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
@@ -11860,80 +12161,58 @@ bool GCRequest_GCKey::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.GCRequest.GCKey)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.HeartbeatTxnRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.GCRequest.GCKey)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.HeartbeatTxnRequest)
   return false;
 #undef DO_
 }
 
-void GCRequest_GCKey::SerializeWithCachedSizes(
+void HeartbeatTxnRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.GCRequest.GCKey)
-  // optional bytes key = 1;
-  if (has_key()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      1, this->key(), output);
-  }
-
-  // optional .cockroach.roachpb.Timestamp timestamp = 2;
-  if (has_timestamp()) {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.HeartbeatTxnRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, *this->timestamp_, output);
+      1, *this->header_, output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.GCRequest.GCKey)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.HeartbeatTxnRequest)
 }
 
-::google::protobuf::uint8* GCRequest_GCKey::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* HeartbeatTxnRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.GCRequest.GCKey)
-  // optional bytes key = 1;
-  if (has_key()) {
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        1, this->key(), target);
-  }
-
-  // optional .cockroach.roachpb.Timestamp timestamp = 2;
-  if (has_timestamp()) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.HeartbeatTxnRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, *this->timestamp_, target);
+        1, *this->header_, target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.GCRequest.GCKey)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.HeartbeatTxnRequest)
   return target;
 }
 
-int GCRequest_GCKey::ByteSize() const {
+int HeartbeatTxnRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3u) {
-    // optional bytes key = 1;
-    if (has_key()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->key());
-    }
-
-    // optional .cockroach.roachpb.Timestamp timestamp = 2;
-    if (has_timestamp()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->timestamp_);
-    }
-
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -11945,10 +12224,10 @@ int GCRequest_GCKey::ByteSize() const {
   return total_size;
 }
 
-void GCRequest_GCKey::MergeFrom(const ::google::protobuf::Message& from) {
+void HeartbeatTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const GCRequest_GCKey* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const GCRequest_GCKey>(
+  const HeartbeatTxnRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const HeartbeatTxnRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -11957,15 +12236,11 @@ void GCRequest_GCKey::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void GCRequest_GCKey::MergeFrom(const GCRequest_GCKey& from) {
+void HeartbeatTxnRequest::MergeFrom(const HeartbeatTxnRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_key()) {
-      set_has_key();
-      key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.key_);
-    }
-    if (from.has_timestamp()) {
-      mutable_timestamp()->::cockroach::roachpb::Timestamp::MergeFrom(from.timestamp());
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -11973,133 +12248,177 @@ void GCRequest_GCKey::MergeFrom(const GCRequest_GCKey& from) {
   }
 }
 
-void GCRequest_GCKey::CopyFrom(const ::google::protobuf::Message& from) {
+void HeartbeatTxnRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GCRequest_GCKey::CopyFrom(const GCRequest_GCKey& from) {
+void HeartbeatTxnRequest::CopyFrom(const HeartbeatTxnRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GCRequest_GCKey::IsInitialized() const {
+bool HeartbeatTxnRequest::IsInitialized() const {
 
   return true;
 }
 
-void GCRequest_GCKey::Swap(GCRequest_GCKey* other) {
+void HeartbeatTxnRequest::Swap(HeartbeatTxnRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GCRequest_GCKey::InternalSwap(GCRequest_GCKey* other) {
-  key_.Swap(&other->key_);
-  std::swap(timestamp_, other->timestamp_);
+void HeartbeatTxnRequest::InternalSwap(HeartbeatTxnRequest* other) {
+  std::swap(header_, other->header_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata GCRequest_GCKey::GetMetadata() const {
+::google::protobuf::Metadata HeartbeatTxnRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = GCRequest_GCKey_descriptor_;
-  metadata.reflection = GCRequest_GCKey_reflection_;
+  metadata.descriptor = HeartbeatTxnRequest_descriptor_;
+  metadata.reflection = HeartbeatTxnRequest_reflection_;
   return metadata;
 }
 
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// HeartbeatTxnRequest
 
-// -------------------------------------------------------------------
+// optional .cockroach.roachpb.Span header = 1;
+bool HeartbeatTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void HeartbeatTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void HeartbeatTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void HeartbeatTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::Span& HeartbeatTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::Span* HeartbeatTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnRequest.header)
+  return header_;
+}
+::cockroach::roachpb::Span* HeartbeatTxnRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void HeartbeatTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnRequest.header)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GCRequest::kHeaderFieldNumber;
-const int GCRequest::kKeysFieldNumber;
+const int HeartbeatTxnResponse::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-GCRequest::GCRequest()
+HeartbeatTxnResponse::HeartbeatTxnResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.GCRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.HeartbeatTxnResponse)
 }
 
-void GCRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+void HeartbeatTxnResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
 }
 
-GCRequest::GCRequest(const GCRequest& from)
+HeartbeatTxnResponse::HeartbeatTxnResponse(const HeartbeatTxnResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.GCRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.HeartbeatTxnResponse)
 }
 
-void GCRequest::SharedCtor() {
+void HeartbeatTxnResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-GCRequest::~GCRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.GCRequest)
+HeartbeatTxnResponse::~HeartbeatTxnResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.HeartbeatTxnResponse)
   SharedDtor();
 }
 
-void GCRequest::SharedDtor() {
+void HeartbeatTxnResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void GCRequest::SetCachedSize(int size) const {
+void HeartbeatTxnResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* GCRequest::descriptor() {
+const ::google::protobuf::Descriptor* HeartbeatTxnResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return GCRequest_descriptor_;
+  return HeartbeatTxnResponse_descriptor_;
 }
 
-const GCRequest& GCRequest::default_instance() {
+const HeartbeatTxnResponse& HeartbeatTxnResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-GCRequest* GCRequest::default_instance_ = NULL;
+HeartbeatTxnResponse* HeartbeatTxnResponse::default_instance_ = NULL;
 
-GCRequest* GCRequest::New(::google::protobuf::Arena* arena) const {
-  GCRequest* n = new GCRequest;
+HeartbeatTxnResponse* HeartbeatTxnResponse::New(::google::protobuf::Arena* arena) const {
+  HeartbeatTxnResponse* n = new HeartbeatTxnResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void GCRequest::Clear() {
+void HeartbeatTxnResponse::Clear() {
   if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   }
-  keys_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool GCRequest::MergePartialFromCodedStream(
+bool HeartbeatTxnResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.GCRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.HeartbeatTxnResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.Span header = 1;
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -12107,23 +12426,6 @@ bool GCRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_keys;
-        break;
-      }
-
-      // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_keys:
-          DO_(input->IncrementRecursionDepth());
-         parse_loop_keys:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
-                input, add_keys()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_loop_keys;
-        input->UnsafeDecrementRecursionDepth();
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -12142,79 +12444,58 @@ bool GCRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.GCRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.HeartbeatTxnResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.GCRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.HeartbeatTxnResponse)
   return false;
 #undef DO_
 }
 
-void GCRequest::SerializeWithCachedSizes(
+void HeartbeatTxnResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.GCRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.HeartbeatTxnResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
-  for (unsigned int i = 0, n = this->keys_size(); i < n; i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->keys(i), output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.GCRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.HeartbeatTxnResponse)
 }
 
-::google::protobuf::uint8* GCRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* HeartbeatTxnResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.GCRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.HeartbeatTxnResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, *this->header_, target);
   }
 
-  // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
-  for (unsigned int i = 0, n = this->keys_size(); i < n; i++) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->keys(i), target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.GCRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.HeartbeatTxnResponse)
   return target;
 }
 
-int GCRequest::ByteSize() const {
+int HeartbeatTxnResponse::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.Span header = 1;
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     total_size += 1 +
       ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
         *this->header_);
   }
 
-  // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
-  total_size += 1 * this->keys_size();
-  for (int i = 0; i < this->keys_size(); i++) {
-    total_size +=
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->keys(i));
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -12226,10 +12507,10 @@ int GCRequest::ByteSize() const {
   return total_size;
 }
 
-void GCRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void HeartbeatTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const GCRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const GCRequest>(
+  const HeartbeatTxnResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const HeartbeatTxnResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -12238,12 +12519,11 @@ void GCRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void GCRequest::MergeFrom(const GCRequest& from) {
+void HeartbeatTxnResponse::MergeFrom(const HeartbeatTxnResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  keys_.MergeFrom(from.keys_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -12251,179 +12531,78 @@ void GCRequest::MergeFrom(const GCRequest& from) {
   }
 }
 
-void GCRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void HeartbeatTxnResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GCRequest::CopyFrom(const GCRequest& from) {
+void HeartbeatTxnResponse::CopyFrom(const HeartbeatTxnResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GCRequest::IsInitialized() const {
+bool HeartbeatTxnResponse::IsInitialized() const {
 
   return true;
 }
 
-void GCRequest::Swap(GCRequest* other) {
+void HeartbeatTxnResponse::Swap(HeartbeatTxnResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GCRequest::InternalSwap(GCRequest* other) {
+void HeartbeatTxnResponse::InternalSwap(HeartbeatTxnResponse* other) {
   std::swap(header_, other->header_);
-  keys_.UnsafeArenaSwap(&other->keys_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata GCRequest::GetMetadata() const {
+::google::protobuf::Metadata HeartbeatTxnResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = GCRequest_descriptor_;
-  metadata.reflection = GCRequest_reflection_;
+  metadata.descriptor = HeartbeatTxnResponse_descriptor_;
+  metadata.reflection = HeartbeatTxnResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// GCRequest_GCKey
-
-// optional bytes key = 1;
-bool GCRequest_GCKey::has_key() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-void GCRequest_GCKey::set_has_key() {
-  _has_bits_[0] |= 0x00000001u;
-}
-void GCRequest_GCKey::clear_has_key() {
-  _has_bits_[0] &= ~0x00000001u;
-}
-void GCRequest_GCKey::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_key();
-}
- const ::std::string& GCRequest_GCKey::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.key)
-  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
- void GCRequest_GCKey::set_key(const ::std::string& value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.GCRequest.GCKey.key)
-}
- void GCRequest_GCKey::set_key(const char* value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.GCRequest.GCKey.key)
-}
- void GCRequest_GCKey::set_key(const void* value, size_t size) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.GCRequest.GCKey.key)
-}
- ::std::string* GCRequest_GCKey::mutable_key() {
-  set_has_key();
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
- ::std::string* GCRequest_GCKey::release_key() {
-  clear_has_key();
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
- void GCRequest_GCKey::set_allocated_key(::std::string* key) {
-  if (key != NULL) {
-    set_has_key();
-  } else {
-    clear_has_key();
-  }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.key)
-}
-
-// optional .cockroach.roachpb.Timestamp timestamp = 2;
-bool GCRequest_GCKey::has_timestamp() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void GCRequest_GCKey::set_has_timestamp() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void GCRequest_GCKey::clear_has_timestamp() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void GCRequest_GCKey::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_timestamp();
-}
-const ::cockroach::roachpb::Timestamp& GCRequest_GCKey::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
-}
-::cockroach::roachpb::Timestamp* GCRequest_GCKey::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) {
-    timestamp_ = new ::cockroach::roachpb::Timestamp;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.timestamp)
-  return timestamp_;
-}
-::cockroach::roachpb::Timestamp* GCRequest_GCKey::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::roachpb::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
-  return temp;
-}
-void GCRequest_GCKey::set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
-  } else {
-    clear_has_timestamp();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.timestamp)
-}
-
-// -------------------------------------------------------------------
-
-// GCRequest
+// HeartbeatTxnResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-bool GCRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool HeartbeatTxnResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void GCRequest::set_has_header() {
+void HeartbeatTxnResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void GCRequest::clear_has_header() {
+void HeartbeatTxnResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void GCRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+void HeartbeatTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& GCRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.header)
+const ::cockroach::roachpb::ResponseHeader& HeartbeatTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* GCRequest::mutable_header() {
+::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnResponse.header)
   return header_;
 }
-::cockroach::roachpb::Span* GCRequest::release_header() {
+::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-void GCRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void HeartbeatTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -12431,110 +12610,89 @@ void GCRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnResponse.header)
 }
 
-// repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
-int GCRequest::keys_size() const {
-  return keys_.size();
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GCRequest_GCKey::kKeyFieldNumber;
+const int GCRequest_GCKey::kTimestampFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GCRequest_GCKey::GCRequest_GCKey()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.GCRequest.GCKey)
 }
-void GCRequest::clear_keys() {
-  keys_.Clear();
-}
-const ::cockroach::roachpb::GCRequest_GCKey& GCRequest::keys(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.keys)
-  return keys_.Get(index);
-}
-::cockroach::roachpb::GCRequest_GCKey* GCRequest::mutable_keys(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.keys)
-  return keys_.Mutable(index);
-}
-::cockroach::roachpb::GCRequest_GCKey* GCRequest::add_keys() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.GCRequest.keys)
-  return keys_.Add();
-}
-::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >*
-GCRequest::mutable_keys() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.GCRequest.keys)
-  return &keys_;
-}
-const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >&
-GCRequest::keys() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.GCRequest.keys)
-  return keys_;
-}
-
-#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
-
-// ===================================================================
-
-#if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GCResponse::kHeaderFieldNumber;
-#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
-
-GCResponse::GCResponse()
-  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
-  SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.GCResponse)
-}
-
-void GCResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+
+void GCRequest_GCKey::InitAsDefaultInstance() {
+  timestamp_ = const_cast< ::cockroach::roachpb::Timestamp*>(&::cockroach::roachpb::Timestamp::default_instance());
 }
 
-GCResponse::GCResponse(const GCResponse& from)
+GCRequest_GCKey::GCRequest_GCKey(const GCRequest_GCKey& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.GCResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.GCRequest.GCKey)
 }
 
-void GCResponse::SharedCtor() {
+void GCRequest_GCKey::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  header_ = NULL;
+  key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  timestamp_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-GCResponse::~GCResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.GCResponse)
+GCRequest_GCKey::~GCRequest_GCKey() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.GCRequest.GCKey)
   SharedDtor();
 }
 
-void GCResponse::SharedDtor() {
+void GCRequest_GCKey::SharedDtor() {
+  key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != default_instance_) {
-    delete header_;
+    delete timestamp_;
   }
 }
 
-void GCResponse::SetCachedSize(int size) const {
+void GCRequest_GCKey::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* GCResponse::descriptor() {
+const ::google::protobuf::Descriptor* GCRequest_GCKey::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return GCResponse_descriptor_;
+  return GCRequest_GCKey_descriptor_;
 }
 
-const GCResponse& GCResponse::default_instance() {
+const GCRequest_GCKey& GCRequest_GCKey::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-GCResponse* GCResponse::default_instance_ = NULL;
+GCRequest_GCKey* GCRequest_GCKey::default_instance_ = NULL;
 
-GCResponse* GCResponse::New(::google::protobuf::Arena* arena) const {
-  GCResponse* n = new GCResponse;
+GCRequest_GCKey* GCRequest_GCKey::New(::google::protobuf::Arena* arena) const {
+  GCRequest_GCKey* n = new GCRequest_GCKey;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void GCResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void GCRequest_GCKey::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_key()) {
+      key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -12542,21 +12700,34 @@ void GCResponse::Clear() {
   }
 }
 
-bool GCResponse::MergePartialFromCodedStream(
+bool GCRequest_GCKey::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.GCResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.GCRequest.GCKey)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      // optional bytes key = 1;
       case 1: {
         if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Timestamp timestamp = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_timestamp:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
+               input, mutable_timestamp()));
         } else {
           goto handle_unusual;
         }
@@ -12578,58 +12749,80 @@ bool GCResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.GCResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.GCRequest.GCKey)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.GCResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.GCRequest.GCKey)
   return false;
 #undef DO_
 }
 
-void GCResponse::SerializeWithCachedSizes(
+void GCRequest_GCKey::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.GCResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.GCRequest.GCKey)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional .cockroach.roachpb.Timestamp timestamp = 2;
+  if (has_timestamp()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, *this->header_, output);
+      2, *this->timestamp_, output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.GCResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.GCRequest.GCKey)
 }
 
-::google::protobuf::uint8* GCResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GCRequest_GCKey::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.GCResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.GCRequest.GCKey)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional .cockroach.roachpb.Timestamp timestamp = 2;
+  if (has_timestamp()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        1, *this->header_, target);
+        2, *this->timestamp_, target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.GCResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.GCRequest.GCKey)
   return target;
 }
 
-int GCResponse::ByteSize() const {
+int GCRequest_GCKey::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 3u) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional .cockroach.roachpb.Timestamp timestamp = 2;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->timestamp_);
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -12641,10 +12834,10 @@ int GCResponse::ByteSize() const {
   return total_size;
 }
 
-void GCResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void GCRequest_GCKey::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const GCResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const GCResponse>(
+  const GCRequest_GCKey* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const GCRequest_GCKey>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -12653,11 +12846,15 @@ void GCResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void GCResponse::MergeFrom(const GCResponse& from) {
+void GCRequest_GCKey::MergeFrom(const GCRequest_GCKey& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+    if (from.has_key()) {
+      set_has_key();
+      key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.key_);
+    }
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::cockroach::roachpb::Timestamp::MergeFrom(from.timestamp());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -12665,204 +12862,127 @@ void GCResponse::MergeFrom(const GCResponse& from) {
   }
 }
 
-void GCResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void GCRequest_GCKey::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GCResponse::CopyFrom(const GCResponse& from) {
+void GCRequest_GCKey::CopyFrom(const GCRequest_GCKey& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GCResponse::IsInitialized() const {
+bool GCRequest_GCKey::IsInitialized() const {
 
   return true;
 }
 
-void GCResponse::Swap(GCResponse* other) {
+void GCRequest_GCKey::Swap(GCRequest_GCKey* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GCResponse::InternalSwap(GCResponse* other) {
-  std::swap(header_, other->header_);
+void GCRequest_GCKey::InternalSwap(GCRequest_GCKey* other) {
+  key_.Swap(&other->key_);
+  std::swap(timestamp_, other->timestamp_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata GCResponse::GetMetadata() const {
+::google::protobuf::Metadata GCRequest_GCKey::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = GCResponse_descriptor_;
-  metadata.reflection = GCResponse_reflection_;
+  metadata.descriptor = GCRequest_GCKey_descriptor_;
+  metadata.reflection = GCRequest_GCKey_reflection_;
   return metadata;
 }
 
-#if PROTOBUF_INLINE_NOT_IN_HEADERS
-// GCResponse
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-bool GCResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// -------------------------------------------------------------------
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GCRequest::kHeaderFieldNumber;
+const int GCRequest::kKeysFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GCRequest::GCRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.GCRequest)
 }
-void GCResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+
+void GCRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
-void GCResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+GCRequest::GCRequest(const GCRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.GCRequest)
 }
-void GCResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
-  clear_has_header();
+
+void GCRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
-const ::cockroach::roachpb::ResponseHeader& GCResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+
+GCRequest::~GCRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.GCRequest)
+  SharedDtor();
 }
-::cockroach::roachpb::ResponseHeader* GCResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCResponse.header)
-  return header_;
-}
-::cockroach::roachpb::ResponseHeader* GCResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
-}
-void GCResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCResponse.header)
-}
-
-#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
-
-// ===================================================================
-
-#if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int PushTxnRequest::kHeaderFieldNumber;
-const int PushTxnRequest::kPusherTxnFieldNumber;
-const int PushTxnRequest::kPusheeTxnFieldNumber;
-const int PushTxnRequest::kPushToFieldNumber;
-const int PushTxnRequest::kNowFieldNumber;
-const int PushTxnRequest::kPushTypeFieldNumber;
-#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
-
-PushTxnRequest::PushTxnRequest()
-  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
-  SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.PushTxnRequest)
-}
-
-void PushTxnRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
-  pusher_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
-  pushee_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
-  push_to_ = const_cast< ::cockroach::roachpb::Timestamp*>(&::cockroach::roachpb::Timestamp::default_instance());
-  now_ = const_cast< ::cockroach::roachpb::Timestamp*>(&::cockroach::roachpb::Timestamp::default_instance());
-}
-
-PushTxnRequest::PushTxnRequest(const PushTxnRequest& from)
-  : ::google::protobuf::Message(),
-    _internal_metadata_(NULL) {
-  SharedCtor();
-  MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.PushTxnRequest)
-}
-
-void PushTxnRequest::SharedCtor() {
-  _cached_size_ = 0;
-  header_ = NULL;
-  pusher_txn_ = NULL;
-  pushee_txn_ = NULL;
-  push_to_ = NULL;
-  now_ = NULL;
-  push_type_ = 0;
-  ::memset(_has_bits_, 0, sizeof(_has_bits_));
-}
-
-PushTxnRequest::~PushTxnRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.PushTxnRequest)
-  SharedDtor();
-}
-
-void PushTxnRequest::SharedDtor() {
-  if (this != default_instance_) {
-    delete header_;
-    delete pusher_txn_;
-    delete pushee_txn_;
-    delete push_to_;
-    delete now_;
+
+void GCRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
   }
 }
 
-void PushTxnRequest::SetCachedSize(int size) const {
+void GCRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* PushTxnRequest::descriptor() {
+const ::google::protobuf::Descriptor* GCRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return PushTxnRequest_descriptor_;
+  return GCRequest_descriptor_;
 }
 
-const PushTxnRequest& PushTxnRequest::default_instance() {
+const GCRequest& GCRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-PushTxnRequest* PushTxnRequest::default_instance_ = NULL;
+GCRequest* GCRequest::default_instance_ = NULL;
 
-PushTxnRequest* PushTxnRequest::New(::google::protobuf::Arena* arena) const {
-  PushTxnRequest* n = new PushTxnRequest;
+GCRequest* GCRequest::New(::google::protobuf::Arena* arena) const {
+  GCRequest* n = new GCRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void PushTxnRequest::Clear() {
-  if (_has_bits_[0 / 32] & 63u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-    }
-    if (has_pusher_txn()) {
-      if (pusher_txn_ != NULL) pusher_txn_->::cockroach::roachpb::Transaction::Clear();
-    }
-    if (has_pushee_txn()) {
-      if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
-    }
-    if (has_push_to()) {
-      if (push_to_ != NULL) push_to_->::cockroach::roachpb::Timestamp::Clear();
-    }
-    if (has_now()) {
-      if (now_ != NULL) now_->::cockroach::roachpb::Timestamp::Clear();
-    }
-    push_type_ = 0;
+void GCRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   }
+  keys_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool PushTxnRequest::MergePartialFromCodedStream(
+bool GCRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.PushTxnRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.GCRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -12876,78 +12996,23 @@ bool PushTxnRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_pusher_txn;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Transaction pusher_txn = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_pusher_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_pusher_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_pushee_txn;
+        if (input->ExpectTag(26)) goto parse_keys;
         break;
       }
 
-      // optional .cockroach.roachpb.Transaction pushee_txn = 3;
+      // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
       case 3: {
         if (tag == 26) {
-         parse_pushee_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_pushee_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(34)) goto parse_push_to;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Timestamp push_to = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_push_to:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_push_to()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(42)) goto parse_now;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Timestamp now = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_now:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_now()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(48)) goto parse_push_type;
-        break;
-      }
-
-      // optional .cockroach.roachpb.PushTxnType push_type = 6;
-      case 6: {
-        if (tag == 48) {
-         parse_push_type:
-          int value;
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          if (::cockroach::roachpb::PushTxnType_IsValid(value)) {
-            set_push_type(static_cast< ::cockroach::roachpb::PushTxnType >(value));
-          } else {
-            mutable_unknown_fields()->AddVarint(6, value);
-          }
+         parse_keys:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_keys:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_keys()));
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(26)) goto parse_loop_keys;
+        input->UnsafeDecrementRecursionDepth();
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -12966,63 +13031,39 @@ bool PushTxnRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.PushTxnRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.GCRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.PushTxnRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.GCRequest)
   return false;
 #undef DO_
 }
 
-void PushTxnRequest::SerializeWithCachedSizes(
+void GCRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.PushTxnRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.GCRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional .cockroach.roachpb.Transaction pusher_txn = 2;
-  if (has_pusher_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, *this->pusher_txn_, output);
-  }
-
-  // optional .cockroach.roachpb.Transaction pushee_txn = 3;
-  if (has_pushee_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, *this->pushee_txn_, output);
-  }
-
-  // optional .cockroach.roachpb.Timestamp push_to = 4;
-  if (has_push_to()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, *this->push_to_, output);
-  }
-
-  // optional .cockroach.roachpb.Timestamp now = 5;
-  if (has_now()) {
+  // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
+  for (unsigned int i = 0, n = this->keys_size(); i < n; i++) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      5, *this->now_, output);
-  }
-
-  // optional .cockroach.roachpb.PushTxnType push_type = 6;
-  if (has_push_type()) {
-    ::google::protobuf::internal::WireFormatLite::WriteEnum(
-      6, this->push_type(), output);
+      3, this->keys(i), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.PushTxnRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.GCRequest)
 }
 
-::google::protobuf::uint8* PushTxnRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GCRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.PushTxnRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.GCRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -13030,94 +13071,39 @@ void PushTxnRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // optional .cockroach.roachpb.Transaction pusher_txn = 2;
-  if (has_pusher_txn()) {
+  // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
+  for (unsigned int i = 0, n = this->keys_size(); i < n; i++) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, *this->pusher_txn_, target);
+        3, this->keys(i), target);
   }
 
-  // optional .cockroach.roachpb.Transaction pushee_txn = 3;
-  if (has_pushee_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, *this->pushee_txn_, target);
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
   }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.GCRequest)
+  return target;
+}
 
-  // optional .cockroach.roachpb.Timestamp push_to = 4;
-  if (has_push_to()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        4, *this->push_to_, target);
-  }
-
-  // optional .cockroach.roachpb.Timestamp now = 5;
-  if (has_now()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        5, *this->now_, target);
-  }
+int GCRequest::ByteSize() const {
+  int total_size = 0;
 
-  // optional .cockroach.roachpb.PushTxnType push_type = 6;
-  if (has_push_type()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
-      6, this->push_type(), target);
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
 
-  if (_internal_metadata_.have_unknown_fields()) {
-    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
-        unknown_fields(), target);
+  // repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
+  total_size += 1 * this->keys_size();
+  for (int i = 0; i < this->keys_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->keys(i));
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.PushTxnRequest)
-  return target;
-}
-
-int PushTxnRequest::ByteSize() const {
-  int total_size = 0;
-
-  if (_has_bits_[0 / 32] & 63u) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional .cockroach.roachpb.Transaction pusher_txn = 2;
-    if (has_pusher_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->pusher_txn_);
-    }
-
-    // optional .cockroach.roachpb.Transaction pushee_txn = 3;
-    if (has_pushee_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->pushee_txn_);
-    }
-
-    // optional .cockroach.roachpb.Timestamp push_to = 4;
-    if (has_push_to()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->push_to_);
-    }
-
-    // optional .cockroach.roachpb.Timestamp now = 5;
-    if (has_now()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->now_);
-    }
-
-    // optional .cockroach.roachpb.PushTxnType push_type = 6;
-    if (has_push_type()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::EnumSize(this->push_type());
-    }
 
-  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -13129,10 +13115,10 @@ int PushTxnRequest::ByteSize() const {
   return total_size;
 }
 
-void PushTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void GCRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const PushTxnRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const PushTxnRequest>(
+  const GCRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const GCRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -13141,110 +13127,192 @@ void PushTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void PushTxnRequest::MergeFrom(const PushTxnRequest& from) {
+void GCRequest::MergeFrom(const GCRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  keys_.MergeFrom(from.keys_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
-    if (from.has_pusher_txn()) {
-      mutable_pusher_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.pusher_txn());
-    }
-    if (from.has_pushee_txn()) {
-      mutable_pushee_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.pushee_txn());
-    }
-    if (from.has_push_to()) {
-      mutable_push_to()->::cockroach::roachpb::Timestamp::MergeFrom(from.push_to());
-    }
-    if (from.has_now()) {
-      mutable_now()->::cockroach::roachpb::Timestamp::MergeFrom(from.now());
-    }
-    if (from.has_push_type()) {
-      set_push_type(from.push_type());
-    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void PushTxnRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void GCRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void PushTxnRequest::CopyFrom(const PushTxnRequest& from) {
+void GCRequest::CopyFrom(const GCRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool PushTxnRequest::IsInitialized() const {
+bool GCRequest::IsInitialized() const {
 
   return true;
 }
 
-void PushTxnRequest::Swap(PushTxnRequest* other) {
+void GCRequest::Swap(GCRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void PushTxnRequest::InternalSwap(PushTxnRequest* other) {
+void GCRequest::InternalSwap(GCRequest* other) {
   std::swap(header_, other->header_);
-  std::swap(pusher_txn_, other->pusher_txn_);
-  std::swap(pushee_txn_, other->pushee_txn_);
-  std::swap(push_to_, other->push_to_);
-  std::swap(now_, other->now_);
-  std::swap(push_type_, other->push_type_);
+  keys_.UnsafeArenaSwap(&other->keys_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata PushTxnRequest::GetMetadata() const {
+::google::protobuf::Metadata GCRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = PushTxnRequest_descriptor_;
-  metadata.reflection = PushTxnRequest_reflection_;
+  metadata.descriptor = GCRequest_descriptor_;
+  metadata.reflection = GCRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// PushTxnRequest
+// GCRequest_GCKey
+
+// optional bytes key = 1;
+bool GCRequest_GCKey::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void GCRequest_GCKey::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void GCRequest_GCKey::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void GCRequest_GCKey::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
+}
+ const ::std::string& GCRequest_GCKey::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void GCRequest_GCKey::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.GCRequest.GCKey.key)
+}
+ void GCRequest_GCKey::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.GCRequest.GCKey.key)
+}
+ void GCRequest_GCKey::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.GCRequest.GCKey.key)
+}
+ ::std::string* GCRequest_GCKey::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* GCRequest_GCKey::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void GCRequest_GCKey::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
+  } else {
+    clear_has_key();
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.key)
+}
+
+// optional .cockroach.roachpb.Timestamp timestamp = 2;
+bool GCRequest_GCKey::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void GCRequest_GCKey::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void GCRequest_GCKey::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void GCRequest_GCKey::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_timestamp();
+}
+const ::cockroach::roachpb::Timestamp& GCRequest_GCKey::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+::cockroach::roachpb::Timestamp* GCRequest_GCKey::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::roachpb::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.timestamp)
+  return timestamp_;
+}
+::cockroach::roachpb::Timestamp* GCRequest_GCKey::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::roachpb::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+void GCRequest_GCKey::set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.timestamp)
+}
+
+// -------------------------------------------------------------------
+
+// GCRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-bool PushTxnRequest::has_header() const {
+bool GCRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void PushTxnRequest::set_has_header() {
+void GCRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void PushTxnRequest::clear_has_header() {
+void GCRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void PushTxnRequest::clear_header() {
+void GCRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& PushTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.header)
+const ::cockroach::roachpb::Span& GCRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* PushTxnRequest::mutable_header() {
+::cockroach::roachpb::Span* GCRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.header)
   return header_;
 }
-::cockroach::roachpb::Span* PushTxnRequest::release_header() {
+::cockroach::roachpb::Span* GCRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void PushTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void GCRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -13252,204 +13320,2835 @@ void PushTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.header)
 }
 
-// optional .cockroach.roachpb.Transaction pusher_txn = 2;
-bool PushTxnRequest::has_pusher_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void PushTxnRequest::set_has_pusher_txn() {
-  _has_bits_[0] |= 0x00000002u;
+// repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
+int GCRequest::keys_size() const {
+  return keys_.size();
+}
+void GCRequest::clear_keys() {
+  keys_.Clear();
+}
+const ::cockroach::roachpb::GCRequest_GCKey& GCRequest::keys(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.keys)
+  return keys_.Get(index);
+}
+::cockroach::roachpb::GCRequest_GCKey* GCRequest::mutable_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.keys)
+  return keys_.Mutable(index);
+}
+::cockroach::roachpb::GCRequest_GCKey* GCRequest::add_keys() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.GCRequest.keys)
+  return keys_.Add();
+}
+::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >*
+GCRequest::mutable_keys() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.GCRequest.keys)
+  return &keys_;
+}
+const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >&
+GCRequest::keys() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.GCRequest.keys)
+  return keys_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GCResponse::kHeaderFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GCResponse::GCResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.GCResponse)
+}
+
+void GCResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+}
+
+GCResponse::GCResponse(const GCResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.GCResponse)
+}
+
+void GCResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+GCResponse::~GCResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.GCResponse)
+  SharedDtor();
+}
+
+void GCResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void GCResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* GCResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return GCResponse_descriptor_;
+}
+
+const GCResponse& GCResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+GCResponse* GCResponse::default_instance_ = NULL;
+
+GCResponse* GCResponse::New(::google::protobuf::Arena* arena) const {
+  GCResponse* n = new GCResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void GCResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool GCResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.GCResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.GCResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.GCResponse)
+  return false;
+#undef DO_
+}
+
+void GCResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.GCResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.GCResponse)
+}
+
+::google::protobuf::uint8* GCResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.GCResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.GCResponse)
+  return target;
+}
+
+int GCResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void GCResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const GCResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const GCResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void GCResponse::MergeFrom(const GCResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void GCResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void GCResponse::CopyFrom(const GCResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GCResponse::IsInitialized() const {
+
+  return true;
+}
+
+void GCResponse::Swap(GCResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GCResponse::InternalSwap(GCResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata GCResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = GCResponse_descriptor_;
+  metadata.reflection = GCResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// GCResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool GCResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void GCResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void GCResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void GCResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::ResponseHeader& GCResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::ResponseHeader* GCResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCResponse.header)
+  return header_;
+}
+::cockroach::roachpb::ResponseHeader* GCResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void GCResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCResponse.header)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int PushTxnRequest::kHeaderFieldNumber;
+const int PushTxnRequest::kPusherTxnFieldNumber;
+const int PushTxnRequest::kPusheeTxnFieldNumber;
+const int PushTxnRequest::kPushToFieldNumber;
+const int PushTxnRequest::kNowFieldNumber;
+const int PushTxnRequest::kPushTypeFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+PushTxnRequest::PushTxnRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.PushTxnRequest)
+}
+
+void PushTxnRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+  pusher_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+  pushee_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+  push_to_ = const_cast< ::cockroach::roachpb::Timestamp*>(&::cockroach::roachpb::Timestamp::default_instance());
+  now_ = const_cast< ::cockroach::roachpb::Timestamp*>(&::cockroach::roachpb::Timestamp::default_instance());
+}
+
+PushTxnRequest::PushTxnRequest(const PushTxnRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.PushTxnRequest)
+}
+
+void PushTxnRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  pusher_txn_ = NULL;
+  pushee_txn_ = NULL;
+  push_to_ = NULL;
+  now_ = NULL;
+  push_type_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+PushTxnRequest::~PushTxnRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.PushTxnRequest)
+  SharedDtor();
+}
+
+void PushTxnRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete pusher_txn_;
+    delete pushee_txn_;
+    delete push_to_;
+    delete now_;
+  }
+}
+
+void PushTxnRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* PushTxnRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return PushTxnRequest_descriptor_;
+}
+
+const PushTxnRequest& PushTxnRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+PushTxnRequest* PushTxnRequest::default_instance_ = NULL;
+
+PushTxnRequest* PushTxnRequest::New(::google::protobuf::Arena* arena) const {
+  PushTxnRequest* n = new PushTxnRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void PushTxnRequest::Clear() {
+  if (_has_bits_[0 / 32] & 63u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+    if (has_pusher_txn()) {
+      if (pusher_txn_ != NULL) pusher_txn_->::cockroach::roachpb::Transaction::Clear();
+    }
+    if (has_pushee_txn()) {
+      if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
+    }
+    if (has_push_to()) {
+      if (push_to_ != NULL) push_to_->::cockroach::roachpb::Timestamp::Clear();
+    }
+    if (has_now()) {
+      if (now_ != NULL) now_->::cockroach::roachpb::Timestamp::Clear();
+    }
+    push_type_ = 0;
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool PushTxnRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.PushTxnRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.Span header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_pusher_txn;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Transaction pusher_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_pusher_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_pusher_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_pushee_txn;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Transaction pushee_txn = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_pushee_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_pushee_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_push_to;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Timestamp push_to = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_push_to:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_push_to()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_now;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Timestamp now = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_now:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_now()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_push_type;
+        break;
+      }
+
+      // optional .cockroach.roachpb.PushTxnType push_type = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_push_type:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::roachpb::PushTxnType_IsValid(value)) {
+            set_push_type(static_cast< ::cockroach::roachpb::PushTxnType >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(6, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.PushTxnRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.PushTxnRequest)
+  return false;
+#undef DO_
+}
+
+void PushTxnRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.PushTxnRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.roachpb.Transaction pusher_txn = 2;
+  if (has_pusher_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->pusher_txn_, output);
+  }
+
+  // optional .cockroach.roachpb.Transaction pushee_txn = 3;
+  if (has_pushee_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, *this->pushee_txn_, output);
+  }
+
+  // optional .cockroach.roachpb.Timestamp push_to = 4;
+  if (has_push_to()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, *this->push_to_, output);
+  }
+
+  // optional .cockroach.roachpb.Timestamp now = 5;
+  if (has_now()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, *this->now_, output);
+  }
+
+  // optional .cockroach.roachpb.PushTxnType push_type = 6;
+  if (has_push_type()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      6, this->push_type(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.PushTxnRequest)
+}
+
+::google::protobuf::uint8* PushTxnRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.PushTxnRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.roachpb.Transaction pusher_txn = 2;
+  if (has_pusher_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->pusher_txn_, target);
+  }
+
+  // optional .cockroach.roachpb.Transaction pushee_txn = 3;
+  if (has_pushee_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, *this->pushee_txn_, target);
+  }
+
+  // optional .cockroach.roachpb.Timestamp push_to = 4;
+  if (has_push_to()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, *this->push_to_, target);
+  }
+
+  // optional .cockroach.roachpb.Timestamp now = 5;
+  if (has_now()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, *this->now_, target);
+  }
+
+  // optional .cockroach.roachpb.PushTxnType push_type = 6;
+  if (has_push_type()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      6, this->push_type(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.PushTxnRequest)
+  return target;
+}
+
+int PushTxnRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 63u) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.roachpb.Transaction pusher_txn = 2;
+    if (has_pusher_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->pusher_txn_);
+    }
+
+    // optional .cockroach.roachpb.Transaction pushee_txn = 3;
+    if (has_pushee_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->pushee_txn_);
+    }
+
+    // optional .cockroach.roachpb.Timestamp push_to = 4;
+    if (has_push_to()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->push_to_);
+    }
+
+    // optional .cockroach.roachpb.Timestamp now = 5;
+    if (has_now()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->now_);
+    }
+
+    // optional .cockroach.roachpb.PushTxnType push_type = 6;
+    if (has_push_type()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->push_type());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void PushTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const PushTxnRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const PushTxnRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void PushTxnRequest::MergeFrom(const PushTxnRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_pusher_txn()) {
+      mutable_pusher_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.pusher_txn());
+    }
+    if (from.has_pushee_txn()) {
+      mutable_pushee_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.pushee_txn());
+    }
+    if (from.has_push_to()) {
+      mutable_push_to()->::cockroach::roachpb::Timestamp::MergeFrom(from.push_to());
+    }
+    if (from.has_now()) {
+      mutable_now()->::cockroach::roachpb::Timestamp::MergeFrom(from.now());
+    }
+    if (from.has_push_type()) {
+      set_push_type(from.push_type());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void PushTxnRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void PushTxnRequest::CopyFrom(const PushTxnRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool PushTxnRequest::IsInitialized() const {
+
+  return true;
+}
+
+void PushTxnRequest::Swap(PushTxnRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void PushTxnRequest::InternalSwap(PushTxnRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(pusher_txn_, other->pusher_txn_);
+  std::swap(pushee_txn_, other->pushee_txn_);
+  std::swap(push_to_, other->push_to_);
+  std::swap(now_, other->now_);
+  std::swap(push_type_, other->push_type_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata PushTxnRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = PushTxnRequest_descriptor_;
+  metadata.reflection = PushTxnRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// PushTxnRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+bool PushTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void PushTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void PushTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void PushTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::Span& PushTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::Span* PushTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.header)
+  return header_;
+}
+::cockroach::roachpb::Span* PushTxnRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void PushTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.header)
+}
+
+// optional .cockroach.roachpb.Transaction pusher_txn = 2;
+bool PushTxnRequest::has_pusher_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void PushTxnRequest::set_has_pusher_txn() {
+  _has_bits_[0] |= 0x00000002u;
 }
 void PushTxnRequest::clear_has_pusher_txn() {
   _has_bits_[0] &= ~0x00000002u;
 }
-void PushTxnRequest::clear_pusher_txn() {
-  if (pusher_txn_ != NULL) pusher_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_pusher_txn();
+void PushTxnRequest::clear_pusher_txn() {
+  if (pusher_txn_ != NULL) pusher_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_pusher_txn();
+}
+const ::cockroach::roachpb::Transaction& PushTxnRequest::pusher_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pusher_txn)
+  return pusher_txn_ != NULL ? *pusher_txn_ : *default_instance_->pusher_txn_;
+}
+::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pusher_txn() {
+  set_has_pusher_txn();
+  if (pusher_txn_ == NULL) {
+    pusher_txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pusher_txn)
+  return pusher_txn_;
+}
+::cockroach::roachpb::Transaction* PushTxnRequest::release_pusher_txn() {
+  clear_has_pusher_txn();
+  ::cockroach::roachpb::Transaction* temp = pusher_txn_;
+  pusher_txn_ = NULL;
+  return temp;
+}
+void PushTxnRequest::set_allocated_pusher_txn(::cockroach::roachpb::Transaction* pusher_txn) {
+  delete pusher_txn_;
+  pusher_txn_ = pusher_txn;
+  if (pusher_txn) {
+    set_has_pusher_txn();
+  } else {
+    clear_has_pusher_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pusher_txn)
+}
+
+// optional .cockroach.roachpb.Transaction pushee_txn = 3;
+bool PushTxnRequest::has_pushee_txn() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void PushTxnRequest::set_has_pushee_txn() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void PushTxnRequest::clear_has_pushee_txn() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void PushTxnRequest::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_pushee_txn();
+}
+const ::cockroach::roachpb::Transaction& PushTxnRequest::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+}
+::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) {
+    pushee_txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pushee_txn)
+  return pushee_txn_;
+}
+::cockroach::roachpb::Transaction* PushTxnRequest::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+void PushTxnRequest::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pushee_txn)
+}
+
+// optional .cockroach.roachpb.Timestamp push_to = 4;
+bool PushTxnRequest::has_push_to() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void PushTxnRequest::set_has_push_to() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void PushTxnRequest::clear_has_push_to() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void PushTxnRequest::clear_push_to() {
+  if (push_to_ != NULL) push_to_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_push_to();
+}
+const ::cockroach::roachpb::Timestamp& PushTxnRequest::push_to() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_to)
+  return push_to_ != NULL ? *push_to_ : *default_instance_->push_to_;
+}
+::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_push_to() {
+  set_has_push_to();
+  if (push_to_ == NULL) {
+    push_to_ = new ::cockroach::roachpb::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.push_to)
+  return push_to_;
+}
+::cockroach::roachpb::Timestamp* PushTxnRequest::release_push_to() {
+  clear_has_push_to();
+  ::cockroach::roachpb::Timestamp* temp = push_to_;
+  push_to_ = NULL;
+  return temp;
+}
+void PushTxnRequest::set_allocated_push_to(::cockroach::roachpb::Timestamp* push_to) {
+  delete push_to_;
+  push_to_ = push_to;
+  if (push_to) {
+    set_has_push_to();
+  } else {
+    clear_has_push_to();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.push_to)
+}
+
+// optional .cockroach.roachpb.Timestamp now = 5;
+bool PushTxnRequest::has_now() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+void PushTxnRequest::set_has_now() {
+  _has_bits_[0] |= 0x00000010u;
+}
+void PushTxnRequest::clear_has_now() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+void PushTxnRequest::clear_now() {
+  if (now_ != NULL) now_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_now();
+}
+const ::cockroach::roachpb::Timestamp& PushTxnRequest::now() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.now)
+  return now_ != NULL ? *now_ : *default_instance_->now_;
+}
+::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_now() {
+  set_has_now();
+  if (now_ == NULL) {
+    now_ = new ::cockroach::roachpb::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.now)
+  return now_;
+}
+::cockroach::roachpb::Timestamp* PushTxnRequest::release_now() {
+  clear_has_now();
+  ::cockroach::roachpb::Timestamp* temp = now_;
+  now_ = NULL;
+  return temp;
+}
+void PushTxnRequest::set_allocated_now(::cockroach::roachpb::Timestamp* now) {
+  delete now_;
+  now_ = now;
+  if (now) {
+    set_has_now();
+  } else {
+    clear_has_now();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.now)
+}
+
+// optional .cockroach.roachpb.PushTxnType push_type = 6;
+bool PushTxnRequest::has_push_type() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+void PushTxnRequest::set_has_push_type() {
+  _has_bits_[0] |= 0x00000020u;
+}
+void PushTxnRequest::clear_has_push_type() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+void PushTxnRequest::clear_push_type() {
+  push_type_ = 0;
+  clear_has_push_type();
+}
+ ::cockroach::roachpb::PushTxnType PushTxnRequest::push_type() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_type)
+  return static_cast< ::cockroach::roachpb::PushTxnType >(push_type_);
+}
+ void PushTxnRequest::set_push_type(::cockroach::roachpb::PushTxnType value) {
+  assert(::cockroach::roachpb::PushTxnType_IsValid(value));
+  set_has_push_type();
+  push_type_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.PushTxnRequest.push_type)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int PushTxnResponse::kHeaderFieldNumber;
+const int PushTxnResponse::kPusheeTxnFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+PushTxnResponse::PushTxnResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.PushTxnResponse)
+}
+
+void PushTxnResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+  pushee_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+}
+
+PushTxnResponse::PushTxnResponse(const PushTxnResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.PushTxnResponse)
+}
+
+void PushTxnResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  pushee_txn_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+PushTxnResponse::~PushTxnResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.PushTxnResponse)
+  SharedDtor();
+}
+
+void PushTxnResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete pushee_txn_;
+  }
+}
+
+void PushTxnResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* PushTxnResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return PushTxnResponse_descriptor_;
+}
+
+const PushTxnResponse& PushTxnResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+PushTxnResponse* PushTxnResponse::default_instance_ = NULL;
+
+PushTxnResponse* PushTxnResponse::New(::google::protobuf::Arena* arena) const {
+  PushTxnResponse* n = new PushTxnResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void PushTxnResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+    }
+    if (has_pushee_txn()) {
+      if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool PushTxnResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.PushTxnResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_pushee_txn;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Transaction pushee_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_pushee_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_pushee_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.PushTxnResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.PushTxnResponse)
+  return false;
+#undef DO_
+}
+
+void PushTxnResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.PushTxnResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.roachpb.Transaction pushee_txn = 2;
+  if (has_pushee_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->pushee_txn_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.PushTxnResponse)
+}
+
+::google::protobuf::uint8* PushTxnResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.PushTxnResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.roachpb.Transaction pushee_txn = 2;
+  if (has_pushee_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->pushee_txn_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.PushTxnResponse)
+  return target;
+}
+
+int PushTxnResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3u) {
+    // optional .cockroach.roachpb.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.roachpb.Transaction pushee_txn = 2;
+    if (has_pushee_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->pushee_txn_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void PushTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const PushTxnResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const PushTxnResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void PushTxnResponse::MergeFrom(const PushTxnResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_pushee_txn()) {
+      mutable_pushee_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.pushee_txn());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void PushTxnResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void PushTxnResponse::CopyFrom(const PushTxnResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool PushTxnResponse::IsInitialized() const {
+
+  return true;
+}
+
+void PushTxnResponse::Swap(PushTxnResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void PushTxnResponse::InternalSwap(PushTxnResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(pushee_txn_, other->pushee_txn_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata PushTxnResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = PushTxnResponse_descriptor_;
+  metadata.reflection = PushTxnResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// PushTxnResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool PushTxnResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void PushTxnResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void PushTxnResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void PushTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::ResponseHeader& PushTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::ResponseHeader* PushTxnResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.header)
+  return header_;
+}
+::cockroach::roachpb::ResponseHeader* PushTxnResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void PushTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.header)
+}
+
+// optional .cockroach.roachpb.Transaction pushee_txn = 2;
+bool PushTxnResponse::has_pushee_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void PushTxnResponse::set_has_pushee_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void PushTxnResponse::clear_has_pushee_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void PushTxnResponse::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_pushee_txn();
+}
+const ::cockroach::roachpb::Transaction& PushTxnResponse::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+}
+::cockroach::roachpb::Transaction* PushTxnResponse::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) {
+    pushee_txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.pushee_txn)
+  return pushee_txn_;
+}
+::cockroach::roachpb::Transaction* PushTxnResponse::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+void PushTxnResponse::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.pushee_txn)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ResolveIntentRequest::kHeaderFieldNumber;
+const int ResolveIntentRequest::kIntentTxnFieldNumber;
+const int ResolveIntentRequest::kPoisonFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+ResolveIntentRequest::ResolveIntentRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentRequest)
+}
+
+void ResolveIntentRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+  intent_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+}
+
+ResolveIntentRequest::ResolveIntentRequest(const ResolveIntentRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentRequest)
+}
+
+void ResolveIntentRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  intent_txn_ = NULL;
+  poison_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ResolveIntentRequest::~ResolveIntentRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentRequest)
+  SharedDtor();
+}
+
+void ResolveIntentRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete intent_txn_;
+  }
+}
+
+void ResolveIntentRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ResolveIntentRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ResolveIntentRequest_descriptor_;
+}
+
+const ResolveIntentRequest& ResolveIntentRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ResolveIntentRequest* ResolveIntentRequest::default_instance_ = NULL;
+
+ResolveIntentRequest* ResolveIntentRequest::New(::google::protobuf::Arena* arena) const {
+  ResolveIntentRequest* n = new ResolveIntentRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ResolveIntentRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+    if (has_intent_txn()) {
+      if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
+    }
+    poison_ = false;
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ResolveIntentRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.Span header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_intent_txn;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Transaction intent_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_intent_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_intent_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_poison;
+        break;
+      }
+
+      // optional bool poison = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_poison:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &poison_)));
+          set_has_poison();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentRequest)
+  return false;
+#undef DO_
+}
+
+void ResolveIntentRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.roachpb.Transaction intent_txn = 2;
+  if (has_intent_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->intent_txn_, output);
+  }
+
+  // optional bool poison = 3;
+  if (has_poison()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->poison(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentRequest)
+}
+
+::google::protobuf::uint8* ResolveIntentRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.roachpb.Transaction intent_txn = 2;
+  if (has_intent_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->intent_txn_, target);
+  }
+
+  // optional bool poison = 3;
+  if (has_poison()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->poison(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentRequest)
+  return target;
+}
+
+int ResolveIntentRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7u) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.roachpb.Transaction intent_txn = 2;
+    if (has_intent_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->intent_txn_);
+    }
+
+    // optional bool poison = 3;
+    if (has_poison()) {
+      total_size += 1 + 1;
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ResolveIntentRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ResolveIntentRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ResolveIntentRequest::MergeFrom(const ResolveIntentRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_intent_txn()) {
+      mutable_intent_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.intent_txn());
+    }
+    if (from.has_poison()) {
+      set_poison(from.poison());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ResolveIntentRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ResolveIntentRequest::CopyFrom(const ResolveIntentRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ResolveIntentRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ResolveIntentRequest::Swap(ResolveIntentRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ResolveIntentRequest::InternalSwap(ResolveIntentRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(intent_txn_, other->intent_txn_);
+  std::swap(poison_, other->poison_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ResolveIntentRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ResolveIntentRequest_descriptor_;
+  metadata.reflection = ResolveIntentRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ResolveIntentRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+bool ResolveIntentRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ResolveIntentRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ResolveIntentRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ResolveIntentRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::Span& ResolveIntentRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::Span* ResolveIntentRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.header)
+  return header_;
+}
+::cockroach::roachpb::Span* ResolveIntentRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void ResolveIntentRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.header)
+}
+
+// optional .cockroach.roachpb.Transaction intent_txn = 2;
+bool ResolveIntentRequest::has_intent_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ResolveIntentRequest::set_has_intent_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ResolveIntentRequest::clear_has_intent_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ResolveIntentRequest::clear_intent_txn() {
+  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_intent_txn();
+}
+const ::cockroach::roachpb::Transaction& ResolveIntentRequest::intent_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.intent_txn)
+  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
+}
+::cockroach::roachpb::Transaction* ResolveIntentRequest::mutable_intent_txn() {
+  set_has_intent_txn();
+  if (intent_txn_ == NULL) {
+    intent_txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.intent_txn)
+  return intent_txn_;
+}
+::cockroach::roachpb::Transaction* ResolveIntentRequest::release_intent_txn() {
+  clear_has_intent_txn();
+  ::cockroach::roachpb::Transaction* temp = intent_txn_;
+  intent_txn_ = NULL;
+  return temp;
+}
+void ResolveIntentRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
+  delete intent_txn_;
+  intent_txn_ = intent_txn;
+  if (intent_txn) {
+    set_has_intent_txn();
+  } else {
+    clear_has_intent_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.intent_txn)
+}
+
+// optional bool poison = 3;
+bool ResolveIntentRequest::has_poison() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void ResolveIntentRequest::set_has_poison() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void ResolveIntentRequest::clear_has_poison() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void ResolveIntentRequest::clear_poison() {
+  poison_ = false;
+  clear_has_poison();
+}
+ bool ResolveIntentRequest::poison() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.poison)
+  return poison_;
+}
+ void ResolveIntentRequest::set_poison(bool value) {
+  set_has_poison();
+  poison_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRequest.poison)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ResolveIntentResponse::kHeaderFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+ResolveIntentResponse::ResolveIntentResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentResponse)
+}
+
+void ResolveIntentResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+}
+
+ResolveIntentResponse::ResolveIntentResponse(const ResolveIntentResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentResponse)
+}
+
+void ResolveIntentResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ResolveIntentResponse::~ResolveIntentResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentResponse)
+  SharedDtor();
+}
+
+void ResolveIntentResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ResolveIntentResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ResolveIntentResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ResolveIntentResponse_descriptor_;
+}
+
+const ResolveIntentResponse& ResolveIntentResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ResolveIntentResponse* ResolveIntentResponse::default_instance_ = NULL;
+
+ResolveIntentResponse* ResolveIntentResponse::New(::google::protobuf::Arena* arena) const {
+  ResolveIntentResponse* n = new ResolveIntentResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ResolveIntentResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ResolveIntentResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentResponse)
+  return false;
+#undef DO_
+}
+
+void ResolveIntentResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentResponse)
+}
+
+::google::protobuf::uint8* ResolveIntentResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentResponse)
+  return target;
+}
+
+int ResolveIntentResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ResolveIntentResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ResolveIntentResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ResolveIntentResponse::MergeFrom(const ResolveIntentResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ResolveIntentResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ResolveIntentResponse::CopyFrom(const ResolveIntentResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ResolveIntentResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ResolveIntentResponse::Swap(ResolveIntentResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ResolveIntentResponse::InternalSwap(ResolveIntentResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ResolveIntentResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ResolveIntentResponse_descriptor_;
+  metadata.reflection = ResolveIntentResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ResolveIntentResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool ResolveIntentResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ResolveIntentResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ResolveIntentResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ResolveIntentResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::ResponseHeader& ResolveIntentResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentResponse.header)
+  return header_;
+}
+::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void ResolveIntentResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentResponse.header)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ResolveIntentRangeRequest::kHeaderFieldNumber;
+const int ResolveIntentRangeRequest::kIntentTxnFieldNumber;
+const int ResolveIntentRangeRequest::kPoisonFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+ResolveIntentRangeRequest::ResolveIntentRangeRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentRangeRequest)
+}
+
+void ResolveIntentRangeRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+  intent_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+}
+
+ResolveIntentRangeRequest::ResolveIntentRangeRequest(const ResolveIntentRangeRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentRangeRequest)
+}
+
+void ResolveIntentRangeRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  intent_txn_ = NULL;
+  poison_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ResolveIntentRangeRequest::~ResolveIntentRangeRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentRangeRequest)
+  SharedDtor();
+}
+
+void ResolveIntentRangeRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete intent_txn_;
+  }
+}
+
+void ResolveIntentRangeRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ResolveIntentRangeRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ResolveIntentRangeRequest_descriptor_;
+}
+
+const ResolveIntentRangeRequest& ResolveIntentRangeRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ResolveIntentRangeRequest* ResolveIntentRangeRequest::default_instance_ = NULL;
+
+ResolveIntentRangeRequest* ResolveIntentRangeRequest::New(::google::protobuf::Arena* arena) const {
+  ResolveIntentRangeRequest* n = new ResolveIntentRangeRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ResolveIntentRangeRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+    if (has_intent_txn()) {
+      if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
+    }
+    poison_ = false;
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ResolveIntentRangeRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentRangeRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.Span header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_intent_txn;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Transaction intent_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_intent_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_intent_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_poison;
+        break;
+      }
+
+      // optional bool poison = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_poison:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &poison_)));
+          set_has_poison();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentRangeRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentRangeRequest)
+  return false;
+#undef DO_
+}
+
+void ResolveIntentRangeRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentRangeRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.roachpb.Transaction intent_txn = 2;
+  if (has_intent_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->intent_txn_, output);
+  }
+
+  // optional bool poison = 3;
+  if (has_poison()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->poison(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentRangeRequest)
+}
+
+::google::protobuf::uint8* ResolveIntentRangeRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentRangeRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.roachpb.Transaction intent_txn = 2;
+  if (has_intent_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->intent_txn_, target);
+  }
+
+  // optional bool poison = 3;
+  if (has_poison()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->poison(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentRangeRequest)
+  return target;
+}
+
+int ResolveIntentRangeRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7u) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.roachpb.Transaction intent_txn = 2;
+    if (has_intent_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->intent_txn_);
+    }
+
+    // optional bool poison = 3;
+    if (has_poison()) {
+      total_size += 1 + 1;
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ResolveIntentRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ResolveIntentRangeRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentRangeRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ResolveIntentRangeRequest::MergeFrom(const ResolveIntentRangeRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_intent_txn()) {
+      mutable_intent_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.intent_txn());
+    }
+    if (from.has_poison()) {
+      set_poison(from.poison());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ResolveIntentRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ResolveIntentRangeRequest::CopyFrom(const ResolveIntentRangeRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ResolveIntentRangeRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ResolveIntentRangeRequest::Swap(ResolveIntentRangeRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ResolveIntentRangeRequest::InternalSwap(ResolveIntentRangeRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(intent_txn_, other->intent_txn_);
+  std::swap(poison_, other->poison_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ResolveIntentRangeRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ResolveIntentRangeRequest_descriptor_;
+  metadata.reflection = ResolveIntentRangeRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ResolveIntentRangeRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+bool ResolveIntentRangeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ResolveIntentRangeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ResolveIntentRangeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ResolveIntentRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::Span& ResolveIntentRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::Span* ResolveIntentRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.header)
+  return header_;
+}
+::cockroach::roachpb::Span* ResolveIntentRangeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void ResolveIntentRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.header)
+}
+
+// optional .cockroach.roachpb.Transaction intent_txn = 2;
+bool ResolveIntentRangeRequest::has_intent_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ResolveIntentRangeRequest::set_has_intent_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ResolveIntentRangeRequest::clear_has_intent_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ResolveIntentRangeRequest::clear_intent_txn() {
+  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_intent_txn();
 }
-const ::cockroach::roachpb::Transaction& PushTxnRequest::pusher_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pusher_txn)
-  return pusher_txn_ != NULL ? *pusher_txn_ : *default_instance_->pusher_txn_;
+const ::cockroach::roachpb::Transaction& ResolveIntentRangeRequest::intent_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
+  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
 }
-::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pusher_txn() {
-  set_has_pusher_txn();
-  if (pusher_txn_ == NULL) {
-    pusher_txn_ = new ::cockroach::roachpb::Transaction;
+::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::mutable_intent_txn() {
+  set_has_intent_txn();
+  if (intent_txn_ == NULL) {
+    intent_txn_ = new ::cockroach::roachpb::Transaction;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pusher_txn)
-  return pusher_txn_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
+  return intent_txn_;
 }
-::cockroach::roachpb::Transaction* PushTxnRequest::release_pusher_txn() {
-  clear_has_pusher_txn();
-  ::cockroach::roachpb::Transaction* temp = pusher_txn_;
-  pusher_txn_ = NULL;
+::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::release_intent_txn() {
+  clear_has_intent_txn();
+  ::cockroach::roachpb::Transaction* temp = intent_txn_;
+  intent_txn_ = NULL;
   return temp;
 }
-void PushTxnRequest::set_allocated_pusher_txn(::cockroach::roachpb::Transaction* pusher_txn) {
-  delete pusher_txn_;
-  pusher_txn_ = pusher_txn;
-  if (pusher_txn) {
-    set_has_pusher_txn();
+void ResolveIntentRangeRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
+  delete intent_txn_;
+  intent_txn_ = intent_txn;
+  if (intent_txn) {
+    set_has_intent_txn();
   } else {
-    clear_has_pusher_txn();
+    clear_has_intent_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pusher_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
 }
 
-// optional .cockroach.roachpb.Transaction pushee_txn = 3;
-bool PushTxnRequest::has_pushee_txn() const {
+// optional bool poison = 3;
+bool ResolveIntentRangeRequest::has_poison() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-void PushTxnRequest::set_has_pushee_txn() {
+void ResolveIntentRangeRequest::set_has_poison() {
   _has_bits_[0] |= 0x00000004u;
 }
-void PushTxnRequest::clear_has_pushee_txn() {
+void ResolveIntentRangeRequest::clear_has_poison() {
   _has_bits_[0] &= ~0x00000004u;
 }
-void PushTxnRequest::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_pushee_txn();
+void ResolveIntentRangeRequest::clear_poison() {
+  poison_ = false;
+  clear_has_poison();
 }
-const ::cockroach::roachpb::Transaction& PushTxnRequest::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+ bool ResolveIntentRangeRequest::poison() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.poison)
+  return poison_;
 }
-::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) {
-    pushee_txn_ = new ::cockroach::roachpb::Transaction;
+ void ResolveIntentRangeRequest::set_poison(bool value) {
+  set_has_poison();
+  poison_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRangeRequest.poison)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int NoopResponse::kHeaderFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+NoopResponse::NoopResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.NoopResponse)
+}
+
+void NoopResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+}
+
+NoopResponse::NoopResponse(const NoopResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.NoopResponse)
+}
+
+void NoopResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+NoopResponse::~NoopResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.NoopResponse)
+  SharedDtor();
+}
+
+void NoopResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pushee_txn)
-  return pushee_txn_;
 }
-::cockroach::roachpb::Transaction* PushTxnRequest::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
-  return temp;
+
+void NoopResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* NoopResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return NoopResponse_descriptor_;
+}
+
+const NoopResponse& NoopResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+NoopResponse* NoopResponse::default_instance_ = NULL;
+
+NoopResponse* NoopResponse::New(::google::protobuf::Arena* arena) const {
+  NoopResponse* n = new NoopResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void NoopResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool NoopResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.NoopResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.NoopResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.NoopResponse)
+  return false;
+#undef DO_
+}
+
+void NoopResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.NoopResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.NoopResponse)
+}
+
+::google::protobuf::uint8* NoopResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.NoopResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.NoopResponse)
+  return target;
+}
+
+int NoopResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
 }
-void PushTxnRequest::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
+
+void NoopResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const NoopResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const NoopResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
   } else {
-    clear_has_pushee_txn();
+    MergeFrom(*source);
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pushee_txn)
 }
 
-// optional .cockroach.roachpb.Timestamp push_to = 4;
-bool PushTxnRequest::has_push_to() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
-}
-void PushTxnRequest::set_has_push_to() {
-  _has_bits_[0] |= 0x00000008u;
+void NoopResponse::MergeFrom(const NoopResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
 }
-void PushTxnRequest::clear_has_push_to() {
-  _has_bits_[0] &= ~0x00000008u;
+
+void NoopResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
 }
-void PushTxnRequest::clear_push_to() {
-  if (push_to_ != NULL) push_to_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_push_to();
+
+void NoopResponse::CopyFrom(const NoopResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
 }
-const ::cockroach::roachpb::Timestamp& PushTxnRequest::push_to() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_to)
-  return push_to_ != NULL ? *push_to_ : *default_instance_->push_to_;
+
+bool NoopResponse::IsInitialized() const {
+
+  return true;
 }
-::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_push_to() {
-  set_has_push_to();
-  if (push_to_ == NULL) {
-    push_to_ = new ::cockroach::roachpb::Timestamp;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.push_to)
-  return push_to_;
+
+void NoopResponse::Swap(NoopResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
 }
-::cockroach::roachpb::Timestamp* PushTxnRequest::release_push_to() {
-  clear_has_push_to();
-  ::cockroach::roachpb::Timestamp* temp = push_to_;
-  push_to_ = NULL;
-  return temp;
+void NoopResponse::InternalSwap(NoopResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
 }
-void PushTxnRequest::set_allocated_push_to(::cockroach::roachpb::Timestamp* push_to) {
-  delete push_to_;
-  push_to_ = push_to;
-  if (push_to) {
-    set_has_push_to();
-  } else {
-    clear_has_push_to();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.push_to)
+
+::google::protobuf::Metadata NoopResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = NoopResponse_descriptor_;
+  metadata.reflection = NoopResponse_reflection_;
+  return metadata;
 }
 
-// optional .cockroach.roachpb.Timestamp now = 5;
-bool PushTxnRequest::has_now() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// NoopResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool NoopResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void PushTxnRequest::set_has_now() {
-  _has_bits_[0] |= 0x00000010u;
+void NoopResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-void PushTxnRequest::clear_has_now() {
-  _has_bits_[0] &= ~0x00000010u;
+void NoopResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-void PushTxnRequest::clear_now() {
-  if (now_ != NULL) now_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_now();
+void NoopResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
 }
-const ::cockroach::roachpb::Timestamp& PushTxnRequest::now() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.now)
-  return now_ != NULL ? *now_ : *default_instance_->now_;
+const ::cockroach::roachpb::ResponseHeader& NoopResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_now() {
-  set_has_now();
-  if (now_ == NULL) {
-    now_ = new ::cockroach::roachpb::Timestamp;
+::cockroach::roachpb::ResponseHeader* NoopResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.now)
-  return now_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopResponse.header)
+  return header_;
 }
-::cockroach::roachpb::Timestamp* PushTxnRequest::release_now() {
-  clear_has_now();
-  ::cockroach::roachpb::Timestamp* temp = now_;
-  now_ = NULL;
+::cockroach::roachpb::ResponseHeader* NoopResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-void PushTxnRequest::set_allocated_now(::cockroach::roachpb::Timestamp* now) {
-  delete now_;
-  now_ = now;
-  if (now) {
-    set_has_now();
+void NoopResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_now();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.now)
-}
-
-// optional .cockroach.roachpb.PushTxnType push_type = 6;
-bool PushTxnRequest::has_push_type() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
-}
-void PushTxnRequest::set_has_push_type() {
-  _has_bits_[0] |= 0x00000020u;
-}
-void PushTxnRequest::clear_has_push_type() {
-  _has_bits_[0] &= ~0x00000020u;
-}
-void PushTxnRequest::clear_push_type() {
-  push_type_ = 0;
-  clear_has_push_type();
-}
- ::cockroach::roachpb::PushTxnType PushTxnRequest::push_type() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_type)
-  return static_cast< ::cockroach::roachpb::PushTxnType >(push_type_);
-}
- void PushTxnRequest::set_push_type(::cockroach::roachpb::PushTxnType value) {
-  assert(::cockroach::roachpb::PushTxnType_IsValid(value));
-  set_has_push_type();
-  push_type_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.PushTxnRequest.push_type)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopResponse.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -13457,81 +16156,72 @@ void PushTxnRequest::clear_push_type() {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int PushTxnResponse::kHeaderFieldNumber;
-const int PushTxnResponse::kPusheeTxnFieldNumber;
+const int NoopRequest::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-PushTxnResponse::PushTxnResponse()
+NoopRequest::NoopRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.PushTxnResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.NoopRequest)
 }
 
-void PushTxnResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
-  pushee_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+void NoopRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-PushTxnResponse::PushTxnResponse(const PushTxnResponse& from)
+NoopRequest::NoopRequest(const NoopRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.PushTxnResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.NoopRequest)
 }
 
-void PushTxnResponse::SharedCtor() {
+void NoopRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  pushee_txn_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-PushTxnResponse::~PushTxnResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.PushTxnResponse)
+NoopRequest::~NoopRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.NoopRequest)
   SharedDtor();
 }
 
-void PushTxnResponse::SharedDtor() {
+void NoopRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete pushee_txn_;
   }
 }
 
-void PushTxnResponse::SetCachedSize(int size) const {
+void NoopRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* PushTxnResponse::descriptor() {
+const ::google::protobuf::Descriptor* NoopRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return PushTxnResponse_descriptor_;
+  return NoopRequest_descriptor_;
 }
 
-const PushTxnResponse& PushTxnResponse::default_instance() {
+const NoopRequest& NoopRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-PushTxnResponse* PushTxnResponse::default_instance_ = NULL;
+NoopRequest* NoopRequest::default_instance_ = NULL;
 
-PushTxnResponse* PushTxnResponse::New(::google::protobuf::Arena* arena) const {
-  PushTxnResponse* n = new PushTxnResponse;
+NoopRequest* NoopRequest::New(::google::protobuf::Arena* arena) const {
+  NoopRequest* n = new NoopRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void PushTxnResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
-    }
-    if (has_pushee_txn()) {
-      if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
-    }
+void NoopRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -13539,34 +16229,21 @@ void PushTxnResponse::Clear() {
   }
 }
 
-bool PushTxnResponse::MergePartialFromCodedStream(
+bool NoopRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.PushTxnResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.NoopRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
-    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.ResponseHeader header = 1;
-      case 1: {
-        if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_pushee_txn;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Transaction pushee_txn = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_pushee_txn:
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.Span header = 1;
+      case 1: {
+        if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_pushee_txn()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
@@ -13588,80 +16265,58 @@ bool PushTxnResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.PushTxnResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.NoopRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.PushTxnResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.NoopRequest)
   return false;
 #undef DO_
 }
 
-void PushTxnResponse::SerializeWithCachedSizes(
+void NoopRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.PushTxnResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.NoopRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional .cockroach.roachpb.Transaction pushee_txn = 2;
-  if (has_pushee_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, *this->pushee_txn_, output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.PushTxnResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.NoopRequest)
 }
 
-::google::protobuf::uint8* PushTxnResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* NoopRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.PushTxnResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.NoopRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, *this->header_, target);
   }
 
-  // optional .cockroach.roachpb.Transaction pushee_txn = 2;
-  if (has_pushee_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, *this->pushee_txn_, target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.PushTxnResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.NoopRequest)
   return target;
 }
 
-int PushTxnResponse::ByteSize() const {
+int NoopRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3u) {
-    // optional .cockroach.roachpb.ResponseHeader header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional .cockroach.roachpb.Transaction pushee_txn = 2;
-    if (has_pushee_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->pushee_txn_);
-    }
-
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -13673,10 +16328,10 @@ int PushTxnResponse::ByteSize() const {
   return total_size;
 }
 
-void PushTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void NoopRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const PushTxnResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const PushTxnResponse>(
+  const NoopRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const NoopRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -13685,14 +16340,11 @@ void PushTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void PushTxnResponse::MergeFrom(const PushTxnResponse& from) {
+void NoopRequest::MergeFrom(const NoopRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
-    }
-    if (from.has_pushee_txn()) {
-      mutable_pushee_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.pushee_txn());
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -13700,79 +16352,78 @@ void PushTxnResponse::MergeFrom(const PushTxnResponse& from) {
   }
 }
 
-void PushTxnResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void NoopRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void PushTxnResponse::CopyFrom(const PushTxnResponse& from) {
+void NoopRequest::CopyFrom(const NoopRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool PushTxnResponse::IsInitialized() const {
+bool NoopRequest::IsInitialized() const {
 
   return true;
 }
 
-void PushTxnResponse::Swap(PushTxnResponse* other) {
+void NoopRequest::Swap(NoopRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void PushTxnResponse::InternalSwap(PushTxnResponse* other) {
+void NoopRequest::InternalSwap(NoopRequest* other) {
   std::swap(header_, other->header_);
-  std::swap(pushee_txn_, other->pushee_txn_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata PushTxnResponse::GetMetadata() const {
+::google::protobuf::Metadata NoopRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = PushTxnResponse_descriptor_;
-  metadata.reflection = PushTxnResponse_reflection_;
+  metadata.descriptor = NoopRequest_descriptor_;
+  metadata.reflection = NoopRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// PushTxnResponse
+// NoopRequest
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-bool PushTxnResponse::has_header() const {
+// optional .cockroach.roachpb.Span header = 1;
+bool NoopRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void PushTxnResponse::set_has_header() {
+void NoopRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void PushTxnResponse::clear_has_header() {
+void NoopRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void PushTxnResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void NoopRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::ResponseHeader& PushTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.header)
+const ::cockroach::roachpb::Span& NoopRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::ResponseHeader* PushTxnResponse::mutable_header() {
+::cockroach::roachpb::Span* NoopRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopRequest.header)
   return header_;
 }
-::cockroach::roachpb::ResponseHeader* PushTxnResponse::release_header() {
+::cockroach::roachpb::Span* NoopRequest::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void PushTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+void NoopRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -13780,50 +16431,7 @@ void PushTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.header)
-}
-
-// optional .cockroach.roachpb.Transaction pushee_txn = 2;
-bool PushTxnResponse::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void PushTxnResponse::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void PushTxnResponse::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void PushTxnResponse::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_pushee_txn();
-}
-const ::cockroach::roachpb::Transaction& PushTxnResponse::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
-}
-::cockroach::roachpb::Transaction* PushTxnResponse::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) {
-    pushee_txn_ = new ::cockroach::roachpb::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.pushee_txn)
-  return pushee_txn_;
-}
-::cockroach::roachpb::Transaction* PushTxnResponse::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
-  return temp;
-}
-void PushTxnResponse::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
-  } else {
-    clear_has_pushee_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopRequest.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -13831,84 +16439,72 @@ void PushTxnResponse::set_allocated_pushee_txn(::cockroach::roachpb::Transaction
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int ResolveIntentRequest::kHeaderFieldNumber;
-const int ResolveIntentRequest::kIntentTxnFieldNumber;
-const int ResolveIntentRequest::kPoisonFieldNumber;
+const int ResolveIntentRangeResponse::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-ResolveIntentRequest::ResolveIntentRequest()
+ResolveIntentRangeResponse::ResolveIntentRangeResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentRangeResponse)
 }
 
-void ResolveIntentRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
-  intent_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+void ResolveIntentRangeResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
 }
 
-ResolveIntentRequest::ResolveIntentRequest(const ResolveIntentRequest& from)
+ResolveIntentRangeResponse::ResolveIntentRangeResponse(const ResolveIntentRangeResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentRangeResponse)
 }
 
-void ResolveIntentRequest::SharedCtor() {
+void ResolveIntentRangeResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  intent_txn_ = NULL;
-  poison_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ResolveIntentRequest::~ResolveIntentRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentRequest)
+ResolveIntentRangeResponse::~ResolveIntentRangeResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentRangeResponse)
   SharedDtor();
 }
 
-void ResolveIntentRequest::SharedDtor() {
+void ResolveIntentRangeResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete intent_txn_;
   }
 }
 
-void ResolveIntentRequest::SetCachedSize(int size) const {
+void ResolveIntentRangeResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ResolveIntentRequest::descriptor() {
+const ::google::protobuf::Descriptor* ResolveIntentRangeResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ResolveIntentRequest_descriptor_;
+  return ResolveIntentRangeResponse_descriptor_;
 }
 
-const ResolveIntentRequest& ResolveIntentRequest::default_instance() {
+const ResolveIntentRangeResponse& ResolveIntentRangeResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ResolveIntentRequest* ResolveIntentRequest::default_instance_ = NULL;
+ResolveIntentRangeResponse* ResolveIntentRangeResponse::default_instance_ = NULL;
 
-ResolveIntentRequest* ResolveIntentRequest::New(::google::protobuf::Arena* arena) const {
-  ResolveIntentRequest* n = new ResolveIntentRequest;
+ResolveIntentRangeResponse* ResolveIntentRangeResponse::New(::google::protobuf::Arena* arena) const {
+  ResolveIntentRangeResponse* n = new ResolveIntentRangeResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ResolveIntentRequest::Clear() {
-  if (_has_bits_[0 / 32] & 7u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-    }
-    if (has_intent_txn()) {
-      if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
-    }
-    poison_ = false;
+void ResolveIntentRangeResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -13916,49 +16512,21 @@ void ResolveIntentRequest::Clear() {
   }
 }
 
-bool ResolveIntentRequest::MergePartialFromCodedStream(
-    ::google::protobuf::io::CodedInputStream* input) {
-#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
-  ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentRequest)
-  for (;;) {
-    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
-    tag = p.first;
-    if (!p.second) goto handle_unusual;
-    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.Span header = 1;
-      case 1: {
-        if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_intent_txn;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Transaction intent_txn = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_intent_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_intent_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(24)) goto parse_poison;
-        break;
-      }
-
-      // optional bool poison = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_poison:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &poison_)));
-          set_has_poison();
+bool ResolveIntentRangeResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentRangeResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
@@ -13980,95 +16548,58 @@ bool ResolveIntentRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentRangeResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentRangeResponse)
   return false;
 #undef DO_
 }
 
-void ResolveIntentRequest::SerializeWithCachedSizes(
+void ResolveIntentRangeResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentRangeResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional .cockroach.roachpb.Transaction intent_txn = 2;
-  if (has_intent_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, *this->intent_txn_, output);
-  }
-
-  // optional bool poison = 3;
-  if (has_poison()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->poison(), output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentRangeResponse)
 }
 
-::google::protobuf::uint8* ResolveIntentRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ResolveIntentRangeResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentRangeResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, *this->header_, target);
   }
 
-  // optional .cockroach.roachpb.Transaction intent_txn = 2;
-  if (has_intent_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, *this->intent_txn_, target);
-  }
-
-  // optional bool poison = 3;
-  if (has_poison()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->poison(), target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentRangeResponse)
   return target;
 }
 
-int ResolveIntentRequest::ByteSize() const {
+int ResolveIntentRangeResponse::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7u) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional .cockroach.roachpb.Transaction intent_txn = 2;
-    if (has_intent_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->intent_txn_);
-    }
-
-    // optional bool poison = 3;
-    if (has_poison()) {
-      total_size += 1 + 1;
-    }
-
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -14080,10 +16611,10 @@ int ResolveIntentRequest::ByteSize() const {
   return total_size;
 }
 
-void ResolveIntentRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ResolveIntentRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ResolveIntentRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentRequest>(
+  const ResolveIntentRangeResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentRangeResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -14092,17 +16623,11 @@ void ResolveIntentRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ResolveIntentRequest::MergeFrom(const ResolveIntentRequest& from) {
+void ResolveIntentRangeResponse::MergeFrom(const ResolveIntentRangeResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
-    }
-    if (from.has_intent_txn()) {
-      mutable_intent_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.intent_txn());
-    }
-    if (from.has_poison()) {
-      set_poison(from.poison());
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -14110,80 +16635,78 @@ void ResolveIntentRequest::MergeFrom(const ResolveIntentRequest& from) {
   }
 }
 
-void ResolveIntentRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ResolveIntentRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ResolveIntentRequest::CopyFrom(const ResolveIntentRequest& from) {
+void ResolveIntentRangeResponse::CopyFrom(const ResolveIntentRangeResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ResolveIntentRequest::IsInitialized() const {
+bool ResolveIntentRangeResponse::IsInitialized() const {
 
   return true;
 }
 
-void ResolveIntentRequest::Swap(ResolveIntentRequest* other) {
+void ResolveIntentRangeResponse::Swap(ResolveIntentRangeResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ResolveIntentRequest::InternalSwap(ResolveIntentRequest* other) {
+void ResolveIntentRangeResponse::InternalSwap(ResolveIntentRangeResponse* other) {
   std::swap(header_, other->header_);
-  std::swap(intent_txn_, other->intent_txn_);
-  std::swap(poison_, other->poison_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ResolveIntentRequest::GetMetadata() const {
+::google::protobuf::Metadata ResolveIntentRangeResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ResolveIntentRequest_descriptor_;
-  metadata.reflection = ResolveIntentRequest_reflection_;
+  metadata.descriptor = ResolveIntentRangeResponse_descriptor_;
+  metadata.reflection = ResolveIntentRangeResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ResolveIntentRequest
+// ResolveIntentRangeResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-bool ResolveIntentRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool ResolveIntentRangeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ResolveIntentRequest::set_has_header() {
+void ResolveIntentRangeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ResolveIntentRequest::clear_has_header() {
+void ResolveIntentRangeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ResolveIntentRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+void ResolveIntentRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& ResolveIntentRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.header)
+const ::cockroach::roachpb::ResponseHeader& ResolveIntentRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* ResolveIntentRequest::mutable_header() {
+::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeResponse.header)
   return header_;
 }
-::cockroach::roachpb::Span* ResolveIntentRequest::release_header() {
+::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-void ResolveIntentRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void ResolveIntentRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -14191,74 +16714,7 @@ void ResolveIntentRequest::set_allocated_header(::cockroach::roachpb::Span* head
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.header)
-}
-
-// optional .cockroach.roachpb.Transaction intent_txn = 2;
-bool ResolveIntentRequest::has_intent_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void ResolveIntentRequest::set_has_intent_txn() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void ResolveIntentRequest::clear_has_intent_txn() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void ResolveIntentRequest::clear_intent_txn() {
-  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_intent_txn();
-}
-const ::cockroach::roachpb::Transaction& ResolveIntentRequest::intent_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.intent_txn)
-  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
-}
-::cockroach::roachpb::Transaction* ResolveIntentRequest::mutable_intent_txn() {
-  set_has_intent_txn();
-  if (intent_txn_ == NULL) {
-    intent_txn_ = new ::cockroach::roachpb::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.intent_txn)
-  return intent_txn_;
-}
-::cockroach::roachpb::Transaction* ResolveIntentRequest::release_intent_txn() {
-  clear_has_intent_txn();
-  ::cockroach::roachpb::Transaction* temp = intent_txn_;
-  intent_txn_ = NULL;
-  return temp;
-}
-void ResolveIntentRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
-  delete intent_txn_;
-  intent_txn_ = intent_txn;
-  if (intent_txn) {
-    set_has_intent_txn();
-  } else {
-    clear_has_intent_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.intent_txn)
-}
-
-// optional bool poison = 3;
-bool ResolveIntentRequest::has_poison() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-void ResolveIntentRequest::set_has_poison() {
-  _has_bits_[0] |= 0x00000004u;
-}
-void ResolveIntentRequest::clear_has_poison() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-void ResolveIntentRequest::clear_poison() {
-  poison_ = false;
-  clear_has_poison();
-}
- bool ResolveIntentRequest::poison() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.poison)
-  return poison_;
-}
- void ResolveIntentRequest::set_poison(bool value) {
-  set_has_poison();
-  poison_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRequest.poison)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeResponse.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -14266,72 +16722,81 @@ void ResolveIntentRequest::clear_poison() {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int ResolveIntentResponse::kHeaderFieldNumber;
+const int MergeRequest::kHeaderFieldNumber;
+const int MergeRequest::kValueFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-ResolveIntentResponse::ResolveIntentResponse()
+MergeRequest::MergeRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.MergeRequest)
 }
 
-void ResolveIntentResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+void MergeRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+  value_ = const_cast< ::cockroach::roachpb::Value*>(&::cockroach::roachpb::Value::default_instance());
 }
 
-ResolveIntentResponse::ResolveIntentResponse(const ResolveIntentResponse& from)
+MergeRequest::MergeRequest(const MergeRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.MergeRequest)
 }
 
-void ResolveIntentResponse::SharedCtor() {
+void MergeRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  value_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ResolveIntentResponse::~ResolveIntentResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentResponse)
+MergeRequest::~MergeRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.MergeRequest)
   SharedDtor();
 }
 
-void ResolveIntentResponse::SharedDtor() {
+void MergeRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete value_;
   }
 }
 
-void ResolveIntentResponse::SetCachedSize(int size) const {
+void MergeRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ResolveIntentResponse::descriptor() {
+const ::google::protobuf::Descriptor* MergeRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ResolveIntentResponse_descriptor_;
+  return MergeRequest_descriptor_;
 }
 
-const ResolveIntentResponse& ResolveIntentResponse::default_instance() {
+const MergeRequest& MergeRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ResolveIntentResponse* ResolveIntentResponse::default_instance_ = NULL;
+MergeRequest* MergeRequest::default_instance_ = NULL;
 
-ResolveIntentResponse* ResolveIntentResponse::New(::google::protobuf::Arena* arena) const {
-  ResolveIntentResponse* n = new ResolveIntentResponse;
+MergeRequest* MergeRequest::New(::google::protobuf::Arena* arena) const {
+  MergeRequest* n = new MergeRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ResolveIntentResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void MergeRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -14339,17 +16804,17 @@ void ResolveIntentResponse::Clear() {
   }
 }
 
-bool ResolveIntentResponse::MergePartialFromCodedStream(
+bool MergeRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.MergeRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      // optional .cockroach.roachpb.Span header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -14357,6 +16822,19 @@ bool ResolveIntentResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Value value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -14375,58 +16853,80 @@ bool ResolveIntentResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.MergeRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.MergeRequest)
   return false;
 #undef DO_
 }
 
-void ResolveIntentResponse::SerializeWithCachedSizes(
+void MergeRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.MergeRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
+  // optional .cockroach.roachpb.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->value_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.MergeRequest)
 }
 
-::google::protobuf::uint8* ResolveIntentResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* MergeRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.MergeRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, *this->header_, target);
   }
 
+  // optional .cockroach.roachpb.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->value_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.MergeRequest)
   return target;
 }
 
-int ResolveIntentResponse::ByteSize() const {
+int MergeRequest::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 3u) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.roachpb.Value value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->value_);
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -14438,10 +16938,10 @@ int ResolveIntentResponse::ByteSize() const {
   return total_size;
 }
 
-void ResolveIntentResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void MergeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ResolveIntentResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentResponse>(
+  const MergeRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const MergeRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -14450,11 +16950,14 @@ void ResolveIntentResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ResolveIntentResponse::MergeFrom(const ResolveIntentResponse& from) {
+void MergeRequest::MergeFrom(const MergeRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_value()) {
+      mutable_value()->::cockroach::roachpb::Value::MergeFrom(from.value());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -14462,78 +16965,79 @@ void ResolveIntentResponse::MergeFrom(const ResolveIntentResponse& from) {
   }
 }
 
-void ResolveIntentResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void MergeRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ResolveIntentResponse::CopyFrom(const ResolveIntentResponse& from) {
+void MergeRequest::CopyFrom(const MergeRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ResolveIntentResponse::IsInitialized() const {
+bool MergeRequest::IsInitialized() const {
 
   return true;
 }
 
-void ResolveIntentResponse::Swap(ResolveIntentResponse* other) {
+void MergeRequest::Swap(MergeRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ResolveIntentResponse::InternalSwap(ResolveIntentResponse* other) {
+void MergeRequest::InternalSwap(MergeRequest* other) {
   std::swap(header_, other->header_);
+  std::swap(value_, other->value_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ResolveIntentResponse::GetMetadata() const {
+::google::protobuf::Metadata MergeRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ResolveIntentResponse_descriptor_;
-  metadata.reflection = ResolveIntentResponse_reflection_;
+  metadata.descriptor = MergeRequest_descriptor_;
+  metadata.reflection = MergeRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ResolveIntentResponse
+// MergeRequest
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-bool ResolveIntentResponse::has_header() const {
+// optional .cockroach.roachpb.Span header = 1;
+bool MergeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ResolveIntentResponse::set_has_header() {
+void MergeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ResolveIntentResponse::clear_has_header() {
+void MergeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ResolveIntentResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void MergeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::ResponseHeader& ResolveIntentResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentResponse.header)
+const ::cockroach::roachpb::Span& MergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::mutable_header() {
+::cockroach::roachpb::Span* MergeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.header)
   return header_;
 }
-::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::release_header() {
+::cockroach::roachpb::Span* MergeRequest::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void ResolveIntentResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+void MergeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -14541,7 +17045,50 @@ void ResolveIntentResponse::set_allocated_header(::cockroach::roachpb::ResponseH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.header)
+}
+
+// optional .cockroach.roachpb.Value value = 2;
+bool MergeRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void MergeRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void MergeRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void MergeRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
+  clear_has_value();
+}
+const ::cockroach::roachpb::Value& MergeRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+::cockroach::roachpb::Value* MergeRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::roachpb::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.value)
+  return value_;
+}
+::cockroach::roachpb::Value* MergeRequest::release_value() {
+  clear_has_value();
+  ::cockroach::roachpb::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+void MergeRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.value)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -14549,84 +17096,72 @@ void ResolveIntentResponse::set_allocated_header(::cockroach::roachpb::ResponseH
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int ResolveIntentRangeRequest::kHeaderFieldNumber;
-const int ResolveIntentRangeRequest::kIntentTxnFieldNumber;
-const int ResolveIntentRangeRequest::kPoisonFieldNumber;
+const int MergeResponse::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-ResolveIntentRangeRequest::ResolveIntentRangeRequest()
+MergeResponse::MergeResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentRangeRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.MergeResponse)
 }
 
-void ResolveIntentRangeRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
-  intent_txn_ = const_cast< ::cockroach::roachpb::Transaction*>(&::cockroach::roachpb::Transaction::default_instance());
+void MergeResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
 }
 
-ResolveIntentRangeRequest::ResolveIntentRangeRequest(const ResolveIntentRangeRequest& from)
+MergeResponse::MergeResponse(const MergeResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentRangeRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.MergeResponse)
 }
 
-void ResolveIntentRangeRequest::SharedCtor() {
+void MergeResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  intent_txn_ = NULL;
-  poison_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ResolveIntentRangeRequest::~ResolveIntentRangeRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentRangeRequest)
+MergeResponse::~MergeResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.MergeResponse)
   SharedDtor();
 }
 
-void ResolveIntentRangeRequest::SharedDtor() {
+void MergeResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete intent_txn_;
   }
 }
 
-void ResolveIntentRangeRequest::SetCachedSize(int size) const {
+void MergeResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ResolveIntentRangeRequest::descriptor() {
+const ::google::protobuf::Descriptor* MergeResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ResolveIntentRangeRequest_descriptor_;
+  return MergeResponse_descriptor_;
 }
 
-const ResolveIntentRangeRequest& ResolveIntentRangeRequest::default_instance() {
+const MergeResponse& MergeResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ResolveIntentRangeRequest* ResolveIntentRangeRequest::default_instance_ = NULL;
+MergeResponse* MergeResponse::default_instance_ = NULL;
 
-ResolveIntentRangeRequest* ResolveIntentRangeRequest::New(::google::protobuf::Arena* arena) const {
-  ResolveIntentRangeRequest* n = new ResolveIntentRangeRequest;
+MergeResponse* MergeResponse::New(::google::protobuf::Arena* arena) const {
+  MergeResponse* n = new MergeResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ResolveIntentRangeRequest::Clear() {
-  if (_has_bits_[0 / 32] & 7u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-    }
-    if (has_intent_txn()) {
-      if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
-    }
-    poison_ = false;
+void MergeResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -14634,17 +17169,17 @@ void ResolveIntentRangeRequest::Clear() {
   }
 }
 
-bool ResolveIntentRangeRequest::MergePartialFromCodedStream(
+bool MergeResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentRangeRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.MergeResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.Span header = 1;
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -14652,34 +17187,6 @@ bool ResolveIntentRangeRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_intent_txn;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Transaction intent_txn = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_intent_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_intent_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(24)) goto parse_poison;
-        break;
-      }
-
-      // optional bool poison = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_poison:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &poison_)));
-          set_has_poison();
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -14698,95 +17205,58 @@ bool ResolveIntentRangeRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentRangeRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.MergeResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentRangeRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.MergeResponse)
   return false;
 #undef DO_
 }
 
-void ResolveIntentRangeRequest::SerializeWithCachedSizes(
+void MergeResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentRangeRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.MergeResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional .cockroach.roachpb.Transaction intent_txn = 2;
-  if (has_intent_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, *this->intent_txn_, output);
-  }
-
-  // optional bool poison = 3;
-  if (has_poison()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->poison(), output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentRangeRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.MergeResponse)
 }
 
-::google::protobuf::uint8* ResolveIntentRangeRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* MergeResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentRangeRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.MergeResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, *this->header_, target);
   }
 
-  // optional .cockroach.roachpb.Transaction intent_txn = 2;
-  if (has_intent_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, *this->intent_txn_, target);
-  }
-
-  // optional bool poison = 3;
-  if (has_poison()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->poison(), target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentRangeRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.MergeResponse)
   return target;
 }
 
-int ResolveIntentRangeRequest::ByteSize() const {
+int MergeResponse::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7u) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional .cockroach.roachpb.Transaction intent_txn = 2;
-    if (has_intent_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->intent_txn_);
-    }
-
-    // optional bool poison = 3;
-    if (has_poison()) {
-      total_size += 1 + 1;
-    }
-
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -14798,10 +17268,10 @@ int ResolveIntentRangeRequest::ByteSize() const {
   return total_size;
 }
 
-void ResolveIntentRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void MergeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ResolveIntentRangeRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentRangeRequest>(
+  const MergeResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const MergeResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -14810,17 +17280,11 @@ void ResolveIntentRangeRequest::MergeFrom(const ::google::protobuf::Message& fro
   }
 }
 
-void ResolveIntentRangeRequest::MergeFrom(const ResolveIntentRangeRequest& from) {
+void MergeResponse::MergeFrom(const MergeResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
-    }
-    if (from.has_intent_txn()) {
-      mutable_intent_txn()->::cockroach::roachpb::Transaction::MergeFrom(from.intent_txn());
-    }
-    if (from.has_poison()) {
-      set_poison(from.poison());
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -14828,80 +17292,78 @@ void ResolveIntentRangeRequest::MergeFrom(const ResolveIntentRangeRequest& from)
   }
 }
 
-void ResolveIntentRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void MergeResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ResolveIntentRangeRequest::CopyFrom(const ResolveIntentRangeRequest& from) {
+void MergeResponse::CopyFrom(const MergeResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ResolveIntentRangeRequest::IsInitialized() const {
+bool MergeResponse::IsInitialized() const {
 
   return true;
 }
 
-void ResolveIntentRangeRequest::Swap(ResolveIntentRangeRequest* other) {
+void MergeResponse::Swap(MergeResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ResolveIntentRangeRequest::InternalSwap(ResolveIntentRangeRequest* other) {
+void MergeResponse::InternalSwap(MergeResponse* other) {
   std::swap(header_, other->header_);
-  std::swap(intent_txn_, other->intent_txn_);
-  std::swap(poison_, other->poison_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ResolveIntentRangeRequest::GetMetadata() const {
+::google::protobuf::Metadata MergeResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ResolveIntentRangeRequest_descriptor_;
-  metadata.reflection = ResolveIntentRangeRequest_reflection_;
+  metadata.descriptor = MergeResponse_descriptor_;
+  metadata.reflection = MergeResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ResolveIntentRangeRequest
+// MergeResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-bool ResolveIntentRangeRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool MergeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ResolveIntentRangeRequest::set_has_header() {
+void MergeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ResolveIntentRangeRequest::clear_has_header() {
+void MergeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ResolveIntentRangeRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+void MergeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& ResolveIntentRangeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.header)
+const ::cockroach::roachpb::ResponseHeader& MergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* ResolveIntentRangeRequest::mutable_header() {
+::cockroach::roachpb::ResponseHeader* MergeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeResponse.header)
   return header_;
 }
-::cockroach::roachpb::Span* ResolveIntentRangeRequest::release_header() {
+::cockroach::roachpb::ResponseHeader* MergeResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-void ResolveIntentRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void MergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -14909,74 +17371,7 @@ void ResolveIntentRangeRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.header)
-}
-
-// optional .cockroach.roachpb.Transaction intent_txn = 2;
-bool ResolveIntentRangeRequest::has_intent_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void ResolveIntentRangeRequest::set_has_intent_txn() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void ResolveIntentRangeRequest::clear_has_intent_txn() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void ResolveIntentRangeRequest::clear_intent_txn() {
-  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_intent_txn();
-}
-const ::cockroach::roachpb::Transaction& ResolveIntentRangeRequest::intent_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
-  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
-}
-::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::mutable_intent_txn() {
-  set_has_intent_txn();
-  if (intent_txn_ == NULL) {
-    intent_txn_ = new ::cockroach::roachpb::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
-  return intent_txn_;
-}
-::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::release_intent_txn() {
-  clear_has_intent_txn();
-  ::cockroach::roachpb::Transaction* temp = intent_txn_;
-  intent_txn_ = NULL;
-  return temp;
-}
-void ResolveIntentRangeRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
-  delete intent_txn_;
-  intent_txn_ = intent_txn;
-  if (intent_txn) {
-    set_has_intent_txn();
-  } else {
-    clear_has_intent_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
-}
-
-// optional bool poison = 3;
-bool ResolveIntentRangeRequest::has_poison() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-void ResolveIntentRangeRequest::set_has_poison() {
-  _has_bits_[0] |= 0x00000004u;
-}
-void ResolveIntentRangeRequest::clear_has_poison() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-void ResolveIntentRangeRequest::clear_poison() {
-  poison_ = false;
-  clear_has_poison();
-}
- bool ResolveIntentRangeRequest::poison() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.poison)
-  return poison_;
-}
- void ResolveIntentRangeRequest::set_poison(bool value) {
-  set_has_poison();
-  poison_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRangeRequest.poison)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeResponse.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -14984,90 +17379,109 @@ void ResolveIntentRangeRequest::clear_poison() {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int NoopResponse::kHeaderFieldNumber;
+const int TruncateLogRequest::kHeaderFieldNumber;
+const int TruncateLogRequest::kIndexFieldNumber;
+const int TruncateLogRequest::kRangeIdFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-NoopResponse::NoopResponse()
+TruncateLogRequest::TruncateLogRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.NoopResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.TruncateLogRequest)
 }
 
-void NoopResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+void TruncateLogRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-NoopResponse::NoopResponse(const NoopResponse& from)
+TruncateLogRequest::TruncateLogRequest(const TruncateLogRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.NoopResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.TruncateLogRequest)
 }
 
-void NoopResponse::SharedCtor() {
+void TruncateLogRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  index_ = GOOGLE_ULONGLONG(0);
+  range_id_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-NoopResponse::~NoopResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.NoopResponse)
+TruncateLogRequest::~TruncateLogRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.TruncateLogRequest)
   SharedDtor();
 }
 
-void NoopResponse::SharedDtor() {
+void TruncateLogRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void NoopResponse::SetCachedSize(int size) const {
+void TruncateLogRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* NoopResponse::descriptor() {
+const ::google::protobuf::Descriptor* TruncateLogRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return NoopResponse_descriptor_;
+  return TruncateLogRequest_descriptor_;
 }
 
-const NoopResponse& NoopResponse::default_instance() {
+const TruncateLogRequest& TruncateLogRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-NoopResponse* NoopResponse::default_instance_ = NULL;
+TruncateLogRequest* TruncateLogRequest::default_instance_ = NULL;
 
-NoopResponse* NoopResponse::New(::google::protobuf::Arena* arena) const {
-  NoopResponse* n = new NoopResponse;
+TruncateLogRequest* TruncateLogRequest::New(::google::protobuf::Arena* arena) const {
+  TruncateLogRequest* n = new TruncateLogRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void NoopResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void TruncateLogRequest::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<TruncateLogRequest*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 7u) {
+    ZR_(index_, range_id_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
   }
+
+#undef ZR_HELPER_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool NoopResponse::MergePartialFromCodedStream(
+bool TruncateLogRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.NoopResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.TruncateLogRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      // optional .cockroach.roachpb.Span header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -15075,6 +17489,36 @@ bool NoopResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_index;
+        break;
+      }
+
+      // optional uint64 index = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_index:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &index_)));
+          set_has_index();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_range_id;
+        break;
+      }
+
+      // optional int64 range_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_range_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &range_id_)));
+          set_has_range_id();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -15093,58 +17537,94 @@ bool NoopResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.NoopResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.TruncateLogRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.NoopResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.TruncateLogRequest)
   return false;
 #undef DO_
 }
 
-void NoopResponse::SerializeWithCachedSizes(
+void TruncateLogRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.NoopResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.TruncateLogRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
+  // optional uint64 index = 2;
+  if (has_index()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(2, this->index(), output);
+  }
+
+  // optional int64 range_id = 3;
+  if (has_range_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->range_id(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.NoopResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.TruncateLogRequest)
 }
 
-::google::protobuf::uint8* NoopResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* TruncateLogRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.NoopResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.TruncateLogRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, *this->header_, target);
   }
 
+  // optional uint64 index = 2;
+  if (has_index()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(2, this->index(), target);
+  }
+
+  // optional int64 range_id = 3;
+  if (has_range_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->range_id(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.NoopResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.TruncateLogRequest)
   return target;
 }
 
-int NoopResponse::ByteSize() const {
+int TruncateLogRequest::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 7u) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional uint64 index = 2;
+    if (has_index()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->index());
+    }
+
+    // optional int64 range_id = 3;
+    if (has_range_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->range_id());
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -15156,10 +17636,10 @@ int NoopResponse::ByteSize() const {
   return total_size;
 }
 
-void NoopResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void TruncateLogRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const NoopResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const NoopResponse>(
+  const TruncateLogRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const TruncateLogRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -15168,11 +17648,17 @@ void NoopResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void NoopResponse::MergeFrom(const NoopResponse& from) {
+void TruncateLogRequest::MergeFrom(const TruncateLogRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_index()) {
+      set_index(from.index());
+    }
+    if (from.has_range_id()) {
+      set_range_id(from.range_id());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -15180,86 +17666,136 @@ void NoopResponse::MergeFrom(const NoopResponse& from) {
   }
 }
 
-void NoopResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void TruncateLogRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void NoopResponse::CopyFrom(const NoopResponse& from) {
+void TruncateLogRequest::CopyFrom(const TruncateLogRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool NoopResponse::IsInitialized() const {
+bool TruncateLogRequest::IsInitialized() const {
 
   return true;
 }
 
-void NoopResponse::Swap(NoopResponse* other) {
+void TruncateLogRequest::Swap(TruncateLogRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void NoopResponse::InternalSwap(NoopResponse* other) {
+void TruncateLogRequest::InternalSwap(TruncateLogRequest* other) {
   std::swap(header_, other->header_);
+  std::swap(index_, other->index_);
+  std::swap(range_id_, other->range_id_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata NoopResponse::GetMetadata() const {
+::google::protobuf::Metadata TruncateLogRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = NoopResponse_descriptor_;
-  metadata.reflection = NoopResponse_reflection_;
+  metadata.descriptor = TruncateLogRequest_descriptor_;
+  metadata.reflection = TruncateLogRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// NoopResponse
+// TruncateLogRequest
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-bool NoopResponse::has_header() const {
+// optional .cockroach.roachpb.Span header = 1;
+bool TruncateLogRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void NoopResponse::set_has_header() {
+void TruncateLogRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void NoopResponse::clear_has_header() {
+void TruncateLogRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void NoopResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void TruncateLogRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::ResponseHeader& NoopResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopResponse.header)
+const ::cockroach::roachpb::Span& TruncateLogRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::ResponseHeader* NoopResponse::mutable_header() {
+::cockroach::roachpb::Span* TruncateLogRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogRequest.header)
   return header_;
 }
-::cockroach::roachpb::ResponseHeader* NoopResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+::cockroach::roachpb::Span* TruncateLogRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void TruncateLogRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogRequest.header)
+}
+
+// optional uint64 index = 2;
+bool TruncateLogRequest::has_index() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void TruncateLogRequest::set_has_index() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void TruncateLogRequest::clear_has_index() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void TruncateLogRequest::clear_index() {
+  index_ = GOOGLE_ULONGLONG(0);
+  clear_has_index();
+}
+ ::google::protobuf::uint64 TruncateLogRequest::index() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.index)
+  return index_;
+}
+ void TruncateLogRequest::set_index(::google::protobuf::uint64 value) {
+  set_has_index();
+  index_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.index)
+}
+
+// optional int64 range_id = 3;
+bool TruncateLogRequest::has_range_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void TruncateLogRequest::set_has_range_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void TruncateLogRequest::clear_has_range_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void TruncateLogRequest::clear_range_id() {
+  range_id_ = GOOGLE_LONGLONG(0);
+  clear_has_range_id();
 }
-void NoopResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopResponse.header)
+ ::google::protobuf::int64 TruncateLogRequest::range_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.range_id)
+  return range_id_;
+}
+ void TruncateLogRequest::set_range_id(::google::protobuf::int64 value) {
+  set_has_range_id();
+  range_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.range_id)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -15267,72 +17803,72 @@ void NoopResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* he
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int NoopRequest::kHeaderFieldNumber;
+const int TruncateLogResponse::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-NoopRequest::NoopRequest()
+TruncateLogResponse::TruncateLogResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.NoopRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.TruncateLogResponse)
 }
 
-void NoopRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+void TruncateLogResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
 }
 
-NoopRequest::NoopRequest(const NoopRequest& from)
+TruncateLogResponse::TruncateLogResponse(const TruncateLogResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.NoopRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.TruncateLogResponse)
 }
 
-void NoopRequest::SharedCtor() {
+void TruncateLogResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-NoopRequest::~NoopRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.NoopRequest)
+TruncateLogResponse::~TruncateLogResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.TruncateLogResponse)
   SharedDtor();
 }
 
-void NoopRequest::SharedDtor() {
+void TruncateLogResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void NoopRequest::SetCachedSize(int size) const {
+void TruncateLogResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* NoopRequest::descriptor() {
+const ::google::protobuf::Descriptor* TruncateLogResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return NoopRequest_descriptor_;
+  return TruncateLogResponse_descriptor_;
 }
 
-const NoopRequest& NoopRequest::default_instance() {
+const TruncateLogResponse& TruncateLogResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-NoopRequest* NoopRequest::default_instance_ = NULL;
+TruncateLogResponse* TruncateLogResponse::default_instance_ = NULL;
 
-NoopRequest* NoopRequest::New(::google::protobuf::Arena* arena) const {
-  NoopRequest* n = new NoopRequest;
+TruncateLogResponse* TruncateLogResponse::New(::google::protobuf::Arena* arena) const {
+  TruncateLogResponse* n = new TruncateLogResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void NoopRequest::Clear() {
+void TruncateLogResponse::Clear() {
   if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -15340,17 +17876,17 @@ void NoopRequest::Clear() {
   }
 }
 
-bool NoopRequest::MergePartialFromCodedStream(
+bool TruncateLogResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.NoopRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.TruncateLogResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.Span header = 1;
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -15376,18 +17912,18 @@ bool NoopRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.NoopRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.TruncateLogResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.NoopRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.TruncateLogResponse)
   return false;
 #undef DO_
 }
 
-void NoopRequest::SerializeWithCachedSizes(
+void TruncateLogResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.NoopRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.TruncateLogResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
@@ -15397,13 +17933,13 @@ void NoopRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.NoopRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.TruncateLogResponse)
 }
 
-::google::protobuf::uint8* NoopRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* TruncateLogResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.NoopRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.TruncateLogResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
@@ -15414,14 +17950,14 @@ void NoopRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.NoopRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.TruncateLogResponse)
   return target;
 }
 
-int NoopRequest::ByteSize() const {
+int TruncateLogResponse::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.Span header = 1;
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     total_size += 1 +
       ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
@@ -15439,10 +17975,10 @@ int NoopRequest::ByteSize() const {
   return total_size;
 }
 
-void NoopRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void TruncateLogResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const NoopRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const NoopRequest>(
+  const TruncateLogResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const TruncateLogResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -15451,11 +17987,11 @@ void NoopRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void NoopRequest::MergeFrom(const NoopRequest& from) {
+void TruncateLogResponse::MergeFrom(const TruncateLogResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -15463,78 +17999,78 @@ void NoopRequest::MergeFrom(const NoopRequest& from) {
   }
 }
 
-void NoopRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void TruncateLogResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void NoopRequest::CopyFrom(const NoopRequest& from) {
+void TruncateLogResponse::CopyFrom(const TruncateLogResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool NoopRequest::IsInitialized() const {
+bool TruncateLogResponse::IsInitialized() const {
 
   return true;
 }
 
-void NoopRequest::Swap(NoopRequest* other) {
+void TruncateLogResponse::Swap(TruncateLogResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void NoopRequest::InternalSwap(NoopRequest* other) {
+void TruncateLogResponse::InternalSwap(TruncateLogResponse* other) {
   std::swap(header_, other->header_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata NoopRequest::GetMetadata() const {
+::google::protobuf::Metadata TruncateLogResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = NoopRequest_descriptor_;
-  metadata.reflection = NoopRequest_reflection_;
+  metadata.descriptor = TruncateLogResponse_descriptor_;
+  metadata.reflection = TruncateLogResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// NoopRequest
+// TruncateLogResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-bool NoopRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool TruncateLogResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void NoopRequest::set_has_header() {
+void TruncateLogResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void NoopRequest::clear_has_header() {
+void TruncateLogResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void NoopRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+void TruncateLogResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& NoopRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopRequest.header)
+const ::cockroach::roachpb::ResponseHeader& TruncateLogResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* NoopRequest::mutable_header() {
+::cockroach::roachpb::ResponseHeader* TruncateLogResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogResponse.header)
   return header_;
 }
-::cockroach::roachpb::Span* NoopRequest::release_header() {
+::cockroach::roachpb::ResponseHeader* TruncateLogResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-void NoopRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void TruncateLogResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -15542,7 +18078,7 @@ void NoopRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogResponse.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -15550,72 +18086,81 @@ void NoopRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int ResolveIntentRangeResponse::kHeaderFieldNumber;
+const int LeaderLeaseRequest::kHeaderFieldNumber;
+const int LeaderLeaseRequest::kLeaseFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-ResolveIntentRangeResponse::ResolveIntentRangeResponse()
+LeaderLeaseRequest::LeaderLeaseRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ResolveIntentRangeResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.LeaderLeaseRequest)
 }
 
-void ResolveIntentRangeResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+void LeaderLeaseRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+  lease_ = const_cast< ::cockroach::roachpb::Lease*>(&::cockroach::roachpb::Lease::default_instance());
 }
 
-ResolveIntentRangeResponse::ResolveIntentRangeResponse(const ResolveIntentRangeResponse& from)
+LeaderLeaseRequest::LeaderLeaseRequest(const LeaderLeaseRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ResolveIntentRangeResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.LeaderLeaseRequest)
 }
 
-void ResolveIntentRangeResponse::SharedCtor() {
+void LeaderLeaseRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  lease_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ResolveIntentRangeResponse::~ResolveIntentRangeResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ResolveIntentRangeResponse)
+LeaderLeaseRequest::~LeaderLeaseRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.LeaderLeaseRequest)
   SharedDtor();
 }
 
-void ResolveIntentRangeResponse::SharedDtor() {
+void LeaderLeaseRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete lease_;
   }
 }
 
-void ResolveIntentRangeResponse::SetCachedSize(int size) const {
+void LeaderLeaseRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ResolveIntentRangeResponse::descriptor() {
+const ::google::protobuf::Descriptor* LeaderLeaseRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ResolveIntentRangeResponse_descriptor_;
+  return LeaderLeaseRequest_descriptor_;
 }
 
-const ResolveIntentRangeResponse& ResolveIntentRangeResponse::default_instance() {
+const LeaderLeaseRequest& LeaderLeaseRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ResolveIntentRangeResponse* ResolveIntentRangeResponse::default_instance_ = NULL;
+LeaderLeaseRequest* LeaderLeaseRequest::default_instance_ = NULL;
 
-ResolveIntentRangeResponse* ResolveIntentRangeResponse::New(::google::protobuf::Arena* arena) const {
-  ResolveIntentRangeResponse* n = new ResolveIntentRangeResponse;
+LeaderLeaseRequest* LeaderLeaseRequest::New(::google::protobuf::Arena* arena) const {
+  LeaderLeaseRequest* n = new LeaderLeaseRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ResolveIntentRangeResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void LeaderLeaseRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+    if (has_lease()) {
+      if (lease_ != NULL) lease_->::cockroach::roachpb::Lease::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -15623,17 +18168,17 @@ void ResolveIntentRangeResponse::Clear() {
   }
 }
 
-bool ResolveIntentRangeResponse::MergePartialFromCodedStream(
+bool LeaderLeaseRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ResolveIntentRangeResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.LeaderLeaseRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      // optional .cockroach.roachpb.Span header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -15641,6 +18186,19 @@ bool ResolveIntentRangeResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_lease;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Lease lease = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_lease:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_lease()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -15659,58 +18217,80 @@ bool ResolveIntentRangeResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ResolveIntentRangeResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.LeaderLeaseRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ResolveIntentRangeResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.LeaderLeaseRequest)
   return false;
 #undef DO_
 }
 
-void ResolveIntentRangeResponse::SerializeWithCachedSizes(
+void LeaderLeaseRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ResolveIntentRangeResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.LeaderLeaseRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
+  // optional .cockroach.roachpb.Lease lease = 2;
+  if (has_lease()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->lease_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ResolveIntentRangeResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.LeaderLeaseRequest)
 }
 
-::google::protobuf::uint8* ResolveIntentRangeResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* LeaderLeaseRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ResolveIntentRangeResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.LeaderLeaseRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        1, *this->header_, target);
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.roachpb.Lease lease = 2;
+  if (has_lease()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->lease_, target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ResolveIntentRangeResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.LeaderLeaseRequest)
   return target;
 }
 
-int ResolveIntentRangeResponse::ByteSize() const {
+int LeaderLeaseRequest::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 3u) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.roachpb.Lease lease = 2;
+    if (has_lease()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->lease_);
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -15722,10 +18302,10 @@ int ResolveIntentRangeResponse::ByteSize() const {
   return total_size;
 }
 
-void ResolveIntentRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void LeaderLeaseRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ResolveIntentRangeResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ResolveIntentRangeResponse>(
+  const LeaderLeaseRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const LeaderLeaseRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -15734,11 +18314,14 @@ void ResolveIntentRangeResponse::MergeFrom(const ::google::protobuf::Message& fr
   }
 }
 
-void ResolveIntentRangeResponse::MergeFrom(const ResolveIntentRangeResponse& from) {
+void LeaderLeaseRequest::MergeFrom(const LeaderLeaseRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_lease()) {
+      mutable_lease()->::cockroach::roachpb::Lease::MergeFrom(from.lease());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -15746,78 +18329,79 @@ void ResolveIntentRangeResponse::MergeFrom(const ResolveIntentRangeResponse& fro
   }
 }
 
-void ResolveIntentRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void LeaderLeaseRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ResolveIntentRangeResponse::CopyFrom(const ResolveIntentRangeResponse& from) {
+void LeaderLeaseRequest::CopyFrom(const LeaderLeaseRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ResolveIntentRangeResponse::IsInitialized() const {
+bool LeaderLeaseRequest::IsInitialized() const {
 
   return true;
 }
 
-void ResolveIntentRangeResponse::Swap(ResolveIntentRangeResponse* other) {
+void LeaderLeaseRequest::Swap(LeaderLeaseRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ResolveIntentRangeResponse::InternalSwap(ResolveIntentRangeResponse* other) {
+void LeaderLeaseRequest::InternalSwap(LeaderLeaseRequest* other) {
   std::swap(header_, other->header_);
+  std::swap(lease_, other->lease_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ResolveIntentRangeResponse::GetMetadata() const {
+::google::protobuf::Metadata LeaderLeaseRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ResolveIntentRangeResponse_descriptor_;
-  metadata.reflection = ResolveIntentRangeResponse_reflection_;
+  metadata.descriptor = LeaderLeaseRequest_descriptor_;
+  metadata.reflection = LeaderLeaseRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ResolveIntentRangeResponse
+// LeaderLeaseRequest
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-bool ResolveIntentRangeResponse::has_header() const {
+// optional .cockroach.roachpb.Span header = 1;
+bool LeaderLeaseRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ResolveIntentRangeResponse::set_has_header() {
+void LeaderLeaseRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ResolveIntentRangeResponse::clear_has_header() {
+void LeaderLeaseRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ResolveIntentRangeResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void LeaderLeaseRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::ResponseHeader& ResolveIntentRangeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeResponse.header)
+const ::cockroach::roachpb::Span& LeaderLeaseRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::mutable_header() {
+::cockroach::roachpb::Span* LeaderLeaseRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.header)
   return header_;
 }
-::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::release_header() {
+::cockroach::roachpb::Span* LeaderLeaseRequest::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void ResolveIntentRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+void LeaderLeaseRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -15825,7 +18409,50 @@ void ResolveIntentRangeResponse::set_allocated_header(::cockroach::roachpb::Resp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.header)
+}
+
+// optional .cockroach.roachpb.Lease lease = 2;
+bool LeaderLeaseRequest::has_lease() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void LeaderLeaseRequest::set_has_lease() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void LeaderLeaseRequest::clear_has_lease() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void LeaderLeaseRequest::clear_lease() {
+  if (lease_ != NULL) lease_->::cockroach::roachpb::Lease::Clear();
+  clear_has_lease();
+}
+const ::cockroach::roachpb::Lease& LeaderLeaseRequest::lease() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.lease)
+  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
+}
+::cockroach::roachpb::Lease* LeaderLeaseRequest::mutable_lease() {
+  set_has_lease();
+  if (lease_ == NULL) {
+    lease_ = new ::cockroach::roachpb::Lease;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.lease)
+  return lease_;
+}
+::cockroach::roachpb::Lease* LeaderLeaseRequest::release_lease() {
+  clear_has_lease();
+  ::cockroach::roachpb::Lease* temp = lease_;
+  lease_ = NULL;
+  return temp;
+}
+void LeaderLeaseRequest::set_allocated_lease(::cockroach::roachpb::Lease* lease) {
+  delete lease_;
+  lease_ = lease;
+  if (lease) {
+    set_has_lease();
+  } else {
+    clear_has_lease();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.lease)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -15833,81 +18460,72 @@ void ResolveIntentRangeResponse::set_allocated_header(::cockroach::roachpb::Resp
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int MergeRequest::kHeaderFieldNumber;
-const int MergeRequest::kValueFieldNumber;
+const int LeaderLeaseResponse::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-MergeRequest::MergeRequest()
+LeaderLeaseResponse::LeaderLeaseResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.MergeRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.LeaderLeaseResponse)
 }
 
-void MergeRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
-  value_ = const_cast< ::cockroach::roachpb::Value*>(&::cockroach::roachpb::Value::default_instance());
+void LeaderLeaseResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
 }
 
-MergeRequest::MergeRequest(const MergeRequest& from)
+LeaderLeaseResponse::LeaderLeaseResponse(const LeaderLeaseResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.MergeRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.LeaderLeaseResponse)
 }
 
-void MergeRequest::SharedCtor() {
+void LeaderLeaseResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  value_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-MergeRequest::~MergeRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.MergeRequest)
+LeaderLeaseResponse::~LeaderLeaseResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.LeaderLeaseResponse)
   SharedDtor();
 }
 
-void MergeRequest::SharedDtor() {
+void LeaderLeaseResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete value_;
   }
 }
 
-void MergeRequest::SetCachedSize(int size) const {
+void LeaderLeaseResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* MergeRequest::descriptor() {
+const ::google::protobuf::Descriptor* LeaderLeaseResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return MergeRequest_descriptor_;
+  return LeaderLeaseResponse_descriptor_;
 }
 
-const MergeRequest& MergeRequest::default_instance() {
+const LeaderLeaseResponse& LeaderLeaseResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-MergeRequest* MergeRequest::default_instance_ = NULL;
+LeaderLeaseResponse* LeaderLeaseResponse::default_instance_ = NULL;
 
-MergeRequest* MergeRequest::New(::google::protobuf::Arena* arena) const {
-  MergeRequest* n = new MergeRequest;
+LeaderLeaseResponse* LeaderLeaseResponse::New(::google::protobuf::Arena* arena) const {
+  LeaderLeaseResponse* n = new LeaderLeaseResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void MergeRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-    }
-    if (has_value()) {
-      if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
-    }
+void LeaderLeaseResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -15915,17 +18533,17 @@ void MergeRequest::Clear() {
   }
 }
 
-bool MergeRequest::MergePartialFromCodedStream(
+bool LeaderLeaseResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.MergeRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.LeaderLeaseResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.Span header = 1;
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -15933,19 +18551,6 @@ bool MergeRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_value;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Value value = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_value:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_value()));
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -15964,80 +18569,58 @@ bool MergeRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.MergeRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.LeaderLeaseResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.MergeRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.LeaderLeaseResponse)
   return false;
 #undef DO_
 }
 
-void MergeRequest::SerializeWithCachedSizes(
+void LeaderLeaseResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.MergeRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.LeaderLeaseResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional .cockroach.roachpb.Value value = 2;
-  if (has_value()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, *this->value_, output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.MergeRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.LeaderLeaseResponse)
 }
 
-::google::protobuf::uint8* MergeRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* LeaderLeaseResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.MergeRequest)
-  // optional .cockroach.roachpb.Span header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.LeaderLeaseResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, *this->header_, target);
   }
 
-  // optional .cockroach.roachpb.Value value = 2;
-  if (has_value()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, *this->value_, target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.MergeRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.LeaderLeaseResponse)
   return target;
 }
 
-int MergeRequest::ByteSize() const {
+int LeaderLeaseResponse::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3u) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional .cockroach.roachpb.Value value = 2;
-    if (has_value()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->value_);
-    }
-
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -16049,10 +18632,10 @@ int MergeRequest::ByteSize() const {
   return total_size;
 }
 
-void MergeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void LeaderLeaseResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const MergeRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const MergeRequest>(
+  const LeaderLeaseResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const LeaderLeaseResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -16061,14 +18644,11 @@ void MergeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void MergeRequest::MergeFrom(const MergeRequest& from) {
+void LeaderLeaseResponse::MergeFrom(const LeaderLeaseResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
-    }
-    if (from.has_value()) {
-      mutable_value()->::cockroach::roachpb::Value::MergeFrom(from.value());
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -16076,79 +18656,78 @@ void MergeRequest::MergeFrom(const MergeRequest& from) {
   }
 }
 
-void MergeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void LeaderLeaseResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void MergeRequest::CopyFrom(const MergeRequest& from) {
+void LeaderLeaseResponse::CopyFrom(const LeaderLeaseResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool MergeRequest::IsInitialized() const {
+bool LeaderLeaseResponse::IsInitialized() const {
 
   return true;
 }
 
-void MergeRequest::Swap(MergeRequest* other) {
+void LeaderLeaseResponse::Swap(LeaderLeaseResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void MergeRequest::InternalSwap(MergeRequest* other) {
+void LeaderLeaseResponse::InternalSwap(LeaderLeaseResponse* other) {
   std::swap(header_, other->header_);
-  std::swap(value_, other->value_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata MergeRequest::GetMetadata() const {
+::google::protobuf::Metadata LeaderLeaseResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = MergeRequest_descriptor_;
-  metadata.reflection = MergeRequest_reflection_;
+  metadata.descriptor = LeaderLeaseResponse_descriptor_;
+  metadata.reflection = LeaderLeaseResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// MergeRequest
+// LeaderLeaseResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-bool MergeRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool LeaderLeaseResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void MergeRequest::set_has_header() {
+void LeaderLeaseResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void MergeRequest::clear_has_header() {
+void LeaderLeaseResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void MergeRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+void LeaderLeaseResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& MergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.header)
+const ::cockroach::roachpb::ResponseHeader& LeaderLeaseResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* MergeRequest::mutable_header() {
+::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseResponse.header)
   return header_;
 }
-::cockroach::roachpb::Span* MergeRequest::release_header() {
+::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-void MergeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void LeaderLeaseResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -16156,50 +18735,7 @@ void MergeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.header)
-}
-
-// optional .cockroach.roachpb.Value value = 2;
-bool MergeRequest::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void MergeRequest::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void MergeRequest::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void MergeRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
-  clear_has_value();
-}
-const ::cockroach::roachpb::Value& MergeRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
-}
-::cockroach::roachpb::Value* MergeRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::roachpb::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.value)
-  return value_;
-}
-::cockroach::roachpb::Value* MergeRequest::release_value() {
-  clear_has_value();
-  ::cockroach::roachpb::Value* temp = value_;
-  value_ = NULL;
-  return temp;
-}
-void MergeRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.value)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseResponse.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -16207,72 +18743,72 @@ void MergeRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int MergeResponse::kHeaderFieldNumber;
+const int RaftStatusRequest::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-MergeResponse::MergeResponse()
+RaftStatusRequest::RaftStatusRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.MergeResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.RaftStatusRequest)
 }
 
-void MergeResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+void RaftStatusRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-MergeResponse::MergeResponse(const MergeResponse& from)
+RaftStatusRequest::RaftStatusRequest(const RaftStatusRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.MergeResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RaftStatusRequest)
 }
 
-void MergeResponse::SharedCtor() {
+void RaftStatusRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-MergeResponse::~MergeResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.MergeResponse)
+RaftStatusRequest::~RaftStatusRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.RaftStatusRequest)
   SharedDtor();
 }
 
-void MergeResponse::SharedDtor() {
+void RaftStatusRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void MergeResponse::SetCachedSize(int size) const {
+void RaftStatusRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* MergeResponse::descriptor() {
+const ::google::protobuf::Descriptor* RaftStatusRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return MergeResponse_descriptor_;
+  return RaftStatusRequest_descriptor_;
 }
 
-const MergeResponse& MergeResponse::default_instance() {
+const RaftStatusRequest& RaftStatusRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-MergeResponse* MergeResponse::default_instance_ = NULL;
+RaftStatusRequest* RaftStatusRequest::default_instance_ = NULL;
 
-MergeResponse* MergeResponse::New(::google::protobuf::Arena* arena) const {
-  MergeResponse* n = new MergeResponse;
+RaftStatusRequest* RaftStatusRequest::New(::google::protobuf::Arena* arena) const {
+  RaftStatusRequest* n = new RaftStatusRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void MergeResponse::Clear() {
+void RaftStatusRequest::Clear() {
   if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -16280,17 +18816,17 @@ void MergeResponse::Clear() {
   }
 }
 
-bool MergeResponse::MergePartialFromCodedStream(
+bool RaftStatusRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.MergeResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RaftStatusRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      // optional .cockroach.roachpb.Span header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -16316,18 +18852,18 @@ bool MergeResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.MergeResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RaftStatusRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.MergeResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RaftStatusRequest)
   return false;
 #undef DO_
 }
 
-void MergeResponse::SerializeWithCachedSizes(
+void RaftStatusRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.MergeResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RaftStatusRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
@@ -16337,13 +18873,13 @@ void MergeResponse::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.MergeResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RaftStatusRequest)
 }
 
-::google::protobuf::uint8* MergeResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RaftStatusRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.MergeResponse)
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RaftStatusRequest)
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
@@ -16354,14 +18890,14 @@ void MergeResponse::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.MergeResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RaftStatusRequest)
   return target;
 }
 
-int MergeResponse::ByteSize() const {
+int RaftStatusRequest::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     total_size += 1 +
       ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
@@ -16379,10 +18915,10 @@ int MergeResponse::ByteSize() const {
   return total_size;
 }
 
-void MergeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void RaftStatusRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const MergeResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const MergeResponse>(
+  const RaftStatusRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RaftStatusRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -16391,11 +18927,11 @@ void MergeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void MergeResponse::MergeFrom(const MergeResponse& from) {
+void RaftStatusRequest::MergeFrom(const RaftStatusRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -16403,78 +18939,78 @@ void MergeResponse::MergeFrom(const MergeResponse& from) {
   }
 }
 
-void MergeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void RaftStatusRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void MergeResponse::CopyFrom(const MergeResponse& from) {
+void RaftStatusRequest::CopyFrom(const RaftStatusRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool MergeResponse::IsInitialized() const {
+bool RaftStatusRequest::IsInitialized() const {
 
   return true;
 }
 
-void MergeResponse::Swap(MergeResponse* other) {
+void RaftStatusRequest::Swap(RaftStatusRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void MergeResponse::InternalSwap(MergeResponse* other) {
+void RaftStatusRequest::InternalSwap(RaftStatusRequest* other) {
   std::swap(header_, other->header_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata MergeResponse::GetMetadata() const {
+::google::protobuf::Metadata RaftStatusRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = MergeResponse_descriptor_;
-  metadata.reflection = MergeResponse_reflection_;
+  metadata.descriptor = RaftStatusRequest_descriptor_;
+  metadata.reflection = RaftStatusRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// MergeResponse
+// RaftStatusRequest
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-bool MergeResponse::has_header() const {
+// optional .cockroach.roachpb.Span header = 1;
+bool RaftStatusRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void MergeResponse::set_has_header() {
+void RaftStatusRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void MergeResponse::clear_has_header() {
+void RaftStatusRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void MergeResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void RaftStatusRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::ResponseHeader& MergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeResponse.header)
+const ::cockroach::roachpb::Span& RaftStatusRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::ResponseHeader* MergeResponse::mutable_header() {
+::cockroach::roachpb::Span* RaftStatusRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusRequest.header)
   return header_;
 }
-::cockroach::roachpb::ResponseHeader* MergeResponse::release_header() {
+::cockroach::roachpb::Span* RaftStatusRequest::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void MergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+void RaftStatusRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -16482,7 +19018,7 @@ void MergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* h
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusRequest.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -16490,86 +19026,89 @@ void MergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* h
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int TruncateLogRequest::kHeaderFieldNumber;
-const int TruncateLogRequest::kIndexFieldNumber;
-const int TruncateLogRequest::kRangeIdFieldNumber;
+const int RaftProgress::kReplicaIdFieldNumber;
+const int RaftProgress::kMatchFieldNumber;
+const int RaftProgress::kNextFieldNumber;
+const int RaftProgress::kStateFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-TruncateLogRequest::TruncateLogRequest()
+RaftProgress::RaftProgress()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.TruncateLogRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.RaftProgress)
 }
 
-void TruncateLogRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+void RaftProgress::InitAsDefaultInstance() {
 }
 
-TruncateLogRequest::TruncateLogRequest(const TruncateLogRequest& from)
+RaftProgress::RaftProgress(const RaftProgress& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.TruncateLogRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RaftProgress)
 }
 
-void TruncateLogRequest::SharedCtor() {
+void RaftProgress::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  header_ = NULL;
-  index_ = GOOGLE_ULONGLONG(0);
-  range_id_ = GOOGLE_LONGLONG(0);
+  replica_id_ = 0;
+  match_ = GOOGLE_ULONGLONG(0);
+  next_ = GOOGLE_ULONGLONG(0);
+  state_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-TruncateLogRequest::~TruncateLogRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.TruncateLogRequest)
+RaftProgress::~RaftProgress() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.RaftProgress)
   SharedDtor();
 }
 
-void TruncateLogRequest::SharedDtor() {
+void RaftProgress::SharedDtor() {
+  state_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != default_instance_) {
-    delete header_;
   }
 }
 
-void TruncateLogRequest::SetCachedSize(int size) const {
+void RaftProgress::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* TruncateLogRequest::descriptor() {
+const ::google::protobuf::Descriptor* RaftProgress::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return TruncateLogRequest_descriptor_;
+  return RaftProgress_descriptor_;
 }
 
-const TruncateLogRequest& TruncateLogRequest::default_instance() {
+const RaftProgress& RaftProgress::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-TruncateLogRequest* TruncateLogRequest::default_instance_ = NULL;
+RaftProgress* RaftProgress::default_instance_ = NULL;
 
-TruncateLogRequest* TruncateLogRequest::New(::google::protobuf::Arena* arena) const {
-  TruncateLogRequest* n = new TruncateLogRequest;
+RaftProgress* RaftProgress::New(::google::protobuf::Arena* arena) const {
+  RaftProgress* n = new RaftProgress;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void TruncateLogRequest::Clear() {
+void RaftProgress::Clear() {
 #define ZR_HELPER_(f) reinterpret_cast<char*>(\
-  &reinterpret_cast<TruncateLogRequest*>(16)->f)
+  &reinterpret_cast<RaftProgress*>(16)->f)
 
 #define ZR_(first, last) do {\
   ::memset(&first, 0,\
            ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
 } while (0)
 
-  if (_has_bits_[0 / 32] & 7u) {
-    ZR_(index_, range_id_);
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  if (_has_bits_[0 / 32] & 15u) {
+    ZR_(match_, next_);
+    replica_id_ = 0;
+    if (has_state()) {
+      state_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     }
   }
 
@@ -16582,51 +19121,70 @@ void TruncateLogRequest::Clear() {
   }
 }
 
-bool TruncateLogRequest::MergePartialFromCodedStream(
+bool RaftProgress::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.TruncateLogRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RaftProgress)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.roachpb.Span header = 1;
+      // optional int32 replica_id = 1;
       case 1: {
-        if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &replica_id_)));
+          set_has_replica_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_index;
+        if (input->ExpectTag(16)) goto parse_match;
         break;
       }
 
-      // optional uint64 index = 2;
+      // optional uint64 match = 2;
       case 2: {
         if (tag == 16) {
-         parse_index:
+         parse_match:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
-                 input, &index_)));
-          set_has_index();
+                 input, &match_)));
+          set_has_match();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(24)) goto parse_range_id;
+        if (input->ExpectTag(24)) goto parse_next;
         break;
       }
 
-      // optional int64 range_id = 3;
+      // optional uint64 next = 3;
       case 3: {
         if (tag == 24) {
-         parse_range_id:
+         parse_next:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &range_id_)));
-          set_has_range_id();
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &next_)));
+          set_has_next();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_state;
+        break;
+      }
+
+      // optional string state = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_state:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_state()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->state().data(), this->state().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "cockroach.roachpb.RaftProgress.state");
         } else {
           goto handle_unusual;
         }
@@ -16648,91 +19206,116 @@ bool TruncateLogRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.TruncateLogRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RaftProgress)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.TruncateLogRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RaftProgress)
   return false;
 #undef DO_
 }
 
-void TruncateLogRequest::SerializeWithCachedSizes(
+void RaftProgress::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.TruncateLogRequest)
-  // optional .cockroach.roachpb.Span header = 1;
-  if (has_header()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, *this->header_, output);
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RaftProgress)
+  // optional int32 replica_id = 1;
+  if (has_replica_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->replica_id(), output);
   }
 
-  // optional uint64 index = 2;
-  if (has_index()) {
-    ::google::protobuf::internal::WireFormatLite::WriteUInt64(2, this->index(), output);
+  // optional uint64 match = 2;
+  if (has_match()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(2, this->match(), output);
   }
 
-  // optional int64 range_id = 3;
-  if (has_range_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->range_id(), output);
+  // optional uint64 next = 3;
+  if (has_next()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(3, this->next(), output);
+  }
+
+  // optional string state = 4;
+  if (has_state()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->state().data(), this->state().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cockroach.roachpb.RaftProgress.state");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      4, this->state(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.TruncateLogRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RaftProgress)
 }
 
-::google::protobuf::uint8* TruncateLogRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RaftProgress::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.TruncateLogRequest)
-  // optional .cockroach.roachpb.Span header = 1;
-  if (has_header()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, *this->header_, target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RaftProgress)
+  // optional int32 replica_id = 1;
+  if (has_replica_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->replica_id(), target);
   }
 
-  // optional uint64 index = 2;
-  if (has_index()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(2, this->index(), target);
+  // optional uint64 match = 2;
+  if (has_match()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(2, this->match(), target);
   }
 
-  // optional int64 range_id = 3;
-  if (has_range_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->range_id(), target);
+  // optional uint64 next = 3;
+  if (has_next()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(3, this->next(), target);
+  }
+
+  // optional string state = 4;
+  if (has_state()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->state().data(), this->state().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cockroach.roachpb.RaftProgress.state");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        4, this->state(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.TruncateLogRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RaftProgress)
   return target;
 }
 
-int TruncateLogRequest::ByteSize() const {
+int RaftProgress::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7u) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
+  if (_has_bits_[0 / 32] & 15u) {
+    // optional int32 replica_id = 1;
+    if (has_replica_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->replica_id());
     }
 
-    // optional uint64 index = 2;
-    if (has_index()) {
+    // optional uint64 match = 2;
+    if (has_match()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::UInt64Size(
-          this->index());
+          this->match());
     }
 
-    // optional int64 range_id = 3;
-    if (has_range_id()) {
+    // optional uint64 next = 3;
+    if (has_next()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->range_id());
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->next());
+    }
+
+    // optional string state = 4;
+    if (has_state()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->state());
     }
 
   }
@@ -16747,10 +19330,10 @@ int TruncateLogRequest::ByteSize() const {
   return total_size;
 }
 
-void TruncateLogRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void RaftProgress::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const TruncateLogRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const TruncateLogRequest>(
+  const RaftProgress* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RaftProgress>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -16759,17 +19342,21 @@ void TruncateLogRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void TruncateLogRequest::MergeFrom(const TruncateLogRequest& from) {
+void RaftProgress::MergeFrom(const RaftProgress& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_header()) {
-      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    if (from.has_replica_id()) {
+      set_replica_id(from.replica_id());
     }
-    if (from.has_index()) {
-      set_index(from.index());
+    if (from.has_match()) {
+      set_match(from.match());
     }
-    if (from.has_range_id()) {
-      set_range_id(from.range_id());
+    if (from.has_next()) {
+      set_next(from.next());
+    }
+    if (from.has_state()) {
+      set_has_state();
+      state_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.state_);
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -16777,136 +19364,171 @@ void TruncateLogRequest::MergeFrom(const TruncateLogRequest& from) {
   }
 }
 
-void TruncateLogRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void RaftProgress::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void TruncateLogRequest::CopyFrom(const TruncateLogRequest& from) {
+void RaftProgress::CopyFrom(const RaftProgress& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool TruncateLogRequest::IsInitialized() const {
+bool RaftProgress::IsInitialized() const {
 
   return true;
 }
 
-void TruncateLogRequest::Swap(TruncateLogRequest* other) {
+void RaftProgress::Swap(RaftProgress* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void TruncateLogRequest::InternalSwap(TruncateLogRequest* other) {
-  std::swap(header_, other->header_);
-  std::swap(index_, other->index_);
-  std::swap(range_id_, other->range_id_);
+void RaftProgress::InternalSwap(RaftProgress* other) {
+  std::swap(replica_id_, other->replica_id_);
+  std::swap(match_, other->match_);
+  std::swap(next_, other->next_);
+  state_.Swap(&other->state_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata TruncateLogRequest::GetMetadata() const {
+::google::protobuf::Metadata RaftProgress::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = TruncateLogRequest_descriptor_;
-  metadata.reflection = TruncateLogRequest_reflection_;
+  metadata.descriptor = RaftProgress_descriptor_;
+  metadata.reflection = RaftProgress_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// TruncateLogRequest
+// RaftProgress
 
-// optional .cockroach.roachpb.Span header = 1;
-bool TruncateLogRequest::has_header() const {
+// optional int32 replica_id = 1;
+bool RaftProgress::has_replica_id() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void TruncateLogRequest::set_has_header() {
+void RaftProgress::set_has_replica_id() {
   _has_bits_[0] |= 0x00000001u;
 }
-void TruncateLogRequest::clear_has_header() {
+void RaftProgress::clear_has_replica_id() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void TruncateLogRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-  clear_has_header();
-}
-const ::cockroach::roachpb::Span& TruncateLogRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
-}
-::cockroach::roachpb::Span* TruncateLogRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogRequest.header)
-  return header_;
+void RaftProgress::clear_replica_id() {
+  replica_id_ = 0;
+  clear_has_replica_id();
 }
-::cockroach::roachpb::Span* TruncateLogRequest::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
-  header_ = NULL;
-  return temp;
+ ::google::protobuf::int32 RaftProgress::replica_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.replica_id)
+  return replica_id_;
 }
-void TruncateLogRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogRequest.header)
+ void RaftProgress::set_replica_id(::google::protobuf::int32 value) {
+  set_has_replica_id();
+  replica_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.replica_id)
 }
 
-// optional uint64 index = 2;
-bool TruncateLogRequest::has_index() const {
+// optional uint64 match = 2;
+bool RaftProgress::has_match() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-void TruncateLogRequest::set_has_index() {
+void RaftProgress::set_has_match() {
   _has_bits_[0] |= 0x00000002u;
 }
-void TruncateLogRequest::clear_has_index() {
+void RaftProgress::clear_has_match() {
   _has_bits_[0] &= ~0x00000002u;
 }
-void TruncateLogRequest::clear_index() {
-  index_ = GOOGLE_ULONGLONG(0);
-  clear_has_index();
+void RaftProgress::clear_match() {
+  match_ = GOOGLE_ULONGLONG(0);
+  clear_has_match();
 }
- ::google::protobuf::uint64 TruncateLogRequest::index() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.index)
-  return index_;
+ ::google::protobuf::uint64 RaftProgress::match() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.match)
+  return match_;
 }
- void TruncateLogRequest::set_index(::google::protobuf::uint64 value) {
-  set_has_index();
-  index_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.index)
+ void RaftProgress::set_match(::google::protobuf::uint64 value) {
+  set_has_match();
+  match_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.match)
 }
 
-// optional int64 range_id = 3;
-bool TruncateLogRequest::has_range_id() const {
+// optional uint64 next = 3;
+bool RaftProgress::has_next() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-void TruncateLogRequest::set_has_range_id() {
+void RaftProgress::set_has_next() {
   _has_bits_[0] |= 0x00000004u;
 }
-void TruncateLogRequest::clear_has_range_id() {
+void RaftProgress::clear_has_next() {
   _has_bits_[0] &= ~0x00000004u;
 }
-void TruncateLogRequest::clear_range_id() {
-  range_id_ = GOOGLE_LONGLONG(0);
-  clear_has_range_id();
+void RaftProgress::clear_next() {
+  next_ = GOOGLE_ULONGLONG(0);
+  clear_has_next();
 }
- ::google::protobuf::int64 TruncateLogRequest::range_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.range_id)
-  return range_id_;
+ ::google::protobuf::uint64 RaftProgress::next() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.next)
+  return next_;
 }
- void TruncateLogRequest::set_range_id(::google::protobuf::int64 value) {
-  set_has_range_id();
-  range_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.range_id)
+ void RaftProgress::set_next(::google::protobuf::uint64 value) {
+  set_has_next();
+  next_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.next)
+}
+
+// optional string state = 4;
+bool RaftProgress::has_state() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void RaftProgress::set_has_state() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void RaftProgress::clear_has_state() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void RaftProgress::clear_state() {
+  state_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_state();
+}
+ const ::std::string& RaftProgress::state() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.state)
+  return state_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RaftProgress::set_state(const ::std::string& value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.state)
+}
+ void RaftProgress::set_state(const char* value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.RaftProgress.state)
+}
+ void RaftProgress::set_state(const char* value, size_t size) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.RaftProgress.state)
+}
+ ::std::string* RaftProgress::mutable_state() {
+  set_has_state();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftProgress.state)
+  return state_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* RaftProgress::release_state() {
+  clear_has_state();
+  return state_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RaftProgress::set_allocated_state(::std::string* state) {
+  if (state != NULL) {
+    set_has_state();
+  } else {
+    clear_has_state();
+  }
+  state_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), state);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftProgress.state)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -16914,84 +19536,127 @@ void TruncateLogRequest::clear_range_id() {
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int TruncateLogResponse::kHeaderFieldNumber;
+const int RaftStatusResponse::kHeaderFieldNumber;
+const int RaftStatusResponse::kRangeIdFieldNumber;
+const int RaftStatusResponse::kReplicaFieldNumber;
+const int RaftStatusResponse::kTermFieldNumber;
+const int RaftStatusResponse::kCommitFieldNumber;
+const int RaftStatusResponse::kAppliedFieldNumber;
+const int RaftStatusResponse::kLeadFieldNumber;
+const int RaftStatusResponse::kStateFieldNumber;
+const int RaftStatusResponse::kProgressFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-TruncateLogResponse::TruncateLogResponse()
+RaftStatusResponse::RaftStatusResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.RaftStatusResponse)
 }
 
-void TruncateLogResponse::InitAsDefaultInstance() {
+void RaftStatusResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+  replica_ = const_cast< ::cockroach::roachpb::ReplicaDescriptor*>(&::cockroach::roachpb::ReplicaDescriptor::default_instance());
 }
 
-TruncateLogResponse::TruncateLogResponse(const TruncateLogResponse& from)
+RaftStatusResponse::RaftStatusResponse(const RaftStatusResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RaftStatusResponse)
 }
 
-void TruncateLogResponse::SharedCtor() {
+void RaftStatusResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   header_ = NULL;
+  range_id_ = GOOGLE_LONGLONG(0);
+  replica_ = NULL;
+  term_ = GOOGLE_ULONGLONG(0);
+  commit_ = GOOGLE_ULONGLONG(0);
+  applied_ = GOOGLE_ULONGLONG(0);
+  lead_ = 0;
+  state_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-TruncateLogResponse::~TruncateLogResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.TruncateLogResponse)
+RaftStatusResponse::~RaftStatusResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.RaftStatusResponse)
   SharedDtor();
 }
 
-void TruncateLogResponse::SharedDtor() {
+void RaftStatusResponse::SharedDtor() {
+  state_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != default_instance_) {
     delete header_;
+    delete replica_;
   }
 }
 
-void TruncateLogResponse::SetCachedSize(int size) const {
+void RaftStatusResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* TruncateLogResponse::descriptor() {
+const ::google::protobuf::Descriptor* RaftStatusResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return TruncateLogResponse_descriptor_;
+  return RaftStatusResponse_descriptor_;
 }
 
-const TruncateLogResponse& TruncateLogResponse::default_instance() {
+const RaftStatusResponse& RaftStatusResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-TruncateLogResponse* TruncateLogResponse::default_instance_ = NULL;
+RaftStatusResponse* RaftStatusResponse::default_instance_ = NULL;
 
-TruncateLogResponse* TruncateLogResponse::New(::google::protobuf::Arena* arena) const {
-  TruncateLogResponse* n = new TruncateLogResponse;
+RaftStatusResponse* RaftStatusResponse::New(::google::protobuf::Arena* arena) const {
+  RaftStatusResponse* n = new RaftStatusResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void TruncateLogResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void RaftStatusResponse::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<RaftStatusResponse*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 255u) {
+    ZR_(term_, applied_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+    }
+    range_id_ = GOOGLE_LONGLONG(0);
+    if (has_replica()) {
+      if (replica_ != NULL) replica_->::cockroach::roachpb::ReplicaDescriptor::Clear();
+    }
+    lead_ = 0;
+    if (has_state()) {
+      state_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
   }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  progress_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool TruncateLogResponse::MergePartialFromCodedStream(
+bool RaftStatusResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RaftStatusResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -17005,6 +19670,128 @@ bool TruncateLogResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_range_id;
+        break;
+      }
+
+      // optional int64 range_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_range_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &range_id_)));
+          set_has_range_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_replica;
+        break;
+      }
+
+      // optional .cockroach.roachpb.ReplicaDescriptor replica = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_replica:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_replica()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_term;
+        break;
+      }
+
+      // optional uint64 term = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_term:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &term_)));
+          set_has_term();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_commit;
+        break;
+      }
+
+      // optional uint64 commit = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_commit:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &commit_)));
+          set_has_commit();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_applied;
+        break;
+      }
+
+      // optional uint64 applied = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_applied:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &applied_)));
+          set_has_applied();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_lead;
+        break;
+      }
+
+      // optional int32 lead = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_lead:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &lead_)));
+          set_has_lead();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_state;
+        break;
+      }
+
+      // optional string state = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_state:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_state()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->state().data(), this->state().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "cockroach.roachpb.RaftStatusResponse.state");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_progress;
+        break;
+      }
+
+      // repeated .cockroach.roachpb.RaftProgress progress = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_progress:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_progress:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_progress()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_loop_progress;
+        input->UnsafeDecrementRecursionDepth();
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -17023,33 +19810,80 @@ bool TruncateLogResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RaftStatusResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RaftStatusResponse)
   return false;
 #undef DO_
 }
 
-void TruncateLogResponse::SerializeWithCachedSizes(
+void RaftStatusResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RaftStatusResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
+  // optional int64 range_id = 2;
+  if (has_range_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->range_id(), output);
+  }
+
+  // optional .cockroach.roachpb.ReplicaDescriptor replica = 3;
+  if (has_replica()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, *this->replica_, output);
+  }
+
+  // optional uint64 term = 4;
+  if (has_term()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(4, this->term(), output);
+  }
+
+  // optional uint64 commit = 5;
+  if (has_commit()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(5, this->commit(), output);
+  }
+
+  // optional uint64 applied = 6;
+  if (has_applied()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(6, this->applied(), output);
+  }
+
+  // optional int32 lead = 7;
+  if (has_lead()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(7, this->lead(), output);
+  }
+
+  // optional string state = 8;
+  if (has_state()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->state().data(), this->state().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cockroach.roachpb.RaftStatusResponse.state");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      8, this->state(), output);
+  }
+
+  // repeated .cockroach.roachpb.RaftProgress progress = 9;
+  for (unsigned int i = 0, n = this->progress_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->progress(i), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RaftStatusResponse)
 }
 
-::google::protobuf::uint8* TruncateLogResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RaftStatusResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RaftStatusResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -17057,22 +19891,131 @@ void TruncateLogResponse::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
+  // optional int64 range_id = 2;
+  if (has_range_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->range_id(), target);
+  }
+
+  // optional .cockroach.roachpb.ReplicaDescriptor replica = 3;
+  if (has_replica()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, *this->replica_, target);
+  }
+
+  // optional uint64 term = 4;
+  if (has_term()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(4, this->term(), target);
+  }
+
+  // optional uint64 commit = 5;
+  if (has_commit()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(5, this->commit(), target);
+  }
+
+  // optional uint64 applied = 6;
+  if (has_applied()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(6, this->applied(), target);
+  }
+
+  // optional int32 lead = 7;
+  if (has_lead()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(7, this->lead(), target);
+  }
+
+  // optional string state = 8;
+  if (has_state()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->state().data(), this->state().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cockroach.roachpb.RaftStatusResponse.state");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        8, this->state(), target);
+  }
+
+  // repeated .cockroach.roachpb.RaftProgress progress = 9;
+  for (unsigned int i = 0, n = this->progress_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->progress(i), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.TruncateLogResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RaftStatusResponse)
   return target;
 }
 
-int TruncateLogResponse::ByteSize() const {
+int RaftStatusResponse::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
+  if (_has_bits_[0 / 32] & 255u) {
+    // optional .cockroach.roachpb.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional int64 range_id = 2;
+    if (has_range_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->range_id());
+    }
+
+    // optional .cockroach.roachpb.ReplicaDescriptor replica = 3;
+    if (has_replica()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->replica_);
+    }
+
+    // optional uint64 term = 4;
+    if (has_term()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->term());
+    }
+
+    // optional uint64 commit = 5;
+    if (has_commit()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->commit());
+    }
+
+    // optional uint64 applied = 6;
+    if (has_applied()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->applied());
+    }
+
+    // optional int32 lead = 7;
+    if (has_lead()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->lead());
+    }
+
+    // optional string state = 8;
+    if (has_state()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->state());
+    }
+
+  }
+  // repeated .cockroach.roachpb.RaftProgress progress = 9;
+  total_size += 1 * this->progress_size();
+  for (int i = 0; i < this->progress_size(); i++) {
+    total_size +=
       ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
+        this->progress(i));
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -17086,10 +20029,10 @@ int TruncateLogResponse::ByteSize() const {
   return total_size;
 }
 
-void TruncateLogResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void RaftStatusResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const TruncateLogResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const TruncateLogResponse>(
+  const RaftStatusResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RaftStatusResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -17098,98 +20041,375 @@ void TruncateLogResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void TruncateLogResponse::MergeFrom(const TruncateLogResponse& from) {
+void RaftStatusResponse::MergeFrom(const RaftStatusResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  progress_.MergeFrom(from.progress_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_range_id()) {
+      set_range_id(from.range_id());
+    }
+    if (from.has_replica()) {
+      mutable_replica()->::cockroach::roachpb::ReplicaDescriptor::MergeFrom(from.replica());
+    }
+    if (from.has_term()) {
+      set_term(from.term());
+    }
+    if (from.has_commit()) {
+      set_commit(from.commit());
+    }
+    if (from.has_applied()) {
+      set_applied(from.applied());
+    }
+    if (from.has_lead()) {
+      set_lead(from.lead());
+    }
+    if (from.has_state()) {
+      set_has_state();
+      state_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.state_);
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void TruncateLogResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void RaftStatusResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void TruncateLogResponse::CopyFrom(const TruncateLogResponse& from) {
+void RaftStatusResponse::CopyFrom(const RaftStatusResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool TruncateLogResponse::IsInitialized() const {
+bool RaftStatusResponse::IsInitialized() const {
 
   return true;
 }
 
-void TruncateLogResponse::Swap(TruncateLogResponse* other) {
+void RaftStatusResponse::Swap(RaftStatusResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void TruncateLogResponse::InternalSwap(TruncateLogResponse* other) {
+void RaftStatusResponse::InternalSwap(RaftStatusResponse* other) {
   std::swap(header_, other->header_);
+  std::swap(range_id_, other->range_id_);
+  std::swap(replica_, other->replica_);
+  std::swap(term_, other->term_);
+  std::swap(commit_, other->commit_);
+  std::swap(applied_, other->applied_);
+  std::swap(lead_, other->lead_);
+  state_.Swap(&other->state_);
+  progress_.UnsafeArenaSwap(&other->progress_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata TruncateLogResponse::GetMetadata() const {
+::google::protobuf::Metadata RaftStatusResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = TruncateLogResponse_descriptor_;
-  metadata.reflection = TruncateLogResponse_reflection_;
+  metadata.descriptor = RaftStatusResponse_descriptor_;
+  metadata.reflection = RaftStatusResponse_reflection_;
   return metadata;
 }
 
-#if PROTOBUF_INLINE_NOT_IN_HEADERS
-// TruncateLogResponse
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// RaftStatusResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool RaftStatusResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void RaftStatusResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void RaftStatusResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void RaftStatusResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::ResponseHeader& RaftStatusResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::ResponseHeader* RaftStatusResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.header)
+  return header_;
+}
+::cockroach::roachpb::ResponseHeader* RaftStatusResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void RaftStatusResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusResponse.header)
+}
+
+// optional int64 range_id = 2;
+bool RaftStatusResponse::has_range_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void RaftStatusResponse::set_has_range_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void RaftStatusResponse::clear_has_range_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void RaftStatusResponse::clear_range_id() {
+  range_id_ = GOOGLE_LONGLONG(0);
+  clear_has_range_id();
+}
+ ::google::protobuf::int64 RaftStatusResponse::range_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.range_id)
+  return range_id_;
+}
+ void RaftStatusResponse::set_range_id(::google::protobuf::int64 value) {
+  set_has_range_id();
+  range_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.range_id)
+}
+
+// optional .cockroach.roachpb.ReplicaDescriptor replica = 3;
+bool RaftStatusResponse::has_replica() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void RaftStatusResponse::set_has_replica() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void RaftStatusResponse::clear_has_replica() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void RaftStatusResponse::clear_replica() {
+  if (replica_ != NULL) replica_->::cockroach::roachpb::ReplicaDescriptor::Clear();
+  clear_has_replica();
+}
+const ::cockroach::roachpb::ReplicaDescriptor& RaftStatusResponse::replica() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.replica)
+  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
+}
+::cockroach::roachpb::ReplicaDescriptor* RaftStatusResponse::mutable_replica() {
+  set_has_replica();
+  if (replica_ == NULL) {
+    replica_ = new ::cockroach::roachpb::ReplicaDescriptor;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.replica)
+  return replica_;
+}
+::cockroach::roachpb::ReplicaDescriptor* RaftStatusResponse::release_replica() {
+  clear_has_replica();
+  ::cockroach::roachpb::ReplicaDescriptor* temp = replica_;
+  replica_ = NULL;
+  return temp;
+}
+void RaftStatusResponse::set_allocated_replica(::cockroach::roachpb::ReplicaDescriptor* replica) {
+  delete replica_;
+  replica_ = replica;
+  if (replica) {
+    set_has_replica();
+  } else {
+    clear_has_replica();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusResponse.replica)
+}
+
+// optional uint64 term = 4;
+bool RaftStatusResponse::has_term() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void RaftStatusResponse::set_has_term() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void RaftStatusResponse::clear_has_term() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void RaftStatusResponse::clear_term() {
+  term_ = GOOGLE_ULONGLONG(0);
+  clear_has_term();
+}
+ ::google::protobuf::uint64 RaftStatusResponse::term() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.term)
+  return term_;
+}
+ void RaftStatusResponse::set_term(::google::protobuf::uint64 value) {
+  set_has_term();
+  term_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.term)
+}
+
+// optional uint64 commit = 5;
+bool RaftStatusResponse::has_commit() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+void RaftStatusResponse::set_has_commit() {
+  _has_bits_[0] |= 0x00000010u;
+}
+void RaftStatusResponse::clear_has_commit() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+void RaftStatusResponse::clear_commit() {
+  commit_ = GOOGLE_ULONGLONG(0);
+  clear_has_commit();
+}
+ ::google::protobuf::uint64 RaftStatusResponse::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.commit)
+  return commit_;
+}
+ void RaftStatusResponse::set_commit(::google::protobuf::uint64 value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.commit)
+}
+
+// optional uint64 applied = 6;
+bool RaftStatusResponse::has_applied() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+void RaftStatusResponse::set_has_applied() {
+  _has_bits_[0] |= 0x00000020u;
+}
+void RaftStatusResponse::clear_has_applied() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+void RaftStatusResponse::clear_applied() {
+  applied_ = GOOGLE_ULONGLONG(0);
+  clear_has_applied();
+}
+ ::google::protobuf::uint64 RaftStatusResponse::applied() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.applied)
+  return applied_;
+}
+ void RaftStatusResponse::set_applied(::google::protobuf::uint64 value) {
+  set_has_applied();
+  applied_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.applied)
+}
+
+// optional int32 lead = 7;
+bool RaftStatusResponse::has_lead() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+void RaftStatusResponse::set_has_lead() {
+  _has_bits_[0] |= 0x00000040u;
+}
+void RaftStatusResponse::clear_has_lead() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+void RaftStatusResponse::clear_lead() {
+  lead_ = 0;
+  clear_has_lead();
+}
+ ::google::protobuf::int32 RaftStatusResponse::lead() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.lead)
+  return lead_;
+}
+ void RaftStatusResponse::set_lead(::google::protobuf::int32 value) {
+  set_has_lead();
+  lead_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.lead)
+}
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-bool TruncateLogResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional string state = 8;
+bool RaftStatusResponse::has_state() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
 }
-void TruncateLogResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+void RaftStatusResponse::set_has_state() {
+  _has_bits_[0] |= 0x00000080u;
 }
-void TruncateLogResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+void RaftStatusResponse::clear_has_state() {
+  _has_bits_[0] &= ~0x00000080u;
 }
-void TruncateLogResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
-  clear_has_header();
+void RaftStatusResponse::clear_state() {
+  state_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_state();
 }
-const ::cockroach::roachpb::ResponseHeader& TruncateLogResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+ const ::std::string& RaftStatusResponse::state() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.state)
+  return state_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-::cockroach::roachpb::ResponseHeader* TruncateLogResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogResponse.header)
-  return header_;
+ void RaftStatusResponse::set_state(const ::std::string& value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.state)
 }
-::cockroach::roachpb::ResponseHeader* TruncateLogResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+ void RaftStatusResponse::set_state(const char* value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.RaftStatusResponse.state)
 }
-void TruncateLogResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+ void RaftStatusResponse::set_state(const char* value, size_t size) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.RaftStatusResponse.state)
+}
+ ::std::string* RaftStatusResponse::mutable_state() {
+  set_has_state();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.state)
+  return state_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* RaftStatusResponse::release_state() {
+  clear_has_state();
+  return state_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RaftStatusResponse::set_allocated_state(::std::string* state) {
+  if (state != NULL) {
+    set_has_state();
   } else {
-    clear_has_header();
+    clear_has_state();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogResponse.header)
+  state_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), state);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusResponse.state)
+}
+
+// repeated .cockroach.roachpb.RaftProgress progress = 9;
+int RaftStatusResponse::progress_size() const {
+  return progress_.size();
+}
+void RaftStatusResponse::clear_progress() {
+  progress_.Clear();
+}
+const ::cockroach::roachpb::RaftProgress& RaftStatusResponse::progress(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_.Get(index);
+}
+::cockroach::roachpb::RaftProgress* RaftStatusResponse::mutable_progress(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_.Mutable(index);
+}
+::cockroach::roachpb::RaftProgress* RaftStatusResponse::add_progress() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_.Add();
+}
+::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RaftProgress >*
+RaftStatusResponse::mutable_progress() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.RaftStatusResponse.progress)
+  return &progress_;
+}
+const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RaftProgress >&
+RaftStatusResponse::progress() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_;
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -17197,81 +20417,72 @@ void TruncateLogResponse::set_allocated_header(::cockroach::roachpb::ResponseHea
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int LeaderLeaseRequest::kHeaderFieldNumber;
-const int LeaderLeaseRequest::kLeaseFieldNumber;
+const int RangeStatsRequest::kHeaderFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-LeaderLeaseRequest::LeaderLeaseRequest()
+RangeStatsRequest::RangeStatsRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.RangeStatsRequest)
 }
 
-void LeaderLeaseRequest::InitAsDefaultInstance() {
+void RangeStatsRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
-  lease_ = const_cast< ::cockroach::roachpb::Lease*>(&::cockroach::roachpb::Lease::default_instance());
 }
 
-LeaderLeaseRequest::LeaderLeaseRequest(const LeaderLeaseRequest& from)
+RangeStatsRequest::RangeStatsRequest(const RangeStatsRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RangeStatsRequest)
 }
 
-void LeaderLeaseRequest::SharedCtor() {
+void RangeStatsRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  lease_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-LeaderLeaseRequest::~LeaderLeaseRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.LeaderLeaseRequest)
+RangeStatsRequest::~RangeStatsRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.RangeStatsRequest)
   SharedDtor();
 }
 
-void LeaderLeaseRequest::SharedDtor() {
+void RangeStatsRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete lease_;
   }
 }
 
-void LeaderLeaseRequest::SetCachedSize(int size) const {
+void RangeStatsRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* LeaderLeaseRequest::descriptor() {
+const ::google::protobuf::Descriptor* RangeStatsRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return LeaderLeaseRequest_descriptor_;
+  return RangeStatsRequest_descriptor_;
 }
 
-const LeaderLeaseRequest& LeaderLeaseRequest::default_instance() {
+const RangeStatsRequest& RangeStatsRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-LeaderLeaseRequest* LeaderLeaseRequest::default_instance_ = NULL;
+RangeStatsRequest* RangeStatsRequest::default_instance_ = NULL;
 
-LeaderLeaseRequest* LeaderLeaseRequest::New(::google::protobuf::Arena* arena) const {
-  LeaderLeaseRequest* n = new LeaderLeaseRequest;
+RangeStatsRequest* RangeStatsRequest::New(::google::protobuf::Arena* arena) const {
+  RangeStatsRequest* n = new RangeStatsRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void LeaderLeaseRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-    }
-    if (has_lease()) {
-      if (lease_ != NULL) lease_->::cockroach::roachpb::Lease::Clear();
-    }
+void RangeStatsRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -17279,11 +20490,11 @@ void LeaderLeaseRequest::Clear() {
   }
 }
 
-bool LeaderLeaseRequest::MergePartialFromCodedStream(
+bool RangeStatsRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RangeStatsRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -17297,19 +20508,6 @@ bool LeaderLeaseRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_lease;
-        break;
-      }
-
-      // optional .cockroach.roachpb.Lease lease = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_lease:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_lease()));
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -17328,39 +20526,33 @@ bool LeaderLeaseRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RangeStatsRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RangeStatsRequest)
   return false;
 #undef DO_
 }
 
-void LeaderLeaseRequest::SerializeWithCachedSizes(
+void RangeStatsRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RangeStatsRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional .cockroach.roachpb.Lease lease = 2;
-  if (has_lease()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, *this->lease_, output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RangeStatsRequest)
 }
 
-::google::protobuf::uint8* LeaderLeaseRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RangeStatsRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RangeStatsRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -17368,40 +20560,24 @@ void LeaderLeaseRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // optional .cockroach.roachpb.Lease lease = 2;
-  if (has_lease()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, *this->lease_, target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.LeaderLeaseRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RangeStatsRequest)
   return target;
 }
 
-int LeaderLeaseRequest::ByteSize() const {
+int RangeStatsRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3u) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional .cockroach.roachpb.Lease lease = 2;
-    if (has_lease()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->lease_);
-    }
-
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -17413,10 +20589,10 @@ int LeaderLeaseRequest::ByteSize() const {
   return total_size;
 }
 
-void LeaderLeaseRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void RangeStatsRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const LeaderLeaseRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const LeaderLeaseRequest>(
+  const RangeStatsRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RangeStatsRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -17425,94 +20601,90 @@ void LeaderLeaseRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void LeaderLeaseRequest::MergeFrom(const LeaderLeaseRequest& from) {
+void RangeStatsRequest::MergeFrom(const RangeStatsRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
-    if (from.has_lease()) {
-      mutable_lease()->::cockroach::roachpb::Lease::MergeFrom(from.lease());
-    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void LeaderLeaseRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void RangeStatsRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void LeaderLeaseRequest::CopyFrom(const LeaderLeaseRequest& from) {
+void RangeStatsRequest::CopyFrom(const RangeStatsRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool LeaderLeaseRequest::IsInitialized() const {
+bool RangeStatsRequest::IsInitialized() const {
 
   return true;
 }
 
-void LeaderLeaseRequest::Swap(LeaderLeaseRequest* other) {
+void RangeStatsRequest::Swap(RangeStatsRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void LeaderLeaseRequest::InternalSwap(LeaderLeaseRequest* other) {
+void RangeStatsRequest::InternalSwap(RangeStatsRequest* other) {
   std::swap(header_, other->header_);
-  std::swap(lease_, other->lease_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata LeaderLeaseRequest::GetMetadata() const {
+::google::protobuf::Metadata RangeStatsRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = LeaderLeaseRequest_descriptor_;
-  metadata.reflection = LeaderLeaseRequest_reflection_;
+  metadata.descriptor = RangeStatsRequest_descriptor_;
+  metadata.reflection = RangeStatsRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// LeaderLeaseRequest
+// RangeStatsRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-bool LeaderLeaseRequest::has_header() const {
+bool RangeStatsRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void LeaderLeaseRequest::set_has_header() {
+void RangeStatsRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void LeaderLeaseRequest::clear_has_header() {
+void RangeStatsRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void LeaderLeaseRequest::clear_header() {
+void RangeStatsRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-const ::cockroach::roachpb::Span& LeaderLeaseRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.header)
+const ::cockroach::roachpb::Span& RangeStatsRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-::cockroach::roachpb::Span* LeaderLeaseRequest::mutable_header() {
+::cockroach::roachpb::Span* RangeStatsRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsRequest.header)
   return header_;
 }
-::cockroach::roachpb::Span* LeaderLeaseRequest::release_header() {
+::cockroach::roachpb::Span* RangeStatsRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-void LeaderLeaseRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+void RangeStatsRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -17520,50 +20692,7 @@ void LeaderLeaseRequest::set_allocated_header(::cockroach::roachpb::Span* header
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.header)
-}
-
-// optional .cockroach.roachpb.Lease lease = 2;
-bool LeaderLeaseRequest::has_lease() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void LeaderLeaseRequest::set_has_lease() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void LeaderLeaseRequest::clear_has_lease() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void LeaderLeaseRequest::clear_lease() {
-  if (lease_ != NULL) lease_->::cockroach::roachpb::Lease::Clear();
-  clear_has_lease();
-}
-const ::cockroach::roachpb::Lease& LeaderLeaseRequest::lease() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.lease)
-  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
-}
-::cockroach::roachpb::Lease* LeaderLeaseRequest::mutable_lease() {
-  set_has_lease();
-  if (lease_ == NULL) {
-    lease_ = new ::cockroach::roachpb::Lease;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.lease)
-  return lease_;
-}
-::cockroach::roachpb::Lease* LeaderLeaseRequest::release_lease() {
-  clear_has_lease();
-  ::cockroach::roachpb::Lease* temp = lease_;
-  lease_ = NULL;
-  return temp;
-}
-void LeaderLeaseRequest::set_allocated_lease(::cockroach::roachpb::Lease* lease) {
-  delete lease_;
-  lease_ = lease;
-  if (lease) {
-    set_has_lease();
-  } else {
-    clear_has_lease();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.lease)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsRequest.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -17571,84 +20700,122 @@ void LeaderLeaseRequest::set_allocated_lease(::cockroach::roachpb::Lease* lease)
 // ===================================================================
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int LeaderLeaseResponse::kHeaderFieldNumber;
+const int RangeStatsResponse::kHeaderFieldNumber;
+const int RangeStatsResponse::kRangeIdFieldNumber;
+const int RangeStatsResponse::kDescFieldNumber;
+const int RangeStatsResponse::kLiveBytesFieldNumber;
+const int RangeStatsResponse::kLiveCountFieldNumber;
+const int RangeStatsResponse::kKeyCountFieldNumber;
+const int RangeStatsResponse::kGcThresholdFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-LeaderLeaseResponse::LeaderLeaseResponse()
+RangeStatsResponse::RangeStatsResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.RangeStatsResponse)
 }
 
-void LeaderLeaseResponse::InitAsDefaultInstance() {
+void RangeStatsResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+  desc_ = const_cast< ::cockroach::roachpb::RangeDescriptor*>(&::cockroach::roachpb::RangeDescriptor::default_instance());
+  gc_threshold_ = const_cast< ::cockroach::roachpb::Timestamp*>(&::cockroach::roachpb::Timestamp::default_instance());
 }
 
-LeaderLeaseResponse::LeaderLeaseResponse(const LeaderLeaseResponse& from)
+RangeStatsResponse::RangeStatsResponse(const RangeStatsResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.RangeStatsResponse)
 }
 
-void LeaderLeaseResponse::SharedCtor() {
+void RangeStatsResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  range_id_ = GOOGLE_LONGLONG(0);
+  desc_ = NULL;
+  live_bytes_ = GOOGLE_LONGLONG(0);
+  live_count_ = GOOGLE_LONGLONG(0);
+  key_count_ = GOOGLE_LONGLONG(0);
+  gc_threshold_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-LeaderLeaseResponse::~LeaderLeaseResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.LeaderLeaseResponse)
+RangeStatsResponse::~RangeStatsResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.RangeStatsResponse)
   SharedDtor();
 }
 
-void LeaderLeaseResponse::SharedDtor() {
+void RangeStatsResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete desc_;
+    delete gc_threshold_;
   }
 }
 
-void LeaderLeaseResponse::SetCachedSize(int size) const {
+void RangeStatsResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* LeaderLeaseResponse::descriptor() {
+const ::google::protobuf::Descriptor* RangeStatsResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return LeaderLeaseResponse_descriptor_;
+  return RangeStatsResponse_descriptor_;
 }
 
-const LeaderLeaseResponse& LeaderLeaseResponse::default_instance() {
+const RangeStatsResponse& RangeStatsResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-LeaderLeaseResponse* LeaderLeaseResponse::default_instance_ = NULL;
+RangeStatsResponse* RangeStatsResponse::default_instance_ = NULL;
 
-LeaderLeaseResponse* LeaderLeaseResponse::New(::google::protobuf::Arena* arena) const {
-  LeaderLeaseResponse* n = new LeaderLeaseResponse;
+RangeStatsResponse* RangeStatsResponse::New(::google::protobuf::Arena* arena) const {
+  RangeStatsResponse* n = new RangeStatsResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void LeaderLeaseResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void RangeStatsResponse::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<RangeStatsResponse*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 127u) {
+    ZR_(live_bytes_, key_count_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+    }
+    range_id_ = GOOGLE_LONGLONG(0);
+    if (has_desc()) {
+      if (desc_ != NULL) desc_->::cockroach::roachpb::RangeDescriptor::Clear();
+    }
+    if (has_gc_threshold()) {
+      if (gc_threshold_ != NULL) gc_threshold_->::cockroach::roachpb::Timestamp::Clear();
+    }
   }
+
+#undef ZR_HELPER_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool LeaderLeaseResponse::MergePartialFromCodedStream(
+bool RangeStatsResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.RangeStatsResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -17662,6 +20829,92 @@ bool LeaderLeaseResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_range_id;
+        break;
+      }
+
+      // optional int64 range_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_range_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &range_id_)));
+          set_has_range_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_desc;
+        break;
+      }
+
+      // optional .cockroach.roachpb.RangeDescriptor desc = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_desc:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_desc()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_live_bytes;
+        break;
+      }
+
+      // optional int64 live_bytes = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_live_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &live_bytes_)));
+          set_has_live_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_live_count;
+        break;
+      }
+
+      // optional int64 live_count = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_live_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &live_count_)));
+          set_has_live_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_key_count;
+        break;
+      }
+
+      // optional int64 key_count = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_key_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &key_count_)));
+          set_has_key_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_gc_threshold;
+        break;
+      }
+
+      // optional .cockroach.roachpb.Timestamp gc_threshold = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_gc_threshold:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_gc_threshold()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -17680,33 +20933,65 @@ bool LeaderLeaseResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.RangeStatsResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.RangeStatsResponse)
   return false;
 #undef DO_
 }
 
-void LeaderLeaseResponse::SerializeWithCachedSizes(
+void RangeStatsResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.RangeStatsResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
+  // optional int64 range_id = 2;
+  if (has_range_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->range_id(), output);
+  }
+
+  // optional .cockroach.roachpb.RangeDescriptor desc = 3;
+  if (has_desc()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, *this->desc_, output);
+  }
+
+  // optional int64 live_bytes = 4;
+  if (has_live_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->live_bytes(), output);
+  }
+
+  // optional int64 live_count = 5;
+  if (has_live_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->live_count(), output);
+  }
+
+  // optional int64 key_count = 6;
+  if (has_key_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->key_count(), output);
+  }
+
+  // optional .cockroach.roachpb.Timestamp gc_threshold = 7;
+  if (has_gc_threshold()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, *this->gc_threshold_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.RangeStatsResponse)
 }
 
-::google::protobuf::uint8* LeaderLeaseResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RangeStatsResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.RangeStatsResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -17714,24 +20999,102 @@ void LeaderLeaseResponse::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
+  // optional int64 range_id = 2;
+  if (has_range_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->range_id(), target);
+  }
+
+  // optional .cockroach.roachpb.RangeDescriptor desc = 3;
+  if (has_desc()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, *this->desc_, target);
+  }
+
+  // optional int64 live_bytes = 4;
+  if (has_live_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->live_bytes(), target);
+  }
+
+  // optional int64 live_count = 5;
+  if (has_live_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->live_count(), target);
+  }
+
+  // optional int64 key_count = 6;
+  if (has_key_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->key_count(), target);
+  }
+
+  // optional .cockroach.roachpb.Timestamp gc_threshold = 7;
+  if (has_gc_threshold()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, *this->gc_threshold_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.LeaderLeaseResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.RangeStatsResponse)
   return target;
 }
 
-int LeaderLeaseResponse::ByteSize() const {
+int RangeStatsResponse::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 127u) {
+    // optional .cockroach.roachpb.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional int64 range_id = 2;
+    if (has_range_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->range_id());
+    }
+
+    // optional .cockroach.roachpb.RangeDescriptor desc = 3;
+    if (has_desc()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->desc_);
+    }
+
+    // optional int64 live_bytes = 4;
+    if (has_live_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->live_bytes());
+    }
+
+    // optional int64 live_count = 5;
+    if (has_live_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->live_count());
+    }
+
+    // optional int64 key_count = 6;
+    if (has_key_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->key_count());
+    }
+
+    // optional .cockroach.roachpb.Timestamp gc_threshold = 7;
+    if (has_gc_threshold()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->gc_threshold_);
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -17743,10 +21106,10 @@ int LeaderLeaseResponse::ByteSize() const {
   return total_size;
 }
 
-void LeaderLeaseResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void RangeStatsResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const LeaderLeaseResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const LeaderLeaseResponse>(
+  const RangeStatsResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RangeStatsResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -17755,98 +21118,304 @@ void LeaderLeaseResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void LeaderLeaseResponse::MergeFrom(const LeaderLeaseResponse& from) {
+void RangeStatsResponse::MergeFrom(const RangeStatsResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_range_id()) {
+      set_range_id(from.range_id());
+    }
+    if (from.has_desc()) {
+      mutable_desc()->::cockroach::roachpb::RangeDescriptor::MergeFrom(from.desc());
+    }
+    if (from.has_live_bytes()) {
+      set_live_bytes(from.live_bytes());
+    }
+    if (from.has_live_count()) {
+      set_live_count(from.live_count());
+    }
+    if (from.has_key_count()) {
+      set_key_count(from.key_count());
+    }
+    if (from.has_gc_threshold()) {
+      mutable_gc_threshold()->::cockroach::roachpb::Timestamp::MergeFrom(from.gc_threshold());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void LeaderLeaseResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void RangeStatsResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void LeaderLeaseResponse::CopyFrom(const LeaderLeaseResponse& from) {
+void RangeStatsResponse::CopyFrom(const RangeStatsResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool LeaderLeaseResponse::IsInitialized() const {
+bool RangeStatsResponse::IsInitialized() const {
 
   return true;
 }
 
-void LeaderLeaseResponse::Swap(LeaderLeaseResponse* other) {
+void RangeStatsResponse::Swap(RangeStatsResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void LeaderLeaseResponse::InternalSwap(LeaderLeaseResponse* other) {
+void RangeStatsResponse::InternalSwap(RangeStatsResponse* other) {
   std::swap(header_, other->header_);
+  std::swap(range_id_, other->range_id_);
+  std::swap(desc_, other->desc_);
+  std::swap(live_bytes_, other->live_bytes_);
+  std::swap(live_count_, other->live_count_);
+  std::swap(key_count_, other->key_count_);
+  std::swap(gc_threshold_, other->gc_threshold_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata LeaderLeaseResponse::GetMetadata() const {
+::google::protobuf::Metadata RangeStatsResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = LeaderLeaseResponse_descriptor_;
-  metadata.reflection = LeaderLeaseResponse_reflection_;
+  metadata.descriptor = RangeStatsResponse_descriptor_;
+  metadata.reflection = RangeStatsResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// LeaderLeaseResponse
+// RangeStatsResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-bool LeaderLeaseResponse::has_header() const {
+bool RangeStatsResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void LeaderLeaseResponse::set_has_header() {
+void RangeStatsResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void LeaderLeaseResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+void RangeStatsResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void RangeStatsResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+const ::cockroach::roachpb::ResponseHeader& RangeStatsResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+::cockroach::roachpb::ResponseHeader* RangeStatsResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsResponse.header)
+  return header_;
+}
+::cockroach::roachpb::ResponseHeader* RangeStatsResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+void RangeStatsResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsResponse.header)
+}
+
+// optional int64 range_id = 2;
+bool RangeStatsResponse::has_range_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void RangeStatsResponse::set_has_range_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void RangeStatsResponse::clear_has_range_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void RangeStatsResponse::clear_range_id() {
+  range_id_ = GOOGLE_LONGLONG(0);
+  clear_has_range_id();
+}
+ ::google::protobuf::int64 RangeStatsResponse::range_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.range_id)
+  return range_id_;
+}
+ void RangeStatsResponse::set_range_id(::google::protobuf::int64 value) {
+  set_has_range_id();
+  range_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.range_id)
+}
+
+// optional .cockroach.roachpb.RangeDescriptor desc = 3;
+bool RangeStatsResponse::has_desc() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void RangeStatsResponse::set_has_desc() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void RangeStatsResponse::clear_has_desc() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void RangeStatsResponse::clear_desc() {
+  if (desc_ != NULL) desc_->::cockroach::roachpb::RangeDescriptor::Clear();
+  clear_has_desc();
+}
+const ::cockroach::roachpb::RangeDescriptor& RangeStatsResponse::desc() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.desc)
+  return desc_ != NULL ? *desc_ : *default_instance_->desc_;
+}
+::cockroach::roachpb::RangeDescriptor* RangeStatsResponse::mutable_desc() {
+  set_has_desc();
+  if (desc_ == NULL) {
+    desc_ = new ::cockroach::roachpb::RangeDescriptor;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsResponse.desc)
+  return desc_;
+}
+::cockroach::roachpb::RangeDescriptor* RangeStatsResponse::release_desc() {
+  clear_has_desc();
+  ::cockroach::roachpb::RangeDescriptor* temp = desc_;
+  desc_ = NULL;
+  return temp;
+}
+void RangeStatsResponse::set_allocated_desc(::cockroach::roachpb::RangeDescriptor* desc) {
+  delete desc_;
+  desc_ = desc;
+  if (desc) {
+    set_has_desc();
+  } else {
+    clear_has_desc();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsResponse.desc)
+}
+
+// optional int64 live_bytes = 4;
+bool RangeStatsResponse::has_live_bytes() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void RangeStatsResponse::set_has_live_bytes() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void RangeStatsResponse::clear_has_live_bytes() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void RangeStatsResponse::clear_live_bytes() {
+  live_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_live_bytes();
+}
+ ::google::protobuf::int64 RangeStatsResponse::live_bytes() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.live_bytes)
+  return live_bytes_;
+}
+ void RangeStatsResponse::set_live_bytes(::google::protobuf::int64 value) {
+  set_has_live_bytes();
+  live_bytes_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.live_bytes)
+}
+
+// optional int64 live_count = 5;
+bool RangeStatsResponse::has_live_count() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+void RangeStatsResponse::set_has_live_count() {
+  _has_bits_[0] |= 0x00000010u;
+}
+void RangeStatsResponse::clear_has_live_count() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+void RangeStatsResponse::clear_live_count() {
+  live_count_ = GOOGLE_LONGLONG(0);
+  clear_has_live_count();
+}
+ ::google::protobuf::int64 RangeStatsResponse::live_count() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.live_count)
+  return live_count_;
+}
+ void RangeStatsResponse::set_live_count(::google::protobuf::int64 value) {
+  set_has_live_count();
+  live_count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.live_count)
+}
+
+// optional int64 key_count = 6;
+bool RangeStatsResponse::has_key_count() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+void RangeStatsResponse::set_has_key_count() {
+  _has_bits_[0] |= 0x00000020u;
+}
+void RangeStatsResponse::clear_has_key_count() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+void RangeStatsResponse::clear_key_count() {
+  key_count_ = GOOGLE_LONGLONG(0);
+  clear_has_key_count();
+}
+ ::google::protobuf::int64 RangeStatsResponse::key_count() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.key_count)
+  return key_count_;
+}
+ void RangeStatsResponse::set_key_count(::google::protobuf::int64 value) {
+  set_has_key_count();
+  key_count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.key_count)
+}
+
+// optional .cockroach.roachpb.Timestamp gc_threshold = 7;
+bool RangeStatsResponse::has_gc_threshold() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+void RangeStatsResponse::set_has_gc_threshold() {
+  _has_bits_[0] |= 0x00000040u;
 }
-void LeaderLeaseResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
-  clear_has_header();
+void RangeStatsResponse::clear_has_gc_threshold() {
+  _has_bits_[0] &= ~0x00000040u;
 }
-const ::cockroach::roachpb::ResponseHeader& LeaderLeaseResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+void RangeStatsResponse::clear_gc_threshold() {
+  if (gc_threshold_ != NULL) gc_threshold_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_gc_threshold();
 }
-::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+const ::cockroach::roachpb::Timestamp& RangeStatsResponse::gc_threshold() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.gc_threshold)
+  return gc_threshold_ != NULL ? *gc_threshold_ : *default_instance_->gc_threshold_;
+}
+::cockroach::roachpb::Timestamp* RangeStatsResponse::mutable_gc_threshold() {
+  set_has_gc_threshold();
+  if (gc_threshold_ == NULL) {
+    gc_threshold_ = new ::cockroach::roachpb::Timestamp;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseResponse.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsResponse.gc_threshold)
+  return gc_threshold_;
 }
-::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
+::cockroach::roachpb::Timestamp* RangeStatsResponse::release_gc_threshold() {
+  clear_has_gc_threshold();
+  ::cockroach::roachpb::Timestamp* temp = gc_threshold_;
+  gc_threshold_ = NULL;
   return temp;
 }
-void LeaderLeaseResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+void RangeStatsResponse::set_allocated_gc_threshold(::cockroach::roachpb::Timestamp* gc_threshold) {
+  delete gc_threshold_;
+  gc_threshold_ = gc_threshold;
+  if (gc_threshold) {
+    set_has_gc_threshold();
   } else {
-    clear_has_header();
+    clear_has_gc_threshold();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsResponse.gc_threshold)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -17876,6 +21445,9 @@ const int RequestUnion::kTruncateLogFieldNumber;
 const int RequestUnion::kLeaderLeaseFieldNumber;
 const int RequestUnion::kReverseScanFieldNumber;
 const int RequestUnion::kNoopFieldNumber;
+const int RequestUnion::kRaftStatusFieldNumber;
+const int RequestUnion::kRangeStatsFieldNumber;
+const int RequestUnion::kAdminScatterFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
 RequestUnion::RequestUnion()
@@ -17907,6 +21479,9 @@ void RequestUnion::InitAsDefaultInstance() {
   leader_lease_ = const_cast< ::cockroach::roachpb::LeaderLeaseRequest*>(&::cockroach::roachpb::LeaderLeaseRequest::default_instance());
   reverse_scan_ = const_cast< ::cockroach::roachpb::ReverseScanRequest*>(&::cockroach::roachpb::ReverseScanRequest::default_instance());
   noop_ = const_cast< ::cockroach::roachpb::NoopRequest*>(&::cockroach::roachpb::NoopRequest::default_instance());
+  raft_status_ = const_cast< ::cockroach::roachpb::RaftStatusRequest*>(&::cockroach::roachpb::RaftStatusRequest::default_instance());
+  range_stats_ = const_cast< ::cockroach::roachpb::RangeStatsRequest*>(&::cockroach::roachpb::RangeStatsRequest::default_instance());
+  admin_scatter_ = const_cast< ::cockroach::roachpb::AdminScatterRequest*>(&::cockroach::roachpb::AdminScatterRequest::default_instance());
 }
 
 RequestUnion::RequestUnion(const RequestUnion& from)
@@ -17941,6 +21516,9 @@ void RequestUnion::SharedCtor() {
   leader_lease_ = NULL;
   reverse_scan_ = NULL;
   noop_ = NULL;
+  raft_status_ = NULL;
+  range_stats_ = NULL;
+  admin_scatter_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -17973,6 +21551,9 @@ void RequestUnion::SharedDtor() {
     delete leader_lease_;
     delete reverse_scan_;
     delete noop_;
+    delete raft_status_;
+    delete range_stats_;
+    delete admin_scatter_;
   }
 }
 
@@ -18054,7 +21635,7 @@ void RequestUnion::Clear() {
       if (resolve_intent_ != NULL) resolve_intent_->::cockroach::roachpb::ResolveIntentRequest::Clear();
     }
   }
-  if (_has_bits_[16 / 32] & 4128768u) {
+  if (_has_bits_[16 / 32] & 16711680u) {
     if (has_resolve_intent_range()) {
       if (resolve_intent_range_ != NULL) resolve_intent_range_->::cockroach::roachpb::ResolveIntentRangeRequest::Clear();
     }
@@ -18073,6 +21654,15 @@ void RequestUnion::Clear() {
     if (has_noop()) {
       if (noop_ != NULL) noop_->::cockroach::roachpb::NoopRequest::Clear();
     }
+    if (has_raft_status()) {
+      if (raft_status_ != NULL) raft_status_->::cockroach::roachpb::RaftStatusRequest::Clear();
+    }
+    if (has_range_stats()) {
+      if (range_stats_ != NULL) range_stats_->::cockroach::roachpb::RangeStatsRequest::Clear();
+    }
+  }
+  if (has_admin_scatter()) {
+    if (admin_scatter_ != NULL) admin_scatter_->::cockroach::roachpb::AdminScatterRequest::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -18371,6 +21961,45 @@ bool RequestUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(186)) goto parse_raft_status;
+        break;
+      }
+
+      // optional .cockroach.roachpb.RaftStatusRequest raft_status = 23;
+      case 23: {
+        if (tag == 186) {
+         parse_raft_status:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_raft_status()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(194)) goto parse_range_stats;
+        break;
+      }
+
+      // optional .cockroach.roachpb.RangeStatsRequest range_stats = 24;
+      case 24: {
+        if (tag == 194) {
+         parse_range_stats:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_range_stats()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(202)) goto parse_admin_scatter;
+        break;
+      }
+
+      // optional .cockroach.roachpb.AdminScatterRequest admin_scatter = 25;
+      case 25: {
+        if (tag == 202) {
+         parse_admin_scatter:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_admin_scatter()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -18532,6 +22161,24 @@ void RequestUnion::SerializeWithCachedSizes(
       22, *this->noop_, output);
   }
 
+  // optional .cockroach.roachpb.RaftStatusRequest raft_status = 23;
+  if (has_raft_status()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      23, *this->raft_status_, output);
+  }
+
+  // optional .cockroach.roachpb.RangeStatsRequest range_stats = 24;
+  if (has_range_stats()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      24, *this->range_stats_, output);
+  }
+
+  // optional .cockroach.roachpb.AdminScatterRequest admin_scatter = 25;
+  if (has_admin_scatter()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      25, *this->admin_scatter_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -18696,6 +22343,27 @@ void RequestUnion::SerializeWithCachedSizes(
         22, *this->noop_, target);
   }
 
+  // optional .cockroach.roachpb.RaftStatusRequest raft_status = 23;
+  if (has_raft_status()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        23, *this->raft_status_, target);
+  }
+
+  // optional .cockroach.roachpb.RangeStatsRequest range_stats = 24;
+  if (has_range_stats()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        24, *this->range_stats_, target);
+  }
+
+  // optional .cockroach.roachpb.AdminScatterRequest admin_scatter = 25;
+  if (has_admin_scatter()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        25, *this->admin_scatter_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -18823,7 +22491,7 @@ int RequestUnion::ByteSize() const {
     }
 
   }
-  if (_has_bits_[16 / 32] & 4128768u) {
+  if (_has_bits_[16 / 32] & 16711680u) {
     // optional .cockroach.roachpb.ResolveIntentRangeRequest resolve_intent_range = 17;
     if (has_resolve_intent_range()) {
       total_size += 2 +
@@ -18866,7 +22534,28 @@ int RequestUnion::ByteSize() const {
           *this->noop_);
     }
 
+    // optional .cockroach.roachpb.RaftStatusRequest raft_status = 23;
+    if (has_raft_status()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->raft_status_);
+    }
+
+    // optional .cockroach.roachpb.RangeStatsRequest range_stats = 24;
+    if (has_range_stats()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->range_stats_);
+    }
+
+  }
+  // optional .cockroach.roachpb.AdminScatterRequest admin_scatter = 25;
+  if (has_admin_scatter()) {
+    total_size += 2 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->admin_scatter_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -18963,6 +22652,17 @@ void RequestUnion::MergeFrom(const RequestUnion& from) {
     if (from.has_noop()) {
       mutable_noop()->::cockroach::roachpb::NoopRequest::MergeFrom(from.noop());
     }
+    if (from.has_raft_status()) {
+      mutable_raft_status()->::cockroach::roachpb::RaftStatusRequest::MergeFrom(from.raft_status());
+    }
+    if (from.has_range_stats()) {
+      mutable_range_stats()->::cockroach::roachpb::RangeStatsRequest::MergeFrom(from.range_stats());
+    }
+  }
+  if (from._has_bits_[24 / 32] & (0xffu << (24 % 32))) {
+    if (from.has_admin_scatter()) {
+      mutable_admin_scatter()->::cockroach::roachpb::AdminScatterRequest::MergeFrom(from.admin_scatter());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -19013,6 +22713,9 @@ void RequestUnion::InternalSwap(RequestUnion* other) {
   std::swap(leader_lease_, other->leader_lease_);
   std::swap(reverse_scan_, other->reverse_scan_);
   std::swap(noop_, other->noop_);
+  std::swap(raft_status_, other->raft_status_);
+  std::swap(range_stats_, other->range_stats_);
+  std::swap(admin_scatter_, other->admin_scatter_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -19975,6 +23678,135 @@ void RequestUnion::set_allocated_noop(::cockroach::roachpb::NoopRequest* noop) {
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.noop)
 }
 
+// optional .cockroach.roachpb.RaftStatusRequest raft_status = 23;
+bool RequestUnion::has_raft_status() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+void RequestUnion::set_has_raft_status() {
+  _has_bits_[0] |= 0x00400000u;
+}
+void RequestUnion::clear_has_raft_status() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+void RequestUnion::clear_raft_status() {
+  if (raft_status_ != NULL) raft_status_->::cockroach::roachpb::RaftStatusRequest::Clear();
+  clear_has_raft_status();
+}
+const ::cockroach::roachpb::RaftStatusRequest& RequestUnion::raft_status() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.raft_status)
+  return raft_status_ != NULL ? *raft_status_ : *default_instance_->raft_status_;
+}
+::cockroach::roachpb::RaftStatusRequest* RequestUnion::mutable_raft_status() {
+  set_has_raft_status();
+  if (raft_status_ == NULL) {
+    raft_status_ = new ::cockroach::roachpb::RaftStatusRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.raft_status)
+  return raft_status_;
+}
+::cockroach::roachpb::RaftStatusRequest* RequestUnion::release_raft_status() {
+  clear_has_raft_status();
+  ::cockroach::roachpb::RaftStatusRequest* temp = raft_status_;
+  raft_status_ = NULL;
+  return temp;
+}
+void RequestUnion::set_allocated_raft_status(::cockroach::roachpb::RaftStatusRequest* raft_status) {
+  delete raft_status_;
+  raft_status_ = raft_status;
+  if (raft_status) {
+    set_has_raft_status();
+  } else {
+    clear_has_raft_status();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.raft_status)
+}
+
+// optional .cockroach.roachpb.RangeStatsRequest range_stats = 24;
+bool RequestUnion::has_range_stats() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+void RequestUnion::set_has_range_stats() {
+  _has_bits_[0] |= 0x00800000u;
+}
+void RequestUnion::clear_has_range_stats() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+void RequestUnion::clear_range_stats() {
+  if (range_stats_ != NULL) range_stats_->::cockroach::roachpb::RangeStatsRequest::Clear();
+  clear_has_range_stats();
+}
+const ::cockroach::roachpb::RangeStatsRequest& RequestUnion::range_stats() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.range_stats)
+  return range_stats_ != NULL ? *range_stats_ : *default_instance_->range_stats_;
+}
+::cockroach::roachpb::RangeStatsRequest* RequestUnion::mutable_range_stats() {
+  set_has_range_stats();
+  if (range_stats_ == NULL) {
+    range_stats_ = new ::cockroach::roachpb::RangeStatsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.range_stats)
+  return range_stats_;
+}
+::cockroach::roachpb::RangeStatsRequest* RequestUnion::release_range_stats() {
+  clear_has_range_stats();
+  ::cockroach::roachpb::RangeStatsRequest* temp = range_stats_;
+  range_stats_ = NULL;
+  return temp;
+}
+void RequestUnion::set_allocated_range_stats(::cockroach::roachpb::RangeStatsRequest* range_stats) {
+  delete range_stats_;
+  range_stats_ = range_stats;
+  if (range_stats) {
+    set_has_range_stats();
+  } else {
+    clear_has_range_stats();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.range_stats)
+}
+
+// optional .cockroach.roachpb.AdminScatterRequest admin_scatter = 25;
+bool RequestUnion::has_admin_scatter() const {
+  return (_has_bits_[0] & 0x01000000u) != 0;
+}
+void RequestUnion::set_has_admin_scatter() {
+  _has_bits_[0] |= 0x01000000u;
+}
+void RequestUnion::clear_has_admin_scatter() {
+  _has_bits_[0] &= ~0x01000000u;
+}
+void RequestUnion::clear_admin_scatter() {
+  if (admin_scatter_ != NULL) admin_scatter_->::cockroach::roachpb::AdminScatterRequest::Clear();
+  clear_has_admin_scatter();
+}
+const ::cockroach::roachpb::AdminScatterRequest& RequestUnion::admin_scatter() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.admin_scatter)
+  return admin_scatter_ != NULL ? *admin_scatter_ : *default_instance_->admin_scatter_;
+}
+::cockroach::roachpb::AdminScatterRequest* RequestUnion::mutable_admin_scatter() {
+  set_has_admin_scatter();
+  if (admin_scatter_ == NULL) {
+    admin_scatter_ = new ::cockroach::roachpb::AdminScatterRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.admin_scatter)
+  return admin_scatter_;
+}
+::cockroach::roachpb::AdminScatterRequest* RequestUnion::release_admin_scatter() {
+  clear_has_admin_scatter();
+  ::cockroach::roachpb::AdminScatterRequest* temp = admin_scatter_;
+  admin_scatter_ = NULL;
+  return temp;
+}
+void RequestUnion::set_allocated_admin_scatter(::cockroach::roachpb::AdminScatterRequest* admin_scatter) {
+  delete admin_scatter_;
+  admin_scatter_ = admin_scatter;
+  if (admin_scatter) {
+    set_has_admin_scatter();
+  } else {
+    clear_has_admin_scatter();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.admin_scatter)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -20002,6 +23834,9 @@ const int ResponseUnion::kTruncateLogFieldNumber;
 const int ResponseUnion::kLeaderLeaseFieldNumber;
 const int ResponseUnion::kReverseScanFieldNumber;
 const int ResponseUnion::kNoopFieldNumber;
+const int ResponseUnion::kRaftStatusFieldNumber;
+const int ResponseUnion::kRangeStatsFieldNumber;
+const int ResponseUnion::kAdminScatterFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
 ResponseUnion::ResponseUnion()
@@ -20033,6 +23868,9 @@ void ResponseUnion::InitAsDefaultInstance() {
   leader_lease_ = const_cast< ::cockroach::roachpb::LeaderLeaseResponse*>(&::cockroach::roachpb::LeaderLeaseResponse::default_instance());
   reverse_scan_ = const_cast< ::cockroach::roachpb::ReverseScanResponse*>(&::cockroach::roachpb::ReverseScanResponse::default_instance());
   noop_ = const_cast< ::cockroach::roachpb::NoopResponse*>(&::cockroach::roachpb::NoopResponse::default_instance());
+  raft_status_ = const_cast< ::cockroach::roachpb::RaftStatusResponse*>(&::cockroach::roachpb::RaftStatusResponse::default_instance());
+  range_stats_ = const_cast< ::cockroach::roachpb::RangeStatsResponse*>(&::cockroach::roachpb::RangeStatsResponse::default_instance());
+  admin_scatter_ = const_cast< ::cockroach::roachpb::AdminScatterResponse*>(&::cockroach::roachpb::AdminScatterResponse::default_instance());
 }
 
 ResponseUnion::ResponseUnion(const ResponseUnion& from)
@@ -20067,6 +23905,9 @@ void ResponseUnion::SharedCtor() {
   leader_lease_ = NULL;
   reverse_scan_ = NULL;
   noop_ = NULL;
+  raft_status_ = NULL;
+  range_stats_ = NULL;
+  admin_scatter_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -20099,6 +23940,9 @@ void ResponseUnion::SharedDtor() {
     delete leader_lease_;
     delete reverse_scan_;
     delete noop_;
+    delete raft_status_;
+    delete range_stats_;
+    delete admin_scatter_;
   }
 }
 
@@ -20180,7 +24024,7 @@ void ResponseUnion::Clear() {
       if (resolve_intent_ != NULL) resolve_intent_->::cockroach::roachpb::ResolveIntentResponse::Clear();
     }
   }
-  if (_has_bits_[16 / 32] & 4128768u) {
+  if (_has_bits_[16 / 32] & 16711680u) {
     if (has_resolve_intent_range()) {
       if (resolve_intent_range_ != NULL) resolve_intent_range_->::cockroach::roachpb::ResolveIntentRangeResponse::Clear();
     }
@@ -20199,6 +24043,15 @@ void ResponseUnion::Clear() {
     if (has_noop()) {
       if (noop_ != NULL) noop_->::cockroach::roachpb::NoopResponse::Clear();
     }
+    if (has_raft_status()) {
+      if (raft_status_ != NULL) raft_status_->::cockroach::roachpb::RaftStatusResponse::Clear();
+    }
+    if (has_range_stats()) {
+      if (range_stats_ != NULL) range_stats_->::cockroach::roachpb::RangeStatsResponse::Clear();
+    }
+  }
+  if (has_admin_scatter()) {
+    if (admin_scatter_ != NULL) admin_scatter_->::cockroach::roachpb::AdminScatterResponse::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -20497,6 +24350,45 @@ bool ResponseUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(186)) goto parse_raft_status;
+        break;
+      }
+
+      // optional .cockroach.roachpb.RaftStatusResponse raft_status = 23;
+      case 23: {
+        if (tag == 186) {
+         parse_raft_status:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_raft_status()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(194)) goto parse_range_stats;
+        break;
+      }
+
+      // optional .cockroach.roachpb.RangeStatsResponse range_stats = 24;
+      case 24: {
+        if (tag == 194) {
+         parse_range_stats:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_range_stats()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(202)) goto parse_admin_scatter;
+        break;
+      }
+
+      // optional .cockroach.roachpb.AdminScatterResponse admin_scatter = 25;
+      case 25: {
+        if (tag == 202) {
+         parse_admin_scatter:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_admin_scatter()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -20658,6 +24550,24 @@ void ResponseUnion::SerializeWithCachedSizes(
       22, *this->noop_, output);
   }
 
+  // optional .cockroach.roachpb.RaftStatusResponse raft_status = 23;
+  if (has_raft_status()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      23, *this->raft_status_, output);
+  }
+
+  // optional .cockroach.roachpb.RangeStatsResponse range_stats = 24;
+  if (has_range_stats()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      24, *this->range_stats_, output);
+  }
+
+  // optional .cockroach.roachpb.AdminScatterResponse admin_scatter = 25;
+  if (has_admin_scatter()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      25, *this->admin_scatter_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -20822,6 +24732,27 @@ void ResponseUnion::SerializeWithCachedSizes(
         22, *this->noop_, target);
   }
 
+  // optional .cockroach.roachpb.RaftStatusResponse raft_status = 23;
+  if (has_raft_status()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        23, *this->raft_status_, target);
+  }
+
+  // optional .cockroach.roachpb.RangeStatsResponse range_stats = 24;
+  if (has_range_stats()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        24, *this->range_stats_, target);
+  }
+
+  // optional .cockroach.roachpb.AdminScatterResponse admin_scatter = 25;
+  if (has_admin_scatter()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        25, *this->admin_scatter_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -20949,7 +24880,7 @@ int ResponseUnion::ByteSize() const {
     }
 
   }
-  if (_has_bits_[16 / 32] & 4128768u) {
+  if (_has_bits_[16 / 32] & 16711680u) {
     // optional .cockroach.roachpb.ResolveIntentRangeResponse resolve_intent_range = 17;
     if (has_resolve_intent_range()) {
       total_size += 2 +
@@ -20992,7 +24923,28 @@ int ResponseUnion::ByteSize() const {
           *this->noop_);
     }
 
+    // optional .cockroach.roachpb.RaftStatusResponse raft_status = 23;
+    if (has_raft_status()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->raft_status_);
+    }
+
+    // optional .cockroach.roachpb.RangeStatsResponse range_stats = 24;
+    if (has_range_stats()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->range_stats_);
+    }
+
   }
+  // optional .cockroach.roachpb.AdminScatterResponse admin_scatter = 25;
+  if (has_admin_scatter()) {
+    total_size += 2 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->admin_scatter_);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -21089,6 +25041,17 @@ void ResponseUnion::MergeFrom(const ResponseUnion& from) {
     if (from.has_noop()) {
       mutable_noop()->::cockroach::roachpb::NoopResponse::MergeFrom(from.noop());
     }
+    if (from.has_raft_status()) {
+      mutable_raft_status()->::cockroach::roachpb::RaftStatusResponse::MergeFrom(from.raft_status());
+    }
+    if (from.has_range_stats()) {
+      mutable_range_stats()->::cockroach::roachpb::RangeStatsResponse::MergeFrom(from.range_stats());
+    }
+  }
+  if (from._has_bits_[24 / 32] & (0xffu << (24 % 32))) {
+    if (from.has_admin_scatter()) {
+      mutable_admin_scatter()->::cockroach::roachpb::AdminScatterResponse::MergeFrom(from.admin_scatter());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -21139,6 +25102,9 @@ void ResponseUnion::InternalSwap(ResponseUnion* other) {
   std::swap(leader_lease_, other->leader_lease_);
   std::swap(reverse_scan_, other->reverse_scan_);
   std::swap(noop_, other->noop_);
+  std::swap(raft_status_, other->raft_status_);
+  std::swap(range_stats_, other->range_stats_);
+  std::swap(admin_scatter_, other->admin_scatter_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -22101,6 +26067,135 @@ void ResponseUnion::set_allocated_noop(::cockroach::roachpb::NoopResponse* noop)
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.noop)
 }
 
+// optional .cockroach.roachpb.RaftStatusResponse raft_status = 23;
+bool ResponseUnion::has_raft_status() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+void ResponseUnion::set_has_raft_status() {
+  _has_bits_[0] |= 0x00400000u;
+}
+void ResponseUnion::clear_has_raft_status() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+void ResponseUnion::clear_raft_status() {
+  if (raft_status_ != NULL) raft_status_->::cockroach::roachpb::RaftStatusResponse::Clear();
+  clear_has_raft_status();
+}
+const ::cockroach::roachpb::RaftStatusResponse& ResponseUnion::raft_status() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.raft_status)
+  return raft_status_ != NULL ? *raft_status_ : *default_instance_->raft_status_;
+}
+::cockroach::roachpb::RaftStatusResponse* ResponseUnion::mutable_raft_status() {
+  set_has_raft_status();
+  if (raft_status_ == NULL) {
+    raft_status_ = new ::cockroach::roachpb::RaftStatusResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.raft_status)
+  return raft_status_;
+}
+::cockroach::roachpb::RaftStatusResponse* ResponseUnion::release_raft_status() {
+  clear_has_raft_status();
+  ::cockroach::roachpb::RaftStatusResponse* temp = raft_status_;
+  raft_status_ = NULL;
+  return temp;
+}
+void ResponseUnion::set_allocated_raft_status(::cockroach::roachpb::RaftStatusResponse* raft_status) {
+  delete raft_status_;
+  raft_status_ = raft_status;
+  if (raft_status) {
+    set_has_raft_status();
+  } else {
+    clear_has_raft_status();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.raft_status)
+}
+
+// optional .cockroach.roachpb.RangeStatsResponse range_stats = 24;
+bool ResponseUnion::has_range_stats() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+void ResponseUnion::set_has_range_stats() {
+  _has_bits_[0] |= 0x00800000u;
+}
+void ResponseUnion::clear_has_range_stats() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+void ResponseUnion::clear_range_stats() {
+  if (range_stats_ != NULL) range_stats_->::cockroach::roachpb::RangeStatsResponse::Clear();
+  clear_has_range_stats();
+}
+const ::cockroach::roachpb::RangeStatsResponse& ResponseUnion::range_stats() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.range_stats)
+  return range_stats_ != NULL ? *range_stats_ : *default_instance_->range_stats_;
+}
+::cockroach::roachpb::RangeStatsResponse* ResponseUnion::mutable_range_stats() {
+  set_has_range_stats();
+  if (range_stats_ == NULL) {
+    range_stats_ = new ::cockroach::roachpb::RangeStatsResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.range_stats)
+  return range_stats_;
+}
+::cockroach::roachpb::RangeStatsResponse* ResponseUnion::release_range_stats() {
+  clear_has_range_stats();
+  ::cockroach::roachpb::RangeStatsResponse* temp = range_stats_;
+  range_stats_ = NULL;
+  return temp;
+}
+void ResponseUnion::set_allocated_range_stats(::cockroach::roachpb::RangeStatsResponse* range_stats) {
+  delete range_stats_;
+  range_stats_ = range_stats;
+  if (range_stats) {
+    set_has_range_stats();
+  } else {
+    clear_has_range_stats();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.range_stats)
+}
+
+// optional .cockroach.roachpb.AdminScatterResponse admin_scatter = 25;
+bool ResponseUnion::has_admin_scatter() const {
+  return (_has_bits_[0] & 0x01000000u) != 0;
+}
+void ResponseUnion::set_has_admin_scatter() {
+  _has_bits_[0] |= 0x01000000u;
+}
+void ResponseUnion::clear_has_admin_scatter() {
+  _has_bits_[0] &= ~0x01000000u;
+}
+void ResponseUnion::clear_admin_scatter() {
+  if (admin_scatter_ != NULL) admin_scatter_->::cockroach::roachpb::AdminScatterResponse::Clear();
+  clear_has_admin_scatter();
+}
+const ::cockroach::roachpb::AdminScatterResponse& ResponseUnion::admin_scatter() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.admin_scatter)
+  return admin_scatter_ != NULL ? *admin_scatter_ : *default_instance_->admin_scatter_;
+}
+::cockroach::roachpb::AdminScatterResponse* ResponseUnion::mutable_admin_scatter() {
+  set_has_admin_scatter();
+  if (admin_scatter_ == NULL) {
+    admin_scatter_ = new ::cockroach::roachpb::AdminScatterResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.admin_scatter)
+  return admin_scatter_;
+}
+::cockroach::roachpb::AdminScatterResponse* ResponseUnion::release_admin_scatter() {
+  clear_has_admin_scatter();
+  ::cockroach::roachpb::AdminScatterResponse* temp = admin_scatter_;
+  admin_scatter_ = NULL;
+  return temp;
+}
+void ResponseUnion::set_allocated_admin_scatter(::cockroach::roachpb::AdminScatterResponse* admin_scatter) {
+  delete admin_scatter_;
+  admin_scatter_ = admin_scatter;
+  if (admin_scatter) {
+    set_has_admin_scatter();
+  } else {
+    clear_has_admin_scatter();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.admin_scatter)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -22112,6 +26207,7 @@ const int Header::kRangeIdFieldNumber;
 const int Header::kUserPriorityFieldNumber;
 const int Header::kTxnFieldNumber;
 const int Header::kReadConsistencyFieldNumber;
+const int Header::kTraceContextFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
 Header::Header()
@@ -22142,6 +26238,7 @@ void Header::SharedCtor() {
   user_priority_ = 0;
   txn_ = NULL;
   read_consistency_ = 0;
+  trace_context_ = GOOGLE_ULONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -22192,8 +26289,9 @@ void Header::Clear() {
            ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
 } while (0)
 
-  if (_has_bits_[0 / 32] & 63u) {
+  if (_has_bits_[0 / 32] & 127u) {
     ZR_(range_id_, user_priority_);
+    ZR_(trace_context_, read_consistency_);
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
     }
@@ -22203,7 +26301,6 @@ void Header::Clear() {
     if (has_txn()) {
       if (txn_ != NULL) txn_->::cockroach::roachpb::Transaction::Clear();
     }
-    read_consistency_ = 0;
   }
 
 #undef ZR_HELPER_
@@ -22309,6 +26406,21 @@ bool Header::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(56)) goto parse_trace_context;
+        break;
+      }
+
+      // optional uint64 trace_context = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_trace_context:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &trace_context_)));
+          set_has_trace_context();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -22372,6 +26484,11 @@ void Header::SerializeWithCachedSizes(
       6, this->read_consistency(), output);
   }
 
+  // optional uint64 trace_context = 7;
+  if (has_trace_context()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(7, this->trace_context(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -22419,6 +26536,11 @@ void Header::SerializeWithCachedSizes(
       6, this->read_consistency(), target);
   }
 
+  // optional uint64 trace_context = 7;
+  if (has_trace_context()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(7, this->trace_context(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -22430,7 +26552,7 @@ void Header::SerializeWithCachedSizes(
 int Header::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 63u) {
+  if (_has_bits_[0 / 32] & 127u) {
     // optional .cockroach.roachpb.Timestamp timestamp = 1;
     if (has_timestamp()) {
       total_size += 1 +
@@ -22470,6 +26592,13 @@ int Header::ByteSize() const {
         ::google::protobuf::internal::WireFormatLite::EnumSize(this->read_consistency());
     }
 
+    // optional uint64 trace_context = 7;
+    if (has_trace_context()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->trace_context());
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -22515,6 +26644,9 @@ void Header::MergeFrom(const Header& from) {
     if (from.has_read_consistency()) {
       set_read_consistency(from.read_consistency());
     }
+    if (from.has_trace_context()) {
+      set_trace_context(from.trace_context());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -22549,6 +26681,7 @@ void Header::InternalSwap(Header* other) {
   std::swap(user_priority_, other->user_priority_);
   std::swap(txn_, other->txn_);
   std::swap(read_consistency_, other->read_consistency_);
+  std::swap(trace_context_, other->trace_context_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -22767,6 +26900,30 @@ void Header::clear_read_consistency() {
   // @@protoc_insertion_point(field_set:cockroach.roachpb.Header.read_consistency)
 }
 
+// optional uint64 trace_context = 7;
+bool Header::has_trace_context() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+void Header::set_has_trace_context() {
+  _has_bits_[0] |= 0x00000040u;
+}
+void Header::clear_has_trace_context() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+void Header::clear_trace_context() {
+  trace_context_ = GOOGLE_ULONGLONG(0);
+  clear_has_trace_context();
+}
+ ::google::protobuf::uint64 Header::trace_context() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.Header.trace_context)
+  return trace_context_;
+}
+ void Header::set_trace_context(::google::protobuf::uint64 value) {
+  set_has_trace_context();
+  trace_context_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.Header.trace_context)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
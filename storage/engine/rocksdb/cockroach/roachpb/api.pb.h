@@ -44,6 +44,8 @@ void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
 
 class AdminMergeRequest;
 class AdminMergeResponse;
+class AdminScatterRequest;
+class AdminScatterResponse;
 class AdminSplitRequest;
 class AdminSplitResponse;
 class BatchRequest;
@@ -79,8 +81,13 @@ class PushTxnRequest;
 class PushTxnResponse;
 class PutRequest;
 class PutResponse;
+class RaftProgress;
+class RaftStatusRequest;
+class RaftStatusResponse;
 class RangeLookupRequest;
 class RangeLookupResponse;
+class RangeStatsRequest;
+class RangeStatsResponse;
 class RequestUnion;
 class ResolveIntentRangeRequest;
 class ResolveIntentRangeResponse;
@@ -2647,6 +2654,200 @@ class AdminMergeResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class AdminScatterRequest : public ::google::protobuf::Message {
+ public:
+  AdminScatterRequest();
+  virtual ~AdminScatterRequest();
+
+  AdminScatterRequest(const AdminScatterRequest& from);
+
+  inline AdminScatterRequest& operator=(const AdminScatterRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminScatterRequest& default_instance();
+
+  void Swap(AdminScatterRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminScatterRequest* New() const { return New(NULL); }
+
+  AdminScatterRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminScatterRequest& from);
+  void MergeFrom(const AdminScatterRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminScatterRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.Span header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::Span& header() const;
+  ::cockroach::roachpb::Span* mutable_header();
+  ::cockroach::roachpb::Span* release_header();
+  void set_allocated_header(::cockroach::roachpb::Span* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.AdminScatterRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::Span* header_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminScatterRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminScatterResponse : public ::google::protobuf::Message {
+ public:
+  AdminScatterResponse();
+  virtual ~AdminScatterResponse();
+
+  AdminScatterResponse(const AdminScatterResponse& from);
+
+  inline AdminScatterResponse& operator=(const AdminScatterResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminScatterResponse& default_instance();
+
+  void Swap(AdminScatterResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminScatterResponse* New() const { return New(NULL); }
+
+  AdminScatterResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminScatterResponse& from);
+  void MergeFrom(const AdminScatterResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminScatterResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::ResponseHeader& header() const;
+  ::cockroach::roachpb::ResponseHeader* mutable_header();
+  ::cockroach::roachpb::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::roachpb::ResponseHeader* header);
+
+  // optional .cockroach.roachpb.RangeDescriptor desc = 2;
+  bool has_desc() const;
+  void clear_desc();
+  static const int kDescFieldNumber = 2;
+  const ::cockroach::roachpb::RangeDescriptor& desc() const;
+  ::cockroach::roachpb::RangeDescriptor* mutable_desc();
+  ::cockroach::roachpb::RangeDescriptor* release_desc();
+  void set_allocated_desc(::cockroach::roachpb::RangeDescriptor* desc);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.AdminScatterResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_desc();
+  inline void clear_has_desc();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::ResponseHeader* header_;
+  ::cockroach::roachpb::RangeDescriptor* desc_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminScatterResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class RangeLookupRequest : public ::google::protobuf::Message {
  public:
   RangeLookupRequest();
@@ -4789,14 +4990,14 @@ class LeaderLeaseResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class RequestUnion : public ::google::protobuf::Message {
+class RaftStatusRequest : public ::google::protobuf::Message {
  public:
-  RequestUnion();
-  virtual ~RequestUnion();
+  RaftStatusRequest();
+  virtual ~RaftStatusRequest();
 
-  RequestUnion(const RequestUnion& from);
+  RaftStatusRequest(const RaftStatusRequest& from);
 
-  inline RequestUnion& operator=(const RequestUnion& from) {
+  inline RaftStatusRequest& operator=(const RaftStatusRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -4810,19 +5011,19 @@ class RequestUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RequestUnion& default_instance();
+  static const RaftStatusRequest& default_instance();
 
-  void Swap(RequestUnion* other);
+  void Swap(RaftStatusRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline RequestUnion* New() const { return New(NULL); }
+  inline RaftStatusRequest* New() const { return New(NULL); }
 
-  RequestUnion* New(::google::protobuf::Arena* arena) const;
+  RaftStatusRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RequestUnion& from);
-  void MergeFrom(const RequestUnion& from);
+  void CopyFrom(const RaftStatusRequest& from);
+  void MergeFrom(const RaftStatusRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -4837,7 +5038,7 @@ class RequestUnion : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(RequestUnion* other);
+  void InternalSwap(RaftStatusRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -4853,293 +5054,165 @@ class RequestUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.roachpb.GetRequest get = 1;
-  bool has_get() const;
-  void clear_get();
-  static const int kGetFieldNumber = 1;
-  const ::cockroach::roachpb::GetRequest& get() const;
-  ::cockroach::roachpb::GetRequest* mutable_get();
-  ::cockroach::roachpb::GetRequest* release_get();
-  void set_allocated_get(::cockroach::roachpb::GetRequest* get);
+  // optional .cockroach.roachpb.Span header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::Span& header() const;
+  ::cockroach::roachpb::Span* mutable_header();
+  ::cockroach::roachpb::Span* release_header();
+  void set_allocated_header(::cockroach::roachpb::Span* header);
 
-  // optional .cockroach.roachpb.PutRequest put = 2;
-  bool has_put() const;
-  void clear_put();
-  static const int kPutFieldNumber = 2;
-  const ::cockroach::roachpb::PutRequest& put() const;
-  ::cockroach::roachpb::PutRequest* mutable_put();
-  ::cockroach::roachpb::PutRequest* release_put();
-  void set_allocated_put(::cockroach::roachpb::PutRequest* put);
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.RaftStatusRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
 
-  // optional .cockroach.roachpb.ConditionalPutRequest conditional_put = 3;
-  bool has_conditional_put() const;
-  void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 3;
-  const ::cockroach::roachpb::ConditionalPutRequest& conditional_put() const;
-  ::cockroach::roachpb::ConditionalPutRequest* mutable_conditional_put();
-  ::cockroach::roachpb::ConditionalPutRequest* release_conditional_put();
-  void set_allocated_conditional_put(::cockroach::roachpb::ConditionalPutRequest* conditional_put);
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::Span* header_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
 
-  // optional .cockroach.roachpb.IncrementRequest increment = 4;
-  bool has_increment() const;
-  void clear_increment();
-  static const int kIncrementFieldNumber = 4;
-  const ::cockroach::roachpb::IncrementRequest& increment() const;
-  ::cockroach::roachpb::IncrementRequest* mutable_increment();
-  ::cockroach::roachpb::IncrementRequest* release_increment();
-  void set_allocated_increment(::cockroach::roachpb::IncrementRequest* increment);
+  void InitAsDefaultInstance();
+  static RaftStatusRequest* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional .cockroach.roachpb.DeleteRequest delete = 5;
-  bool has_delete_() const;
-  void clear_delete_();
-  static const int kDeleteFieldNumber = 5;
-  const ::cockroach::roachpb::DeleteRequest& delete_() const;
-  ::cockroach::roachpb::DeleteRequest* mutable_delete_();
-  ::cockroach::roachpb::DeleteRequest* release_delete_();
-  void set_allocated_delete_(::cockroach::roachpb::DeleteRequest* delete_);
+class RaftProgress : public ::google::protobuf::Message {
+ public:
+  RaftProgress();
+  virtual ~RaftProgress();
 
-  // optional .cockroach.roachpb.DeleteRangeRequest delete_range = 6;
-  bool has_delete_range() const;
-  void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 6;
-  const ::cockroach::roachpb::DeleteRangeRequest& delete_range() const;
-  ::cockroach::roachpb::DeleteRangeRequest* mutable_delete_range();
-  ::cockroach::roachpb::DeleteRangeRequest* release_delete_range();
-  void set_allocated_delete_range(::cockroach::roachpb::DeleteRangeRequest* delete_range);
+  RaftProgress(const RaftProgress& from);
 
-  // optional .cockroach.roachpb.ScanRequest scan = 7;
-  bool has_scan() const;
-  void clear_scan();
-  static const int kScanFieldNumber = 7;
-  const ::cockroach::roachpb::ScanRequest& scan() const;
-  ::cockroach::roachpb::ScanRequest* mutable_scan();
-  ::cockroach::roachpb::ScanRequest* release_scan();
-  void set_allocated_scan(::cockroach::roachpb::ScanRequest* scan);
+  inline RaftProgress& operator=(const RaftProgress& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional .cockroach.roachpb.BeginTransactionRequest begin_transaction = 8;
-  bool has_begin_transaction() const;
-  void clear_begin_transaction();
-  static const int kBeginTransactionFieldNumber = 8;
-  const ::cockroach::roachpb::BeginTransactionRequest& begin_transaction() const;
-  ::cockroach::roachpb::BeginTransactionRequest* mutable_begin_transaction();
-  ::cockroach::roachpb::BeginTransactionRequest* release_begin_transaction();
-  void set_allocated_begin_transaction(::cockroach::roachpb::BeginTransactionRequest* begin_transaction);
-
-  // optional .cockroach.roachpb.EndTransactionRequest end_transaction = 9;
-  bool has_end_transaction() const;
-  void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  const ::cockroach::roachpb::EndTransactionRequest& end_transaction() const;
-  ::cockroach::roachpb::EndTransactionRequest* mutable_end_transaction();
-  ::cockroach::roachpb::EndTransactionRequest* release_end_transaction();
-  void set_allocated_end_transaction(::cockroach::roachpb::EndTransactionRequest* end_transaction);
-
-  // optional .cockroach.roachpb.AdminSplitRequest admin_split = 10;
-  bool has_admin_split() const;
-  void clear_admin_split();
-  static const int kAdminSplitFieldNumber = 10;
-  const ::cockroach::roachpb::AdminSplitRequest& admin_split() const;
-  ::cockroach::roachpb::AdminSplitRequest* mutable_admin_split();
-  ::cockroach::roachpb::AdminSplitRequest* release_admin_split();
-  void set_allocated_admin_split(::cockroach::roachpb::AdminSplitRequest* admin_split);
-
-  // optional .cockroach.roachpb.AdminMergeRequest admin_merge = 11;
-  bool has_admin_merge() const;
-  void clear_admin_merge();
-  static const int kAdminMergeFieldNumber = 11;
-  const ::cockroach::roachpb::AdminMergeRequest& admin_merge() const;
-  ::cockroach::roachpb::AdminMergeRequest* mutable_admin_merge();
-  ::cockroach::roachpb::AdminMergeRequest* release_admin_merge();
-  void set_allocated_admin_merge(::cockroach::roachpb::AdminMergeRequest* admin_merge);
-
-  // optional .cockroach.roachpb.HeartbeatTxnRequest heartbeat_txn = 12;
-  bool has_heartbeat_txn() const;
-  void clear_heartbeat_txn();
-  static const int kHeartbeatTxnFieldNumber = 12;
-  const ::cockroach::roachpb::HeartbeatTxnRequest& heartbeat_txn() const;
-  ::cockroach::roachpb::HeartbeatTxnRequest* mutable_heartbeat_txn();
-  ::cockroach::roachpb::HeartbeatTxnRequest* release_heartbeat_txn();
-  void set_allocated_heartbeat_txn(::cockroach::roachpb::HeartbeatTxnRequest* heartbeat_txn);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
 
-  // optional .cockroach.roachpb.GCRequest gc = 13;
-  bool has_gc() const;
-  void clear_gc();
-  static const int kGcFieldNumber = 13;
-  const ::cockroach::roachpb::GCRequest& gc() const;
-  ::cockroach::roachpb::GCRequest* mutable_gc();
-  ::cockroach::roachpb::GCRequest* release_gc();
-  void set_allocated_gc(::cockroach::roachpb::GCRequest* gc);
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
 
-  // optional .cockroach.roachpb.PushTxnRequest push_txn = 14;
-  bool has_push_txn() const;
-  void clear_push_txn();
-  static const int kPushTxnFieldNumber = 14;
-  const ::cockroach::roachpb::PushTxnRequest& push_txn() const;
-  ::cockroach::roachpb::PushTxnRequest* mutable_push_txn();
-  ::cockroach::roachpb::PushTxnRequest* release_push_txn();
-  void set_allocated_push_txn(::cockroach::roachpb::PushTxnRequest* push_txn);
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RaftProgress& default_instance();
 
-  // optional .cockroach.roachpb.RangeLookupRequest range_lookup = 15;
-  bool has_range_lookup() const;
-  void clear_range_lookup();
-  static const int kRangeLookupFieldNumber = 15;
-  const ::cockroach::roachpb::RangeLookupRequest& range_lookup() const;
-  ::cockroach::roachpb::RangeLookupRequest* mutable_range_lookup();
-  ::cockroach::roachpb::RangeLookupRequest* release_range_lookup();
-  void set_allocated_range_lookup(::cockroach::roachpb::RangeLookupRequest* range_lookup);
+  void Swap(RaftProgress* other);
 
-  // optional .cockroach.roachpb.ResolveIntentRequest resolve_intent = 16;
-  bool has_resolve_intent() const;
-  void clear_resolve_intent();
-  static const int kResolveIntentFieldNumber = 16;
-  const ::cockroach::roachpb::ResolveIntentRequest& resolve_intent() const;
-  ::cockroach::roachpb::ResolveIntentRequest* mutable_resolve_intent();
-  ::cockroach::roachpb::ResolveIntentRequest* release_resolve_intent();
-  void set_allocated_resolve_intent(::cockroach::roachpb::ResolveIntentRequest* resolve_intent);
+  // implements Message ----------------------------------------------
 
-  // optional .cockroach.roachpb.ResolveIntentRangeRequest resolve_intent_range = 17;
-  bool has_resolve_intent_range() const;
-  void clear_resolve_intent_range();
-  static const int kResolveIntentRangeFieldNumber = 17;
-  const ::cockroach::roachpb::ResolveIntentRangeRequest& resolve_intent_range() const;
-  ::cockroach::roachpb::ResolveIntentRangeRequest* mutable_resolve_intent_range();
-  ::cockroach::roachpb::ResolveIntentRangeRequest* release_resolve_intent_range();
-  void set_allocated_resolve_intent_range(::cockroach::roachpb::ResolveIntentRangeRequest* resolve_intent_range);
+  inline RaftProgress* New() const { return New(NULL); }
 
-  // optional .cockroach.roachpb.MergeRequest merge = 18;
-  bool has_merge() const;
-  void clear_merge();
-  static const int kMergeFieldNumber = 18;
-  const ::cockroach::roachpb::MergeRequest& merge() const;
-  ::cockroach::roachpb::MergeRequest* mutable_merge();
-  ::cockroach::roachpb::MergeRequest* release_merge();
-  void set_allocated_merge(::cockroach::roachpb::MergeRequest* merge);
+  RaftProgress* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RaftProgress& from);
+  void MergeFrom(const RaftProgress& from);
+  void Clear();
+  bool IsInitialized() const;
 
-  // optional .cockroach.roachpb.TruncateLogRequest truncate_log = 19;
-  bool has_truncate_log() const;
-  void clear_truncate_log();
-  static const int kTruncateLogFieldNumber = 19;
-  const ::cockroach::roachpb::TruncateLogRequest& truncate_log() const;
-  ::cockroach::roachpb::TruncateLogRequest* mutable_truncate_log();
-  ::cockroach::roachpb::TruncateLogRequest* release_truncate_log();
-  void set_allocated_truncate_log(::cockroach::roachpb::TruncateLogRequest* truncate_log);
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(RaftProgress* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
 
-  // optional .cockroach.roachpb.LeaderLeaseRequest leader_lease = 20;
-  bool has_leader_lease() const;
-  void clear_leader_lease();
-  static const int kLeaderLeaseFieldNumber = 20;
-  const ::cockroach::roachpb::LeaderLeaseRequest& leader_lease() const;
-  ::cockroach::roachpb::LeaderLeaseRequest* mutable_leader_lease();
-  ::cockroach::roachpb::LeaderLeaseRequest* release_leader_lease();
-  void set_allocated_leader_lease(::cockroach::roachpb::LeaderLeaseRequest* leader_lease);
+  ::google::protobuf::Metadata GetMetadata() const;
 
-  // optional .cockroach.roachpb.ReverseScanRequest reverse_scan = 21;
-  bool has_reverse_scan() const;
-  void clear_reverse_scan();
-  static const int kReverseScanFieldNumber = 21;
-  const ::cockroach::roachpb::ReverseScanRequest& reverse_scan() const;
-  ::cockroach::roachpb::ReverseScanRequest* mutable_reverse_scan();
-  ::cockroach::roachpb::ReverseScanRequest* release_reverse_scan();
-  void set_allocated_reverse_scan(::cockroach::roachpb::ReverseScanRequest* reverse_scan);
+  // nested types ----------------------------------------------------
 
-  // optional .cockroach.roachpb.NoopRequest noop = 22;
-  bool has_noop() const;
-  void clear_noop();
-  static const int kNoopFieldNumber = 22;
-  const ::cockroach::roachpb::NoopRequest& noop() const;
-  ::cockroach::roachpb::NoopRequest* mutable_noop();
-  ::cockroach::roachpb::NoopRequest* release_noop();
-  void set_allocated_noop(::cockroach::roachpb::NoopRequest* noop);
+  // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:cockroach.roachpb.RequestUnion)
+  // optional int32 replica_id = 1;
+  bool has_replica_id() const;
+  void clear_replica_id();
+  static const int kReplicaIdFieldNumber = 1;
+  ::google::protobuf::int32 replica_id() const;
+  void set_replica_id(::google::protobuf::int32 value);
+
+  // optional uint64 match = 2;
+  bool has_match() const;
+  void clear_match();
+  static const int kMatchFieldNumber = 2;
+  ::google::protobuf::uint64 match() const;
+  void set_match(::google::protobuf::uint64 value);
+
+  // optional uint64 next = 3;
+  bool has_next() const;
+  void clear_next();
+  static const int kNextFieldNumber = 3;
+  ::google::protobuf::uint64 next() const;
+  void set_next(::google::protobuf::uint64 value);
+
+  // optional string state = 4;
+  bool has_state() const;
+  void clear_state();
+  static const int kStateFieldNumber = 4;
+  const ::std::string& state() const;
+  void set_state(const ::std::string& value);
+  void set_state(const char* value);
+  void set_state(const char* value, size_t size);
+  ::std::string* mutable_state();
+  ::std::string* release_state();
+  void set_allocated_state(::std::string* state);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.RaftProgress)
  private:
-  inline void set_has_get();
-  inline void clear_has_get();
-  inline void set_has_put();
-  inline void clear_has_put();
-  inline void set_has_conditional_put();
-  inline void clear_has_conditional_put();
-  inline void set_has_increment();
-  inline void clear_has_increment();
-  inline void set_has_delete_();
-  inline void clear_has_delete_();
-  inline void set_has_delete_range();
-  inline void clear_has_delete_range();
-  inline void set_has_scan();
-  inline void clear_has_scan();
-  inline void set_has_begin_transaction();
-  inline void clear_has_begin_transaction();
-  inline void set_has_end_transaction();
-  inline void clear_has_end_transaction();
-  inline void set_has_admin_split();
-  inline void clear_has_admin_split();
-  inline void set_has_admin_merge();
-  inline void clear_has_admin_merge();
-  inline void set_has_heartbeat_txn();
-  inline void clear_has_heartbeat_txn();
-  inline void set_has_gc();
-  inline void clear_has_gc();
-  inline void set_has_push_txn();
-  inline void clear_has_push_txn();
-  inline void set_has_range_lookup();
-  inline void clear_has_range_lookup();
-  inline void set_has_resolve_intent();
-  inline void clear_has_resolve_intent();
-  inline void set_has_resolve_intent_range();
-  inline void clear_has_resolve_intent_range();
-  inline void set_has_merge();
-  inline void clear_has_merge();
-  inline void set_has_truncate_log();
-  inline void clear_has_truncate_log();
-  inline void set_has_leader_lease();
-  inline void clear_has_leader_lease();
-  inline void set_has_reverse_scan();
-  inline void clear_has_reverse_scan();
-  inline void set_has_noop();
-  inline void clear_has_noop();
+  inline void set_has_replica_id();
+  inline void clear_has_replica_id();
+  inline void set_has_match();
+  inline void clear_has_match();
+  inline void set_has_next();
+  inline void clear_has_next();
+  inline void set_has_state();
+  inline void clear_has_state();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::roachpb::GetRequest* get_;
-  ::cockroach::roachpb::PutRequest* put_;
-  ::cockroach::roachpb::ConditionalPutRequest* conditional_put_;
-  ::cockroach::roachpb::IncrementRequest* increment_;
-  ::cockroach::roachpb::DeleteRequest* delete__;
-  ::cockroach::roachpb::DeleteRangeRequest* delete_range_;
-  ::cockroach::roachpb::ScanRequest* scan_;
-  ::cockroach::roachpb::BeginTransactionRequest* begin_transaction_;
-  ::cockroach::roachpb::EndTransactionRequest* end_transaction_;
-  ::cockroach::roachpb::AdminSplitRequest* admin_split_;
-  ::cockroach::roachpb::AdminMergeRequest* admin_merge_;
-  ::cockroach::roachpb::HeartbeatTxnRequest* heartbeat_txn_;
-  ::cockroach::roachpb::GCRequest* gc_;
-  ::cockroach::roachpb::PushTxnRequest* push_txn_;
-  ::cockroach::roachpb::RangeLookupRequest* range_lookup_;
-  ::cockroach::roachpb::ResolveIntentRequest* resolve_intent_;
-  ::cockroach::roachpb::ResolveIntentRangeRequest* resolve_intent_range_;
-  ::cockroach::roachpb::MergeRequest* merge_;
-  ::cockroach::roachpb::TruncateLogRequest* truncate_log_;
-  ::cockroach::roachpb::LeaderLeaseRequest* leader_lease_;
-  ::cockroach::roachpb::ReverseScanRequest* reverse_scan_;
-  ::cockroach::roachpb::NoopRequest* noop_;
+  ::google::protobuf::uint64 match_;
+  ::google::protobuf::uint64 next_;
+  ::google::protobuf::internal::ArenaStringPtr state_;
+  ::google::protobuf::int32 replica_id_;
   friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static RequestUnion* default_instance_;
+  static RaftProgress* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class ResponseUnion : public ::google::protobuf::Message {
+class RaftStatusResponse : public ::google::protobuf::Message {
  public:
-  ResponseUnion();
-  virtual ~ResponseUnion();
+  RaftStatusResponse();
+  virtual ~RaftStatusResponse();
 
-  ResponseUnion(const ResponseUnion& from);
+  RaftStatusResponse(const RaftStatusResponse& from);
 
-  inline ResponseUnion& operator=(const ResponseUnion& from) {
+  inline RaftStatusResponse& operator=(const RaftStatusResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -5153,19 +5226,19 @@ class ResponseUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ResponseUnion& default_instance();
+  static const RaftStatusResponse& default_instance();
 
-  void Swap(ResponseUnion* other);
+  void Swap(RaftStatusResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline ResponseUnion* New() const { return New(NULL); }
+  inline RaftStatusResponse* New() const { return New(NULL); }
 
-  ResponseUnion* New(::google::protobuf::Arena* arena) const;
+  RaftStatusResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ResponseUnion& from);
-  void MergeFrom(const ResponseUnion& from);
+  void CopyFrom(const RaftStatusResponse& from);
+  void MergeFrom(const RaftStatusResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -5180,7 +5253,7 @@ class ResponseUnion : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(ResponseUnion* other);
+  void InternalSwap(RaftStatusResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -5196,293 +5269,222 @@ class ResponseUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.roachpb.GetResponse get = 1;
-  bool has_get() const;
-  void clear_get();
-  static const int kGetFieldNumber = 1;
-  const ::cockroach::roachpb::GetResponse& get() const;
-  ::cockroach::roachpb::GetResponse* mutable_get();
-  ::cockroach::roachpb::GetResponse* release_get();
-  void set_allocated_get(::cockroach::roachpb::GetResponse* get);
-
-  // optional .cockroach.roachpb.PutResponse put = 2;
-  bool has_put() const;
-  void clear_put();
-  static const int kPutFieldNumber = 2;
-  const ::cockroach::roachpb::PutResponse& put() const;
-  ::cockroach::roachpb::PutResponse* mutable_put();
-  ::cockroach::roachpb::PutResponse* release_put();
-  void set_allocated_put(::cockroach::roachpb::PutResponse* put);
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::ResponseHeader& header() const;
+  ::cockroach::roachpb::ResponseHeader* mutable_header();
+  ::cockroach::roachpb::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::roachpb::ResponseHeader* header);
 
-  // optional .cockroach.roachpb.ConditionalPutResponse conditional_put = 3;
-  bool has_conditional_put() const;
-  void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 3;
-  const ::cockroach::roachpb::ConditionalPutResponse& conditional_put() const;
-  ::cockroach::roachpb::ConditionalPutResponse* mutable_conditional_put();
-  ::cockroach::roachpb::ConditionalPutResponse* release_conditional_put();
-  void set_allocated_conditional_put(::cockroach::roachpb::ConditionalPutResponse* conditional_put);
+  // optional int64 range_id = 2;
+  bool has_range_id() const;
+  void clear_range_id();
+  static const int kRangeIdFieldNumber = 2;
+  ::google::protobuf::int64 range_id() const;
+  void set_range_id(::google::protobuf::int64 value);
 
-  // optional .cockroach.roachpb.IncrementResponse increment = 4;
-  bool has_increment() const;
-  void clear_increment();
-  static const int kIncrementFieldNumber = 4;
-  const ::cockroach::roachpb::IncrementResponse& increment() const;
-  ::cockroach::roachpb::IncrementResponse* mutable_increment();
-  ::cockroach::roachpb::IncrementResponse* release_increment();
-  void set_allocated_increment(::cockroach::roachpb::IncrementResponse* increment);
+  // optional .cockroach.roachpb.ReplicaDescriptor replica = 3;
+  bool has_replica() const;
+  void clear_replica();
+  static const int kReplicaFieldNumber = 3;
+  const ::cockroach::roachpb::ReplicaDescriptor& replica() const;
+  ::cockroach::roachpb::ReplicaDescriptor* mutable_replica();
+  ::cockroach::roachpb::ReplicaDescriptor* release_replica();
+  void set_allocated_replica(::cockroach::roachpb::ReplicaDescriptor* replica);
 
-  // optional .cockroach.roachpb.DeleteResponse delete = 5;
-  bool has_delete_() const;
-  void clear_delete_();
-  static const int kDeleteFieldNumber = 5;
-  const ::cockroach::roachpb::DeleteResponse& delete_() const;
-  ::cockroach::roachpb::DeleteResponse* mutable_delete_();
-  ::cockroach::roachpb::DeleteResponse* release_delete_();
-  void set_allocated_delete_(::cockroach::roachpb::DeleteResponse* delete_);
+  // optional uint64 term = 4;
+  bool has_term() const;
+  void clear_term();
+  static const int kTermFieldNumber = 4;
+  ::google::protobuf::uint64 term() const;
+  void set_term(::google::protobuf::uint64 value);
 
-  // optional .cockroach.roachpb.DeleteRangeResponse delete_range = 6;
-  bool has_delete_range() const;
-  void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 6;
-  const ::cockroach::roachpb::DeleteRangeResponse& delete_range() const;
-  ::cockroach::roachpb::DeleteRangeResponse* mutable_delete_range();
-  ::cockroach::roachpb::DeleteRangeResponse* release_delete_range();
-  void set_allocated_delete_range(::cockroach::roachpb::DeleteRangeResponse* delete_range);
+  // optional uint64 commit = 5;
+  bool has_commit() const;
+  void clear_commit();
+  static const int kCommitFieldNumber = 5;
+  ::google::protobuf::uint64 commit() const;
+  void set_commit(::google::protobuf::uint64 value);
+
+  // optional uint64 applied = 6;
+  bool has_applied() const;
+  void clear_applied();
+  static const int kAppliedFieldNumber = 6;
+  ::google::protobuf::uint64 applied() const;
+  void set_applied(::google::protobuf::uint64 value);
+
+  // optional int32 lead = 7;
+  bool has_lead() const;
+  void clear_lead();
+  static const int kLeadFieldNumber = 7;
+  ::google::protobuf::int32 lead() const;
+  void set_lead(::google::protobuf::int32 value);
+
+  // optional string state = 8;
+  bool has_state() const;
+  void clear_state();
+  static const int kStateFieldNumber = 8;
+  const ::std::string& state() const;
+  void set_state(const ::std::string& value);
+  void set_state(const char* value);
+  void set_state(const char* value, size_t size);
+  ::std::string* mutable_state();
+  ::std::string* release_state();
+  void set_allocated_state(::std::string* state);
+
+  // repeated .cockroach.roachpb.RaftProgress progress = 9;
+  int progress_size() const;
+  void clear_progress();
+  static const int kProgressFieldNumber = 9;
+  const ::cockroach::roachpb::RaftProgress& progress(int index) const;
+  ::cockroach::roachpb::RaftProgress* mutable_progress(int index);
+  ::cockroach::roachpb::RaftProgress* add_progress();
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RaftProgress >*
+      mutable_progress();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RaftProgress >&
+      progress() const;
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.RaftStatusResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_range_id();
+  inline void clear_has_range_id();
+  inline void set_has_replica();
+  inline void clear_has_replica();
+  inline void set_has_term();
+  inline void clear_has_term();
+  inline void set_has_commit();
+  inline void clear_has_commit();
+  inline void set_has_applied();
+  inline void clear_has_applied();
+  inline void set_has_lead();
+  inline void clear_has_lead();
+  inline void set_has_state();
+  inline void clear_has_state();
 
-  // optional .cockroach.roachpb.ScanResponse scan = 7;
-  bool has_scan() const;
-  void clear_scan();
-  static const int kScanFieldNumber = 7;
-  const ::cockroach::roachpb::ScanResponse& scan() const;
-  ::cockroach::roachpb::ScanResponse* mutable_scan();
-  ::cockroach::roachpb::ScanResponse* release_scan();
-  void set_allocated_scan(::cockroach::roachpb::ScanResponse* scan);
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::ResponseHeader* header_;
+  ::google::protobuf::int64 range_id_;
+  ::cockroach::roachpb::ReplicaDescriptor* replica_;
+  ::google::protobuf::uint64 term_;
+  ::google::protobuf::uint64 commit_;
+  ::google::protobuf::uint64 applied_;
+  ::google::protobuf::internal::ArenaStringPtr state_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RaftProgress > progress_;
+  ::google::protobuf::int32 lead_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
 
-  // optional .cockroach.roachpb.BeginTransactionResponse begin_transaction = 8;
-  bool has_begin_transaction() const;
-  void clear_begin_transaction();
-  static const int kBeginTransactionFieldNumber = 8;
-  const ::cockroach::roachpb::BeginTransactionResponse& begin_transaction() const;
-  ::cockroach::roachpb::BeginTransactionResponse* mutable_begin_transaction();
-  ::cockroach::roachpb::BeginTransactionResponse* release_begin_transaction();
-  void set_allocated_begin_transaction(::cockroach::roachpb::BeginTransactionResponse* begin_transaction);
+  void InitAsDefaultInstance();
+  static RaftStatusResponse* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional .cockroach.roachpb.EndTransactionResponse end_transaction = 9;
-  bool has_end_transaction() const;
-  void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  const ::cockroach::roachpb::EndTransactionResponse& end_transaction() const;
-  ::cockroach::roachpb::EndTransactionResponse* mutable_end_transaction();
-  ::cockroach::roachpb::EndTransactionResponse* release_end_transaction();
-  void set_allocated_end_transaction(::cockroach::roachpb::EndTransactionResponse* end_transaction);
+class RangeStatsRequest : public ::google::protobuf::Message {
+ public:
+  RangeStatsRequest();
+  virtual ~RangeStatsRequest();
 
-  // optional .cockroach.roachpb.AdminSplitResponse admin_split = 10;
-  bool has_admin_split() const;
-  void clear_admin_split();
-  static const int kAdminSplitFieldNumber = 10;
-  const ::cockroach::roachpb::AdminSplitResponse& admin_split() const;
-  ::cockroach::roachpb::AdminSplitResponse* mutable_admin_split();
-  ::cockroach::roachpb::AdminSplitResponse* release_admin_split();
-  void set_allocated_admin_split(::cockroach::roachpb::AdminSplitResponse* admin_split);
+  RangeStatsRequest(const RangeStatsRequest& from);
 
-  // optional .cockroach.roachpb.AdminMergeResponse admin_merge = 11;
-  bool has_admin_merge() const;
-  void clear_admin_merge();
-  static const int kAdminMergeFieldNumber = 11;
-  const ::cockroach::roachpb::AdminMergeResponse& admin_merge() const;
-  ::cockroach::roachpb::AdminMergeResponse* mutable_admin_merge();
-  ::cockroach::roachpb::AdminMergeResponse* release_admin_merge();
-  void set_allocated_admin_merge(::cockroach::roachpb::AdminMergeResponse* admin_merge);
+  inline RangeStatsRequest& operator=(const RangeStatsRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional .cockroach.roachpb.HeartbeatTxnResponse heartbeat_txn = 12;
-  bool has_heartbeat_txn() const;
-  void clear_heartbeat_txn();
-  static const int kHeartbeatTxnFieldNumber = 12;
-  const ::cockroach::roachpb::HeartbeatTxnResponse& heartbeat_txn() const;
-  ::cockroach::roachpb::HeartbeatTxnResponse* mutable_heartbeat_txn();
-  ::cockroach::roachpb::HeartbeatTxnResponse* release_heartbeat_txn();
-  void set_allocated_heartbeat_txn(::cockroach::roachpb::HeartbeatTxnResponse* heartbeat_txn);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
 
-  // optional .cockroach.roachpb.GCResponse gc = 13;
-  bool has_gc() const;
-  void clear_gc();
-  static const int kGcFieldNumber = 13;
-  const ::cockroach::roachpb::GCResponse& gc() const;
-  ::cockroach::roachpb::GCResponse* mutable_gc();
-  ::cockroach::roachpb::GCResponse* release_gc();
-  void set_allocated_gc(::cockroach::roachpb::GCResponse* gc);
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
 
-  // optional .cockroach.roachpb.PushTxnResponse push_txn = 14;
-  bool has_push_txn() const;
-  void clear_push_txn();
-  static const int kPushTxnFieldNumber = 14;
-  const ::cockroach::roachpb::PushTxnResponse& push_txn() const;
-  ::cockroach::roachpb::PushTxnResponse* mutable_push_txn();
-  ::cockroach::roachpb::PushTxnResponse* release_push_txn();
-  void set_allocated_push_txn(::cockroach::roachpb::PushTxnResponse* push_txn);
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RangeStatsRequest& default_instance();
 
-  // optional .cockroach.roachpb.RangeLookupResponse range_lookup = 15;
-  bool has_range_lookup() const;
-  void clear_range_lookup();
-  static const int kRangeLookupFieldNumber = 15;
-  const ::cockroach::roachpb::RangeLookupResponse& range_lookup() const;
-  ::cockroach::roachpb::RangeLookupResponse* mutable_range_lookup();
-  ::cockroach::roachpb::RangeLookupResponse* release_range_lookup();
-  void set_allocated_range_lookup(::cockroach::roachpb::RangeLookupResponse* range_lookup);
+  void Swap(RangeStatsRequest* other);
 
-  // optional .cockroach.roachpb.ResolveIntentResponse resolve_intent = 16;
-  bool has_resolve_intent() const;
-  void clear_resolve_intent();
-  static const int kResolveIntentFieldNumber = 16;
-  const ::cockroach::roachpb::ResolveIntentResponse& resolve_intent() const;
-  ::cockroach::roachpb::ResolveIntentResponse* mutable_resolve_intent();
-  ::cockroach::roachpb::ResolveIntentResponse* release_resolve_intent();
-  void set_allocated_resolve_intent(::cockroach::roachpb::ResolveIntentResponse* resolve_intent);
+  // implements Message ----------------------------------------------
 
-  // optional .cockroach.roachpb.ResolveIntentRangeResponse resolve_intent_range = 17;
-  bool has_resolve_intent_range() const;
-  void clear_resolve_intent_range();
-  static const int kResolveIntentRangeFieldNumber = 17;
-  const ::cockroach::roachpb::ResolveIntentRangeResponse& resolve_intent_range() const;
-  ::cockroach::roachpb::ResolveIntentRangeResponse* mutable_resolve_intent_range();
-  ::cockroach::roachpb::ResolveIntentRangeResponse* release_resolve_intent_range();
-  void set_allocated_resolve_intent_range(::cockroach::roachpb::ResolveIntentRangeResponse* resolve_intent_range);
+  inline RangeStatsRequest* New() const { return New(NULL); }
 
-  // optional .cockroach.roachpb.MergeResponse merge = 18;
-  bool has_merge() const;
-  void clear_merge();
-  static const int kMergeFieldNumber = 18;
-  const ::cockroach::roachpb::MergeResponse& merge() const;
-  ::cockroach::roachpb::MergeResponse* mutable_merge();
-  ::cockroach::roachpb::MergeResponse* release_merge();
-  void set_allocated_merge(::cockroach::roachpb::MergeResponse* merge);
+  RangeStatsRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RangeStatsRequest& from);
+  void MergeFrom(const RangeStatsRequest& from);
+  void Clear();
+  bool IsInitialized() const;
 
-  // optional .cockroach.roachpb.TruncateLogResponse truncate_log = 19;
-  bool has_truncate_log() const;
-  void clear_truncate_log();
-  static const int kTruncateLogFieldNumber = 19;
-  const ::cockroach::roachpb::TruncateLogResponse& truncate_log() const;
-  ::cockroach::roachpb::TruncateLogResponse* mutable_truncate_log();
-  ::cockroach::roachpb::TruncateLogResponse* release_truncate_log();
-  void set_allocated_truncate_log(::cockroach::roachpb::TruncateLogResponse* truncate_log);
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(RangeStatsRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
 
-  // optional .cockroach.roachpb.LeaderLeaseResponse leader_lease = 20;
-  bool has_leader_lease() const;
-  void clear_leader_lease();
-  static const int kLeaderLeaseFieldNumber = 20;
-  const ::cockroach::roachpb::LeaderLeaseResponse& leader_lease() const;
-  ::cockroach::roachpb::LeaderLeaseResponse* mutable_leader_lease();
-  ::cockroach::roachpb::LeaderLeaseResponse* release_leader_lease();
-  void set_allocated_leader_lease(::cockroach::roachpb::LeaderLeaseResponse* leader_lease);
+  ::google::protobuf::Metadata GetMetadata() const;
 
-  // optional .cockroach.roachpb.ReverseScanResponse reverse_scan = 21;
-  bool has_reverse_scan() const;
-  void clear_reverse_scan();
-  static const int kReverseScanFieldNumber = 21;
-  const ::cockroach::roachpb::ReverseScanResponse& reverse_scan() const;
-  ::cockroach::roachpb::ReverseScanResponse* mutable_reverse_scan();
-  ::cockroach::roachpb::ReverseScanResponse* release_reverse_scan();
-  void set_allocated_reverse_scan(::cockroach::roachpb::ReverseScanResponse* reverse_scan);
+  // nested types ----------------------------------------------------
 
-  // optional .cockroach.roachpb.NoopResponse noop = 22;
-  bool has_noop() const;
-  void clear_noop();
-  static const int kNoopFieldNumber = 22;
-  const ::cockroach::roachpb::NoopResponse& noop() const;
-  ::cockroach::roachpb::NoopResponse* mutable_noop();
-  ::cockroach::roachpb::NoopResponse* release_noop();
-  void set_allocated_noop(::cockroach::roachpb::NoopResponse* noop);
+  // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:cockroach.roachpb.ResponseUnion)
+  // optional .cockroach.roachpb.Span header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::Span& header() const;
+  ::cockroach::roachpb::Span* mutable_header();
+  ::cockroach::roachpb::Span* release_header();
+  void set_allocated_header(::cockroach::roachpb::Span* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.RangeStatsRequest)
  private:
-  inline void set_has_get();
-  inline void clear_has_get();
-  inline void set_has_put();
-  inline void clear_has_put();
-  inline void set_has_conditional_put();
-  inline void clear_has_conditional_put();
-  inline void set_has_increment();
-  inline void clear_has_increment();
-  inline void set_has_delete_();
-  inline void clear_has_delete_();
-  inline void set_has_delete_range();
-  inline void clear_has_delete_range();
-  inline void set_has_scan();
-  inline void clear_has_scan();
-  inline void set_has_begin_transaction();
-  inline void clear_has_begin_transaction();
-  inline void set_has_end_transaction();
-  inline void clear_has_end_transaction();
-  inline void set_has_admin_split();
-  inline void clear_has_admin_split();
-  inline void set_has_admin_merge();
-  inline void clear_has_admin_merge();
-  inline void set_has_heartbeat_txn();
-  inline void clear_has_heartbeat_txn();
-  inline void set_has_gc();
-  inline void clear_has_gc();
-  inline void set_has_push_txn();
-  inline void clear_has_push_txn();
-  inline void set_has_range_lookup();
-  inline void clear_has_range_lookup();
-  inline void set_has_resolve_intent();
-  inline void clear_has_resolve_intent();
-  inline void set_has_resolve_intent_range();
-  inline void clear_has_resolve_intent_range();
-  inline void set_has_merge();
-  inline void clear_has_merge();
-  inline void set_has_truncate_log();
-  inline void clear_has_truncate_log();
-  inline void set_has_leader_lease();
-  inline void clear_has_leader_lease();
-  inline void set_has_reverse_scan();
-  inline void clear_has_reverse_scan();
-  inline void set_has_noop();
-  inline void clear_has_noop();
+  inline void set_has_header();
+  inline void clear_has_header();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::roachpb::GetResponse* get_;
-  ::cockroach::roachpb::PutResponse* put_;
-  ::cockroach::roachpb::ConditionalPutResponse* conditional_put_;
-  ::cockroach::roachpb::IncrementResponse* increment_;
-  ::cockroach::roachpb::DeleteResponse* delete__;
-  ::cockroach::roachpb::DeleteRangeResponse* delete_range_;
-  ::cockroach::roachpb::ScanResponse* scan_;
-  ::cockroach::roachpb::BeginTransactionResponse* begin_transaction_;
-  ::cockroach::roachpb::EndTransactionResponse* end_transaction_;
-  ::cockroach::roachpb::AdminSplitResponse* admin_split_;
-  ::cockroach::roachpb::AdminMergeResponse* admin_merge_;
-  ::cockroach::roachpb::HeartbeatTxnResponse* heartbeat_txn_;
-  ::cockroach::roachpb::GCResponse* gc_;
-  ::cockroach::roachpb::PushTxnResponse* push_txn_;
-  ::cockroach::roachpb::RangeLookupResponse* range_lookup_;
-  ::cockroach::roachpb::ResolveIntentResponse* resolve_intent_;
-  ::cockroach::roachpb::ResolveIntentRangeResponse* resolve_intent_range_;
-  ::cockroach::roachpb::MergeResponse* merge_;
-  ::cockroach::roachpb::TruncateLogResponse* truncate_log_;
-  ::cockroach::roachpb::LeaderLeaseResponse* leader_lease_;
-  ::cockroach::roachpb::ReverseScanResponse* reverse_scan_;
-  ::cockroach::roachpb::NoopResponse* noop_;
+  ::cockroach::roachpb::Span* header_;
   friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static ResponseUnion* default_instance_;
+  static RangeStatsRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class Header : public ::google::protobuf::Message {
+class RangeStatsResponse : public ::google::protobuf::Message {
  public:
-  Header();
-  virtual ~Header();
+  RangeStatsResponse();
+  virtual ~RangeStatsResponse();
 
-  Header(const Header& from);
+  RangeStatsResponse(const RangeStatsResponse& from);
 
-  inline Header& operator=(const Header& from) {
+  inline RangeStatsResponse& operator=(const RangeStatsResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -5496,19 +5498,19 @@ class Header : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const Header& default_instance();
+  static const RangeStatsResponse& default_instance();
 
-  void Swap(Header* other);
+  void Swap(RangeStatsResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline Header* New() const { return New(NULL); }
+  inline RangeStatsResponse* New() const { return New(NULL); }
 
-  Header* New(::google::protobuf::Arena* arena) const;
+  RangeStatsResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const Header& from);
-  void MergeFrom(const Header& from);
+  void CopyFrom(const RangeStatsResponse& from);
+  void MergeFrom(const RangeStatsResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -5523,7 +5525,7 @@ class Header : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(Header* other);
+  void InternalSwap(RangeStatsResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -5539,95 +5541,105 @@ class Header : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.roachpb.Timestamp timestamp = 1;
-  bool has_timestamp() const;
-  void clear_timestamp();
-  static const int kTimestampFieldNumber = 1;
-  const ::cockroach::roachpb::Timestamp& timestamp() const;
-  ::cockroach::roachpb::Timestamp* mutable_timestamp();
-  ::cockroach::roachpb::Timestamp* release_timestamp();
-  void set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp);
-
-  // optional .cockroach.roachpb.ReplicaDescriptor replica = 2;
-  bool has_replica() const;
-  void clear_replica();
-  static const int kReplicaFieldNumber = 2;
-  const ::cockroach::roachpb::ReplicaDescriptor& replica() const;
-  ::cockroach::roachpb::ReplicaDescriptor* mutable_replica();
-  ::cockroach::roachpb::ReplicaDescriptor* release_replica();
-  void set_allocated_replica(::cockroach::roachpb::ReplicaDescriptor* replica);
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::ResponseHeader& header() const;
+  ::cockroach::roachpb::ResponseHeader* mutable_header();
+  ::cockroach::roachpb::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::roachpb::ResponseHeader* header);
 
-  // optional int64 range_id = 3;
+  // optional int64 range_id = 2;
   bool has_range_id() const;
   void clear_range_id();
-  static const int kRangeIdFieldNumber = 3;
+  static const int kRangeIdFieldNumber = 2;
   ::google::protobuf::int64 range_id() const;
   void set_range_id(::google::protobuf::int64 value);
 
-  // optional double user_priority = 4;
-  bool has_user_priority() const;
-  void clear_user_priority();
-  static const int kUserPriorityFieldNumber = 4;
-  double user_priority() const;
-  void set_user_priority(double value);
-
-  // optional .cockroach.roachpb.Transaction txn = 5;
-  bool has_txn() const;
-  void clear_txn();
-  static const int kTxnFieldNumber = 5;
-  const ::cockroach::roachpb::Transaction& txn() const;
-  ::cockroach::roachpb::Transaction* mutable_txn();
-  ::cockroach::roachpb::Transaction* release_txn();
-  void set_allocated_txn(::cockroach::roachpb::Transaction* txn);
-
-  // optional .cockroach.roachpb.ReadConsistencyType read_consistency = 6;
-  bool has_read_consistency() const;
-  void clear_read_consistency();
-  static const int kReadConsistencyFieldNumber = 6;
-  ::cockroach::roachpb::ReadConsistencyType read_consistency() const;
-  void set_read_consistency(::cockroach::roachpb::ReadConsistencyType value);
-
-  // @@protoc_insertion_point(class_scope:cockroach.roachpb.Header)
+  // optional .cockroach.roachpb.RangeDescriptor desc = 3;
+  bool has_desc() const;
+  void clear_desc();
+  static const int kDescFieldNumber = 3;
+  const ::cockroach::roachpb::RangeDescriptor& desc() const;
+  ::cockroach::roachpb::RangeDescriptor* mutable_desc();
+  ::cockroach::roachpb::RangeDescriptor* release_desc();
+  void set_allocated_desc(::cockroach::roachpb::RangeDescriptor* desc);
+
+  // optional int64 live_bytes = 4;
+  bool has_live_bytes() const;
+  void clear_live_bytes();
+  static const int kLiveBytesFieldNumber = 4;
+  ::google::protobuf::int64 live_bytes() const;
+  void set_live_bytes(::google::protobuf::int64 value);
+
+  // optional int64 live_count = 5;
+  bool has_live_count() const;
+  void clear_live_count();
+  static const int kLiveCountFieldNumber = 5;
+  ::google::protobuf::int64 live_count() const;
+  void set_live_count(::google::protobuf::int64 value);
+
+  // optional int64 key_count = 6;
+  bool has_key_count() const;
+  void clear_key_count();
+  static const int kKeyCountFieldNumber = 6;
+  ::google::protobuf::int64 key_count() const;
+  void set_key_count(::google::protobuf::int64 value);
+
+  // optional .cockroach.roachpb.Timestamp gc_threshold = 7;
+  bool has_gc_threshold() const;
+  void clear_gc_threshold();
+  static const int kGcThresholdFieldNumber = 7;
+  const ::cockroach::roachpb::Timestamp& gc_threshold() const;
+  ::cockroach::roachpb::Timestamp* mutable_gc_threshold();
+  ::cockroach::roachpb::Timestamp* release_gc_threshold();
+  void set_allocated_gc_threshold(::cockroach::roachpb::Timestamp* gc_threshold);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.RangeStatsResponse)
  private:
-  inline void set_has_timestamp();
-  inline void clear_has_timestamp();
-  inline void set_has_replica();
-  inline void clear_has_replica();
+  inline void set_has_header();
+  inline void clear_has_header();
   inline void set_has_range_id();
   inline void clear_has_range_id();
-  inline void set_has_user_priority();
-  inline void clear_has_user_priority();
-  inline void set_has_txn();
-  inline void clear_has_txn();
-  inline void set_has_read_consistency();
-  inline void clear_has_read_consistency();
+  inline void set_has_desc();
+  inline void clear_has_desc();
+  inline void set_has_live_bytes();
+  inline void clear_has_live_bytes();
+  inline void set_has_live_count();
+  inline void clear_has_live_count();
+  inline void set_has_key_count();
+  inline void clear_has_key_count();
+  inline void set_has_gc_threshold();
+  inline void clear_has_gc_threshold();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::roachpb::Timestamp* timestamp_;
-  ::cockroach::roachpb::ReplicaDescriptor* replica_;
+  ::cockroach::roachpb::ResponseHeader* header_;
   ::google::protobuf::int64 range_id_;
-  double user_priority_;
-  ::cockroach::roachpb::Transaction* txn_;
-  int read_consistency_;
+  ::cockroach::roachpb::RangeDescriptor* desc_;
+  ::google::protobuf::int64 live_bytes_;
+  ::google::protobuf::int64 live_count_;
+  ::google::protobuf::int64 key_count_;
+  ::cockroach::roachpb::Timestamp* gc_threshold_;
   friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static Header* default_instance_;
+  static RangeStatsResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class BatchRequest : public ::google::protobuf::Message {
+class RequestUnion : public ::google::protobuf::Message {
  public:
-  BatchRequest();
-  virtual ~BatchRequest();
+  RequestUnion();
+  virtual ~RequestUnion();
 
-  BatchRequest(const BatchRequest& from);
+  RequestUnion(const RequestUnion& from);
 
-  inline BatchRequest& operator=(const BatchRequest& from) {
+  inline RequestUnion& operator=(const RequestUnion& from) {
     CopyFrom(from);
     return *this;
   }
@@ -5641,19 +5653,19 @@ class BatchRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchRequest& default_instance();
+  static const RequestUnion& default_instance();
 
-  void Swap(BatchRequest* other);
+  void Swap(RequestUnion* other);
 
   // implements Message ----------------------------------------------
 
-  inline BatchRequest* New() const { return New(NULL); }
+  inline RequestUnion* New() const { return New(NULL); }
 
-  BatchRequest* New(::google::protobuf::Arena* arena) const;
+  RequestUnion* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchRequest& from);
-  void MergeFrom(const BatchRequest& from);
+  void CopyFrom(const RequestUnion& from);
+  void MergeFrom(const RequestUnion& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -5668,7 +5680,7 @@ class BatchRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(BatchRequest* other);
+  void InternalSwap(RequestUnion* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -5684,54 +5696,329 @@ class BatchRequest : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.roachpb.Header header = 1;
-  bool has_header() const;
-  void clear_header();
-  static const int kHeaderFieldNumber = 1;
-  const ::cockroach::roachpb::Header& header() const;
-  ::cockroach::roachpb::Header* mutable_header();
-  ::cockroach::roachpb::Header* release_header();
-  void set_allocated_header(::cockroach::roachpb::Header* header);
+  // optional .cockroach.roachpb.GetRequest get = 1;
+  bool has_get() const;
+  void clear_get();
+  static const int kGetFieldNumber = 1;
+  const ::cockroach::roachpb::GetRequest& get() const;
+  ::cockroach::roachpb::GetRequest* mutable_get();
+  ::cockroach::roachpb::GetRequest* release_get();
+  void set_allocated_get(::cockroach::roachpb::GetRequest* get);
 
-  // repeated .cockroach.roachpb.RequestUnion requests = 2;
-  int requests_size() const;
-  void clear_requests();
-  static const int kRequestsFieldNumber = 2;
-  const ::cockroach::roachpb::RequestUnion& requests(int index) const;
-  ::cockroach::roachpb::RequestUnion* mutable_requests(int index);
-  ::cockroach::roachpb::RequestUnion* add_requests();
-  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RequestUnion >*
-      mutable_requests();
-  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RequestUnion >&
-      requests() const;
+  // optional .cockroach.roachpb.PutRequest put = 2;
+  bool has_put() const;
+  void clear_put();
+  static const int kPutFieldNumber = 2;
+  const ::cockroach::roachpb::PutRequest& put() const;
+  ::cockroach::roachpb::PutRequest* mutable_put();
+  ::cockroach::roachpb::PutRequest* release_put();
+  void set_allocated_put(::cockroach::roachpb::PutRequest* put);
 
-  // @@protoc_insertion_point(class_scope:cockroach.roachpb.BatchRequest)
- private:
-  inline void set_has_header();
-  inline void clear_has_header();
+  // optional .cockroach.roachpb.ConditionalPutRequest conditional_put = 3;
+  bool has_conditional_put() const;
+  void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 3;
+  const ::cockroach::roachpb::ConditionalPutRequest& conditional_put() const;
+  ::cockroach::roachpb::ConditionalPutRequest* mutable_conditional_put();
+  ::cockroach::roachpb::ConditionalPutRequest* release_conditional_put();
+  void set_allocated_conditional_put(::cockroach::roachpb::ConditionalPutRequest* conditional_put);
 
-  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
-  ::google::protobuf::uint32 _has_bits_[1];
-  mutable int _cached_size_;
-  ::cockroach::roachpb::Header* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RequestUnion > requests_;
-  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
-  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
-  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+  // optional .cockroach.roachpb.IncrementRequest increment = 4;
+  bool has_increment() const;
+  void clear_increment();
+  static const int kIncrementFieldNumber = 4;
+  const ::cockroach::roachpb::IncrementRequest& increment() const;
+  ::cockroach::roachpb::IncrementRequest* mutable_increment();
+  ::cockroach::roachpb::IncrementRequest* release_increment();
+  void set_allocated_increment(::cockroach::roachpb::IncrementRequest* increment);
 
-  void InitAsDefaultInstance();
-  static BatchRequest* default_instance_;
-};
-// -------------------------------------------------------------------
+  // optional .cockroach.roachpb.DeleteRequest delete = 5;
+  bool has_delete_() const;
+  void clear_delete_();
+  static const int kDeleteFieldNumber = 5;
+  const ::cockroach::roachpb::DeleteRequest& delete_() const;
+  ::cockroach::roachpb::DeleteRequest* mutable_delete_();
+  ::cockroach::roachpb::DeleteRequest* release_delete_();
+  void set_allocated_delete_(::cockroach::roachpb::DeleteRequest* delete_);
 
-class BatchResponse_Header : public ::google::protobuf::Message {
- public:
-  BatchResponse_Header();
-  virtual ~BatchResponse_Header();
+  // optional .cockroach.roachpb.DeleteRangeRequest delete_range = 6;
+  bool has_delete_range() const;
+  void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 6;
+  const ::cockroach::roachpb::DeleteRangeRequest& delete_range() const;
+  ::cockroach::roachpb::DeleteRangeRequest* mutable_delete_range();
+  ::cockroach::roachpb::DeleteRangeRequest* release_delete_range();
+  void set_allocated_delete_range(::cockroach::roachpb::DeleteRangeRequest* delete_range);
 
-  BatchResponse_Header(const BatchResponse_Header& from);
+  // optional .cockroach.roachpb.ScanRequest scan = 7;
+  bool has_scan() const;
+  void clear_scan();
+  static const int kScanFieldNumber = 7;
+  const ::cockroach::roachpb::ScanRequest& scan() const;
+  ::cockroach::roachpb::ScanRequest* mutable_scan();
+  ::cockroach::roachpb::ScanRequest* release_scan();
+  void set_allocated_scan(::cockroach::roachpb::ScanRequest* scan);
 
-  inline BatchResponse_Header& operator=(const BatchResponse_Header& from) {
+  // optional .cockroach.roachpb.BeginTransactionRequest begin_transaction = 8;
+  bool has_begin_transaction() const;
+  void clear_begin_transaction();
+  static const int kBeginTransactionFieldNumber = 8;
+  const ::cockroach::roachpb::BeginTransactionRequest& begin_transaction() const;
+  ::cockroach::roachpb::BeginTransactionRequest* mutable_begin_transaction();
+  ::cockroach::roachpb::BeginTransactionRequest* release_begin_transaction();
+  void set_allocated_begin_transaction(::cockroach::roachpb::BeginTransactionRequest* begin_transaction);
+
+  // optional .cockroach.roachpb.EndTransactionRequest end_transaction = 9;
+  bool has_end_transaction() const;
+  void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  const ::cockroach::roachpb::EndTransactionRequest& end_transaction() const;
+  ::cockroach::roachpb::EndTransactionRequest* mutable_end_transaction();
+  ::cockroach::roachpb::EndTransactionRequest* release_end_transaction();
+  void set_allocated_end_transaction(::cockroach::roachpb::EndTransactionRequest* end_transaction);
+
+  // optional .cockroach.roachpb.AdminSplitRequest admin_split = 10;
+  bool has_admin_split() const;
+  void clear_admin_split();
+  static const int kAdminSplitFieldNumber = 10;
+  const ::cockroach::roachpb::AdminSplitRequest& admin_split() const;
+  ::cockroach::roachpb::AdminSplitRequest* mutable_admin_split();
+  ::cockroach::roachpb::AdminSplitRequest* release_admin_split();
+  void set_allocated_admin_split(::cockroach::roachpb::AdminSplitRequest* admin_split);
+
+  // optional .cockroach.roachpb.AdminMergeRequest admin_merge = 11;
+  bool has_admin_merge() const;
+  void clear_admin_merge();
+  static const int kAdminMergeFieldNumber = 11;
+  const ::cockroach::roachpb::AdminMergeRequest& admin_merge() const;
+  ::cockroach::roachpb::AdminMergeRequest* mutable_admin_merge();
+  ::cockroach::roachpb::AdminMergeRequest* release_admin_merge();
+  void set_allocated_admin_merge(::cockroach::roachpb::AdminMergeRequest* admin_merge);
+
+  // optional .cockroach.roachpb.HeartbeatTxnRequest heartbeat_txn = 12;
+  bool has_heartbeat_txn() const;
+  void clear_heartbeat_txn();
+  static const int kHeartbeatTxnFieldNumber = 12;
+  const ::cockroach::roachpb::HeartbeatTxnRequest& heartbeat_txn() const;
+  ::cockroach::roachpb::HeartbeatTxnRequest* mutable_heartbeat_txn();
+  ::cockroach::roachpb::HeartbeatTxnRequest* release_heartbeat_txn();
+  void set_allocated_heartbeat_txn(::cockroach::roachpb::HeartbeatTxnRequest* heartbeat_txn);
+
+  // optional .cockroach.roachpb.GCRequest gc = 13;
+  bool has_gc() const;
+  void clear_gc();
+  static const int kGcFieldNumber = 13;
+  const ::cockroach::roachpb::GCRequest& gc() const;
+  ::cockroach::roachpb::GCRequest* mutable_gc();
+  ::cockroach::roachpb::GCRequest* release_gc();
+  void set_allocated_gc(::cockroach::roachpb::GCRequest* gc);
+
+  // optional .cockroach.roachpb.PushTxnRequest push_txn = 14;
+  bool has_push_txn() const;
+  void clear_push_txn();
+  static const int kPushTxnFieldNumber = 14;
+  const ::cockroach::roachpb::PushTxnRequest& push_txn() const;
+  ::cockroach::roachpb::PushTxnRequest* mutable_push_txn();
+  ::cockroach::roachpb::PushTxnRequest* release_push_txn();
+  void set_allocated_push_txn(::cockroach::roachpb::PushTxnRequest* push_txn);
+
+  // optional .cockroach.roachpb.RangeLookupRequest range_lookup = 15;
+  bool has_range_lookup() const;
+  void clear_range_lookup();
+  static const int kRangeLookupFieldNumber = 15;
+  const ::cockroach::roachpb::RangeLookupRequest& range_lookup() const;
+  ::cockroach::roachpb::RangeLookupRequest* mutable_range_lookup();
+  ::cockroach::roachpb::RangeLookupRequest* release_range_lookup();
+  void set_allocated_range_lookup(::cockroach::roachpb::RangeLookupRequest* range_lookup);
+
+  // optional .cockroach.roachpb.ResolveIntentRequest resolve_intent = 16;
+  bool has_resolve_intent() const;
+  void clear_resolve_intent();
+  static const int kResolveIntentFieldNumber = 16;
+  const ::cockroach::roachpb::ResolveIntentRequest& resolve_intent() const;
+  ::cockroach::roachpb::ResolveIntentRequest* mutable_resolve_intent();
+  ::cockroach::roachpb::ResolveIntentRequest* release_resolve_intent();
+  void set_allocated_resolve_intent(::cockroach::roachpb::ResolveIntentRequest* resolve_intent);
+
+  // optional .cockroach.roachpb.ResolveIntentRangeRequest resolve_intent_range = 17;
+  bool has_resolve_intent_range() const;
+  void clear_resolve_intent_range();
+  static const int kResolveIntentRangeFieldNumber = 17;
+  const ::cockroach::roachpb::ResolveIntentRangeRequest& resolve_intent_range() const;
+  ::cockroach::roachpb::ResolveIntentRangeRequest* mutable_resolve_intent_range();
+  ::cockroach::roachpb::ResolveIntentRangeRequest* release_resolve_intent_range();
+  void set_allocated_resolve_intent_range(::cockroach::roachpb::ResolveIntentRangeRequest* resolve_intent_range);
+
+  // optional .cockroach.roachpb.MergeRequest merge = 18;
+  bool has_merge() const;
+  void clear_merge();
+  static const int kMergeFieldNumber = 18;
+  const ::cockroach::roachpb::MergeRequest& merge() const;
+  ::cockroach::roachpb::MergeRequest* mutable_merge();
+  ::cockroach::roachpb::MergeRequest* release_merge();
+  void set_allocated_merge(::cockroach::roachpb::MergeRequest* merge);
+
+  // optional .cockroach.roachpb.TruncateLogRequest truncate_log = 19;
+  bool has_truncate_log() const;
+  void clear_truncate_log();
+  static const int kTruncateLogFieldNumber = 19;
+  const ::cockroach::roachpb::TruncateLogRequest& truncate_log() const;
+  ::cockroach::roachpb::TruncateLogRequest* mutable_truncate_log();
+  ::cockroach::roachpb::TruncateLogRequest* release_truncate_log();
+  void set_allocated_truncate_log(::cockroach::roachpb::TruncateLogRequest* truncate_log);
+
+  // optional .cockroach.roachpb.LeaderLeaseRequest leader_lease = 20;
+  bool has_leader_lease() const;
+  void clear_leader_lease();
+  static const int kLeaderLeaseFieldNumber = 20;
+  const ::cockroach::roachpb::LeaderLeaseRequest& leader_lease() const;
+  ::cockroach::roachpb::LeaderLeaseRequest* mutable_leader_lease();
+  ::cockroach::roachpb::LeaderLeaseRequest* release_leader_lease();
+  void set_allocated_leader_lease(::cockroach::roachpb::LeaderLeaseRequest* leader_lease);
+
+  // optional .cockroach.roachpb.ReverseScanRequest reverse_scan = 21;
+  bool has_reverse_scan() const;
+  void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 21;
+  const ::cockroach::roachpb::ReverseScanRequest& reverse_scan() const;
+  ::cockroach::roachpb::ReverseScanRequest* mutable_reverse_scan();
+  ::cockroach::roachpb::ReverseScanRequest* release_reverse_scan();
+  void set_allocated_reverse_scan(::cockroach::roachpb::ReverseScanRequest* reverse_scan);
+
+  // optional .cockroach.roachpb.NoopRequest noop = 22;
+  bool has_noop() const;
+  void clear_noop();
+  static const int kNoopFieldNumber = 22;
+  const ::cockroach::roachpb::NoopRequest& noop() const;
+  ::cockroach::roachpb::NoopRequest* mutable_noop();
+  ::cockroach::roachpb::NoopRequest* release_noop();
+  void set_allocated_noop(::cockroach::roachpb::NoopRequest* noop);
+
+  // optional .cockroach.roachpb.RaftStatusRequest raft_status = 23;
+  bool has_raft_status() const;
+  void clear_raft_status();
+  static const int kRaftStatusFieldNumber = 23;
+  const ::cockroach::roachpb::RaftStatusRequest& raft_status() const;
+  ::cockroach::roachpb::RaftStatusRequest* mutable_raft_status();
+  ::cockroach::roachpb::RaftStatusRequest* release_raft_status();
+  void set_allocated_raft_status(::cockroach::roachpb::RaftStatusRequest* raft_status);
+
+  // optional .cockroach.roachpb.RangeStatsRequest range_stats = 24;
+  bool has_range_stats() const;
+  void clear_range_stats();
+  static const int kRangeStatsFieldNumber = 24;
+  const ::cockroach::roachpb::RangeStatsRequest& range_stats() const;
+  ::cockroach::roachpb::RangeStatsRequest* mutable_range_stats();
+  ::cockroach::roachpb::RangeStatsRequest* release_range_stats();
+  void set_allocated_range_stats(::cockroach::roachpb::RangeStatsRequest* range_stats);
+
+  // optional .cockroach.roachpb.AdminScatterRequest admin_scatter = 25;
+  bool has_admin_scatter() const;
+  void clear_admin_scatter();
+  static const int kAdminScatterFieldNumber = 25;
+  const ::cockroach::roachpb::AdminScatterRequest& admin_scatter() const;
+  ::cockroach::roachpb::AdminScatterRequest* mutable_admin_scatter();
+  ::cockroach::roachpb::AdminScatterRequest* release_admin_scatter();
+  void set_allocated_admin_scatter(::cockroach::roachpb::AdminScatterRequest* admin_scatter);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.RequestUnion)
+ private:
+  inline void set_has_get();
+  inline void clear_has_get();
+  inline void set_has_put();
+  inline void clear_has_put();
+  inline void set_has_conditional_put();
+  inline void clear_has_conditional_put();
+  inline void set_has_increment();
+  inline void clear_has_increment();
+  inline void set_has_delete_();
+  inline void clear_has_delete_();
+  inline void set_has_delete_range();
+  inline void clear_has_delete_range();
+  inline void set_has_scan();
+  inline void clear_has_scan();
+  inline void set_has_begin_transaction();
+  inline void clear_has_begin_transaction();
+  inline void set_has_end_transaction();
+  inline void clear_has_end_transaction();
+  inline void set_has_admin_split();
+  inline void clear_has_admin_split();
+  inline void set_has_admin_merge();
+  inline void clear_has_admin_merge();
+  inline void set_has_heartbeat_txn();
+  inline void clear_has_heartbeat_txn();
+  inline void set_has_gc();
+  inline void clear_has_gc();
+  inline void set_has_push_txn();
+  inline void clear_has_push_txn();
+  inline void set_has_range_lookup();
+  inline void clear_has_range_lookup();
+  inline void set_has_resolve_intent();
+  inline void clear_has_resolve_intent();
+  inline void set_has_resolve_intent_range();
+  inline void clear_has_resolve_intent_range();
+  inline void set_has_merge();
+  inline void clear_has_merge();
+  inline void set_has_truncate_log();
+  inline void clear_has_truncate_log();
+  inline void set_has_leader_lease();
+  inline void clear_has_leader_lease();
+  inline void set_has_reverse_scan();
+  inline void clear_has_reverse_scan();
+  inline void set_has_noop();
+  inline void clear_has_noop();
+  inline void set_has_raft_status();
+  inline void clear_has_raft_status();
+  inline void set_has_range_stats();
+  inline void clear_has_range_stats();
+  inline void set_has_admin_scatter();
+  inline void clear_has_admin_scatter();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::GetRequest* get_;
+  ::cockroach::roachpb::PutRequest* put_;
+  ::cockroach::roachpb::ConditionalPutRequest* conditional_put_;
+  ::cockroach::roachpb::IncrementRequest* increment_;
+  ::cockroach::roachpb::DeleteRequest* delete__;
+  ::cockroach::roachpb::DeleteRangeRequest* delete_range_;
+  ::cockroach::roachpb::ScanRequest* scan_;
+  ::cockroach::roachpb::BeginTransactionRequest* begin_transaction_;
+  ::cockroach::roachpb::EndTransactionRequest* end_transaction_;
+  ::cockroach::roachpb::AdminSplitRequest* admin_split_;
+  ::cockroach::roachpb::AdminMergeRequest* admin_merge_;
+  ::cockroach::roachpb::HeartbeatTxnRequest* heartbeat_txn_;
+  ::cockroach::roachpb::GCRequest* gc_;
+  ::cockroach::roachpb::PushTxnRequest* push_txn_;
+  ::cockroach::roachpb::RangeLookupRequest* range_lookup_;
+  ::cockroach::roachpb::ResolveIntentRequest* resolve_intent_;
+  ::cockroach::roachpb::ResolveIntentRangeRequest* resolve_intent_range_;
+  ::cockroach::roachpb::MergeRequest* merge_;
+  ::cockroach::roachpb::TruncateLogRequest* truncate_log_;
+  ::cockroach::roachpb::LeaderLeaseRequest* leader_lease_;
+  ::cockroach::roachpb::ReverseScanRequest* reverse_scan_;
+  ::cockroach::roachpb::NoopRequest* noop_;
+  ::cockroach::roachpb::RaftStatusRequest* raft_status_;
+  ::cockroach::roachpb::RangeStatsRequest* range_stats_;
+  ::cockroach::roachpb::AdminScatterRequest* admin_scatter_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static RequestUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ResponseUnion : public ::google::protobuf::Message {
+ public:
+  ResponseUnion();
+  virtual ~ResponseUnion();
+
+  ResponseUnion(const ResponseUnion& from);
+
+  inline ResponseUnion& operator=(const ResponseUnion& from) {
     CopyFrom(from);
     return *this;
   }
@@ -5745,19 +6032,19 @@ class BatchResponse_Header : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchResponse_Header& default_instance();
+  static const ResponseUnion& default_instance();
 
-  void Swap(BatchResponse_Header* other);
+  void Swap(ResponseUnion* other);
 
   // implements Message ----------------------------------------------
 
-  inline BatchResponse_Header* New() const { return New(NULL); }
+  inline ResponseUnion* New() const { return New(NULL); }
 
-  BatchResponse_Header* New(::google::protobuf::Arena* arena) const;
+  ResponseUnion* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchResponse_Header& from);
-  void MergeFrom(const BatchResponse_Header& from);
+  void CopyFrom(const ResponseUnion& from);
+  void MergeFrom(const ResponseUnion& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -5772,7 +6059,7 @@ class BatchResponse_Header : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(BatchResponse_Header* other);
+  void InternalSwap(ResponseUnion* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -5788,292 +6075,1526 @@ class BatchResponse_Header : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.roachpb.Error error = 1;
-  bool has_error() const;
-  void clear_error();
-  static const int kErrorFieldNumber = 1;
-  const ::cockroach::roachpb::Error& error() const;
-  ::cockroach::roachpb::Error* mutable_error();
-  ::cockroach::roachpb::Error* release_error();
-  void set_allocated_error(::cockroach::roachpb::Error* error);
+  // optional .cockroach.roachpb.GetResponse get = 1;
+  bool has_get() const;
+  void clear_get();
+  static const int kGetFieldNumber = 1;
+  const ::cockroach::roachpb::GetResponse& get() const;
+  ::cockroach::roachpb::GetResponse* mutable_get();
+  ::cockroach::roachpb::GetResponse* release_get();
+  void set_allocated_get(::cockroach::roachpb::GetResponse* get);
 
-  // optional .cockroach.roachpb.Timestamp timestamp = 2;
-  bool has_timestamp() const;
-  void clear_timestamp();
-  static const int kTimestampFieldNumber = 2;
-  const ::cockroach::roachpb::Timestamp& timestamp() const;
-  ::cockroach::roachpb::Timestamp* mutable_timestamp();
-  ::cockroach::roachpb::Timestamp* release_timestamp();
-  void set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp);
+  // optional .cockroach.roachpb.PutResponse put = 2;
+  bool has_put() const;
+  void clear_put();
+  static const int kPutFieldNumber = 2;
+  const ::cockroach::roachpb::PutResponse& put() const;
+  ::cockroach::roachpb::PutResponse* mutable_put();
+  ::cockroach::roachpb::PutResponse* release_put();
+  void set_allocated_put(::cockroach::roachpb::PutResponse* put);
 
-  // optional .cockroach.roachpb.Transaction txn = 3;
-  bool has_txn() const;
-  void clear_txn();
-  static const int kTxnFieldNumber = 3;
-  const ::cockroach::roachpb::Transaction& txn() const;
-  ::cockroach::roachpb::Transaction* mutable_txn();
-  ::cockroach::roachpb::Transaction* release_txn();
-  void set_allocated_txn(::cockroach::roachpb::Transaction* txn);
+  // optional .cockroach.roachpb.ConditionalPutResponse conditional_put = 3;
+  bool has_conditional_put() const;
+  void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 3;
+  const ::cockroach::roachpb::ConditionalPutResponse& conditional_put() const;
+  ::cockroach::roachpb::ConditionalPutResponse* mutable_conditional_put();
+  ::cockroach::roachpb::ConditionalPutResponse* release_conditional_put();
+  void set_allocated_conditional_put(::cockroach::roachpb::ConditionalPutResponse* conditional_put);
 
-  // @@protoc_insertion_point(class_scope:cockroach.roachpb.BatchResponse.Header)
+  // optional .cockroach.roachpb.IncrementResponse increment = 4;
+  bool has_increment() const;
+  void clear_increment();
+  static const int kIncrementFieldNumber = 4;
+  const ::cockroach::roachpb::IncrementResponse& increment() const;
+  ::cockroach::roachpb::IncrementResponse* mutable_increment();
+  ::cockroach::roachpb::IncrementResponse* release_increment();
+  void set_allocated_increment(::cockroach::roachpb::IncrementResponse* increment);
+
+  // optional .cockroach.roachpb.DeleteResponse delete = 5;
+  bool has_delete_() const;
+  void clear_delete_();
+  static const int kDeleteFieldNumber = 5;
+  const ::cockroach::roachpb::DeleteResponse& delete_() const;
+  ::cockroach::roachpb::DeleteResponse* mutable_delete_();
+  ::cockroach::roachpb::DeleteResponse* release_delete_();
+  void set_allocated_delete_(::cockroach::roachpb::DeleteResponse* delete_);
+
+  // optional .cockroach.roachpb.DeleteRangeResponse delete_range = 6;
+  bool has_delete_range() const;
+  void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 6;
+  const ::cockroach::roachpb::DeleteRangeResponse& delete_range() const;
+  ::cockroach::roachpb::DeleteRangeResponse* mutable_delete_range();
+  ::cockroach::roachpb::DeleteRangeResponse* release_delete_range();
+  void set_allocated_delete_range(::cockroach::roachpb::DeleteRangeResponse* delete_range);
+
+  // optional .cockroach.roachpb.ScanResponse scan = 7;
+  bool has_scan() const;
+  void clear_scan();
+  static const int kScanFieldNumber = 7;
+  const ::cockroach::roachpb::ScanResponse& scan() const;
+  ::cockroach::roachpb::ScanResponse* mutable_scan();
+  ::cockroach::roachpb::ScanResponse* release_scan();
+  void set_allocated_scan(::cockroach::roachpb::ScanResponse* scan);
+
+  // optional .cockroach.roachpb.BeginTransactionResponse begin_transaction = 8;
+  bool has_begin_transaction() const;
+  void clear_begin_transaction();
+  static const int kBeginTransactionFieldNumber = 8;
+  const ::cockroach::roachpb::BeginTransactionResponse& begin_transaction() const;
+  ::cockroach::roachpb::BeginTransactionResponse* mutable_begin_transaction();
+  ::cockroach::roachpb::BeginTransactionResponse* release_begin_transaction();
+  void set_allocated_begin_transaction(::cockroach::roachpb::BeginTransactionResponse* begin_transaction);
+
+  // optional .cockroach.roachpb.EndTransactionResponse end_transaction = 9;
+  bool has_end_transaction() const;
+  void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  const ::cockroach::roachpb::EndTransactionResponse& end_transaction() const;
+  ::cockroach::roachpb::EndTransactionResponse* mutable_end_transaction();
+  ::cockroach::roachpb::EndTransactionResponse* release_end_transaction();
+  void set_allocated_end_transaction(::cockroach::roachpb::EndTransactionResponse* end_transaction);
+
+  // optional .cockroach.roachpb.AdminSplitResponse admin_split = 10;
+  bool has_admin_split() const;
+  void clear_admin_split();
+  static const int kAdminSplitFieldNumber = 10;
+  const ::cockroach::roachpb::AdminSplitResponse& admin_split() const;
+  ::cockroach::roachpb::AdminSplitResponse* mutable_admin_split();
+  ::cockroach::roachpb::AdminSplitResponse* release_admin_split();
+  void set_allocated_admin_split(::cockroach::roachpb::AdminSplitResponse* admin_split);
+
+  // optional .cockroach.roachpb.AdminMergeResponse admin_merge = 11;
+  bool has_admin_merge() const;
+  void clear_admin_merge();
+  static const int kAdminMergeFieldNumber = 11;
+  const ::cockroach::roachpb::AdminMergeResponse& admin_merge() const;
+  ::cockroach::roachpb::AdminMergeResponse* mutable_admin_merge();
+  ::cockroach::roachpb::AdminMergeResponse* release_admin_merge();
+  void set_allocated_admin_merge(::cockroach::roachpb::AdminMergeResponse* admin_merge);
+
+  // optional .cockroach.roachpb.HeartbeatTxnResponse heartbeat_txn = 12;
+  bool has_heartbeat_txn() const;
+  void clear_heartbeat_txn();
+  static const int kHeartbeatTxnFieldNumber = 12;
+  const ::cockroach::roachpb::HeartbeatTxnResponse& heartbeat_txn() const;
+  ::cockroach::roachpb::HeartbeatTxnResponse* mutable_heartbeat_txn();
+  ::cockroach::roachpb::HeartbeatTxnResponse* release_heartbeat_txn();
+  void set_allocated_heartbeat_txn(::cockroach::roachpb::HeartbeatTxnResponse* heartbeat_txn);
+
+  // optional .cockroach.roachpb.GCResponse gc = 13;
+  bool has_gc() const;
+  void clear_gc();
+  static const int kGcFieldNumber = 13;
+  const ::cockroach::roachpb::GCResponse& gc() const;
+  ::cockroach::roachpb::GCResponse* mutable_gc();
+  ::cockroach::roachpb::GCResponse* release_gc();
+  void set_allocated_gc(::cockroach::roachpb::GCResponse* gc);
+
+  // optional .cockroach.roachpb.PushTxnResponse push_txn = 14;
+  bool has_push_txn() const;
+  void clear_push_txn();
+  static const int kPushTxnFieldNumber = 14;
+  const ::cockroach::roachpb::PushTxnResponse& push_txn() const;
+  ::cockroach::roachpb::PushTxnResponse* mutable_push_txn();
+  ::cockroach::roachpb::PushTxnResponse* release_push_txn();
+  void set_allocated_push_txn(::cockroach::roachpb::PushTxnResponse* push_txn);
+
+  // optional .cockroach.roachpb.RangeLookupResponse range_lookup = 15;
+  bool has_range_lookup() const;
+  void clear_range_lookup();
+  static const int kRangeLookupFieldNumber = 15;
+  const ::cockroach::roachpb::RangeLookupResponse& range_lookup() const;
+  ::cockroach::roachpb::RangeLookupResponse* mutable_range_lookup();
+  ::cockroach::roachpb::RangeLookupResponse* release_range_lookup();
+  void set_allocated_range_lookup(::cockroach::roachpb::RangeLookupResponse* range_lookup);
+
+  // optional .cockroach.roachpb.ResolveIntentResponse resolve_intent = 16;
+  bool has_resolve_intent() const;
+  void clear_resolve_intent();
+  static const int kResolveIntentFieldNumber = 16;
+  const ::cockroach::roachpb::ResolveIntentResponse& resolve_intent() const;
+  ::cockroach::roachpb::ResolveIntentResponse* mutable_resolve_intent();
+  ::cockroach::roachpb::ResolveIntentResponse* release_resolve_intent();
+  void set_allocated_resolve_intent(::cockroach::roachpb::ResolveIntentResponse* resolve_intent);
+
+  // optional .cockroach.roachpb.ResolveIntentRangeResponse resolve_intent_range = 17;
+  bool has_resolve_intent_range() const;
+  void clear_resolve_intent_range();
+  static const int kResolveIntentRangeFieldNumber = 17;
+  const ::cockroach::roachpb::ResolveIntentRangeResponse& resolve_intent_range() const;
+  ::cockroach::roachpb::ResolveIntentRangeResponse* mutable_resolve_intent_range();
+  ::cockroach::roachpb::ResolveIntentRangeResponse* release_resolve_intent_range();
+  void set_allocated_resolve_intent_range(::cockroach::roachpb::ResolveIntentRangeResponse* resolve_intent_range);
+
+  // optional .cockroach.roachpb.MergeResponse merge = 18;
+  bool has_merge() const;
+  void clear_merge();
+  static const int kMergeFieldNumber = 18;
+  const ::cockroach::roachpb::MergeResponse& merge() const;
+  ::cockroach::roachpb::MergeResponse* mutable_merge();
+  ::cockroach::roachpb::MergeResponse* release_merge();
+  void set_allocated_merge(::cockroach::roachpb::MergeResponse* merge);
+
+  // optional .cockroach.roachpb.TruncateLogResponse truncate_log = 19;
+  bool has_truncate_log() const;
+  void clear_truncate_log();
+  static const int kTruncateLogFieldNumber = 19;
+  const ::cockroach::roachpb::TruncateLogResponse& truncate_log() const;
+  ::cockroach::roachpb::TruncateLogResponse* mutable_truncate_log();
+  ::cockroach::roachpb::TruncateLogResponse* release_truncate_log();
+  void set_allocated_truncate_log(::cockroach::roachpb::TruncateLogResponse* truncate_log);
+
+  // optional .cockroach.roachpb.LeaderLeaseResponse leader_lease = 20;
+  bool has_leader_lease() const;
+  void clear_leader_lease();
+  static const int kLeaderLeaseFieldNumber = 20;
+  const ::cockroach::roachpb::LeaderLeaseResponse& leader_lease() const;
+  ::cockroach::roachpb::LeaderLeaseResponse* mutable_leader_lease();
+  ::cockroach::roachpb::LeaderLeaseResponse* release_leader_lease();
+  void set_allocated_leader_lease(::cockroach::roachpb::LeaderLeaseResponse* leader_lease);
+
+  // optional .cockroach.roachpb.ReverseScanResponse reverse_scan = 21;
+  bool has_reverse_scan() const;
+  void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 21;
+  const ::cockroach::roachpb::ReverseScanResponse& reverse_scan() const;
+  ::cockroach::roachpb::ReverseScanResponse* mutable_reverse_scan();
+  ::cockroach::roachpb::ReverseScanResponse* release_reverse_scan();
+  void set_allocated_reverse_scan(::cockroach::roachpb::ReverseScanResponse* reverse_scan);
+
+  // optional .cockroach.roachpb.NoopResponse noop = 22;
+  bool has_noop() const;
+  void clear_noop();
+  static const int kNoopFieldNumber = 22;
+  const ::cockroach::roachpb::NoopResponse& noop() const;
+  ::cockroach::roachpb::NoopResponse* mutable_noop();
+  ::cockroach::roachpb::NoopResponse* release_noop();
+  void set_allocated_noop(::cockroach::roachpb::NoopResponse* noop);
+
+  // optional .cockroach.roachpb.RaftStatusResponse raft_status = 23;
+  bool has_raft_status() const;
+  void clear_raft_status();
+  static const int kRaftStatusFieldNumber = 23;
+  const ::cockroach::roachpb::RaftStatusResponse& raft_status() const;
+  ::cockroach::roachpb::RaftStatusResponse* mutable_raft_status();
+  ::cockroach::roachpb::RaftStatusResponse* release_raft_status();
+  void set_allocated_raft_status(::cockroach::roachpb::RaftStatusResponse* raft_status);
+
+  // optional .cockroach.roachpb.RangeStatsResponse range_stats = 24;
+  bool has_range_stats() const;
+  void clear_range_stats();
+  static const int kRangeStatsFieldNumber = 24;
+  const ::cockroach::roachpb::RangeStatsResponse& range_stats() const;
+  ::cockroach::roachpb::RangeStatsResponse* mutable_range_stats();
+  ::cockroach::roachpb::RangeStatsResponse* release_range_stats();
+  void set_allocated_range_stats(::cockroach::roachpb::RangeStatsResponse* range_stats);
+
+  // optional .cockroach.roachpb.AdminScatterResponse admin_scatter = 25;
+  bool has_admin_scatter() const;
+  void clear_admin_scatter();
+  static const int kAdminScatterFieldNumber = 25;
+  const ::cockroach::roachpb::AdminScatterResponse& admin_scatter() const;
+  ::cockroach::roachpb::AdminScatterResponse* mutable_admin_scatter();
+  ::cockroach::roachpb::AdminScatterResponse* release_admin_scatter();
+  void set_allocated_admin_scatter(::cockroach::roachpb::AdminScatterResponse* admin_scatter);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.ResponseUnion)
  private:
-  inline void set_has_error();
-  inline void clear_has_error();
-  inline void set_has_timestamp();
-  inline void clear_has_timestamp();
-  inline void set_has_txn();
-  inline void clear_has_txn();
+  inline void set_has_get();
+  inline void clear_has_get();
+  inline void set_has_put();
+  inline void clear_has_put();
+  inline void set_has_conditional_put();
+  inline void clear_has_conditional_put();
+  inline void set_has_increment();
+  inline void clear_has_increment();
+  inline void set_has_delete_();
+  inline void clear_has_delete_();
+  inline void set_has_delete_range();
+  inline void clear_has_delete_range();
+  inline void set_has_scan();
+  inline void clear_has_scan();
+  inline void set_has_begin_transaction();
+  inline void clear_has_begin_transaction();
+  inline void set_has_end_transaction();
+  inline void clear_has_end_transaction();
+  inline void set_has_admin_split();
+  inline void clear_has_admin_split();
+  inline void set_has_admin_merge();
+  inline void clear_has_admin_merge();
+  inline void set_has_heartbeat_txn();
+  inline void clear_has_heartbeat_txn();
+  inline void set_has_gc();
+  inline void clear_has_gc();
+  inline void set_has_push_txn();
+  inline void clear_has_push_txn();
+  inline void set_has_range_lookup();
+  inline void clear_has_range_lookup();
+  inline void set_has_resolve_intent();
+  inline void clear_has_resolve_intent();
+  inline void set_has_resolve_intent_range();
+  inline void clear_has_resolve_intent_range();
+  inline void set_has_merge();
+  inline void clear_has_merge();
+  inline void set_has_truncate_log();
+  inline void clear_has_truncate_log();
+  inline void set_has_leader_lease();
+  inline void clear_has_leader_lease();
+  inline void set_has_reverse_scan();
+  inline void clear_has_reverse_scan();
+  inline void set_has_noop();
+  inline void clear_has_noop();
+  inline void set_has_raft_status();
+  inline void clear_has_raft_status();
+  inline void set_has_range_stats();
+  inline void clear_has_range_stats();
+  inline void set_has_admin_scatter();
+  inline void clear_has_admin_scatter();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::GetResponse* get_;
+  ::cockroach::roachpb::PutResponse* put_;
+  ::cockroach::roachpb::ConditionalPutResponse* conditional_put_;
+  ::cockroach::roachpb::IncrementResponse* increment_;
+  ::cockroach::roachpb::DeleteResponse* delete__;
+  ::cockroach::roachpb::DeleteRangeResponse* delete_range_;
+  ::cockroach::roachpb::ScanResponse* scan_;
+  ::cockroach::roachpb::BeginTransactionResponse* begin_transaction_;
+  ::cockroach::roachpb::EndTransactionResponse* end_transaction_;
+  ::cockroach::roachpb::AdminSplitResponse* admin_split_;
+  ::cockroach::roachpb::AdminMergeResponse* admin_merge_;
+  ::cockroach::roachpb::HeartbeatTxnResponse* heartbeat_txn_;
+  ::cockroach::roachpb::GCResponse* gc_;
+  ::cockroach::roachpb::PushTxnResponse* push_txn_;
+  ::cockroach::roachpb::RangeLookupResponse* range_lookup_;
+  ::cockroach::roachpb::ResolveIntentResponse* resolve_intent_;
+  ::cockroach::roachpb::ResolveIntentRangeResponse* resolve_intent_range_;
+  ::cockroach::roachpb::MergeResponse* merge_;
+  ::cockroach::roachpb::TruncateLogResponse* truncate_log_;
+  ::cockroach::roachpb::LeaderLeaseResponse* leader_lease_;
+  ::cockroach::roachpb::ReverseScanResponse* reverse_scan_;
+  ::cockroach::roachpb::NoopResponse* noop_;
+  ::cockroach::roachpb::RaftStatusResponse* raft_status_;
+  ::cockroach::roachpb::RangeStatsResponse* range_stats_;
+  ::cockroach::roachpb::AdminScatterResponse* admin_scatter_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ResponseUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class Header : public ::google::protobuf::Message {
+ public:
+  Header();
+  virtual ~Header();
+
+  Header(const Header& from);
+
+  inline Header& operator=(const Header& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const Header& default_instance();
+
+  void Swap(Header* other);
+
+  // implements Message ----------------------------------------------
+
+  inline Header* New() const { return New(NULL); }
+
+  Header* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const Header& from);
+  void MergeFrom(const Header& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(Header* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.Timestamp timestamp = 1;
+  bool has_timestamp() const;
+  void clear_timestamp();
+  static const int kTimestampFieldNumber = 1;
+  const ::cockroach::roachpb::Timestamp& timestamp() const;
+  ::cockroach::roachpb::Timestamp* mutable_timestamp();
+  ::cockroach::roachpb::Timestamp* release_timestamp();
+  void set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp);
+
+  // optional .cockroach.roachpb.ReplicaDescriptor replica = 2;
+  bool has_replica() const;
+  void clear_replica();
+  static const int kReplicaFieldNumber = 2;
+  const ::cockroach::roachpb::ReplicaDescriptor& replica() const;
+  ::cockroach::roachpb::ReplicaDescriptor* mutable_replica();
+  ::cockroach::roachpb::ReplicaDescriptor* release_replica();
+  void set_allocated_replica(::cockroach::roachpb::ReplicaDescriptor* replica);
+
+  // optional int64 range_id = 3;
+  bool has_range_id() const;
+  void clear_range_id();
+  static const int kRangeIdFieldNumber = 3;
+  ::google::protobuf::int64 range_id() const;
+  void set_range_id(::google::protobuf::int64 value);
+
+  // optional double user_priority = 4;
+  bool has_user_priority() const;
+  void clear_user_priority();
+  static const int kUserPriorityFieldNumber = 4;
+  double user_priority() const;
+  void set_user_priority(double value);
+
+  // optional .cockroach.roachpb.Transaction txn = 5;
+  bool has_txn() const;
+  void clear_txn();
+  static const int kTxnFieldNumber = 5;
+  const ::cockroach::roachpb::Transaction& txn() const;
+  ::cockroach::roachpb::Transaction* mutable_txn();
+  ::cockroach::roachpb::Transaction* release_txn();
+  void set_allocated_txn(::cockroach::roachpb::Transaction* txn);
+
+  // optional .cockroach.roachpb.ReadConsistencyType read_consistency = 6;
+  bool has_read_consistency() const;
+  void clear_read_consistency();
+  static const int kReadConsistencyFieldNumber = 6;
+  ::cockroach::roachpb::ReadConsistencyType read_consistency() const;
+  void set_read_consistency(::cockroach::roachpb::ReadConsistencyType value);
+
+  // optional uint64 trace_context = 7;
+  bool has_trace_context() const;
+  void clear_trace_context();
+  static const int kTraceContextFieldNumber = 7;
+  ::google::protobuf::uint64 trace_context() const;
+  void set_trace_context(::google::protobuf::uint64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.Header)
+ private:
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_replica();
+  inline void clear_has_replica();
+  inline void set_has_range_id();
+  inline void clear_has_range_id();
+  inline void set_has_user_priority();
+  inline void clear_has_user_priority();
+  inline void set_has_txn();
+  inline void clear_has_txn();
+  inline void set_has_read_consistency();
+  inline void clear_has_read_consistency();
+  inline void set_has_trace_context();
+  inline void clear_has_trace_context();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::roachpb::Error* error_;
   ::cockroach::roachpb::Timestamp* timestamp_;
+  ::cockroach::roachpb::ReplicaDescriptor* replica_;
+  ::google::protobuf::int64 range_id_;
+  double user_priority_;
   ::cockroach::roachpb::Transaction* txn_;
+  ::google::protobuf::uint64 trace_context_;
+  int read_consistency_;
   friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
 
-  void InitAsDefaultInstance();
-  static BatchResponse_Header* default_instance_;
-};
+  void InitAsDefaultInstance();
+  static Header* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchRequest : public ::google::protobuf::Message {
+ public:
+  BatchRequest();
+  virtual ~BatchRequest();
+
+  BatchRequest(const BatchRequest& from);
+
+  inline BatchRequest& operator=(const BatchRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchRequest& default_instance();
+
+  void Swap(BatchRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline BatchRequest* New() const { return New(NULL); }
+
+  BatchRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchRequest& from);
+  void MergeFrom(const BatchRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(BatchRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.Header header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::Header& header() const;
+  ::cockroach::roachpb::Header* mutable_header();
+  ::cockroach::roachpb::Header* release_header();
+  void set_allocated_header(::cockroach::roachpb::Header* header);
+
+  // repeated .cockroach.roachpb.RequestUnion requests = 2;
+  int requests_size() const;
+  void clear_requests();
+  static const int kRequestsFieldNumber = 2;
+  const ::cockroach::roachpb::RequestUnion& requests(int index) const;
+  ::cockroach::roachpb::RequestUnion* mutable_requests(int index);
+  ::cockroach::roachpb::RequestUnion* add_requests();
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RequestUnion >*
+      mutable_requests();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RequestUnion >&
+      requests() const;
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.BatchRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::Header* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RequestUnion > requests_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchResponse_Header : public ::google::protobuf::Message {
+ public:
+  BatchResponse_Header();
+  virtual ~BatchResponse_Header();
+
+  BatchResponse_Header(const BatchResponse_Header& from);
+
+  inline BatchResponse_Header& operator=(const BatchResponse_Header& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchResponse_Header& default_instance();
+
+  void Swap(BatchResponse_Header* other);
+
+  // implements Message ----------------------------------------------
+
+  inline BatchResponse_Header* New() const { return New(NULL); }
+
+  BatchResponse_Header* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchResponse_Header& from);
+  void MergeFrom(const BatchResponse_Header& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(BatchResponse_Header* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.Error error = 1;
+  bool has_error() const;
+  void clear_error();
+  static const int kErrorFieldNumber = 1;
+  const ::cockroach::roachpb::Error& error() const;
+  ::cockroach::roachpb::Error* mutable_error();
+  ::cockroach::roachpb::Error* release_error();
+  void set_allocated_error(::cockroach::roachpb::Error* error);
+
+  // optional .cockroach.roachpb.Timestamp timestamp = 2;
+  bool has_timestamp() const;
+  void clear_timestamp();
+  static const int kTimestampFieldNumber = 2;
+  const ::cockroach::roachpb::Timestamp& timestamp() const;
+  ::cockroach::roachpb::Timestamp* mutable_timestamp();
+  ::cockroach::roachpb::Timestamp* release_timestamp();
+  void set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp);
+
+  // optional .cockroach.roachpb.Transaction txn = 3;
+  bool has_txn() const;
+  void clear_txn();
+  static const int kTxnFieldNumber = 3;
+  const ::cockroach::roachpb::Transaction& txn() const;
+  ::cockroach::roachpb::Transaction* mutable_txn();
+  ::cockroach::roachpb::Transaction* release_txn();
+  void set_allocated_txn(::cockroach::roachpb::Transaction* txn);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.BatchResponse.Header)
+ private:
+  inline void set_has_error();
+  inline void clear_has_error();
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_txn();
+  inline void clear_has_txn();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::Error* error_;
+  ::cockroach::roachpb::Timestamp* timestamp_;
+  ::cockroach::roachpb::Transaction* txn_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchResponse_Header* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchResponse : public ::google::protobuf::Message {
+ public:
+  BatchResponse();
+  virtual ~BatchResponse();
+
+  BatchResponse(const BatchResponse& from);
+
+  inline BatchResponse& operator=(const BatchResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchResponse& default_instance();
+
+  void Swap(BatchResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline BatchResponse* New() const { return New(NULL); }
+
+  BatchResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchResponse& from);
+  void MergeFrom(const BatchResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(BatchResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  typedef BatchResponse_Header Header;
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.BatchResponse.Header header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::BatchResponse_Header& header() const;
+  ::cockroach::roachpb::BatchResponse_Header* mutable_header();
+  ::cockroach::roachpb::BatchResponse_Header* release_header();
+  void set_allocated_header(::cockroach::roachpb::BatchResponse_Header* header);
+
+  // repeated .cockroach.roachpb.ResponseUnion responses = 2;
+  int responses_size() const;
+  void clear_responses();
+  static const int kResponsesFieldNumber = 2;
+  const ::cockroach::roachpb::ResponseUnion& responses(int index) const;
+  ::cockroach::roachpb::ResponseUnion* mutable_responses(int index);
+  ::cockroach::roachpb::ResponseUnion* add_responses();
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::ResponseUnion >*
+      mutable_responses();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::ResponseUnion >&
+      responses() const;
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.BatchResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::BatchResponse_Header* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::ResponseUnion > responses_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchResponse* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+#if !PROTOBUF_INLINE_NOT_IN_HEADERS
+// ResponseHeader
+
+// optional .cockroach.roachpb.Timestamp timestamp = 2;
+inline bool ResponseHeader::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ResponseHeader::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ResponseHeader::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ResponseHeader::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::roachpb::Timestamp& ResponseHeader::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseHeader.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::roachpb::Timestamp* ResponseHeader::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::roachpb::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseHeader.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::roachpb::Timestamp* ResponseHeader::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::roachpb::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseHeader.timestamp)
+}
+
+// optional .cockroach.roachpb.Transaction txn = 3;
+inline bool ResponseHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ResponseHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ResponseHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ResponseHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::cockroach::roachpb::Transaction& ResponseHeader::txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::cockroach::roachpb::Transaction* ResponseHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) {
+    txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseHeader.txn)
+  return txn_;
+}
+inline ::cockroach::roachpb::Transaction* ResponseHeader::release_txn() {
+  clear_has_txn();
+  ::cockroach::roachpb::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_txn(::cockroach::roachpb::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseHeader.txn)
+}
+
+// -------------------------------------------------------------------
+
+// GetRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool GetRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::Span& GetRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GetRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::Span* GetRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GetRequest.header)
+  return header_;
+}
+inline ::cockroach::roachpb::Span* GetRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GetRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// GetResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool GetResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::ResponseHeader& GetResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GetResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* GetResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GetResponse.header)
+  return header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* GetResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GetResponse.header)
+}
+
+// optional .cockroach.roachpb.Value value = 2;
+inline bool GetResponse::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void GetResponse::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void GetResponse::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void GetResponse::clear_value() {
+  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::roachpb::Value& GetResponse::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GetResponse.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::roachpb::Value* GetResponse::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::roachpb::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GetResponse.value)
+  return value_;
+}
+inline ::cockroach::roachpb::Value* GetResponse::release_value() {
+  clear_has_value();
+  ::cockroach::roachpb::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_value(::cockroach::roachpb::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GetResponse.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool PutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void PutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::Span& PutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::Span* PutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PutRequest.header)
+  return header_;
+}
+inline ::cockroach::roachpb::Span* PutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void PutRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PutRequest.header)
+}
+
+// optional .cockroach.roachpb.Value value = 2;
+inline bool PutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void PutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void PutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void PutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::roachpb::Value& PutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::roachpb::Value* PutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::roachpb::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PutRequest.value)
+  return value_;
+}
+inline ::cockroach::roachpb::Value* PutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::roachpb::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void PutRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PutRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool PutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void PutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::ResponseHeader& PutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* PutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PutResponse.header)
+  return header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* PutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void PutResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PutResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// ConditionalPutRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool ConditionalPutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ConditionalPutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ConditionalPutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ConditionalPutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::Span& ConditionalPutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::Span* ConditionalPutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutRequest.header)
+  return header_;
+}
+inline ::cockroach::roachpb::Span* ConditionalPutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutRequest.header)
+}
+
+// optional .cockroach.roachpb.Value value = 2;
+inline bool ConditionalPutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ConditionalPutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ConditionalPutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ConditionalPutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::roachpb::Value& ConditionalPutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::roachpb::Value* ConditionalPutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::roachpb::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutRequest.value)
+  return value_;
+}
+inline ::cockroach::roachpb::Value* ConditionalPutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::roachpb::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutRequest.value)
+}
+
+// optional .cockroach.roachpb.Value exp_value = 3;
+inline bool ConditionalPutRequest::has_exp_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ConditionalPutRequest::set_has_exp_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ConditionalPutRequest::clear_has_exp_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ConditionalPutRequest::clear_exp_value() {
+  if (exp_value_ != NULL) exp_value_->::cockroach::roachpb::Value::Clear();
+  clear_has_exp_value();
+}
+inline const ::cockroach::roachpb::Value& ConditionalPutRequest::exp_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutRequest.exp_value)
+  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
+}
+inline ::cockroach::roachpb::Value* ConditionalPutRequest::mutable_exp_value() {
+  set_has_exp_value();
+  if (exp_value_ == NULL) {
+    exp_value_ = new ::cockroach::roachpb::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutRequest.exp_value)
+  return exp_value_;
+}
+inline ::cockroach::roachpb::Value* ConditionalPutRequest::release_exp_value() {
+  clear_has_exp_value();
+  ::cockroach::roachpb::Value* temp = exp_value_;
+  exp_value_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::roachpb::Value* exp_value) {
+  delete exp_value_;
+  exp_value_ = exp_value;
+  if (exp_value) {
+    set_has_exp_value();
+  } else {
+    clear_has_exp_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutRequest.exp_value)
+}
+
 // -------------------------------------------------------------------
 
-class BatchResponse : public ::google::protobuf::Message {
- public:
-  BatchResponse();
-  virtual ~BatchResponse();
-
-  BatchResponse(const BatchResponse& from);
-
-  inline BatchResponse& operator=(const BatchResponse& from) {
-    CopyFrom(from);
-    return *this;
-  }
+// ConditionalPutResponse
 
-  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
-    return _internal_metadata_.unknown_fields();
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool ConditionalPutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ConditionalPutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ConditionalPutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ConditionalPutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::ResponseHeader& ConditionalPutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* ConditionalPutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-
-  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
-    return _internal_metadata_.mutable_unknown_fields();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutResponse.header)
+  return header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* ConditionalPutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ConditionalPutResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutResponse.header)
+}
 
-  static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchResponse& default_instance();
-
-  void Swap(BatchResponse* other);
-
-  // implements Message ----------------------------------------------
-
-  inline BatchResponse* New() const { return New(NULL); }
+// -------------------------------------------------------------------
 
-  BatchResponse* New(::google::protobuf::Arena* arena) const;
-  void CopyFrom(const ::google::protobuf::Message& from);
-  void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchResponse& from);
-  void MergeFrom(const BatchResponse& from);
-  void Clear();
-  bool IsInitialized() const;
+// IncrementRequest
 
-  int ByteSize() const;
-  bool MergePartialFromCodedStream(
-      ::google::protobuf::io::CodedInputStream* input);
-  void SerializeWithCachedSizes(
-      ::google::protobuf::io::CodedOutputStream* output) const;
-  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
-  int GetCachedSize() const { return _cached_size_; }
-  private:
-  void SharedCtor();
-  void SharedDtor();
-  void SetCachedSize(int size) const;
-  void InternalSwap(BatchResponse* other);
-  private:
-  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
-    return _internal_metadata_.arena();
+// optional .cockroach.roachpb.Span header = 1;
+inline bool IncrementRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void IncrementRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void IncrementRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void IncrementRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::Span& IncrementRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::Span* IncrementRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
   }
-  inline void* MaybeArenaPtr() const {
-    return _internal_metadata_.raw_arena_ptr();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.IncrementRequest.header)
+  return header_;
+}
+inline ::cockroach::roachpb::Span* IncrementRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void IncrementRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
-  public:
-
-  ::google::protobuf::Metadata GetMetadata() const;
-
-  // nested types ----------------------------------------------------
-
-  typedef BatchResponse_Header Header;
-
-  // accessors -------------------------------------------------------
-
-  // optional .cockroach.roachpb.BatchResponse.Header header = 1;
-  bool has_header() const;
-  void clear_header();
-  static const int kHeaderFieldNumber = 1;
-  const ::cockroach::roachpb::BatchResponse_Header& header() const;
-  ::cockroach::roachpb::BatchResponse_Header* mutable_header();
-  ::cockroach::roachpb::BatchResponse_Header* release_header();
-  void set_allocated_header(::cockroach::roachpb::BatchResponse_Header* header);
-
-  // repeated .cockroach.roachpb.ResponseUnion responses = 2;
-  int responses_size() const;
-  void clear_responses();
-  static const int kResponsesFieldNumber = 2;
-  const ::cockroach::roachpb::ResponseUnion& responses(int index) const;
-  ::cockroach::roachpb::ResponseUnion* mutable_responses(int index);
-  ::cockroach::roachpb::ResponseUnion* add_responses();
-  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::ResponseUnion >*
-      mutable_responses();
-  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::ResponseUnion >&
-      responses() const;
-
-  // @@protoc_insertion_point(class_scope:cockroach.roachpb.BatchResponse)
- private:
-  inline void set_has_header();
-  inline void clear_has_header();
-
-  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
-  ::google::protobuf::uint32 _has_bits_[1];
-  mutable int _cached_size_;
-  ::cockroach::roachpb::BatchResponse_Header* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::ResponseUnion > responses_;
-  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
-  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
-  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
-
-  void InitAsDefaultInstance();
-  static BatchResponse* default_instance_;
-};
-// ===================================================================
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.IncrementRequest.header)
+}
 
+// optional int64 increment = 2;
+inline bool IncrementRequest::has_increment() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void IncrementRequest::set_has_increment() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void IncrementRequest::clear_has_increment() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void IncrementRequest::clear_increment() {
+  increment_ = GOOGLE_LONGLONG(0);
+  clear_has_increment();
+}
+inline ::google::protobuf::int64 IncrementRequest::increment() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementRequest.increment)
+  return increment_;
+}
+inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
+  set_has_increment();
+  increment_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.IncrementRequest.increment)
+}
 
-// ===================================================================
+// -------------------------------------------------------------------
 
-#if !PROTOBUF_INLINE_NOT_IN_HEADERS
-// ResponseHeader
+// IncrementResponse
 
-// optional .cockroach.roachpb.Timestamp timestamp = 2;
-inline bool ResponseHeader::has_timestamp() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool IncrementResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseHeader::set_has_timestamp() {
+inline void IncrementResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseHeader::clear_has_timestamp() {
+inline void IncrementResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResponseHeader::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_timestamp();
+inline void IncrementResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::roachpb::Timestamp& ResponseHeader::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseHeader.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline const ::cockroach::roachpb::ResponseHeader& IncrementResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Timestamp* ResponseHeader::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) {
-    timestamp_ = new ::cockroach::roachpb::Timestamp;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseHeader.timestamp)
-  return timestamp_;
+inline ::cockroach::roachpb::ResponseHeader* IncrementResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.IncrementResponse.header)
+  return header_;
 }
-inline ::cockroach::roachpb::Timestamp* ResponseHeader::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::roachpb::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::roachpb::ResponseHeader* IncrementResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void ResponseHeader::set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void IncrementResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_timestamp();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseHeader.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.IncrementResponse.header)
 }
 
-// optional .cockroach.roachpb.Transaction txn = 3;
-inline bool ResponseHeader::has_txn() const {
+// optional int64 new_value = 2;
+inline bool IncrementResponse::has_new_value() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseHeader::set_has_txn() {
+inline void IncrementResponse::set_has_new_value() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ResponseHeader::clear_has_txn() {
+inline void IncrementResponse::clear_has_new_value() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ResponseHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_txn();
-}
-inline const ::cockroach::roachpb::Transaction& ResponseHeader::txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
-}
-inline ::cockroach::roachpb::Transaction* ResponseHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) {
-    txn_ = new ::cockroach::roachpb::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseHeader.txn)
-  return txn_;
+inline void IncrementResponse::clear_new_value() {
+  new_value_ = GOOGLE_LONGLONG(0);
+  clear_has_new_value();
 }
-inline ::cockroach::roachpb::Transaction* ResponseHeader::release_txn() {
-  clear_has_txn();
-  ::cockroach::roachpb::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 IncrementResponse::new_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementResponse.new_value)
+  return new_value_;
 }
-inline void ResponseHeader::set_allocated_txn(::cockroach::roachpb::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseHeader.txn)
+inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
+  set_has_new_value();
+  new_value_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.IncrementResponse.new_value)
 }
 
 // -------------------------------------------------------------------
 
-// GetRequest
+// DeleteRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool GetRequest::has_header() const {
+inline bool DeleteRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GetRequest::set_has_header() {
+inline void DeleteRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GetRequest::clear_has_header() {
+inline void DeleteRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GetRequest::clear_header() {
+inline void DeleteRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& GetRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GetRequest.header)
+inline const ::cockroach::roachpb::Span& DeleteRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* GetRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* DeleteRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GetRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* GetRequest::release_header() {
+inline ::cockroach::roachpb::Span* DeleteRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GetRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void DeleteRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6081,46 +7602,46 @@ inline void GetRequest::set_allocated_header(::cockroach::roachpb::Span* header)
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GetRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// GetResponse
+// DeleteResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool GetResponse::has_header() const {
+inline bool DeleteResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GetResponse::set_has_header() {
+inline void DeleteResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GetResponse::clear_has_header() {
+inline void DeleteResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GetResponse::clear_header() {
+inline void DeleteResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& GetResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GetResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& DeleteResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* GetResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* DeleteResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GetResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* GetResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* DeleteResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GetResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void DeleteResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6128,89 +7649,46 @@ inline void GetResponse::set_allocated_header(::cockroach::roachpb::ResponseHead
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GetResponse.header)
-}
-
-// optional .cockroach.roachpb.Value value = 2;
-inline bool GetResponse::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void GetResponse::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void GetResponse::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void GetResponse::clear_value() {
-  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
-  clear_has_value();
-}
-inline const ::cockroach::roachpb::Value& GetResponse::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GetResponse.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
-}
-inline ::cockroach::roachpb::Value* GetResponse::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::roachpb::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GetResponse.value)
-  return value_;
-}
-inline ::cockroach::roachpb::Value* GetResponse::release_value() {
-  clear_has_value();
-  ::cockroach::roachpb::Value* temp = value_;
-  value_ = NULL;
-  return temp;
-}
-inline void GetResponse::set_allocated_value(::cockroach::roachpb::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GetResponse.value)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// PutRequest
+// DeleteRangeRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool PutRequest::has_header() const {
+inline bool DeleteRangeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PutRequest::set_has_header() {
+inline void DeleteRangeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PutRequest::clear_has_header() {
+inline void DeleteRangeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PutRequest::clear_header() {
+inline void DeleteRangeRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& PutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PutRequest.header)
+inline const ::cockroach::roachpb::Span& DeleteRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* PutRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* DeleteRangeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PutRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* PutRequest::release_header() {
+inline ::cockroach::roachpb::Span* DeleteRangeRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PutRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void DeleteRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6218,89 +7696,70 @@ inline void PutRequest::set_allocated_header(::cockroach::roachpb::Span* header)
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PutRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeRequest.header)
 }
 
-// optional .cockroach.roachpb.Value value = 2;
-inline bool PutRequest::has_value() const {
+// optional int64 max_entries_to_delete = 2;
+inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void PutRequest::set_has_value() {
+inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void PutRequest::clear_has_value() {
+inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void PutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
-  clear_has_value();
-}
-inline const ::cockroach::roachpb::Value& PutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
-}
-inline ::cockroach::roachpb::Value* PutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::roachpb::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PutRequest.value)
-  return value_;
+inline void DeleteRangeRequest::clear_max_entries_to_delete() {
+  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
+  clear_has_max_entries_to_delete();
 }
-inline ::cockroach::roachpb::Value* PutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::roachpb::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
+  return max_entries_to_delete_;
 }
-inline void PutRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PutRequest.value)
+inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
+  set_has_max_entries_to_delete();
+  max_entries_to_delete_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
 }
 
 // -------------------------------------------------------------------
 
-// PutResponse
+// DeleteRangeResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool PutResponse::has_header() const {
+inline bool DeleteRangeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PutResponse::set_has_header() {
+inline void DeleteRangeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PutResponse::clear_has_header() {
+inline void DeleteRangeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PutResponse::clear_header() {
+inline void DeleteRangeResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& PutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PutResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& DeleteRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* PutResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PutResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* PutResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PutResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void DeleteRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6308,46 +7767,70 @@ inline void PutResponse::set_allocated_header(::cockroach::roachpb::ResponseHead
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PutResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeResponse.header)
+}
+
+// optional int64 num_deleted = 2;
+inline bool DeleteRangeResponse::has_num_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void DeleteRangeResponse::set_has_num_deleted() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void DeleteRangeResponse::clear_has_num_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void DeleteRangeResponse::clear_num_deleted() {
+  num_deleted_ = GOOGLE_LONGLONG(0);
+  clear_has_num_deleted();
+}
+inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.num_deleted)
+  return num_deleted_;
+}
+inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
+  set_has_num_deleted();
+  num_deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeResponse.num_deleted)
 }
 
 // -------------------------------------------------------------------
 
-// ConditionalPutRequest
+// ScanRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool ConditionalPutRequest::has_header() const {
+inline bool ScanRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ConditionalPutRequest::set_has_header() {
+inline void ScanRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ConditionalPutRequest::clear_has_header() {
+inline void ScanRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ConditionalPutRequest::clear_header() {
+inline void ScanRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& ConditionalPutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutRequest.header)
+inline const ::cockroach::roachpb::Span& ScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* ConditionalPutRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* ScanRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* ConditionalPutRequest::release_header() {
+inline ::cockroach::roachpb::Span* ScanRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ConditionalPutRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void ScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6355,132 +7838,70 @@ inline void ConditionalPutRequest::set_allocated_header(::cockroach::roachpb::Sp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanRequest.header)
 }
 
-// optional .cockroach.roachpb.Value value = 2;
-inline bool ConditionalPutRequest::has_value() const {
+// optional int64 max_results = 2;
+inline bool ScanRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ConditionalPutRequest::set_has_value() {
+inline void ScanRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ConditionalPutRequest::clear_has_value() {
+inline void ScanRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ConditionalPutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
-  clear_has_value();
-}
-inline const ::cockroach::roachpb::Value& ConditionalPutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
-}
-inline ::cockroach::roachpb::Value* ConditionalPutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::roachpb::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutRequest.value)
-  return value_;
-}
-inline ::cockroach::roachpb::Value* ConditionalPutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::roachpb::Value* temp = value_;
-  value_ = NULL;
-  return temp;
-}
-inline void ConditionalPutRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutRequest.value)
-}
-
-// optional .cockroach.roachpb.Value exp_value = 3;
-inline bool ConditionalPutRequest::has_exp_value() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void ConditionalPutRequest::set_has_exp_value() {
-  _has_bits_[0] |= 0x00000004u;
-}
-inline void ConditionalPutRequest::clear_has_exp_value() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-inline void ConditionalPutRequest::clear_exp_value() {
-  if (exp_value_ != NULL) exp_value_->::cockroach::roachpb::Value::Clear();
-  clear_has_exp_value();
-}
-inline const ::cockroach::roachpb::Value& ConditionalPutRequest::exp_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutRequest.exp_value)
-  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
-}
-inline ::cockroach::roachpb::Value* ConditionalPutRequest::mutable_exp_value() {
-  set_has_exp_value();
-  if (exp_value_ == NULL) {
-    exp_value_ = new ::cockroach::roachpb::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutRequest.exp_value)
-  return exp_value_;
+inline void ScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
 }
-inline ::cockroach::roachpb::Value* ConditionalPutRequest::release_exp_value() {
-  clear_has_exp_value();
-  ::cockroach::roachpb::Value* temp = exp_value_;
-  exp_value_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 ScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.max_results)
+  return max_results_;
 }
-inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::roachpb::Value* exp_value) {
-  delete exp_value_;
-  exp_value_ = exp_value;
-  if (exp_value) {
-    set_has_exp_value();
-  } else {
-    clear_has_exp_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutRequest.exp_value)
+inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ScanRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// ConditionalPutResponse
+// ScanResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool ConditionalPutResponse::has_header() const {
+inline bool ScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ConditionalPutResponse::set_has_header() {
+inline void ScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ConditionalPutResponse::clear_has_header() {
+inline void ScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ConditionalPutResponse::clear_header() {
+inline void ScanResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& ConditionalPutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ConditionalPutResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& ScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ConditionalPutResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* ScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ConditionalPutResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ConditionalPutResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* ScanResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ConditionalPutResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void ScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6488,46 +7909,76 @@ inline void ConditionalPutResponse::set_allocated_header(::cockroach::roachpb::R
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ConditionalPutResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanResponse.header)
+}
+
+// repeated .cockroach.roachpb.KeyValue rows = 2;
+inline int ScanResponse::rows_size() const {
+  return rows_.size();
+}
+inline void ScanResponse::clear_rows() {
+  rows_.Clear();
+}
+inline const ::cockroach::roachpb::KeyValue& ScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.rows)
+  return rows_.Get(index);
+}
+inline ::cockroach::roachpb::KeyValue* ScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.rows)
+  return rows_.Mutable(index);
+}
+inline ::cockroach::roachpb::KeyValue* ScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.ScanResponse.rows)
+  return rows_.Add();
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
+ScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ScanResponse.rows)
+  return &rows_;
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
+ScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.ScanResponse.rows)
+  return rows_;
 }
 
 // -------------------------------------------------------------------
 
-// IncrementRequest
+// ReverseScanRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool IncrementRequest::has_header() const {
+inline bool ReverseScanRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void IncrementRequest::set_has_header() {
+inline void ReverseScanRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void IncrementRequest::clear_has_header() {
+inline void ReverseScanRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void IncrementRequest::clear_header() {
+inline void ReverseScanRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& IncrementRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementRequest.header)
+inline const ::cockroach::roachpb::Span& ReverseScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* IncrementRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* ReverseScanRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.IncrementRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* IncrementRequest::release_header() {
+inline ::cockroach::roachpb::Span* ReverseScanRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void IncrementRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void ReverseScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6535,70 +7986,70 @@ inline void IncrementRequest::set_allocated_header(::cockroach::roachpb::Span* h
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.IncrementRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanRequest.header)
 }
 
-// optional int64 increment = 2;
-inline bool IncrementRequest::has_increment() const {
+// optional int64 max_results = 2;
+inline bool ReverseScanRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void IncrementRequest::set_has_increment() {
+inline void ReverseScanRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void IncrementRequest::clear_has_increment() {
+inline void ReverseScanRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void IncrementRequest::clear_increment() {
-  increment_ = GOOGLE_LONGLONG(0);
-  clear_has_increment();
+inline void ReverseScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
 }
-inline ::google::protobuf::int64 IncrementRequest::increment() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementRequest.increment)
-  return increment_;
+inline ::google::protobuf::int64 ReverseScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.max_results)
+  return max_results_;
 }
-inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
-  set_has_increment();
-  increment_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.IncrementRequest.increment)
+inline void ReverseScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ReverseScanRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// IncrementResponse
+// ReverseScanResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool IncrementResponse::has_header() const {
+inline bool ReverseScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void IncrementResponse::set_has_header() {
+inline void ReverseScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void IncrementResponse::clear_has_header() {
+inline void ReverseScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void IncrementResponse::clear_header() {
+inline void ReverseScanResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& IncrementResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& ReverseScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* IncrementResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.IncrementResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* IncrementResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void IncrementResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void ReverseScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6606,70 +8057,76 @@ inline void IncrementResponse::set_allocated_header(::cockroach::roachpb::Respon
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.IncrementResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanResponse.header)
 }
 
-// optional int64 new_value = 2;
-inline bool IncrementResponse::has_new_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// repeated .cockroach.roachpb.KeyValue rows = 2;
+inline int ReverseScanResponse::rows_size() const {
+  return rows_.size();
 }
-inline void IncrementResponse::set_has_new_value() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ReverseScanResponse::clear_rows() {
+  rows_.Clear();
 }
-inline void IncrementResponse::clear_has_new_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline const ::cockroach::roachpb::KeyValue& ReverseScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.rows)
+  return rows_.Get(index);
 }
-inline void IncrementResponse::clear_new_value() {
-  new_value_ = GOOGLE_LONGLONG(0);
-  clear_has_new_value();
+inline ::cockroach::roachpb::KeyValue* ReverseScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.rows)
+  return rows_.Mutable(index);
 }
-inline ::google::protobuf::int64 IncrementResponse::new_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.IncrementResponse.new_value)
-  return new_value_;
+inline ::cockroach::roachpb::KeyValue* ReverseScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.ReverseScanResponse.rows)
+  return rows_.Add();
 }
-inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
-  set_has_new_value();
-  new_value_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.IncrementResponse.new_value)
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
+ReverseScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ReverseScanResponse.rows)
+  return &rows_;
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
+ReverseScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.ReverseScanResponse.rows)
+  return rows_;
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRequest
+// BeginTransactionRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool DeleteRequest::has_header() const {
+inline bool BeginTransactionRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRequest::set_has_header() {
+inline void BeginTransactionRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRequest::clear_has_header() {
+inline void BeginTransactionRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRequest::clear_header() {
+inline void BeginTransactionRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& DeleteRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRequest.header)
+inline const ::cockroach::roachpb::Span& BeginTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.BeginTransactionRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* DeleteRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* BeginTransactionRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.BeginTransactionRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* DeleteRequest::release_header() {
+inline ::cockroach::roachpb::Span* BeginTransactionRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void BeginTransactionRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6677,46 +8134,46 @@ inline void DeleteRequest::set_allocated_header(::cockroach::roachpb::Span* head
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.BeginTransactionRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteResponse
+// BeginTransactionResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool DeleteResponse::has_header() const {
+inline bool BeginTransactionResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteResponse::set_has_header() {
+inline void BeginTransactionResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteResponse::clear_has_header() {
+inline void BeginTransactionResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteResponse::clear_header() {
+inline void BeginTransactionResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& DeleteResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& BeginTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.BeginTransactionResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* DeleteResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* BeginTransactionResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.BeginTransactionResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* DeleteResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* BeginTransactionResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void BeginTransactionResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6724,46 +8181,46 @@ inline void DeleteResponse::set_allocated_header(::cockroach::roachpb::ResponseH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.BeginTransactionResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRangeRequest
+// EndTransactionRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool DeleteRangeRequest::has_header() const {
+inline bool EndTransactionRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeRequest::set_has_header() {
+inline void EndTransactionRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRangeRequest::clear_has_header() {
+inline void EndTransactionRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRangeRequest::clear_header() {
+inline void EndTransactionRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& DeleteRangeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.header)
+inline const ::cockroach::roachpb::Span& EndTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* DeleteRangeRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* EndTransactionRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* DeleteRangeRequest::release_header() {
+inline ::cockroach::roachpb::Span* EndTransactionRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void EndTransactionRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6771,70 +8228,186 @@ inline void DeleteRangeRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionRequest.header)
 }
 
-// optional int64 max_entries_to_delete = 2;
-inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
+// optional bool commit = 2;
+inline bool EndTransactionRequest::has_commit() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
+inline void EndTransactionRequest::set_has_commit() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
+inline void EndTransactionRequest::clear_has_commit() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void DeleteRangeRequest::clear_max_entries_to_delete() {
-  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
-  clear_has_max_entries_to_delete();
+inline void EndTransactionRequest::clear_commit() {
+  commit_ = false;
+  clear_has_commit();
 }
-inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
-  return max_entries_to_delete_;
+inline bool EndTransactionRequest::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.commit)
+  return commit_;
+}
+inline void EndTransactionRequest::set_commit(bool value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.EndTransactionRequest.commit)
+}
+
+// optional .cockroach.roachpb.Timestamp deadline = 3;
+inline bool EndTransactionRequest::has_deadline() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void EndTransactionRequest::set_has_deadline() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void EndTransactionRequest::clear_has_deadline() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void EndTransactionRequest::clear_deadline() {
+  if (deadline_ != NULL) deadline_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_deadline();
+}
+inline const ::cockroach::roachpb::Timestamp& EndTransactionRequest::deadline() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.deadline)
+  return deadline_ != NULL ? *deadline_ : *default_instance_->deadline_;
+}
+inline ::cockroach::roachpb::Timestamp* EndTransactionRequest::mutable_deadline() {
+  set_has_deadline();
+  if (deadline_ == NULL) {
+    deadline_ = new ::cockroach::roachpb::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.deadline)
+  return deadline_;
+}
+inline ::cockroach::roachpb::Timestamp* EndTransactionRequest::release_deadline() {
+  clear_has_deadline();
+  ::cockroach::roachpb::Timestamp* temp = deadline_;
+  deadline_ = NULL;
+  return temp;
+}
+inline void EndTransactionRequest::set_allocated_deadline(::cockroach::roachpb::Timestamp* deadline) {
+  delete deadline_;
+  deadline_ = deadline;
+  if (deadline) {
+    set_has_deadline();
+  } else {
+    clear_has_deadline();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionRequest.deadline)
+}
+
+// optional .cockroach.roachpb.InternalCommitTrigger internal_commit_trigger = 4;
+inline bool EndTransactionRequest::has_internal_commit_trigger() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void EndTransactionRequest::set_has_internal_commit_trigger() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void EndTransactionRequest::clear_internal_commit_trigger() {
+  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::roachpb::InternalCommitTrigger::Clear();
+  clear_has_internal_commit_trigger();
+}
+inline const ::cockroach::roachpb::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+}
+inline ::cockroach::roachpb::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
+  set_has_internal_commit_trigger();
+  if (internal_commit_trigger_ == NULL) {
+    internal_commit_trigger_ = new ::cockroach::roachpb::InternalCommitTrigger;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_;
+}
+inline ::cockroach::roachpb::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
+  clear_has_internal_commit_trigger();
+  ::cockroach::roachpb::InternalCommitTrigger* temp = internal_commit_trigger_;
+  internal_commit_trigger_ = NULL;
+  return temp;
+}
+inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::roachpb::InternalCommitTrigger* internal_commit_trigger) {
+  delete internal_commit_trigger_;
+  internal_commit_trigger_ = internal_commit_trigger;
+  if (internal_commit_trigger) {
+    set_has_internal_commit_trigger();
+  } else {
+    clear_has_internal_commit_trigger();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionRequest.internal_commit_trigger)
+}
+
+// repeated .cockroach.roachpb.Span intent_spans = 5;
+inline int EndTransactionRequest::intent_spans_size() const {
+  return intent_spans_.size();
+}
+inline void EndTransactionRequest::clear_intent_spans() {
+  intent_spans_.Clear();
+}
+inline const ::cockroach::roachpb::Span& EndTransactionRequest::intent_spans(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.intent_spans)
+  return intent_spans_.Get(index);
+}
+inline ::cockroach::roachpb::Span* EndTransactionRequest::mutable_intent_spans(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.intent_spans)
+  return intent_spans_.Mutable(index);
+}
+inline ::cockroach::roachpb::Span* EndTransactionRequest::add_intent_spans() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.EndTransactionRequest.intent_spans)
+  return intent_spans_.Add();
 }
-inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
-  set_has_max_entries_to_delete();
-  max_entries_to_delete_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::Span >*
+EndTransactionRequest::mutable_intent_spans() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.EndTransactionRequest.intent_spans)
+  return &intent_spans_;
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::Span >&
+EndTransactionRequest::intent_spans() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.EndTransactionRequest.intent_spans)
+  return intent_spans_;
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRangeResponse
+// EndTransactionResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool DeleteRangeResponse::has_header() const {
+inline bool EndTransactionResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeResponse::set_has_header() {
+inline void EndTransactionResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRangeResponse::clear_has_header() {
+inline void EndTransactionResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRangeResponse::clear_header() {
+inline void EndTransactionResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& DeleteRangeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& EndTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* EndTransactionResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* EndTransactionResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void EndTransactionResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6842,70 +8415,124 @@ inline void DeleteRangeResponse::set_allocated_header(::cockroach::roachpb::Resp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionResponse.header)
 }
 
-// optional int64 num_deleted = 2;
-inline bool DeleteRangeResponse::has_num_deleted() const {
+// optional int64 commit_wait = 2;
+inline bool EndTransactionResponse::has_commit_wait() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void DeleteRangeResponse::set_has_num_deleted() {
+inline void EndTransactionResponse::set_has_commit_wait() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void DeleteRangeResponse::clear_has_num_deleted() {
+inline void EndTransactionResponse::clear_has_commit_wait() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void DeleteRangeResponse::clear_num_deleted() {
-  num_deleted_ = GOOGLE_LONGLONG(0);
-  clear_has_num_deleted();
+inline void EndTransactionResponse::clear_commit_wait() {
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_commit_wait();
 }
-inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.num_deleted)
-  return num_deleted_;
+inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionResponse.commit_wait)
+  return commit_wait_;
 }
-inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
-  set_has_num_deleted();
-  num_deleted_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeResponse.num_deleted)
+inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
+  set_has_commit_wait();
+  commit_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.EndTransactionResponse.commit_wait)
+}
+
+// repeated bytes resolved = 3;
+inline int EndTransactionResponse::resolved_size() const {
+  return resolved_.size();
+}
+inline void EndTransactionResponse::clear_resolved() {
+  resolved_.Clear();
+}
+inline const ::std::string& EndTransactionResponse::resolved(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionResponse.resolved)
+  return resolved_.Get(index);
+}
+inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionResponse.resolved)
+  return resolved_.Mutable(index);
+}
+inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.EndTransactionResponse.resolved)
+  resolved_.Mutable(index)->assign(value);
+}
+inline void EndTransactionResponse::set_resolved(int index, const char* value) {
+  resolved_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
+  resolved_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.EndTransactionResponse.resolved)
+}
+inline ::std::string* EndTransactionResponse::add_resolved() {
+  return resolved_.Add();
+}
+inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const char* value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.roachpb.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
+  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.roachpb.EndTransactionResponse.resolved)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+EndTransactionResponse::resolved() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.EndTransactionResponse.resolved)
+  return resolved_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+EndTransactionResponse::mutable_resolved() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.EndTransactionResponse.resolved)
+  return &resolved_;
 }
 
 // -------------------------------------------------------------------
 
-// ScanRequest
+// AdminSplitRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool ScanRequest::has_header() const {
+inline bool AdminSplitRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanRequest::set_has_header() {
+inline void AdminSplitRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanRequest::clear_has_header() {
+inline void AdminSplitRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanRequest::clear_header() {
+inline void AdminSplitRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& ScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.header)
+inline const ::cockroach::roachpb::Span& AdminSplitRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminSplitRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* ScanRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* AdminSplitRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminSplitRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* ScanRequest::release_header() {
+inline ::cockroach::roachpb::Span* AdminSplitRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void AdminSplitRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6913,147 +8540,146 @@ inline void ScanRequest::set_allocated_header(::cockroach::roachpb::Span* header
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminSplitRequest.header)
 }
 
-// optional int64 max_results = 2;
-inline bool ScanRequest::has_max_results() const {
+// optional bytes split_key = 2;
+inline bool AdminSplitRequest::has_split_key() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ScanRequest::set_has_max_results() {
+inline void AdminSplitRequest::set_has_split_key() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ScanRequest::clear_has_max_results() {
+inline void AdminSplitRequest::clear_has_split_key() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ScanRequest::clear_max_results() {
-  max_results_ = GOOGLE_LONGLONG(0);
-  clear_has_max_results();
+inline void AdminSplitRequest::clear_split_key() {
+  split_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_split_key();
 }
-inline ::google::protobuf::int64 ScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.max_results)
-  return max_results_;
+inline const ::std::string& AdminSplitRequest::split_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminSplitRequest.split_key)
+  return split_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
-  set_has_max_results();
-  max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ScanRequest.max_results)
+inline void AdminSplitRequest::set_split_key(const ::std::string& value) {
+  set_has_split_key();
+  split_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.AdminSplitRequest.split_key)
+}
+inline void AdminSplitRequest::set_split_key(const char* value) {
+  set_has_split_key();
+  split_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.AdminSplitRequest.split_key)
+}
+inline void AdminSplitRequest::set_split_key(const void* value, size_t size) {
+  set_has_split_key();
+  split_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.AdminSplitRequest.split_key)
+}
+inline ::std::string* AdminSplitRequest::mutable_split_key() {
+  set_has_split_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminSplitRequest.split_key)
+  return split_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* AdminSplitRequest::release_split_key() {
+  clear_has_split_key();
+  return split_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void AdminSplitRequest::set_allocated_split_key(::std::string* split_key) {
+  if (split_key != NULL) {
+    set_has_split_key();
+  } else {
+    clear_has_split_key();
+  }
+  split_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), split_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminSplitRequest.split_key)
 }
 
 // -------------------------------------------------------------------
 
-// ScanResponse
+// AdminSplitResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool ScanResponse::has_header() const {
+inline bool AdminSplitResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanResponse::set_has_header() {
+inline void AdminSplitResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanResponse::clear_has_header() {
+inline void AdminSplitResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanResponse::clear_header() {
+inline void AdminSplitResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& ScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& AdminSplitResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminSplitResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ScanResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* AdminSplitResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.header)
-  return header_;
-}
-inline ::cockroach::roachpb::ResponseHeader* ScanResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
-}
-inline void ScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanResponse.header)
-}
-
-// repeated .cockroach.roachpb.KeyValue rows = 2;
-inline int ScanResponse::rows_size() const {
-  return rows_.size();
-}
-inline void ScanResponse::clear_rows() {
-  rows_.Clear();
-}
-inline const ::cockroach::roachpb::KeyValue& ScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.rows)
-  return rows_.Get(index);
-}
-inline ::cockroach::roachpb::KeyValue* ScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.rows)
-  return rows_.Mutable(index);
-}
-inline ::cockroach::roachpb::KeyValue* ScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.ScanResponse.rows)
-  return rows_.Add();
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminSplitResponse.header)
+  return header_;
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
-ScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ScanResponse.rows)
-  return &rows_;
+inline ::cockroach::roachpb::ResponseHeader* AdminSplitResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
-ScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.ScanResponse.rows)
-  return rows_;
+inline void AdminSplitResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminSplitResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// ReverseScanRequest
+// AdminMergeRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool ReverseScanRequest::has_header() const {
+inline bool AdminMergeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ReverseScanRequest::set_has_header() {
+inline void AdminMergeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ReverseScanRequest::clear_has_header() {
+inline void AdminMergeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ReverseScanRequest::clear_header() {
+inline void AdminMergeRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& ReverseScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.header)
+inline const ::cockroach::roachpb::Span& AdminMergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminMergeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* ReverseScanRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* AdminMergeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminMergeRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* ReverseScanRequest::release_header() {
+inline ::cockroach::roachpb::Span* AdminMergeRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ReverseScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void AdminMergeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7061,70 +8687,46 @@ inline void ReverseScanRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanRequest.header)
-}
-
-// optional int64 max_results = 2;
-inline bool ReverseScanRequest::has_max_results() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void ReverseScanRequest::set_has_max_results() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void ReverseScanRequest::clear_has_max_results() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void ReverseScanRequest::clear_max_results() {
-  max_results_ = GOOGLE_LONGLONG(0);
-  clear_has_max_results();
-}
-inline ::google::protobuf::int64 ReverseScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.max_results)
-  return max_results_;
-}
-inline void ReverseScanRequest::set_max_results(::google::protobuf::int64 value) {
-  set_has_max_results();
-  max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ReverseScanRequest.max_results)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminMergeRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// ReverseScanResponse
+// AdminMergeResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool ReverseScanResponse::has_header() const {
+inline bool AdminMergeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ReverseScanResponse::set_has_header() {
+inline void AdminMergeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ReverseScanResponse::clear_has_header() {
+inline void AdminMergeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ReverseScanResponse::clear_header() {
+inline void AdminMergeResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& ReverseScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& AdminMergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminMergeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* AdminMergeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminMergeResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* AdminMergeResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ReverseScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void AdminMergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7132,76 +8734,46 @@ inline void ReverseScanResponse::set_allocated_header(::cockroach::roachpb::Resp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanResponse.header)
-}
-
-// repeated .cockroach.roachpb.KeyValue rows = 2;
-inline int ReverseScanResponse::rows_size() const {
-  return rows_.size();
-}
-inline void ReverseScanResponse::clear_rows() {
-  rows_.Clear();
-}
-inline const ::cockroach::roachpb::KeyValue& ReverseScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.rows)
-  return rows_.Get(index);
-}
-inline ::cockroach::roachpb::KeyValue* ReverseScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.rows)
-  return rows_.Mutable(index);
-}
-inline ::cockroach::roachpb::KeyValue* ReverseScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.ReverseScanResponse.rows)
-  return rows_.Add();
-}
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
-ReverseScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ReverseScanResponse.rows)
-  return &rows_;
-}
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
-ReverseScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.ReverseScanResponse.rows)
-  return rows_;
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminMergeResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// BeginTransactionRequest
+// AdminScatterRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool BeginTransactionRequest::has_header() const {
+inline bool AdminScatterRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void BeginTransactionRequest::set_has_header() {
+inline void AdminScatterRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void BeginTransactionRequest::clear_has_header() {
+inline void AdminScatterRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void BeginTransactionRequest::clear_header() {
+inline void AdminScatterRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& BeginTransactionRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.BeginTransactionRequest.header)
+inline const ::cockroach::roachpb::Span& AdminScatterRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminScatterRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* BeginTransactionRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* AdminScatterRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.BeginTransactionRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminScatterRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* BeginTransactionRequest::release_header() {
+inline ::cockroach::roachpb::Span* AdminScatterRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void BeginTransactionRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void AdminScatterRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7209,46 +8781,46 @@ inline void BeginTransactionRequest::set_allocated_header(::cockroach::roachpb::
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.BeginTransactionRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminScatterRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// BeginTransactionResponse
+// AdminScatterResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool BeginTransactionResponse::has_header() const {
+inline bool AdminScatterResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void BeginTransactionResponse::set_has_header() {
+inline void AdminScatterResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void BeginTransactionResponse::clear_has_header() {
+inline void AdminScatterResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void BeginTransactionResponse::clear_header() {
+inline void AdminScatterResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& BeginTransactionResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.BeginTransactionResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& AdminScatterResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminScatterResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* BeginTransactionResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* AdminScatterResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.BeginTransactionResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminScatterResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* BeginTransactionResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* AdminScatterResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void BeginTransactionResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void AdminScatterResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7256,46 +8828,89 @@ inline void BeginTransactionResponse::set_allocated_header(::cockroach::roachpb:
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.BeginTransactionResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminScatterResponse.header)
+}
+
+// optional .cockroach.roachpb.RangeDescriptor desc = 2;
+inline bool AdminScatterResponse::has_desc() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AdminScatterResponse::set_has_desc() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AdminScatterResponse::clear_has_desc() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AdminScatterResponse::clear_desc() {
+  if (desc_ != NULL) desc_->::cockroach::roachpb::RangeDescriptor::Clear();
+  clear_has_desc();
+}
+inline const ::cockroach::roachpb::RangeDescriptor& AdminScatterResponse::desc() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminScatterResponse.desc)
+  return desc_ != NULL ? *desc_ : *default_instance_->desc_;
+}
+inline ::cockroach::roachpb::RangeDescriptor* AdminScatterResponse::mutable_desc() {
+  set_has_desc();
+  if (desc_ == NULL) {
+    desc_ = new ::cockroach::roachpb::RangeDescriptor;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminScatterResponse.desc)
+  return desc_;
+}
+inline ::cockroach::roachpb::RangeDescriptor* AdminScatterResponse::release_desc() {
+  clear_has_desc();
+  ::cockroach::roachpb::RangeDescriptor* temp = desc_;
+  desc_ = NULL;
+  return temp;
+}
+inline void AdminScatterResponse::set_allocated_desc(::cockroach::roachpb::RangeDescriptor* desc) {
+  delete desc_;
+  desc_ = desc;
+  if (desc) {
+    set_has_desc();
+  } else {
+    clear_has_desc();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminScatterResponse.desc)
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionRequest
+// RangeLookupRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool EndTransactionRequest::has_header() const {
+inline bool RangeLookupRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionRequest::set_has_header() {
+inline void RangeLookupRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionRequest::clear_has_header() {
+inline void RangeLookupRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionRequest::clear_header() {
+inline void RangeLookupRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& EndTransactionRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.header)
+inline const ::cockroach::roachpb::Span& RangeLookupRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* EndTransactionRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* RangeLookupRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* EndTransactionRequest::release_header() {
+inline ::cockroach::roachpb::Span* RangeLookupRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EndTransactionRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void RangeLookupRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7303,186 +8918,118 @@ inline void EndTransactionRequest::set_allocated_header(::cockroach::roachpb::Sp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupRequest.header)
 }
 
-// optional bool commit = 2;
-inline bool EndTransactionRequest::has_commit() const {
+// optional int32 max_ranges = 2;
+inline bool RangeLookupRequest::has_max_ranges() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void EndTransactionRequest::set_has_commit() {
+inline void RangeLookupRequest::set_has_max_ranges() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void EndTransactionRequest::clear_has_commit() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void EndTransactionRequest::clear_commit() {
-  commit_ = false;
-  clear_has_commit();
-}
-inline bool EndTransactionRequest::commit() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.commit)
-  return commit_;
-}
-inline void EndTransactionRequest::set_commit(bool value) {
-  set_has_commit();
-  commit_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.EndTransactionRequest.commit)
-}
-
-// optional .cockroach.roachpb.Timestamp deadline = 3;
-inline bool EndTransactionRequest::has_deadline() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void EndTransactionRequest::set_has_deadline() {
-  _has_bits_[0] |= 0x00000004u;
-}
-inline void EndTransactionRequest::clear_has_deadline() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-inline void EndTransactionRequest::clear_deadline() {
-  if (deadline_ != NULL) deadline_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_deadline();
-}
-inline const ::cockroach::roachpb::Timestamp& EndTransactionRequest::deadline() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.deadline)
-  return deadline_ != NULL ? *deadline_ : *default_instance_->deadline_;
-}
-inline ::cockroach::roachpb::Timestamp* EndTransactionRequest::mutable_deadline() {
-  set_has_deadline();
-  if (deadline_ == NULL) {
-    deadline_ = new ::cockroach::roachpb::Timestamp;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.deadline)
-  return deadline_;
-}
-inline ::cockroach::roachpb::Timestamp* EndTransactionRequest::release_deadline() {
-  clear_has_deadline();
-  ::cockroach::roachpb::Timestamp* temp = deadline_;
-  deadline_ = NULL;
-  return temp;
-}
-inline void EndTransactionRequest::set_allocated_deadline(::cockroach::roachpb::Timestamp* deadline) {
-  delete deadline_;
-  deadline_ = deadline;
-  if (deadline) {
-    set_has_deadline();
-  } else {
-    clear_has_deadline();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionRequest.deadline)
-}
-
-// optional .cockroach.roachpb.InternalCommitTrigger internal_commit_trigger = 4;
-inline bool EndTransactionRequest::has_internal_commit_trigger() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
+inline void RangeLookupRequest::clear_has_max_ranges() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void EndTransactionRequest::set_has_internal_commit_trigger() {
-  _has_bits_[0] |= 0x00000008u;
+inline void RangeLookupRequest::clear_max_ranges() {
+  max_ranges_ = 0;
+  clear_has_max_ranges();
 }
-inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
-  _has_bits_[0] &= ~0x00000008u;
+inline ::google::protobuf::int32 RangeLookupRequest::max_ranges() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.max_ranges)
+  return max_ranges_;
 }
-inline void EndTransactionRequest::clear_internal_commit_trigger() {
-  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::roachpb::InternalCommitTrigger::Clear();
-  clear_has_internal_commit_trigger();
+inline void RangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
+  set_has_max_ranges();
+  max_ranges_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.max_ranges)
 }
-inline const ::cockroach::roachpb::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+
+// optional bool consider_intents = 3;
+inline bool RangeLookupRequest::has_consider_intents() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline ::cockroach::roachpb::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
-  set_has_internal_commit_trigger();
-  if (internal_commit_trigger_ == NULL) {
-    internal_commit_trigger_ = new ::cockroach::roachpb::InternalCommitTrigger;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_;
+inline void RangeLookupRequest::set_has_consider_intents() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline ::cockroach::roachpb::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
-  clear_has_internal_commit_trigger();
-  ::cockroach::roachpb::InternalCommitTrigger* temp = internal_commit_trigger_;
-  internal_commit_trigger_ = NULL;
-  return temp;
+inline void RangeLookupRequest::clear_has_consider_intents() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::roachpb::InternalCommitTrigger* internal_commit_trigger) {
-  delete internal_commit_trigger_;
-  internal_commit_trigger_ = internal_commit_trigger;
-  if (internal_commit_trigger) {
-    set_has_internal_commit_trigger();
-  } else {
-    clear_has_internal_commit_trigger();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionRequest.internal_commit_trigger)
+inline void RangeLookupRequest::clear_consider_intents() {
+  consider_intents_ = false;
+  clear_has_consider_intents();
 }
-
-// repeated .cockroach.roachpb.Span intent_spans = 5;
-inline int EndTransactionRequest::intent_spans_size() const {
-  return intent_spans_.size();
+inline bool RangeLookupRequest::consider_intents() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.consider_intents)
+  return consider_intents_;
 }
-inline void EndTransactionRequest::clear_intent_spans() {
-  intent_spans_.Clear();
+inline void RangeLookupRequest::set_consider_intents(bool value) {
+  set_has_consider_intents();
+  consider_intents_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.consider_intents)
 }
-inline const ::cockroach::roachpb::Span& EndTransactionRequest::intent_spans(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionRequest.intent_spans)
-  return intent_spans_.Get(index);
+
+// optional bool reverse = 4;
+inline bool RangeLookupRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline ::cockroach::roachpb::Span* EndTransactionRequest::mutable_intent_spans(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionRequest.intent_spans)
-  return intent_spans_.Mutable(index);
+inline void RangeLookupRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline ::cockroach::roachpb::Span* EndTransactionRequest::add_intent_spans() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.EndTransactionRequest.intent_spans)
-  return intent_spans_.Add();
+inline void RangeLookupRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::Span >*
-EndTransactionRequest::mutable_intent_spans() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.EndTransactionRequest.intent_spans)
-  return &intent_spans_;
+inline void RangeLookupRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::Span >&
-EndTransactionRequest::intent_spans() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.EndTransactionRequest.intent_spans)
-  return intent_spans_;
+inline bool RangeLookupRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.reverse)
+  return reverse_;
+}
+inline void RangeLookupRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.reverse)
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionResponse
+// RangeLookupResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool EndTransactionResponse::has_header() const {
+inline bool RangeLookupResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionResponse::set_has_header() {
+inline void RangeLookupResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionResponse::clear_has_header() {
+inline void RangeLookupResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionResponse::clear_header() {
+inline void RangeLookupResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& EndTransactionResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& RangeLookupResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* EndTransactionResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* RangeLookupResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* EndTransactionResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* RangeLookupResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EndTransactionResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void RangeLookupResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7490,124 +9037,123 @@ inline void EndTransactionResponse::set_allocated_header(::cockroach::roachpb::R
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.EndTransactionResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupResponse.header)
 }
 
-// optional int64 commit_wait = 2;
-inline bool EndTransactionResponse::has_commit_wait() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void EndTransactionResponse::set_has_commit_wait() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void EndTransactionResponse::clear_has_commit_wait() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void EndTransactionResponse::clear_commit_wait() {
-  commit_wait_ = GOOGLE_LONGLONG(0);
-  clear_has_commit_wait();
-}
-inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionResponse.commit_wait)
-  return commit_wait_;
+// repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
+inline int RangeLookupResponse::ranges_size() const {
+  return ranges_.size();
 }
-inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
-  set_has_commit_wait();
-  commit_wait_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.EndTransactionResponse.commit_wait)
+inline void RangeLookupResponse::clear_ranges() {
+  ranges_.Clear();
 }
-
-// repeated bytes resolved = 3;
-inline int EndTransactionResponse::resolved_size() const {
-  return resolved_.size();
+inline const ::cockroach::roachpb::RangeDescriptor& RangeLookupResponse::ranges(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_.Get(index);
 }
-inline void EndTransactionResponse::clear_resolved() {
-  resolved_.Clear();
+inline ::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::mutable_ranges(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_.Mutable(index);
 }
-inline const ::std::string& EndTransactionResponse::resolved(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.EndTransactionResponse.resolved)
-  return resolved_.Get(index);
+inline ::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::add_ranges() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_.Add();
 }
-inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.EndTransactionResponse.resolved)
-  return resolved_.Mutable(index);
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >*
+RangeLookupResponse::mutable_ranges() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.RangeLookupResponse.ranges)
+  return &ranges_;
 }
-inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.EndTransactionResponse.resolved)
-  resolved_.Mutable(index)->assign(value);
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >&
+RangeLookupResponse::ranges() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.RangeLookupResponse.ranges)
+  return ranges_;
 }
-inline void EndTransactionResponse::set_resolved(int index, const char* value) {
-  resolved_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.EndTransactionResponse.resolved)
+
+// -------------------------------------------------------------------
+
+// HeartbeatTxnRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool HeartbeatTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
-  resolved_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.EndTransactionResponse.resolved)
+inline void HeartbeatTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline ::std::string* EndTransactionResponse::add_resolved() {
-  return resolved_.Add();
+inline void HeartbeatTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.EndTransactionResponse.resolved)
+inline void HeartbeatTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
 }
-inline void EndTransactionResponse::add_resolved(const char* value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:cockroach.roachpb.EndTransactionResponse.resolved)
+inline const ::cockroach::roachpb::Span& HeartbeatTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
-  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:cockroach.roachpb.EndTransactionResponse.resolved)
+inline ::cockroach::roachpb::Span* HeartbeatTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnRequest.header)
+  return header_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
-EndTransactionResponse::resolved() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.EndTransactionResponse.resolved)
-  return resolved_;
+inline ::cockroach::roachpb::Span* HeartbeatTxnRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline ::google::protobuf::RepeatedPtrField< ::std::string>*
-EndTransactionResponse::mutable_resolved() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.EndTransactionResponse.resolved)
-  return &resolved_;
+inline void HeartbeatTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// AdminSplitRequest
+// HeartbeatTxnResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-inline bool AdminSplitRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool HeartbeatTxnResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminSplitRequest::set_has_header() {
+inline void HeartbeatTxnResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminSplitRequest::clear_has_header() {
+inline void HeartbeatTxnResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminSplitRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+inline void HeartbeatTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& AdminSplitRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminSplitRequest.header)
+inline const ::cockroach::roachpb::ResponseHeader& HeartbeatTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* AdminSplitRequest::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminSplitRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* AdminSplitRequest::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void AdminSplitRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void HeartbeatTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7615,146 +9161,146 @@ inline void AdminSplitRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminSplitRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnResponse.header)
 }
 
-// optional bytes split_key = 2;
-inline bool AdminSplitRequest::has_split_key() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// GCRequest_GCKey
+
+// optional bytes key = 1;
+inline bool GCRequest_GCKey::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminSplitRequest::set_has_split_key() {
-  _has_bits_[0] |= 0x00000002u;
+inline void GCRequest_GCKey::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminSplitRequest::clear_has_split_key() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void GCRequest_GCKey::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminSplitRequest::clear_split_key() {
-  split_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_split_key();
+inline void GCRequest_GCKey::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
 }
-inline const ::std::string& AdminSplitRequest::split_key() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminSplitRequest.split_key)
-  return split_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline const ::std::string& GCRequest_GCKey::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void AdminSplitRequest::set_split_key(const ::std::string& value) {
-  set_has_split_key();
-  split_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.AdminSplitRequest.split_key)
+inline void GCRequest_GCKey::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.GCRequest.GCKey.key)
 }
-inline void AdminSplitRequest::set_split_key(const char* value) {
-  set_has_split_key();
-  split_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.AdminSplitRequest.split_key)
+inline void GCRequest_GCKey::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.GCRequest.GCKey.key)
 }
-inline void AdminSplitRequest::set_split_key(const void* value, size_t size) {
-  set_has_split_key();
-  split_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+inline void GCRequest_GCKey::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.AdminSplitRequest.split_key)
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.GCRequest.GCKey.key)
 }
-inline ::std::string* AdminSplitRequest::mutable_split_key() {
-  set_has_split_key();
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminSplitRequest.split_key)
-  return split_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::std::string* GCRequest_GCKey::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* AdminSplitRequest::release_split_key() {
-  clear_has_split_key();
-  return split_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::std::string* GCRequest_GCKey::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void AdminSplitRequest::set_allocated_split_key(::std::string* split_key) {
-  if (split_key != NULL) {
-    set_has_split_key();
+inline void GCRequest_GCKey::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
   } else {
-    clear_has_split_key();
+    clear_has_key();
   }
-  split_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), split_key);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminSplitRequest.split_key)
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.key)
 }
 
-// -------------------------------------------------------------------
-
-// AdminSplitResponse
-
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool AdminSplitResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.roachpb.Timestamp timestamp = 2;
+inline bool GCRequest_GCKey::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void AdminSplitResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void GCRequest_GCKey::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void AdminSplitResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void GCRequest_GCKey::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void AdminSplitResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
-  clear_has_header();
+inline void GCRequest_GCKey::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_timestamp();
 }
-inline const ::cockroach::roachpb::ResponseHeader& AdminSplitResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminSplitResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::roachpb::Timestamp& GCRequest_GCKey::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
 }
-inline ::cockroach::roachpb::ResponseHeader* AdminSplitResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+inline ::cockroach::roachpb::Timestamp* GCRequest_GCKey::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::roachpb::Timestamp;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminSplitResponse.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.timestamp)
+  return timestamp_;
 }
-inline ::cockroach::roachpb::ResponseHeader* AdminSplitResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::roachpb::Timestamp* GCRequest_GCKey::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::roachpb::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
   return temp;
 }
-inline void AdminSplitResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void GCRequest_GCKey::set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
   } else {
-    clear_has_header();
+    clear_has_timestamp();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminSplitResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.timestamp)
 }
 
 // -------------------------------------------------------------------
 
-// AdminMergeRequest
+// GCRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool AdminMergeRequest::has_header() const {
+inline bool GCRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminMergeRequest::set_has_header() {
+inline void GCRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminMergeRequest::clear_has_header() {
+inline void GCRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminMergeRequest::clear_header() {
+inline void GCRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& AdminMergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminMergeRequest.header)
+inline const ::cockroach::roachpb::Span& GCRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* AdminMergeRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* GCRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminMergeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* AdminMergeRequest::release_header() {
+inline ::cockroach::roachpb::Span* GCRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void AdminMergeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void GCRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7762,46 +9308,76 @@ inline void AdminMergeRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminMergeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.header)
+}
+
+// repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
+inline int GCRequest::keys_size() const {
+  return keys_.size();
+}
+inline void GCRequest::clear_keys() {
+  keys_.Clear();
+}
+inline const ::cockroach::roachpb::GCRequest_GCKey& GCRequest::keys(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.keys)
+  return keys_.Get(index);
+}
+inline ::cockroach::roachpb::GCRequest_GCKey* GCRequest::mutable_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.keys)
+  return keys_.Mutable(index);
+}
+inline ::cockroach::roachpb::GCRequest_GCKey* GCRequest::add_keys() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.GCRequest.keys)
+  return keys_.Add();
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >*
+GCRequest::mutable_keys() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.GCRequest.keys)
+  return &keys_;
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >&
+GCRequest::keys() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.GCRequest.keys)
+  return keys_;
 }
 
 // -------------------------------------------------------------------
 
-// AdminMergeResponse
+// GCResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool AdminMergeResponse::has_header() const {
+inline bool GCResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminMergeResponse::set_has_header() {
+inline void GCResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminMergeResponse::clear_has_header() {
+inline void GCResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminMergeResponse::clear_header() {
+inline void GCResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& AdminMergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.AdminMergeResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& GCResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* AdminMergeResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* GCResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.AdminMergeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* AdminMergeResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* GCResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void AdminMergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void GCResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7809,46 +9385,46 @@ inline void AdminMergeResponse::set_allocated_header(::cockroach::roachpb::Respo
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.AdminMergeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// RangeLookupRequest
+// PushTxnRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool RangeLookupRequest::has_header() const {
+inline bool PushTxnRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RangeLookupRequest::set_has_header() {
+inline void PushTxnRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void RangeLookupRequest::clear_has_header() {
+inline void PushTxnRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void RangeLookupRequest::clear_header() {
+inline void PushTxnRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& RangeLookupRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.header)
+inline const ::cockroach::roachpb::Span& PushTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* RangeLookupRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* PushTxnRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* RangeLookupRequest::release_header() {
+inline ::cockroach::roachpb::Span* PushTxnRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void RangeLookupRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void PushTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7856,242 +9432,243 @@ inline void RangeLookupRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.header)
 }
 
-// optional int32 max_ranges = 2;
-inline bool RangeLookupRequest::has_max_ranges() const {
+// optional .cockroach.roachpb.Transaction pusher_txn = 2;
+inline bool PushTxnRequest::has_pusher_txn() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RangeLookupRequest::set_has_max_ranges() {
+inline void PushTxnRequest::set_has_pusher_txn() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void RangeLookupRequest::clear_has_max_ranges() {
+inline void PushTxnRequest::clear_has_pusher_txn() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void RangeLookupRequest::clear_max_ranges() {
-  max_ranges_ = 0;
-  clear_has_max_ranges();
+inline void PushTxnRequest::clear_pusher_txn() {
+  if (pusher_txn_ != NULL) pusher_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_pusher_txn();
 }
-inline ::google::protobuf::int32 RangeLookupRequest::max_ranges() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.max_ranges)
-  return max_ranges_;
+inline const ::cockroach::roachpb::Transaction& PushTxnRequest::pusher_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pusher_txn)
+  return pusher_txn_ != NULL ? *pusher_txn_ : *default_instance_->pusher_txn_;
 }
-inline void RangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
-  set_has_max_ranges();
-  max_ranges_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.max_ranges)
+inline ::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pusher_txn() {
+  set_has_pusher_txn();
+  if (pusher_txn_ == NULL) {
+    pusher_txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pusher_txn)
+  return pusher_txn_;
+}
+inline ::cockroach::roachpb::Transaction* PushTxnRequest::release_pusher_txn() {
+  clear_has_pusher_txn();
+  ::cockroach::roachpb::Transaction* temp = pusher_txn_;
+  pusher_txn_ = NULL;
+  return temp;
+}
+inline void PushTxnRequest::set_allocated_pusher_txn(::cockroach::roachpb::Transaction* pusher_txn) {
+  delete pusher_txn_;
+  pusher_txn_ = pusher_txn;
+  if (pusher_txn) {
+    set_has_pusher_txn();
+  } else {
+    clear_has_pusher_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pusher_txn)
 }
 
-// optional bool consider_intents = 3;
-inline bool RangeLookupRequest::has_consider_intents() const {
+// optional .cockroach.roachpb.Transaction pushee_txn = 3;
+inline bool PushTxnRequest::has_pushee_txn() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void RangeLookupRequest::set_has_consider_intents() {
+inline void PushTxnRequest::set_has_pushee_txn() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void RangeLookupRequest::clear_has_consider_intents() {
+inline void PushTxnRequest::clear_has_pushee_txn() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void RangeLookupRequest::clear_consider_intents() {
-  consider_intents_ = false;
-  clear_has_consider_intents();
+inline void PushTxnRequest::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_pushee_txn();
 }
-inline bool RangeLookupRequest::consider_intents() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.consider_intents)
-  return consider_intents_;
+inline const ::cockroach::roachpb::Transaction& PushTxnRequest::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
 }
-inline void RangeLookupRequest::set_consider_intents(bool value) {
-  set_has_consider_intents();
-  consider_intents_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.consider_intents)
+inline ::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) {
+    pushee_txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pushee_txn)
+  return pushee_txn_;
+}
+inline ::cockroach::roachpb::Transaction* PushTxnRequest::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+inline void PushTxnRequest::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pushee_txn)
 }
 
-// optional bool reverse = 4;
-inline bool RangeLookupRequest::has_reverse() const {
+// optional .cockroach.roachpb.Timestamp push_to = 4;
+inline bool PushTxnRequest::has_push_to() const {
   return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void RangeLookupRequest::set_has_reverse() {
+inline void PushTxnRequest::set_has_push_to() {
   _has_bits_[0] |= 0x00000008u;
 }
-inline void RangeLookupRequest::clear_has_reverse() {
+inline void PushTxnRequest::clear_has_push_to() {
   _has_bits_[0] &= ~0x00000008u;
 }
-inline void RangeLookupRequest::clear_reverse() {
-  reverse_ = false;
-  clear_has_reverse();
-}
-inline bool RangeLookupRequest::reverse() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupRequest.reverse)
-  return reverse_;
-}
-inline void RangeLookupRequest::set_reverse(bool value) {
-  set_has_reverse();
-  reverse_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeLookupRequest.reverse)
-}
-
-// -------------------------------------------------------------------
-
-// RangeLookupResponse
-
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool RangeLookupResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void RangeLookupResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void RangeLookupResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
-}
-inline void RangeLookupResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
-  clear_has_header();
+inline void PushTxnRequest::clear_push_to() {
+  if (push_to_ != NULL) push_to_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_push_to();
 }
-inline const ::cockroach::roachpb::ResponseHeader& RangeLookupResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::roachpb::Timestamp& PushTxnRequest::push_to() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_to)
+  return push_to_ != NULL ? *push_to_ : *default_instance_->push_to_;
 }
-inline ::cockroach::roachpb::ResponseHeader* RangeLookupResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+inline ::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_push_to() {
+  set_has_push_to();
+  if (push_to_ == NULL) {
+    push_to_ = new ::cockroach::roachpb::Timestamp;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.push_to)
+  return push_to_;
 }
-inline ::cockroach::roachpb::ResponseHeader* RangeLookupResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::roachpb::Timestamp* PushTxnRequest::release_push_to() {
+  clear_has_push_to();
+  ::cockroach::roachpb::Timestamp* temp = push_to_;
+  push_to_ = NULL;
   return temp;
 }
-inline void RangeLookupResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void PushTxnRequest::set_allocated_push_to(::cockroach::roachpb::Timestamp* push_to) {
+  delete push_to_;
+  push_to_ = push_to;
+  if (push_to) {
+    set_has_push_to();
   } else {
-    clear_has_header();
+    clear_has_push_to();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeLookupResponse.header)
-}
-
-// repeated .cockroach.roachpb.RangeDescriptor ranges = 2;
-inline int RangeLookupResponse::ranges_size() const {
-  return ranges_.size();
-}
-inline void RangeLookupResponse::clear_ranges() {
-  ranges_.Clear();
-}
-inline const ::cockroach::roachpb::RangeDescriptor& RangeLookupResponse::ranges(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_.Get(index);
-}
-inline ::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::mutable_ranges(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_.Mutable(index);
-}
-inline ::cockroach::roachpb::RangeDescriptor* RangeLookupResponse::add_ranges() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_.Add();
-}
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >*
-RangeLookupResponse::mutable_ranges() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.RangeLookupResponse.ranges)
-  return &ranges_;
-}
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RangeDescriptor >&
-RangeLookupResponse::ranges() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.RangeLookupResponse.ranges)
-  return ranges_;
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.push_to)
 }
 
-// -------------------------------------------------------------------
-
-// HeartbeatTxnRequest
-
-// optional .cockroach.roachpb.Span header = 1;
-inline bool HeartbeatTxnRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.roachpb.Timestamp now = 5;
+inline bool PushTxnRequest::has_now() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
 }
-inline void HeartbeatTxnRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void PushTxnRequest::set_has_now() {
+  _has_bits_[0] |= 0x00000010u;
 }
-inline void HeartbeatTxnRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void PushTxnRequest::clear_has_now() {
+  _has_bits_[0] &= ~0x00000010u;
 }
-inline void HeartbeatTxnRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-  clear_has_header();
+inline void PushTxnRequest::clear_now() {
+  if (now_ != NULL) now_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_now();
 }
-inline const ::cockroach::roachpb::Span& HeartbeatTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::roachpb::Timestamp& PushTxnRequest::now() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.now)
+  return now_ != NULL ? *now_ : *default_instance_->now_;
 }
-inline ::cockroach::roachpb::Span* HeartbeatTxnRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+inline ::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_now() {
+  set_has_now();
+  if (now_ == NULL) {
+    now_ = new ::cockroach::roachpb::Timestamp;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnRequest.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.now)
+  return now_;
 }
-inline ::cockroach::roachpb::Span* HeartbeatTxnRequest::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
-  header_ = NULL;
+inline ::cockroach::roachpb::Timestamp* PushTxnRequest::release_now() {
+  clear_has_now();
+  ::cockroach::roachpb::Timestamp* temp = now_;
+  now_ = NULL;
   return temp;
 }
-inline void HeartbeatTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void PushTxnRequest::set_allocated_now(::cockroach::roachpb::Timestamp* now) {
+  delete now_;
+  now_ = now;
+  if (now) {
+    set_has_now();
   } else {
-    clear_has_header();
+    clear_has_now();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.now)
+}
+
+// optional .cockroach.roachpb.PushTxnType push_type = 6;
+inline bool PushTxnRequest::has_push_type() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void PushTxnRequest::set_has_push_type() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void PushTxnRequest::clear_has_push_type() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void PushTxnRequest::clear_push_type() {
+  push_type_ = 0;
+  clear_has_push_type();
+}
+inline ::cockroach::roachpb::PushTxnType PushTxnRequest::push_type() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_type)
+  return static_cast< ::cockroach::roachpb::PushTxnType >(push_type_);
+}
+inline void PushTxnRequest::set_push_type(::cockroach::roachpb::PushTxnType value) {
+  assert(::cockroach::roachpb::PushTxnType_IsValid(value));
+  set_has_push_type();
+  push_type_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.PushTxnRequest.push_type)
 }
 
 // -------------------------------------------------------------------
 
-// HeartbeatTxnResponse
+// PushTxnResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool HeartbeatTxnResponse::has_header() const {
+inline bool PushTxnResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void HeartbeatTxnResponse::set_has_header() {
+inline void PushTxnResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void HeartbeatTxnResponse::clear_has_header() {
+inline void PushTxnResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void HeartbeatTxnResponse::clear_header() {
+inline void PushTxnResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& HeartbeatTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.HeartbeatTxnResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& PushTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* PushTxnResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.HeartbeatTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* HeartbeatTxnResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* PushTxnResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void HeartbeatTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void PushTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8099,223 +9676,203 @@ inline void HeartbeatTxnResponse::set_allocated_header(::cockroach::roachpb::Res
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.HeartbeatTxnResponse.header)
-}
-
-// -------------------------------------------------------------------
-
-// GCRequest_GCKey
-
-// optional bytes key = 1;
-inline bool GCRequest_GCKey::has_key() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void GCRequest_GCKey::set_has_key() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void GCRequest_GCKey::clear_has_key() {
-  _has_bits_[0] &= ~0x00000001u;
-}
-inline void GCRequest_GCKey::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_key();
-}
-inline const ::std::string& GCRequest_GCKey::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.key)
-  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
-inline void GCRequest_GCKey::set_key(const ::std::string& value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.GCRequest.GCKey.key)
-}
-inline void GCRequest_GCKey::set_key(const char* value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.GCRequest.GCKey.key)
-}
-inline void GCRequest_GCKey::set_key(const void* value, size_t size) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.GCRequest.GCKey.key)
-}
-inline ::std::string* GCRequest_GCKey::mutable_key() {
-  set_has_key();
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
-inline ::std::string* GCRequest_GCKey::release_key() {
-  clear_has_key();
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
-inline void GCRequest_GCKey::set_allocated_key(::std::string* key) {
-  if (key != NULL) {
-    set_has_key();
-  } else {
-    clear_has_key();
-  }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.header)
 }
 
-// optional .cockroach.roachpb.Timestamp timestamp = 2;
-inline bool GCRequest_GCKey::has_timestamp() const {
+// optional .cockroach.roachpb.Transaction pushee_txn = 2;
+inline bool PushTxnResponse::has_pushee_txn() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void GCRequest_GCKey::set_has_timestamp() {
+inline void PushTxnResponse::set_has_pushee_txn() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void GCRequest_GCKey::clear_has_timestamp() {
+inline void PushTxnResponse::clear_has_pushee_txn() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void GCRequest_GCKey::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_timestamp();
+inline void PushTxnResponse::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_pushee_txn();
 }
-inline const ::cockroach::roachpb::Timestamp& GCRequest_GCKey::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.GCKey.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline const ::cockroach::roachpb::Transaction& PushTxnResponse::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
 }
-inline ::cockroach::roachpb::Timestamp* GCRequest_GCKey::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) {
-    timestamp_ = new ::cockroach::roachpb::Timestamp;
+inline ::cockroach::roachpb::Transaction* PushTxnResponse::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) {
+    pushee_txn_ = new ::cockroach::roachpb::Transaction;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.GCKey.timestamp)
-  return timestamp_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.pushee_txn)
+  return pushee_txn_;
 }
-inline ::cockroach::roachpb::Timestamp* GCRequest_GCKey::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::roachpb::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::roachpb::Transaction* PushTxnResponse::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
   return temp;
 }
-inline void GCRequest_GCKey::set_allocated_timestamp(::cockroach::roachpb::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void PushTxnResponse::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
   } else {
-    clear_has_timestamp();
+    clear_has_pushee_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.GCKey.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.pushee_txn)
 }
 
 // -------------------------------------------------------------------
 
-// GCRequest
+// ResolveIntentRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool GCRequest::has_header() const {
+inline bool ResolveIntentRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GCRequest::set_has_header() {
+inline void ResolveIntentRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GCRequest::clear_has_header() {
+inline void ResolveIntentRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GCRequest::clear_header() {
+inline void ResolveIntentRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& GCRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.header)
+inline const ::cockroach::roachpb::Span& ResolveIntentRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* GCRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* ResolveIntentRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* GCRequest::release_header() {
+inline ::cockroach::roachpb::Span* ResolveIntentRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GCRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void ResolveIntentRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
     set_has_header();
   } else {
-    clear_has_header();
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.header)
+}
+
+// optional .cockroach.roachpb.Transaction intent_txn = 2;
+inline bool ResolveIntentRequest::has_intent_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ResolveIntentRequest::set_has_intent_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ResolveIntentRequest::clear_has_intent_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ResolveIntentRequest::clear_intent_txn() {
+  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_intent_txn();
+}
+inline const ::cockroach::roachpb::Transaction& ResolveIntentRequest::intent_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.intent_txn)
+  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
+}
+inline ::cockroach::roachpb::Transaction* ResolveIntentRequest::mutable_intent_txn() {
+  set_has_intent_txn();
+  if (intent_txn_ == NULL) {
+    intent_txn_ = new ::cockroach::roachpb::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.intent_txn)
+  return intent_txn_;
+}
+inline ::cockroach::roachpb::Transaction* ResolveIntentRequest::release_intent_txn() {
+  clear_has_intent_txn();
+  ::cockroach::roachpb::Transaction* temp = intent_txn_;
+  intent_txn_ = NULL;
+  return temp;
+}
+inline void ResolveIntentRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
+  delete intent_txn_;
+  intent_txn_ = intent_txn;
+  if (intent_txn) {
+    set_has_intent_txn();
+  } else {
+    clear_has_intent_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.intent_txn)
 }
 
-// repeated .cockroach.roachpb.GCRequest.GCKey keys = 3;
-inline int GCRequest::keys_size() const {
-  return keys_.size();
-}
-inline void GCRequest::clear_keys() {
-  keys_.Clear();
+// optional bool poison = 3;
+inline bool ResolveIntentRequest::has_poison() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline const ::cockroach::roachpb::GCRequest_GCKey& GCRequest::keys(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCRequest.keys)
-  return keys_.Get(index);
+inline void ResolveIntentRequest::set_has_poison() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline ::cockroach::roachpb::GCRequest_GCKey* GCRequest::mutable_keys(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCRequest.keys)
-  return keys_.Mutable(index);
+inline void ResolveIntentRequest::clear_has_poison() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline ::cockroach::roachpb::GCRequest_GCKey* GCRequest::add_keys() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.GCRequest.keys)
-  return keys_.Add();
+inline void ResolveIntentRequest::clear_poison() {
+  poison_ = false;
+  clear_has_poison();
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >*
-GCRequest::mutable_keys() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.GCRequest.keys)
-  return &keys_;
+inline bool ResolveIntentRequest::poison() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.poison)
+  return poison_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::GCRequest_GCKey >&
-GCRequest::keys() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.GCRequest.keys)
-  return keys_;
+inline void ResolveIntentRequest::set_poison(bool value) {
+  set_has_poison();
+  poison_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRequest.poison)
 }
 
 // -------------------------------------------------------------------
 
-// GCResponse
+// ResolveIntentResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool GCResponse::has_header() const {
+inline bool ResolveIntentResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GCResponse::set_has_header() {
+inline void ResolveIntentResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GCResponse::clear_has_header() {
+inline void ResolveIntentResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GCResponse::clear_header() {
+inline void ResolveIntentResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& GCResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.GCResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& ResolveIntentResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* GCResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.GCResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* GCResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GCResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void ResolveIntentResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8323,46 +9880,46 @@ inline void GCResponse::set_allocated_header(::cockroach::roachpb::ResponseHeade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.GCResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// PushTxnRequest
+// ResolveIntentRangeRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool PushTxnRequest::has_header() const {
+inline bool ResolveIntentRangeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PushTxnRequest::set_has_header() {
+inline void ResolveIntentRangeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PushTxnRequest::clear_has_header() {
+inline void ResolveIntentRangeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PushTxnRequest::clear_header() {
+inline void ResolveIntentRangeRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& PushTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.header)
+inline const ::cockroach::roachpb::Span& ResolveIntentRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* PushTxnRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* ResolveIntentRangeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* PushTxnRequest::release_header() {
+inline ::cockroach::roachpb::Span* ResolveIntentRangeRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PushTxnRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void ResolveIntentRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8370,243 +9927,113 @@ inline void PushTxnRequest::set_allocated_header(::cockroach::roachpb::Span* hea
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.header)
 }
 
-// optional .cockroach.roachpb.Transaction pusher_txn = 2;
-inline bool PushTxnRequest::has_pusher_txn() const {
+// optional .cockroach.roachpb.Transaction intent_txn = 2;
+inline bool ResolveIntentRangeRequest::has_intent_txn() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void PushTxnRequest::set_has_pusher_txn() {
+inline void ResolveIntentRangeRequest::set_has_intent_txn() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void PushTxnRequest::clear_has_pusher_txn() {
+inline void ResolveIntentRangeRequest::clear_has_intent_txn() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void PushTxnRequest::clear_pusher_txn() {
-  if (pusher_txn_ != NULL) pusher_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_pusher_txn();
-}
-inline const ::cockroach::roachpb::Transaction& PushTxnRequest::pusher_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pusher_txn)
-  return pusher_txn_ != NULL ? *pusher_txn_ : *default_instance_->pusher_txn_;
-}
-inline ::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pusher_txn() {
-  set_has_pusher_txn();
-  if (pusher_txn_ == NULL) {
-    pusher_txn_ = new ::cockroach::roachpb::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pusher_txn)
-  return pusher_txn_;
-}
-inline ::cockroach::roachpb::Transaction* PushTxnRequest::release_pusher_txn() {
-  clear_has_pusher_txn();
-  ::cockroach::roachpb::Transaction* temp = pusher_txn_;
-  pusher_txn_ = NULL;
-  return temp;
-}
-inline void PushTxnRequest::set_allocated_pusher_txn(::cockroach::roachpb::Transaction* pusher_txn) {
-  delete pusher_txn_;
-  pusher_txn_ = pusher_txn;
-  if (pusher_txn) {
-    set_has_pusher_txn();
-  } else {
-    clear_has_pusher_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pusher_txn)
-}
-
-// optional .cockroach.roachpb.Transaction pushee_txn = 3;
-inline bool PushTxnRequest::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void PushTxnRequest::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000004u;
-}
-inline void PushTxnRequest::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-inline void PushTxnRequest::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_pushee_txn();
-}
-inline const ::cockroach::roachpb::Transaction& PushTxnRequest::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
-}
-inline ::cockroach::roachpb::Transaction* PushTxnRequest::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) {
-    pushee_txn_ = new ::cockroach::roachpb::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.pushee_txn)
-  return pushee_txn_;
-}
-inline ::cockroach::roachpb::Transaction* PushTxnRequest::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
-  return temp;
-}
-inline void PushTxnRequest::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
-  } else {
-    clear_has_pushee_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.pushee_txn)
-}
-
-// optional .cockroach.roachpb.Timestamp push_to = 4;
-inline bool PushTxnRequest::has_push_to() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
-}
-inline void PushTxnRequest::set_has_push_to() {
-  _has_bits_[0] |= 0x00000008u;
-}
-inline void PushTxnRequest::clear_has_push_to() {
-  _has_bits_[0] &= ~0x00000008u;
-}
-inline void PushTxnRequest::clear_push_to() {
-  if (push_to_ != NULL) push_to_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_push_to();
-}
-inline const ::cockroach::roachpb::Timestamp& PushTxnRequest::push_to() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_to)
-  return push_to_ != NULL ? *push_to_ : *default_instance_->push_to_;
-}
-inline ::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_push_to() {
-  set_has_push_to();
-  if (push_to_ == NULL) {
-    push_to_ = new ::cockroach::roachpb::Timestamp;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.push_to)
-  return push_to_;
-}
-inline ::cockroach::roachpb::Timestamp* PushTxnRequest::release_push_to() {
-  clear_has_push_to();
-  ::cockroach::roachpb::Timestamp* temp = push_to_;
-  push_to_ = NULL;
-  return temp;
-}
-inline void PushTxnRequest::set_allocated_push_to(::cockroach::roachpb::Timestamp* push_to) {
-  delete push_to_;
-  push_to_ = push_to;
-  if (push_to) {
-    set_has_push_to();
-  } else {
-    clear_has_push_to();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.push_to)
-}
-
-// optional .cockroach.roachpb.Timestamp now = 5;
-inline bool PushTxnRequest::has_now() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
-}
-inline void PushTxnRequest::set_has_now() {
-  _has_bits_[0] |= 0x00000010u;
-}
-inline void PushTxnRequest::clear_has_now() {
-  _has_bits_[0] &= ~0x00000010u;
-}
-inline void PushTxnRequest::clear_now() {
-  if (now_ != NULL) now_->::cockroach::roachpb::Timestamp::Clear();
-  clear_has_now();
+inline void ResolveIntentRangeRequest::clear_intent_txn() {
+  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
+  clear_has_intent_txn();
 }
-inline const ::cockroach::roachpb::Timestamp& PushTxnRequest::now() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.now)
-  return now_ != NULL ? *now_ : *default_instance_->now_;
+inline const ::cockroach::roachpb::Transaction& ResolveIntentRangeRequest::intent_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
+  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
 }
-inline ::cockroach::roachpb::Timestamp* PushTxnRequest::mutable_now() {
-  set_has_now();
-  if (now_ == NULL) {
-    now_ = new ::cockroach::roachpb::Timestamp;
+inline ::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::mutable_intent_txn() {
+  set_has_intent_txn();
+  if (intent_txn_ == NULL) {
+    intent_txn_ = new ::cockroach::roachpb::Transaction;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnRequest.now)
-  return now_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
+  return intent_txn_;
 }
-inline ::cockroach::roachpb::Timestamp* PushTxnRequest::release_now() {
-  clear_has_now();
-  ::cockroach::roachpb::Timestamp* temp = now_;
-  now_ = NULL;
+inline ::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::release_intent_txn() {
+  clear_has_intent_txn();
+  ::cockroach::roachpb::Transaction* temp = intent_txn_;
+  intent_txn_ = NULL;
   return temp;
 }
-inline void PushTxnRequest::set_allocated_now(::cockroach::roachpb::Timestamp* now) {
-  delete now_;
-  now_ = now;
-  if (now) {
-    set_has_now();
+inline void ResolveIntentRangeRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
+  delete intent_txn_;
+  intent_txn_ = intent_txn;
+  if (intent_txn) {
+    set_has_intent_txn();
   } else {
-    clear_has_now();
+    clear_has_intent_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnRequest.now)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
 }
 
-// optional .cockroach.roachpb.PushTxnType push_type = 6;
-inline bool PushTxnRequest::has_push_type() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
+// optional bool poison = 3;
+inline bool ResolveIntentRangeRequest::has_poison() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void PushTxnRequest::set_has_push_type() {
-  _has_bits_[0] |= 0x00000020u;
+inline void ResolveIntentRangeRequest::set_has_poison() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void PushTxnRequest::clear_has_push_type() {
-  _has_bits_[0] &= ~0x00000020u;
+inline void ResolveIntentRangeRequest::clear_has_poison() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void PushTxnRequest::clear_push_type() {
-  push_type_ = 0;
-  clear_has_push_type();
+inline void ResolveIntentRangeRequest::clear_poison() {
+  poison_ = false;
+  clear_has_poison();
 }
-inline ::cockroach::roachpb::PushTxnType PushTxnRequest::push_type() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnRequest.push_type)
-  return static_cast< ::cockroach::roachpb::PushTxnType >(push_type_);
+inline bool ResolveIntentRangeRequest::poison() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.poison)
+  return poison_;
 }
-inline void PushTxnRequest::set_push_type(::cockroach::roachpb::PushTxnType value) {
-  assert(::cockroach::roachpb::PushTxnType_IsValid(value));
-  set_has_push_type();
-  push_type_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.PushTxnRequest.push_type)
+inline void ResolveIntentRangeRequest::set_poison(bool value) {
+  set_has_poison();
+  poison_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRangeRequest.poison)
 }
 
 // -------------------------------------------------------------------
 
-// PushTxnResponse
+// NoopResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool PushTxnResponse::has_header() const {
+inline bool NoopResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PushTxnResponse::set_has_header() {
+inline void NoopResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PushTxnResponse::clear_has_header() {
+inline void NoopResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PushTxnResponse::clear_header() {
+inline void NoopResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& PushTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& NoopResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* PushTxnResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* NoopResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* PushTxnResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* NoopResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PushTxnResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void NoopResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8614,89 +10041,93 @@ inline void PushTxnResponse::set_allocated_header(::cockroach::roachpb::Response
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopResponse.header)
 }
 
-// optional .cockroach.roachpb.Transaction pushee_txn = 2;
-inline bool PushTxnResponse::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// NoopRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool NoopRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PushTxnResponse::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000002u;
+inline void NoopRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void PushTxnResponse::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void NoopRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void PushTxnResponse::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_pushee_txn();
+inline void NoopRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::roachpb::Transaction& PushTxnResponse::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.PushTxnResponse.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+inline const ::cockroach::roachpb::Span& NoopRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Transaction* PushTxnResponse::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) {
-    pushee_txn_ = new ::cockroach::roachpb::Transaction;
+inline ::cockroach::roachpb::Span* NoopRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.PushTxnResponse.pushee_txn)
-  return pushee_txn_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopRequest.header)
+  return header_;
 }
-inline ::cockroach::roachpb::Transaction* PushTxnResponse::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::cockroach::roachpb::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
+inline ::cockroach::roachpb::Span* NoopRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void PushTxnResponse::set_allocated_pushee_txn(::cockroach::roachpb::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
+inline void NoopRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_pushee_txn();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.PushTxnResponse.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// ResolveIntentRequest
+// ResolveIntentRangeResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-inline bool ResolveIntentRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool ResolveIntentRangeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResolveIntentRequest::set_has_header() {
+inline void ResolveIntentRangeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResolveIntentRequest::clear_has_header() {
+inline void ResolveIntentRangeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResolveIntentRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+inline void ResolveIntentRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& ResolveIntentRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.header)
+inline const ::cockroach::roachpb::ResponseHeader& ResolveIntentRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* ResolveIntentRequest::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* ResolveIntentRequest::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ResolveIntentRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void ResolveIntentRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8704,113 +10135,136 @@ inline void ResolveIntentRequest::set_allocated_header(::cockroach::roachpb::Spa
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeResponse.header)
 }
 
-// optional .cockroach.roachpb.Transaction intent_txn = 2;
-inline bool ResolveIntentRequest::has_intent_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// MergeRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool MergeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResolveIntentRequest::set_has_intent_txn() {
-  _has_bits_[0] |= 0x00000002u;
+inline void MergeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResolveIntentRequest::clear_has_intent_txn() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void MergeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResolveIntentRequest::clear_intent_txn() {
-  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_intent_txn();
+inline void MergeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::roachpb::Transaction& ResolveIntentRequest::intent_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.intent_txn)
-  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
+inline const ::cockroach::roachpb::Span& MergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Transaction* ResolveIntentRequest::mutable_intent_txn() {
-  set_has_intent_txn();
-  if (intent_txn_ == NULL) {
-    intent_txn_ = new ::cockroach::roachpb::Transaction;
+inline ::cockroach::roachpb::Span* MergeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRequest.intent_txn)
-  return intent_txn_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.header)
+  return header_;
 }
-inline ::cockroach::roachpb::Transaction* ResolveIntentRequest::release_intent_txn() {
-  clear_has_intent_txn();
-  ::cockroach::roachpb::Transaction* temp = intent_txn_;
-  intent_txn_ = NULL;
+inline ::cockroach::roachpb::Span* MergeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void ResolveIntentRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
-  delete intent_txn_;
-  intent_txn_ = intent_txn;
-  if (intent_txn) {
-    set_has_intent_txn();
+inline void MergeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_intent_txn();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRequest.intent_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.header)
 }
 
-// optional bool poison = 3;
-inline bool ResolveIntentRequest::has_poison() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional .cockroach.roachpb.Value value = 2;
+inline bool MergeRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResolveIntentRequest::set_has_poison() {
-  _has_bits_[0] |= 0x00000004u;
+inline void MergeRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void MergeRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void MergeRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
+  clear_has_value();
 }
-inline void ResolveIntentRequest::clear_has_poison() {
-  _has_bits_[0] &= ~0x00000004u;
+inline const ::cockroach::roachpb::Value& MergeRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline void ResolveIntentRequest::clear_poison() {
-  poison_ = false;
-  clear_has_poison();
+inline ::cockroach::roachpb::Value* MergeRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::roachpb::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.value)
+  return value_;
 }
-inline bool ResolveIntentRequest::poison() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRequest.poison)
-  return poison_;
+inline ::cockroach::roachpb::Value* MergeRequest::release_value() {
+  clear_has_value();
+  ::cockroach::roachpb::Value* temp = value_;
+  value_ = NULL;
+  return temp;
 }
-inline void ResolveIntentRequest::set_poison(bool value) {
-  set_has_poison();
-  poison_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRequest.poison)
+inline void MergeRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.value)
 }
 
 // -------------------------------------------------------------------
 
-// ResolveIntentResponse
+// MergeResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool ResolveIntentResponse::has_header() const {
+inline bool MergeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResolveIntentResponse::set_has_header() {
+inline void MergeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResolveIntentResponse::clear_has_header() {
+inline void MergeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResolveIntentResponse::clear_header() {
+inline void MergeResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& ResolveIntentResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& MergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* MergeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ResolveIntentResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* MergeResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ResolveIntentResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void MergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8818,46 +10272,46 @@ inline void ResolveIntentResponse::set_allocated_header(::cockroach::roachpb::Re
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// ResolveIntentRangeRequest
+// TruncateLogRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool ResolveIntentRangeRequest::has_header() const {
+inline bool TruncateLogRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResolveIntentRangeRequest::set_has_header() {
+inline void TruncateLogRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResolveIntentRangeRequest::clear_has_header() {
+inline void TruncateLogRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResolveIntentRangeRequest::clear_header() {
+inline void TruncateLogRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& ResolveIntentRangeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.header)
+inline const ::cockroach::roachpb::Span& TruncateLogRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* ResolveIntentRangeRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* TruncateLogRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* ResolveIntentRangeRequest::release_header() {
+inline ::cockroach::roachpb::Span* TruncateLogRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ResolveIntentRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void TruncateLogRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8865,113 +10319,94 @@ inline void ResolveIntentRangeRequest::set_allocated_header(::cockroach::roachpb
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogRequest.header)
 }
 
-// optional .cockroach.roachpb.Transaction intent_txn = 2;
-inline bool ResolveIntentRangeRequest::has_intent_txn() const {
+// optional uint64 index = 2;
+inline bool TruncateLogRequest::has_index() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResolveIntentRangeRequest::set_has_intent_txn() {
+inline void TruncateLogRequest::set_has_index() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ResolveIntentRangeRequest::clear_has_intent_txn() {
+inline void TruncateLogRequest::clear_has_index() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ResolveIntentRangeRequest::clear_intent_txn() {
-  if (intent_txn_ != NULL) intent_txn_->::cockroach::roachpb::Transaction::Clear();
-  clear_has_intent_txn();
-}
-inline const ::cockroach::roachpb::Transaction& ResolveIntentRangeRequest::intent_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
-  return intent_txn_ != NULL ? *intent_txn_ : *default_instance_->intent_txn_;
-}
-inline ::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::mutable_intent_txn() {
-  set_has_intent_txn();
-  if (intent_txn_ == NULL) {
-    intent_txn_ = new ::cockroach::roachpb::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
-  return intent_txn_;
+inline void TruncateLogRequest::clear_index() {
+  index_ = GOOGLE_ULONGLONG(0);
+  clear_has_index();
 }
-inline ::cockroach::roachpb::Transaction* ResolveIntentRangeRequest::release_intent_txn() {
-  clear_has_intent_txn();
-  ::cockroach::roachpb::Transaction* temp = intent_txn_;
-  intent_txn_ = NULL;
-  return temp;
+inline ::google::protobuf::uint64 TruncateLogRequest::index() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.index)
+  return index_;
 }
-inline void ResolveIntentRangeRequest::set_allocated_intent_txn(::cockroach::roachpb::Transaction* intent_txn) {
-  delete intent_txn_;
-  intent_txn_ = intent_txn;
-  if (intent_txn) {
-    set_has_intent_txn();
-  } else {
-    clear_has_intent_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeRequest.intent_txn)
+inline void TruncateLogRequest::set_index(::google::protobuf::uint64 value) {
+  set_has_index();
+  index_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.index)
 }
 
-// optional bool poison = 3;
-inline bool ResolveIntentRangeRequest::has_poison() const {
+// optional int64 range_id = 3;
+inline bool TruncateLogRequest::has_range_id() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void ResolveIntentRangeRequest::set_has_poison() {
+inline void TruncateLogRequest::set_has_range_id() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void ResolveIntentRangeRequest::clear_has_poison() {
+inline void TruncateLogRequest::clear_has_range_id() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void ResolveIntentRangeRequest::clear_poison() {
-  poison_ = false;
-  clear_has_poison();
+inline void TruncateLogRequest::clear_range_id() {
+  range_id_ = GOOGLE_LONGLONG(0);
+  clear_has_range_id();
 }
-inline bool ResolveIntentRangeRequest::poison() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeRequest.poison)
-  return poison_;
+inline ::google::protobuf::int64 TruncateLogRequest::range_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.range_id)
+  return range_id_;
 }
-inline void ResolveIntentRangeRequest::set_poison(bool value) {
-  set_has_poison();
-  poison_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ResolveIntentRangeRequest.poison)
+inline void TruncateLogRequest::set_range_id(::google::protobuf::int64 value) {
+  set_has_range_id();
+  range_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.range_id)
 }
 
 // -------------------------------------------------------------------
 
-// NoopResponse
+// TruncateLogResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool NoopResponse::has_header() const {
+inline bool TruncateLogResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void NoopResponse::set_has_header() {
+inline void TruncateLogResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void NoopResponse::clear_has_header() {
+inline void TruncateLogResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void NoopResponse::clear_header() {
+inline void TruncateLogResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& NoopResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& TruncateLogResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* NoopResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* TruncateLogResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* NoopResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* TruncateLogResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void NoopResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void TruncateLogResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8979,46 +10414,46 @@ inline void NoopResponse::set_allocated_header(::cockroach::roachpb::ResponseHea
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// NoopRequest
+// LeaderLeaseRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool NoopRequest::has_header() const {
+inline bool LeaderLeaseRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void NoopRequest::set_has_header() {
+inline void LeaderLeaseRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void NoopRequest::clear_has_header() {
+inline void LeaderLeaseRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void NoopRequest::clear_header() {
+inline void LeaderLeaseRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& NoopRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.NoopRequest.header)
+inline const ::cockroach::roachpb::Span& LeaderLeaseRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* NoopRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* LeaderLeaseRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NoopRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* NoopRequest::release_header() {
+inline ::cockroach::roachpb::Span* LeaderLeaseRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void NoopRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void LeaderLeaseRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -9026,46 +10461,89 @@ inline void NoopRequest::set_allocated_header(::cockroach::roachpb::Span* header
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NoopRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.header)
+}
+
+// optional .cockroach.roachpb.Lease lease = 2;
+inline bool LeaderLeaseRequest::has_lease() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void LeaderLeaseRequest::set_has_lease() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void LeaderLeaseRequest::clear_has_lease() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void LeaderLeaseRequest::clear_lease() {
+  if (lease_ != NULL) lease_->::cockroach::roachpb::Lease::Clear();
+  clear_has_lease();
+}
+inline const ::cockroach::roachpb::Lease& LeaderLeaseRequest::lease() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.lease)
+  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
+}
+inline ::cockroach::roachpb::Lease* LeaderLeaseRequest::mutable_lease() {
+  set_has_lease();
+  if (lease_ == NULL) {
+    lease_ = new ::cockroach::roachpb::Lease;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.lease)
+  return lease_;
+}
+inline ::cockroach::roachpb::Lease* LeaderLeaseRequest::release_lease() {
+  clear_has_lease();
+  ::cockroach::roachpb::Lease* temp = lease_;
+  lease_ = NULL;
+  return temp;
+}
+inline void LeaderLeaseRequest::set_allocated_lease(::cockroach::roachpb::Lease* lease) {
+  delete lease_;
+  lease_ = lease;
+  if (lease) {
+    set_has_lease();
+  } else {
+    clear_has_lease();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.lease)
 }
 
 // -------------------------------------------------------------------
 
-// ResolveIntentRangeResponse
+// LeaderLeaseResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool ResolveIntentRangeResponse::has_header() const {
+inline bool LeaderLeaseResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResolveIntentRangeResponse::set_has_header() {
+inline void LeaderLeaseResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResolveIntentRangeResponse::clear_has_header() {
+inline void LeaderLeaseResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResolveIntentRangeResponse::clear_header() {
+inline void LeaderLeaseResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& ResolveIntentRangeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResolveIntentRangeResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& LeaderLeaseResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResolveIntentRangeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* ResolveIntentRangeResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ResolveIntentRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void LeaderLeaseResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -9073,46 +10551,46 @@ inline void ResolveIntentRangeResponse::set_allocated_header(::cockroach::roachp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResolveIntentRangeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// MergeRequest
+// RaftStatusRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool MergeRequest::has_header() const {
+inline bool RaftStatusRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void MergeRequest::set_has_header() {
+inline void RaftStatusRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void MergeRequest::clear_has_header() {
+inline void RaftStatusRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void MergeRequest::clear_header() {
+inline void RaftStatusRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& MergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.header)
+inline const ::cockroach::roachpb::Span& RaftStatusRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* MergeRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* RaftStatusRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* MergeRequest::release_header() {
+inline ::cockroach::roachpb::Span* RaftStatusRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void MergeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void RaftStatusRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -9120,89 +10598,175 @@ inline void MergeRequest::set_allocated_header(::cockroach::roachpb::Span* heade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusRequest.header)
 }
 
-// optional .cockroach.roachpb.Value value = 2;
-inline bool MergeRequest::has_value() const {
+// -------------------------------------------------------------------
+
+// RaftProgress
+
+// optional int32 replica_id = 1;
+inline bool RaftProgress::has_replica_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RaftProgress::set_has_replica_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RaftProgress::clear_has_replica_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RaftProgress::clear_replica_id() {
+  replica_id_ = 0;
+  clear_has_replica_id();
+}
+inline ::google::protobuf::int32 RaftProgress::replica_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.replica_id)
+  return replica_id_;
+}
+inline void RaftProgress::set_replica_id(::google::protobuf::int32 value) {
+  set_has_replica_id();
+  replica_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.replica_id)
+}
+
+// optional uint64 match = 2;
+inline bool RaftProgress::has_match() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void MergeRequest::set_has_value() {
+inline void RaftProgress::set_has_match() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void MergeRequest::clear_has_value() {
+inline void RaftProgress::clear_has_match() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void MergeRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::roachpb::Value::Clear();
-  clear_has_value();
+inline void RaftProgress::clear_match() {
+  match_ = GOOGLE_ULONGLONG(0);
+  clear_has_match();
 }
-inline const ::cockroach::roachpb::Value& MergeRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline ::google::protobuf::uint64 RaftProgress::match() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.match)
+  return match_;
 }
-inline ::cockroach::roachpb::Value* MergeRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::roachpb::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeRequest.value)
-  return value_;
+inline void RaftProgress::set_match(::google::protobuf::uint64 value) {
+  set_has_match();
+  match_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.match)
 }
-inline ::cockroach::roachpb::Value* MergeRequest::release_value() {
-  clear_has_value();
-  ::cockroach::roachpb::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+
+// optional uint64 next = 3;
+inline bool RaftProgress::has_next() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void MergeRequest::set_allocated_value(::cockroach::roachpb::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
+inline void RaftProgress::set_has_next() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RaftProgress::clear_has_next() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RaftProgress::clear_next() {
+  next_ = GOOGLE_ULONGLONG(0);
+  clear_has_next();
+}
+inline ::google::protobuf::uint64 RaftProgress::next() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.next)
+  return next_;
+}
+inline void RaftProgress::set_next(::google::protobuf::uint64 value) {
+  set_has_next();
+  next_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.next)
+}
+
+// optional string state = 4;
+inline bool RaftProgress::has_state() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void RaftProgress::set_has_state() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void RaftProgress::clear_has_state() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void RaftProgress::clear_state() {
+  state_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_state();
+}
+inline const ::std::string& RaftProgress::state() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftProgress.state)
+  return state_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RaftProgress::set_state(const ::std::string& value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftProgress.state)
+}
+inline void RaftProgress::set_state(const char* value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.RaftProgress.state)
+}
+inline void RaftProgress::set_state(const char* value, size_t size) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.RaftProgress.state)
+}
+inline ::std::string* RaftProgress::mutable_state() {
+  set_has_state();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftProgress.state)
+  return state_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RaftProgress::release_state() {
+  clear_has_state();
+  return state_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RaftProgress::set_allocated_state(::std::string* state) {
+  if (state != NULL) {
+    set_has_state();
   } else {
-    clear_has_value();
+    clear_has_state();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeRequest.value)
+  state_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), state);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftProgress.state)
 }
 
 // -------------------------------------------------------------------
 
-// MergeResponse
+// RaftStatusResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool MergeResponse::has_header() const {
+inline bool RaftStatusResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void MergeResponse::set_has_header() {
+inline void RaftStatusResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void MergeResponse::clear_has_header() {
+inline void RaftStatusResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void MergeResponse::clear_header() {
+inline void RaftStatusResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& MergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.MergeResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& RaftStatusResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* MergeResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* RaftStatusResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.MergeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* MergeResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* RaftStatusResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void MergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void RaftStatusResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -9210,141 +10774,292 @@ inline void MergeResponse::set_allocated_header(::cockroach::roachpb::ResponseHe
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.MergeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusResponse.header)
 }
 
-// -------------------------------------------------------------------
-
-// TruncateLogRequest
+// optional int64 range_id = 2;
+inline bool RaftStatusResponse::has_range_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RaftStatusResponse::set_has_range_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RaftStatusResponse::clear_has_range_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RaftStatusResponse::clear_range_id() {
+  range_id_ = GOOGLE_LONGLONG(0);
+  clear_has_range_id();
+}
+inline ::google::protobuf::int64 RaftStatusResponse::range_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.range_id)
+  return range_id_;
+}
+inline void RaftStatusResponse::set_range_id(::google::protobuf::int64 value) {
+  set_has_range_id();
+  range_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.range_id)
+}
 
-// optional .cockroach.roachpb.Span header = 1;
-inline bool TruncateLogRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.roachpb.ReplicaDescriptor replica = 3;
+inline bool RaftStatusResponse::has_replica() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void TruncateLogRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void RaftStatusResponse::set_has_replica() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void TruncateLogRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void RaftStatusResponse::clear_has_replica() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void TruncateLogRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-  clear_has_header();
+inline void RaftStatusResponse::clear_replica() {
+  if (replica_ != NULL) replica_->::cockroach::roachpb::ReplicaDescriptor::Clear();
+  clear_has_replica();
 }
-inline const ::cockroach::roachpb::Span& TruncateLogRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::roachpb::ReplicaDescriptor& RaftStatusResponse::replica() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.replica)
+  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
 }
-inline ::cockroach::roachpb::Span* TruncateLogRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+inline ::cockroach::roachpb::ReplicaDescriptor* RaftStatusResponse::mutable_replica() {
+  set_has_replica();
+  if (replica_ == NULL) {
+    replica_ = new ::cockroach::roachpb::ReplicaDescriptor;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogRequest.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.replica)
+  return replica_;
 }
-inline ::cockroach::roachpb::Span* TruncateLogRequest::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
-  header_ = NULL;
+inline ::cockroach::roachpb::ReplicaDescriptor* RaftStatusResponse::release_replica() {
+  clear_has_replica();
+  ::cockroach::roachpb::ReplicaDescriptor* temp = replica_;
+  replica_ = NULL;
   return temp;
 }
-inline void TruncateLogRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void RaftStatusResponse::set_allocated_replica(::cockroach::roachpb::ReplicaDescriptor* replica) {
+  delete replica_;
+  replica_ = replica;
+  if (replica) {
+    set_has_replica();
   } else {
-    clear_has_header();
+    clear_has_replica();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusResponse.replica)
 }
 
-// optional uint64 index = 2;
-inline bool TruncateLogRequest::has_index() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional uint64 term = 4;
+inline bool RaftStatusResponse::has_term() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void TruncateLogRequest::set_has_index() {
-  _has_bits_[0] |= 0x00000002u;
+inline void RaftStatusResponse::set_has_term() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline void TruncateLogRequest::clear_has_index() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void RaftStatusResponse::clear_has_term() {
+  _has_bits_[0] &= ~0x00000008u;
 }
-inline void TruncateLogRequest::clear_index() {
-  index_ = GOOGLE_ULONGLONG(0);
-  clear_has_index();
+inline void RaftStatusResponse::clear_term() {
+  term_ = GOOGLE_ULONGLONG(0);
+  clear_has_term();
 }
-inline ::google::protobuf::uint64 TruncateLogRequest::index() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.index)
-  return index_;
+inline ::google::protobuf::uint64 RaftStatusResponse::term() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.term)
+  return term_;
 }
-inline void TruncateLogRequest::set_index(::google::protobuf::uint64 value) {
-  set_has_index();
-  index_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.index)
+inline void RaftStatusResponse::set_term(::google::protobuf::uint64 value) {
+  set_has_term();
+  term_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.term)
 }
 
-// optional int64 range_id = 3;
-inline bool TruncateLogRequest::has_range_id() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional uint64 commit = 5;
+inline bool RaftStatusResponse::has_commit() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
 }
-inline void TruncateLogRequest::set_has_range_id() {
-  _has_bits_[0] |= 0x00000004u;
+inline void RaftStatusResponse::set_has_commit() {
+  _has_bits_[0] |= 0x00000010u;
 }
-inline void TruncateLogRequest::clear_has_range_id() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void RaftStatusResponse::clear_has_commit() {
+  _has_bits_[0] &= ~0x00000010u;
 }
-inline void TruncateLogRequest::clear_range_id() {
-  range_id_ = GOOGLE_LONGLONG(0);
-  clear_has_range_id();
+inline void RaftStatusResponse::clear_commit() {
+  commit_ = GOOGLE_ULONGLONG(0);
+  clear_has_commit();
+}
+inline ::google::protobuf::uint64 RaftStatusResponse::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.commit)
+  return commit_;
+}
+inline void RaftStatusResponse::set_commit(::google::protobuf::uint64 value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.commit)
+}
+
+// optional uint64 applied = 6;
+inline bool RaftStatusResponse::has_applied() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void RaftStatusResponse::set_has_applied() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void RaftStatusResponse::clear_has_applied() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void RaftStatusResponse::clear_applied() {
+  applied_ = GOOGLE_ULONGLONG(0);
+  clear_has_applied();
+}
+inline ::google::protobuf::uint64 RaftStatusResponse::applied() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.applied)
+  return applied_;
+}
+inline void RaftStatusResponse::set_applied(::google::protobuf::uint64 value) {
+  set_has_applied();
+  applied_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.applied)
+}
+
+// optional int32 lead = 7;
+inline bool RaftStatusResponse::has_lead() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void RaftStatusResponse::set_has_lead() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void RaftStatusResponse::clear_has_lead() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void RaftStatusResponse::clear_lead() {
+  lead_ = 0;
+  clear_has_lead();
+}
+inline ::google::protobuf::int32 RaftStatusResponse::lead() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.lead)
+  return lead_;
+}
+inline void RaftStatusResponse::set_lead(::google::protobuf::int32 value) {
+  set_has_lead();
+  lead_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.lead)
+}
+
+// optional string state = 8;
+inline bool RaftStatusResponse::has_state() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void RaftStatusResponse::set_has_state() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void RaftStatusResponse::clear_has_state() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void RaftStatusResponse::clear_state() {
+  state_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_state();
+}
+inline const ::std::string& RaftStatusResponse::state() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.state)
+  return state_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RaftStatusResponse::set_state(const ::std::string& value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RaftStatusResponse.state)
+}
+inline void RaftStatusResponse::set_state(const char* value) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.RaftStatusResponse.state)
+}
+inline void RaftStatusResponse::set_state(const char* value, size_t size) {
+  set_has_state();
+  state_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.RaftStatusResponse.state)
+}
+inline ::std::string* RaftStatusResponse::mutable_state() {
+  set_has_state();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.state)
+  return state_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RaftStatusResponse::release_state() {
+  clear_has_state();
+  return state_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RaftStatusResponse::set_allocated_state(::std::string* state) {
+  if (state != NULL) {
+    set_has_state();
+  } else {
+    clear_has_state();
+  }
+  state_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), state);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RaftStatusResponse.state)
+}
+
+// repeated .cockroach.roachpb.RaftProgress progress = 9;
+inline int RaftStatusResponse::progress_size() const {
+  return progress_.size();
+}
+inline void RaftStatusResponse::clear_progress() {
+  progress_.Clear();
+}
+inline const ::cockroach::roachpb::RaftProgress& RaftStatusResponse::progress(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_.Get(index);
 }
-inline ::google::protobuf::int64 TruncateLogRequest::range_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogRequest.range_id)
-  return range_id_;
+inline ::cockroach::roachpb::RaftProgress* RaftStatusResponse::mutable_progress(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_.Mutable(index);
 }
-inline void TruncateLogRequest::set_range_id(::google::protobuf::int64 value) {
-  set_has_range_id();
-  range_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.TruncateLogRequest.range_id)
+inline ::cockroach::roachpb::RaftProgress* RaftStatusResponse::add_progress() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_.Add();
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RaftProgress >*
+RaftStatusResponse::mutable_progress() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.RaftStatusResponse.progress)
+  return &progress_;
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::RaftProgress >&
+RaftStatusResponse::progress() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.RaftStatusResponse.progress)
+  return progress_;
 }
 
 // -------------------------------------------------------------------
 
-// TruncateLogResponse
+// RangeStatsRequest
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool TruncateLogResponse::has_header() const {
+// optional .cockroach.roachpb.Span header = 1;
+inline bool RangeStatsRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void TruncateLogResponse::set_has_header() {
+inline void RangeStatsRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void TruncateLogResponse::clear_has_header() {
+inline void RangeStatsRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void TruncateLogResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+inline void RangeStatsRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& TruncateLogResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.TruncateLogResponse.header)
+inline const ::cockroach::roachpb::Span& RangeStatsRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* TruncateLogResponse::mutable_header() {
+inline ::cockroach::roachpb::Span* RangeStatsRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+    header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.TruncateLogResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* TruncateLogResponse::release_header() {
+inline ::cockroach::roachpb::Span* RangeStatsRequest::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void TruncateLogResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void RangeStatsRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -9352,46 +11067,46 @@ inline void TruncateLogResponse::set_allocated_header(::cockroach::roachpb::Resp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.TruncateLogResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// LeaderLeaseRequest
+// RangeStatsResponse
 
-// optional .cockroach.roachpb.Span header = 1;
-inline bool LeaderLeaseRequest::has_header() const {
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool RangeStatsResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void LeaderLeaseRequest::set_has_header() {
+inline void RangeStatsResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void LeaderLeaseRequest::clear_has_header() {
+inline void RangeStatsResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void LeaderLeaseRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+inline void RangeStatsResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& LeaderLeaseRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.header)
+inline const ::cockroach::roachpb::ResponseHeader& RangeStatsResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* LeaderLeaseRequest::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* RangeStatsResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::Span;
+    header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* LeaderLeaseRequest::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* RangeStatsResponse::release_header() {
   clear_has_header();
-  ::cockroach::roachpb::Span* temp = header_;
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void LeaderLeaseRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void RangeStatsResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -9399,97 +11114,189 @@ inline void LeaderLeaseRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsResponse.header)
 }
 
-// optional .cockroach.roachpb.Lease lease = 2;
-inline bool LeaderLeaseRequest::has_lease() const {
+// optional int64 range_id = 2;
+inline bool RangeStatsResponse::has_range_id() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void LeaderLeaseRequest::set_has_lease() {
+inline void RangeStatsResponse::set_has_range_id() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void LeaderLeaseRequest::clear_has_lease() {
+inline void RangeStatsResponse::clear_has_range_id() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void LeaderLeaseRequest::clear_lease() {
-  if (lease_ != NULL) lease_->::cockroach::roachpb::Lease::Clear();
-  clear_has_lease();
+inline void RangeStatsResponse::clear_range_id() {
+  range_id_ = GOOGLE_LONGLONG(0);
+  clear_has_range_id();
 }
-inline const ::cockroach::roachpb::Lease& LeaderLeaseRequest::lease() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseRequest.lease)
-  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
+inline ::google::protobuf::int64 RangeStatsResponse::range_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.range_id)
+  return range_id_;
 }
-inline ::cockroach::roachpb::Lease* LeaderLeaseRequest::mutable_lease() {
-  set_has_lease();
-  if (lease_ == NULL) {
-    lease_ = new ::cockroach::roachpb::Lease;
+inline void RangeStatsResponse::set_range_id(::google::protobuf::int64 value) {
+  set_has_range_id();
+  range_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.range_id)
+}
+
+// optional .cockroach.roachpb.RangeDescriptor desc = 3;
+inline bool RangeStatsResponse::has_desc() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RangeStatsResponse::set_has_desc() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RangeStatsResponse::clear_has_desc() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RangeStatsResponse::clear_desc() {
+  if (desc_ != NULL) desc_->::cockroach::roachpb::RangeDescriptor::Clear();
+  clear_has_desc();
+}
+inline const ::cockroach::roachpb::RangeDescriptor& RangeStatsResponse::desc() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.desc)
+  return desc_ != NULL ? *desc_ : *default_instance_->desc_;
+}
+inline ::cockroach::roachpb::RangeDescriptor* RangeStatsResponse::mutable_desc() {
+  set_has_desc();
+  if (desc_ == NULL) {
+    desc_ = new ::cockroach::roachpb::RangeDescriptor;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseRequest.lease)
-  return lease_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsResponse.desc)
+  return desc_;
 }
-inline ::cockroach::roachpb::Lease* LeaderLeaseRequest::release_lease() {
-  clear_has_lease();
-  ::cockroach::roachpb::Lease* temp = lease_;
-  lease_ = NULL;
+inline ::cockroach::roachpb::RangeDescriptor* RangeStatsResponse::release_desc() {
+  clear_has_desc();
+  ::cockroach::roachpb::RangeDescriptor* temp = desc_;
+  desc_ = NULL;
   return temp;
 }
-inline void LeaderLeaseRequest::set_allocated_lease(::cockroach::roachpb::Lease* lease) {
-  delete lease_;
-  lease_ = lease;
-  if (lease) {
-    set_has_lease();
+inline void RangeStatsResponse::set_allocated_desc(::cockroach::roachpb::RangeDescriptor* desc) {
+  delete desc_;
+  desc_ = desc;
+  if (desc) {
+    set_has_desc();
   } else {
-    clear_has_lease();
+    clear_has_desc();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseRequest.lease)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsResponse.desc)
 }
 
-// -------------------------------------------------------------------
+// optional int64 live_bytes = 4;
+inline bool RangeStatsResponse::has_live_bytes() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void RangeStatsResponse::set_has_live_bytes() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void RangeStatsResponse::clear_has_live_bytes() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void RangeStatsResponse::clear_live_bytes() {
+  live_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_live_bytes();
+}
+inline ::google::protobuf::int64 RangeStatsResponse::live_bytes() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.live_bytes)
+  return live_bytes_;
+}
+inline void RangeStatsResponse::set_live_bytes(::google::protobuf::int64 value) {
+  set_has_live_bytes();
+  live_bytes_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.live_bytes)
+}
 
-// LeaderLeaseResponse
+// optional int64 live_count = 5;
+inline bool RangeStatsResponse::has_live_count() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void RangeStatsResponse::set_has_live_count() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void RangeStatsResponse::clear_has_live_count() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void RangeStatsResponse::clear_live_count() {
+  live_count_ = GOOGLE_LONGLONG(0);
+  clear_has_live_count();
+}
+inline ::google::protobuf::int64 RangeStatsResponse::live_count() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.live_count)
+  return live_count_;
+}
+inline void RangeStatsResponse::set_live_count(::google::protobuf::int64 value) {
+  set_has_live_count();
+  live_count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.live_count)
+}
 
-// optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool LeaderLeaseResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional int64 key_count = 6;
+inline bool RangeStatsResponse::has_key_count() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
 }
-inline void LeaderLeaseResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void RangeStatsResponse::set_has_key_count() {
+  _has_bits_[0] |= 0x00000020u;
 }
-inline void LeaderLeaseResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void RangeStatsResponse::clear_has_key_count() {
+  _has_bits_[0] &= ~0x00000020u;
 }
-inline void LeaderLeaseResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
-  clear_has_header();
+inline void RangeStatsResponse::clear_key_count() {
+  key_count_ = GOOGLE_LONGLONG(0);
+  clear_has_key_count();
 }
-inline const ::cockroach::roachpb::ResponseHeader& LeaderLeaseResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.LeaderLeaseResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline ::google::protobuf::int64 RangeStatsResponse::key_count() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.key_count)
+  return key_count_;
 }
-inline ::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::roachpb::ResponseHeader;
+inline void RangeStatsResponse::set_key_count(::google::protobuf::int64 value) {
+  set_has_key_count();
+  key_count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.RangeStatsResponse.key_count)
+}
+
+// optional .cockroach.roachpb.Timestamp gc_threshold = 7;
+inline bool RangeStatsResponse::has_gc_threshold() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void RangeStatsResponse::set_has_gc_threshold() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void RangeStatsResponse::clear_has_gc_threshold() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void RangeStatsResponse::clear_gc_threshold() {
+  if (gc_threshold_ != NULL) gc_threshold_->::cockroach::roachpb::Timestamp::Clear();
+  clear_has_gc_threshold();
+}
+inline const ::cockroach::roachpb::Timestamp& RangeStatsResponse::gc_threshold() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RangeStatsResponse.gc_threshold)
+  return gc_threshold_ != NULL ? *gc_threshold_ : *default_instance_->gc_threshold_;
+}
+inline ::cockroach::roachpb::Timestamp* RangeStatsResponse::mutable_gc_threshold() {
+  set_has_gc_threshold();
+  if (gc_threshold_ == NULL) {
+    gc_threshold_ = new ::cockroach::roachpb::Timestamp;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.LeaderLeaseResponse.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RangeStatsResponse.gc_threshold)
+  return gc_threshold_;
 }
-inline ::cockroach::roachpb::ResponseHeader* LeaderLeaseResponse::release_header() {
-  clear_has_header();
-  ::cockroach::roachpb::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::roachpb::Timestamp* RangeStatsResponse::release_gc_threshold() {
+  clear_has_gc_threshold();
+  ::cockroach::roachpb::Timestamp* temp = gc_threshold_;
+  gc_threshold_ = NULL;
   return temp;
 }
-inline void LeaderLeaseResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void RangeStatsResponse::set_allocated_gc_threshold(::cockroach::roachpb::Timestamp* gc_threshold) {
+  delete gc_threshold_;
+  gc_threshold_ = gc_threshold;
+  if (gc_threshold) {
+    set_has_gc_threshold();
   } else {
-    clear_has_header();
+    clear_has_gc_threshold();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.LeaderLeaseResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RangeStatsResponse.gc_threshold)
 }
 
 // -------------------------------------------------------------------
@@ -10442,6 +12249,135 @@ inline void RequestUnion::set_allocated_noop(::cockroach::roachpb::NoopRequest*
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.noop)
 }
 
+// optional .cockroach.roachpb.RaftStatusRequest raft_status = 23;
+inline bool RequestUnion::has_raft_status() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+inline void RequestUnion::set_has_raft_status() {
+  _has_bits_[0] |= 0x00400000u;
+}
+inline void RequestUnion::clear_has_raft_status() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+inline void RequestUnion::clear_raft_status() {
+  if (raft_status_ != NULL) raft_status_->::cockroach::roachpb::RaftStatusRequest::Clear();
+  clear_has_raft_status();
+}
+inline const ::cockroach::roachpb::RaftStatusRequest& RequestUnion::raft_status() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.raft_status)
+  return raft_status_ != NULL ? *raft_status_ : *default_instance_->raft_status_;
+}
+inline ::cockroach::roachpb::RaftStatusRequest* RequestUnion::mutable_raft_status() {
+  set_has_raft_status();
+  if (raft_status_ == NULL) {
+    raft_status_ = new ::cockroach::roachpb::RaftStatusRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.raft_status)
+  return raft_status_;
+}
+inline ::cockroach::roachpb::RaftStatusRequest* RequestUnion::release_raft_status() {
+  clear_has_raft_status();
+  ::cockroach::roachpb::RaftStatusRequest* temp = raft_status_;
+  raft_status_ = NULL;
+  return temp;
+}
+inline void RequestUnion::set_allocated_raft_status(::cockroach::roachpb::RaftStatusRequest* raft_status) {
+  delete raft_status_;
+  raft_status_ = raft_status;
+  if (raft_status) {
+    set_has_raft_status();
+  } else {
+    clear_has_raft_status();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.raft_status)
+}
+
+// optional .cockroach.roachpb.RangeStatsRequest range_stats = 24;
+inline bool RequestUnion::has_range_stats() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+inline void RequestUnion::set_has_range_stats() {
+  _has_bits_[0] |= 0x00800000u;
+}
+inline void RequestUnion::clear_has_range_stats() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+inline void RequestUnion::clear_range_stats() {
+  if (range_stats_ != NULL) range_stats_->::cockroach::roachpb::RangeStatsRequest::Clear();
+  clear_has_range_stats();
+}
+inline const ::cockroach::roachpb::RangeStatsRequest& RequestUnion::range_stats() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.range_stats)
+  return range_stats_ != NULL ? *range_stats_ : *default_instance_->range_stats_;
+}
+inline ::cockroach::roachpb::RangeStatsRequest* RequestUnion::mutable_range_stats() {
+  set_has_range_stats();
+  if (range_stats_ == NULL) {
+    range_stats_ = new ::cockroach::roachpb::RangeStatsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.range_stats)
+  return range_stats_;
+}
+inline ::cockroach::roachpb::RangeStatsRequest* RequestUnion::release_range_stats() {
+  clear_has_range_stats();
+  ::cockroach::roachpb::RangeStatsRequest* temp = range_stats_;
+  range_stats_ = NULL;
+  return temp;
+}
+inline void RequestUnion::set_allocated_range_stats(::cockroach::roachpb::RangeStatsRequest* range_stats) {
+  delete range_stats_;
+  range_stats_ = range_stats;
+  if (range_stats) {
+    set_has_range_stats();
+  } else {
+    clear_has_range_stats();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.range_stats)
+}
+
+// optional .cockroach.roachpb.AdminScatterRequest admin_scatter = 25;
+inline bool RequestUnion::has_admin_scatter() const {
+  return (_has_bits_[0] & 0x01000000u) != 0;
+}
+inline void RequestUnion::set_has_admin_scatter() {
+  _has_bits_[0] |= 0x01000000u;
+}
+inline void RequestUnion::clear_has_admin_scatter() {
+  _has_bits_[0] &= ~0x01000000u;
+}
+inline void RequestUnion::clear_admin_scatter() {
+  if (admin_scatter_ != NULL) admin_scatter_->::cockroach::roachpb::AdminScatterRequest::Clear();
+  clear_has_admin_scatter();
+}
+inline const ::cockroach::roachpb::AdminScatterRequest& RequestUnion::admin_scatter() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.admin_scatter)
+  return admin_scatter_ != NULL ? *admin_scatter_ : *default_instance_->admin_scatter_;
+}
+inline ::cockroach::roachpb::AdminScatterRequest* RequestUnion::mutable_admin_scatter() {
+  set_has_admin_scatter();
+  if (admin_scatter_ == NULL) {
+    admin_scatter_ = new ::cockroach::roachpb::AdminScatterRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.admin_scatter)
+  return admin_scatter_;
+}
+inline ::cockroach::roachpb::AdminScatterRequest* RequestUnion::release_admin_scatter() {
+  clear_has_admin_scatter();
+  ::cockroach::roachpb::AdminScatterRequest* temp = admin_scatter_;
+  admin_scatter_ = NULL;
+  return temp;
+}
+inline void RequestUnion::set_allocated_admin_scatter(::cockroach::roachpb::AdminScatterRequest* admin_scatter) {
+  delete admin_scatter_;
+  admin_scatter_ = admin_scatter;
+  if (admin_scatter) {
+    set_has_admin_scatter();
+  } else {
+    clear_has_admin_scatter();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.admin_scatter)
+}
+
 // -------------------------------------------------------------------
 
 // ResponseUnion
@@ -11392,6 +13328,135 @@ inline void ResponseUnion::set_allocated_noop(::cockroach::roachpb::NoopResponse
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.noop)
 }
 
+// optional .cockroach.roachpb.RaftStatusResponse raft_status = 23;
+inline bool ResponseUnion::has_raft_status() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+inline void ResponseUnion::set_has_raft_status() {
+  _has_bits_[0] |= 0x00400000u;
+}
+inline void ResponseUnion::clear_has_raft_status() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+inline void ResponseUnion::clear_raft_status() {
+  if (raft_status_ != NULL) raft_status_->::cockroach::roachpb::RaftStatusResponse::Clear();
+  clear_has_raft_status();
+}
+inline const ::cockroach::roachpb::RaftStatusResponse& ResponseUnion::raft_status() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.raft_status)
+  return raft_status_ != NULL ? *raft_status_ : *default_instance_->raft_status_;
+}
+inline ::cockroach::roachpb::RaftStatusResponse* ResponseUnion::mutable_raft_status() {
+  set_has_raft_status();
+  if (raft_status_ == NULL) {
+    raft_status_ = new ::cockroach::roachpb::RaftStatusResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.raft_status)
+  return raft_status_;
+}
+inline ::cockroach::roachpb::RaftStatusResponse* ResponseUnion::release_raft_status() {
+  clear_has_raft_status();
+  ::cockroach::roachpb::RaftStatusResponse* temp = raft_status_;
+  raft_status_ = NULL;
+  return temp;
+}
+inline void ResponseUnion::set_allocated_raft_status(::cockroach::roachpb::RaftStatusResponse* raft_status) {
+  delete raft_status_;
+  raft_status_ = raft_status;
+  if (raft_status) {
+    set_has_raft_status();
+  } else {
+    clear_has_raft_status();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.raft_status)
+}
+
+// optional .cockroach.roachpb.RangeStatsResponse range_stats = 24;
+inline bool ResponseUnion::has_range_stats() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+inline void ResponseUnion::set_has_range_stats() {
+  _has_bits_[0] |= 0x00800000u;
+}
+inline void ResponseUnion::clear_has_range_stats() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+inline void ResponseUnion::clear_range_stats() {
+  if (range_stats_ != NULL) range_stats_->::cockroach::roachpb::RangeStatsResponse::Clear();
+  clear_has_range_stats();
+}
+inline const ::cockroach::roachpb::RangeStatsResponse& ResponseUnion::range_stats() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.range_stats)
+  return range_stats_ != NULL ? *range_stats_ : *default_instance_->range_stats_;
+}
+inline ::cockroach::roachpb::RangeStatsResponse* ResponseUnion::mutable_range_stats() {
+  set_has_range_stats();
+  if (range_stats_ == NULL) {
+    range_stats_ = new ::cockroach::roachpb::RangeStatsResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.range_stats)
+  return range_stats_;
+}
+inline ::cockroach::roachpb::RangeStatsResponse* ResponseUnion::release_range_stats() {
+  clear_has_range_stats();
+  ::cockroach::roachpb::RangeStatsResponse* temp = range_stats_;
+  range_stats_ = NULL;
+  return temp;
+}
+inline void ResponseUnion::set_allocated_range_stats(::cockroach::roachpb::RangeStatsResponse* range_stats) {
+  delete range_stats_;
+  range_stats_ = range_stats;
+  if (range_stats) {
+    set_has_range_stats();
+  } else {
+    clear_has_range_stats();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.range_stats)
+}
+
+// optional .cockroach.roachpb.AdminScatterResponse admin_scatter = 25;
+inline bool ResponseUnion::has_admin_scatter() const {
+  return (_has_bits_[0] & 0x01000000u) != 0;
+}
+inline void ResponseUnion::set_has_admin_scatter() {
+  _has_bits_[0] |= 0x01000000u;
+}
+inline void ResponseUnion::clear_has_admin_scatter() {
+  _has_bits_[0] &= ~0x01000000u;
+}
+inline void ResponseUnion::clear_admin_scatter() {
+  if (admin_scatter_ != NULL) admin_scatter_->::cockroach::roachpb::AdminScatterResponse::Clear();
+  clear_has_admin_scatter();
+}
+inline const ::cockroach::roachpb::AdminScatterResponse& ResponseUnion::admin_scatter() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.admin_scatter)
+  return admin_scatter_ != NULL ? *admin_scatter_ : *default_instance_->admin_scatter_;
+}
+inline ::cockroach::roachpb::AdminScatterResponse* ResponseUnion::mutable_admin_scatter() {
+  set_has_admin_scatter();
+  if (admin_scatter_ == NULL) {
+    admin_scatter_ = new ::cockroach::roachpb::AdminScatterResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.admin_scatter)
+  return admin_scatter_;
+}
+inline ::cockroach::roachpb::AdminScatterResponse* ResponseUnion::release_admin_scatter() {
+  clear_has_admin_scatter();
+  ::cockroach::roachpb::AdminScatterResponse* temp = admin_scatter_;
+  admin_scatter_ = NULL;
+  return temp;
+}
+inline void ResponseUnion::set_allocated_admin_scatter(::cockroach::roachpb::AdminScatterResponse* admin_scatter) {
+  delete admin_scatter_;
+  admin_scatter_ = admin_scatter;
+  if (admin_scatter) {
+    set_has_admin_scatter();
+  } else {
+    clear_has_admin_scatter();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.admin_scatter)
+}
+
 // -------------------------------------------------------------------
 
 // Header
@@ -11598,6 +13663,30 @@ inline void Header::set_read_consistency(::cockroach::roachpb::ReadConsistencyTy
   // @@protoc_insertion_point(field_set:cockroach.roachpb.Header.read_consistency)
 }
 
+// optional uint64 trace_context = 7;
+inline bool Header::has_trace_context() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void Header::set_has_trace_context() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void Header::clear_has_trace_context() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void Header::clear_trace_context() {
+  trace_context_ = GOOGLE_ULONGLONG(0);
+  clear_has_trace_context();
+}
+inline ::google::protobuf::uint64 Header::trace_context() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.Header.trace_context)
+  return trace_context_;
+}
+inline void Header::set_trace_context(::google::protobuf::uint64 value) {
+  set_has_trace_context();
+  trace_context_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.Header.trace_context)
+}
+
 // -------------------------------------------------------------------
 
 // BatchRequest
@@ -11988,6 +14077,20 @@ BatchResponse::responses() const {
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -46,6 +46,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* StoreDescriptor_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   StoreDescriptor_reflection_ = NULL;
+const ::google::protobuf::Descriptor* DecommissionStatus_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  DecommissionStatus_reflection_ = NULL;
 
 }  // namespace
 
@@ -159,10 +162,11 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fmetadata_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreCapacity, _internal_metadata_),
       -1);
   NodeDescriptor_descriptor_ = file->message_type(6);
-  static const int NodeDescriptor_offsets_[3] = {
+  static const int NodeDescriptor_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeDescriptor, node_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeDescriptor, address_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeDescriptor, attrs_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeDescriptor, sql_address_),
   };
   NodeDescriptor_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -193,6 +197,22 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fmetadata_2eproto() {
       sizeof(StoreDescriptor),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreDescriptor, _internal_metadata_),
       -1);
+  DecommissionStatus_descriptor_ = file->message_type(8);
+  static const int DecommissionStatus_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DecommissionStatus, decommissioning_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DecommissionStatus, decommissioned_),
+  };
+  DecommissionStatus_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      DecommissionStatus_descriptor_,
+      DecommissionStatus::default_instance_,
+      DecommissionStatus_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DecommissionStatus, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(DecommissionStatus),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DecommissionStatus, _internal_metadata_),
+      -1);
 }
 
 namespace {
@@ -221,6 +241,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
       NodeDescriptor_descriptor_, &NodeDescriptor::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       StoreDescriptor_descriptor_, &StoreDescriptor::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      DecommissionStatus_descriptor_, &DecommissionStatus::default_instance());
 }
 
 }  // namespace
@@ -242,6 +264,8 @@ void protobuf_ShutdownFile_cockroach_2froachpb_2fmetadata_2eproto() {
   delete NodeDescriptor_reflection_;
   delete StoreDescriptor::default_instance_;
   delete StoreDescriptor_reflection_;
+  delete DecommissionStatus::default_instance_;
+  delete DecommissionStatus_reflection_;
 }
 
 void protobuf_AddDesc_cockroach_2froachpb_2fmetadata_2eproto() {
@@ -274,17 +298,22 @@ void protobuf_AddDesc_cockroach_2froachpb_2fmetadata_2eproto() {
     "_key\030\004 \001(\014B\010\372\336\037\004RKey\022\033\n\tright_key\030\005 \001(\014B"
     "\010\372\336\037\004RKey\"Z\n\rStoreCapacity\022\026\n\010Capacity\030\001"
     " \001(\003B\004\310\336\037\000\022\027\n\tAvailable\030\002 \001(\003B\004\310\336\037\000\022\030\n\nR"
-    "angeCount\030\003 \001(\005B\004\310\336\037\000\"\246\001\n\016NodeDescriptor"
+    "angeCount\030\003 \001(\005B\004\310\336\037\000\"\357\001\n\016NodeDescriptor"
     "\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\372\336\037\006Nod"
     "eID\0225\n\007address\030\002 \001(\0132\036.cockroach.util.Un"
     "resolvedAddrB\004\310\336\037\000\0222\n\005attrs\030\003 \001(\0132\035.cock"
-    "roach.roachpb.AttributesB\004\310\336\037\000\"\344\001\n\017Store"
-    "Descriptor\022,\n\010store_id\030\001 \001(\005B\032\310\336\037\000\342\336\037\007St"
-    "oreID\372\336\037\007StoreID\0222\n\005attrs\030\002 \001(\0132\035.cockro"
-    "ach.roachpb.AttributesB\004\310\336\037\000\0225\n\004node\030\003 \001"
-    "(\0132!.cockroach.roachpb.NodeDescriptorB\004\310"
-    "\336\037\000\0228\n\010capacity\030\004 \001(\0132 .cockroach.roachp"
-    "b.StoreCapacityB\004\310\336\037\000B\tZ\007roachpbX\001", 1274);
+    "roach.roachpb.AttributesB\004\310\336\037\000\022G\n\013sql_ad"
+    "dress\030\004 \001(\0132\036.cockroach.util.UnresolvedA"
+    "ddrB\022\310\336\037\000\342\336\037\nSQLAddress\"\344\001\n\017StoreDescrip"
+    "tor\022,\n\010store_id\030\001 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\372\336"
+    "\037\007StoreID\0222\n\005attrs\030\002 \001(\0132\035.cockroach.roa"
+    "chpb.AttributesB\004\310\336\037\000\0225\n\004node\030\003 \001(\0132!.co"
+    "ckroach.roachpb.NodeDescriptorB\004\310\336\037\000\0228\n\010"
+    "capacity\030\004 \001(\0132 .cockroach.roachpb.Store"
+    "CapacityB\004\310\336\037\000\"]\n\022DecommissionStatus\022#\n\017"
+    "decommissioning\030\001 \003(\005B\n\372\336\037\006NodeID\022\"\n\016dec"
+    "ommissioned\030\002 \003(\005B\n\372\336\037\006NodeIDB\tZ\007roachpb"
+    "X\001", 1442);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/roachpb/metadata.proto", &protobuf_RegisterTypes);
   Attributes::default_instance_ = new Attributes();
@@ -295,6 +324,7 @@ void protobuf_AddDesc_cockroach_2froachpb_2fmetadata_2eproto() {
   StoreCapacity::default_instance_ = new StoreCapacity();
   NodeDescriptor::default_instance_ = new NodeDescriptor();
   StoreDescriptor::default_instance_ = new StoreDescriptor();
+  DecommissionStatus::default_instance_ = new DecommissionStatus();
   Attributes::default_instance_->InitAsDefaultInstance();
   ReplicaDescriptor::default_instance_->InitAsDefaultInstance();
   RangeDescriptor::default_instance_->InitAsDefaultInstance();
@@ -303,6 +333,7 @@ void protobuf_AddDesc_cockroach_2froachpb_2fmetadata_2eproto() {
   StoreCapacity::default_instance_->InitAsDefaultInstance();
   NodeDescriptor::default_instance_->InitAsDefaultInstance();
   StoreDescriptor::default_instance_->InitAsDefaultInstance();
+  DecommissionStatus::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_cockroach_2froachpb_2fmetadata_2eproto);
 }
 
@@ -2960,6 +2991,7 @@ void StoreCapacity::clear_rangecount() {
 const int NodeDescriptor::kNodeIdFieldNumber;
 const int NodeDescriptor::kAddressFieldNumber;
 const int NodeDescriptor::kAttrsFieldNumber;
+const int NodeDescriptor::kSqlAddressFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
 NodeDescriptor::NodeDescriptor()
@@ -2971,6 +3003,7 @@ NodeDescriptor::NodeDescriptor()
 void NodeDescriptor::InitAsDefaultInstance() {
   address_ = const_cast< ::cockroach::util::UnresolvedAddr*>(&::cockroach::util::UnresolvedAddr::default_instance());
   attrs_ = const_cast< ::cockroach::roachpb::Attributes*>(&::cockroach::roachpb::Attributes::default_instance());
+  sql_address_ = const_cast< ::cockroach::util::UnresolvedAddr*>(&::cockroach::util::UnresolvedAddr::default_instance());
 }
 
 NodeDescriptor::NodeDescriptor(const NodeDescriptor& from)
@@ -2986,6 +3019,7 @@ void NodeDescriptor::SharedCtor() {
   node_id_ = 0;
   address_ = NULL;
   attrs_ = NULL;
+  sql_address_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -2998,6 +3032,7 @@ void NodeDescriptor::SharedDtor() {
   if (this != default_instance_) {
     delete address_;
     delete attrs_;
+    delete sql_address_;
   }
 }
 
@@ -3027,7 +3062,7 @@ NodeDescriptor* NodeDescriptor::New(::google::protobuf::Arena* arena) const {
 }
 
 void NodeDescriptor::Clear() {
-  if (_has_bits_[0 / 32] & 7u) {
+  if (_has_bits_[0 / 32] & 15u) {
     node_id_ = 0;
     if (has_address()) {
       if (address_ != NULL) address_->::cockroach::util::UnresolvedAddr::Clear();
@@ -3035,6 +3070,9 @@ void NodeDescriptor::Clear() {
     if (has_attrs()) {
       if (attrs_ != NULL) attrs_->::cockroach::roachpb::Attributes::Clear();
     }
+    if (has_sql_address()) {
+      if (sql_address_ != NULL) sql_address_->::cockroach::util::UnresolvedAddr::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -3088,6 +3126,19 @@ bool NodeDescriptor::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(34)) goto parse_sql_address;
+        break;
+      }
+
+      // optional .cockroach.util.UnresolvedAddr sql_address = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_sql_address:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_sql_address()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3134,6 +3185,12 @@ void NodeDescriptor::SerializeWithCachedSizes(
       3, *this->attrs_, output);
   }
 
+  // optional .cockroach.util.UnresolvedAddr sql_address = 4;
+  if (has_sql_address()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, *this->sql_address_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -3163,6 +3220,13 @@ void NodeDescriptor::SerializeWithCachedSizes(
         3, *this->attrs_, target);
   }
 
+  // optional .cockroach.util.UnresolvedAddr sql_address = 4;
+  if (has_sql_address()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, *this->sql_address_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -3174,7 +3238,7 @@ void NodeDescriptor::SerializeWithCachedSizes(
 int NodeDescriptor::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7u) {
+  if (_has_bits_[0 / 32] & 15u) {
     // optional int32 node_id = 1;
     if (has_node_id()) {
       total_size += 1 +
@@ -3196,6 +3260,13 @@ int NodeDescriptor::ByteSize() const {
           *this->attrs_);
     }
 
+    // optional .cockroach.util.UnresolvedAddr sql_address = 4;
+    if (has_sql_address()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->sql_address_);
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -3232,6 +3303,9 @@ void NodeDescriptor::MergeFrom(const NodeDescriptor& from) {
     if (from.has_attrs()) {
       mutable_attrs()->::cockroach::roachpb::Attributes::MergeFrom(from.attrs());
     }
+    if (from.has_sql_address()) {
+      mutable_sql_address()->::cockroach::util::UnresolvedAddr::MergeFrom(from.sql_address());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -3263,6 +3337,7 @@ void NodeDescriptor::InternalSwap(NodeDescriptor* other) {
   std::swap(node_id_, other->node_id_);
   std::swap(address_, other->address_);
   std::swap(attrs_, other->attrs_);
+  std::swap(sql_address_, other->sql_address_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -3389,6 +3464,49 @@ void NodeDescriptor::set_allocated_attrs(::cockroach::roachpb::Attributes* attrs
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NodeDescriptor.attrs)
 }
 
+// optional .cockroach.util.UnresolvedAddr sql_address = 4;
+bool NodeDescriptor::has_sql_address() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void NodeDescriptor::set_has_sql_address() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void NodeDescriptor::clear_has_sql_address() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void NodeDescriptor::clear_sql_address() {
+  if (sql_address_ != NULL) sql_address_->::cockroach::util::UnresolvedAddr::Clear();
+  clear_has_sql_address();
+}
+const ::cockroach::util::UnresolvedAddr& NodeDescriptor::sql_address() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.NodeDescriptor.sql_address)
+  return sql_address_ != NULL ? *sql_address_ : *default_instance_->sql_address_;
+}
+::cockroach::util::UnresolvedAddr* NodeDescriptor::mutable_sql_address() {
+  set_has_sql_address();
+  if (sql_address_ == NULL) {
+    sql_address_ = new ::cockroach::util::UnresolvedAddr;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NodeDescriptor.sql_address)
+  return sql_address_;
+}
+::cockroach::util::UnresolvedAddr* NodeDescriptor::release_sql_address() {
+  clear_has_sql_address();
+  ::cockroach::util::UnresolvedAddr* temp = sql_address_;
+  sql_address_ = NULL;
+  return temp;
+}
+void NodeDescriptor::set_allocated_sql_address(::cockroach::util::UnresolvedAddr* sql_address) {
+  delete sql_address_;
+  sql_address_ = sql_address;
+  if (sql_address) {
+    set_has_sql_address();
+  } else {
+    clear_has_sql_address();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NodeDescriptor.sql_address)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -3915,6 +4033,351 @@ void StoreDescriptor::set_allocated_capacity(::cockroach::roachpb::StoreCapacity
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
+// ===================================================================
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int DecommissionStatus::kDecommissioningFieldNumber;
+const int DecommissionStatus::kDecommissionedFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+DecommissionStatus::DecommissionStatus()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.DecommissionStatus)
+}
+
+void DecommissionStatus::InitAsDefaultInstance() {
+}
+
+DecommissionStatus::DecommissionStatus(const DecommissionStatus& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.DecommissionStatus)
+}
+
+void DecommissionStatus::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+DecommissionStatus::~DecommissionStatus() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.DecommissionStatus)
+  SharedDtor();
+}
+
+void DecommissionStatus::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void DecommissionStatus::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* DecommissionStatus::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return DecommissionStatus_descriptor_;
+}
+
+const DecommissionStatus& DecommissionStatus::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fmetadata_2eproto();
+  return *default_instance_;
+}
+
+DecommissionStatus* DecommissionStatus::default_instance_ = NULL;
+
+DecommissionStatus* DecommissionStatus::New(::google::protobuf::Arena* arena) const {
+  DecommissionStatus* n = new DecommissionStatus;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void DecommissionStatus::Clear() {
+  decommissioning_.Clear();
+  decommissioned_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool DecommissionStatus::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.DecommissionStatus)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // repeated int32 decommissioning = 1;
+      case 1: {
+        if (tag == 8) {
+         parse_decommissioning:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 1, 8, input, this->mutable_decommissioning())));
+        } else if (tag == 10) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitiveNoInline<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, this->mutable_decommissioning())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(8)) goto parse_decommissioning;
+        if (input->ExpectTag(16)) goto parse_decommissioned;
+        break;
+      }
+
+      // repeated int32 decommissioned = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_decommissioned:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 1, 16, input, this->mutable_decommissioned())));
+        } else if (tag == 18) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitiveNoInline<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, this->mutable_decommissioned())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_decommissioned;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.DecommissionStatus)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.DecommissionStatus)
+  return false;
+#undef DO_
+}
+
+void DecommissionStatus::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.DecommissionStatus)
+  // repeated int32 decommissioning = 1;
+  for (int i = 0; i < this->decommissioning_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(
+      1, this->decommissioning(i), output);
+  }
+
+  // repeated int32 decommissioned = 2;
+  for (int i = 0; i < this->decommissioned_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(
+      2, this->decommissioned(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.DecommissionStatus)
+}
+
+::google::protobuf::uint8* DecommissionStatus::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.DecommissionStatus)
+  // repeated int32 decommissioning = 1;
+  for (int i = 0; i < this->decommissioning_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteInt32ToArray(1, this->decommissioning(i), target);
+  }
+
+  // repeated int32 decommissioned = 2;
+  for (int i = 0; i < this->decommissioned_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteInt32ToArray(2, this->decommissioned(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.DecommissionStatus)
+  return target;
+}
+
+int DecommissionStatus::ByteSize() const {
+  int total_size = 0;
+
+  // repeated int32 decommissioning = 1;
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->decommissioning_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        Int32Size(this->decommissioning(i));
+    }
+    total_size += 1 * this->decommissioning_size() + data_size;
+  }
+
+  // repeated int32 decommissioned = 2;
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->decommissioned_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        Int32Size(this->decommissioned(i));
+    }
+    total_size += 1 * this->decommissioned_size() + data_size;
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void DecommissionStatus::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const DecommissionStatus* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const DecommissionStatus>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void DecommissionStatus::MergeFrom(const DecommissionStatus& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  decommissioning_.MergeFrom(from.decommissioning_);
+  decommissioned_.MergeFrom(from.decommissioned_);
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void DecommissionStatus::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void DecommissionStatus::CopyFrom(const DecommissionStatus& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool DecommissionStatus::IsInitialized() const {
+
+  return true;
+}
+
+void DecommissionStatus::Swap(DecommissionStatus* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void DecommissionStatus::InternalSwap(DecommissionStatus* other) {
+  decommissioning_.UnsafeArenaSwap(&other->decommissioning_);
+  decommissioned_.UnsafeArenaSwap(&other->decommissioned_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata DecommissionStatus::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = DecommissionStatus_descriptor_;
+  metadata.reflection = DecommissionStatus_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// DecommissionStatus
+
+// repeated int32 decommissioning = 1;
+int DecommissionStatus::decommissioning_size() const {
+  return decommissioning_.size();
+}
+void DecommissionStatus::clear_decommissioning() {
+  decommissioning_.Clear();
+}
+ ::google::protobuf::int32 DecommissionStatus::decommissioning(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DecommissionStatus.decommissioning)
+  return decommissioning_.Get(index);
+}
+ void DecommissionStatus::set_decommissioning(int index, ::google::protobuf::int32 value) {
+  decommissioning_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DecommissionStatus.decommissioning)
+}
+ void DecommissionStatus::add_decommissioning(::google::protobuf::int32 value) {
+  decommissioning_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.DecommissionStatus.decommissioning)
+}
+ const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
+DecommissionStatus::decommissioning() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.DecommissionStatus.decommissioning)
+  return decommissioning_;
+}
+ ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
+DecommissionStatus::mutable_decommissioning() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.DecommissionStatus.decommissioning)
+  return &decommissioning_;
+}
+
+// repeated int32 decommissioned = 2;
+int DecommissionStatus::decommissioned_size() const {
+  return decommissioned_.size();
+}
+void DecommissionStatus::clear_decommissioned() {
+  decommissioned_.Clear();
+}
+ ::google::protobuf::int32 DecommissionStatus::decommissioned(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DecommissionStatus.decommissioned)
+  return decommissioned_.Get(index);
+}
+ void DecommissionStatus::set_decommissioned(int index, ::google::protobuf::int32 value) {
+  decommissioned_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DecommissionStatus.decommissioned)
+}
+ void DecommissionStatus::add_decommissioned(::google::protobuf::int32 value) {
+  decommissioned_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.DecommissionStatus.decommissioned)
+}
+ const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
+DecommissionStatus::decommissioned() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.DecommissionStatus.decommissioned)
+  return decommissioned_;
+}
+ ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
+DecommissionStatus::mutable_decommissioned() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.DecommissionStatus.decommissioned)
+  return &decommissioned_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
 // @@protoc_insertion_point(namespace_scope)
 
 }  // namespace roachpb
@@ -40,6 +40,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fmetadata_2eproto();
 void protobuf_ShutdownFile_cockroach_2froachpb_2fmetadata_2eproto();
 
 class Attributes;
+class DecommissionStatus;
 class NodeDescriptor;
 class RangeDescriptor;
 class RangeTree;
@@ -838,6 +839,15 @@ class NodeDescriptor : public ::google::protobuf::Message {
   ::cockroach::roachpb::Attributes* release_attrs();
   void set_allocated_attrs(::cockroach::roachpb::Attributes* attrs);
 
+  // optional .cockroach.util.UnresolvedAddr sql_address = 4;
+  bool has_sql_address() const;
+  void clear_sql_address();
+  static const int kSqlAddressFieldNumber = 4;
+  const ::cockroach::util::UnresolvedAddr& sql_address() const;
+  ::cockroach::util::UnresolvedAddr* mutable_sql_address();
+  ::cockroach::util::UnresolvedAddr* release_sql_address();
+  void set_allocated_sql_address(::cockroach::util::UnresolvedAddr* sql_address);
+
   // @@protoc_insertion_point(class_scope:cockroach.roachpb.NodeDescriptor)
  private:
   inline void set_has_node_id();
@@ -846,12 +856,15 @@ class NodeDescriptor : public ::google::protobuf::Message {
   inline void clear_has_address();
   inline void set_has_attrs();
   inline void clear_has_attrs();
+  inline void set_has_sql_address();
+  inline void clear_has_sql_address();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::util::UnresolvedAddr* address_;
   ::cockroach::roachpb::Attributes* attrs_;
+  ::cockroach::util::UnresolvedAddr* sql_address_;
   ::google::protobuf::int32 node_id_;
   friend void  protobuf_AddDesc_cockroach_2froachpb_2fmetadata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2froachpb_2fmetadata_2eproto();
@@ -985,6 +998,111 @@ class StoreDescriptor : public ::google::protobuf::Message {
   void InitAsDefaultInstance();
   static StoreDescriptor* default_instance_;
 };
+// -------------------------------------------------------------------
+
+class DecommissionStatus : public ::google::protobuf::Message {
+ public:
+  DecommissionStatus();
+  virtual ~DecommissionStatus();
+
+  DecommissionStatus(const DecommissionStatus& from);
+
+  inline DecommissionStatus& operator=(const DecommissionStatus& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const DecommissionStatus& default_instance();
+
+  void Swap(DecommissionStatus* other);
+
+  // implements Message ----------------------------------------------
+
+  inline DecommissionStatus* New() const { return New(NULL); }
+
+  DecommissionStatus* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const DecommissionStatus& from);
+  void MergeFrom(const DecommissionStatus& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(DecommissionStatus* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated int32 decommissioning = 1;
+  int decommissioning_size() const;
+  void clear_decommissioning();
+  static const int kDecommissioningFieldNumber = 1;
+  ::google::protobuf::int32 decommissioning(int index) const;
+  void set_decommissioning(int index, ::google::protobuf::int32 value);
+  void add_decommissioning(::google::protobuf::int32 value);
+  const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
+      decommissioning() const;
+  ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
+      mutable_decommissioning();
+
+  // repeated int32 decommissioned = 2;
+  int decommissioned_size() const;
+  void clear_decommissioned();
+  static const int kDecommissionedFieldNumber = 2;
+  ::google::protobuf::int32 decommissioned(int index) const;
+  void set_decommissioned(int index, ::google::protobuf::int32 value);
+  void add_decommissioned(::google::protobuf::int32 value);
+  const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
+      decommissioned() const;
+  ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
+      mutable_decommissioned();
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.DecommissionStatus)
+ private:
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::RepeatedField< ::google::protobuf::int32 > decommissioning_;
+  ::google::protobuf::RepeatedField< ::google::protobuf::int32 > decommissioned_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fmetadata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fmetadata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fmetadata_2eproto();
+
+  void InitAsDefaultInstance();
+  static DecommissionStatus* default_instance_;
+};
 // ===================================================================
 
 
@@ -1798,6 +1916,49 @@ inline void NodeDescriptor::set_allocated_attrs(::cockroach::roachpb::Attributes
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NodeDescriptor.attrs)
 }
 
+// optional .cockroach.util.UnresolvedAddr sql_address = 4;
+inline bool NodeDescriptor::has_sql_address() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void NodeDescriptor::set_has_sql_address() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void NodeDescriptor::clear_has_sql_address() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void NodeDescriptor::clear_sql_address() {
+  if (sql_address_ != NULL) sql_address_->::cockroach::util::UnresolvedAddr::Clear();
+  clear_has_sql_address();
+}
+inline const ::cockroach::util::UnresolvedAddr& NodeDescriptor::sql_address() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.NodeDescriptor.sql_address)
+  return sql_address_ != NULL ? *sql_address_ : *default_instance_->sql_address_;
+}
+inline ::cockroach::util::UnresolvedAddr* NodeDescriptor::mutable_sql_address() {
+  set_has_sql_address();
+  if (sql_address_ == NULL) {
+    sql_address_ = new ::cockroach::util::UnresolvedAddr;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.NodeDescriptor.sql_address)
+  return sql_address_;
+}
+inline ::cockroach::util::UnresolvedAddr* NodeDescriptor::release_sql_address() {
+  clear_has_sql_address();
+  ::cockroach::util::UnresolvedAddr* temp = sql_address_;
+  sql_address_ = NULL;
+  return temp;
+}
+inline void NodeDescriptor::set_allocated_sql_address(::cockroach::util::UnresolvedAddr* sql_address) {
+  delete sql_address_;
+  sql_address_ = sql_address;
+  if (sql_address) {
+    set_has_sql_address();
+  } else {
+    clear_has_sql_address();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.NodeDescriptor.sql_address)
+}
+
 // -------------------------------------------------------------------
 
 // StoreDescriptor
@@ -1955,6 +2116,70 @@ inline void StoreDescriptor::set_allocated_capacity(::cockroach::roachpb::StoreC
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.StoreDescriptor.capacity)
 }
 
+// -------------------------------------------------------------------
+
+// DecommissionStatus
+
+// repeated int32 decommissioning = 1;
+inline int DecommissionStatus::decommissioning_size() const {
+  return decommissioning_.size();
+}
+inline void DecommissionStatus::clear_decommissioning() {
+  decommissioning_.Clear();
+}
+inline ::google::protobuf::int32 DecommissionStatus::decommissioning(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DecommissionStatus.decommissioning)
+  return decommissioning_.Get(index);
+}
+inline void DecommissionStatus::set_decommissioning(int index, ::google::protobuf::int32 value) {
+  decommissioning_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DecommissionStatus.decommissioning)
+}
+inline void DecommissionStatus::add_decommissioning(::google::protobuf::int32 value) {
+  decommissioning_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.DecommissionStatus.decommissioning)
+}
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
+DecommissionStatus::decommissioning() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.DecommissionStatus.decommissioning)
+  return decommissioning_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
+DecommissionStatus::mutable_decommissioning() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.DecommissionStatus.decommissioning)
+  return &decommissioning_;
+}
+
+// repeated int32 decommissioned = 2;
+inline int DecommissionStatus::decommissioned_size() const {
+  return decommissioned_.size();
+}
+inline void DecommissionStatus::clear_decommissioned() {
+  decommissioned_.Clear();
+}
+inline ::google::protobuf::int32 DecommissionStatus::decommissioned(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DecommissionStatus.decommissioned)
+  return decommissioned_.Get(index);
+}
+inline void DecommissionStatus::set_decommissioned(int index, ::google::protobuf::int32 value) {
+  decommissioned_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DecommissionStatus.decommissioned)
+}
+inline void DecommissionStatus::add_decommissioned(::google::protobuf::int32 value) {
+  decommissioned_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.DecommissionStatus.decommissioned)
+}
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
+DecommissionStatus::decommissioned() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.DecommissionStatus.decommissioned)
+  return decommissioned_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
+DecommissionStatus::mutable_decommissioned() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.DecommissionStatus.decommissioned)
+  return &decommissioned_;
+}
+
 #endif  // !PROTOBUF_INLINE_NOT_IN_HEADERS
 // -------------------------------------------------------------------
 
@@ -1970,6 +2195,8 @@ inline void StoreDescriptor::set_allocated_capacity(::cockroach::roachpb::StoreC
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
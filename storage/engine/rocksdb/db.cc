@@ -1196,7 +1196,7 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
   rocksdb::Options options(rocksdb::DBOptions(), cf_options);
   options.allow_os_buffer = db_opts.allow_os_buffer;
   options.comparator = &kComparator;
-  options.create_if_missing = true;
+  options.create_if_missing = !db_opts.read_only;
   options.info_log.reset(new DBLogger(db_opts.logging_enabled));
   options.merge_operator.reset(new DBMergeOperator);
   options.prefix_extractor.reset(new DBPrefixExtractor);
@@ -1214,7 +1214,12 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
   }
 
   rocksdb::DB *db_ptr;
-  rocksdb::Status status = rocksdb::DB::Open(options, ToString(dir), &db_ptr);
+  rocksdb::Status status;
+  if (db_opts.read_only) {
+    status = rocksdb::DB::OpenForReadOnly(options, ToString(dir), &db_ptr);
+  } else {
+    status = rocksdb::DB::Open(options, ToString(dir), &db_ptr);
+  }
   if (!status.ok()) {
     return ToDBStatus(status);
   }
@@ -1266,6 +1271,26 @@ uint64_t DBApproximateSize(DBEngine* db, DBKey start, DBKey end) {
   return result;
 }
 
+DBStatus DBGetStallStats(DBEngine* db, DBStallStats* stats) {
+  const std::shared_ptr<rocksdb::Statistics> &s = db->rep->GetOptions().statistics;
+  if (s == nullptr) {
+    return FmtStatus("unable to get stall stats: statistics are disabled");
+  }
+  const uint64_t micros = s->getTickerCount(rocksdb::STALL_MICROS);
+  stats->stall_micros = micros;
+  // RocksDB records the duration of each stall in the WRITE_STALL
+  // histogram, which does not expose its sample count. The number of
+  // stalls is derived from the total stall time and the average stall
+  // duration.
+  rocksdb::HistogramData data;
+  s->histogramData(rocksdb::WRITE_STALL, &data);
+  stats->stall_count = 0;
+  if (data.average > 0) {
+    stats->stall_count = static_cast<int64_t>(micros / data.average + 0.5);
+  }
+  return kSuccess;
+}
+
 DBStatus DBImpl::Put(DBKey key, DBSlice value) {
   rocksdb::WriteOptions options;
   return ToDBStatus(rep->Put(options, EncodeKey(key), ToSlice(value)));
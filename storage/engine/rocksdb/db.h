@@ -63,10 +63,12 @@ typedef struct {
   int64_t memtable_budget;
   bool allow_os_buffer;
   bool logging_enabled;
+  bool read_only;
 } DBOptions;
 
 // Opens the database located in "dir", creating it if it doesn't
-// exist.
+// exist. If options.read_only is set, the database must already exist
+// and all writes to it will fail.
 DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions options);
 
 // Destroys the database located in "dir". As the name implies, this
@@ -91,6 +93,16 @@ DBStatus DBCompactRange(DBEngine* db, DBKey* start, DBKey* end);
 // range [start,end].
 uint64_t DBApproximateSize(DBEngine* db, DBKey start, DBKey end);
 
+// DBStallStats contains the cumulative write stall statistics of a
+// database.
+typedef struct {
+  int64_t stall_count;
+  int64_t stall_micros;
+} DBStallStats;
+
+// Retrieves the write stall statistics of the database.
+DBStatus DBGetStallStats(DBEngine* db, DBStallStats* stats);
+
 // Sets the database entry for "key" to "value".
 DBStatus DBPut(DBEngine* db, DBKey key, DBSlice value);
 
@@ -18,6 +18,7 @@ package engine
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -30,7 +31,9 @@ import (
 	"github.com/termie/go-shutil"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/randutil"
 	"github.com/cockroachdb/cockroach/util/stop"
@@ -581,3 +584,47 @@ func BenchmarkMVCCComputeStats1Version32Bytes(b *testing.B) {
 func BenchmarkMVCCComputeStats1Version256Bytes(b *testing.B) {
 	runMVCCComputeStats(256, b)
 }
+
+// TestReadOnlyRocksDB verifies that a read-only RocksDB instance can read
+// data written by a regular instance but can not write to it.
+func TestReadOnlyRocksDB(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir := util.CreateTempDir(t, "read_only_rocksdb")
+	defer util.CleanupDir(dir)
+
+	const memtableBudget = 1 << 20 // 1 MB
+	key := MakeMVCCMetadataKey(roachpb.Key("a"))
+	value := []byte("value")
+
+	stopper := stop.NewStopper()
+	rocksdb := NewRocksDB(roachpb.Attributes{}, dir, testCacheSize, memtableBudget, stopper)
+	if err := rocksdb.Open(); err != nil {
+		t.Fatal(err)
+	}
+	if err := rocksdb.Put(key, value); err != nil {
+		t.Fatal(err)
+	}
+	stopper.Stop()
+
+	stopper = stop.NewStopper()
+	defer stopper.Stop()
+	readOnly := NewReadOnlyRocksDB(roachpb.Attributes{}, dir, testCacheSize, memtableBudget, stopper)
+	if err := readOnly.Open(); err != nil {
+		t.Fatal(err)
+	}
+	if val, err := readOnly.Get(key); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(val, value) {
+		t.Errorf("expected %q, got %q", value, val)
+	}
+	if err := readOnly.Put(key, []byte("other")); err == nil {
+		t.Error("expected write to read-only instance to fail")
+	}
+
+	// A read-only instance can not create a missing database.
+	missing := NewReadOnlyRocksDB(roachpb.Attributes{}, filepath.Join(dir, "missing"),
+		testCacheSize, memtableBudget, stopper)
+	if err := missing.Open(); err == nil {
+		t.Error("expected opening a missing database read-only to fail")
+	}
+}
@@ -87,6 +87,8 @@ type StartStoreEvent struct {
 // independently of other operations.
 type StoreStatusEvent struct {
 	Desc *roachpb.StoreDescriptor
+	// Device identifies the device backing the store, if any.
+	Device string
 }
 
 // ReplicationStatusEvent contains statistics on the replication status of the
@@ -105,6 +107,148 @@ type ReplicationStatusEvent struct {
 	LeaderRangeCount     int64
 	ReplicatedRangeCount int64
 	AvailableRangeCount  int64
+	// LowDiversityRangeCount is the number of ranges led by the store whose
+	// replicas span fewer failure domains than their zone requires.
+	LowDiversityRangeCount int64
+}
+
+// RaftSnapshotStatusEvent contains statistics on the Raft snapshots sent by
+// the store to other replicas.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type RaftSnapshotStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// QueuedCount is the number of snapshots which are queued by the Raft
+	// transport but haven't been sent yet.
+	QueuedCount int64
+	// FailedCount is the number of snapshots which could not be sent since the
+	// previous RaftSnapshotStatusEvent.
+	FailedCount int64
+}
+
+// RaftMessageStatusEvent contains statistics on the Raft messages sent by the
+// store to other replicas.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type RaftMessageStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// DroppedCount is the number of messages which the Raft transport dropped
+	// since the previous RaftMessageStatusEvent because its queue of messages
+	// to the recipient was full.
+	DroppedCount int64
+}
+
+// WriteStallStatusEvent contains statistics on the writes stalled by the
+// store's engine because it fell behind on flushes or compactions.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type WriteStallStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// StallCount is the number of write stalls since the previous
+	// WriteStallStatusEvent.
+	StallCount int64
+	// StallNanos is the time writes spent stalled since the previous
+	// WriteStallStatusEvent.
+	StallNanos int64
+}
+
+// GCStatusEvent contains statistics on the progress of garbage collection on
+// the store.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type GCStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// OldestVersionAgeNanos is the age of the oldest version eligible for GC
+	// which the GC queue found on any of the store's replicas when it last
+	// processed them, or zero if it found none.
+	OldestVersionAgeNanos int64
+}
+
+// RebalanceStatusEvent contains statistics on the rebalancing of the store's
+// replicas.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type RebalanceStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// RejectedConstraintsCount is the number of rebalances which the replicate
+	// queue could not carry out since the previous RebalanceStatusEvent because
+	// no target store satisfied the constraints of the replica's zone.
+	RejectedConstraintsCount int64
+	// BytesMoved is the size of the replicas which the replicate queue added
+	// to other stores to rebalance since the previous RebalanceStatusEvent.
+	// Replicas added to replace missing or dead ones aren't included.
+	BytesMoved int64
+}
+
+// LeaseStatusEvent contains statistics on the leader leases of the store's
+// replicas.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type LeaseStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// ExpirationCount is the number of leader leases held by the store which
+	// expired and were acquired by another replica since the previous
+	// LeaseStatusEvent, while the store was neither draining nor in
+	// maintenance.
+	ExpirationCount int64
+}
+
+// StatsStatusEvent contains statistics on the consistency of the MVCC stats
+// recorded by the store's replicas.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type StatsStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// DivergentRangeCount is the number of the store's replicas whose recorded
+	// stats differed from the stats computed from their data when the verify
+	// queue last processed them.
+	DivergentRangeCount int64
+}
+
+// ScanStatusEvent contains statistics on the scans served by the store's
+// replicas.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type ScanStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// SlowCount is the number of scans which took the slow path since the
+	// previous ScanStatusEvent, because they encountered write intents which
+	// had to be resolved or whose transactions had to be pushed.
+	SlowCount int64
+}
+
+// IntentResolutionStatusEvent contains statistics on the write intents
+// resolved by the store's replicas.
+//
+// This event should be periodically broadcast by the store independently of
+// other operations.
+type IntentResolutionStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// AsyncCount is the number of intents whose resolution was carried out
+	// asynchronously since the previous IntentResolutionStatusEvent, without
+	// keeping the request which encountered them waiting.
+	AsyncCount int64
+	// SyncCount is the number of intents whose resolution was carried out
+	// synchronously since the previous IntentResolutionStatusEvent, either
+	// because the caller waited for it or because the store was draining.
+	SyncCount int64
 }
 
 // BeginScanRangesEvent occurs when the store is about to scan over all ranges.
@@ -179,19 +323,98 @@ func (sef StoreEventFeed) startStore(startedAt int64) {
 }
 
 // storeStatus publishes a StoreStatusEvent to this feed.
-func (sef StoreEventFeed) storeStatus(desc *roachpb.StoreDescriptor) {
+func (sef StoreEventFeed) storeStatus(desc *roachpb.StoreDescriptor, device string) {
 	sef.f.Publish(&StoreStatusEvent{
-		Desc: desc,
+		Desc:   desc,
+		Device: device,
 	})
 }
 
 // replicationStatus publishes a ReplicationStatusEvent to this feed.
-func (sef StoreEventFeed) replicationStatus(leaders, replicated, available int64) {
+func (sef StoreEventFeed) replicationStatus(leaders, replicated, available, lowDiversity int64) {
 	sef.f.Publish(&ReplicationStatusEvent{
-		StoreID:              sef.id,
-		LeaderRangeCount:     leaders,
-		ReplicatedRangeCount: replicated,
-		AvailableRangeCount:  available,
+		StoreID:                sef.id,
+		LeaderRangeCount:       leaders,
+		ReplicatedRangeCount:   replicated,
+		AvailableRangeCount:    available,
+		LowDiversityRangeCount: lowDiversity,
+	})
+}
+
+// raftSnapshotStatus publishes a RaftSnapshotStatusEvent to this feed.
+func (sef StoreEventFeed) raftSnapshotStatus(queued, failed int64) {
+	sef.f.Publish(&RaftSnapshotStatusEvent{
+		StoreID:     sef.id,
+		QueuedCount: queued,
+		FailedCount: failed,
+	})
+}
+
+// raftMessageStatus publishes a RaftMessageStatusEvent to this feed.
+func (sef StoreEventFeed) raftMessageStatus(dropped int64) {
+	sef.f.Publish(&RaftMessageStatusEvent{
+		StoreID:      sef.id,
+		DroppedCount: dropped,
+	})
+}
+
+// writeStallStatus publishes a WriteStallStatusEvent to this feed.
+func (sef StoreEventFeed) writeStallStatus(count, nanos int64) {
+	sef.f.Publish(&WriteStallStatusEvent{
+		StoreID:    sef.id,
+		StallCount: count,
+		StallNanos: nanos,
+	})
+}
+
+// gcStatus publishes a GCStatusEvent to this feed.
+func (sef StoreEventFeed) gcStatus(oldestVersionAge int64) {
+	sef.f.Publish(&GCStatusEvent{
+		StoreID:               sef.id,
+		OldestVersionAgeNanos: oldestVersionAge,
+	})
+}
+
+// rebalanceStatus publishes a RebalanceStatusEvent to this feed.
+func (sef StoreEventFeed) rebalanceStatus(rejectedConstraints, bytesMoved int64) {
+	sef.f.Publish(&RebalanceStatusEvent{
+		StoreID:                  sef.id,
+		RejectedConstraintsCount: rejectedConstraints,
+		BytesMoved:               bytesMoved,
+	})
+}
+
+// leaseStatus publishes a LeaseStatusEvent to this feed.
+func (sef StoreEventFeed) leaseStatus(expirations int64) {
+	sef.f.Publish(&LeaseStatusEvent{
+		StoreID:         sef.id,
+		ExpirationCount: expirations,
+	})
+}
+
+// statsStatus publishes a StatsStatusEvent to this feed.
+func (sef StoreEventFeed) statsStatus(divergentRanges int64) {
+	sef.f.Publish(&StatsStatusEvent{
+		StoreID:             sef.id,
+		DivergentRangeCount: divergentRanges,
+	})
+}
+
+// scanStatus publishes a ScanStatusEvent to this feed.
+func (sef StoreEventFeed) scanStatus(slow int64) {
+	sef.f.Publish(&ScanStatusEvent{
+		StoreID:   sef.id,
+		SlowCount: slow,
+	})
+}
+
+// intentResolutionStatus publishes an IntentResolutionStatusEvent to this
+// feed.
+func (sef StoreEventFeed) intentResolutionStatus(async, sync int64) {
+	sef.f.Publish(&IntentResolutionStatusEvent{
+		StoreID:    sef.id,
+		AsyncCount: async,
+		SyncCount:  sync,
 	})
 }
 
@@ -218,6 +441,15 @@ type StoreEventListener interface {
 	OnEndScanRanges(event *EndScanRangesEvent)
 	OnStoreStatus(event *StoreStatusEvent)
 	OnReplicationStatus(event *ReplicationStatusEvent)
+	OnRaftSnapshotStatus(event *RaftSnapshotStatusEvent)
+	OnRaftMessageStatus(event *RaftMessageStatusEvent)
+	OnWriteStallStatus(event *WriteStallStatusEvent)
+	OnGCStatus(event *GCStatusEvent)
+	OnRebalanceStatus(event *RebalanceStatusEvent)
+	OnLeaseStatus(event *LeaseStatusEvent)
+	OnStatsStatus(event *StatsStatusEvent)
+	OnScanStatus(event *ScanStatusEvent)
+	OnIntentResolutionStatus(event *IntentResolutionStatusEvent)
 }
 
 // ProcessStoreEvent dispatches an event on the StoreEventListener.
@@ -243,6 +475,24 @@ func ProcessStoreEvent(l StoreEventListener, event interface{}) {
 		l.OnStoreStatus(specificEvent)
 	case *ReplicationStatusEvent:
 		l.OnReplicationStatus(specificEvent)
+	case *RaftSnapshotStatusEvent:
+		l.OnRaftSnapshotStatus(specificEvent)
+	case *RaftMessageStatusEvent:
+		l.OnRaftMessageStatus(specificEvent)
+	case *WriteStallStatusEvent:
+		l.OnWriteStallStatus(specificEvent)
+	case *GCStatusEvent:
+		l.OnGCStatus(specificEvent)
+	case *RebalanceStatusEvent:
+		l.OnRebalanceStatus(specificEvent)
+	case *LeaseStatusEvent:
+		l.OnLeaseStatus(specificEvent)
+	case *StatsStatusEvent:
+		l.OnStatsStatus(specificEvent)
+	case *ScanStatusEvent:
+		l.OnScanStatus(specificEvent)
+	case *IntentResolutionStatusEvent:
+		l.OnIntentResolutionStatus(specificEvent)
 	}
 }
 
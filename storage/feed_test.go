@@ -247,22 +247,118 @@ func TestStoreEventFeed(t *testing.T) {
 		{
 			"StoreStatus",
 			func(feed StoreEventFeed) {
-				feed.storeStatus(storeDesc)
+				feed.storeStatus(storeDesc, "2049")
 			},
 			&StoreStatusEvent{
-				Desc: storeDesc,
+				Desc:   storeDesc,
+				Device: "2049",
 			},
 		},
 		{
 			"ReplicationStatus",
 			func(feed StoreEventFeed) {
-				feed.replicationStatus(3, 2, 1)
+				feed.replicationStatus(3, 2, 1, 1)
 			},
 			&ReplicationStatusEvent{
-				StoreID:              roachpb.StoreID(1),
-				LeaderRangeCount:     3,
-				ReplicatedRangeCount: 2,
-				AvailableRangeCount:  1,
+				StoreID:                roachpb.StoreID(1),
+				LeaderRangeCount:       3,
+				ReplicatedRangeCount:   2,
+				AvailableRangeCount:    1,
+				LowDiversityRangeCount: 1,
+			},
+		},
+		{
+			"RaftSnapshotStatus",
+			func(feed StoreEventFeed) {
+				feed.raftSnapshotStatus(2, 1)
+			},
+			&RaftSnapshotStatusEvent{
+				StoreID:     roachpb.StoreID(1),
+				QueuedCount: 2,
+				FailedCount: 1,
+			},
+		},
+		{
+			"RaftMessageStatus",
+			func(feed StoreEventFeed) {
+				feed.raftMessageStatus(3)
+			},
+			&RaftMessageStatusEvent{
+				StoreID:      roachpb.StoreID(1),
+				DroppedCount: 3,
+			},
+		},
+		{
+			"WriteStallStatus",
+			func(feed StoreEventFeed) {
+				feed.writeStallStatus(2, 300)
+			},
+			&WriteStallStatusEvent{
+				StoreID:    roachpb.StoreID(1),
+				StallCount: 2,
+				StallNanos: 300,
+			},
+		},
+		{
+			"GCStatus",
+			func(feed StoreEventFeed) {
+				feed.gcStatus(500)
+			},
+			&GCStatusEvent{
+				StoreID:               roachpb.StoreID(1),
+				OldestVersionAgeNanos: 500,
+			},
+		},
+		{
+			"RebalanceStatus",
+			func(feed StoreEventFeed) {
+				feed.rebalanceStatus(3, 1024)
+			},
+			&RebalanceStatusEvent{
+				StoreID:                  roachpb.StoreID(1),
+				RejectedConstraintsCount: 3,
+				BytesMoved:               1024,
+			},
+		},
+		{
+			"LeaseStatus",
+			func(feed StoreEventFeed) {
+				feed.leaseStatus(2)
+			},
+			&LeaseStatusEvent{
+				StoreID:         roachpb.StoreID(1),
+				ExpirationCount: 2,
+			},
+		},
+		{
+			"StatsStatus",
+			func(feed StoreEventFeed) {
+				feed.statsStatus(4)
+			},
+			&StatsStatusEvent{
+				StoreID:             roachpb.StoreID(1),
+				DivergentRangeCount: 4,
+			},
+		},
+		{
+			"ScanStatus",
+			func(feed StoreEventFeed) {
+				feed.scanStatus(3)
+			},
+			&ScanStatusEvent{
+				StoreID:   roachpb.StoreID(1),
+				SlowCount: 3,
+			},
+		},
+		{
+			"IntentResolutionStatus",
+			func(feed StoreEventFeed) {
+				feed.intentResolutionStatus(5, 2)
+			},
+			&IntentResolutionStatusEvent{
+				StoreID:    roachpb.StoreID(1),
+				AsyncCount: 5,
+				SyncCount:  2,
 			},
 		},
 		{
@@ -19,6 +19,7 @@ package storage
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -178,6 +179,8 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	var expBaseKey roachpb.Key
 	var keys []engine.MVCCKey
 	var vals [][]byte
+	// The timestamp of the oldest version eligible for GC.
+	var oldestGCTS roachpb.Timestamp
 
 	// Maps from txn ID to txn and intent key slice.
 	txnMap := map[string]*roachpb.Transaction{}
@@ -214,6 +217,10 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 					// multiple requests in the event that more than X keys
 					// are added to the request.
 					gcArgs.Keys = append(gcArgs.Keys, roachpb.GCRequest_GCKey{Key: expBaseKey, Timestamp: gcTS})
+					// Versions are sorted newest first.
+					if ts := keys[len(keys)-1].Timestamp; oldestGCTS.Equal(roachpb.ZeroTimestamp) || ts.Less(oldestGCTS) {
+						oldestGCTS = ts
+					}
 				}
 			}
 		}
@@ -247,6 +254,12 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	// Handle last collected set of keys/vals.
 	processKeysAndValues()
 
+	var oldestGCAge int64
+	if !oldestGCTS.Equal(roachpb.ZeroTimestamp) {
+		oldestGCAge = now.WallTime - oldestGCTS.WallTime
+	}
+	atomic.StoreInt64(&repl.gcOldestVersionAge, oldestGCAge)
+
 	txnKeys, err := processTransactionTable(repl, txnMap, txnExp)
 	if err != nil {
 		return err
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -273,6 +274,69 @@ func TestGCQueueProcess(t *testing.T) {
 	}
 }
 
+// TestGCQueueOldestVersionAge verifies that the GC queue records the age of
+// the oldest version eligible for GC it found on the replica.
+func TestGCQueueOldestVersionAge(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 4 * 24 * 60 * 60 * 1E9 // 4d past the epoch
+	tc.manualClock.Set(now)
+
+	ts1 := makeTS(now-4*24*60*60*1E9+1, 0) // 4d old (add one nanosecond so we're not using zero timestamp)
+	ts2 := makeTS(now-3*24*60*60*1E9, 0)   // 3d old
+	ts3 := makeTS(now-30*60*60*1E9, 0)     // 30h old
+	ts4 := makeTS(now-1E9, 0)              // 1s old
+
+	data := []struct {
+		key roachpb.Key
+		ts  roachpb.Timestamp
+	}{
+		// The only version of a key is not eligible for GC, regardless of age.
+		{roachpb.Key("a"), ts1},
+		// The oldest version eligible for GC.
+		{roachpb.Key("b"), ts2},
+		{roachpb.Key("b"), ts4},
+		{roachpb.Key("c"), ts3},
+		{roachpb.Key("c"), ts4},
+	}
+	for i, datum := range data {
+		pArgs := putArgs(datum.key, []byte("value"))
+		if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+			Timestamp: datum.ts,
+		}, &pArgs); err != nil {
+			t.Fatalf("%d: could not put data: %s", i, err)
+		}
+	}
+
+	cfg := tc.gossip.GetSystemConfig()
+	if cfg == nil {
+		t.Fatal("nil config")
+	}
+
+	gcQ := newGCQueue(tc.gossip)
+	if err := gcQ.process(tc.clock.Now(), tc.rng, cfg); err != nil {
+		t.Fatal(err)
+	}
+	expAge := now - ts2.WallTime
+	if a := atomic.LoadInt64(&tc.rng.gcOldestVersionAge); a != expAge {
+		t.Errorf("expected oldest version age %d; got %d", expAge, a)
+	}
+	if a := tc.store.gcOldestVersionAge(); a != expAge {
+		t.Errorf("expected store oldest version age %d; got %d", expAge, a)
+	}
+
+	// The first run removed all versions eligible for GC.
+	if err := gcQ.process(tc.clock.Now(), tc.rng, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if a := atomic.LoadInt64(&tc.rng.gcOldestVersionAge); a != 0 {
+		t.Errorf("expected no oldest version age after GC; got %d", a)
+	}
+}
+
 func TestGCQueueTransactionTable(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
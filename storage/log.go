@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util/log"
 )
 
 // RangeEventLogType describes a specific event type recorded in the range log
@@ -34,6 +35,12 @@ type RangeEventLogType string
 const (
 	// RangeEventLogSplit is the event type recorded when a range splits.
 	RangeEventLogSplit RangeEventLogType = "split"
+	// RangeEventLogUnavailable is the event type recorded when a range loses
+	// a quorum of replicas on live stores.
+	RangeEventLogUnavailable RangeEventLogType = "unavailable"
+	// RangeEventLogRecovered is the event type recorded when an unavailable
+	// range regains a quorum of replicas on live stores.
+	RangeEventLogRecovered RangeEventLogType = "recovered"
 )
 
 // rangeEventTableSchema defines the schema of the event log table. It is
@@ -46,6 +53,7 @@ CREATE TABLE system.rangelog (
   eventType     STRING     NOT NULL,
   storeID       INT        NOT NULL,
   otherRangeID  INT,
+  info          STRING,
   PRIMARY KEY (timestamp, rangeID)
 );`
 
@@ -61,10 +69,10 @@ type rangeLogEvent struct {
 func (s *Store) insertRangeLogEvent(txn *client.Txn, event rangeLogEvent) *roachpb.Error {
 	const insertEventTableStmt = `
 INSERT INTO system.rangelog (
-  timestamp, rangeID, eventType, storeID, otherRangeID
+  timestamp, rangeID, eventType, storeID, otherRangeID, info
 )
 VALUES(
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 )
 `
 	args := []interface{}{
@@ -73,10 +81,14 @@ VALUES(
 		event.eventType,
 		event.storeID,
 		nil, //otherRangeID
+		nil, //info
 	}
 	if event.otherRangeID != nil {
 		args[4] = *event.otherRangeID
 	}
+	if event.info != "" {
+		args[5] = event.info
+	}
 
 	rows, err := s.ctx.SQLExecutor.ExecuteStatementInTransaction(txn, insertEventTableStmt, args...)
 	if err != nil {
@@ -110,3 +122,17 @@ func (s *Store) logSplit(txn *client.Txn, updated, new roachpb.RangeDescriptor)
 		otherRangeID: &new.RangeID,
 	})
 }
+
+// logRangeAvailability asynchronously logs a range availability event into
+// the event table, in a transaction of its own. The event's info describes
+// the state of each of the range's replicas.
+func (s *Store) logRangeAvailability(event rangeLogEvent) {
+	s.stopper.RunAsyncTask(func() {
+		if pErr := s.db.Txn(func(txn *client.Txn) *roachpb.Error {
+			event.timestamp = txn.Proto.Timestamp.GoTime()
+			return s.insertRangeLogEvent(txn, event)
+		}); pErr != nil {
+			log.Warningf("unable to log %s event of range %d: %s", event.eventType, event.rangeID, pErr)
+		}
+	})
+}
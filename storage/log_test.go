@@ -20,14 +20,19 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	_ "github.com/lib/pq"
 
+	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/testutils/sqlutils"
+	"github.com/cockroachdb/cockroach/testutils/testcluster"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -56,7 +61,10 @@ func TestLogSplits(t *testing.T) {
 		return count
 	}
 
-	// Count the number of split events.
+	// Count the number of split events once the initial splits are complete.
+	if err := s.WaitForInitialSplits(); err != nil {
+		t.Fatal(err)
+	}
 	initialSplits := server.ExpectedInitialRangeCount() - 1
 	if a, e := countSplits(), initialSplits; a != e {
 		t.Fatalf("expected %d initial splits, found %d", e, a)
@@ -67,37 +75,259 @@ func TestLogSplits(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	beforeSplit := time.Now()
 	if err := kvDB.AdminSplit("splitkey"); err != nil {
 		t.Fatal(err)
 	}
 
-	// verify that every the count has increased by one.
+	// Wait for the explicit split event, and verify that the count has
+	// increased by one.
+	sqlutils.WaitForRangeEventMatching(t, db, storage.RangeEventLogSplit, 10*time.Second,
+		func(event sqlutils.RangeEvent) bool {
+			return event.Timestamp.After(beforeSplit)
+		})
 	if a, e := countSplits(), initialSplits+1; a != e {
 		t.Fatalf("expected %d splits, found %d", e, a)
 	}
 
 	// verify that RangeID always increases (a good way to see that the splits
 	// are logged correctly)
-	// TODO(mrtracy): Change to parameterized query when #3660 is fixed.
-	rows, err := db.Query(fmt.Sprintf(`SELECT rangeID, otherRangeID FROM system.rangelog WHERE eventType = '%s'`, string(storage.RangeEventLogSplit)))
+	for _, event := range sqlutils.WaitForRangeEvent(t, db, storage.RangeEventLogSplit, 10*time.Second) {
+		if !event.OtherRangeID.Valid {
+			t.Fatalf("otherRangeID not recorded for split of range %d", event.RangeID)
+		}
+		if event.OtherRangeID.Int64 <= event.RangeID {
+			t.Fatalf("otherRangeID %d is not greater than rangeID %d", event.OtherRangeID.Int64, event.RangeID)
+		}
+	}
+}
+
+// recordingTester is a util.Tester which records failures instead of
+// failing the test.
+type recordingTester struct {
+	failures []string
+}
+
+func (rt *recordingTester) Error(args ...interface{}) {
+	rt.failures = append(rt.failures, fmt.Sprint(args...))
+}
+
+func (rt *recordingTester) Failed() bool {
+	return len(rt.failures) > 0
+}
+
+func (rt *recordingTester) Fatal(args ...interface{}) {
+	rt.failures = append(rt.failures, fmt.Sprint(args...))
+}
+
+func (rt *recordingTester) Fatalf(format string, args ...interface{}) {
+	rt.failures = append(rt.failures, fmt.Sprintf(format, args...))
+}
+
+// TestWaitForRangeEventTimeout verifies that waiting for a range event which
+// doesn't appear fails the test once the timeout expires.
+func TestWaitForRangeEventTimeout(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	pgUrl, cleanupFn := sqlutils.PGUrl(t, s, security.RootUser, os.TempDir(), "TestWaitForRangeEventTimeout")
+	defer cleanupFn()
+
+	db, err := sql.Open("postgres", pgUrl.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// A single node never loses the quorum of its ranges.
+	rt := &recordingTester{}
+	const timeout = 100 * time.Millisecond
+	start := time.Now()
+	if events := sqlutils.WaitForRangeEvent(rt, db, storage.RangeEventLogUnavailable, timeout); events != nil {
+		t.Errorf("expected no events, got %+v", events)
+	}
+	if elapsed := time.Since(start); elapsed < timeout {
+		t.Errorf("expected to wait for %s, waited for %s", timeout, elapsed)
+	}
+	if len(rt.failures) != 1 || !strings.Contains(rt.failures[0], "no unavailable event") {
+		t.Errorf("expected a single timeout failure, got %q", rt.failures)
+	}
+}
+
+// TestRecentRangeEvents splits a range and verifies that the split is among
+// the recent events of both the original range and the new range.
+func TestRecentRangeEvents(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	pgUrl, cleanupFn := sqlutils.PGUrl(t, s, security.RootUser, os.TempDir(), "TestRecentRangeEvents")
+	defer cleanupFn()
+
+	db, err := sql.Open("postgres", pgUrl.String())
 	if err != nil {
 		t.Fatal(err)
 	}
-	for rows.Next() {
-		var rangeID int64
-		var otherRangeID sql.NullInt64
-		if err := rows.Scan(&rangeID, &otherRangeID); err != nil {
+	defer db.Close()
+
+	if err := s.WaitForInitialSplits(); err != nil {
+		t.Fatal(err)
+	}
+	beforeSplit := time.Now()
+	if err := s.DB().AdminSplit("splitkey"); err != nil {
+		t.Fatal(err)
+	}
+	events := sqlutils.WaitForRangeEventMatching(t, db, storage.RangeEventLogSplit, 10*time.Second,
+		func(event sqlutils.RangeEvent) bool {
+			return event.Timestamp.After(beforeSplit)
+		})
+	split := events[len(events)-1]
+	if !split.OtherRangeID.Valid {
+		t.Fatalf("otherRangeID not recorded for split of range %d", split.RangeID)
+	}
+	isSplit := func(event sqlutils.RangeEvent) bool {
+		return event.Timestamp.Equal(split.Timestamp) && event.EventType == split.EventType &&
+			event.RangeID == split.RangeID
+	}
+
+	for _, rangeID := range []int64{split.RangeID, split.OtherRangeID.Int64} {
+		recent, err := sqlutils.RecentRangeEvents(db, rangeID, 10)
+		if err != nil {
 			t.Fatal(err)
 		}
+		if len(recent) == 0 || !isSplit(recent[0]) {
+			t.Fatalf("expected the split %+v to be the most recent event of range %d, got %+v", split, rangeID, recent)
+		}
+		for i, event := range recent {
+			if event.RangeID != rangeID && event.OtherRangeID.Int64 != rangeID {
+				t.Errorf("%d: event %+v doesn't involve range %d", i, event, rangeID)
+			}
+			if i > 0 && event.Timestamp.After(recent[i-1].Timestamp) {
+				t.Errorf("%d: event %+v is newer than its predecessor %+v", i, event, recent[i-1])
+			}
+		}
+	}
+
+	// The original range was itself created by an initial split, so it has
+	// more than one event to limit.
+	recent, err := sqlutils.RecentRangeEvents(db, split.RangeID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recent) != 1 || !isSplit(recent[0]) {
+		t.Errorf("expected only the split %+v, got %+v", split, recent)
+	}
+}
+
+// TestLogRangeAvailability stops two of the three nodes holding replicas of a
+// range and verifies that the range's loss of quorum and its recovery once
+// the nodes are restarted are logged.
+func TestLogRangeAvailability(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{
+		ReplicationMode:    testcluster.ReplicationManual,
+		TimeUntilStoreDead: 2 * time.Second,
+	})
+	defer tc.Stop()
+
+	key := roachpb.Key("unavailable")
+	if err := tc.Servers[0].DB().AdminSplit(key); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := tc.RelocateRange(key, tc.Target(0), tc.Target(1), tc.Target(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := tc.Servers[0].GetStore(tc.Target(0).StoreID)
+	if err != nil {
+		t.Fatal(err)
+	}
 
-		if !otherRangeID.Valid {
-			t.Fatalf("otherRangeID not recorded for split of range %d", rangeID)
+	type rangeEvent struct {
+		eventType string
+		info      string
+	}
+	// rangeEvents publishes the status of node 0's store, which logs the
+	// availability transitions of its ranges, and returns the events logged
+	// for the range in order.
+	rangeEvents := func() []rangeEvent {
+		if err := store.PublishStatus(); err != nil {
+			t.Fatal(err)
+		}
+		// TODO(mrtracy): Change to parameterized query when #3660 is fixed.
+		rows, err := tc.Conns[0].Query(fmt.Sprintf(
+			`SELECT eventType, info FROM system.rangelog WHERE rangeID = %d ORDER BY timestamp`, desc.RangeID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var events []rangeEvent
+		for rows.Next() {
+			var event rangeEvent
+			var info sql.NullString
+			if err := rows.Scan(&event.eventType, &info); err != nil {
+				t.Fatal(err)
+			}
+			event.info = info.String
+			events = append(events, event)
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
 		}
-		if otherRangeID.Int64 <= rangeID {
-			t.Fatalf("otherRangeID %d is not greater than rangeID %d", otherRangeID.Int64, rangeID)
+		return events
+	}
+	// indexOf returns the index of the first event of the given type, or -1.
+	indexOf := func(events []rangeEvent, eventType storage.RangeEventLogType) int {
+		for i, event := range events {
+			if event.eventType == string(eventType) {
+				return i
+			}
 		}
+		return -1
 	}
-	if rows.Err() != nil {
-		t.Fatal(rows.Err())
+
+	deadTargets := []testcluster.ReplicationTarget{tc.Target(1), tc.Target(2)}
+	tc.StopNode(1)
+	tc.StopNode(2)
+	util.SucceedsWithin(t, 10*time.Second, func() error {
+		events := rangeEvents()
+		i := indexOf(events, storage.RangeEventLogUnavailable)
+		if i < 0 {
+			return util.Errorf("range %d not yet logged as unavailable: %+v", desc.RangeID, events)
+		}
+		for _, target := range deadTargets {
+			if state := fmt.Sprintf("n%d/s%d:dead", target.NodeID, target.StoreID); !strings.Contains(events[i].info, state) {
+				t.Errorf("expected %q in the info of %+v", state, events[i])
+			}
+		}
+		return nil
+	})
+
+	for _, i := range []int{1, 2} {
+		if err := tc.RestartNode(i); err != nil {
+			t.Fatal(err)
+		}
 	}
+	util.SucceedsWithin(t, 10*time.Second, func() error {
+		// Keep the restarted stores alive.
+		for _, i := range []int{1, 2} {
+			if err := tc.Servers[i].Stores().VisitStores(func(s *storage.Store) error {
+				s.GossipStore()
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		events := rangeEvents()
+		unavailable := indexOf(events, storage.RangeEventLogUnavailable)
+		recovered := indexOf(events, storage.RangeEventLogRecovered)
+		if recovered < 0 {
+			return util.Errorf("range %d not yet logged as recovered: %+v", desc.RangeID, events)
+		}
+		if recovered < unavailable {
+			t.Fatalf("expected recovery to be logged after unavailability: %+v", events)
+		}
+		return nil
+	})
 }
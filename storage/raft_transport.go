@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"fmt"
 	"net"
 	netrpc "net/rpc"
 	"sync"
@@ -35,6 +36,16 @@ import (
 // TODO(bdarnell): remove/change raftMessageName
 const raftMessageName = "MultiRaft.RaftMessage"
 
+// RaftQueueFullError is returned by RaftTransport.Send when a message is
+// dropped because the queue of messages to its recipient is full.
+type RaftQueueFullError struct {
+	StoreID roachpb.StoreID
+}
+
+func (e *RaftQueueFullError) Error() string {
+	return fmt.Sprintf("queue for store %d is full", e.StoreID)
+}
+
 // RaftMessageHandler is the callback type used by RaftTransport.
 type RaftMessageHandler func(*RaftMessageRequest) error
 
@@ -50,9 +61,15 @@ type RaftTransport interface {
 	// Stop undoes a previous Listen.
 	Stop(id roachpb.StoreID)
 
-	// Send a message to the node specified in the request's To field.
+	// Send a message to the node specified in the request's To field. A
+	// RaftQueueFullError is returned if the message is dropped because too
+	// many messages to the node are pending.
 	Send(req *RaftMessageRequest) error
 
+	// QueuedSnapshots returns the number of snapshots sent by the given local
+	// store which are queued but haven't been handed to the network yet.
+	QueuedSnapshots(id roachpb.StoreID) int64
+
 	// Close all associated connections.
 	Close()
 }
@@ -201,6 +218,12 @@ func (lt *localRPCTransport) Send(req *RaftMessageRequest) error {
 	}
 }
 
+// QueuedSnapshots always returns zero, since messages are written to the
+// network by Send itself rather than queued.
+func (lt *localRPCTransport) QueuedSnapshots(id roachpb.StoreID) int64 {
+	return 0
+}
+
 func (lt *localRPCTransport) Close() {
 	lt.mu.Lock()
 	defer lt.mu.Unlock()
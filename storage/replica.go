@@ -149,11 +149,22 @@ type Replica struct {
 	store    *Store
 	stats    *rangeStats // Range statistics
 	maxBytes int64       // Max bytes before split.
+	// GC TTL in nanoseconds of the range's zone, or zero if the range's
+	// versions are not garbage collected. Updated atomically.
+	gcTTLNanos int64
 	// Last index persisted to the raft log (not necessarily committed).
 	// Updated atomically.
 	lastIndex uint64
 	// Last index applied to the state machine. Updated atomically.
 	appliedIndex uint64
+	// Age in nanoseconds of the oldest version eligible for GC which was found
+	// when the GC queue last processed the replica. Updated atomically.
+	gcOldestVersionAge int64
+	// Set to 1 if the verify queue found the replica's recorded stats to
+	// differ from its data when it last processed the replica. Updated
+	// atomically.
+	statsDivergent int32
+
 	systemDBHash []byte         // sha1 hash of the system config @ last gossip
 	lease        unsafe.Pointer // Information for leader lease, updated atomically
 	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
@@ -357,6 +368,42 @@ func (r *Replica) SetMaxBytes(maxBytes int64) {
 	atomic.StoreInt64(&r.maxBytes, maxBytes)
 }
 
+// setGCPolicy atomically sets the GC policy of the range's zone. The TTL of
+// the policy is cached by the range to reject reads at timestamps for which
+// versions may have been garbage collected.
+func (r *Replica) setGCPolicy(policy *config.GCPolicy) {
+	var ttl int64
+	if policy != nil && policy.TTLSeconds > 0 {
+		ttl = int64(policy.TTLSeconds) * int64(time.Second)
+	}
+	atomic.StoreInt64(&r.gcTTLNanos, ttl)
+}
+
+// gcThreshold returns the timestamp below which reads are rejected, which
+// trails the clock by the GC TTL of the range's zone. It is zero if the zone
+// has no GC TTL.
+func (r *Replica) gcThreshold() roachpb.Timestamp {
+	ttl := atomic.LoadInt64(&r.gcTTLNanos)
+	if ttl == 0 {
+		return roachpb.ZeroTimestamp
+	}
+	return roachpb.Timestamp{WallTime: r.store.Clock().PhysicalNow() - ttl}
+}
+
+// checkGCThreshold returns an error if the given read timestamp is older than
+// the GC TTL of the range's zone permits, as versions visible at the
+// timestamp may already have been garbage collected.
+func (r *Replica) checkGCThreshold(timestamp roachpb.Timestamp) *roachpb.Error {
+	if timestamp.Equal(roachpb.ZeroTimestamp) {
+		return nil
+	}
+	if threshold := r.gcThreshold(); timestamp.Less(threshold) {
+		return roachpb.NewErrorf("read timestamp %s is below the GC threshold %s of range %d",
+			timestamp, threshold, r.RangeID)
+	}
+	return nil
+}
+
 // IsFirstRange returns true if this is the first range.
 func (r *Replica) IsFirstRange() bool {
 	return bytes.Equal(r.Desc().StartKey, roachpb.RKeyMin)
@@ -375,6 +422,12 @@ func (r *Replica) getLease() *roachpb.Lease {
 	return (*roachpb.Lease)(atomic.LoadPointer(&r.lease))
 }
 
+// GetLeaderLease returns the current leader lease, which may be nil or
+// expired.
+func (r *Replica) GetLeaderLease() *roachpb.Lease {
+	return r.getLease()
+}
+
 // newNotLeaderError returns a NotLeaderError initialized with the
 // replica for the holder (if any) of the given lease.
 func (r *Replica) newNotLeaderError(l *roachpb.Lease, originStoreID roachpb.StoreID) error {
@@ -477,6 +530,12 @@ func (r *Replica) redirectOnOrAcquireLeaderLease(trace *tracer.Trace) *roachpb.E
 		// If lease is currently held by another, redirect to holder.
 		return roachpb.NewError(r.newNotLeaderError(lease, r.store.StoreID()))
 	}
+	// A draining store, or one in maintenance mode, doesn't acquire new
+	// leases for ranges which have other replicas, so that those replicas can
+	// take over.
+	if (r.store.IsDraining() || r.store.InMaintenance()) && len(r.Desc().Replicas) > 1 {
+		return roachpb.NewError(r.newNotLeaderError(nil, r.store.StoreID()))
+	}
 	defer trace.Epoch("request leader lease")()
 	// Otherwise, no active lease: Request renewal.
 	pErr := r.requestLeaderLease(timestamp)
@@ -808,6 +867,10 @@ func (r *Replica) addAdminCmd(ctx context.Context, ba roachpb.BatchRequest) (*ro
 		var reply roachpb.AdminMergeResponse
 		reply, pErr = r.AdminMerge(*tArgs, r.Desc())
 		resp = &reply
+	case *roachpb.AdminScatterRequest:
+		var reply roachpb.AdminScatterResponse
+		reply, pErr = r.AdminScatter(*tArgs, r.Desc())
+		resp = &reply
 	default:
 		return nil, roachpb.NewErrorf("unrecognized admin command: %T", args)
 	}
@@ -843,6 +906,11 @@ func (r *Replica) addReadOnlyCmd(ctx context.Context, ba roachpb.BatchRequest) (
 		}
 	}
 
+	if pErr := r.checkGCThreshold(ba.Timestamp); pErr != nil {
+		r.endCmds(cmdKeys, ba, pErr)
+		return nil, pErr
+	}
+
 	r.readOnlyCmdMu.RLock()
 	// Execute read-only batch command. It checks for matching key range; note
 	// that holding readMu throughout is important to avoid reads from the
@@ -1196,6 +1264,9 @@ func (r *Replica) sendRaftMessage(msg raftpb.Message) {
 	})
 	snapStatus := raft.SnapshotFinish
 	if err != nil {
+		if _, ok := err.(*RaftQueueFullError); ok {
+			atomic.AddInt64(&r.store.droppedRaftMsgs, 1)
+		}
 		log.Warningf("group %s on store %s failed to send message to %s: %s", groupID,
 			r.store.StoreID(), toReplica.StoreID, err)
 		r.mu.Lock()
@@ -1204,6 +1275,9 @@ func (r *Replica) sendRaftMessage(msg raftpb.Message) {
 		snapStatus = raft.SnapshotFailure
 	}
 	if msg.Type == raftpb.MsgSnap {
+		if snapStatus == raft.SnapshotFailure {
+			atomic.AddInt64(&r.store.failedSnapshots, 1)
+		}
 		// TODO(bdarnell): add an ack for snapshots and don't report status until
 		// ack, error, or timeout.
 		r.mu.Lock()
@@ -1836,9 +1910,12 @@ func (r *Replica) resolveIntents(ctx context.Context, intents []roachpb.Intent,
 			// going async here again is merely for performance, but some intents
 			// need to be resolved because they might block other tasks. See #1684.
 			// Note that handleSkippedIntents has a TODO in case #1684 comes back.
+			atomic.AddInt64(&r.store.syncIntents, int64(len(baLocal.Requests)))
 			if err := action(); err != nil {
 				return err
 			}
+		} else {
+			atomic.AddInt64(&r.store.asyncIntents, int64(len(baLocal.Requests)))
 		}
 	}
 
@@ -1857,9 +1934,12 @@ func (r *Replica) resolveIntents(ctx context.Context, intents []roachpb.Intent,
 		}) {
 			// As with local intents, try async to not keep the caller waiting, but
 			// when draining just go ahead and do it synchronously. See #1684.
+			atomic.AddInt64(&r.store.syncIntents, int64(len(reqsRemote)))
 			if err := action(); err != nil {
 				return err
 			}
+		} else {
+			atomic.AddInt64(&r.store.asyncIntents, int64(len(reqsRemote)))
 		}
 	}
 
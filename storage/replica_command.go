@@ -23,6 +23,7 @@ import (
 	"bytes"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync/atomic"
 	"time"
 	"unsafe"
@@ -66,8 +67,10 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 	// Update the node clock with the serviced request. This maintains a
 	// high water mark for all ops serviced, so that received ops
 	// without a timestamp specified are guaranteed one higher than any
-	// op already executed for overlapping keys.
-	r.store.Clock().Update(ts)
+	// op already executed for overlapping keys. The command has been
+	// committed, so its timestamp is accepted even if it is further
+	// ahead of the local clock than MaxOffset.
+	r.store.Clock().ForceUpdate(ts)
 
 	var reply roachpb.Response
 	var intents []roachpb.Intent
@@ -149,6 +152,14 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.LeaderLeaseResponse
 		resp, err = r.LeaderLease(batch, ms, h, *tArgs)
 		reply = &resp
+	case *roachpb.RaftStatusRequest:
+		var resp roachpb.RaftStatusResponse
+		resp, err = r.ReadRaftStatus(h, *tArgs)
+		reply = &resp
+	case *roachpb.RangeStatsRequest:
+		var resp roachpb.RangeStatsResponse
+		resp, err = r.ReadRangeStats(h, *tArgs)
+		reply = &resp
 	default:
 		err = util.Errorf("unrecognized command %s", args.Method())
 	}
@@ -251,6 +262,7 @@ func (r *Replica) Scan(batch engine.Engine, h roachpb.Header, args roachpb.ScanR
 
 	rows, intents, err := engine.MVCCScan(batch, args.Key, args.EndKey, args.MaxResults, h.Timestamp, h.ReadConsistency == roachpb.CONSISTENT, h.Txn)
 	reply.Rows = rows
+	r.recordScan(intents, err)
 	return reply, intents, err
 }
 
@@ -262,9 +274,19 @@ func (r *Replica) ReverseScan(batch engine.Engine, h roachpb.Header, args roachp
 	rows, intents, err := engine.MVCCReverseScan(batch, args.Key, args.EndKey, args.MaxResults, h.Timestamp,
 		h.ReadConsistency == roachpb.CONSISTENT, h.Txn)
 	reply.Rows = rows
+	r.recordScan(intents, err)
 	return reply, intents, err
 }
 
+// recordScan counts a scan as slow if it encountered write intents, which
+// must be resolved or whose transactions must be pushed before the scan can
+// be served.
+func (r *Replica) recordScan(intents []roachpb.Intent, err error) {
+	if _, ok := err.(*roachpb.WriteIntentError); ok || len(intents) > 0 {
+		atomic.AddInt64(&r.store.slowScans, 1)
+	}
+}
+
 func verifyTransaction(h roachpb.Header, args roachpb.Request) error {
 	if h.Txn == nil {
 		return util.Errorf("no transaction specified to HeartbeatTxn")
@@ -1212,6 +1234,15 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 		log.Infof("range %d: new leader lease %s", r.RangeID, args.Lease)
 	}
 
+	// If this replica held the previous lease and another replica acquired
+	// the new one, the previous lease expired. Unless this store was draining
+	// or in maintenance, and thus handing off its leases on purpose, the lease
+	// was lost involuntarily.
+	if prevLease.Replica.StoreID == r.store.StoreID() && !isExtension &&
+		!r.store.IsDraining() && !r.store.InMaintenance() {
+		atomic.AddInt64(&r.store.expiredLeases, 1)
+	}
+
 	// Gossip system config if this range includes the system span.
 	if r.ContainsKey(keys.SystemConfigSpan.Key) {
 		r.maybeGossipSystemConfig()
@@ -1219,6 +1250,59 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	return reply, nil
 }
 
+// ReadRaftStatus returns the Raft status of this replica. The progress of the
+// range's replicas is only known, and hence only returned, if this replica is
+// the Raft leader.
+func (r *Replica) ReadRaftStatus(h roachpb.Header, args roachpb.RaftStatusRequest) (roachpb.RaftStatusResponse, error) {
+	var reply roachpb.RaftStatusResponse
+
+	replica := r.GetReplica()
+	if replica == nil {
+		return reply, roachpb.NewRangeNotFoundError(r.RangeID)
+	}
+	status := r.RaftStatus()
+
+	reply.RangeID = r.RangeID
+	reply.Replica = *replica
+	reply.Term = status.Term
+	reply.Commit = status.Commit
+	reply.Applied = status.Applied
+	reply.Lead = roachpb.ReplicaID(status.Lead)
+	reply.State = status.RaftState.String()
+	for id, progress := range status.Progress {
+		reply.Progress = append(reply.Progress, roachpb.RaftProgress{
+			ReplicaID: roachpb.ReplicaID(id),
+			Match:     progress.Match,
+			Next:      progress.Next,
+			State:     progress.State.String(),
+		})
+	}
+	// The progress is kept in a map; report it in a stable order.
+	sort.Sort(raftProgressByReplicaID(reply.Progress))
+	return reply, nil
+}
+
+// raftProgressByReplicaID implements sort.Interface.
+type raftProgressByReplicaID []roachpb.RaftProgress
+
+func (p raftProgressByReplicaID) Len() int           { return len(p) }
+func (p raftProgressByReplicaID) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p raftProgressByReplicaID) Less(i, j int) bool { return p[i].ReplicaID < p[j].ReplicaID }
+
+// ReadRangeStats returns the descriptor, MVCC statistics and GC threshold of
+// this range.
+func (r *Replica) ReadRangeStats(h roachpb.Header, args roachpb.RangeStatsRequest) (roachpb.RangeStatsResponse, error) {
+	var reply roachpb.RangeStatsResponse
+	ms := r.GetMVCCStats()
+	reply.RangeID = r.RangeID
+	reply.Desc = *r.Desc()
+	reply.LiveBytes = ms.LiveBytes
+	reply.LiveCount = ms.LiveCount
+	reply.KeyCount = ms.KeyCount
+	reply.GCThreshold = r.gcThreshold()
+	return reply, nil
+}
+
 // AdminSplit divides the range into into two ranges, using either
 // args.SplitKey (if provided) or an internally computed key that aims to
 // roughly equipartition the range by size. The split is done inside of
@@ -1739,6 +1823,64 @@ func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica r
 	return nil
 }
 
+// AdminScatter moves one of the range's replicas, other than this replica,
+// which holds the leader lease, to a store chosen by the allocator among
+// those of the nodes holding no replica of the range. The replica to move is
+// also chosen by the allocator. The new replica is added before the old one
+// is removed, so the range keeps its number of replicas. The range is left
+// in place if it has no other replica or there is no store to move one to.
+//
+// The supplied RangeDescriptor is used as a form of optimistic lock. See the
+// comment of "AdminSplit" for more information on this pattern.
+func (r *Replica) AdminScatter(args roachpb.AdminScatterRequest, desc *roachpb.RangeDescriptor) (roachpb.AdminScatterResponse, *roachpb.Error) {
+	reply := roachpb.AdminScatterResponse{Desc: *desc}
+
+	var others []roachpb.ReplicaDescriptor
+	for _, repl := range desc.Replicas {
+		if repl.StoreID != r.store.StoreID() {
+			others = append(others, repl)
+		}
+	}
+	if len(others) == 0 {
+		return reply, nil
+	}
+
+	cfg := r.store.Gossip().GetSystemConfig()
+	if cfg == nil {
+		return reply, roachpb.NewErrorf("no system config available to scatter range %d", desc.RangeID)
+	}
+	zone, err := cfg.GetZoneConfigForKey(desc.StartKey)
+	if err != nil {
+		return reply, roachpb.NewError(err)
+	}
+	target, err := r.store.allocator.AllocateTarget(zone.ReplicaAttrs[0], desc.Replicas, true, nil)
+	if err != nil {
+		if log.V(1) {
+			log.Infof("not scattering range %d: %s", desc.RangeID, err)
+		}
+		return reply, nil
+	}
+	removeReplica, err := r.store.allocator.RemoveTarget(others)
+	if err != nil {
+		return reply, roachpb.NewError(err)
+	}
+
+	addReplica := roachpb.ReplicaDescriptor{
+		NodeID:  target.Node.NodeID,
+		StoreID: target.StoreID,
+	}
+	if err := r.ChangeReplicas(roachpb.ADD_REPLICA, addReplica, desc); err != nil {
+		return reply, roachpb.NewError(err)
+	}
+	// The change has been applied by this replica once ChangeReplicas
+	// returns, so its descriptor includes the added replica.
+	if err := r.ChangeReplicas(roachpb.REMOVE_REPLICA, removeReplica, r.Desc()); err != nil {
+		return reply, roachpb.NewError(err)
+	}
+	reply.Desc = *r.Desc()
+	return reply, nil
+}
+
 // replicaSetsEqual is used in AdminMerge to ensure that the ranges are
 // all collocate on the same set of replicas.
 func replicaSetsEqual(a, b []roachpb.ReplicaDescriptor) bool {
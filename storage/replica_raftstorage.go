@@ -465,6 +465,7 @@ func (r *Replica) updateRangeInfo() error {
 	}
 
 	r.SetMaxBytes(zone.RangeMaxBytes)
+	r.setGCPolicy(zone.GC)
 	return nil
 }
 
@@ -612,3 +613,40 @@ func decodeRaftCommand(data []byte) (commandID string, command []byte) {
 	}
 	return string(data[1 : 1+raftCommandIDLen]), data[1+raftCommandIDLen:]
 }
+
+// DecodeRaftEntry decodes the command embedded in a Raft log entry, which
+// is either a normal or a configuration change entry. Empty entries, which
+// are proposed by new leaders, have a nil command.
+func DecodeRaftEntry(ent raftpb.Entry) (commandID string, command *roachpb.RaftCommand, err error) {
+	var encodedCommand []byte
+	switch ent.Type {
+	case raftpb.EntryNormal:
+		if len(ent.Data) == 0 {
+			return "", nil, nil
+		}
+		if len(ent.Data) < 1+raftCommandIDLen {
+			return "", nil, util.Errorf("raft command of length %d is too short", len(ent.Data))
+		}
+		if ent.Data[0] != raftCommandEncodingVersion {
+			return "", nil, util.Errorf("unknown command encoding version %v", ent.Data[0])
+		}
+		commandID, encodedCommand = decodeRaftCommand(ent.Data)
+	case raftpb.EntryConfChange:
+		var cc raftpb.ConfChange
+		if err := cc.Unmarshal(ent.Data); err != nil {
+			return "", nil, err
+		}
+		var ctx ConfChangeContext
+		if err := ctx.Unmarshal(cc.Context); err != nil {
+			return "", nil, err
+		}
+		commandID, encodedCommand = ctx.CommandID, ctx.Payload
+	default:
+		return "", nil, util.Errorf("unknown entry type %s", ent.Type)
+	}
+	command = &roachpb.RaftCommand{}
+	if err := command.Unmarshal(encodedCommand); err != nil {
+		return "", nil, err
+	}
+	return commandID, command, nil
+}
@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/config"
@@ -148,6 +149,12 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 		// rebalance. Attempt to find a rebalancing target.
 		rebalanceStore := rq.allocator.RebalanceTarget(repl.store.StoreID(), zone.ReplicaAttrs[0], desc.Replicas)
 		if rebalanceStore == nil {
+			// If a target would have been found without the zone's required
+			// attributes, the rebalance was blocked by the zone's constraints.
+			if len(zone.ReplicaAttrs[0].Attrs) > 0 && rq.allocator.RebalanceTarget(
+				repl.store.StoreID(), roachpb.Attributes{}, desc.Replicas) != nil {
+				atomic.AddInt64(&repl.store.blockedRebalances, 1)
+			}
 			// No action was necessary and no rebalance target was found. Return
 			// without re-queueing this replica.
 			return nil
@@ -159,6 +166,10 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 		if err = repl.ChangeReplicas(roachpb.ADD_REPLICA, rebalanceReplica, desc); err != nil {
 			return err
 		}
+		// The new replica is sent a snapshot of the range, whose size is
+		// approximated by the range's key and value bytes.
+		stats := repl.GetMVCCStats()
+		atomic.AddInt64(&repl.store.rebalancedBytes, stats.KeyBytes+stats.ValBytes)
 	}
 
 	// Enqueue this replica again to see if there are more changes to be made.
@@ -19,6 +19,7 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -260,11 +261,22 @@ type Store struct {
 	removeReplicaChan chan removeReplicaOp
 	wakeRaftLoop      chan struct{}
 	started           int32
+	draining          int32 // Accessed atomically; see SetDraining
+	maintenance       int32 // Accessed atomically; see SetMaintenance
+	failedSnapshots   int64 // Accessed atomically; reset by PublishStatus
+	droppedRaftMsgs   int64 // Accessed atomically; reset by PublishStatus
+	blockedRebalances int64 // Accessed atomically; reset by PublishStatus
+	rebalancedBytes   int64 // Accessed atomically; reset by PublishStatus
+	expiredLeases     int64 // Accessed atomically; reset by PublishStatus
+	slowScans         int64 // Accessed atomically; reset by PublishStatus
+	asyncIntents      int64 // Accessed atomically; reset by PublishStatus
+	syncIntents       int64 // Accessed atomically; reset by PublishStatus
 	stopper           *stop.Stopper
 	startedAt         int64
 	nodeDesc          *roachpb.NodeDescriptor
 	initComplete      sync.WaitGroup // Signaled by async init tasks
 	raftRequestChan   chan *RaftMessageRequest
+	lastEngineStats   engine.Stats // Engine stats as of the last PublishStatus
 
 	// Lock ordering notes: The processRaft goroutine acts as a kind of
 	// mutex. To avoid deadlocks, the following lock order must be
@@ -282,6 +294,10 @@ type Store struct {
 		// updates. After updating this map, write to wakeRaftLoop to
 		// trigger the check.
 		pendingRaftGroups map[roachpb.RangeID]struct{}
+		// unavailableRanges contains the ranges whose loss of quorum this
+		// store has recorded in the range event log, and whose recovery it
+		// will record. See updateRangeAvailability.
+		unavailableRanges map[roachpb.RangeID]struct{}
 	}
 }
 
@@ -403,6 +419,7 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *roachpb.NodeDescrip
 		},
 	})
 	s.mu.pendingRaftGroups = map[roachpb.RangeID]struct{}{}
+	s.mu.unavailableRanges = map[roachpb.RangeID]struct{}{}
 
 	s.mu.Unlock()
 
@@ -708,6 +725,7 @@ func (s *Store) systemGossipUpdate(cfg *config.SystemConfig) {
 	for _, rng := range s.mu.replicas {
 		if zone, err := cfg.GetZoneConfigForKey(rng.Desc().StartKey); err == nil {
 			rng.SetMaxBytes(zone.RangeMaxBytes)
+			rng.setGCPolicy(zone.GC)
 		}
 		s.splitQueue.MaybeAdd(rng, s.ctx.Clock.Now())
 	}
@@ -1274,7 +1292,7 @@ func (s *Store) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.Bat
 	var pErr *roachpb.Error
 
 	// Add the command to the range for execution; exit retry loop on success.
-	for r := retry.Start(s.ctx.RangeRetryOptions); next(&r); {
+	for r := retry.StartWithCtx(ctx, s.ctx.RangeRetryOptions); next(&r); {
 		// Get range and add command to the range for execution.
 		var err error
 		rng, err = s.GetReplica(ba.RangeID)
@@ -1360,6 +1378,12 @@ func (s *Store) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.Bat
 		return nil, pErr
 	}
 
+	// The retry loop ends early once the context is done, in which case
+	// the last error is returned.
+	if ctx.Err() != nil {
+		return nil, pErr
+	}
+
 	// By default, retries are indefinite. However, some unittests set a
 	// maximum retry count; return txn retry error for transactional cases
 	// and the original error otherwise.
@@ -1764,12 +1788,13 @@ func (s *Store) GetStatus() (*StoreStatus, error) {
 }
 
 // computeReplicationStatus counts a number of simple replication statistics for
-// the ranges in this store.
+// the ranges in this store. Ranges whose replicas span fewer failure domains
+// than their zone requires are counted as low diversity ranges.
 // TODO(bram): It may be appropriate to compute these statistics while scanning
 // ranges. An ideal solution would be to create incremental events whenever
 // availability changes.
 func (s *Store) computeReplicationStatus(now int64) (
-	leaderRangeCount, replicatedRangeCount, availableRangeCount int64) {
+	leaderRangeCount, replicatedRangeCount, availableRangeCount, lowDiversityRangeCount int64) {
 	// Load the system config.
 	cfg := s.Gossip().GetSystemConfig()
 	if cfg == nil {
@@ -1795,6 +1820,13 @@ func (s *Store) computeReplicationStatus(now int64) (
 			if len(raftStatus.Progress) >= len(zoneConfig.ReplicaAttrs) {
 				replicatedRangeCount++
 			}
+			if zoneConfig.MinFailureDomains > 0 && s.ctx.StorePool != nil {
+				// Ranges with replicas of unknown locality aren't counted.
+				domains, ok := s.ctx.StorePool.failureDomains(rng.Desc().Replicas)
+				if ok && domains < int(zoneConfig.MinFailureDomains) {
+					lowDiversityRangeCount++
+				}
+			}
 
 			// If any replica holds the leader lease, the range is available.
 			if rng.getLease().Covers(timestamp) {
@@ -1819,6 +1851,69 @@ func (s *Store) computeReplicationStatus(now int64) (
 	return
 }
 
+// updateRangeAvailability records in the range event log the ranges which
+// lost or regained a quorum of replicas on live stores since the last call.
+// Loss of quorum is recorded by the first of the range's replicas located on a
+// live store, and recovery by the store which recorded the loss. A store
+// always considers itself live.
+func (s *Store) updateRangeAvailability() {
+	if !s.ctx.LogRangeEvents || s.ctx.StorePool == nil {
+		return
+	}
+	var events []rangeLogEvent
+	s.mu.Lock()
+	for rangeID, rng := range s.mu.replicas {
+		desc := rng.Desc()
+		if len(desc.Replicas) == 0 {
+			continue
+		}
+		dead := map[roachpb.StoreID]struct{}{}
+		for _, repl := range s.ctx.StorePool.deadReplicas(desc.Replicas) {
+			if repl.StoreID != s.StoreID() {
+				dead[repl.StoreID] = struct{}{}
+			}
+		}
+		var firstLive roachpb.StoreID
+		states := make([]string, 0, len(desc.Replicas))
+		for _, repl := range desc.Replicas {
+			state := "live"
+			if _, ok := dead[repl.StoreID]; ok {
+				state = "dead"
+			} else if firstLive == 0 {
+				firstLive = repl.StoreID
+			}
+			states = append(states, fmt.Sprintf("n%d/s%d:%s", repl.NodeID, repl.StoreID, state))
+		}
+		unavailable := len(desc.Replicas)-len(dead) < computeQuorum(len(desc.Replicas))
+		_, recorded := s.mu.unavailableRanges[rangeID]
+		event := rangeLogEvent{
+			rangeID: rangeID,
+			storeID: s.StoreID(),
+			info:    strings.Join(states, " "),
+		}
+		if unavailable && !recorded && firstLive == s.StoreID() {
+			s.mu.unavailableRanges[rangeID] = struct{}{}
+			event.eventType = RangeEventLogUnavailable
+			events = append(events, event)
+		} else if !unavailable && recorded {
+			delete(s.mu.unavailableRanges, rangeID)
+			event.eventType = RangeEventLogRecovered
+			events = append(events, event)
+		}
+	}
+	// Forget the ranges which were removed from this store.
+	for rangeID := range s.mu.unavailableRanges {
+		if _, ok := s.mu.replicas[rangeID]; !ok {
+			delete(s.mu.unavailableRanges, rangeID)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, event := range events {
+		s.logRangeAvailability(event)
+	}
+}
+
 // PublishStatus publishes periodically computed status events to the store's
 // events feed. This method itself should be periodically called by some
 // external mechanism.
@@ -1828,16 +1923,157 @@ func (s *Store) PublishStatus() error {
 	if err != nil {
 		return err
 	}
-	s.feed.storeStatus(desc)
+	device, err := s.engine.Device()
+	if err != nil {
+		return err
+	}
+	s.feed.storeStatus(desc, device)
 
 	// broadcast replication status.
 	now := s.ctx.Clock.Now().WallTime
-	leaderRangeCount, replicatedRangeCount, availableRangeCount :=
+	leaderRangeCount, replicatedRangeCount, availableRangeCount, lowDiversityRangeCount :=
 		s.computeReplicationStatus(now)
-	s.feed.replicationStatus(leaderRangeCount, replicatedRangeCount, availableRangeCount)
+	s.feed.replicationStatus(leaderRangeCount, replicatedRangeCount, availableRangeCount,
+		lowDiversityRangeCount)
+	s.updateRangeAvailability()
+
+	// broadcast raft snapshot status.
+	s.feed.raftSnapshotStatus(s.ctx.Transport.QueuedSnapshots(s.StoreID()),
+		atomic.SwapInt64(&s.failedSnapshots, 0))
+
+	// broadcast the raft messages dropped since the last status.
+	s.feed.raftMessageStatus(atomic.SwapInt64(&s.droppedRaftMsgs, 0))
+
+	// broadcast the write stalls since the last status.
+	stats, err := s.engine.GetStats()
+	if err != nil {
+		return err
+	}
+	s.feed.writeStallStatus(stats.WriteStalls-s.lastEngineStats.WriteStalls,
+		stats.WriteStallNanos-s.lastEngineStats.WriteStallNanos)
+	s.lastEngineStats = *stats
+
+	// broadcast the age of the oldest version awaiting GC.
+	s.feed.gcStatus(s.gcOldestVersionAge())
+
+	// broadcast the rebalances rejected and the bytes moved by rebalancing
+	// since the last status.
+	s.feed.rebalanceStatus(atomic.SwapInt64(&s.blockedRebalances, 0),
+		atomic.SwapInt64(&s.rebalancedBytes, 0))
+
+	// broadcast the leases lost to expiration since the last status.
+	s.feed.leaseStatus(atomic.SwapInt64(&s.expiredLeases, 0))
+
+	// broadcast the number of replicas with divergent stats.
+	s.feed.statsStatus(s.divergentStatsCount())
+
+	// broadcast the scans which took the slow path since the last status.
+	s.feed.scanStatus(atomic.SwapInt64(&s.slowScans, 0))
+
+	// broadcast the intents resolved asynchronously and synchronously since
+	// the last status.
+	s.feed.intentResolutionStatus(atomic.SwapInt64(&s.asyncIntents, 0),
+		atomic.SwapInt64(&s.syncIntents, 0))
 	return nil
 }
 
+// gcOldestVersionAge returns the age in nanoseconds of the oldest version
+// eligible for GC found by the GC queue across the store's replicas, or zero
+// if it found none.
+func (s *Store) gcOldestVersionAge() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var oldest int64
+	for _, rng := range s.mu.replicas {
+		if age := atomic.LoadInt64(&rng.gcOldestVersionAge); age > oldest {
+			oldest = age
+		}
+	}
+	return oldest
+}
+
+// divergentStatsCount returns the number of the store's replicas whose
+// recorded stats differed from their data when last verified.
+func (s *Store) divergentStatsCount() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var count int64
+	for _, rng := range s.mu.replicas {
+		if atomic.LoadInt32(&rng.statsDivergent) == 1 {
+			count++
+		}
+	}
+	return count
+}
+
+// SetDraining (when called with 'true') prevents the store from acquiring
+// leader leases for ranges which have other replicas. Leases already held by
+// the store remain valid until they expire, after which another replica may
+// acquire them.
+func (s *Store) SetDraining(drain bool) {
+	var v int32
+	if drain {
+		v = 1
+	}
+	atomic.StoreInt32(&s.draining, v)
+}
+
+// IsDraining returns whether the store is draining; see SetDraining.
+func (s *Store) IsDraining() bool {
+	return atomic.LoadInt32(&s.draining) == 1
+}
+
+// SetMaintenance (when called with 'true') puts the store into maintenance
+// mode, in which, like a draining store, it doesn't acquire leader leases for
+// ranges which have other replicas, so that its leases move to other replicas
+// as they expire. Unlike draining, maintenance mode is expected to be cleared
+// again, after which the store resumes acquiring leases.
+func (s *Store) SetMaintenance(maintenance bool) {
+	var v int32
+	if maintenance {
+		v = 1
+	}
+	atomic.StoreInt32(&s.maintenance, v)
+}
+
+// InMaintenance returns whether the store is in maintenance mode; see
+// SetMaintenance.
+func (s *Store) InMaintenance() bool {
+	return atomic.LoadInt32(&s.maintenance) == 1
+}
+
+// VisitReplicas calls the visitor with each of the store's replicas, in key
+// order, until it returns false.
+func (s *Store) VisitReplicas(visitor func(*Replica) bool) {
+	newStoreRangeSet(s).Visit(visitor)
+}
+
+// LeaderLeaseCount returns the number of ranges with other replicas for which
+// this store holds an active leader lease. These are the leases which must
+// expire before a draining store stops serving requests for other replicas.
+func (s *Store) LeaderLeaseCount() int {
+	timestamp := s.ctx.Clock.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, rng := range s.mu.replicas {
+		if len(rng.Desc().Replicas) < 2 {
+			continue
+		}
+		if lease := rng.getLease(); lease.Covers(timestamp) && lease.OwnedBy(s.StoreID()) {
+			count++
+		}
+	}
+	return count
+}
+
+// SetReplicateQueueActive controls the replication queue. When inactive,
+// ranges are neither up- nor down-replicated nor rebalanced by this store.
+// For unittests only.
+func (s *Store) SetReplicateQueueActive(active bool) {
+	s.replicateQueue.SetDisabled(!active)
+}
+
 // SetRangeRetryOptions sets the retry options used for this store.
 // For unittests only.
 func (s *Store) SetRangeRetryOptions(ro retry.Options) {
@@ -19,6 +19,7 @@ package storage
 import (
 	"container/heap"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -135,12 +136,20 @@ func (pq *storePoolPQ) dequeue() *storeDetail {
 type StorePool struct {
 	clock              *hlc.Clock
 	timeUntilStoreDead time.Duration
+	// wakeC wakes the goroutine which marks stores as dead; see Wake.
+	wakeC chan struct{}
 
 	// Each storeDetail is contained in both a map and a priorityQueue; pointers
 	// are used so that data can be kept in sync.
-	mu     sync.RWMutex // Protects stores and queue.
+	mu     sync.RWMutex // Protects stores, queue, decommissioning and maintenance.
 	stores map[roachpb.StoreID]*storeDetail
 	queue  storePoolPQ
+	// decommissioning is the set of nodes which are being, or have been,
+	// removed from the cluster. Their stores are not allocation targets.
+	decommissioning map[roachpb.NodeID]struct{}
+	// maintenance is the set of nodes in maintenance mode. Their stores keep
+	// their replicas but are not allocation targets.
+	maintenance map[roachpb.NodeID]struct{}
 }
 
 // NewStorePool creates a StorePool and registers the store updating callback
@@ -149,12 +158,18 @@ func NewStorePool(g *gossip.Gossip, clock *hlc.Clock, timeUntilStoreDead time.Du
 	sp := &StorePool{
 		clock:              clock,
 		timeUntilStoreDead: timeUntilStoreDead,
+		wakeC:              make(chan struct{}, 1),
 		stores:             make(map[roachpb.StoreID]*storeDetail),
+		decommissioning:    make(map[roachpb.NodeID]struct{}),
+		maintenance:        make(map[roachpb.NodeID]struct{}),
 	}
 	heap.Init(&sp.queue)
 
 	storeRegex := gossip.MakePrefixPattern(gossip.KeyStorePrefix)
 	g.RegisterCallback(storeRegex, sp.storeGossipUpdate)
+	g.RegisterCallback(gossip.KeyDecommissionStatus, sp.decommissionStatusGossipUpdate)
+	maintenanceRegex := gossip.MakePrefixPattern(gossip.KeyMaintenancePrefix)
+	g.RegisterCallback(maintenanceRegex, sp.maintenanceGossipUpdate)
 
 	sp.start(stopper)
 
@@ -182,6 +197,54 @@ func (sp *StorePool) storeGossipUpdate(_ string, content roachpb.Value) {
 	sp.queue.enqueue(detail)
 }
 
+// decommissionStatusGossipUpdate is the gossip callback used to keep the set
+// of decommissioning nodes up to date.
+func (sp *StorePool) decommissionStatusGossipUpdate(_ string, content roachpb.Value) {
+	var status roachpb.DecommissionStatus
+	if err := content.GetProto(&status); err != nil {
+		log.Error(err)
+		return
+	}
+
+	decommissioning := make(map[roachpb.NodeID]struct{})
+	for _, nodeIDs := range [][]roachpb.NodeID{status.Decommissioning, status.Decommissioned} {
+		for _, nodeID := range nodeIDs {
+			decommissioning[nodeID] = struct{}{}
+		}
+	}
+	sp.mu.Lock()
+	sp.decommissioning = decommissioning
+	sp.mu.Unlock()
+}
+
+// maintenanceGossipUpdate is the gossip callback used to keep the set of
+// nodes in maintenance mode up to date.
+func (sp *StorePool) maintenanceGossipUpdate(key string, content roachpb.Value) {
+	nodeID, err := gossip.NodeIDFromMaintenanceKey(key)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	b, err := content.GetBytes()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	maintenance, err := strconv.ParseBool(string(b))
+	if err != nil {
+		log.Errorf("%s: %s", key, err)
+		return
+	}
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if maintenance {
+		sp.maintenance[nodeID] = struct{}{}
+	} else {
+		delete(sp.maintenance, nodeID)
+	}
+}
+
 // start will run continuously and mark stores as offline if they haven't been
 // heard from in longer than timeUntilStoreDead.
 func (sp *StorePool) start(stopper *stop.Stopper) {
@@ -212,6 +275,7 @@ func (sp *StorePool) start(stopper *stop.Stopper) {
 			sp.mu.Unlock()
 			select {
 			case <-time.After(timeout):
+			case <-sp.wakeC:
 			case <-stopper.ShouldStop():
 				return
 			}
@@ -219,6 +283,16 @@ func (sp *StorePool) start(stopper *stop.Stopper) {
 	})
 }
 
+// Wake causes the store pool to check immediately whether stores have to be
+// marked as dead, rather than when the next store is due to time out. It is
+// used after advancing a manual clock.
+func (sp *StorePool) Wake() {
+	select {
+	case sp.wakeC <- struct{}{}:
+	default:
+	}
+}
+
 // GetStoreDescriptor returns the store detail for the given storeID.
 func (sp *StorePool) getStoreDetail(storeID roachpb.StoreID) storeDetail {
 	sp.mu.Lock()
@@ -270,6 +344,37 @@ func (sp *StorePool) deadReplicas(repls []roachpb.ReplicaDescriptor) []roachpb.R
 	return deadReplicas
 }
 
+// decommissioningReplicas returns any replicas from the supplied slice that
+// are located on nodes which are being decommissioned.
+func (sp *StorePool) decommissioningReplicas(repls []roachpb.ReplicaDescriptor) []roachpb.ReplicaDescriptor {
+	sp.mu.RLock()
+	defer sp.mu.RUnlock()
+
+	var decommissioningReplicas []roachpb.ReplicaDescriptor
+	for _, repl := range repls {
+		if _, ok := sp.decommissioning[repl.NodeID]; ok {
+			decommissioningReplicas = append(decommissioningReplicas, repl)
+		}
+	}
+	return decommissioningReplicas
+}
+
+// failureDomains returns the number of failure domains spanned by the
+// supplied replicas. Nodes with the same attributes are considered to share
+// a failure domain. The returned bool is false if the descriptor of any of
+// the replicas' stores hasn't been gossiped yet.
+func (sp *StorePool) failureDomains(repls []roachpb.ReplicaDescriptor) (int, bool) {
+	domains := map[string]struct{}{}
+	for _, repl := range repls {
+		desc := sp.getStoreDescriptor(repl.StoreID)
+		if desc == nil {
+			return 0, false
+		}
+		domains[desc.Node.Attrs.SortedString()] = struct{}{}
+	}
+	return len(domains), true
+}
+
 // stat provides a running sample size and mean.
 type stat struct {
 	n, mean float64
@@ -298,7 +403,8 @@ func (sl *StoreList) add(s *roachpb.StoreDescriptor) {
 }
 
 // GetStoreList returns a storeList that contains all active stores that
-// contain the required attributes and their associated stats.
+// contain the required attributes and their associated stats. Stores on
+// decommissioning nodes and nodes in maintenance mode are not included.
 // TODO(embark, spencer): consider using a reverse index map from
 // Attr->stores, for efficiency. Ensure that entries in this map still
 // have an opportunity to be garbage collected.
@@ -318,6 +424,12 @@ func (sp *StorePool) getStoreList(required roachpb.Attributes, deterministic boo
 	sl := StoreList{}
 	for _, storeID := range storeIDs {
 		detail := sp.stores[roachpb.StoreID(storeID)]
+		if _, ok := sp.decommissioning[detail.desc.Node.NodeID]; ok {
+			continue
+		}
+		if _, ok := sp.maintenance[detail.desc.Node.NodeID]; ok {
+			continue
+		}
 		if !detail.dead && required.IsSubset(*detail.desc.CombinedAttrs()) {
 			desc := detail.desc
 			sl.add(&desc)
@@ -373,3 +373,62 @@ func TestStorePoolFindDeadReplicas(t *testing.T) {
 		t.Fatalf("findDeadReplicas did not return expected values; got \n%v, expected \n%v", a, e)
 	}
 }
+
+// TestStorePoolFailureDomains verifies that the replicas on nodes with the
+// same attributes are considered to share a failure domain.
+func TestStorePoolFailureDomains(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, sp := createTestStorePool(TestTimeUntilStoreDead)
+	defer stopper.Stop()
+	sg := gossiputil.NewStoreGossiper(g)
+
+	stores := []*roachpb.StoreDescriptor{
+		{
+			StoreID: 1,
+			Node: roachpb.NodeDescriptor{
+				NodeID: 1,
+				Attrs:  roachpb.Attributes{Attrs: []string{"us-east", "rack1"}},
+			},
+		},
+		{
+			StoreID: 2,
+			Node: roachpb.NodeDescriptor{
+				NodeID: 2,
+				Attrs:  roachpb.Attributes{Attrs: []string{"rack1", "us-east"}},
+			},
+		},
+		{
+			StoreID: 3,
+			Node: roachpb.NodeDescriptor{
+				NodeID: 3,
+				Attrs:  roachpb.Attributes{Attrs: []string{"us-east", "rack2"}},
+			},
+		},
+	}
+	sg.GossipStores(stores, t)
+
+	replica := func(id int) roachpb.ReplicaDescriptor {
+		return roachpb.ReplicaDescriptor{
+			NodeID:    roachpb.NodeID(id),
+			StoreID:   roachpb.StoreID(id),
+			ReplicaID: roachpb.ReplicaID(id),
+		}
+	}
+	testCases := []struct {
+		replicas []roachpb.ReplicaDescriptor
+		domains  int
+		known    bool
+	}{
+		{[]roachpb.ReplicaDescriptor{replica(1)}, 1, true},
+		{[]roachpb.ReplicaDescriptor{replica(1), replica(2)}, 1, true},
+		{[]roachpb.ReplicaDescriptor{replica(1), replica(2), replica(3)}, 2, true},
+		// Store 4 hasn't been gossiped.
+		{[]roachpb.ReplicaDescriptor{replica(1), replica(3), replica(4)}, 0, false},
+	}
+	for i, test := range testCases {
+		if domains, known := sp.failureDomains(test.replicas); domains != test.domains || known != test.known {
+			t.Errorf("%d: expected %d failure domains (known %t), got %d (known %t)",
+				i, test.domains, test.known, domains, known)
+		}
+	}
+}
@@ -854,6 +854,38 @@ func TestStoreSetRangesMaxBytes(t *testing.T) {
 	}
 }
 
+// TestStoreLowDiversityRanges verifies that the ranges led by the store whose
+// replicas span fewer failure domains than their zone requires are counted.
+func TestStoreLowDiversityRanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	// The replicas of the new range only span the failure domain of the
+	// store, while its zone requires two.
+	baseID := uint32(keys.MaxReservedDescID + 1)
+	splitTestRange(store, roachpb.RKeyMin, keys.MakeTablePrefix(baseID), t)
+	config.TestingSetZoneConfig(baseID, &config.ZoneConfig{
+		ReplicaAttrs:      []roachpb.Attributes{{}},
+		MinFailureDomains: 2,
+	})
+
+	// The locality of the replicas is unknown until the store's descriptor
+	// has been gossiped.
+	if _, _, _, lowDiversity := store.computeReplicationStatus(store.ctx.Clock.Now().WallTime); lowDiversity != 0 {
+		t.Errorf("expected no low diversity ranges before gossiping the store, got %d", lowDiversity)
+	}
+	store.GossipStore()
+
+	util.SucceedsWithin(t, time.Second, func() error {
+		_, _, _, lowDiversity := store.computeReplicationStatus(store.ctx.Clock.Now().WallTime)
+		if lowDiversity != 1 {
+			return util.Errorf("expected 1 low diversity range, got %d", lowDiversity)
+		}
+		return nil
+	})
+}
+
 // TestStoreResolveWriteIntent adds write intent and then verifies
 // that a put returns success and aborts intent's txn in the event the
 // pushee has lower priority. Othwerise, verifies that a
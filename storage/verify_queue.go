@@ -17,11 +17,13 @@
 package storage
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
@@ -104,10 +106,39 @@ func (*verifyQueue) process(now roachpb.Timestamp, rng *Replica,
 		log.Fatalf("unhandled failure when scanning range %s; probable data corruption: %s", rng, iter.Error())
 	}
 
+	if err := verifyStats(rng, snap, now.WallTime); err != nil {
+		return err
+	}
+
 	// Store current timestamp as last verification for this range.
 	return rng.SetLastVerificationTimestamp(now)
 }
 
+// verifyStats compares the stats recorded for the range in the snapshot with
+// the stats computed from the range's data in the snapshot, and records on
+// the replica whether they diverge. Only the counts and sizes are compared,
+// as the ages depend on when the stats were last updated.
+func verifyStats(rng *Replica, snap engine.Engine, nowNanos int64) error {
+	var recorded engine.MVCCStats
+	if err := engine.MVCCGetRangeStats(snap, rng.RangeID, &recorded); err != nil {
+		return err
+	}
+	computed, err := rng.computeStats(rng.Desc(), snap, nowNanos)
+	if err != nil {
+		return err
+	}
+	recorded.IntentAge, computed.IntentAge = 0, 0
+	recorded.GCBytesAge, computed.GCBytesAge = 0, 0
+	recorded.LastUpdateNanos, computed.LastUpdateNanos = 0, 0
+	var divergent int32
+	if recorded != computed {
+		log.Warningf("range %s: recorded stats %+v differ from computed stats %+v", rng, recorded, computed)
+		divergent = 1
+	}
+	atomic.StoreInt32(&rng.statsDivergent, divergent)
+	return nil
+}
+
 // timer returns the duration of intervals between successive range
 // verification scans. The durations are sized so that the full
 // complement of ranges can be scanned within verificationInterval.
@@ -0,0 +1,104 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sqlutils
+
+import (
+	gosql "database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// RangeEvent is an event recorded in the system.rangelog table.
+type RangeEvent struct {
+	Timestamp    time.Time
+	RangeID      int64
+	EventType    string
+	StoreID      int64
+	OtherRangeID gosql.NullInt64
+	Info         gosql.NullString
+}
+
+// WaitForRangeEvent blocks until at least one event of the given type has
+// been recorded in the range event log, and returns the events of that type
+// in timestamp order. The test fails (with t.Fatal) if no such event appears
+// within the timeout.
+func WaitForRangeEvent(t util.Tester, db *gosql.DB, eventType storage.RangeEventLogType,
+	timeout time.Duration) []RangeEvent {
+	return WaitForRangeEventMatching(t, db, eventType, timeout, nil)
+}
+
+// WaitForRangeEventMatching is like WaitForRangeEvent, but only considers the
+// events of the given type for which match returns true, if it is not nil.
+func WaitForRangeEventMatching(t util.Tester, db *gosql.DB, eventType storage.RangeEventLogType,
+	timeout time.Duration, match func(RangeEvent) bool) []RangeEvent {
+	var events []RangeEvent
+	util.SucceedsWithinDepth(1, t, timeout, func() error {
+		var err error
+		if events, err = queryRangeEvents(db, eventType, match); err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return util.Errorf("no %s event in the range event log", eventType)
+		}
+		return nil
+	})
+	return events
+}
+
+// RecentRangeEvents returns the last n events recorded in the range event
+// log which involve the given range, either as the range the event was
+// recorded for or as the other range (e.g. the range created by a split),
+// newest first.
+func RecentRangeEvents(db *gosql.DB, rangeID int64, n int) ([]RangeEvent, error) {
+	// TODO(mrtracy): Change to parameterized query when #3660 is fixed.
+	return scanRangeEvents(db, fmt.Sprintf(`SELECT timestamp, rangeID, eventType, storeID, otherRangeID, info `+
+		`FROM system.rangelog WHERE rangeID = %d OR otherRangeID = %d ORDER BY timestamp DESC LIMIT %d`,
+		rangeID, rangeID, n), nil)
+}
+
+// queryRangeEvents returns the events of the given type for which match
+// returns true, if it is not nil, in timestamp order.
+func queryRangeEvents(db *gosql.DB, eventType storage.RangeEventLogType,
+	match func(RangeEvent) bool) ([]RangeEvent, error) {
+	// TODO(mrtracy): Change to parameterized query when #3660 is fixed.
+	return scanRangeEvents(db, fmt.Sprintf(`SELECT timestamp, rangeID, eventType, storeID, otherRangeID, info `+
+		`FROM system.rangelog WHERE eventType = '%s' ORDER BY timestamp`, eventType), match)
+}
+
+// scanRangeEvents runs the query, which must select all the columns of the
+// range event log, and returns the events for which match returns true, if
+// it is not nil.
+func scanRangeEvents(db *gosql.DB, query string, match func(RangeEvent) bool) ([]RangeEvent, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var events []RangeEvent
+	for rows.Next() {
+		var event RangeEvent
+		if err := rows.Scan(&event.Timestamp, &event.RangeID, &event.EventType, &event.StoreID,
+			&event.OtherRangeID, &event.Info); err != nil {
+			return nil, err
+		}
+		if match == nil || match(event) {
+			events = append(events, event)
+		}
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,33 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package statusutils
+
+import "github.com/cockroachdb/cockroach/ts"
+
+// Replay applies each of the events, in order, through process and returns
+// the time series then reported by source. The events are of the types
+// published to the node's event feed, e.g. *storage.ReplicationStatusEvent or
+// *status.CallSuccessEvent; process is typically the ProcessEvent method of a
+// status.NodeStatusMonitor and source a status.NodeStatusRecorder using that
+// monitor.
+//
+// Replay does not depend on the status package so that it can be used by the
+// status package's own tests.
+func Replay(source ts.DataSource, process func(event interface{}), events ...interface{}) []ts.TimeSeriesData {
+	for _, event := range events {
+		process(event)
+	}
+	return source.GetTimeSeriesData()
+}
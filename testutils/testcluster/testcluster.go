@@ -0,0 +1,564 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package testcluster
+
+import (
+	gosql "database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip/resolver"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+const (
+	// replicationTimeout bounds the time spent waiting for replica and leader
+	// lease changes to take effect.
+	replicationTimeout = 10 * time.Second
+	// scanMaxIdleTime is the maximum idle time of each node's replica scanner,
+	// chosen so that the replicate queue visits every range quickly.
+	scanMaxIdleTime = 50 * time.Millisecond
+)
+
+// ReplicationMode determines how ranges are replicated in a TestCluster.
+type ReplicationMode int
+
+const (
+	// ReplicationAuto requires every range to be replicated to
+	// ClusterArgs.NumReplicas of the cluster's nodes and leaves the replicate
+	// queue running to do so.
+	ReplicationAuto ReplicationMode = iota
+	// ReplicationManual disables the replicate queue; ranges are only
+	// replicated through TestCluster.AddReplicas and
+	// TestCluster.RelocateRange.
+	ReplicationManual
+)
+
+// ClusterArgs contains the parameters used to start a TestCluster.
+type ClusterArgs struct {
+	ReplicationMode ReplicationMode
+	// NumReplicas is the number of replicas of each range under
+	// ReplicationAuto. It defaults to the number of nodes.
+	NumReplicas int
+	// TimeUntilStoreDead is the time after which the nodes consider a store
+	// whose descriptor hasn't been gossiped to be dead. Stores are gossiped
+	// when their node starts, and then once a minute.
+	TimeUntilStoreDead time.Duration
+}
+
+// ReplicationTarget identifies a store of a node in the cluster.
+type ReplicationTarget struct {
+	NodeID  roachpb.NodeID
+	StoreID roachpb.StoreID
+}
+
+// A TestCluster encapsulates a set of in-memory cockroach nodes, each running
+// as a TestServer with a single store, which have joined each other through
+// gossip. Node 0 bootstraps the cluster. Example usage follows:
+//
+//   tc := testcluster.StartTestCluster(t, 3, testcluster.ClusterArgs{})
+//   defer tc.Stop()
+//
+type TestCluster struct {
+	// Servers are the cluster's nodes. The server of a stopped node is left in
+	// place until the node is restarted.
+	Servers []*server.TestServer
+	// Conns are SQL connections to each of the nodes; nil if the node is
+	// stopped.
+	Conns []*gosql.DB
+	// DBs are KV clients connected to each of the nodes; nil if the node is
+	// stopped.
+	DBs []*client.DB
+
+	args    ClusterArgs
+	engines []engine.Engine
+	stopped []bool
+	// engineStopper owns the engines, which outlive the servers so that nodes
+	// can be restarted.
+	engineStopper *stop.Stopper
+	// replicaAttrs are the default zone config's replica attributes prior to
+	// starting the cluster, restored by Stop.
+	replicaAttrs []roachpb.Attributes
+}
+
+// StartTestCluster starts a cluster of the given number of nodes. Ports are
+// assigned dynamically. Use Stop to shutdown the cluster after the test
+// completes.
+func StartTestCluster(t util.Tester, nodes int, args ClusterArgs) *TestCluster {
+	if nodes < 1 {
+		t.Fatalf("invalid cluster size: %d", nodes)
+	}
+	tc := &TestCluster{
+		Servers:       make([]*server.TestServer, nodes),
+		Conns:         make([]*gosql.DB, nodes),
+		DBs:           make([]*client.DB, nodes),
+		args:          args,
+		engines:       make([]engine.Engine, nodes),
+		stopped:       make([]bool, nodes),
+		engineStopper: stop.NewStopper(),
+		replicaAttrs:  config.DefaultZoneConfig.ReplicaAttrs,
+	}
+	for i := range tc.Servers {
+		tc.engines[i] = engine.NewInMem(roachpb.Attributes{}, 100<<20, tc.engineStopper)
+		if err := tc.startNode(i, i > 0 /* skipBootstrap */); err != nil {
+			tc.Stop()
+			t.Fatal(err)
+		}
+	}
+	return tc
+}
+
+// startNode starts the server of node i using its engine. Unless it is the
+// cluster's first node, the server joins the cluster through the gossip
+// network of the other running nodes. A restarted node keeps the addresses
+// it previously had.
+func (tc *TestCluster) startNode(i int, skipBootstrap bool) error {
+	ctx := server.NewTestContext()
+	ctx.Engines = []engine.Engine{tc.engines[i]}
+	ctx.ScanMaxIdleTime = scanMaxIdleTime
+	if tc.args.TimeUntilStoreDead != 0 {
+		ctx.TimeUntilStoreDead = tc.args.TimeUntilStoreDead
+	}
+	if prev := tc.Servers[i]; prev != nil {
+		ctx.Addr = prev.ServingAddr()
+		ctx.PGAddr = prev.PGAddr()
+	}
+	for j, s := range tc.Servers {
+		if j == i || s == nil || tc.stopped[j] {
+			continue
+		}
+		r, err := resolver.NewResolver(&ctx.Context, s.ServingAddr())
+		if err != nil {
+			return err
+		}
+		ctx.GossipBootstrapResolvers = append(ctx.GossipBootstrapResolvers, r)
+	}
+
+	s := &server.TestServer{Ctx: ctx, SkipBootstrap: skipBootstrap}
+	if err := s.Start(); err != nil {
+		return err
+	}
+	tc.Servers[i] = s
+	tc.stopped[i] = false
+
+	// A node joining the cluster for the first time bootstraps its store
+	// asynchronously once it has been assigned a node ID.
+	if err := util.RetryForDuration(replicationTimeout, func() error {
+		if s.Stores().GetStoreCount() == 0 {
+			return util.Errorf("store of node %d not yet started", i)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if tc.args.ReplicationMode == ReplicationManual {
+		if err := s.Stores().VisitStores(func(store *storage.Store) error {
+			store.SetReplicateQueueActive(false)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	// Starting a TestServer resets the default zone config to a single
+	// replica.
+	if tc.args.ReplicationMode == ReplicationAuto {
+		numReplicas := tc.args.NumReplicas
+		if numReplicas == 0 {
+			numReplicas = len(tc.Servers)
+		}
+		config.DefaultZoneConfig.ReplicaAttrs = make([]roachpb.Attributes, numReplicas)
+	}
+
+	var err error
+	tc.Conns[i], err = gosql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=%s",
+		security.RootUser, s.ServingAddr(), security.EmbeddedCertsDir))
+	if err != nil {
+		return err
+	}
+	tc.DBs[i], err = s.OpenDBClient(security.NodeUser)
+	return err
+}
+
+// Stop stops all of the cluster's running nodes and releases their engines.
+func (tc *TestCluster) Stop() {
+	for i, s := range tc.Servers {
+		if s != nil && !tc.stopped[i] {
+			tc.StopNode(i)
+		}
+	}
+	tc.engineStopper.Stop()
+	config.DefaultZoneConfig.ReplicaAttrs = tc.replicaAttrs
+}
+
+// StopNode stops node i. Its engine is retained so that it can later be
+// restarted using RestartNode.
+func (tc *TestCluster) StopNode(i int) {
+	if tc.Conns[i] != nil {
+		_ = tc.Conns[i].Close()
+		tc.Conns[i] = nil
+	}
+	tc.DBs[i] = nil
+	tc.Servers[i].Stop()
+	tc.stopped[i] = true
+}
+
+// RestartNode restarts node i, which must have been stopped using StopNode.
+// The node rejoins the cluster with its previous node ID, store and
+// addresses.
+func (tc *TestCluster) RestartNode(i int) error {
+	if !tc.stopped[i] {
+		return util.Errorf("node %d is not stopped", i)
+	}
+	return tc.startNode(i, true /* skipBootstrap */)
+}
+
+// ServingAddr returns the RPC address of node i.
+func (tc *TestCluster) ServingAddr(i int) string {
+	return tc.Servers[i].ServingAddr()
+}
+
+// Target returns the replication target for the store of node i.
+func (tc *TestCluster) Target(i int) ReplicationTarget {
+	s := tc.Servers[i]
+	return ReplicationTarget{
+		NodeID:  s.Gossip().GetNodeID(),
+		StoreID: tc.store(i).StoreID(),
+	}
+}
+
+// store returns the store of node i.
+func (tc *TestCluster) store(i int) *storage.Store {
+	var store *storage.Store
+	if err := tc.Servers[i].Stores().VisitStores(func(s *storage.Store) error {
+		store = s
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+	return store
+}
+
+// findStore returns the store with the given ID on any running node.
+func (tc *TestCluster) findStore(storeID roachpb.StoreID) (*storage.Store, error) {
+	for i, s := range tc.Servers {
+		if tc.stopped[i] {
+			continue
+		}
+		if store, pErr := s.Stores().GetStore(storeID); pErr == nil {
+			return store, nil
+		}
+	}
+	return nil, util.Errorf("store %d not found on a running node", storeID)
+}
+
+// lookupReplica returns the replica of the range containing the given key
+// from the first running node having one.
+func (tc *TestCluster) lookupReplica(key roachpb.RKey) (*storage.Replica, error) {
+	for i := range tc.Servers {
+		if tc.stopped[i] {
+			continue
+		}
+		if rng := tc.store(i).LookupReplica(key, nil); rng != nil {
+			return rng, nil
+		}
+	}
+	return nil, util.Errorf("no replica of the range containing %s found", key)
+}
+
+// LookupRange returns a consistent read of the descriptor of the range
+// containing the given key.
+func (tc *TestCluster) LookupRange(key roachpb.Key) (roachpb.RangeDescriptor, error) {
+	b := &client.Batch{}
+	b.InternalAddRequest(&roachpb.RangeLookupRequest{
+		Span: roachpb.Span{
+			Key: keys.RangeMetaKey(keys.Addr(key)),
+		},
+		MaxRanges: 1,
+	})
+	br, pErr := tc.kvDB().RunWithResponse(b)
+	if pErr != nil {
+		return roachpb.RangeDescriptor{}, pErr.GoError()
+	}
+	reply := br.Responses[0].GetInner().(*roachpb.RangeLookupResponse)
+	if a, e := len(reply.Ranges), 1; a != e {
+		return roachpb.RangeDescriptor{}, util.Errorf("expected %d range descriptor, got %d", e, a)
+	}
+	return reply.Ranges[0], nil
+}
+
+// kvDB returns the KV client of the first running node.
+func (tc *TestCluster) kvDB() *client.DB {
+	for _, db := range tc.DBs {
+		if db != nil {
+			return db
+		}
+	}
+	panic("no running nodes")
+}
+
+// AddReplicas adds replicas of the range containing the given key on each of
+// the targets and waits for them to be initialized. It returns the updated
+// range descriptor.
+func (tc *TestCluster) AddReplicas(key roachpb.Key, targets ...ReplicationTarget) (*roachpb.RangeDescriptor, error) {
+	rKey := keys.Addr(key)
+	rng, err := tc.lookupReplica(rKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range targets {
+		replica := roachpb.ReplicaDescriptor{
+			NodeID:  target.NodeID,
+			StoreID: target.StoreID,
+		}
+		if err := rng.ChangeReplicas(roachpb.ADD_REPLICA, replica, rng.Desc()); err != nil {
+			return nil, err
+		}
+		// The next change uses the local descriptor as its optimistic lock, so
+		// wait for this change to be applied locally.
+		if err := util.RetryForDuration(replicationTimeout, func() error {
+			if _, r := rng.Desc().FindReplica(target.StoreID); r == nil {
+				return util.Errorf("replica on store %d not yet added to %s", target.StoreID, rng)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tc.waitForInitialized(rKey, targets); err != nil {
+		return nil, err
+	}
+	return rng.Desc(), nil
+}
+
+// waitForInitialized waits for the range containing the given key to be
+// initialized on the stores of each of the targets.
+func (tc *TestCluster) waitForInitialized(rKey roachpb.RKey, targets []ReplicationTarget) error {
+	return util.RetryForDuration(replicationTimeout, func() error {
+		for _, target := range targets {
+			store, err := tc.findStore(target.StoreID)
+			if err != nil {
+				return err
+			}
+			if store.LookupReplica(rKey, nil) == nil {
+				return util.Errorf("range not yet initialized on store %d", target.StoreID)
+			}
+		}
+		return nil
+	})
+}
+
+// RelocateRange moves the replicas of the range containing the given key to
+// exactly the given targets and transfers its leader lease to the first of
+// them. Targets which already hold a replica keep it. Replicas are added on
+// the other targets before the lease is transferred and the remaining
+// replicas are removed, so the range never has fewer replicas than it
+// started with or than there are targets. Each change is retried until it
+// succeeds or replicationTimeout elapses. It returns the updated range
+// descriptor.
+//
+// RelocateRange is meant for tests which need a range's replicas in known
+// places and is only available on a TestCluster, which has access to every
+// store: there is no lease transfer primitive for a KV request to use.
+func (tc *TestCluster) RelocateRange(key roachpb.Key, targets ...ReplicationTarget) (*roachpb.RangeDescriptor, error) {
+	if len(targets) == 0 {
+		return nil, util.Errorf("relocating the range containing %s requires at least one target", key)
+	}
+	nodes := make(map[roachpb.NodeID]struct{}, len(targets))
+	for _, target := range targets {
+		if _, ok := nodes[target.NodeID]; ok {
+			return nil, util.Errorf("multiple relocation targets on node %d", target.NodeID)
+		}
+		nodes[target.NodeID] = struct{}{}
+		if _, err := tc.findStore(target.StoreID); err != nil {
+			return nil, err
+		}
+	}
+	rKey := keys.Addr(key)
+
+	for _, target := range targets {
+		if err := tc.changeReplicas(key, roachpb.ADD_REPLICA, target); err != nil {
+			return nil, err
+		}
+	}
+	if err := tc.waitForInitialized(rKey, targets); err != nil {
+		return nil, err
+	}
+
+	desc, err := tc.LookupRange(key)
+	if err != nil {
+		return nil, err
+	}
+	if holder, err := tc.FindRangeLeaseHolder(&desc); err != nil || holder != targets[0] {
+		if err := tc.TransferLease(&desc, targets[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, replica := range desc.Replicas {
+		if _, ok := nodes[replica.NodeID]; ok {
+			continue
+		}
+		target := ReplicationTarget{NodeID: replica.NodeID, StoreID: replica.StoreID}
+		if err := tc.changeReplicas(key, roachpb.REMOVE_REPLICA, target); err != nil {
+			return nil, err
+		}
+	}
+
+	if desc, err = tc.LookupRange(key); err != nil {
+		return nil, err
+	}
+	return &desc, nil
+}
+
+// changeReplicas adds or removes the replica on the target of the range
+// containing the given key, unless the range already has or lacks it. The
+// change is proposed through a replica on a running node which is not being
+// removed and is retried until it succeeds or replicationTimeout elapses.
+func (tc *TestCluster) changeReplicas(key roachpb.Key, changeType roachpb.ReplicaChangeType, target ReplicationTarget) error {
+	return util.RetryForDuration(replicationTimeout, func() error {
+		desc, err := tc.LookupRange(key)
+		if err != nil {
+			return err
+		}
+		if _, r := desc.FindReplica(target.StoreID); (r != nil) == (changeType == roachpb.ADD_REPLICA) {
+			return nil
+		}
+		for _, replica := range desc.Replicas {
+			if replica.StoreID == target.StoreID {
+				continue
+			}
+			store, err := tc.findStore(replica.StoreID)
+			if err != nil {
+				continue
+			}
+			rng, err := store.GetReplica(desc.RangeID)
+			if err != nil {
+				continue
+			}
+			return rng.ChangeReplicas(changeType, roachpb.ReplicaDescriptor{
+				NodeID:  target.NodeID,
+				StoreID: target.StoreID,
+			}, &desc)
+		}
+		return util.Errorf("no replica of range %d found on a running node", desc.RangeID)
+	})
+}
+
+// FindRangeLeaseHolder returns the store holding the active leader lease of
+// the given range.
+func (tc *TestCluster) FindRangeLeaseHolder(desc *roachpb.RangeDescriptor) (ReplicationTarget, error) {
+	for _, replica := range desc.Replicas {
+		store, err := tc.findStore(replica.StoreID)
+		if err != nil {
+			continue
+		}
+		rng, err := store.GetReplica(desc.RangeID)
+		if err != nil {
+			continue
+		}
+		if lease := rng.GetLeaderLease(); lease != nil && lease.Covers(store.Clock().Now()) {
+			return ReplicationTarget{
+				NodeID:  lease.Replica.NodeID,
+				StoreID: lease.Replica.StoreID,
+			}, nil
+		}
+	}
+	return ReplicationTarget{}, util.Errorf("no active leader lease found for range %d", desc.RangeID)
+}
+
+// TransferLease moves the leader lease of the given range to the target,
+// which must hold one of its replicas. There is no lease transfer primitive,
+// so the other replicas' stores are set to draining, which prevents them from
+// acquiring or extending leases, until the current lease has expired and the
+// target has acquired it. This affects the leases of all ranges on those
+// stores while the transfer is in progress.
+func (tc *TestCluster) TransferLease(desc *roachpb.RangeDescriptor, target ReplicationTarget) error {
+	if _, r := desc.FindReplica(target.StoreID); r == nil {
+		return util.Errorf("store %d does not hold a replica of range %d", target.StoreID, desc.RangeID)
+	}
+	targetStore, err := tc.findStore(target.StoreID)
+	if err != nil {
+		return err
+	}
+	for _, replica := range desc.Replicas {
+		if replica.StoreID == target.StoreID {
+			continue
+		}
+		if store, err := tc.findStore(replica.StoreID); err == nil {
+			store.SetDraining(true)
+			defer store.SetDraining(false)
+		}
+	}
+
+	// Reading from the target's replica makes it acquire the lease as soon as
+	// the current lease has expired.
+	return util.RetryForDuration(replicationTimeout, func() error {
+		getArgs := roachpb.GetRequest{
+			Span: roachpb.Span{
+				Key: keys.RangeDescriptorKey(desc.StartKey),
+			},
+		}
+		if _, pErr := client.SendWrappedWith(targetStore, nil, roachpb.Header{
+			RangeID: desc.RangeID,
+		}, &getArgs); pErr != nil {
+			return pErr.GoError()
+		}
+		holder, err := tc.FindRangeLeaseHolder(desc)
+		if err != nil {
+			return err
+		}
+		if holder.StoreID != target.StoreID {
+			return util.Errorf("leader lease of range %d held by store %d", desc.RangeID, holder.StoreID)
+		}
+		return nil
+	})
+}
+
+// WaitForFullReplication waits until every range is replicated to all of the
+// cluster's running nodes, returning an error if this does not happen within
+// a configured timeout.
+func (tc *TestCluster) WaitForFullReplication() error {
+	return util.RetryForDuration(replicationTimeout, func() error {
+		rows, pErr := tc.kvDB().Scan(keys.Meta2Prefix, keys.MetaMax, 0)
+		if pErr != nil {
+			return pErr.GoError()
+		}
+		for _, row := range rows {
+			var desc roachpb.RangeDescriptor
+			if err := row.ValueProto(&desc); err != nil {
+				return err
+			}
+			for i := range tc.Servers {
+				if tc.stopped[i] {
+					continue
+				}
+				if tc.store(i).LookupReplica(desc.StartKey, nil) == nil {
+					return util.Errorf("range %d not yet replicated to node %d", desc.RangeID, i)
+				}
+			}
+		}
+		return nil
+	})
+}
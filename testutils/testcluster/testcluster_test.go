@@ -0,0 +1,255 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package testcluster
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestClusterLeaseTransfer replicates a table's range across a manually
+// replicated cluster, transfers its leader lease and verifies that the table
+// is readable and writable through SQL on every node.
+func TestClusterLeaseTransfer(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := StartTestCluster(t, 3, ClusterArgs{ReplicationMode: ReplicationManual})
+	defer tc.Stop()
+
+	if _, err := tc.Conns[0].Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (0, 0);
+`); err != nil {
+		t.Fatal(err)
+	}
+	var tableID uint32
+	if err := tc.Conns[0].QueryRow(`SELECT id FROM system.namespace WHERE name = 'kv'`).Scan(&tableID); err != nil {
+		t.Fatal(err)
+	}
+	tableStartKey := roachpb.Key(keys.MakeTablePrefix(tableID))
+
+	// Wait for the table's range to be split off.
+	util.SucceedsWithin(t, replicationTimeout, func() error {
+		desc, err := tc.LookupRange(tableStartKey)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(desc.StartKey, tableStartKey) {
+			return util.Errorf("table range not yet split off; range starts at %s", desc.StartKey)
+		}
+		return nil
+	})
+
+	start := time.Now()
+	target := tc.Target(2)
+	desc, err := tc.RelocateRange(tableStartKey, target, tc.Target(0), tc.Target(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("relocated range %d to %+v in %s", desc.RangeID, desc.Replicas, time.Since(start))
+	if a, e := len(desc.Replicas), 3; a != e {
+		t.Fatalf("expected %d replicas, got %+v", e, desc.Replicas)
+	}
+	if holder, err := tc.FindRangeLeaseHolder(desc); err != nil {
+		t.Fatal(err)
+	} else if holder != target {
+		t.Fatalf("expected leader lease to be held by %+v, got %+v", target, holder)
+	}
+
+	for i, conn := range tc.Conns {
+		if _, err := conn.Exec(`INSERT INTO t.kv VALUES ($1, $1)`, i+1); err != nil {
+			t.Fatalf("node %d: %s", i, err)
+		}
+		var count int
+		if err := conn.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+			t.Fatalf("node %d: %s", i, err)
+		}
+		if e := i + 2; count != e {
+			t.Errorf("node %d: expected %d rows, got %d", i, e, count)
+		}
+	}
+}
+
+// TestClusterRelocateRange relocates a range between overlapping and
+// disjoint sets of stores and verifies that its data is preserved.
+func TestClusterRelocateRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := StartTestCluster(t, 4, ClusterArgs{ReplicationMode: ReplicationManual})
+	defer tc.Stop()
+	db := tc.DBs[0]
+
+	key := roachpb.Key("m")
+	if pErr := db.AdminSplit(key); pErr != nil {
+		t.Fatal(pErr)
+	}
+	if pErr := db.Put(key, "v"); pErr != nil {
+		t.Fatal(pErr)
+	}
+
+	// Relocations which would leave the range without replicas or with two
+	// replicas on a node are refused.
+	if _, err := tc.RelocateRange(key); err == nil {
+		t.Error("expected relocation to no targets to fail")
+	}
+	if _, err := tc.RelocateRange(key, tc.Target(1), tc.Target(1)); err == nil {
+		t.Error("expected relocation to duplicate targets to fail")
+	}
+
+	testCases := [][]int{
+		// Disjoint from the initial replica, which holds the lease.
+		{1, 2},
+		// Overlapping, with the lease moving to a new replica.
+		{3, 2},
+		// Shrinking to an existing replica which doesn't hold the lease.
+		{2},
+		// Unchanged.
+		{2},
+		// Growing.
+		{0, 1, 2, 3},
+	}
+	for i, nodes := range testCases {
+		var targets []ReplicationTarget
+		for _, n := range nodes {
+			targets = append(targets, tc.Target(n))
+		}
+		desc, err := tc.RelocateRange(key, targets...)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if a, e := len(desc.Replicas), len(targets); a != e {
+			t.Fatalf("%d: expected %d replicas, got %+v", i, e, desc.Replicas)
+		}
+		for _, target := range targets {
+			if _, r := desc.FindReplica(target.StoreID); r == nil {
+				t.Errorf("%d: expected a replica on %+v, got %+v", i, target, desc.Replicas)
+			}
+		}
+		if holder, err := tc.FindRangeLeaseHolder(desc); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		} else if holder != targets[0] {
+			t.Errorf("%d: expected leader lease to be held by %+v, got %+v", i, targets[0], holder)
+		}
+		if kv, pErr := db.Get(key); pErr != nil {
+			t.Fatalf("%d: %s", i, pErr)
+		} else if v := kv.ValueBytes(); !bytes.Equal(v, []byte("v")) {
+			t.Errorf("%d: expected value %q, got %q", i, "v", v)
+		}
+	}
+}
+
+// TestClusterRestartNode verifies that a stopped node rejoins the cluster on
+// restart and serves writes made while it was down.
+func TestClusterRestartNode(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := StartTestCluster(t, 3, ClusterArgs{})
+	defer tc.Stop()
+
+	if err := tc.WaitForFullReplication(); err != nil {
+		t.Fatal(err)
+	}
+	tc.StopNode(2)
+	if pErr := tc.DBs[0].Put("a", "b"); pErr != nil {
+		t.Fatal(pErr)
+	}
+	if err := tc.RestartNode(2); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, replicationTimeout, func() error {
+		kv, pErr := tc.DBs[2].Get("a")
+		if pErr != nil {
+			return pErr.GoError()
+		}
+		if v := kv.ValueBytes(); !bytes.Equal(v, []byte("b")) {
+			return util.Errorf("expected value %q, got %q", "b", v)
+		}
+		return nil
+	})
+}
+
+// TestClusterDecommission decommissions a node of a cluster replicating each
+// range three times and verifies that its replicas are moved to the other
+// nodes, after which it is marked as decommissioned.
+func TestClusterDecommission(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := StartTestCluster(t, 4, ClusterArgs{NumReplicas: 3})
+	defer tc.Stop()
+
+	s := tc.Servers[0]
+	nodeID := tc.Target(1).NodeID
+
+	// Recommissioning is possible until the node has been decommissioned.
+	if err := s.Decommission([]roachpb.NodeID{nodeID}, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Decommission([]roachpb.NodeID{nodeID}, false); err != nil {
+		t.Fatal(err)
+	}
+	if progress, err := s.DecommissionProgress(); err != nil {
+		t.Fatal(err)
+	} else if len(progress) != 0 {
+		t.Fatalf("expected no decommissioning nodes, got %+v", progress)
+	}
+
+	// Wait for the node to hold a replica before decommissioning it.
+	util.SucceedsWithin(t, replicationTimeout, func() error {
+		if n := tc.store(1).ReplicaCount(); n == 0 {
+			return util.Errorf("node %d holds no replicas", nodeID)
+		}
+		return nil
+	})
+	if err := s.Decommission([]roachpb.NodeID{nodeID}, true); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 3*replicationTimeout, func() error {
+		progress, err := s.DecommissionProgress()
+		if err != nil {
+			return err
+		}
+		if len(progress) != 1 || progress[0].NodeID != nodeID {
+			return util.Errorf("unexpected decommission progress %+v", progress)
+		}
+		if !progress[0].Decommissioned {
+			return util.Errorf("%d replicas remaining on node %d", progress[0].Replicas, nodeID)
+		}
+		return nil
+	})
+
+	// Every range has been replicated to the other nodes.
+	rows, pErr := tc.kvDB().Scan(keys.Meta2Prefix, keys.MetaMax, 0)
+	if pErr != nil {
+		t.Fatal(pErr)
+	}
+	for _, row := range rows {
+		var desc roachpb.RangeDescriptor
+		if err := row.ValueProto(&desc); err != nil {
+			t.Fatal(err)
+		}
+		for _, repl := range desc.Replicas {
+			if repl.NodeID == nodeID {
+				t.Errorf("range %d still has a replica on node %d", desc.RangeID, nodeID)
+			}
+		}
+	}
+
+	if err := s.Decommission([]roachpb.NodeID{nodeID}, false); err == nil {
+		t.Error("expected recommissioning a decommissioned node to fail")
+	}
+}
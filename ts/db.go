@@ -17,23 +17,45 @@
 package ts
 
 import (
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
 
+const (
+	// writeErrorsName is the name of the metric which counts the failed
+	// writes of time series data.
+	writeErrorsName = "internal.tsd.write.errors"
+	// writeErrorLogInterval is the minimum interval between two logged
+	// failures to write time series data. Failures are counted regardless.
+	writeErrorLogInterval = time.Minute
+)
+
 // DB provides Cockroach's Time Series API.
 type DB struct {
-	db *client.DB
+	db          *client.DB
+	writeErrors *metric.Counter
+
+	mu struct {
+		sync.Mutex
+		// lastErrorLog is the time at which a write failure was last logged,
+		// and suppressedErrors the number of failures not logged since.
+		lastErrorLog     time.Time
+		suppressedErrors int
+	}
 }
 
-// NewDB creates a new DB instance.
-func NewDB(db *client.DB) *DB {
+// NewDB creates a new DB instance. The DB's metrics are added to the
+// registry.
+func NewDB(db *client.DB, registry *metric.Registry) *DB {
 	return &DB{
-		db: db,
+		db:          db,
+		writeErrors: registry.Counter(writeErrorsName),
 	}
 }
 
@@ -93,13 +115,36 @@ func (p *poller) poll() {
 		}
 
 		if err := p.db.StoreData(p.r, data); err != nil {
-			log.Warningf("error writing time series data: %s", err)
+			p.db.logWriteError(err)
 		}
 	})
 }
 
+// logWriteError logs a failure to write time series data, unless another
+// failure was logged less than writeErrorLogInterval ago. The failures of
+// all the pollers are logged at this rate, as they usually share a cause,
+// e.g. an unavailable range.
+func (db *DB) logWriteError(err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	now := time.Now()
+	if now.Sub(db.mu.lastErrorLog) < writeErrorLogInterval {
+		db.mu.suppressedErrors++
+		return
+	}
+	if db.mu.suppressedErrors > 0 {
+		log.Warningf("error writing time series data (%d similar errors suppressed): %s",
+			db.mu.suppressedErrors, err)
+	} else {
+		log.Warningf("error writing time series data: %s", err)
+	}
+	db.mu.lastErrorLog = now
+	db.mu.suppressedErrors = 0
+}
+
 // StoreData writes the supplied time series data to the cockroach server.
-// Stored data will be sampled at the supplied resolution.
+// Stored data will be sampled at the supplied resolution. Failed writes are
+// counted by the internal.tsd.write.errors metric.
 func (db *DB) StoreData(r Resolution, data []TimeSeriesData) error {
 	var kvs []roachpb.KeyValue
 
@@ -133,5 +178,9 @@ func (db *DB) StoreData(r Resolution, data []TimeSeriesData) error {
 		})
 	}
 
-	return db.db.Run(&b).GoError()
+	if err := db.db.Run(&b).GoError(); err != nil {
+		db.writeErrors.Inc(1)
+		return err
+	}
+	return nil
 }
@@ -24,10 +24,14 @@ import (
 	"testing"
 	"time"
 
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/gogo/protobuf/proto"
 )
@@ -69,7 +73,7 @@ func newTestModel(t *testing.T) *testModel {
 // time series DB.
 func (tm *testModel) Start() {
 	tm.LocalTestCluster.Start(tm.t)
-	tm.DB = NewDB(tm.LocalTestCluster.DB)
+	tm.DB = NewDB(tm.LocalTestCluster.DB, metric.NewRegistry())
 }
 
 // getActualData returns the actual value of all time series keys in the
@@ -362,3 +366,39 @@ func TestPollSource(t *testing.T) {
 	tm.assertKeyCount(3)
 	tm.assertModelCorrect()
 }
+
+// TestStoreDataWriteError verifies that failed writes of time series data are
+// counted, and that their logging is throttled.
+func TestStoreDataWriteError(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	registry := metric.NewRegistry()
+	db := NewDB(client.NewDB(client.SenderFunc(
+		func(context.Context, roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+			return nil, roachpb.NewErrorf("range unavailable")
+		})), registry)
+
+	data := []TimeSeriesData{
+		{
+			Name: "test.metric",
+			Datapoints: []*TimeSeriesDatapoint{
+				datapoint(1428713843000000000, 100),
+			},
+		},
+	}
+	for i := 0; i < 3; i++ {
+		err := db.StoreData(Resolution10s, data)
+		if err == nil {
+			t.Fatalf("%d: expected an error writing time series data", i)
+		}
+		db.logWriteError(err)
+	}
+	if a, e := registry.GetCounter(writeErrorsName).Count(), int64(3); a != e {
+		t.Errorf("expected %d write errors, got %d", e, a)
+	}
+	// Only the first error was logged.
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if a, e := db.mu.suppressedErrors, 2; a != e {
+		t.Errorf("expected %d suppressed errors, got %d", e, a)
+	}
+}
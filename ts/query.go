@@ -380,6 +380,10 @@ func (is unionIterator) dAvg() float64 {
 	return sum
 }
 
+// defaultMovingAverageWindow is the number of sample periods averaged by the
+// MOVING_AVG aggregator if the query doesn't specify a window.
+const defaultMovingAverageWindow = 6
+
 // Query returns datapoints for the named time series during the supplied time
 // span.  Data is returned as a series of consecutive data points.
 //
@@ -474,7 +478,7 @@ func (db *DB) Query(query TimeSeriesQueryRequest_Query, r Resolution,
 	// the response for each value.
 	var valueFn func() float64
 	switch query.GetAggregator() {
-	case TimeSeriesQueryAggregator_AVG:
+	case TimeSeriesQueryAggregator_AVG, TimeSeriesQueryAggregator_MOVING_AVG:
 		valueFn = iters.avg
 	case TimeSeriesQueryAggregator_AVG_RATE:
 		valueFn = iters.dAvg
@@ -489,5 +493,93 @@ func (db *DB) Query(query TimeSeriesQueryRequest_Query, r Resolution,
 		iters.advance()
 	}
 
+	if query.GetAggregator() == TimeSeriesQueryAggregator_MOVING_AVG {
+		window := int(query.GetWindow())
+		if window <= 0 {
+			window = defaultMovingAverageWindow
+		}
+		movingAverage(responseData, window)
+	}
+
 	return responseData, sources, nil
 }
+
+// movingAverage replaces the value of each datapoint with the average of its
+// value and the values of the window-1 datapoints preceding it. The first
+// datapoints, which have fewer predecessors, average the values present.
+func movingAverage(datapoints []*TimeSeriesDatapoint, window int) {
+	values := make([]float64, len(datapoints))
+	var sum float64
+	for i, dp := range datapoints {
+		values[i] = dp.Value
+		sum += dp.Value
+		if i >= window {
+			sum -= values[i-window]
+		}
+		count := i + 1
+		if count > window {
+			count = window
+		}
+		dp.Value = sum / float64(count)
+	}
+}
+
+// ExportSource returns all data collected from the given source at the
+// supplied Resolution during the supplied time span, as one TimeSeriesData
+// per series. Unlike Query, data is not interpolated or aggregated: each
+// returned datapoint holds the average value of a single stored sample and is
+// timestamped with the start of the sample period, so that the exported data
+// can be stored again using StoreData.
+//
+// Because time series keys are sorted by series name before source, every
+// series at the given resolution is scanned in order to find the data for the
+// source.
+func (db *DB) ExportSource(source string, r Resolution,
+	startNanos, endNanos int64) ([]TimeSeriesData, error) {
+	// Normalize startNanos to the nearest SampleDuration boundary.
+	startNanos -= startNanos % r.SampleDuration()
+
+	rows, pErr := db.db.Scan(keyDataPrefix, keyDataPrefix.PrefixEnd(), 0)
+	if pErr != nil {
+		return nil, pErr.GoError()
+	}
+
+	var result []TimeSeriesData
+	for _, row := range rows {
+		name, rowSource, rowResolution, keyTime, err := DecodeDataKey(row.Key)
+		if err != nil {
+			return nil, err
+		}
+		if rowSource != source || rowResolution != r {
+			continue
+		}
+		if keyTime > endNanos || keyTime+r.KeyDuration() <= startNanos {
+			continue
+		}
+
+		data := &roachpb.InternalTimeSeriesData{}
+		if err := row.ValueProto(data); err != nil {
+			return nil, err
+		}
+		// Rows are sorted by series name, so all data for a series is
+		// contiguous.
+		if n := len(result); n == 0 || result[n-1].Name != name {
+			result = append(result, TimeSeriesData{
+				Name:   name,
+				Source: source,
+			})
+		}
+		series := &result[len(result)-1]
+		for _, sample := range data.Samples {
+			timestamp := data.StartTimestampNanos + int64(sample.Offset)*data.SampleDurationNanos
+			if timestamp < startNanos || timestamp > endNanos {
+				continue
+			}
+			series.Datapoints = append(series.Datapoints, &TimeSeriesDatapoint{
+				TimestampNanos: timestamp,
+				Value:          sample.Average(),
+			})
+		}
+	}
+	return result, nil
+}
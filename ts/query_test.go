@@ -206,6 +206,37 @@ func TestSumRateInterpolation(t *testing.T) {
 	}
 }
 
+// TestMovingAverage verifies the smoothed values of the MOVING_AVG aggregator,
+// including windows which extend before the first datapoint.
+func TestMovingAverage(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		window   int
+		expected []float64
+	}{
+		{1, []float64{3, 6, 9, 12, 30}},
+		{3, []float64{3, 4.5, 6, 9, 17}},
+		{10, []float64{3, 4.5, 6, 7.5, 12}},
+	}
+	for i, tc := range testCases {
+		var datapoints []*TimeSeriesDatapoint
+		for j, value := range []float64{3, 6, 9, 12, 30} {
+			datapoints = append(datapoints, datapoint(int64(j*10), value))
+		}
+		movingAverage(datapoints, tc.window)
+		actual := make([]float64, 0, len(datapoints))
+		for j, dp := range datapoints {
+			if dp.TimestampNanos != int64(j*10) {
+				t.Errorf("%d: expected timestamp %d, got %d", i, j*10, dp.TimestampNanos)
+			}
+			actual = append(actual, dp.Value)
+		}
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%d: smoothed values: %v, expected values: %v", i, actual, tc.expected)
+		}
+	}
+}
+
 // assertQuery generates a query result from the local test model and compares
 // it against the query returned from the server.
 func (tm *testModel) assertQuery(name string, sources []string, agg *TimeSeriesQueryAggregator,
@@ -426,3 +457,81 @@ func TestQuery(t *testing.T) {
 	tm.assertModelCorrect()
 	tm.assertQuery("test.specificmetric", []string{"source2", "source4", "source6"}, nil, resolution1ns, 0, 90, 7, 2)
 }
+
+// TestExportSource verifies that exporting a source returns every series
+// collected from that source within the time span, and nothing from other
+// sources.
+func TestExportSource(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tm := newTestModel(t)
+	tm.Start()
+	defer tm.Stop()
+
+	tm.storeTimeSeriesData(resolution1ns, []TimeSeriesData{
+		{
+			Name:   "test.metric1",
+			Source: "source1",
+			Datapoints: []*TimeSeriesDatapoint{
+				datapoint(1, 100),
+				datapoint(15, 300),
+				datapoint(52, 900),
+			},
+		},
+		{
+			Name:   "test.metric1",
+			Source: "source2",
+			Datapoints: []*TimeSeriesDatapoint{
+				datapoint(5, 9999),
+				datapoint(16, 9999),
+			},
+		},
+		{
+			Name:   "test.metric2",
+			Source: "source1",
+			Datapoints: []*TimeSeriesDatapoint{
+				datapoint(2, 10),
+				datapoint(22, 20),
+			},
+		},
+		{
+			Name:   "test.metric3",
+			Source: "source2",
+			Datapoints: []*TimeSeriesDatapoint{
+				datapoint(3, 9999),
+			},
+		},
+	})
+	tm.assertModelCorrect()
+
+	actual, err := tm.DB.ExportSource("source1", resolution1ns, 0, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []TimeSeriesData{
+		{
+			Name:   "test.metric1",
+			Source: "source1",
+			Datapoints: []*TimeSeriesDatapoint{
+				datapoint(1, 100),
+				datapoint(15, 300),
+			},
+		},
+		{
+			Name:   "test.metric2",
+			Source: "source1",
+			Datapoints: []*TimeSeriesDatapoint{
+				datapoint(2, 10),
+				datapoint(22, 20),
+			},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("actual export: %v, expected: %v", actual, expected)
+	}
+
+	if actual, err := tm.DB.ExportSource("source3", resolution1ns, 0, 90); err != nil {
+		t.Fatal(err)
+	} else if len(actual) != 0 {
+		t.Errorf("expected no data for unknown source, got %v", actual)
+	}
+}
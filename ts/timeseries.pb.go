@@ -10,6 +10,7 @@
 
 	It has these top-level messages:
 		TimeSeriesDatapoint
+		TimeSeriesLabel
 		TimeSeriesData
 		TimeSeriesQueryRequest
 		TimeSeriesQueryResponse
@@ -45,15 +46,22 @@ const (
 	// duration.  This is computed via linear regression with the previous sample
 	// period's average value.
 	TimeSeriesQueryAggregator_AVG_RATE TimeSeriesQueryAggregator = 2
+	// MOVING_AVG returns the average value of points within the sample period,
+	// smoothed by averaging it with the values of the preceding sample periods
+	// in the query's window. Fewer values are averaged where the window extends
+	// before the first returned sample period.
+	TimeSeriesQueryAggregator_MOVING_AVG TimeSeriesQueryAggregator = 3
 )
 
 var TimeSeriesQueryAggregator_name = map[int32]string{
 	1: "AVG",
 	2: "AVG_RATE",
+	3: "MOVING_AVG",
 }
 var TimeSeriesQueryAggregator_value = map[string]int32{
-	"AVG":      1,
-	"AVG_RATE": 2,
+	"AVG":        1,
+	"AVG_RATE":   2,
+	"MOVING_AVG": 3,
 }
 
 func (x TimeSeriesQueryAggregator) Enum() *TimeSeriesQueryAggregator {
@@ -87,6 +95,17 @@ func (m *TimeSeriesDatapoint) Reset()         { *m = TimeSeriesDatapoint{} }
 func (m *TimeSeriesDatapoint) String() string { return proto.CompactTextString(m) }
 func (*TimeSeriesDatapoint) ProtoMessage()    {}
 
+// TimeSeriesLabel is a name/value pair which describes the source of a time
+// series, for example the device backing a store.
+type TimeSeriesLabel struct {
+	Name  string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value"`
+}
+
+func (m *TimeSeriesLabel) Reset()         { *m = TimeSeriesLabel{} }
+func (m *TimeSeriesLabel) String() string { return proto.CompactTextString(m) }
+func (*TimeSeriesLabel) ProtoMessage()    {}
+
 // TimeSeriesData is a set of measurements of a single named variable at
 // multiple points in time. This message contains a name and a source which, in
 // combination, uniquely identify the time series being measured. Measurement
@@ -99,6 +118,9 @@ type TimeSeriesData struct {
 	Source string `protobuf:"bytes,2,opt,name=source" json:"source"`
 	// Datapoints representing one or more measurements taken from the variable.
 	Datapoints []*TimeSeriesDatapoint `protobuf:"bytes,3,rep,name=datapoints" json:"datapoints,omitempty"`
+	// Labels describing the source, attached by the recorder. They are not
+	// part of the identity of the time series and aren't persisted.
+	Labels []TimeSeriesLabel `protobuf:"bytes,4,rep,name=labels" json:"labels"`
 }
 
 func (m *TimeSeriesData) Reset()         { *m = TimeSeriesData{} }
@@ -133,6 +155,9 @@ type TimeSeriesQueryRequest_Query struct {
 	// An optional list of sources to restrict the time series query. If no
 	// sources are provided, all sources will be queried.
 	Sources []string `protobuf:"bytes,3,rep,name=sources" json:"sources,omitempty"`
+	// The number of sample periods averaged by the MOVING_AVG aggregator,
+	// including the current one. If zero, a default window is used.
+	Window int32 `protobuf:"varint,4,opt,name=window" json:"window"`
 }
 
 func (m *TimeSeriesQueryRequest_Query) Reset()         { *m = TimeSeriesQueryRequest_Query{} }
@@ -162,6 +187,13 @@ func (m *TimeSeriesQueryRequest_Query) GetSources() []string {
 	return nil
 }
 
+func (m *TimeSeriesQueryRequest_Query) GetWindow() int32 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 // TimeSeriesQueryResponse is the standard response for time series queries
 // returned to cockroach clients.
 type TimeSeriesQueryResponse struct {
@@ -224,6 +256,7 @@ func (m *TimeSeriesQueryResponse_Result) GetDatapoints() []*TimeSeriesDatapoint
 
 func init() {
 	proto.RegisterType((*TimeSeriesDatapoint)(nil), "cockroach.ts.TimeSeriesDatapoint")
+	proto.RegisterType((*TimeSeriesLabel)(nil), "cockroach.ts.TimeSeriesLabel")
 	proto.RegisterType((*TimeSeriesData)(nil), "cockroach.ts.TimeSeriesData")
 	proto.RegisterType((*TimeSeriesQueryRequest)(nil), "cockroach.ts.TimeSeriesQueryRequest")
 	proto.RegisterType((*TimeSeriesQueryRequest_Query)(nil), "cockroach.ts.TimeSeriesQueryRequest.Query")
@@ -255,6 +288,32 @@ func (m *TimeSeriesDatapoint) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TimeSeriesLabel) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TimeSeriesLabel) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintTimeseries(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintTimeseries(data, i, uint64(len(m.Value)))
+	i += copy(data[i:], m.Value)
+	return i, nil
+}
+
 func (m *TimeSeriesData) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -290,6 +349,18 @@ func (m *TimeSeriesData) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			data[i] = 0x22
+			i++
+			i = encodeVarintTimeseries(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -368,6 +439,9 @@ func (m *TimeSeriesQueryRequest_Query) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
+	data[i] = 0x20
+	i++
+	i = encodeVarintTimeseries(data, i, uint64(m.Window))
 	return i, nil
 }
 
@@ -490,6 +564,16 @@ func (m *TimeSeriesDatapoint) Size() (n int) {
 	return n
 }
 
+func (m *TimeSeriesLabel) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovTimeseries(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovTimeseries(uint64(l))
+	return n
+}
+
 func (m *TimeSeriesData) Size() (n int) {
 	var l int
 	_ = l
@@ -503,6 +587,12 @@ func (m *TimeSeriesData) Size() (n int) {
 			n += 1 + l + sovTimeseries(uint64(l))
 		}
 	}
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTimeseries(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -534,6 +624,7 @@ func (m *TimeSeriesQueryRequest_Query) Size() (n int) {
 			n += 1 + l + sovTimeseries(uint64(l))
 		}
 	}
+	n += 1 + sovTimeseries(uint64(m.Window))
 	return n
 }
 
@@ -672,6 +763,114 @@ func (m *TimeSeriesDatapoint) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *TimeSeriesLabel) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimeseries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeSeriesLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeSeriesLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeseries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeseries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeseries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeseries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimeseries(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTimeseries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TimeSeriesData) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -790,6 +989,37 @@ func (m *TimeSeriesData) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeseries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimeseries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, TimeSeriesLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTimeseries(data[iNdEx:])
@@ -1037,6 +1267,25 @@ func (m *TimeSeriesQueryRequest_Query) Unmarshal(data []byte) error {
 			}
 			m.Sources = append(m.Sources, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeseries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Window |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTimeseries(data[iNdEx:])
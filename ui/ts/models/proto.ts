@@ -199,6 +199,7 @@ module Models {
     export enum QueryAggregator {
       AVG = 1,
       AVG_RATE = 2,
+      MOVING_AVG = 3,
     }
 
     /**
@@ -26,20 +26,40 @@ var (
 	buildDeps string // Git SHAs of dependencies
 )
 
+const (
+	// binaryVersion is the version of the on-disk and wire formats used by
+	// this binary. It must be incremented whenever either changes in a way
+	// that older binaries can't handle.
+	binaryVersion = 1
+	// minimumSupportedVersion is the oldest cluster version this binary is
+	// able to join.
+	minimumSupportedVersion = 1
+)
+
 // BuildInfo ...
 type BuildInfo struct {
 	Vers string `json:"goVersion"`
 	Tag  string `json:"tag"`
 	Time string `json:"time"`
 	Deps string `json:"dependencies"`
+	// Version is the version of the on-disk and wire formats.
+	Version int32 `json:"version"`
+	// MinimumSupportedVersion is the oldest cluster version supported.
+	MinimumSupportedVersion int32 `json:"minimumSupportedVersion"`
 }
 
-// GetBuildInfo ...
-func GetBuildInfo() BuildInfo {
+// GetBuildInfo returns the build information of this binary. It is a variable
+// so that tests can simulate binaries of other versions.
+var GetBuildInfo = getBuildInfo
+
+func getBuildInfo() BuildInfo {
 	return BuildInfo{
 		Vers: runtime.Version(),
 		Tag:  buildTag,
 		Time: buildTime,
 		Deps: buildDeps,
+
+		Version:                 binaryVersion,
+		MinimumSupportedVersion: minimumSupportedVersion,
 	}
 }
@@ -23,8 +23,8 @@ import (
 	"fmt"
 	"sync/atomic"
 
-	"github.com/biogo/store/interval"
 	"github.com/biogo/store/llrb"
+	"github.com/cockroachdb/cockroach/util/interval"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
@@ -60,16 +60,47 @@ type Config struct {
 	//   }
 	ShouldEvict func(size int, key, value interface{}) bool
 
+	// MaxBytes, if positive, is the budget for the total size in bytes of
+	// the entries. Each time an entry is added or its value replaced,
+	// entries are evicted according to the policy until the total size is
+	// within the budget, in addition to those ShouldEvict selects. This
+	// bounds the memory used by caches whose entries vary in size better
+	// than a maximum number of entries.
+	MaxBytes int64
+
+	// SizeOf optionally specifies a callback function computing the size
+	// in bytes of an entry. If nil, the size of an entry is that of its
+	// value if it implements Sizer, and zero otherwise.
+	SizeOf func(key, value interface{}) int64
+
 	// OnEvicted optionally specifies a callback function to be
 	// executed when an entry is purged from the cache.
 	OnEvicted func(key, value interface{})
 }
 
+// A Sizer is a cache value which knows its size in bytes, such as a
+// protocol buffer message.
+type Sizer interface {
+	Size() int
+}
+
+// Stats holds the statistics of a cache.
+type Stats struct {
+	// Bytes is the total size in bytes of the entries.
+	Bytes int64
+	// Entries is the number of entries.
+	Entries int
+	// Evictions is the number of entries evicted according to the policy,
+	// excluding those removed by Del or Clear.
+	Evictions int64
+}
+
 // entry holds the key and value and a pointer to the linked list
 // which defines the eviction ordering.
 type entry struct {
 	key, value interface{}
 	le         *list.Element
+	size       int64
 }
 
 func (e entry) String() string {
@@ -95,13 +126,6 @@ func (e *entry) Start() interval.Comparable {
 func (e *entry) End() interval.Comparable {
 	return e.key.(*IntervalKey).End()
 }
-func (e *entry) NewMutable() interval.Mutable {
-	ik := e.key.(*IntervalKey)
-	return &IntervalKey{id: 0, start: ik.Start(), end: ik.End()}
-}
-func (e *entry) Overlap(r interval.Range) bool {
-	return e.key.(*IntervalKey).Overlap(r)
-}
 
 // cacheStore is an interface for the backing store used for the cache.
 type cacheStore interface {
@@ -121,8 +145,10 @@ type cacheStore interface {
 // list for eviction order.
 type baseCache struct {
 	Config
-	store cacheStore
-	ll    *list.List
+	store     cacheStore
+	ll        *list.List
+	bytes     int64 // total size of the entries
+	evictions int64
 }
 
 func newBaseCache(config Config) *baseCache {
@@ -137,13 +163,20 @@ func (bc *baseCache) Add(key, value interface{}) {
 	if e := bc.store.get(key); e != nil {
 		bc.access(e)
 		e.value = value
+		bc.bytes -= e.size
+		e.size = bc.sizeOf(key, value)
+		bc.bytes += e.size
+		// The new value may exceed the budget.
+		for bc.overBudget() && bc.evict() {
+		}
 		return
 	}
-	e := &entry{key: key, value: value}
+	e := &entry{key: key, value: value, size: bc.sizeOf(key, value)}
 	if bc.Policy != CacheNone {
 		e.le = bc.ll.PushFront(e)
 	}
 	bc.store.add(e)
+	bc.bytes += e.size
 	// Evict as many elements as we can.
 	for bc.evict() {
 	}
@@ -175,6 +208,32 @@ func (bc *baseCache) Len() int {
 	return bc.store.length()
 }
 
+// Stats returns the statistics of the cache.
+func (bc *baseCache) Stats() Stats {
+	return Stats{
+		Bytes:     bc.bytes,
+		Entries:   bc.store.length(),
+		Evictions: bc.evictions,
+	}
+}
+
+// sizeOf returns the size in bytes of an entry.
+func (bc *baseCache) sizeOf(key, value interface{}) int64 {
+	if bc.SizeOf != nil {
+		return bc.SizeOf(key, value)
+	}
+	if s, ok := value.(Sizer); ok {
+		return int64(s.Size())
+	}
+	return 0
+}
+
+// overBudget returns whether the total size of the entries exceeds the
+// budget.
+func (bc *baseCache) overBudget() bool {
+	return bc.MaxBytes > 0 && bc.bytes > bc.MaxBytes
+}
+
 func (bc *baseCache) access(e *entry) {
 	if bc.Policy == CacheLRU {
 		bc.ll.MoveToFront(e.le)
@@ -186,23 +245,26 @@ func (bc *baseCache) removeElement(e *entry) {
 		bc.ll.Remove(e.le)
 	}
 	bc.store.del(e.key)
+	bc.bytes -= e.size
 	if bc.OnEvicted != nil {
 		bc.OnEvicted(e.key, e.value)
 	}
 }
 
 // evict removes the oldest item from the cache for FIFO and
-// the least recently used item for LRU. Returns true if an
-// entry was evicted, false otherwise.
+// the least recently used item for LRU, if the cache is over its
+// budget or ShouldEvict selects it. Returns true if an entry was
+// evicted, false otherwise.
 func (bc *baseCache) evict() bool {
-	if bc.ShouldEvict == nil || bc.Policy == CacheNone {
+	if bc.Policy == CacheNone {
 		return false
 	}
 	l := bc.store.length()
 	if l > 0 {
 		ele := bc.ll.Back()
 		e := ele.Value.(*entry)
-		if bc.ShouldEvict(l, e.key, e.value) {
+		if bc.overBudget() || (bc.ShouldEvict != nil && bc.ShouldEvict(l, e.key, e.value)) {
+			bc.evictions++
 			bc.removeElement(e)
 			return true
 		}
@@ -347,7 +409,7 @@ func (oc *OrderedCache) DoRange(f func(k, v interface{}), from, to interface{})
 // IntervalCache is not safe for concurrent access.
 type IntervalCache struct {
 	*baseCache
-	tree *interval.Tree
+	tree interval.Tree
 }
 
 // IntervalKey provides uniqueness as well as key interval.
@@ -358,7 +420,7 @@ type IntervalKey struct {
 
 var intervalAlloc int64
 
-// Implementation of the interval.Range & interval.Mutable interfaces.
+// Implementation of the interval.Range interface.
 
 // Start .
 func (ik *IntervalKey) Start() interval.Comparable { return ik.start }
@@ -392,7 +454,6 @@ func (ik *IntervalKey) Contains(lk *IntervalKey) bool {
 func NewIntervalCache(config Config) *IntervalCache {
 	ic := &IntervalCache{
 		baseCache: newBaseCache(config),
-		tree:      &interval.Tree{},
 	}
 	ic.baseCache.store = ic
 	return ic
@@ -409,32 +470,35 @@ func (ic *IntervalCache) NewKey(start, end interval.Comparable) *IntervalKey {
 // Implementation of cacheStore interface.
 func (ic *IntervalCache) get(key interface{}) *entry {
 	ik := key.(*IntervalKey)
-	if es := ic.tree.Get(ik); len(es) > 0 {
-		// Search interval slice for any exact match on ID and return it.
-		for _, e := range es {
-			e := e.(*entry)
-			if e.ID() == ik.id {
-				return e
-			}
+	var found *entry
+	// Search the overlapping intervals for an exact match on ID.
+	ic.tree.DoMatching(func(e interval.Interface) (done bool) {
+		if e.ID() == ik.id {
+			found = e.(*entry)
+			return true
 		}
-	}
-	return nil
+		return false
+	}, ik)
+	return found
 }
 func (ic *IntervalCache) add(e *entry) {
-	if err := ic.tree.Insert(e, false); err != nil {
+	if err := ic.tree.Insert(e); err != nil {
 		log.Error(err)
 	}
 }
 func (ic *IntervalCache) del(key interface{}) {
-	if err := ic.tree.Delete(&entry{key: key}, false); err != nil {
-		log.Error(err)
-	}
+	ic.tree.Delete(&entry{key: key})
 }
 func (ic *IntervalCache) clear() {
+	// The tree can't be modified while it is traversed.
+	var keys []interface{}
 	ic.tree.Do(func(e interval.Interface) (done bool) {
-		ic.Del(e.(*entry).key.(*IntervalKey))
+		keys = append(keys, e.(*entry).key)
 		return
 	})
+	for _, key := range keys {
+		ic.Del(key)
+	}
 }
 func (ic *IntervalCache) length() int {
 	return ic.tree.Len()
@@ -449,14 +513,13 @@ type Overlap struct {
 // GetOverlaps returns a slice of values which overlap the specified
 // interval.
 func (ic *IntervalCache) GetOverlaps(start, end interval.Comparable) []Overlap {
-	es := ic.tree.Get(ic.NewKey(start, end))
-	values := make([]Overlap, len(es))
-	for i := range es {
-		e := es[i].(*entry)
+	var values []Overlap
+	ic.tree.DoMatching(func(i interval.Interface) (done bool) {
+		e := i.(*entry)
 		ic.access(e) // maintain cache eviction ordering
-		values[i].Key = e.key.(*IntervalKey)
-		values[i].Value = e.value
-	}
+		values = append(values, Overlap{Key: e.key.(*IntervalKey), Value: e.value})
+		return
+	}, &IntervalKey{start: start, end: end})
 	return values
 }
 
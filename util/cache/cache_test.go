@@ -23,9 +23,9 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/biogo/store/interval"
 	"github.com/biogo/store/llrb"
 
+	"github.com/cockroachdb/cockroach/util/interval"
 	_ "github.com/cockroachdb/cockroach/util/log" // for flags
 )
 
@@ -297,3 +297,104 @@ func TestIntervalCacheClear(t *testing.T) {
 		t.Error("expected reinsert to succeed")
 	}
 }
+
+// sizedValue is a cache value whose size is its length.
+type sizedValue string
+
+// Size implements the Sizer interface.
+func (sv sizedValue) Size() int {
+	return len(sv)
+}
+
+// TestCacheMaxBytes verifies that all kinds of caches evict entries of
+// mixed sizes in LRU order until they are within their byte budget.
+func TestCacheMaxBytes(t *testing.T) {
+	config := Config{Policy: CacheLRU, MaxBytes: 10}
+	ic := NewIntervalCache(config)
+	intervalKeys := map[string]*IntervalKey{}
+	testCases := []struct {
+		name  string
+		cache interface {
+			Add(key, value interface{})
+			Get(key interface{}) (interface{}, bool)
+			Del(key interface{})
+			Stats() Stats
+		}
+		key func(string) interface{}
+	}{
+		{"unordered", NewUnorderedCache(config), func(k string) interface{} { return testKey(k) }},
+		{"ordered", NewOrderedCache(config), func(k string) interface{} { return testKey(k) }},
+		{"interval", ic, func(k string) interface{} {
+			if _, ok := intervalKeys[k]; !ok {
+				intervalKeys[k] = ic.NewKey(rangeKey(k), rangeKey(k+"\x00"))
+			}
+			return intervalKeys[k]
+		}},
+	}
+	for _, c := range testCases {
+		// expect verifies the cached keys and the statistics.
+		expect := func(step string, bytes int64, evictions int64, keys ...string) {
+			if s := c.cache.Stats(); s.Bytes != bytes || s.Entries != len(keys) || s.Evictions != evictions {
+				t.Errorf("%s: %s: expected %d bytes, %d entries and %d evictions, got %+v",
+					c.name, step, bytes, len(keys), evictions, s)
+			}
+			for _, k := range keys {
+				if _, ok := c.cache.Get(c.key(k)); !ok {
+					t.Errorf("%s: %s: expected key %q to be cached", c.name, step, k)
+				}
+			}
+		}
+
+		c.cache.Add(c.key("a"), sizedValue("aaaa"))
+		c.cache.Add(c.key("b"), sizedValue("bbb"))
+		c.cache.Add(c.key("c"), sizedValue("cc"))
+		expect("within budget", 9, 0, "a", "b", "c")
+
+		// Make "a" the most recently used entry; adding "d" evicts "b" and
+		// "c" to get back within budget.
+		if _, ok := c.cache.Get(c.key("a")); !ok {
+			t.Fatalf("%s: failed to get key a", c.name)
+		}
+		c.cache.Add(c.key("d"), sizedValue("ddddd"))
+		expect("added d", 9, 2, "a", "d")
+
+		// Replacing values adjusts the total size, and growing "d" evicts
+		// "a".
+		c.cache.Add(c.key("a"), sizedValue("a"))
+		expect("shrunk a", 6, 2, "a", "d")
+		c.cache.Add(c.key("a"), sizedValue("a"))
+		c.cache.Add(c.key("d"), sizedValue("dddddddddd"))
+		expect("grew d", 10, 3, "d")
+
+		// Entries larger than the budget are evicted right away.
+		c.cache.Add(c.key("e"), sizedValue("eeeeeeeeeee"))
+		expect("added e", 0, 5)
+
+		// Deletions are not evictions.
+		c.cache.Add(c.key("f"), sizedValue("f"))
+		c.cache.Del(c.key("f"))
+		expect("deleted f", 0, 5)
+	}
+}
+
+func TestCacheSizeOf(t *testing.T) {
+	mc := NewUnorderedCache(Config{
+		Policy:   CacheFIFO,
+		MaxBytes: 5,
+		SizeOf: func(key, value interface{}) int64 {
+			return int64(len(key.(testKey)) + value.(int))
+		},
+	})
+	mc.Add(testKey("a"), 1)
+	mc.Add(testKey("bb"), 1)
+	if s := mc.Stats(); s.Bytes != 5 || s.Entries != 2 || s.Evictions != 0 {
+		t.Errorf("expected 5 bytes in 2 entries, got %+v", s)
+	}
+	mc.Add(testKey("c"), 0)
+	if _, ok := mc.Get(testKey("a")); ok {
+		t.Error("expected first key \"a\" to be evicted")
+	}
+	if s := mc.Stats(); s.Bytes != 4 || s.Entries != 2 || s.Evictions != 1 {
+		t.Errorf("expected 4 bytes in 2 entries after an eviction, got %+v", s)
+	}
+}
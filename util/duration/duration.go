@@ -0,0 +1,112 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package duration
+
+import (
+	"math"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+const (
+	nanosPerDay = 24 * int64(time.Hour)
+	// nanosPerMonth is the length of a month when ordering durations.
+	nanosPerMonth = 30 * nanosPerDay
+)
+
+// A Duration is a period of time made of months, days and nanoseconds, as
+// held by an interval. The length of a month or a day depends on the time
+// the duration is added to, so they are not converted to nanoseconds.
+//
+// Durations are ordered by their length, assuming that months are 30 days
+// long and days 24 hours long, and durations of the same length by their
+// months and then by their days, e.g. 30 days sort before 1 month, which
+// sorts before 30 days and 1 hour.
+type Duration struct {
+	Months int64
+	Days   int64
+	Nanos  int64
+}
+
+// Encode returns the normalized duration as its length in nanoseconds, which
+// orders durations, along with its months and days, from which Decode
+// recovers its nanoseconds. Normalizing moves the whole days of the
+// nanoseconds to the days, so that e.g. 24 hours and 1 day are encoded
+// identically. Days are not moved to months, as months vary in length. An
+// error is returned if the length overflows an int64.
+func (d Duration) Encode() (int64, int64, int64, error) {
+	days, ok := addInt64(d.Days, d.Nanos/nanosPerDay)
+	var sortNanos int64
+	if ok {
+		sortNanos, ok = length(d.Months, days, d.Nanos%nanosPerDay)
+	}
+	if !ok {
+		return 0, 0, 0, util.Errorf("duration %+v overflows", d)
+	}
+	return sortNanos, d.Months, days, nil
+}
+
+// Decode returns the normalized duration from the values returned by
+// Encode. Its nanoseconds are less than a day long. An error is returned if
+// the values can't have been returned by Encode.
+func Decode(sortNanos, months, days int64) (Duration, error) {
+	// The nanoseconds are what remains of the length without the months and
+	// the days.
+	n, ok := length(months, days, 0)
+	ok = ok && n != math.MinInt64
+	var nanos int64
+	if ok {
+		nanos, ok = addInt64(sortNanos, -n)
+	}
+	if !ok || nanos <= -nanosPerDay || nanos >= nanosPerDay {
+		return Duration{}, util.Errorf("malformed duration: length %d, %d months, %d days",
+			sortNanos, months, days)
+	}
+	return Duration{Months: months, Days: days, Nanos: nanos}, nil
+}
+
+// length returns the length in nanoseconds of the given months, days and
+// nanoseconds, and false if it overflows an int64.
+func length(months, days, nanos int64) (int64, bool) {
+	m, ok := mulInt64(months, nanosPerMonth)
+	if !ok {
+		return 0, false
+	}
+	d, ok := mulInt64(days, nanosPerDay)
+	if !ok {
+		return 0, false
+	}
+	n, ok := addInt64(m, d)
+	if !ok {
+		return 0, false
+	}
+	return addInt64(n, nanos)
+}
+
+// addInt64 returns a+b, and false if the sum overflows.
+func addInt64(a, b int64) (int64, bool) {
+	c := a + b
+	return c, (c > a) == (b > 0)
+}
+
+// mulInt64 returns a*k for a positive k, and false if the product
+// overflows.
+func mulInt64(a, k int64) (int64, bool) {
+	if a > math.MaxInt64/k || a < math.MinInt64/k {
+		return 0, false
+	}
+	return a * k, true
+}
@@ -0,0 +1,196 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// EncodeDecimal returns the resulting byte slice with the encoded decimal
+// unscaled*10^-scale appended to b. Decimals share the encoding of floats
+// (see EncodeFloat), which preserves the numeric order across exponents and
+// signs, so that a decimal and a float of the same value are encoded
+// identically. Trailing zeros don't affect the encoding: 1.5 and 1.50 are
+// encoded identically as well.
+func EncodeDecimal(b []byte, unscaled *big.Int, scale int32) []byte {
+	if unscaled.Sign() == 0 {
+		return append(b, floatZero)
+	}
+	e, m := decimalMandE(unscaled, scale)
+	return encodeMandE(b, unscaled.Sign() < 0, e, m)
+}
+
+// EncodeDecimalDecreasing returns the resulting byte slice with the encoded
+// decimal unscaled*10^-scale appended to b, such that it sorts in reverse
+// order. The decimal is encoded by EncodeDecimal as its negation.
+func EncodeDecimalDecreasing(b []byte, unscaled *big.Int, scale int32) []byte {
+	return EncodeDecimal(b, new(big.Int).Neg(unscaled), scale)
+}
+
+// DecodeDecimal returns the remaining byte slice after decoding and the
+// decoded decimal from buf, as its unscaled value and its scale. The scale
+// is the smallest non-negative one which represents the decimal exactly,
+// i.e. the unscaled value has no trailing zeros unless the scale is zero.
+func DecodeDecimal(buf []byte) ([]byte, *big.Int, int32, error) {
+	if len(buf) == 0 {
+		return nil, nil, 0, util.Errorf("insufficient bytes to decode decimal value")
+	}
+	if buf[0] == floatZero {
+		return buf[1:], new(big.Int), 0, nil
+	}
+	negative, e, m, b, err := decodeMandE(buf)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	unscaled, scale, err := makeDecimalFromMandE(negative, e, m)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return b, unscaled, scale, nil
+}
+
+// DecodeDecimalDecreasing returns the remaining byte slice after decoding
+// and the decoded decimal from buf, which was encoded using
+// EncodeDecimalDecreasing. See DecodeDecimal for details.
+func DecodeDecimalDecreasing(buf []byte) ([]byte, *big.Int, int32, error) {
+	b, unscaled, scale, err := DecodeDecimal(buf)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return b, unscaled.Neg(unscaled), scale, nil
+}
+
+// decimalMandE computes and returns the mantissa M and exponent E of the
+// non-zero decimal unscaled*10^-scale. See floatMandE for details.
+func decimalMandE(unscaled *big.Int, scale int32) (int, []byte) {
+	digits := []byte(new(big.Int).Abs(unscaled).String())
+	// The exponent of 0.ddddd; trailing zeros don't change it.
+	e10 := len(digits) - int(scale)
+	digits = bytes.TrimRight(digits, "0")
+
+	b := make([]byte, 0, len(digits)+2)
+	b = append(b, '0')
+	b = append(b, digits...)
+	return mandEFromDigits(b, e10)
+}
+
+// makeDecimalFromMandE reconstructs the decimal from the mantissa M and
+// exponent E, returning its unscaled value and its scale.
+func makeDecimalFromMandE(negative bool, e int, m []byte) (*big.Int, int32, error) {
+	digits := make([]byte, 0, 2*len(m))
+	for _, v := range m {
+		// Every byte is 2n+1, except for the last one, which is 2n+0.
+		t := int(v) / 2
+		digits = append(digits, byte(t/10)+'0', byte(t%10)+'0')
+	}
+	scale := int64(len(digits)) - 2*int64(e)
+	for scale > 0 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+		scale--
+	}
+	if scale < math.MinInt32 || scale > math.MaxInt32 {
+		return nil, 0, util.Errorf("decimal exponent out of range: %d", e)
+	}
+	for ; scale < 0; scale++ {
+		digits = append(digits, '0')
+	}
+	unscaled, ok := new(big.Int).SetString(string(digits), 10)
+	if !ok {
+		return nil, 0, util.Errorf("malformed decimal mantissa: [% x]", m)
+	}
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return unscaled, int32(scale), nil
+}
+
+// decodeMandE decodes the sign, the exponent E and the mantissa M of the
+// finite, non-zero number at the start of buf, and returns them along with
+// the remainder of buf. Unlike DecodeFloat, it doesn't rely on the exponent
+// fitting a single varint byte, which holds for floats but not for decimals.
+func decodeMandE(buf []byte) (bool, int, []byte, []byte, error) {
+	var negative bool
+	var e int
+	b := buf[1:]
+	switch tag := buf[0]; {
+	case tag == floatNegLarge, tag == floatPosLarge:
+		// The exponent of negative large numbers is complemented.
+		negative = tag == floatNegLarge
+		x, n, err := decodeExponent(b, negative)
+		if err != nil {
+			return false, 0, nil, nil, err
+		}
+		e, b = int(x), b[n:]
+	case tag > floatNegLarge && tag <= floatNegMedium:
+		negative = true
+		e = floatNegMedium - int(tag)
+	case tag >= floatPosMedium && tag < floatPosLarge:
+		e = int(tag) - floatPosMedium
+	case tag == floatNegSmall, tag == floatPosSmall:
+		// The exponent of positive small numbers is complemented.
+		negative = tag == floatNegSmall
+		x, n, err := decodeExponent(b, !negative)
+		if err != nil {
+			return false, 0, nil, nil, err
+		}
+		e, b = -int(x), b[n:]
+	default:
+		return false, 0, nil, nil, util.Errorf("unknown prefix of the encoded byte slice: %q", buf)
+	}
+	// The mantissa never contains the terminator, even when complemented.
+	i := bytes.IndexByte(b, floatTerminator)
+	if i <= 0 {
+		return false, 0, nil, nil, util.Errorf("did not find terminator after mantissa: %q", buf)
+	}
+	m := append([]byte(nil), b[:i]...)
+	if negative {
+		onesComplement(m)
+	}
+	return negative, e, m, b[i+1:], nil
+}
+
+// decodeExponent decodes the varint exponent at the start of b, which is
+// complemented if complement is true, and returns it along with the length
+// of its encoding.
+func decodeExponent(b []byte, complement bool) (uint64, int, error) {
+	if len(b) == 0 {
+		return 0, 0, util.Errorf("insufficient bytes to decode exponent")
+	}
+	var tmp [maxVarintSize]byte
+	n := copy(tmp[:], b)
+	if complement {
+		onesComplement(tmp[:n])
+	}
+	// The length of a varint is determined by its first byte.
+	l := 1
+	switch {
+	case tmp[0] >= 241 && tmp[0] <= 248:
+		l = 2
+	case tmp[0] >= 249:
+		l = int(tmp[0]) - 246
+	}
+	if l > n {
+		return 0, 0, util.Errorf("insufficient bytes to decode exponent: %q", b)
+	}
+	x, _ := getUvarint(tmp[:l])
+	if x > math.MaxInt32 {
+		return 0, 0, util.Errorf("exponent out of range: %d", x)
+	}
+	return x, l, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/randutil"
+)
+
+// decimalRat returns the decimal unscaled*10^-scale as a rational.
+func decimalRat(unscaled *big.Int, scale int32) *big.Rat {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs32(scale))), nil)
+	if scale >= 0 {
+		return new(big.Rat).SetFrac(unscaled, pow)
+	}
+	return new(big.Rat).SetInt(new(big.Int).Mul(unscaled, pow))
+}
+
+func abs32(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestEncodeDecimal(t *testing.T) {
+	testCases := []struct {
+		unscaled int64
+		scale    int32
+		// The float with the same value, whose encoding must be identical.
+		float float64
+		// The expected result of decoding.
+		decUnscaled int64
+		decScale    int32
+	}{
+		{-99990000, 2, -999900, -999900, 0},
+		{-12345, 0, -12345, -12345, 0},
+		{-1, -2, -100, -100, 0},
+		{-10, 1, -1, -1, 0},
+		{-123, 5, -0.00123, -123, 5},
+		{0, 3, 0, 0, 0},
+		{123, 5, 0.00123, 123, 5},
+		{1, 0, 1, 1, 0},
+		{15, 1, 1.5, 15, 1},
+		{150, 2, 1.5, 15, 1},
+		{10, 0, 10, 10, 0},
+		{1, -1, 10, 10, 0},
+		{99, 0, 99, 99, 0},
+		{9901, 2, 99.01, 9901, 2},
+		{100, 0, 100, 100, 0},
+		{12345, 0, 12345, 12345, 0},
+		{123450, 1, 12345, 12345, 0},
+		{9223372036854775807, 0, 0, 9223372036854775807, 0},
+	}
+	var last []byte
+	for i, c := range testCases {
+		enc := EncodeDecimal(nil, big.NewInt(c.unscaled), c.scale)
+		if c.float != 0 || c.unscaled == 0 {
+			if e := EncodeFloat(nil, c.float); !bytes.Equal(enc, e) {
+				t.Errorf("%d: expected encoding [% x] of %v, got [% x]", i, e, c.float, enc)
+			}
+		}
+		if last != nil && bytes.Compare(last, enc) > 0 {
+			t.Errorf("%d: expected [% x] to sort before [% x]", i, last, enc)
+		}
+		last = enc
+
+		rem, unscaled, scale, err := DecodeDecimal(append(enc, "rest"...))
+		if err != nil {
+			t.Errorf("%d: %s", i, err)
+			continue
+		}
+		if string(rem) != "rest" {
+			t.Errorf("%d: expected remainder \"rest\", got %q", i, rem)
+		}
+		if unscaled.Cmp(big.NewInt(c.decUnscaled)) != 0 || scale != c.decScale {
+			t.Errorf("%d: expected %de-%d, got %se-%d", i, c.decUnscaled, c.decScale, unscaled, scale)
+		}
+	}
+}
+
+func TestDecodeDecimalInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		enc  []byte
+	}{
+		{"empty", nil},
+		{"unknown prefix", EncodeNull(nil)},
+		{"missing terminator", EncodeDecimal(nil, big.NewInt(15), 1)[:2]},
+		{"missing exponent", []byte{floatPosLarge}},
+		{"truncated exponent", []byte{floatPosLarge, 255, 1}},
+		{"exponent out of range", EncodeUvarint([]byte{floatPosLarge}, 1<<40)},
+	}
+	for _, c := range testCases {
+		if _, _, _, err := DecodeDecimal(c.enc); err == nil {
+			t.Errorf("%s: expected error decoding [% x]", c.name, c.enc)
+		}
+	}
+}
+
+// randDecimal returns a random decimal of up to 40 digits, whose scale is
+// mostly small but sometimes requires a multi-byte exponent.
+func randDecimal(rng *rand.Rand) (*big.Int, int32) {
+	unscaled := new(big.Int).Rand(rng, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(rng.Intn(40)+1)), nil))
+	if rng.Intn(2) == 0 {
+		unscaled.Neg(unscaled)
+	}
+	scale := int32(rng.Intn(40) - 20)
+	if rng.Intn(10) == 0 {
+		scale = int32(rng.Intn(20000) - 10000)
+	}
+	return unscaled, scale
+}
+
+// TestDecimalOrderRandomized verifies that the encodings of random decimals
+// sort like the decimals, or in reverse for the decreasing encoding, and
+// that they round-trip.
+func TestDecimalOrderRandomized(t *testing.T) {
+	rng, _ := randutil.NewPseudoRand()
+	for i := 0; i < 10000; i++ {
+		unscaledA, scaleA := randDecimal(rng)
+		unscaledB, scaleB := randDecimal(rng)
+		if rng.Intn(10) == 0 {
+			// Compare values close to each other.
+			unscaledB, scaleB = new(big.Int).Add(unscaledA, big.NewInt(int64(rng.Intn(3)-1))), scaleA
+		}
+		a, b := decimalRat(unscaledA, scaleA), decimalRat(unscaledB, scaleB)
+
+		encA, encB := EncodeDecimal(nil, unscaledA, scaleA), EncodeDecimal(nil, unscaledB, scaleB)
+		if c, e := bytes.Compare(encA, encB), a.Cmp(b); c != e {
+			t.Fatalf("expected comparison of %s and %s to be %d, got %d for [% x] and [% x]",
+				a, b, e, c, encA, encB)
+		}
+		descA, descB := EncodeDecimalDecreasing(nil, unscaledA, scaleA), EncodeDecimalDecreasing(nil, unscaledB, scaleB)
+		if c, e := bytes.Compare(descA, descB), -a.Cmp(b); c != e {
+			t.Fatalf("expected decreasing comparison of %s and %s to be %d, got %d for [% x] and [% x]",
+				a, b, e, c, descA, descB)
+		}
+		for _, d := range []struct {
+			enc    []byte
+			decode func([]byte) ([]byte, *big.Int, int32, error)
+		}{
+			{encA, DecodeDecimal},
+			{descA, DecodeDecimalDecreasing},
+		} {
+			rem, unscaled, scale, err := d.decode(d.enc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dec := decimalRat(unscaled, scale); len(rem) != 0 || dec.Cmp(a) != 0 {
+				t.Fatalf("expected [% x] to decode to %s, got %s with remainder [% x]", d.enc, a, dec, rem)
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package encoding
+
+import (
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/duration"
+)
+
+// EncodeDuration encodes a duration, appends it to the supplied buffer and
+// returns the final buffer. The encoding preserves the order of the
+// durations (see duration.Duration). The duration is normalized, so that
+// e.g. 24 hours and 1 day are encoded identically. An error is returned if
+// the length of the duration overflows an int64 of nanoseconds.
+func EncodeDuration(b []byte, d duration.Duration) ([]byte, error) {
+	return encodeDuration(b, d, EncodeVarint)
+}
+
+// EncodeDurationDecreasing encodes a duration like EncodeDuration, except
+// that longer durations sort first.
+func EncodeDurationDecreasing(b []byte, d duration.Duration) ([]byte, error) {
+	return encodeDuration(b, d, EncodeVarintDecreasing)
+}
+
+func encodeDuration(b []byte, d duration.Duration,
+	encodeVarint func([]byte, int64) []byte) ([]byte, error) {
+	sortNanos, months, days, err := d.Encode()
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, durationMarker)
+	b = encodeVarint(b, sortNanos)
+	b = encodeVarint(b, months)
+	b = encodeVarint(b, days)
+	return b, nil
+}
+
+// DecodeDuration decodes a duration which was encoded using EncodeDuration.
+// The remainder of the input buffer and the normalized duration are
+// returned.
+func DecodeDuration(b []byte) ([]byte, duration.Duration, error) {
+	return decodeDuration(b, DecodeVarint)
+}
+
+// DecodeDurationDecreasing decodes a duration which was encoded using
+// EncodeDurationDecreasing. The remainder of the input buffer and the
+// normalized duration are returned.
+func DecodeDurationDecreasing(b []byte) ([]byte, duration.Duration, error) {
+	return decodeDuration(b, DecodeVarintDecreasing)
+}
+
+func decodeDuration(b []byte,
+	decodeVarint func([]byte) ([]byte, int64, error)) ([]byte, duration.Duration, error) {
+	if PeekType(b) != Duration {
+		return nil, duration.Duration{}, util.Errorf("did not find marker")
+	}
+	b = b[1:]
+	var vals [3]int64
+	for i := range vals {
+		var err error
+		if b, vals[i], err = decodeVarint(b); err != nil {
+			return nil, duration.Duration{}, err
+		}
+	}
+	d, err := duration.Decode(vals[0], vals[1], vals[2])
+	if err != nil {
+		return nil, duration.Duration{}, err
+	}
+	return b, d, nil
+}
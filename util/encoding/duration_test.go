@@ -0,0 +1,238 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/duration"
+	"github.com/cockroachdb/cockroach/util/randutil"
+)
+
+const day = 24 * int64(time.Hour)
+
+// mustEncode returns the result of an encoder which can fail, and panics if
+// it did.
+func mustEncode(b []byte, err error) []byte {
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestEncodeDuration(t *testing.T) {
+	// The durations are in increasing order.
+	testCases := []duration.Duration{
+		{Nanos: math.MinInt64},
+		{Months: -12 * 290},
+		{Months: -1, Days: -1},
+		{Days: -31},
+		{Months: -1},
+		{Days: -30},
+		{Days: -1, Nanos: -1},
+		{Days: -1},
+		{Days: -1, Nanos: 1},
+		{Nanos: -1},
+		{},
+		{Nanos: 1},
+		{Days: 1, Nanos: -1},
+		{Days: 1},
+		{Days: 1, Nanos: 1},
+		{Days: 30},
+		{Months: 1},
+		{Months: 1, Nanos: 1},
+		{Days: 31},
+		{Months: 1, Days: 1},
+		{Months: 12 * 290},
+		{Nanos: math.MaxInt64},
+	}
+	var last, lastDesc []byte
+	for i, c := range testCases {
+		enc, err := EncodeDuration(nil, c)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		desc, err := EncodeDurationDecreasing(nil, c)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if last != nil && bytes.Compare(last, enc) >= 0 {
+			t.Errorf("%d: expected [% x] to sort before [% x] of %+v", i, last, enc, c)
+		}
+		if lastDesc != nil && bytes.Compare(lastDesc, desc) <= 0 {
+			t.Errorf("%d: expected [% x] to sort after [% x] of %+v", i, lastDesc, desc, c)
+		}
+		last, lastDesc = enc, desc
+
+		for _, d := range []struct {
+			enc    []byte
+			decode func([]byte) ([]byte, duration.Duration, error)
+		}{
+			{enc, DecodeDuration},
+			{desc, DecodeDurationDecreasing},
+		} {
+			rem, dec, err := d.decode(append(d.enc, "rest"...))
+			if err != nil {
+				t.Errorf("%d: %s", i, err)
+				continue
+			}
+			if string(rem) != "rest" {
+				t.Errorf("%d: expected remainder \"rest\", got %q", i, rem)
+			}
+			if c.Nanos > -day && c.Nanos < day && dec != c {
+				t.Errorf("%d: expected %+v, got %+v", i, c, dec)
+			}
+			if dec.Nanos <= -day || dec.Nanos >= day {
+				t.Errorf("%d: expected %+v to be normalized", i, dec)
+			}
+		}
+	}
+}
+
+func TestEncodeDurationNormalized(t *testing.T) {
+	testCases := []struct {
+		d, normalized duration.Duration
+	}{
+		{duration.Duration{Nanos: day}, duration.Duration{Days: 1}},
+		{duration.Duration{Nanos: 25 * int64(time.Hour)}, duration.Duration{Days: 1, Nanos: int64(time.Hour)}},
+		{duration.Duration{Days: 2, Nanos: -day - 1}, duration.Duration{Days: 1, Nanos: -1}},
+		{duration.Duration{Months: 1, Nanos: -3 * day}, duration.Duration{Months: 1, Days: -3}},
+	}
+	for i, c := range testCases {
+		enc, err := EncodeDuration(nil, c.d)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if e, _ := EncodeDuration(nil, c.normalized); !bytes.Equal(enc, e) {
+			t.Errorf("%d: expected %+v and %+v to be encoded identically, got [% x] and [% x]",
+				i, c.d, c.normalized, enc, e)
+		}
+		if _, dec, err := DecodeDuration(enc); err != nil {
+			t.Errorf("%d: %s", i, err)
+		} else if dec != c.normalized {
+			t.Errorf("%d: expected %+v, got %+v", i, c.normalized, dec)
+		}
+	}
+}
+
+func TestEncodeDurationOverflow(t *testing.T) {
+	testCases := []duration.Duration{
+		{Months: math.MaxInt64},
+		{Days: math.MinInt64},
+		// Durations can't be longer than about 292 years.
+		{Months: 12 * 9999},
+		{Months: 12 * 290, Nanos: math.MaxInt64},
+		{Days: math.MaxInt64, Nanos: day},
+	}
+	for i, c := range testCases {
+		if _, err := EncodeDuration(nil, c); err == nil {
+			t.Errorf("%d: expected error encoding %+v", i, c)
+		}
+	}
+}
+
+func TestDecodeDurationInvalid(t *testing.T) {
+	valid, err := EncodeDuration(nil, duration.Duration{Months: 1, Days: 2, Nanos: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	marker := []byte{durationMarker}
+	testCases := []struct {
+		name string
+		enc  []byte
+	}{
+		{"empty", nil},
+		{"unknown prefix", EncodeVarint(nil, 1)},
+		{"time", EncodeTime(nil, time.Unix(0, 0))},
+		{"missing days", valid[:len(valid)-1]},
+		{"unnormalized nanos", EncodeVarint(EncodeVarint(EncodeVarint(marker, day), 0), 0)},
+		{"months overflow", EncodeVarint(EncodeVarint(EncodeVarint(marker, 0), math.MaxInt64), 0)},
+		{"length overflow", EncodeVarint(EncodeVarint(EncodeVarint(marker, math.MaxInt64), -1), 0)},
+	}
+	for _, c := range testCases {
+		if _, _, err := DecodeDuration(c.enc); err == nil {
+			t.Errorf("%s: expected error decoding [% x]", c.name, c.enc)
+		}
+	}
+}
+
+// durationLength returns the length of the duration used to order it.
+func durationLength(d duration.Duration) *big.Int {
+	n := new(big.Int).Mul(big.NewInt(d.Months), big.NewInt(30*day))
+	n.Add(n, new(big.Int).Mul(big.NewInt(d.Days), big.NewInt(day)))
+	return n.Add(n, big.NewInt(d.Nanos))
+}
+
+// compareDurations orders normalized durations by length, months and days.
+func compareDurations(a, b duration.Duration) int {
+	if c := durationLength(a).Cmp(durationLength(b)); c != 0 {
+		return c
+	}
+	for _, v := range [][2]int64{{a.Months, b.Months}, {a.Days, b.Days}} {
+		if v[0] < v[1] {
+			return -1
+		} else if v[0] > v[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// TestDurationOrderRandomized verifies that the encodings of random
+// durations sort like the durations, or in reverse for the decreasing
+// encoding.
+func TestDurationOrderRandomized(t *testing.T) {
+	rng, _ := randutil.NewPseudoRand()
+	randDuration := func() duration.Duration {
+		return duration.Duration{
+			Months: rng.Int63n(2*12*250) - 12*250,
+			Days:   rng.Int63n(2*1000) - 1000,
+			Nanos:  rng.Int63n(2*day) - day,
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		a, b := randDuration(), randDuration()
+		if rng.Intn(10) == 0 {
+			// Compare durations of the same length.
+			b = duration.Duration{Months: a.Months - 1, Days: a.Days + 30, Nanos: a.Nanos}
+		}
+		encA, err := EncodeDuration(nil, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encB, err := EncodeDuration(nil, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c, e := bytes.Compare(encA, encB), compareDurations(a, b); c != e {
+			t.Fatalf("expected comparison of %+v and %+v to be %d, got %d", a, b, e, c)
+		}
+		descA, err := EncodeDurationDecreasing(nil, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		descB, err := EncodeDurationDecreasing(nil, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c, e := bytes.Compare(descA, descB), -compareDurations(a, b); c != e {
+			t.Fatalf("expected decreasing comparison of %+v and %+v to be %d, got %d", a, b, e, c)
+		}
+	}
+}
@@ -44,6 +44,10 @@ const (
 
 	bytesMarker byte = floatInfinity + 1
 	timeMarker  byte = bytesMarker + 1
+	// floatNaNDesc is the encoding of NaN by EncodeFloatDecreasing, which
+	// sorts after all other floats.
+	floatNaNDesc   byte = timeMarker + 1
+	durationMarker byte = floatNaNDesc + 1
 
 	// IntMin is chosen such that the range of int tags does not overlap the
 	// ascii character set that is frequently used in testing.
@@ -541,8 +545,9 @@ func DecodeIfNotNull(b []byte) ([]byte, bool) {
 // EncodeTime encodes a time value, appends it to the supplied buffer,
 // and returns the final buffer. The encoding is guaranteed to be ordered
 // Such that if t1.Before(t2) then after EncodeTime(b1, t1), and
-// EncodeTime(b2, t1), Compare(b1, b2) < 0. The time zone offset not
-// included in the encoding.
+// EncodeTime(b2, t1), Compare(b1, b2) < 0. This holds for times before
+// 1970 as well, as the seconds are encoded as a signed varint. The time
+// zone offset not included in the encoding.
 func EncodeTime(b []byte, t time.Time) []byte {
 	// Read the unix absolute time. This is the absolute time and is
 	// not time zone offset dependent.
@@ -552,27 +557,51 @@ func EncodeTime(b []byte, t time.Time) []byte {
 	return b
 }
 
+// EncodeTimeDecreasing encodes a time value like EncodeTime, except that
+// later times sort first.
+func EncodeTimeDecreasing(b []byte, t time.Time) []byte {
+	b = append(b, timeMarker)
+	b = EncodeVarintDecreasing(b, t.Unix())
+	b = EncodeVarintDecreasing(b, int64(t.Nanosecond()))
+	return b
+}
+
 // DecodeTime decodes a time.Time value which was encoded using
 // EncodeTime. The remainder of the input buffer and the decoded
 // time.Time are returned.
 func DecodeTime(b []byte) ([]byte, time.Time, error) {
+	return decodeTime(b, DecodeVarint)
+}
+
+// DecodeTimeDecreasing decodes a time.Time value which was encoded using
+// EncodeTimeDecreasing. The remainder of the input buffer and the decoded
+// time.Time are returned.
+func DecodeTimeDecreasing(b []byte) ([]byte, time.Time, error) {
+	return decodeTime(b, DecodeVarintDecreasing)
+}
+
+func decodeTime(b []byte, decodeVarint func([]byte) ([]byte, int64, error)) ([]byte, time.Time, error) {
 	if PeekType(b) != Time {
 		return nil, time.Time{}, util.Errorf("did not find marker")
 	}
 	b = b[1:]
-	b, sec, err := DecodeVarint(b)
+	b, sec, err := decodeVarint(b)
 	if err != nil {
 		return b, time.Time{}, err
 	}
-	b, nsec, err := DecodeVarint(b)
+	b, nsec, err := decodeVarint(b)
 	if err != nil {
 		return b, time.Time{}, err
 	}
+	if nsec < 0 || nsec >= int64(time.Second) {
+		return nil, time.Time{}, util.Errorf("nanoseconds out of range: %d", nsec)
+	}
 	return b, time.Unix(sec, nsec), nil
 }
 
 // Type represents the type of a value encoded by
-// Encode{Null,NotNull,Varint,Uvarint,Float,Bytes}.
+// Encode{Null,NotNull,Varint,Uvarint,Float,Decimal,Bytes,Time,Duration}. The values
+// encoded by the Decreasing variants of the encoders have the same types.
 type Type int
 
 // Type values.
@@ -584,6 +613,7 @@ const (
 	Float
 	Bytes
 	Time
+	Duration
 )
 
 // PeekType peeks at the type of the value encoded at the start of b.
@@ -599,9 +629,11 @@ func PeekType(b []byte) Type {
 			return Bytes
 		case m == timeMarker:
 			return Time
+		case m == durationMarker:
+			return Duration
 		case m >= IntMin && m <= IntMax:
 			return Int
-		case m >= floatNaN && m <= floatInfinity:
+		case m >= floatNaN && m <= floatInfinity, m == floatNaNDesc:
 			return Float
 		}
 	}
@@ -19,10 +19,12 @@ package encoding
 import (
 	"bytes"
 	"math"
+	"math/big"
 	"regexp"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/util/duration"
 	"github.com/cockroachdb/cockroach/util/randutil"
 )
 
@@ -675,6 +677,77 @@ func TestEncodeDecodeTime(t *testing.T) {
 	}
 }
 
+// TestEncodeTimeExtremes verifies that times from year 1 to year 9999 sort
+// chronologically, or in reverse for the decreasing encoding, and that they
+// round-trip.
+func TestEncodeTimeExtremes(t *testing.T) {
+	testCases := []time.Time{
+		time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1, time.January, 1, 0, 0, 0, 1, time.UTC),
+		time.Date(1066, time.October, 14, 9, 0, 0, 0, time.UTC),
+		time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1969, time.December, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(1969, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+		time.Unix(0, 0),
+		time.Unix(0, 1),
+		time.Unix(1, 0),
+		time.Date(2016, time.February, 29, 12, 0, 0, 0, time.UTC),
+		time.Date(9999, time.December, 31, 23, 59, 59, 999999998, time.UTC),
+		time.Date(9999, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+	}
+	var last, lastDesc []byte
+	for i, c := range testCases {
+		enc, desc := EncodeTime(nil, c), EncodeTimeDecreasing(nil, c)
+		if last != nil && bytes.Compare(last, enc) >= 0 {
+			t.Errorf("%d: expected [% x] to sort before [% x] of %s", i, last, enc, c)
+		}
+		if lastDesc != nil && bytes.Compare(lastDesc, desc) <= 0 {
+			t.Errorf("%d: expected [% x] to sort after [% x] of %s", i, lastDesc, desc, c)
+		}
+		last, lastDesc = enc, desc
+
+		for _, d := range []struct {
+			enc    []byte
+			decode func([]byte) ([]byte, time.Time, error)
+		}{
+			{enc, DecodeTime},
+			{desc, DecodeTimeDecreasing},
+		} {
+			rem, dec, err := d.decode(append(d.enc, "rest"...))
+			if err != nil {
+				t.Errorf("%d: %s", i, err)
+				continue
+			}
+			if string(rem) != "rest" {
+				t.Errorf("%d: expected remainder \"rest\", got %q", i, rem)
+			}
+			if !dec.Equal(c) {
+				t.Errorf("%d: expected %s, got %s", i, c, dec)
+			}
+		}
+	}
+}
+
+func TestDecodeTimeInvalid(t *testing.T) {
+	marker := []byte{timeMarker}
+	testCases := []struct {
+		name string
+		enc  []byte
+	}{
+		{"empty", nil},
+		{"unknown prefix", EncodeVarint(nil, 1)},
+		{"missing nanos", EncodeVarint(marker, 1)},
+		{"truncated seconds", EncodeVarint(marker, 1<<40)[:3]},
+		{"negative nanos", EncodeVarint(EncodeVarint(marker, 1), -1)},
+		{"nanos out of range", EncodeVarint(EncodeVarint(marker, 1), int64(time.Second))},
+	}
+	for _, c := range testCases {
+		if _, _, err := DecodeTime(c.enc); err == nil {
+			t.Errorf("%s: expected error decoding [% x]", c.name, c.enc)
+		}
+	}
+}
+
 func TestPeekType(t *testing.T) {
 	testCases := []struct {
 		enc []byte
@@ -685,8 +758,16 @@ func TestPeekType(t *testing.T) {
 		{EncodeVarint(nil, 0), Int},
 		{EncodeUvarint(nil, 0), Int},
 		{EncodeFloat(nil, 0), Float},
+		{EncodeFloatDecreasing(nil, 1), Float},
+		{EncodeFloatDecreasing(nil, math.NaN()), Float},
+		{EncodeDecimal(nil, big.NewInt(-15), 1), Float},
+		{EncodeDecimalDecreasing(nil, big.NewInt(15), 1), Float},
 		{EncodeBytes(nil, []byte("")), Bytes},
+		{EncodeBytesDecreasing(nil, []byte("a")), Bytes},
 		{EncodeTime(nil, time.Now()), Time},
+		{EncodeTimeDecreasing(nil, time.Now()), Time},
+		{mustEncode(EncodeDuration(nil, duration.Duration{Days: 1})), Duration},
+		{mustEncode(EncodeDurationDecreasing(nil, duration.Duration{Months: -1})), Duration},
 	}
 	for i, c := range testCases {
 		typ := PeekType(c.enc)
@@ -48,6 +48,10 @@ import (
 // as a byte 0x13-E followed by the ones-complement of M. Large negative values
 // consist of the single byte 0x08 followed by the ones-complement of the
 // varint encoding of E followed by the ones-complement of M.
+//
+// The encoding defines a total order: NaN sorts first, followed by negative
+// infinity, the finite values and positive infinity. Negative zero is
+// encoded as zero.
 func EncodeFloat(b []byte, f float64) []byte {
 	// Handle the simplistic cases first.
 	switch {
@@ -61,7 +65,24 @@ func EncodeFloat(b []byte, f float64) []byte {
 		return append(b, floatZero)
 	}
 	e, m := floatMandE(b, f)
+	return encodeMandE(b, f < 0, e, m)
+}
+
+// EncodeFloatDecreasing returns the resulting byte slice with the encoded
+// float64 appended to b, such that it sorts in reverse order: NaN sorts
+// last, preceded by positive infinity, the finite values and negative
+// infinity. Values other than NaN are encoded by EncodeFloat as their
+// negation.
+func EncodeFloatDecreasing(b []byte, f float64) []byte {
+	if math.IsNaN(f) {
+		return append(b, floatNaNDesc)
+	}
+	return EncodeFloat(b, -f)
+}
 
+// encodeMandE appends the encoding of the finite, non-zero number with the
+// given sign, exponent E and mantissa M to b. See EncodeFloat for details.
+func encodeMandE(b []byte, negative bool, e int, m []byte) []byte {
 	var buf []byte
 	if n := len(m) + maxVarintSize + 2; n <= cap(b)-len(b) {
 		buf = b[len(b) : len(b)+n]
@@ -70,11 +91,11 @@ func EncodeFloat(b []byte, f float64) []byte {
 	}
 	switch {
 	case e < 0:
-		return append(b, encodeSmallNumber(f < 0, e, m, buf)...)
+		return append(b, encodeSmallNumber(negative, e, m, buf)...)
 	case e >= 0 && e <= 10:
-		return append(b, encodeMediumNumber(f < 0, e, m, buf)...)
+		return append(b, encodeMediumNumber(negative, e, m, buf)...)
 	case e >= 11:
-		return append(b, encodeLargeNumber(f < 0, e, m, buf)...)
+		return append(b, encodeLargeNumber(negative, e, m, buf)...)
 	}
 	return nil
 }
@@ -123,6 +144,21 @@ func DecodeFloat(buf []byte, tmp []byte) ([]byte, float64, error) {
 	}
 }
 
+// DecodeFloatDecreasing returns the remaining byte slice after decoding and
+// the decoded float64 from buf, which was encoded using
+// EncodeFloatDecreasing.
+func DecodeFloatDecreasing(buf []byte, tmp []byte) ([]byte, float64, error) {
+	if len(buf) > 0 && buf[0] == floatNaNDesc {
+		return buf[1:], math.NaN(), nil
+	}
+	b, f, err := DecodeFloat(buf, tmp)
+	if err != nil || f == 0 {
+		// Don't turn zero into negative zero.
+		return b, 0, err
+	}
+	return b, -f, nil
+}
+
 // floatMandE computes and returns the mantissa M and exponent E for f.
 //
 // The mantissa is a base-100 representation of the value. The exponent E
@@ -181,6 +217,14 @@ func floatMandE(b []byte, f float64) (int, []byte) {
 	b[0] = '0' // "0ddddd"
 	e10++
 
+	return mandEFromDigits(b, e10)
+}
+
+// mandEFromDigits computes and returns the mantissa M and exponent E of the
+// number 0.ddddd * 10^e10, given the slice "0ddddd" which holds a leading
+// zero followed by its decimal digits, the last of which must not be zero.
+// The slice is overwritten by the mantissa. See floatMandE for details.
+func mandEFromDigits(b []byte, e10 int) (int, []byte) {
 	// Convert the power-10 exponent to a power of 100 exponent.
 	var e100 int
 	if e10 >= 0 {
@@ -19,6 +19,7 @@ package encoding
 import (
 	"bytes"
 	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/util/randutil"
@@ -157,6 +158,147 @@ func TestEncodeFloat(t *testing.T) {
 	}
 }
 
+func TestEncodeFloatDecreasing(t *testing.T) {
+	// The values in decreasing order.
+	testCases := []float64{
+		math.Inf(1),
+		math.MaxFloat64,
+		1e308,
+		12345,
+		1,
+		0.00123,
+		math.SmallestNonzeroFloat64,
+		0,
+		-math.SmallestNonzeroFloat64,
+		-0.00123,
+		-1,
+		-12345,
+		-1e308,
+		-math.MaxFloat64,
+		math.Inf(-1),
+		math.NaN(),
+	}
+	var last []byte
+	for _, c := range testCases {
+		enc := EncodeFloatDecreasing(nil, c)
+		if last != nil && bytes.Compare(last, enc) >= 0 {
+			t.Errorf("%v: expected [% x] to be less than [% x]", c, last, enc)
+		}
+		last = enc
+		rem, dec, err := DecodeFloatDecreasing(append(enc, "rest"...), nil)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(rem) != "rest" {
+			t.Errorf("%v: expected remainder \"rest\", got %q", c, rem)
+		}
+		if !(dec == c || math.IsNaN(c) && math.IsNaN(dec)) {
+			t.Errorf("unexpected mismatch for %v. got %v", c, dec)
+		}
+	}
+}
+
+// TestFloatNegativeZero verifies that negative zero is encoded as zero, and
+// decoded as positive zero.
+func TestFloatNegativeZero(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	for _, enc := range []struct {
+		encode func([]byte, float64) []byte
+		decode func([]byte, []byte) ([]byte, float64, error)
+	}{
+		{EncodeFloat, DecodeFloat},
+		{EncodeFloatDecreasing, DecodeFloatDecreasing},
+	} {
+		b := enc.encode(nil, negZero)
+		if e := enc.encode(nil, 0); !bytes.Equal(b, e) {
+			t.Errorf("expected -0 to be encoded as [% x], got [% x]", e, b)
+		}
+		if _, f, err := enc.decode(b, nil); err != nil {
+			t.Error(err)
+		} else if f != 0 || math.Signbit(f) {
+			t.Errorf("expected +0, got %v", f)
+		}
+	}
+}
+
+// randFloat returns a random float64, which is special (NaN, infinite or
+// zero) one time out of ten and otherwise has random bits.
+func randFloat(rng *rand.Rand) float64 {
+	switch rng.Intn(20) {
+	case 0:
+		return math.NaN()
+	case 1:
+		return math.Inf(1)
+	case 2:
+		return math.Inf(-1)
+	case 3:
+		return math.Copysign(0, float64(rng.Intn(2)*2-1))
+	}
+	for {
+		if f := math.Float64frombits(uint64(rng.Int63()) | uint64(rng.Intn(2))<<63); !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return f
+		}
+	}
+}
+
+// compareFloats compares floats in the total order of their encoding, in
+// which NaN is smaller than all other values.
+func compareFloats(a, b float64) int {
+	switch {
+	case math.IsNaN(a) && math.IsNaN(b):
+		return 0
+	case math.IsNaN(a):
+		return -1
+	case math.IsNaN(b):
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// TestFloatOrderRandomized verifies that the encodings of random floats sort
+// like the floats, or in reverse for the decreasing encoding, and that they
+// round-trip.
+func TestFloatOrderRandomized(t *testing.T) {
+	rng, _ := randutil.NewPseudoRand()
+	for i := 0; i < 10000; i++ {
+		a, b := randFloat(rng), randFloat(rng)
+		if rng.Intn(10) == 0 {
+			// Compare values close to each other.
+			b = math.Nextafter(a, b)
+		}
+		encA, encB := EncodeFloat(nil, a), EncodeFloat(nil, b)
+		if c, e := bytes.Compare(encA, encB), compareFloats(a, b); c != e {
+			t.Fatalf("expected comparison of %v and %v to be %d, got %d for [% x] and [% x]",
+				a, b, e, c, encA, encB)
+		}
+		descA, descB := EncodeFloatDecreasing(nil, a), EncodeFloatDecreasing(nil, b)
+		if c, e := bytes.Compare(descA, descB), -compareFloats(a, b); c != e {
+			t.Fatalf("expected decreasing comparison of %v and %v to be %d, got %d for [% x] and [% x]",
+				a, b, e, c, descA, descB)
+		}
+		for _, d := range []struct {
+			enc    []byte
+			decode func([]byte, []byte) ([]byte, float64, error)
+		}{
+			{encA, DecodeFloat},
+			{descA, DecodeFloatDecreasing},
+		} {
+			rem, dec, err := d.decode(d.enc, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rem) != 0 || compareFloats(dec, a) != 0 {
+				t.Fatalf("expected [% x] to decode to %v, got %v with remainder [% x]", d.enc, a, dec, rem)
+			}
+		}
+	}
+}
+
 func BenchmarkEncodeFloat(b *testing.B) {
 	rng, _ := randutil.NewPseudoRand()
 
@@ -27,12 +27,12 @@ import (
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 )
 
-// TODO(Tobias): Figure out if it would make sense to save some
-// history of the physical clock and react if it jumps backwards
-// repeatedly. This is expected during NTP updates, but may
-// indicate a broken clock in some cases.
+// fatalf is called when a remote clock is found to be further ahead than
+// MaxOffset. It is a variable so that tests can intercept it.
+var fatalf = log.Fatalf
 
 // Clock is a hybrid logical clock. Objects of this
 // type model causality while maintaining a relation
@@ -55,6 +55,12 @@ type Clock struct {
 	// clock (and cluster time) the wall time can be.
 	// See SetMaxOffset.
 	maxOffset time.Duration
+	// lastPhysicalTime is the most recent reading of the physical clock,
+	// used to detect backward jumps.
+	lastPhysicalTime int64
+	// backwardJumps counts the readings of the physical clock which were
+	// behind the previous one.
+	backwardJumps *metric.Counter
 }
 
 // ManualClock is a convenience type to facilitate
@@ -102,6 +108,7 @@ func UnixNano() int64 {
 func NewClock(physicalClock func() int64) *Clock {
 	return &Clock{
 		physicalClock: physicalClock,
+		backwardJumps: metric.NewCounter(),
 	}
 }
 
@@ -129,6 +136,61 @@ func (c *Clock) MaxOffset() time.Duration {
 	return c.maxOffset
 }
 
+// BackwardJumps returns the counter of backward jumps of the physical clock,
+// so that it can be added to a metrics registry. The timestamps issued by the
+// clock remain monotonic across such jumps.
+func (c *Clock) BackwardJumps() *metric.Counter {
+	return c.backwardJumps
+}
+
+// Skew returns how far the clock's timestamp is ahead of the physical clock,
+// as its wall time difference and the logical ticks on top of it. Both are
+// zero when the physical clock has caught up. A persistent skew indicates
+// that the clock is driven by remote clocks ahead of the local one, or by a
+// backward jump of the physical clock.
+func (c *Clock) Skew() (time.Duration, int32) {
+	c.Lock()
+	defer c.Unlock()
+	physicalClock := c.getPhysicalClockLocked()
+	if c.state.WallTime < physicalClock {
+		return 0, 0
+	}
+	return time.Duration(c.state.WallTime - physicalClock), c.state.Logical
+}
+
+// CheckOffset verifies that a remote clock is not ahead of the physical clock
+// by more than MaxOffset. The offset should be a lower bound of the remote
+// clock's offset, such as a measured offset minus its uncertainty. If it
+// exceeds MaxOffset, one of the clocks is misconfigured and the process is
+// terminated rather than letting the remote clock drag the timestamps of the
+// cluster into the future. A MaxOffset of zero disables the check.
+func (c *Clock) CheckOffset(offset time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+	c.checkOffsetLocked(offset)
+}
+
+// checkOffsetLocked implements CheckOffset with the clock locked.
+func (c *Clock) checkOffsetLocked(offset time.Duration) {
+	if c.maxOffset > 0 && offset > c.maxOffset {
+		fatalf("remote clock is %s ahead of the local physical clock, which exceeds the maximum offset of %s",
+			offset, c.maxOffset)
+	}
+}
+
+// getPhysicalClockLocked reads the physical clock, counting and logging
+// backward jumps. The clock must be locked.
+func (c *Clock) getPhysicalClockLocked() int64 {
+	physicalClock := c.physicalClock()
+	if physicalClock < c.lastPhysicalTime {
+		c.backwardJumps.Inc(1)
+		log.Warningf("backward time jump of the physical clock detected: %s",
+			time.Duration(c.lastPhysicalTime-physicalClock))
+	}
+	c.lastPhysicalTime = physicalClock
+	return physicalClock
+}
+
 // Timestamp returns a copy of the clock's current timestamp,
 // without performing a clock adjustment.
 func (c *Clock) Timestamp() roachpb.Timestamp {
@@ -155,9 +217,10 @@ func (c *Clock) Now() roachpb.Timestamp {
 	c.Lock()
 	defer c.Unlock()
 
-	physicalClock := c.physicalClock()
+	physicalClock := c.getPhysicalClockLocked()
 	if c.state.WallTime >= physicalClock {
-		// The wall time is ahead, so the logical clock ticks.
+		// The wall time is ahead, possibly because the physical clock jumped
+		// backwards, so the logical clock ticks.
 		c.state.Logical++
 	} else {
 		// Use the physical clock, and reset the logical one.
@@ -184,15 +247,28 @@ func (c *Clock) PhysicalTime() time.Time {
 // an event received from another member of a distributed
 // system. The clock is updated and the hybrid timestamp
 // associated to the receipt of the event returned.
-// An error may only occur if offset checking is active and
-// the remote timestamp was rejected due to clock offset,
-// in which case the state of the clock will not have been
-// altered.
+// If offset checking is active and the remote wall time is
+// ahead of the local physical clock by more than MaxOffset,
+// the process is terminated; see CheckOffset.
 // To timestamp events of local origin, use Now instead.
 func (c *Clock) Update(rt roachpb.Timestamp) roachpb.Timestamp {
+	return c.update(rt, true)
+}
+
+// ForceUpdate is like Update, but accepts remote wall times
+// ahead of the local physical clock by more than MaxOffset,
+// merely logging them. It is used for the timestamps of
+// commands which were agreed upon by the cluster and must be
+// applied regardless of the local clock.
+func (c *Clock) ForceUpdate(rt roachpb.Timestamp) roachpb.Timestamp {
+	return c.update(rt, false)
+}
+
+// update implements Update and ForceUpdate.
+func (c *Clock) update(rt roachpb.Timestamp, checkOffset bool) roachpb.Timestamp {
 	c.Lock()
 	defer c.Unlock()
-	physicalClock := c.physicalClock()
+	physicalClock := c.getPhysicalClockLocked()
 
 	if physicalClock > c.state.WallTime && physicalClock > rt.WallTime {
 		// Our physical clock is ahead of both wall times. It is used
@@ -206,7 +282,9 @@ func (c *Clock) Update(rt roachpb.Timestamp) roachpb.Timestamp {
 	// as it is behind the local and remote wall times. Instead,
 	// the logical clock comes into play.
 	if rt.WallTime > c.state.WallTime {
-		if c.maxOffset.Nanoseconds() > 0 &&
+		if checkOffset {
+			c.checkOffsetLocked(time.Duration(rt.WallTime - physicalClock))
+		} else if c.maxOffset.Nanoseconds() > 0 &&
 			rt.WallTime-physicalClock > c.maxOffset.Nanoseconds() {
 			// The remote wall time is too far ahead to be trustworthy.
 			log.Errorf("Remote wall time offsets from local physical clock: %d (%dns ahead)",
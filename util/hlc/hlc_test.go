@@ -152,6 +152,107 @@ func TestClock(t *testing.T) {
 	c.Now()
 }
 
+// TestClockBackwardJump verifies that backward jumps of the physical clock
+// are counted and that the clock keeps issuing increasing timestamps from
+// its logical component until the physical clock catches up.
+func TestClockBackwardJump(t *testing.T) {
+	m := NewManualClock(0)
+	c := NewClock(m.UnixNano)
+	expectedHistory := []struct {
+		wallClock     int64
+		expected      roachpb.Timestamp
+		backwardJumps int64
+		wallSkew      time.Duration
+		logicalSkew   int32
+	}{
+		{10, roachpb.Timestamp{WallTime: 10, Logical: 0}, 0, 0, 0},
+		// The physical clock jumps back.
+		{5, roachpb.Timestamp{WallTime: 10, Logical: 1}, 1, 5, 1},
+		{4, roachpb.Timestamp{WallTime: 10, Logical: 2}, 2, 6, 2},
+		// The physical clock advances again, but is still behind.
+		{8, roachpb.Timestamp{WallTime: 10, Logical: 3}, 2, 2, 3},
+		// The physical clock has caught up and takes over.
+		{11, roachpb.Timestamp{WallTime: 11, Logical: 0}, 2, 0, 0},
+	}
+	for i, step := range expectedHistory {
+		m.Set(step.wallClock)
+		if ts := c.Now(); !ts.Equal(step.expected) {
+			t.Errorf("%d: expected %s, got %s", i, step.expected, ts)
+		}
+		if count := c.BackwardJumps().Count(); count != step.backwardJumps {
+			t.Errorf("%d: expected %d backward jumps, got %d", i, step.backwardJumps, count)
+		}
+		if wall, logical := c.Skew(); wall != step.wallSkew || logical != step.logicalSkew {
+			t.Errorf("%d: expected skew %s+%d, got %s+%d", i, step.wallSkew, step.logicalSkew, wall, logical)
+		}
+	}
+}
+
+// TestClockMaxOffset verifies that remote clocks ahead of the physical clock
+// by at most MaxOffset move the clock forward, while those further ahead are
+// fatal unless the update is forced.
+func TestClockMaxOffset(t *testing.T) {
+	var fatals int
+	defer func(f func(string, ...interface{})) { fatalf = f }(fatalf)
+	fatalf = func(format string, args ...interface{}) {
+		fatals++
+	}
+
+	m := NewManualClock(100)
+	c := NewClock(m.UnixNano)
+	// Without a maximum offset, any remote clock is accepted.
+	c.Update(roachpb.Timestamp{WallTime: 1000})
+	c.CheckOffset(1000)
+	if fatals != 0 {
+		t.Fatalf("expected no fatal errors without a maximum offset, got %d", fatals)
+	}
+
+	m.Set(1000)
+	c.SetMaxOffset(10)
+	testCases := []struct {
+		offset time.Duration
+		force  bool
+		fatal  bool
+	}{
+		// A forward jump within the maximum offset.
+		{5, false, false},
+		{10, false, false},
+		// A remote clock further ahead is fatal.
+		{11, false, true},
+		// Unless the update is forced.
+		{100, true, false},
+	}
+	for i, test := range testCases {
+		rt := roachpb.Timestamp{WallTime: m.UnixNano() + int64(test.offset)}
+		var ts roachpb.Timestamp
+		if test.force {
+			ts = c.ForceUpdate(rt)
+		} else {
+			ts = c.Update(rt)
+		}
+		if expected := rt.Add(0, 1); !ts.Equal(expected) {
+			t.Errorf("%d: expected %s, got %s", i, expected, ts)
+		}
+		if wall, _ := c.Skew(); wall != test.offset {
+			t.Errorf("%d: expected a skew of %s, got %s", i, test.offset, wall)
+		}
+		if test.fatal != (fatals == 1) {
+			t.Errorf("%d: expected fatal=%t, got %d fatal errors", i, test.fatal, fatals)
+		}
+		fatals = 0
+		// Offsets observed directly, such as by heartbeats, are checked the
+		// same way, but only when not forced.
+		if !test.force {
+			c.CheckOffset(test.offset)
+			if test.fatal != (fatals == 1) {
+				t.Errorf("%d: expected fatal=%t checking the offset, got %d fatal errors", i, test.fatal, fatals)
+			}
+			fatals = 0
+		}
+		m.Increment(1000)
+	}
+}
+
 // ExampleManualClock shows how a manual clock can be
 // used as a physical clock. This is useful for testing.
 func ExampleManualClock() {
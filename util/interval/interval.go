@@ -0,0 +1,341 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package interval provides an interval tree of half-open intervals,
+// backed by an AVL tree ordered by the start of the intervals and
+// augmented with the maximum end of the intervals in each subtree.
+//
+// The API follows the one of github.com/biogo/store/interval, which this
+// package replaces for the command queue and the interval cache, with a
+// few differences: the tree decides on overlaps itself, since all the
+// intervals are half-open; multiple intervals can be inserted at once with
+// a single allocation; and overlapping intervals can be visited through a
+// callback, without allocating a slice of results.
+package interval
+
+import "errors"
+
+// ErrInvertedRange is returned if an interval is used where the start
+// value is greater than the end value.
+var ErrInvertedRange = errors.New("interval: inverted range")
+
+// ErrEmptyRange is returned if an interval is used where the start value
+// is equal to the end value.
+var ErrEmptyRange = errors.New("interval: empty range")
+
+// A Comparable is a type that describes the ordering of the bounds of
+// intervals.
+type Comparable interface {
+	// Compare returns a value indicating the sort order relationship
+	// between the receiver and the parameter.
+	//
+	// Given c = a.Compare(b):
+	//  c < 0 if a < b;
+	//  c == 0 if a == b; and
+	//  c > 0 if a > b.
+	Compare(Comparable) int
+}
+
+// A Range is a type that describes the basic characteristics of an
+// interval: the half-open interval [Start(), End()).
+type Range interface {
+	Start() Comparable
+	End() Comparable
+}
+
+// An Interface is a type that can be inserted into an interval tree.
+type Interface interface {
+	Range
+	// ID returns a unique identifier, which distinguishes intervals with
+	// the same range.
+	ID() uintptr
+}
+
+// An Operation is a function that operates on an Interface. If done is
+// returned true, the Operation is indicating that no further work needs
+// to be done and so the traversal should be terminated.
+type Operation func(Interface) (done bool)
+
+// A Tree manages the root node of an interval tree. The zero value is an
+// empty tree ready to use. A Tree is not safe for concurrent access, and
+// must not be modified by the Operation of a traversal.
+type Tree struct {
+	root  *node
+	count int
+}
+
+// node is a node of the tree. The bounds and the ID of its interval are
+// cached, since they are compared on every operation.
+type node struct {
+	elem        Interface
+	start, end  Comparable
+	id          uintptr
+	maxEnd      Comparable // the maximum end of the intervals of the subtree
+	left, right *node
+	height      int
+}
+
+// Len returns the number of intervals stored in the tree.
+func (t *Tree) Len() int {
+	return t.count
+}
+
+// Insert inserts the interval e into the tree. An error is returned if
+// the range of e is empty or inverted. The IDs of the intervals stored in
+// the tree which have the same start must be distinct.
+func (t *Tree) Insert(e Interface) error {
+	n := &node{}
+	if err := n.init(e); err != nil {
+		return err
+	}
+	t.root = insert(t.root, n)
+	t.count++
+	return nil
+}
+
+// InsertMany inserts all the intervals of es into the tree, allocating
+// the nodes for them at once. If the range of any of the intervals is
+// empty or inverted, an error is returned and none of them is inserted.
+func (t *Tree) InsertMany(es []Interface) error {
+	nodes := make([]node, len(es))
+	for i, e := range es {
+		if err := nodes[i].init(e); err != nil {
+			return err
+		}
+	}
+	for i := range nodes {
+		t.root = insert(t.root, &nodes[i])
+	}
+	t.count += len(nodes)
+	return nil
+}
+
+// Delete deletes the interval with the start and the ID of e from the
+// tree, and returns whether such an interval was found.
+func (t *Tree) Delete(e Interface) bool {
+	var deleted bool
+	t.root, deleted = remove(t.root, e.Start(), e.ID())
+	if deleted {
+		t.count--
+	}
+	return deleted
+}
+
+// Get returns a slice of the intervals which overlap r, in the order of
+// their start and ID.
+func (t *Tree) Get(r Range) []Interface {
+	var es []Interface
+	t.DoMatching(func(e Interface) (done bool) {
+		es = append(es, e)
+		return false
+	}, r)
+	return es
+}
+
+// Do performs fn on all intervals stored in the tree, in the order of
+// their start and ID. A boolean is returned indicating whether the
+// traversal was interrupted by an Operation returning true.
+func (t *Tree) Do(fn Operation) bool {
+	return t.root.do(fn)
+}
+
+// DoMatching performs fn on all intervals stored in the tree which
+// overlap r, in the order of their start and ID. A boolean is returned
+// indicating whether the traversal was interrupted by an Operation
+// returning true. Subtrees which can't contain overlapping intervals are
+// skipped, so that the traversal visits O(log n) nodes for every
+// overlapping interval at most.
+func (t *Tree) DoMatching(fn Operation, r Range) bool {
+	return t.root.doMatching(fn, r.Start(), r.End())
+}
+
+func (n *node) do(fn Operation) bool {
+	for ; n != nil; n = n.right {
+		if n.left.do(fn) || fn(n.elem) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *node) doMatching(fn Operation, start, end Comparable) bool {
+	for ; n != nil; n = n.right {
+		if n.maxEnd.Compare(start) <= 0 {
+			// All the intervals of the subtree end before start.
+			return false
+		}
+		if n.left.doMatching(fn, start, end) {
+			return true
+		}
+		if n.start.Compare(end) >= 0 {
+			// This interval and the ones of the right subtree start after end.
+			return false
+		}
+		if n.end.Compare(start) > 0 && fn(n.elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// init initializes the node with the interval e, whose range must be
+// neither empty nor inverted.
+func (n *node) init(e Interface) error {
+	n.elem, n.start, n.end, n.id = e, e.Start(), e.End(), e.ID()
+	switch c := n.start.Compare(n.end); {
+	case c == 0:
+		return ErrEmptyRange
+	case c > 0:
+		return ErrInvertedRange
+	}
+	return nil
+}
+
+// compare orders an interval with the given start and ID relative to the
+// interval of the node.
+func (n *node) compare(start Comparable, id uintptr) int {
+	if c := start.Compare(n.start); c != 0 {
+		return c
+	}
+	switch {
+	case id < n.id:
+		return -1
+	case id > n.id:
+		return 1
+	}
+	return 0
+}
+
+func height(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and the maximum end of the node from the
+// ones of its children.
+func (n *node) update() {
+	n.height = height(n.left)
+	if h := height(n.right); h > n.height {
+		n.height = h
+	}
+	n.height++
+	n.maxEnd = n.end
+	if n.left != nil && n.left.maxEnd.Compare(n.maxEnd) > 0 {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && n.right.maxEnd.Compare(n.maxEnd) > 0 {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+func rotateLeft(n *node) *node {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func rotateRight(n *node) *node {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// balance updates the node and restores the AVL invariant, under which
+// the heights of the children of a node differ by one at most, returning
+// the new root of the subtree.
+func balance(n *node) *node {
+	n.update()
+	switch d := height(n.left) - height(n.right); {
+	case d > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case d < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+// insert inserts the node x into the subtree rooted at n and returns the
+// new root of the subtree.
+func insert(n, x *node) *node {
+	if n == nil {
+		x.update()
+		return x
+	}
+	if n.compare(x.start, x.id) < 0 {
+		n.left = insert(n.left, x)
+	} else {
+		n.right = insert(n.right, x)
+	}
+	return balance(n)
+}
+
+// remove removes the node with the given start and ID from the subtree
+// rooted at n, and returns the new root of the subtree and whether the
+// node was found.
+func remove(n *node, start Comparable, id uintptr) (*node, bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch c := n.compare(start, id); {
+	case c < 0:
+		n.left, removed = remove(n.left, start, id)
+	case c > 0:
+		n.right, removed = remove(n.right, start, id)
+	default:
+		left, right := n.left, n.right
+		// Release the interval, since the node may be kept alive by the
+		// other nodes allocated along with it.
+		*n = node{}
+		if left == nil {
+			return right, true
+		}
+		if right == nil {
+			return left, true
+		}
+		var min *node
+		right, min = removeMin(right)
+		min.left, min.right = left, right
+		return balance(min), true
+	}
+	if !removed {
+		return n, false
+	}
+	return balance(n), true
+}
+
+// removeMin removes the leftmost node of the subtree rooted at n, and
+// returns the new root of the subtree and the removed node.
+func removeMin(n *node) (*node, *node) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var min *node
+	n.left, min = removeMin(n.left)
+	return balance(n), min
+}
@@ -0,0 +1,297 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/randutil"
+)
+
+type intKey int
+
+// Compare implements Comparable.
+func (k intKey) Compare(b Comparable) int {
+	switch b := b.(intKey); {
+	case k < b:
+		return -1
+	case k > b:
+		return 1
+	}
+	return 0
+}
+
+type ival struct {
+	id         uintptr
+	start, end intKey
+}
+
+func (iv *ival) Start() Comparable { return iv.start }
+func (iv *ival) End() Comparable   { return iv.end }
+func (iv *ival) ID() uintptr       { return iv.id }
+
+func (iv *ival) String() string {
+	return fmt.Sprintf("%d:[%d,%d)", iv.id, iv.start, iv.end)
+}
+
+// byStartAndID sorts intervals in the order of the tree.
+type byStartAndID []*ival
+
+func (s byStartAndID) Len() int      { return len(s) }
+func (s byStartAndID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byStartAndID) Less(i, j int) bool {
+	if s[i].start != s[j].start {
+		return s[i].start < s[j].start
+	}
+	return s[i].id < s[j].id
+}
+
+// checkInvariants verifies the order, the balance, the heights and the
+// maximum ends of the nodes of the tree.
+func checkInvariants(t *testing.T, tree *Tree) {
+	var last *node
+	var check func(n *node) int
+	check = func(n *node) int {
+		if n == nil {
+			return 0
+		}
+		hl := check(n.left)
+		if last != nil && last.compare(n.start, n.id) <= 0 {
+			t.Fatalf("%v is not ordered after %v", n.elem, last.elem)
+		}
+		last = n
+		hr := check(n.right)
+		if d := hl - hr; d < -1 || d > 1 {
+			t.Fatalf("unbalanced node %v: heights %d and %d", n.elem, hl, hr)
+		}
+		h := hl
+		if hr > h {
+			h = hr
+		}
+		if h++; n.height != h {
+			t.Fatalf("expected height %d of %v, got %d", h, n.elem, n.height)
+		}
+		maxEnd := n.end
+		for _, c := range []*node{n.left, n.right} {
+			if c != nil && c.maxEnd.Compare(maxEnd) > 0 {
+				maxEnd = c.maxEnd
+			}
+		}
+		if n.maxEnd.Compare(maxEnd) != 0 {
+			t.Fatalf("expected max end %v of %v, got %v", maxEnd, n.elem, n.maxEnd)
+		}
+		return h
+	}
+	check(tree.root)
+}
+
+func collect(tree *Tree, r Range) []*ival {
+	var res []*ival
+	tree.DoMatching(func(e Interface) bool {
+		res = append(res, e.(*ival))
+		return false
+	}, r)
+	return res
+}
+
+func TestTree(t *testing.T) {
+	var tree Tree
+	ivs := []*ival{
+		{1, 2, 5}, {2, 0, 1}, {3, 4, 6}, {4, 2, 3}, {5, 7, 9}, {6, 2, 5},
+	}
+	if err := tree.Insert(ivs[0]); err != nil {
+		t.Fatal(err)
+	}
+	es := make([]Interface, 0, len(ivs)-1)
+	for _, iv := range ivs[1:] {
+		es = append(es, iv)
+	}
+	if err := tree.InsertMany(es); err != nil {
+		t.Fatal(err)
+	}
+	if l := tree.Len(); l != len(ivs) {
+		t.Fatalf("expected %d intervals, got %d", len(ivs), l)
+	}
+	checkInvariants(t, &tree)
+
+	testCases := []struct {
+		start, end intKey
+		expected   []uintptr
+	}{
+		{0, 10, []uintptr{2, 1, 4, 6, 3, 5}},
+		{1, 2, nil},
+		{0, 2, []uintptr{2}},
+		{2, 3, []uintptr{1, 4, 6}},
+		{4, 5, []uintptr{1, 6, 3}},
+		{5, 7, []uintptr{3}},
+		{6, 7, nil},
+		{8, 20, []uintptr{5}},
+		// Inverted ranges don't overlap anything.
+		{5, 2, nil},
+	}
+	for i, c := range testCases {
+		var ids []uintptr
+		for _, iv := range collect(&tree, &ival{start: c.start, end: c.end}) {
+			ids = append(ids, iv.id)
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+			t.Errorf("%d: expected [%d,%d) to overlap %v, got %v", i, c.start, c.end, c.expected, ids)
+		}
+	}
+
+	// Interrupt traversals.
+	var n int
+	if !tree.DoMatching(func(Interface) bool { n++; return n == 2 }, &ival{start: 0, end: 10}) || n != 2 {
+		t.Errorf("expected DoMatching to be interrupted after 2 intervals, got %d", n)
+	}
+	n = 0
+	if !tree.Do(func(Interface) bool { n++; return n == 3 }) || n != 3 {
+		t.Errorf("expected Do to be interrupted after 3 intervals, got %d", n)
+	}
+
+	// Deleting an interval requires its start and its ID.
+	if tree.Delete(&ival{id: 1, start: 3, end: 5}) || tree.Delete(&ival{id: 7, start: 2, end: 5}) {
+		t.Error("expected mismatching intervals not to be deleted")
+	}
+	if !tree.Delete(&ival{id: 1, start: 2}) {
+		t.Error("expected interval 1 to be deleted")
+	}
+	if l := tree.Len(); l != len(ivs)-1 {
+		t.Fatalf("expected %d intervals, got %d", len(ivs)-1, l)
+	}
+	checkInvariants(t, &tree)
+	if es := tree.Get(&ival{start: 2, end: 3}); len(es) != 2 || es[0] != ivs[3] || es[1] != ivs[5] {
+		t.Errorf("expected intervals 4 and 6, got %v", es)
+	}
+}
+
+func TestTreeInvalidRange(t *testing.T) {
+	var tree Tree
+	if err := tree.Insert(&ival{id: 1, start: 1, end: 1}); err != ErrEmptyRange {
+		t.Errorf("expected %v, got %v", ErrEmptyRange, err)
+	}
+	if err := tree.Insert(&ival{id: 1, start: 2, end: 1}); err != ErrInvertedRange {
+		t.Errorf("expected %v, got %v", ErrInvertedRange, err)
+	}
+	// None of the intervals is inserted if one of them is invalid.
+	if err := tree.InsertMany([]Interface{&ival{id: 1, start: 0, end: 1}, &ival{id: 2, start: 2, end: 1}}); err != ErrInvertedRange {
+		t.Errorf("expected %v, got %v", ErrInvertedRange, err)
+	}
+	if l := tree.Len(); l != 0 {
+		t.Errorf("expected empty tree, got %d intervals", l)
+	}
+}
+
+// TestTreeRandomized compares the tree to a naive slice of intervals,
+// under random insertions, deletions and queries.
+func TestTreeRandomized(t *testing.T) {
+	rng, _ := randutil.NewPseudoRand()
+	randIval := func(id uintptr, maxKey int) *ival {
+		start := intKey(rng.Intn(maxKey))
+		return &ival{id: id, start: start, end: start + 1 + intKey(rng.Intn(maxKey/4+1))}
+	}
+
+	for _, maxKey := range []int{10, 100, 10000} {
+		var tree Tree
+		var naive []*ival
+		var nextID uintptr
+		for i := 0; i < 2000; i++ {
+			switch op := rng.Intn(10); {
+			case op < 4:
+				// Insert a batch of intervals.
+				es := make([]Interface, rng.Intn(4)+1)
+				for j := range es {
+					nextID++
+					iv := randIval(nextID, maxKey)
+					es[j] = iv
+					naive = append(naive, iv)
+				}
+				if err := tree.InsertMany(es); err != nil {
+					t.Fatal(err)
+				}
+			case op < 7 && len(naive) > 0:
+				// Delete an interval.
+				j := rng.Intn(len(naive))
+				if !tree.Delete(naive[j]) {
+					t.Fatalf("failed to delete %v", naive[j])
+				}
+				naive = append(naive[:j], naive[j+1:]...)
+			default:
+				// Query the intervals overlapping a random range.
+				r := randIval(0, maxKey)
+				var expected []*ival
+				for _, iv := range naive {
+					if iv.start < r.end && r.start < iv.end {
+						expected = append(expected, iv)
+					}
+				}
+				sort.Sort(byStartAndID(expected))
+				if actual := collect(&tree, r); fmt.Sprint(actual) != fmt.Sprint(expected) {
+					t.Fatalf("expected %v to overlap %v, got %v", r, expected, actual)
+				}
+			}
+			if l := tree.Len(); l != len(naive) {
+				t.Fatalf("expected %d intervals, got %d", len(naive), l)
+			}
+		}
+		checkInvariants(t, &tree)
+
+		var all []*ival
+		tree.Do(func(e Interface) bool {
+			all = append(all, e.(*ival))
+			return false
+		})
+		sort.Sort(byStartAndID(naive))
+		if fmt.Sprint(all) != fmt.Sprint(naive) {
+			t.Fatalf("expected intervals %v, got %v", naive, all)
+		}
+	}
+}
+
+func benchmarkTree(b *testing.B, n int) {
+	rng := rand.New(rand.NewSource(int64(n)))
+	ivs := make([]*ival, n)
+	for i := range ivs {
+		start := intKey(rng.Intn(1000 * n))
+		ivs[i] = &ival{id: uintptr(i), start: start, end: start + 1 + intKey(rng.Intn(10))}
+	}
+	var tree Tree
+	for _, iv := range ivs {
+		if err := tree.Insert(iv); err != nil {
+			b.Fatal(err)
+		}
+	}
+	fn := func(Interface) bool { return false }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		iv := ivs[i%n]
+		tree.DoMatching(fn, iv)
+		tree.Delete(iv)
+		if err := tree.Insert(iv); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTree100(b *testing.B) {
+	benchmarkTree(b, 100)
+}
+
+func BenchmarkTree10000(b *testing.B) {
+	benchmarkTree(b, 10000)
+}
@@ -0,0 +1,197 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package leaktest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// A Pattern matches the stack traces of goroutines which are expected to
+// outlive the tests, such as the background goroutines of libraries.
+type Pattern interface {
+	// Match returns whether the stack trace of a goroutine, without its
+	// header line, matches the pattern.
+	Match(stack string) bool
+}
+
+type funcPattern string
+
+// Func returns a pattern matching the goroutines which have a frame in the
+// given function, or which were created by it. The name must be fully
+// qualified as it appears in stack traces, e.g. "os/signal.loop" or
+// "google.golang.org/grpc.(*Server).Serve".
+func Func(name string) Pattern {
+	return funcPattern(name)
+}
+
+// Match implements the Pattern interface.
+func (p funcPattern) Match(stack string) bool {
+	for _, line := range strings.Split(stack, "\n") {
+		if stackFunc(line) == string(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// stackFunc returns the name of the function on a line of a stack trace,
+// or the empty string if the line doesn't hold one.
+func stackFunc(line string) string {
+	if strings.HasPrefix(line, "\t") {
+		// A file:line line.
+		return ""
+	}
+	line = strings.TrimPrefix(line, "created by ")
+	if i := strings.Index(line, " in goroutine "); i >= 0 {
+		line = line[:i]
+	}
+	if strings.HasSuffix(line, ")") {
+		// Strip the arguments.
+		if i := strings.LastIndex(line, "("); i > 0 {
+			line = line[:i]
+		}
+	}
+	return line
+}
+
+type regexpPattern struct {
+	re *regexp.Regexp
+}
+
+// Regexp returns a pattern matching the goroutines whose stack trace
+// matches the given regular expression. It panics if the expression
+// doesn't compile.
+func Regexp(expr string) Pattern {
+	return regexpPattern{re: regexp.MustCompile(expr)}
+}
+
+// Match implements the Pattern interface.
+func (p regexpPattern) Match(stack string) bool {
+	return p.re.MatchString(stack)
+}
+
+// allowed holds the registered patterns of goroutines which are not
+// reported as leaked.
+var allowed struct {
+	sync.Mutex
+	global []Pattern
+	byTest map[testing.TB][]Pattern
+}
+
+// Allow registers a pattern of goroutines which are not reported as leaked
+// by any test, nor by TestMainWithLeakCheck. It is meant to be called from
+// the init function of packages which start long-lived goroutines, or of
+// their tests.
+func Allow(p Pattern) {
+	allowed.Lock()
+	defer allowed.Unlock()
+	allowed.global = append(allowed.global, p)
+}
+
+// AllowInTest registers a pattern of goroutines which are not reported as
+// leaked by the AfterTest call of the given test. The pattern is removed
+// again by that call.
+func AllowInTest(t testing.TB, p Pattern) {
+	allowed.Lock()
+	defer allowed.Unlock()
+	if allowed.byTest == nil {
+		allowed.byTest = map[testing.TB][]Pattern{}
+	}
+	allowed.byTest[t] = append(allowed.byTest[t], p)
+}
+
+// removeTestPatterns removes and returns the patterns registered for the
+// given test.
+func removeTestPatterns(t testing.TB) []Pattern {
+	allowed.Lock()
+	defer allowed.Unlock()
+	patterns := allowed.byTest[t]
+	delete(allowed.byTest, t)
+	return patterns
+}
+
+// isAllowed returns whether the stack matches one of the global patterns or
+// of the given ones.
+func isAllowed(stack string, patterns []Pattern) bool {
+	allowed.Lock()
+	defer allowed.Unlock()
+	for _, ps := range [][]Pattern{allowed.global, patterns} {
+		for _, p := range ps {
+			if p.Match(stack) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// creator returns the function which created the goroutine with the given
+// stack trace, or "main" if there is none.
+func creator(stack string) string {
+	for _, line := range strings.Split(stack, "\n") {
+		if strings.HasPrefix(line, "created by ") {
+			return stackFunc(line)
+		}
+	}
+	return "main"
+}
+
+// leakGroup is a group of leaked goroutines created by the same function.
+type leakGroup struct {
+	creator string
+	count   int
+	stack   string // the stack trace of one of the goroutines
+}
+
+// leakGroups sorts groups by decreasing count, and then by creator.
+type leakGroups []*leakGroup
+
+func (lg leakGroups) Len() int      { return len(lg) }
+func (lg leakGroups) Swap(i, j int) { lg[i], lg[j] = lg[j], lg[i] }
+func (lg leakGroups) Less(i, j int) bool {
+	if lg[i].count != lg[j].count {
+		return lg[i].count > lg[j].count
+	}
+	return lg[i].creator < lg[j].creator
+}
+
+// describeLeaks groups the stack traces of the leaked goroutines by the
+// function which created them, and describes each group by its count and
+// one of its stacks, most numerous first.
+func describeLeaks(stacks []string) string {
+	var groups leakGroups
+	byCreator := map[string]*leakGroup{}
+	for _, stack := range stacks {
+		c := creator(stack)
+		g, ok := byCreator[c]
+		if !ok {
+			g = &leakGroup{creator: c, stack: stack}
+			byCreator[c] = g
+			groups = append(groups, g)
+		}
+		g.count++
+	}
+	sort.Sort(groups)
+	var descs []string
+	for _, g := range groups {
+		descs = append(descs, fmt.Sprintf("%d goroutine(s) created by %s, e.g.:\n%s", g.count, g.creator, g.stack))
+	}
+	return strings.Join(descs, "\n\n")
+}
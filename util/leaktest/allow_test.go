@@ -0,0 +1,135 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package leaktest
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+const testStack = `github.com/cockroachdb/cockroach/util/stop.(*Stopper).RunWorker.func1(0xc820010f60, 0xc8201a4000)
+	/go/src/github.com/cockroachdb/cockroach/util/stop/stopper.go:93 +0x52
+google.golang.org/grpc.(*Server).Serve(0xc8200c4000)
+	/go/src/google.golang.org/grpc/server.go:301 +0x1a5
+created by net/http.(*Server).Serve
+	/usr/local/go/src/net/http/server.go:1910 +0x3f6`
+
+func TestFuncPattern(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect bool
+	}{
+		{"google.golang.org/grpc.(*Server).Serve", true},
+		{"github.com/cockroachdb/cockroach/util/stop.(*Stopper).RunWorker.func1", true},
+		{"net/http.(*Server).Serve", true},
+		// Function names must match exactly.
+		{"google.golang.org/grpc.(*Server)", false},
+		{"github.com/cockroachdb/cockroach/util/stop.(*Stopper).RunWorker", false},
+		{"(*Server).Serve", false},
+		{"server.go", false},
+	}
+	for i, c := range testCases {
+		if a := Func(c.name).Match(testStack); a != c.expect {
+			t.Errorf("%d: expected match of %q to be %t", i, c.name, c.expect)
+		}
+	}
+	// Go 1.21 and later note the goroutine of the creator.
+	if !Func("os/signal.loop").Match("created by os/signal.loop in goroutine 1\n\t/usr/local/go/src/os/signal/signal_unix.go:22") {
+		t.Error("expected creator with goroutine to match")
+	}
+}
+
+func TestRegexpPattern(t *testing.T) {
+	testCases := []struct {
+		expr   string
+		expect bool
+	}{
+		{`grpc\.\(\*Server\)\.Serve`, true},
+		{`stopper\.go:\d+`, true},
+		{`^created by`, false},
+		{`(?m)^created by net/http\.`, true},
+	}
+	for i, c := range testCases {
+		if a := Regexp(c.expr).Match(testStack); a != c.expect {
+			t.Errorf("%d: expected match of %q to be %t", i, c.expr, c.expect)
+		}
+	}
+}
+
+// blockInLeakTest blocks until the channel is closed.
+func blockInLeakTest(c chan struct{}) {
+	<-c
+}
+
+// TestAllowInTest verifies that the goroutines matching the patterns
+// registered for a test are not reported, until they are removed.
+func TestAllowInTest(t *testing.T) {
+	name := runtime.FuncForPC(reflect.ValueOf(blockInLeakTest).Pointer()).Name()
+	isLeaked := func(patterns []Pattern) bool {
+		for _, stack := range interestingGoroutines(patterns) {
+			if strings.Contains(stack, name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	c := make(chan struct{})
+	go blockInLeakTest(c)
+	defer close(c)
+	// Wait for the goroutine to block.
+	for !isLeaked(nil) {
+		runtime.Gosched()
+	}
+
+	AllowInTest(t, Func(name))
+	allowed.Lock()
+	patterns := allowed.byTest[t]
+	allowed.Unlock()
+	if isLeaked(patterns) {
+		t.Errorf("expected goroutine in %s to be allowed", name)
+	}
+	if removed := removeTestPatterns(t); len(removed) != 1 {
+		t.Errorf("expected one pattern to be removed, got %d", len(removed))
+	}
+	if removed := removeTestPatterns(t); len(removed) != 0 {
+		t.Errorf("expected no patterns to be left, got %d", len(removed))
+	}
+}
+
+func TestDescribeLeaks(t *testing.T) {
+	stacks := []string{
+		"a()\n\ta.go:1\ncreated by x.spawn\n\tx.go:2",
+		"b()\n\tb.go:1\ncreated by y.spawn\n\ty.go:2",
+		"c()\n\tc.go:1\ncreated by y.spawn\n\ty.go:3",
+		"main.main()\n\tmain.go:1",
+	}
+	desc := describeLeaks(stacks)
+	expected := []string{
+		"2 goroutine(s) created by y.spawn, e.g.:\nb()",
+		"1 goroutine(s) created by main, e.g.:\nmain.main()",
+		"1 goroutine(s) created by x.spawn, e.g.:\na()",
+	}
+	last := -1
+	for _, e := range expected {
+		i := strings.Index(desc, e)
+		if i <= last {
+			t.Fatalf("expected %q after position %d in:\n%s", e, last, desc)
+		}
+		last = i
+	}
+}
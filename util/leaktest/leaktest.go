@@ -22,9 +22,16 @@ import (
 
 var hasFailed int32 // updated atomially (though not necessary in normal usage)
 
+func init() {
+	// The log flushing daemon runs for the lifetime of the process.
+	Allow(Regexp(`created by github\.com/cockroachdb/cockroach/util/log\.init`))
+	Allow(Func("os/signal.loop"))
+}
+
 // TestMainWithLeakCheck is an implementation of TestMain which verifies that
 // there are no leaked goroutines at the end of the run (except those created
-// by the system which are on a whitelist). Usage:
+// by the system which are on a whitelist, and those matching the patterns
+// registered with Allow). Usage:
 //
 //
 // // Adjust the relative path as needed.
@@ -41,7 +48,10 @@ func TestMainWithLeakCheck(m *testing.M) {
 	os.Exit(v)
 }
 
-func interestingGoroutines() (gs []string) {
+// interestingGoroutines returns the stack traces of the running goroutines,
+// except those which are on the whitelist or match the patterns registered
+// with Allow or the given ones.
+func interestingGoroutines(patterns []Pattern) (gs []string) {
 	buf := make([]byte, 2<<20)
 	buf = buf[:runtime.Stack(buf, true)]
 	for _, g := range strings.Split(string(buf), "\n\n") {
@@ -60,8 +70,7 @@ func interestingGoroutines() (gs []string) {
 			strings.Contains(stack, "created by runtime.gc") ||
 			strings.Contains(stack, "github.com/cockroachdb/cockroach/util/leaktest.interestingGoroutines") ||
 			strings.Contains(stack, "runtime.MHeap_Scavenger") ||
-			strings.Contains(stack, "github.com/cockroachdb/cockroach/util/log.init") ||
-			strings.Contains(stack, "os/signal.loop") {
+			isAllowed(stack, patterns) {
 			continue
 		}
 		gs = append(gs, stack)
@@ -76,26 +85,15 @@ func goroutineLeaked() bool {
 		// not counting goroutines for leakage in -short mode
 		return false
 	}
-	var stackCount map[string]int
+	var gs []string
 	for i := 0; i < 8; i++ {
-		gs := interestingGoroutines()
-
-		n := 0
-		stackCount = make(map[string]int)
-		for _, g := range gs {
-			stackCount[g]++
-			n++
-		}
-
-		if n == 0 {
+		gs = interestingGoroutines(nil)
+		if len(gs) == 0 {
 			return false
 		}
 		time.Sleep(10 * time.Millisecond)
 	}
-	fmt.Fprintf(os.Stderr, "Too many goroutines running after tests.\n")
-	for stack, count := range stackCount {
-		fmt.Fprintf(os.Stderr, "%d instances of:\n%s\n", count, stack)
-	}
+	fmt.Fprintf(os.Stderr, "Too many goroutines running after tests.\n%s\n", describeLeaks(gs))
 	return true
 }
 
@@ -104,8 +102,10 @@ func goroutineLeaked() bool {
 // on a blacklist to terminate and provides more precise error reporting
 // than TestMainWithLeakCheck alone.
 // If a previous test's check has already failed, this is a noop (to avoid
-// failing unrelated tests).
+// failing unrelated tests). The patterns registered for the test with
+// AllowInTest are removed.
 func AfterTest(t testing.TB) {
+	patterns := removeTestPatterns(t)
 	if atomic.LoadInt32(&hasFailed) > 0 {
 		t.Log("prior leak detected, leaktest disabled")
 		return
@@ -137,10 +137,11 @@ func AfterTest(t testing.TB) {
 		"(*Store).Start":                               "a store",
 		"(*Range).Send":                                "a range command",
 	}
-	var stacks string
+	var gs []string
 	for i := 0; i < 8; i++ {
 		bad = ""
-		stacks = strings.Join(interestingGoroutines(), "\n\n")
+		gs = interestingGoroutines(patterns)
+		stacks := strings.Join(gs, "\n\n")
 		for substr, what := range badSubstring {
 			if strings.Contains(stacks, substr) {
 				bad = what
@@ -154,5 +155,5 @@ func AfterTest(t testing.TB) {
 		time.Sleep(10 * time.Millisecond)
 	}
 	atomic.StoreInt32(&hasFailed, 1)
-	t.Errorf("Test appears to have leaked %s:\n%s", bad, stacks)
+	t.Errorf("Test appears to have leaked %s:\n%s", bad, describeLeaks(gs))
 }
@@ -357,23 +357,37 @@ func (t *traceLocation) Set(value string) error {
 }
 
 // EntryDecoder reads successive encoded log entries from the input
-// buffer. Each entry is preceded by a single big-ending uint32
-// describing the next entry's length.
+// buffer. Each entry is either preceded by a single big-ending uint32
+// describing the next entry's length, or written in the JSON format
+// on a line of its own.
 type EntryDecoder struct {
-	in io.Reader
+	in *bufio.Reader
 }
 
 // NewEntryDecoder creates a new instance of EntryDecoder.
 func NewEntryDecoder(in io.Reader) *EntryDecoder {
-	return &EntryDecoder{in: in}
+	return &EntryDecoder{in: bufio.NewReader(in)}
 }
 
 // Decode decodes the next log entry into the provided protobuf message.
 func (lr *EntryDecoder) Decode(entry *LogEntry) error {
+	// An entry in the JSON format starts with an opening brace, which can't
+	// be the first byte of the length of an encoded entry, as that would
+	// exceed any reasonable entry size.
+	first, err := lr.in.Peek(1)
+	if err != nil {
+		return err
+	}
+	if first[0] == '{' {
+		line, err := lr.in.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return err
+		}
+		return decodeJSONEntry(line, entry)
+	}
 	// Read the next log entry.
 	szBuf := make([]byte, 4)
-	n, err := lr.in.Read(szBuf)
-	if err != nil {
+	if _, err := io.ReadFull(lr.in, szBuf); err != nil {
 		return err
 	}
 	_, sz, err := encoding.DecodeUint32(szBuf)
@@ -381,14 +395,10 @@ func (lr *EntryDecoder) Decode(entry *LogEntry) error {
 		return err
 	}
 	buf := make([]byte, sz)
-	n, err = lr.in.Read(buf)
-	if err != nil {
+	if _, err := io.ReadFull(lr.in, buf); err != nil {
 		return err
 	}
-	if err := proto.Unmarshal(buf[:n], entry); err != nil {
-		return err
-	}
-	return nil
+	return proto.Unmarshal(buf, entry)
 }
 
 type baseEntryReader struct {
@@ -577,17 +587,32 @@ func (buf *buffer) someDigits(i, d int) int {
 	return copy(buf.tmp[i:], buf.tmp[j:])
 }
 
-func formatLogEntry(entry *LogEntry, colors *colorProfile) []byte {
-	buf := formatHeader(Severity(entry.Severity), time.Unix(entry.Time/1E9, entry.Time%1E9), entry.ThreadID, entry.File, entry.Line, colors)
+// entryMessage returns the message of a log entry, formatted from its
+// format string and arguments.
+func entryMessage(entry *LogEntry) string {
 	var args []interface{}
 	for _, arg := range entry.Args {
 		args = append(args, arg.Str)
 	}
 	if len(entry.Format) == 0 {
-		fmt.Fprint(buf, args...)
-	} else {
-		fmt.Fprintf(buf, entry.Format, args...)
+		return fmt.Sprint(args...)
 	}
+	return fmt.Sprintf(entry.Format, args...)
+}
+
+func formatLogEntry(entry *LogEntry, colors *colorProfile) []byte {
+	buf := formatHeader(Severity(entry.Severity), time.Unix(entry.Time/1E9, entry.Time%1E9), entry.ThreadID, entry.File, entry.Line, colors)
+	if len(entry.Fields) > 0 {
+		_ = buf.WriteByte('[')
+		for i, field := range entry.Fields {
+			if i > 0 {
+				_ = buf.WriteByte(',')
+			}
+			fmt.Fprintf(buf, "%s=%s", field.Key, field.Value)
+		}
+		buf.WriteString("] ")
+	}
+	buf.WriteString(entryMessage(entry))
 	_ = buf.WriteByte('\n')
 	if len(entry.Stacks) > 0 {
 		buf.Write(entry.Stacks)
@@ -623,6 +648,8 @@ type loggingT struct {
 
 	// Level flag. Handled atomically.
 	stderrThreshold Severity // The -stderrthreshold flag.
+	// Format flag. Handled atomically.
+	format logFormat // The --log-format flag.
 
 	// freeList is a list of byte buffers, maintained under freeListMu.
 	freeList *buffer
@@ -727,6 +754,7 @@ func (l *loggingT) outputLogEntry(s Severity, file string, line int, alsoToStder
 	entry.Severity = int32(s)
 	entry.Time = now.UnixNano()
 	entry.ThreadID = int32(pid) // TODO: should be TID
+	entry.Goroutine = goroutineID()
 	entry.File = file
 	entry.Line = int32(line)
 	// On fatal log, set all stacks.
@@ -738,6 +766,8 @@ func (l *loggingT) outputLogEntry(s Severity, file string, line int, alsoToStder
 			entry.Stacks = stacks(false)
 		}
 	}
+	recent.add(*entry)
+	sendToSinks(entry)
 
 	if l.toStderr {
 		if _, err := os.Stderr.Write(l.processForStderr(entry)); err != nil {
@@ -759,7 +789,7 @@ func (l *loggingT) outputLogEntry(s Severity, file string, line int, alsoToStder
 			}
 		}
 
-		data := encodeLogEntry(entry)
+		data := l.encodeForFile(entry)
 
 		switch s {
 		case FatalLog:
@@ -812,8 +842,21 @@ func encodeLogEntry(entry *LogEntry) []byte {
 	return append(data, entryData...)
 }
 
-// processForStderr formats a log entry for output to standard error.
+// encodeForFile encodes a log entry for output to the log files in the
+// selected format.
+func (l *loggingT) encodeForFile(entry *LogEntry) []byte {
+	if l.format.get() == jsonFormat {
+		return formatJSONEntry(entry)
+	}
+	return encodeLogEntry(entry)
+}
+
+// processForStderr formats a log entry for output to standard error in the
+// selected format. Only the text format is colorized.
 func (l *loggingT) processForStderr(entry *LogEntry) []byte {
+	if l.format.get() == jsonFormat {
+		return formatJSONEntry(entry)
+	}
 	return formatLogEntry(entry, l.shouldColorize())
 }
 
@@ -911,9 +954,10 @@ func (l *loggingT) exit(err error) {
 type syncBuffer struct {
 	logger *loggingT
 	*bufio.Writer
-	file   *os.File
-	sev    Severity
-	nbytes uint64 // The number of bytes written to this file
+	file    *os.File
+	sev     Severity
+	nbytes  uint64    // The number of bytes written to this file
+	created time.Time // The time this file is named after
 }
 
 func (sb *syncBuffer) Sync() error {
@@ -934,22 +978,35 @@ func (sb *syncBuffer) Write(p []byte) (n int, err error) {
 	return
 }
 
-// rotateFile closes the syncBuffer's file and starts a new one.
+// rotateFile closes the syncBuffer's file and starts a new one. The new
+// file is created before the current one is closed, so that the current
+// file remains in use if the new one can't be created. Log files beyond
+// the retention limits are removed afterwards.
 func (sb *syncBuffer) rotateFile(now time.Time) error {
+	// Log files are named after the second they were created in, so a file
+	// which rolls over within the same second as its predecessor is named
+	// after a later second, keeping the files in order.
+	t := now
+	if next := sb.created.Add(time.Second); t.Before(next) {
+		t = next
+	}
+	f, fname, err := create(sb.sev, t)
+	if err != nil {
+		return err
+	}
 	if sb.file != nil {
 		if err := sb.Flush(); err != nil {
+			_ = f.Close() // ignore err
 			return err
 		}
 		if err := sb.file.Close(); err != nil {
+			_ = f.Close() // ignore err
 			return err
 		}
 	}
-	var err error
-	sb.file, _, err = create(sb.sev, now)
+	sb.file = f
 	sb.nbytes = 0
-	if err != nil {
-		return err
-	}
+	sb.created = t
 
 	sb.Writer = bufio.NewWriterSize(sb.file, bufferSize)
 
@@ -966,13 +1023,14 @@ func (sb *syncBuffer) rotateFile(now time.Time) error {
 			Line:   int32(line),
 			Format: format,
 		}
-		n, err := sb.file.Write(encodeLogEntry(&entry))
+		n, err := sb.file.Write(sb.logger.encodeForFile(&entry))
 		if err != nil {
 			panic(err)
 		}
 		sb.nbytes += uint64(n)
 	}
-	return err
+	removeOldFiles(sb.sev, filepath.Base(fname), now)
+	return nil
 }
 
 // bufferSize sizes the buffer associated with each log file. It's large
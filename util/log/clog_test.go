@@ -24,6 +24,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -162,7 +163,9 @@ func TestJSONLogFormat(t *testing.T) {
   "file": "clog_test.go",
   "line": [\d]+,
   "format": "test",
-  "args": null
+  "args": null,
+  "fields": null,
+  "goroutine": [\d]+
 }`
 	if !regexp.MustCompile(expPat).Match(json) {
 		t.Errorf("expected json match; got %s", json)
@@ -466,6 +469,109 @@ func TestRollover(t *testing.T) {
 	}
 }
 
+// TestRolloverRetention verifies that log files roll over once they exceed
+// MaxSize, that the oldest files beyond the retention limits are removed,
+// and that the symlink keeps pointing at the current log file.
+func TestRolloverRetention(t *testing.T) {
+	setFlags()
+	dir, err := ioutil.TempDir("", "TestRolloverRetention")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	defer func(previous string) { *logDir = previous }(*logDir)
+	*logDir = dir
+	defer logging.swap(logging.swap([NumSeverity]flushSyncWriter{}))
+	defer func() {
+		if err := logging.removeFiles(); err != nil {
+			t.Error(err)
+		}
+	}()
+	defer func(previous func(error)) { logExitFunc = previous }(logExitFunc)
+	logExitFunc = func(e error) {
+		t.Error(e)
+	}
+	defer func(size uint64, files int, totalSize uint64, age time.Duration) {
+		MaxSize, MaxFiles, MaxTotalSize, MaxAge = size, files, totalSize, age
+	}(MaxSize, MaxFiles, MaxTotalSize, MaxAge)
+	MaxSize, MaxFiles, MaxTotalSize, MaxAge = 512, 0, 0, 0
+
+	// rollover writes entries which each roll over to a new log file, and
+	// returns the name of the current log file and the info log files.
+	rollover := func(n int) (string, []FileInfo) {
+		for i := 0; i < n; i++ {
+			Info(strings.Repeat("x", int(MaxSize)))
+		}
+		current := filepath.Base(logging.file[InfoLog].(*syncBuffer).file.Name())
+		link, err := os.Readlink(filepath.Join(dir, removePeriods(program)+".INFO"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if link != current {
+			t.Errorf("expected symlink to point at %s, got %s", current, link)
+		}
+		logFiles, err := ListLogFiles()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files := sortableFileInfoSlice{}
+		var found bool
+		for _, logFile := range logFiles {
+			if logFile.Details.Severity == InfoLog {
+				files = append(files, logFile)
+				found = found || logFile.Name == current
+			}
+		}
+		if !found {
+			t.Errorf("current log file %s is not listed in %v", current, files)
+		}
+		sort.Sort(files)
+		return current, files
+	}
+
+	// Without limits, every rollover creates a new file.
+	if _, files := rollover(4); len(files) != 5 {
+		t.Fatalf("expected 5 log files, got %d", len(files))
+	}
+
+	MaxFiles = 3
+	if _, files := rollover(4); len(files) != MaxFiles {
+		t.Fatalf("expected %d log files, got %d", MaxFiles, len(files))
+	}
+
+	// The size of the current log file changes as it is written to, but the
+	// rolled over files must fit the budget.
+	MaxFiles, MaxTotalSize = 0, 4*MaxSize
+	current, files := rollover(8)
+	var totalSize uint64
+	for _, file := range files {
+		if file.Name != current {
+			totalSize += uint64(file.SizeBytes)
+		}
+	}
+	if totalSize > MaxTotalSize || len(files) < 2 || len(files) > 8 {
+		t.Fatalf("expected the log files to fit %d bytes, got %d files of %d bytes",
+			MaxTotalSize, len(files), totalSize)
+	}
+
+	// Files which weren't written to for longer than the maximum age are
+	// removed on the next rollover.
+	MaxTotalSize, MaxAge = 0, time.Hour
+	_, files = rollover(2)
+	old := time.Now().Add(-2 * MaxAge)
+	if err := os.Chtimes(filepath.Join(dir, files[0].Name), old, old); err != nil {
+		t.Fatal(err)
+	}
+	_, newFiles := rollover(1)
+	if len(newFiles) != len(files) || newFiles[0].Name == files[0].Name {
+		t.Errorf("expected %s to be removed, got %v", files[0].Name, newFiles)
+	}
+}
+
 func TestLogBacktraceAt(t *testing.T) {
 	setFlags()
 	defer logging.swap(logging.newBuffers())
@@ -16,7 +16,11 @@
 
 package log
 
-import "golang.org/x/net/context"
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+)
 
 // Add takes a context and an additional even number of arguments,
 // interpreted as key-value pairs. These are added on top of the
@@ -31,3 +35,36 @@ func Add(ctx context.Context, kvs ...interface{}) context.Context {
 	}
 	return ctx
 }
+
+// fieldsKey is the context key under which WithFields stores the
+// structured key/value context of log entries.
+type fieldsKey struct{}
+
+// WithFields takes a context and an additional even number of arguments,
+// interpreted as key-value pairs, and returns a context which attaches them
+// to the entries logged with it (e.g. through Infoc) in addition to those
+// attached by the supplied context. Keys and values are formatted in the
+// manner of fmt.Print; a key which is already attached is overridden.
+func WithFields(ctx context.Context, kvs ...interface{}) context.Context {
+	l := len(kvs)
+	if l%2 != 0 {
+		panic("WithFields called with odd number of arguments")
+	}
+	parent, _ := ctx.Value(fieldsKey{}).([]LogEntry_Field)
+	// The fields of the parent context are copied, as they may be shared
+	// by other contexts and log entries.
+	fields := make([]LogEntry_Field, len(parent), len(parent)+l/2)
+	copy(fields, parent)
+outer:
+	for i := 1; i < l; i += 2 {
+		field := LogEntry_Field{Key: fmt.Sprint(kvs[i-1]), Value: fmt.Sprint(kvs[i])}
+		for j := range fields {
+			if fields[j].Key == field.Key {
+				fields[j] = field
+				continue outer
+			}
+		}
+		fields = append(fields, field)
+	}
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
@@ -57,6 +57,22 @@
 //	--log-dir=""
 //		Log files will be written to this directory instead of the
 //		default temporary directory.
+//	--log-file-max-size=10485760
+//		Logging rolls over to a new file once the current log file
+//		exceeds this many bytes.
+//	--log-max-files=0
+//	--log-max-total-size=104857600
+//	--log-max-age=0
+//		Whenever a new log file is created, the oldest log files of its
+//		severity are removed until at most this many files, of at most
+//		this combined size, are left, and files which were last written
+//		to longer ago than the maximum age are removed. Zero disables a
+//		limit.
+//	--log-format="text"
+//		The format of log entries written to standard error and to the
+//		log files. With "json", each entry is written as a JSON object
+//		on a line of its own, holding the severity, time, goroutine,
+//		file:line, message and any context attached with WithFields.
 //
 //	Other flags provide aids to debugging.
 //
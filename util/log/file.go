@@ -35,9 +35,24 @@ import (
 	"time"
 )
 
-// MaxSize is the maximum size of a log file in bytes.
+// MaxSize is the maximum size of a log file in bytes. Once the current log
+// file of a severity exceeds it, logging rolls over to a new file.
 var MaxSize uint64 = 1024 * 1024 * 10
 
+// The retention limits of the log files of each severity, which are applied
+// whenever a new log file is created, removing the oldest files first. Zero
+// disables a limit.
+var (
+	// MaxFiles is the maximum number of log files kept, including the
+	// current one.
+	MaxFiles int
+	// MaxTotalSize is the maximum combined size of the log files kept, in
+	// bytes.
+	MaxTotalSize uint64 = 1024 * 1024 * 100
+	// MaxAge is the maximum time since a log file was last written to.
+	MaxAge time.Duration
+)
+
 // If non-empty, overrides the choice of directory in which to write logs.
 // See createLogDirs for the full list of possible destinations.
 var logDir *string
@@ -164,21 +179,82 @@ func create(severity Severity, t time.Time) (f *os.File, filename string, err er
 	if len(*logDir) == 0 {
 		return nil, "", errDirectoryNotSet
 	}
-	name, link := logName(severity, t)
-	var lastErr error
-	fname := filepath.Join(*logDir, name)
-
-	// Open the file os.O_APPEND|os.O_CREATE rather than use os.Create.
-	// Append is almost always more efficient than O_RDRW on most modern file systems.
-	f, err = os.OpenFile(fname, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0664)
-	if err == nil {
-		symlink := filepath.Join(*logDir, link)
-		_ = os.Remove(symlink)        // ignore err
-		_ = os.Symlink(name, symlink) // ignore err
+	for {
+		name, link := logName(severity, t)
+		fname := filepath.Join(*logDir, name)
+
+		// Open the file os.O_APPEND|os.O_CREATE rather than use os.Create.
+		// Append is almost always more efficient than O_RDRW on most modern file systems.
+		f, err = os.OpenFile(fname, os.O_APPEND|os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0664)
+		if os.IsExist(err) {
+			// File names only have a resolution of a second, so the file
+			// may have been created by another process with the same pid.
+			t = t.Add(time.Second)
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("log: cannot create log: %v", err)
+		}
+		updateSymlink(name, filepath.Join(*logDir, link))
 		return f, fname, nil
 	}
-	lastErr = err
-	return nil, "", fmt.Errorf("log: cannot create log: %v", lastErr)
+}
+
+// updateSymlink points symlink at the log file name, ignoring errors. A new
+// symlink is renamed over the existing one, so that the symlink refers to a
+// log file at all times.
+func updateSymlink(name, symlink string) {
+	tmp := symlink + ".tmp"
+	_ = os.Remove(tmp) // ignore err
+	if err := os.Symlink(name, tmp); err != nil {
+		return
+	}
+	if err := os.Rename(tmp, symlink); err != nil {
+		_ = os.Remove(tmp) // ignore err
+	}
+}
+
+// removeOldFiles removes the log files of severity written by this program on
+// this host which exceed the retention limits, oldest first. The current log
+// file, named current, is kept regardless of the limits.
+func removeOldFiles(severity Severity, current string, now time.Time) {
+	if MaxFiles == 0 && MaxTotalSize == 0 && MaxAge == 0 {
+		return
+	}
+	logFiles, err := ListLogFiles()
+	if err != nil {
+		return
+	}
+	files := sortableFileInfoSlice{}
+	for _, logFile := range logFiles {
+		if logFile.Details.Severity == severity &&
+			logFile.Details.Program == removePeriods(program) &&
+			logFile.Details.Host == removePeriods(host) {
+			files = append(files, logFile)
+		}
+	}
+	// Sort the files in reverse order so that the newest are kept.
+	sort.Sort(sort.Reverse(files))
+
+	var count int
+	var totalSize uint64
+	for _, file := range files {
+		count++
+		totalSize += uint64(file.SizeBytes)
+		if file.Name == current {
+			continue
+		}
+		if (MaxFiles > 0 && count > MaxFiles) ||
+			(MaxTotalSize > 0 && totalSize > MaxTotalSize) ||
+			(MaxAge > 0 && now.Sub(time.Unix(0, file.ModTimeNanos)) > MaxAge) {
+			if err := os.Remove(filepath.Join(*logDir, file.Name)); err != nil {
+				fmt.Fprintf(os.Stderr, "log: unable to remove log file %s: %v\n", file.Name, err)
+				continue
+			}
+			count--
+			totalSize -= uint64(file.SizeBytes)
+		}
+	}
 }
 
 var errNotAFile = errors.New("not a regular file")
@@ -336,6 +412,23 @@ func FetchEntriesFromFiles(severity Severity, startTimestamp, endTimestamp int64
 	return entries, nil
 }
 
+// entryMatches returns whether the formatted message, file or method of the
+// log entry match the regexp 'pattern'. All entries match a nil pattern.
+func entryMatches(entry LogEntry, pattern *regexp.Regexp) bool {
+	if pattern == nil {
+		return true
+	}
+	args := []interface{}{}
+	for _, arg := range entry.Args {
+		args = append(args, arg.Str)
+	}
+	logText := fmt.Sprintf(entry.Format, args...)
+
+	return pattern.MatchString(logText) ||
+		pattern.MatchString(entry.File) ||
+		((entry.Method != nil) && (pattern.MatchString(entry.Method.String())))
+}
+
 // readAllEntriesFromFile reads in all log entries from a given file that are
 // between the 'startTimestamp' and 'endTimestamp' and match the 'pattern' if it
 // exists. It returns the entries in the reverse chronological order. It also
@@ -360,21 +453,7 @@ func readAllEntriesFromFile(file FileInfo, startTimestamp, endTimestamp int64, m
 			}
 			return nil, false, err
 		}
-		var match bool
-		if pattern == nil {
-			match = true
-		} else {
-			args := []interface{}{}
-			for _, arg := range entry.Args {
-				args = append(args, arg.Str)
-			}
-			logText := fmt.Sprintf(entry.Format, args...)
-
-			match = pattern.MatchString(logText) ||
-				pattern.MatchString(entry.File) ||
-				((entry.Method != nil) && (pattern.MatchString(entry.Method.String())))
-		}
-		if match && entry.Time >= startTimestamp && entry.Time <= endTimestamp {
+		if entryMatches(entry, pattern) && entry.Time >= startTimestamp && entry.Time <= endTimestamp {
 			entries = append([]LogEntry{entry}, entries...)
 			if len(entries) >= maxEntries {
 				break
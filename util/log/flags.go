@@ -19,5 +19,6 @@ package log
 import "github.com/cockroachdb/cockroach/util/log/logflags"
 
 func init() {
-	logflags.InitFlags(&logging.mu, &logging.toStderr, &logging.alsoToStderr, logDir, &logging.color, &logging.verbosity, &logging.vmodule, &logging.traceLocation)
+	logflags.InitFlags(&logging.mu, &logging.toStderr, &logging.alsoToStderr, logDir, &logging.color, &logging.verbosity, &logging.vmodule, &logging.traceLocation, &logging.format,
+		&MaxSize, &MaxFiles, &MaxTotalSize, &MaxAge)
 }
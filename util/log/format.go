@@ -0,0 +1,190 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+// logFormat is the format in which log entries are written to standard
+// error and to the log files. It implements the flag.Value interface and
+// is handled atomically.
+type logFormat int32 // sync/atomic int32
+
+const (
+	// textFormat writes entries in the glog-style text format to standard
+	// error and as length-prefixed protos to the log files.
+	textFormat logFormat = iota
+	// jsonFormat writes each entry as a single line holding a JSON object.
+	jsonFormat
+)
+
+var logFormatName = []string{
+	textFormat: "text",
+	jsonFormat: "json",
+}
+
+// get returns the value of the logFormat.
+func (f *logFormat) get() logFormat {
+	return logFormat(atomic.LoadInt32((*int32)(f)))
+}
+
+// set sets the value of the logFormat.
+func (f *logFormat) set(val logFormat) {
+	atomic.StoreInt32((*int32)(f), int32(val))
+}
+
+// String is part of the flag.Value interface.
+func (f *logFormat) String() string {
+	return logFormatName[f.get()]
+}
+
+// Set is part of the flag.Value interface.
+func (f *logFormat) Set(value string) error {
+	for i, name := range logFormatName {
+		if name == strings.ToLower(value) {
+			f.set(logFormat(i))
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown log format %q, expected one of: %s", value, strings.Join(logFormatName, ", "))
+}
+
+// jsonEntry is the representation of a log entry in the JSON format.
+type jsonEntry struct {
+	Severity  string            `json:"severity"`
+	Time      string            `json:"time"`
+	Goroutine int64             `json:"goroutine"`
+	Caller    string            `json:"caller"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	NodeID    *roachpb.NodeID   `json:"node_id,omitempty"`
+	StoreID   *roachpb.StoreID  `json:"store_id,omitempty"`
+	RangeID   *roachpb.RangeID  `json:"range_id,omitempty"`
+	Method    *roachpb.Method   `json:"method,omitempty"`
+	Key       roachpb.Key       `json:"key,omitempty"`
+	Stacks    string            `json:"stacks,omitempty"`
+}
+
+// formatJSONEntry formats a log entry as a JSON object on a single line.
+// Newlines and quotes in the message are escaped, so that every line of
+// the output holds exactly one entry.
+func formatJSONEntry(entry *LogEntry) []byte {
+	je := jsonEntry{
+		Time:      time.Unix(0, entry.Time).UTC().Format(time.RFC3339Nano),
+		Goroutine: entry.Goroutine,
+		Caller:    fmt.Sprintf("%s:%d", entry.File, entry.Line),
+		Message:   entryMessage(entry),
+		NodeID:    entry.NodeID,
+		StoreID:   entry.StoreID,
+		RangeID:   entry.RangeID,
+		Method:    entry.Method,
+		Key:       entry.Key,
+		Stacks:    string(entry.Stacks),
+	}
+	if s := Severity(entry.Severity); s >= 0 && s < NumSeverity {
+		je.Severity = s.Name()
+	} else {
+		je.Severity = strconv.Itoa(int(s))
+	}
+	if len(entry.Fields) > 0 {
+		je.Fields = make(map[string]string, len(entry.Fields))
+		for _, field := range entry.Fields {
+			je.Fields[field.Key] = field.Value
+		}
+	}
+	data, err := json.Marshal(je)
+	if err != nil {
+		data = []byte(fmt.Sprintf("{\"error\": %q}", err))
+	}
+	return append(data, '\n')
+}
+
+// decodeJSONEntry decodes a line written by formatJSONEntry into entry. The
+// message is restored as a single argument, as its format and arguments are
+// not retained by the JSON format.
+func decodeJSONEntry(data []byte, entry *LogEntry) error {
+	var je jsonEntry
+	if err := json.Unmarshal(data, &je); err != nil {
+		return err
+	}
+	t, err := time.Parse(time.RFC3339Nano, je.Time)
+	if err != nil {
+		return err
+	}
+	*entry = LogEntry{
+		Time:      t.UnixNano(),
+		Goroutine: je.Goroutine,
+		Format:    "%s",
+		Args:      []LogEntry_Arg{{Str: je.Message}},
+		NodeID:    je.NodeID,
+		StoreID:   je.StoreID,
+		RangeID:   je.RangeID,
+		Method:    je.Method,
+		Key:       je.Key,
+	}
+	if s, ok := SeverityByName(je.Severity); ok {
+		entry.Severity = int32(s)
+	}
+	entry.File = je.Caller
+	if i := strings.LastIndex(je.Caller, ":"); i >= 0 {
+		if line, err := strconv.Atoi(je.Caller[i+1:]); err == nil {
+			entry.File, entry.Line = je.Caller[:i], int32(line)
+		}
+	}
+	keys := make([]string, 0, len(je.Fields))
+	for key := range je.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		entry.Fields = append(entry.Fields, LogEntry_Field{Key: key, Value: je.Fields[key]})
+	}
+	if len(je.Stacks) > 0 {
+		entry.Stacks = []byte(je.Stacks)
+	}
+	return nil
+}
+
+// FormatEntry formats a log entry, such as one returned by
+// FetchRecentEntries, in the format selected by the --log-format flag.
+func FormatEntry(entry *LogEntry) []byte {
+	if logging.format.get() == jsonFormat {
+		return formatJSONEntry(entry)
+	}
+	return formatLogEntry(entry, nil)
+}
+
+// goroutineID returns the id of the calling goroutine, as reported in the
+// header of its stack trace.
+func goroutineID() int64 {
+	var buf [64]byte
+	b := bytes.TrimPrefix(buf[:runtime.Stack(buf[:], false)], []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseInt(string(b), 10, 64)
+	return id
+}
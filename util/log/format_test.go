@@ -0,0 +1,138 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// captureStderr returns everything written to os.Stderr while running f.
+func captureStderr(t *testing.T, f func()) []byte {
+	file, err := ioutil.TempFile("", "format_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+	old := os.Stderr
+	os.Stderr = file
+	f()
+	os.Stderr = old
+	data, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestJSONFormat verifies that, with the JSON format selected, entries are
+// written to standard error and the log files as a JSON object per line,
+// that the recent entries are formatted identically, and that the entries
+// written to the log files can be decoded again.
+func TestJSONFormat(t *testing.T) {
+	setFlags()
+	defer logging.swap(logging.newBuffers())
+	logging.alsoToStderr = true
+	defer func() { logging.alsoToStderr = false }()
+	if err := logging.format.Set("json"); err != nil {
+		t.Fatal(err)
+	}
+	defer logging.format.set(textFormat)
+
+	ctx := WithFields(context.Background(), "user", "root", "query", `SELECT "a"`)
+	ctx = WithFields(ctx, "user", "admin")
+	const msg = "multi\nline \"quoted\" {message}"
+	stderr := captureStderr(t, func() {
+		Infoc(ctx, "multi\nline %q %s", "quoted", "{message}")
+	})
+
+	data := logging.file[InfoLog].(*flushBuffer).Bytes()
+	if bytes.Count(data, []byte("\n")) != 1 || !bytes.HasSuffix(data, []byte("\n")) {
+		t.Fatalf("expected a single line, got %q", data)
+	}
+	if !bytes.Equal(stderr, data) {
+		t.Errorf("expected standard error output %q to match log file output %q", stderr, data)
+	}
+	entries := FetchRecentEntries(InfoLog, 1, nil)
+	if len(entries) != 1 {
+		t.Fatalf("expected one recent entry, got %d", len(entries))
+	}
+	if formatted := FormatEntry(&entries[0]); !bytes.Equal(formatted, data) {
+		t.Errorf("expected recent entry output %q to match log file output %q", formatted, data)
+	}
+
+	var je struct {
+		Severity  string
+		Time      string
+		Goroutine int64
+		Caller    string
+		Message   string
+		Fields    map[string]string
+	}
+	if err := json.Unmarshal(data, &je); err != nil {
+		t.Fatal(err)
+	}
+	if je.Severity != "INFO" {
+		t.Errorf("expected severity INFO, got %s", je.Severity)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, je.Time); err != nil {
+		t.Error(err)
+	}
+	if je.Goroutine <= 0 {
+		t.Errorf("expected a goroutine id, got %d", je.Goroutine)
+	}
+	if !strings.HasPrefix(je.Caller, "format_test.go:") {
+		t.Errorf("expected caller in format_test.go, got %s", je.Caller)
+	}
+	if je.Message != msg {
+		t.Errorf("expected message %q, got %q", msg, je.Message)
+	}
+	if expFields := map[string]string{"user": "admin", "query": `SELECT "a"`}; !reflect.DeepEqual(je.Fields, expFields) {
+		t.Errorf("expected fields %v, got %v", expFields, je.Fields)
+	}
+
+	var entry LogEntry
+	if err := NewEntryDecoder(bytes.NewReader(data)).Decode(&entry); err != nil {
+		t.Fatal(err)
+	}
+	if m := entryMessage(&entry); m != msg {
+		t.Errorf("expected decoded message %q, got %q", msg, m)
+	}
+	if entry.Time != entries[0].Time || entry.Line != entries[0].Line || entry.Goroutine != je.Goroutine {
+		t.Errorf("expected decoded entry to match %+v, got %+v", entries[0], entry)
+	}
+}
+
+// TestTextFormatFields verifies that the text format prefixes the message
+// with the fields attached to the context.
+func TestTextFormatFields(t *testing.T) {
+	setFlags()
+	defer logging.swap(logging.newBuffers())
+	Infoc(WithFields(context.Background(), "user", "root", "txn", 5), "test")
+	if !contains(InfoLog, "] [user=root,txn=5] test\n", t) {
+		t.Errorf("expected fields in the text output, got %q", contents(InfoLog))
+	}
+}
@@ -51,6 +51,10 @@ type LogEntry struct {
 	Key     github_com_cockroachdb_cockroach_roachpb.Key      `protobuf:"bytes,12,opt,name=key,casttype=github.com/cockroachdb/cockroach/roachpb.Key" json:"key,omitempty"`
 	// Stack traces if requested.
 	Stacks []byte `protobuf:"bytes,13,opt,name=stacks" json:"stacks,omitempty"`
+	// Structured key/value context attached to the logging context.
+	Fields []LogEntry_Field `protobuf:"bytes,14,rep,name=fields" json:"fields"`
+	// Goroutine id of logging routine.
+	Goroutine int64 `protobuf:"varint,15,opt,name=goroutine" json:"goroutine"`
 }
 
 func (m *LogEntry) Reset()         { *m = LogEntry{} }
@@ -69,9 +73,20 @@ func (m *LogEntry_Arg) Reset()         { *m = LogEntry_Arg{} }
 func (m *LogEntry_Arg) String() string { return proto.CompactTextString(m) }
 func (*LogEntry_Arg) ProtoMessage()    {}
 
+// Structured key/value context.
+type LogEntry_Field struct {
+	Key   string `protobuf:"bytes,1,opt,name=key" json:"key"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value"`
+}
+
+func (m *LogEntry_Field) Reset()         { *m = LogEntry_Field{} }
+func (m *LogEntry_Field) String() string { return proto.CompactTextString(m) }
+func (*LogEntry_Field) ProtoMessage()    {}
+
 func init() {
 	proto.RegisterType((*LogEntry)(nil), "cockroach.util.log.LogEntry")
 	proto.RegisterType((*LogEntry_Arg)(nil), "cockroach.util.log.LogEntry.Arg")
+	proto.RegisterType((*LogEntry_Field)(nil), "cockroach.util.log.LogEntry.Field")
 }
 func (m *LogEntry) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -152,6 +167,21 @@ func (m *LogEntry) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintLog(data, i, uint64(len(m.Stacks)))
 		i += copy(data[i:], m.Stacks)
 	}
+	if len(m.Fields) > 0 {
+		for _, msg := range m.Fields {
+			data[i] = 0x72
+			i++
+			i = encodeVarintLog(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x78
+	i++
+	i = encodeVarintLog(data, i, uint64(m.Goroutine))
 	return i, nil
 }
 
@@ -187,6 +217,32 @@ func (m *LogEntry_Arg) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *LogEntry_Field) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LogEntry_Field) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintLog(data, i, uint64(len(m.Key)))
+	i += copy(data[i:], m.Key)
+	data[i] = 0x12
+	i++
+	i = encodeVarintLog(data, i, uint64(len(m.Value)))
+	i += copy(data[i:], m.Value)
+	return i, nil
+}
+
 func encodeFixed64Log(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	data[offset+1] = uint8(v >> 8)
@@ -251,6 +307,13 @@ func (m *LogEntry) Size() (n int) {
 		l = len(m.Stacks)
 		n += 1 + l + sovLog(uint64(l))
 	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovLog(uint64(l))
+		}
+	}
+	n += 1 + sovLog(uint64(m.Goroutine))
 	return n
 }
 
@@ -268,6 +331,16 @@ func (m *LogEntry_Arg) Size() (n int) {
 	return n
 }
 
+func (m *LogEntry_Field) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovLog(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovLog(uint64(l))
+	return n
+}
+
 func sovLog(x uint64) (n int) {
 	for {
 		n++
@@ -611,6 +684,56 @@ func (m *LogEntry) Unmarshal(data []byte) error {
 			}
 			m.Stacks = append([]byte{}, data[iNdEx:postIndex]...)
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, LogEntry_Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Goroutine", wireType)
+			}
+			m.Goroutine = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Goroutine |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLog(data[iNdEx:])
@@ -768,6 +891,114 @@ func (m *LogEntry_Arg) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *LogEntry_Field) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Field: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Field: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLog(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLog(data []byte) (n int, err error) {
 	l := len(data)
 	iNdEx := 0
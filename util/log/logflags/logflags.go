@@ -20,6 +20,7 @@ import (
 	"flag"
 	"strconv"
 	"sync"
+	"time"
 )
 
 type atomicBool struct {
@@ -57,7 +58,8 @@ var _ flag.Value = &atomicBool{}
 // InitFlags creates logging flags which update the given variables. The passed mutex is
 // locked while the boolean variables are accessed during flag updates.
 func InitFlags(mu sync.Locker, toStderr *bool, alsoToStderr *bool, logDir, color *string,
-	verbosity, vmodule, traceLocation flag.Value) {
+	verbosity, vmodule, traceLocation, format flag.Value, maxSize *uint64, maxFiles *int,
+	maxTotalSize *uint64, maxAge *time.Duration) {
 	*toStderr = true // wonky way of specifying a default
 	flag.Var(&atomicBool{Locker: mu, b: toStderr}, "logtostderr", "log to standard error instead of files")
 	flag.Var(&atomicBool{Locker: mu, b: alsoToStderr}, "alsologtostderr", "log to standard error as well as files")
@@ -68,5 +70,10 @@ func InitFlags(mu sync.Locker, toStderr *bool, alsoToStderr *bool, logDir, color
 	flag.Var(vmodule, "vmodule", "comma-separated list of pattern=N settings for file-filtered logging")
 	flag.Var(traceLocation, "log-backtrace-at", "when logging hits line file:N, emit a stack trace")
 	flag.StringVar(logDir, "log-dir", "", "if non-empty, write log files in this directory") // in util/log/file.go
+	flag.Var(format, "log-format", "format of log entries written to standard error and log files: text or json")
+	flag.Uint64Var(maxSize, "log-file-max-size", *maxSize, "roll over to a new log file once the current one exceeds this many bytes")
+	flag.IntVar(maxFiles, "log-max-files", *maxFiles, "maximum number of log files kept per severity; 0 for no limit")
+	flag.Uint64Var(maxTotalSize, "log-max-total-size", *maxTotalSize, "maximum combined size in bytes of the log files kept per severity; 0 for no limit")
+	flag.DurationVar(maxAge, "log-max-age", *maxAge, "remove log files not written to for longer than this; 0 for no limit")
 
 }
@@ -0,0 +1,73 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package log
+
+import (
+	"regexp"
+	"sync"
+)
+
+// recentEntriesSize is the number of log entries retained in memory.
+const recentEntriesSize = 1000
+
+// recentEntries is a fixed size ring buffer holding the most recently
+// logged entries, regardless of whether logging to files is enabled.
+type recentEntries struct {
+	mu      sync.Mutex
+	entries []LogEntry
+	next    int // Index at which the next entry is stored
+}
+
+var recent = newRecentEntries(recentEntriesSize)
+
+func newRecentEntries(size int) *recentEntries {
+	return &recentEntries{entries: make([]LogEntry, 0, size)}
+}
+
+// add stores an entry, evicting the oldest entry if the buffer is full.
+func (r *recentEntries) add(entry LogEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.entries) < cap(r.entries) {
+		r.entries = append(r.entries, entry)
+	} else {
+		r.entries[r.next] = entry
+	}
+	r.next = (r.next + 1) % cap(r.entries)
+}
+
+// fetch returns up to maxEntries of the stored entries which have the given
+// severity (or worse) and match pattern, if provided. The entries are
+// returned in reverse chronological order.
+func (r *recentEntries) fetch(severity Severity, maxEntries int, pattern *regexp.Regexp) []LogEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	results := []LogEntry{}
+	for i := 0; i < len(r.entries) && len(results) < maxEntries; i++ {
+		entry := r.entries[(r.next-1-i+len(r.entries))%len(r.entries)]
+		if Severity(entry.Severity) >= severity && entryMatches(entry, pattern) {
+			results = append(results, entry)
+		}
+	}
+	return results
+}
+
+// FetchRecentEntries returns up to maxEntries of the most recently logged
+// entries held in memory which match the log 'severity' (or worse) and the
+// regexp 'pattern' if provided. The log entries are returned in reverse
+// chronological order.
+func FetchRecentEntries(severity Severity, maxEntries int, pattern *regexp.Regexp) []LogEntry {
+	return recent.fetch(severity, maxEntries, pattern)
+}
@@ -0,0 +1,58 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+// TestRecentEntries verifies that the in-memory buffer retains only the most
+// recent entries and filters them by severity and pattern.
+func TestRecentEntries(t *testing.T) {
+	r := newRecentEntries(3)
+	for i, sev := range []Severity{InfoLog, ErrorLog, WarningLog, InfoLog, ErrorLog} {
+		r.add(LogEntry{Severity: int32(sev), Format: fmt.Sprintf("entry %d", i)})
+	}
+
+	formats := func(entries []LogEntry) []string {
+		var result []string
+		for _, entry := range entries {
+			result = append(result, entry.Format)
+		}
+		return result
+	}
+
+	testCases := []struct {
+		severity Severity
+		max      int
+		pattern  *regexp.Regexp
+		expected []string
+	}{
+		{InfoLog, 10, nil, []string{"entry 4", "entry 3", "entry 2"}},
+		{InfoLog, 2, nil, []string{"entry 4", "entry 3"}},
+		{WarningLog, 10, nil, []string{"entry 4", "entry 2"}},
+		{ErrorLog, 10, nil, []string{"entry 4"}},
+		{InfoLog, 10, regexp.MustCompile("3|2"), []string{"entry 3", "entry 2"}},
+		{InfoLog, 10, regexp.MustCompile("1"), nil},
+	}
+	for i, tc := range testCases {
+		if a := formats(r.fetch(tc.severity, tc.max, tc.pattern)); !reflect.DeepEqual(a, tc.expected) {
+			t.Errorf("%d: expected %q, got %q", i, tc.expected, a)
+		}
+	}
+}
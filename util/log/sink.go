@@ -0,0 +1,65 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package log
+
+import "sync"
+
+// A Sink receives a copy of every log entry, in addition to standard error
+// and the log files. Receive is called with the logging lock held, so it
+// must neither block nor log.
+type Sink interface {
+	Receive(entry *LogEntry)
+}
+
+// sinks holds the registered sinks. Each sink is kept behind a pointer so
+// that it can be removed even if the same sink was added more than once.
+var sinks struct {
+	sync.Mutex
+	sinks []*Sink
+}
+
+// AddSink makes sink receive every subsequently logged entry. It returns a
+// function which removes sink again.
+func AddSink(sink Sink) func() {
+	sinks.Lock()
+	defer sinks.Unlock()
+	s := &sink
+	sinks.sinks = append(sinks.sinks, s)
+	return func() {
+		sinks.Lock()
+		defer sinks.Unlock()
+		for i, other := range sinks.sinks {
+			if other == s {
+				sinks.sinks = append(sinks.sinks[:i], sinks.sinks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// sendToSinks passes the entry to the registered sinks.
+func sendToSinks(entry *LogEntry) {
+	sinks.Lock()
+	defer sinks.Unlock()
+	for _, s := range sinks.sinks {
+		(*s).Receive(entry)
+	}
+}
+
+// Message returns the message of the entry, formatted from its format
+// string and arguments.
+func (entry *LogEntry) Message() string {
+	return entryMessage(entry)
+}
@@ -75,6 +75,9 @@ func (entry *LogEntry) set(ctx context.Context, format string, args []interface{
 				}
 			}
 		}
+		if fields, ok := ctx.Value(fieldsKey{}).([]LogEntry_Field); ok {
+			entry.Fields = fields
+		}
 	}
 }
 
@@ -0,0 +1,250 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package syslog implements a log sink which forwards a copy of the log
+// entries to a syslog daemon, formatted as described in RFC 5424.
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/retry"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+const (
+	// DefaultFacility is the name of the facility of the forwarded entries
+	// unless another is configured.
+	DefaultFacility = "local0"
+
+	defaultMaxBuffered    = 1000
+	defaultDialTimeout    = 5 * time.Second
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 30 * time.Second
+
+	// timestampFormat is the RFC 3339 format with the microsecond precision
+	// allowed by RFC 5424.
+	timestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// facilities maps the names of the syslog facilities to their codes.
+var facilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// syslogSeverities maps the log severities to the syslog severities.
+var syslogSeverities = []int{
+	log.InfoLog:    6, // informational
+	log.WarningLog: 4, // warning
+	log.ErrorLog:   3, // error
+	log.FatalLog:   2, // critical
+}
+
+// Sink forwards the log entries of at least a minimum severity to a syslog
+// daemon. Entries are buffered and written by a single worker, so that a
+// slow or unavailable daemon doesn't block logging; entries are dropped
+// while the buffer is full. The worker reconnects with backoff after a
+// failure, retrying the entry it was writing.
+type Sink struct {
+	network, addr string
+	facility      int
+	severity      log.Severity
+
+	hostname string
+	appName  string
+	procID   string
+
+	dialTimeout    time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	delivered *metric.Counter
+	dropped   *metric.Counter
+	failures  *metric.Counter
+
+	entries chan log.LogEntry
+}
+
+// NewSink returns a sink forwarding the entries of the given severity or
+// worse to the syslog daemon at addr, with the given facility. The address
+// has the form network://address, where network is one of udp, tcp, unix or
+// unixgram, e.g. "udp://localhost:514" or "unixgram:///dev/log". The sink's
+// metrics are added to the registry.
+func NewSink(addr, facility string, severity log.Severity, registry *metric.Registry) (*Sink, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, util.Errorf("invalid syslog address %q: %s", addr, err)
+	}
+	s := &Sink{
+		network:        u.Scheme,
+		severity:       severity,
+		procID:         strconv.Itoa(os.Getpid()),
+		dialTimeout:    defaultDialTimeout,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		delivered:      registry.Counter("log.syslog.delivered"),
+		dropped:        registry.Counter("log.syslog.dropped"),
+		failures:       registry.Counter("log.syslog.failures"),
+		entries:        make(chan log.LogEntry, defaultMaxBuffered),
+	}
+	switch s.network {
+	case "udp", "tcp":
+		s.addr = u.Host
+	case "unix", "unixgram":
+		s.addr = u.Path
+	default:
+		return nil, util.Errorf("syslog address %q must use udp, tcp, unix or unixgram", addr)
+	}
+	if s.addr == "" {
+		return nil, util.Errorf("syslog address %q is missing a host or path", addr)
+	}
+	var ok bool
+	if s.facility, ok = facilities[strings.ToLower(facility)]; !ok {
+		return nil, util.Errorf("unknown syslog facility %q", facility)
+	}
+	if severity < log.InfoLog || severity > log.FatalLog {
+		return nil, util.Errorf("invalid syslog severity %d", severity)
+	}
+	if s.hostname, err = os.Hostname(); err != nil || s.hostname == "" {
+		s.hostname = "-"
+	}
+	if s.appName = filepath.Base(os.Args[0]); s.appName == "" {
+		s.appName = "-"
+	}
+	return s, nil
+}
+
+// Receive implements the log.Sink interface. It buffers a copy of the entry
+// unless its severity is below the sink's, dropping it if the buffer is
+// full.
+func (s *Sink) Receive(entry *log.LogEntry) {
+	if log.Severity(entry.Severity) < s.severity {
+		return
+	}
+	select {
+	case s.entries <- *entry:
+	default:
+		s.dropped.Inc(1)
+	}
+}
+
+// Start registers the sink with the logging package and starts the worker
+// which writes the buffered entries to the syslog daemon. The sink is
+// removed again when the stopper stops.
+func (s *Sink) Start(stopper *stop.Stopper) {
+	remove := log.AddSink(s)
+	stopper.RunWorker(func() {
+		defer remove()
+		var conn net.Conn
+		defer func() {
+			if conn != nil {
+				_ = conn.Close()
+			}
+		}()
+		for {
+			select {
+			case entry := <-s.entries:
+				msg := s.format(&entry)
+				for r := retry.Start(retry.Options{
+					InitialBackoff: s.initialBackoff,
+					MaxBackoff:     s.maxBackoff,
+					Closer:         stopper.ShouldStop(),
+				}); r.Next(); {
+					var err error
+					if conn == nil {
+						conn, err = net.DialTimeout(s.network, s.addr, s.dialTimeout)
+					}
+					if err == nil {
+						_, err = conn.Write(msg)
+					}
+					if err == nil {
+						s.delivered.Inc(1)
+						break
+					}
+					s.failures.Inc(1)
+					if conn != nil {
+						_ = conn.Close()
+						conn = nil
+					}
+				}
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// format formats the entry as an RFC 5424 message. The source location and
+// the fields of the entry precede its message. Over stream transports, the
+// message is prefixed with its length as described in RFC 6587.
+func (s *Sink) format(entry *log.LogEntry) []byte {
+	sev := log.Severity(entry.Severity)
+	if sev < log.InfoLog || sev > log.FatalLog {
+		sev = log.InfoLog
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s %s - - %s:%d ",
+		s.facility*8+syslogSeverities[sev],
+		time.Unix(0, entry.Time).UTC().Format(timestampFormat),
+		s.hostname, s.appName, s.procID, entry.File, entry.Line)
+	if len(entry.Fields) > 0 {
+		_ = buf.WriteByte('[')
+		for i, field := range entry.Fields {
+			if i > 0 {
+				_ = buf.WriteByte(',')
+			}
+			fmt.Fprintf(&buf, "%s=%s", field.Key, field.Value)
+		}
+		buf.WriteString("] ")
+	}
+	buf.WriteString(entry.Message())
+	if len(entry.Stacks) > 0 {
+		_ = buf.WriteByte('\n')
+		_, _ = buf.Write(entry.Stacks)
+	}
+	if s.network == "tcp" || s.network == "unix" {
+		return append([]byte(fmt.Sprintf("%d ", buf.Len())), buf.Bytes()...)
+	}
+	return buf.Bytes()
+}
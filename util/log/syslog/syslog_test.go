@@ -0,0 +1,139 @@
+// Copyright 2016 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package syslog
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// listen starts a UDP listener on the given address, which is chosen by the
+// system if it has port 0.
+func listen(t *testing.T, addr string) net.PacketConn {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+// receive returns the next message received by the listener whose text
+// contains substr, skipping the entries logged by the rest of the process.
+func receive(t *testing.T, conn net.PacketConn, substr string) string {
+	buf := make([]byte, 64<<10)
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("no message containing %q received: %s", substr, err)
+		}
+		if msg := string(buf[:n]); strings.Contains(msg, substr) {
+			return msg
+		}
+	}
+}
+
+// TestSinkDelivery verifies that the entries of at least the sink's severity
+// are delivered to the syslog daemon as RFC 5424 messages.
+func TestSinkDelivery(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	listener := listen(t, "127.0.0.1:0")
+	defer listener.Close()
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+
+	registry := metric.NewRegistry()
+	s, err := NewSink("udp://"+listener.LocalAddr().String(), "local3", log.WarningLog, registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Start(stopper)
+
+	log.Infof("syslog test: not delivered")
+	log.Warningc(log.WithFields(context.Background(), "user", "root"), "syslog test: %d", 1)
+	log.Errorf("syslog test: %d", 2)
+
+	// PRI is facility local3 (19) * 8 plus the severity: warning (4) and
+	// error (3) respectively.
+	for _, e := range []string{
+		`^<156>1 \d{4}-\d\d-\d\dT\d\d:\d\d:\d\d\.\d{6}Z \S+ \S+ \d+ - - syslog_test\.go:\d+ \[user=root\] syslog test: 1$`,
+		`^<155>1 \d{4}-\d\d-\d\dT\d\d:\d\d:\d\d\.\d{6}Z \S+ \S+ \d+ - - syslog_test\.go:\d+ syslog test: 2$`,
+	} {
+		if msg := receive(t, listener, "syslog test: "); !regexp.MustCompile(e).MatchString(msg) {
+			t.Errorf("expected message matching %s, got %q", e, msg)
+		}
+	}
+	if a, e := s.delivered.Count(), int64(2); a < e {
+		t.Errorf("expected at least %d delivered entries, got %d", e, a)
+	}
+}
+
+// TestSinkDropsWhilePaused verifies that entries are dropped while the
+// buffer is full because the syslog daemon is unavailable, and that the
+// sink reconnects once it is available again.
+func TestSinkDropsWhilePaused(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	listener := listen(t, "127.0.0.1:0")
+	addr := listener.LocalAddr().String()
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+
+	s, err := NewSink("udp://"+addr, DefaultFacility, log.InfoLog, metric.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.entries = make(chan log.LogEntry, 3)
+	s.initialBackoff = time.Millisecond
+	s.maxBackoff = 10 * time.Millisecond
+	s.Start(stopper)
+
+	log.Infof("syslog test: before pause")
+	receive(t, listener, "syslog test: before pause")
+
+	// Writes to the closed listener are refused, which leaves the worker
+	// retrying while the buffer fills up.
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		log.Infof("syslog test: paused")
+		if s.failures.Count() == 0 || s.dropped.Count() == 0 {
+			return util.Errorf("expected failures and dropped entries, got %d and %d",
+				s.failures.Count(), s.dropped.Count())
+		}
+		return nil
+	})
+
+	// Once the listener is back, the buffered entries are delivered and new
+	// entries are buffered again.
+	listener = listen(t, addr)
+	defer listener.Close()
+	receive(t, listener, "syslog test: paused")
+	log.Infof("syslog test: after pause")
+	receive(t, listener, "syslog test: after pause")
+}
@@ -35,6 +35,12 @@ type TimeScale struct {
 	d    time.Duration
 }
 
+// Name returns the name of the time scale, which is used as the suffix of
+// the names of metrics created for it.
+func (ts TimeScale) Name() string {
+	return ts.name
+}
+
 var scale1M = TimeScale{"1m", 1 * time.Minute}
 var scale10M = TimeScale{"10m", 10 * time.Minute}
 var scale1H = TimeScale{"1h", time.Hour}
@@ -123,6 +129,17 @@ func (h *Histogram) RecordValue(v int64) {
 	}
 }
 
+// RecordValues adds n occurrences of the given value to the histogram,
+// truncating if necessary.
+func (h *Histogram) RecordValues(v, n int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	maybeTick(h)
+	for h.windowed.Current.RecordValues(v, n) != nil {
+		v = h.maxVal
+	}
+}
+
 // Current returns a copy of the data currently in the window.
 func (h *Histogram) Current() *hdrhistogram.Histogram {
 	h.mu.Lock()
@@ -150,6 +167,13 @@ func (hs Histograms) RecordValue(v int64) {
 	}
 }
 
+// RecordValues calls through to each individual Histogram.
+func (hs Histograms) RecordValues(v, n int64) {
+	for _, h := range hs {
+		h.RecordValues(v, n)
+	}
+}
+
 // A Counter holds a single mutable atomic value.
 type Counter struct {
 	metrics.Counter
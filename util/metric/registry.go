@@ -83,6 +83,38 @@ func (r *Registry) Each(f func(name string, val interface{})) {
 	}
 }
 
+// get returns the metric with the given name, or nil if there is none.
+func (r *Registry) get(name string) interface{} {
+	var found interface{}
+	r.Each(func(n string, v interface{}) {
+		if n == name {
+			found = v
+		}
+	})
+	return found
+}
+
+// GetCounter returns the counter with the given name, or nil if there is no
+// such counter.
+func (r *Registry) GetCounter(name string) *Counter {
+	c, _ := r.get(name).(*Counter)
+	return c
+}
+
+// Reset sets the counter with the given name back to zero. Only counters can
+// be reset; an error is returned for any other metric and for unknown names.
+func (r *Registry) Reset(name string) error {
+	switch m := r.get(name).(type) {
+	case nil:
+		return fmt.Errorf("no metric named %q", name)
+	case *Counter:
+		m.Clear()
+		return nil
+	default:
+		return fmt.Errorf("metric %q of type %T cannot be reset", name, m)
+	}
+}
+
 // MarshalJSON marshals to JSON.
 func (r *Registry) MarshalJSON() ([]byte, error) {
 	m := make(map[string]interface{})
@@ -66,3 +66,41 @@ func TestRegistry(t *testing.T) {
 		t.Fatalf("missed names: %v", expNames)
 	}
 }
+
+func TestRegistryGetCounterAndReset(t *testing.T) {
+	r := NewRegistry()
+	sub := NewRegistry()
+	c := r.Counter("top.counter")
+	_ = r.Gauge("top.gauge")
+	subC := sub.Counter("counter")
+	r.MustAdd("bottom.%s#1", sub)
+
+	c.Inc(3)
+	subC.Inc(5)
+	if a := r.GetCounter("top.counter"); a != c {
+		t.Fatalf("expected %v, got %v", c, a)
+	}
+	if a := r.GetCounter("bottom.counter#1"); a != subC {
+		t.Fatalf("expected %v, got %v", subC, a)
+	}
+	for _, name := range []string{"top.gauge", "unknown"} {
+		if a := r.GetCounter(name); a != nil {
+			t.Errorf("%s: expected no counter, got %v", name, a)
+		}
+	}
+
+	if err := r.Reset("top.counter"); err != nil {
+		t.Fatal(err)
+	}
+	if a := c.Count(); a != 0 {
+		t.Errorf("expected reset counter to be zero, got %d", a)
+	}
+	if a, e := subC.Count(), int64(5); a != e {
+		t.Errorf("expected other counter to be unaffected with %d, got %d", e, a)
+	}
+	for _, name := range []string{"top.gauge", "unknown"} {
+		if err := r.Reset(name); err == nil {
+			t.Errorf("%s: expected reset to fail", name)
+		}
+	}
+}
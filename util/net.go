@@ -39,9 +39,37 @@ func (ln listener) Addr() net.Addr {
 	return ln.addr
 }
 
+// tlsListener is like the listener returned by tls.NewListener, but retrieves
+// the config for each connection it accepts.
+type tlsListener struct {
+	net.Listener
+	getConfig func() *tls.Config
+}
+
+// Accept waits for and returns the next connection, which is a TLS
+// connection unless the current config is nil.
+func (ln tlsListener) Accept() (net.Conn, error) {
+	conn, err := ln.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if config := ln.getConfig(); config != nil {
+		return tls.Server(conn, config), nil
+	}
+	return conn, nil
+}
+
 // ListenAndServe creates a listener and serves handler on it, closing
 // the listener when signalled by the stopper.
 func ListenAndServe(stopper *stop.Stopper, handler http.Handler, addr net.Addr, config *tls.Config) (net.Listener, error) {
+	return ListenAndServeReloadable(stopper, handler, addr, func() *tls.Config { return config })
+}
+
+// ListenAndServeReloadable is like ListenAndServe, but retrieves the TLS
+// config for every connection it accepts from getConfig, which allows the
+// config to be replaced, for instance to rotate certificates, while serving.
+// Connections are not encrypted while getConfig returns nil.
+func ListenAndServeReloadable(stopper *stop.Stopper, handler http.Handler, addr net.Addr, getConfig func() *tls.Config) (net.Listener, error) {
 	ln, err := net.Listen(addr.Network(), addr.String())
 	if err != nil {
 		return nil, err
@@ -51,9 +79,7 @@ func ListenAndServe(stopper *stop.Stopper, handler http.Handler, addr net.Addr,
 		return nil, err
 	}
 
-	if config != nil {
-		ln = tls.NewListener(ln, config)
-	}
+	ln = tlsListener{Listener: ln, getConfig: getConfig}
 
 	stopper.RunWorker(func() {
 		var mu sync.Mutex
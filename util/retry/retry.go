@@ -17,9 +17,12 @@
 package retry
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
 	"time"
+
+	"golang.org/x/net/context"
 )
 
 // Options provides reusable configuration of Retry objects.
@@ -30,14 +33,20 @@ type Options struct {
 	MaxRetries          int             // Maximum number of attempts (0 for infinite)
 	RandomizationFactor float64         // Randomize the backoff interval by constant
 	Closer              <-chan struct{} // Optionally end retry loop channel close.
+	AttemptTimeout      time.Duration   // Timeout of the context of each attempt (0 for none)
 }
 
 // Retry implements the public methods necessary to control an exponential-
 // backoff retry loop.
 type Retry struct {
 	opts           Options
+	ctx            context.Context
 	currentAttempt int
 	isReset        bool
+	// attemptCtx is the context of the current attempt, and cancelAttempt
+	// cancels it. Both are only set if opts.AttemptTimeout is.
+	attemptCtx    context.Context
+	cancelAttempt context.CancelFunc
 }
 
 // Start returns a new Retry initialized to some default values. The Retry can
@@ -56,11 +65,19 @@ func Start(opts Options) Retry {
 		opts.Multiplier = 2
 	}
 
-	r := Retry{opts: opts}
+	r := Retry{opts: opts, ctx: context.Background()}
 	r.Reset()
 	return r
 }
 
+// StartWithCtx returns a new Retry like Start, whose retry loop additionally
+// ends as soon as the context is done, including while backing off.
+func StartWithCtx(ctx context.Context, opts Options) Retry {
+	r := Start(opts)
+	r.ctx = ctx
+	return r
+}
+
 // Reset resets the Retry to its initial state, meaning that the next call to
 // Next will return true immediately and subsequent calls will behave as if
 // they had followed the very first attempt (i.e. their backoffs will be
@@ -92,10 +109,18 @@ func (r Retry) retryIn() time.Duration {
 // Next returns whether the retry loop should continue, and blocks for the
 // appropriate length of time before yielding back to the caller. If a stopper
 // is present, Next will eagerly return false when the stopper is stopped.
+// Likewise, Next returns false as soon as the context of the Retry is done.
 func (r *Retry) Next() bool {
+	r.finishAttempt()
+	select {
+	case <-r.ctx.Done():
+		return false
+	default:
+	}
+
 	if r.isReset {
 		r.isReset = false
-		return true
+		return r.startAttempt()
 	}
 
 	if r.opts.MaxRetries > 0 && r.currentAttempt == r.opts.MaxRetries {
@@ -106,8 +131,60 @@ func (r *Retry) Next() bool {
 	select {
 	case <-time.After(r.retryIn()):
 		r.currentAttempt++
-		return true
+		return r.startAttempt()
 	case <-r.opts.Closer:
 		return false
+	case <-r.ctx.Done():
+		return false
+	}
+}
+
+// Ctx returns the context of the current attempt. If the AttemptTimeout
+// option is set, the context is derived from the context of the Retry and is
+// done once the timeout expires or Next is called again. Otherwise, it is the
+// context of the Retry.
+func (r *Retry) Ctx() context.Context {
+	if r.attemptCtx != nil {
+		return r.attemptCtx
+	}
+	return r.ctx
+}
+
+// startAttempt derives the context of a new attempt, if the AttemptTimeout
+// option is set. It always returns true.
+func (r *Retry) startAttempt() bool {
+	if r.opts.AttemptTimeout > 0 {
+		r.attemptCtx, r.cancelAttempt = context.WithTimeout(r.ctx, r.opts.AttemptTimeout)
+	}
+	return true
+}
+
+// finishAttempt cancels the context of the current attempt, if any.
+func (r *Retry) finishAttempt() {
+	if r.cancelAttempt != nil {
+		r.cancelAttempt()
+		r.attemptCtx, r.cancelAttempt = nil, nil
+	}
+}
+
+// WithMaxAttempts calls fn until it succeeds, but at most n > 0 times, backing
+// off between attempts as configured by opts. fn is passed the context of
+// each attempt (see Retry.Ctx). Retrying stops early once ctx is done. The
+// last error returned by fn is returned, annotated with the number of
+// attempts made; if ctx is done before the first attempt, its error is
+// returned instead.
+func WithMaxAttempts(ctx context.Context, opts Options, n int, fn func(ctx context.Context) error) error {
+	r := StartWithCtx(ctx, opts)
+	defer r.finishAttempt()
+	var err error
+	attempts := 0
+	for ; attempts < n && r.Next(); attempts++ {
+		if err = fn(r.Ctx()); err == nil {
+			return nil
+		}
+	}
+	if attempts == 0 {
+		return ctx.Err()
 	}
+	return fmt.Errorf("failed after %d attempt(s): %s", attempts, err)
 }
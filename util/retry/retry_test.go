@@ -17,8 +17,11 @@
 package retry
 
 import (
+	"errors"
 	"testing"
 	"time"
+
+	"golang.org/x/net/context"
 )
 
 func TestRetryExceedsMaxBackoff(t *testing.T) {
@@ -113,3 +116,129 @@ func TestRetryStop(t *testing.T) {
 		t.Errorf("expected %d attempts, got %d", expAttempts, attempts)
 	}
 }
+
+func TestRetryCtxCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := Options{
+		InitialBackoff: time.Hour,
+		MaxBackoff:     time.Hour,
+		Multiplier:     2,
+	}
+
+	var attempts int
+	start := time.Now()
+	// Cancel the context while the retry loop backs off after the first
+	// attempt.
+	for r := StartWithCtx(ctx, opts); r.Next(); attempts++ {
+		time.AfterFunc(10*time.Millisecond, cancel)
+	}
+
+	if expAttempts := 1; attempts != expAttempts {
+		t.Errorf("expected %d attempts, got %d", expAttempts, attempts)
+	}
+	if duration := time.Since(start); duration > time.Minute {
+		t.Errorf("expected cancellation to end the backoff, ran for %s", duration)
+	}
+
+	// A retry loop with a done context makes no attempts.
+	r := StartWithCtx(ctx, opts)
+	if r.Next() {
+		t.Error("expected no attempt with a canceled context")
+	}
+}
+
+func TestRetryAttemptTimeout(t *testing.T) {
+	opts := Options{
+		InitialBackoff: time.Microsecond * 10,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		MaxRetries:     1,
+		AttemptTimeout: 10 * time.Millisecond,
+	}
+
+	var attempts int
+	for r := Start(opts); r.Next(); attempts++ {
+		ctx := r.Ctx()
+		if _, ok := ctx.Deadline(); !ok {
+			t.Fatal("expected the context of the attempt to have a deadline")
+		}
+		<-ctx.Done()
+		if ctx.Err() != context.DeadlineExceeded {
+			t.Errorf("expected the deadline of the attempt to be exceeded, got %v", ctx.Err())
+		}
+	}
+
+	if expAttempts := opts.MaxRetries + 1; attempts != expAttempts {
+		t.Errorf("expected %d attempts, got %d", expAttempts, attempts)
+	}
+
+	// The context of an attempt is canceled when the next one starts.
+	opts.AttemptTimeout = time.Hour
+	var prevCtx context.Context
+	for r := Start(opts); r.Next(); {
+		if prevCtx != nil {
+			if prevCtx.Err() != context.Canceled {
+				t.Errorf("expected the context of the previous attempt to be canceled, got %v", prevCtx.Err())
+			}
+			break
+		}
+		prevCtx = r.Ctx()
+	}
+}
+
+func TestWithMaxAttempts(t *testing.T) {
+	opts := Options{
+		InitialBackoff: time.Microsecond * 10,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+	errFail := errors.New("boom")
+
+	// Attempts stop once fn succeeds.
+	attempts := 0
+	if err := WithMaxAttempts(context.Background(), opts, 3, func(context.Context) error {
+		attempts++
+		if attempts < 2 {
+			return errFail
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("expected success, got %s", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	// The last error is returned, annotated with the number of attempts.
+	attempts = 0
+	if err := WithMaxAttempts(context.Background(), opts, 3, func(context.Context) error {
+		attempts++
+		return errFail
+	}); err == nil || err.Error() != "failed after 3 attempt(s): boom" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	// Attempts which exceed their timeout fail with their context's error.
+	opts.AttemptTimeout = time.Millisecond
+	if err := WithMaxAttempts(context.Background(), opts, 2, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}); err == nil || err.Error() != "failed after 2 attempt(s): "+context.DeadlineExceeded.Error() {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// No attempt is made with a done context.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := WithMaxAttempts(ctx, opts, 2, func(context.Context) error {
+		t.Error("unexpected attempt")
+		return nil
+	}); err != context.Canceled {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+}
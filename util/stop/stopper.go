@@ -19,9 +19,11 @@ package stop
 import (
 	"fmt"
 	"log"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/cockroach/util/caller"
 )
@@ -32,6 +34,18 @@ type Closer interface {
 	Close()
 }
 
+// DefaultQuiesceReportThreshold is the time after which a Quiesce() which is
+// still waiting for outstanding tasks logs the tasks it is waiting for.
+const DefaultQuiesceReportThreshold = 10 * time.Second
+
+// callsites caches the task keys of the callsites of RunTask() and
+// RunAsyncTask() by program counter, so that starting a task doesn't need
+// to format the caller's location.
+var callsites = struct {
+	sync.Mutex
+	keys map[uintptr]string
+}{keys: map[uintptr]string{}}
+
 // CloserFn is type that allows any function to be a Closer.
 type CloserFn func()
 
@@ -64,8 +78,12 @@ type Stopper struct {
 	drain    *sync.Cond     // Conditional variable to wait for outstanding tasks
 	draining bool           // true when Stop() has been called
 	numTasks int            // number of outstanding tasks
-	tasks    map[string]int
+	tasks    map[string]int // number of outstanding tasks by name
 	closers  []Closer
+
+	// quiesceReportThreshold is the time after which Quiesce() logs the
+	// outstanding tasks, and again every time it elapses while they remain.
+	quiesceReportThreshold time.Duration
 }
 
 // NewStopper returns an instance of Stopper.
@@ -75,6 +93,8 @@ func NewStopper() *Stopper {
 		stopper: make(chan struct{}),
 		stopped: make(chan struct{}),
 		tasks:   map[string]int{},
+
+		quiesceReportThreshold: DefaultQuiesceReportThreshold,
 	}
 	s.drain = sync.NewCond(&s.mu)
 	return s
@@ -97,42 +117,77 @@ func (s *Stopper) AddCloser(c Closer) {
 	s.closers = append(s.closers, c)
 }
 
+// SetQuiesceReportThreshold sets the time after which a Quiesce() which is
+// still waiting for outstanding tasks logs them, which defaults to
+// DefaultQuiesceReportThreshold.
+func (s *Stopper) SetQuiesceReportThreshold(threshold time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quiesceReportThreshold = threshold
+}
+
 // RunTask adds one to the count of tasks left to drain in the system. Any
 // worker which is a "first mover" when starting tasks must call this method
 // before starting work on a new task. First movers include
 // goroutines launched to do periodic work and the kv/db.go gateway which
-// accepts external client requests.
+// accepts external client requests. The task is named after its callsite.
 //
 // Returns false to indicate that the system is currently draining and
 // function f was not called.
 func (s *Stopper) RunTask(f func()) bool {
-	file, line, _ := caller.Lookup(1)
-	taskKey := fmt.Sprintf("%s:%d", file, line)
-	if !s.runPrelude(taskKey) {
+	return s.RunNamedTask(callsiteKey(1), f)
+}
+
+// RunNamedTask is like RunTask, but the task is reported under the given
+// name instead of its callsite.
+func (s *Stopper) RunNamedTask(name string, f func()) bool {
+	if !s.runPrelude(name) {
 		return false
 	}
 	// Call f.
-	defer s.runPostlude(taskKey)
+	defer s.runPostlude(name)
 	f()
 	return true
 }
 
 // RunAsyncTask runs function f in a goroutine. It returns false when the
-// Stopper is draining and the function is not executed.
+// Stopper is draining and the function is not executed. The task is named
+// after its callsite.
 func (s *Stopper) RunAsyncTask(f func()) bool {
-	file, line, _ := caller.Lookup(1)
-	taskKey := fmt.Sprintf("%s:%d", file, line)
-	if !s.runPrelude(taskKey) {
+	return s.RunAsyncNamedTask(callsiteKey(1), f)
+}
+
+// RunAsyncNamedTask is like RunAsyncTask, but the task is reported under the
+// given name instead of its callsite.
+func (s *Stopper) RunAsyncNamedTask(name string, f func()) bool {
+	if !s.runPrelude(name) {
 		return false
 	}
 	// Call f.
 	go func() {
-		defer s.runPostlude(taskKey)
+		defer s.runPostlude(name)
 		f()
 	}()
 	return true
 }
 
+// callsiteKey returns the file:line of the caller at the given depth, which
+// is looked up and formatted only the first time it is seen.
+func callsiteKey(depth int) string {
+	// Unlike runtime.Caller, runtime.Callers doesn't allocate.
+	var pcs [1]uintptr
+	runtime.Callers(depth+2, pcs[:])
+	callsites.Lock()
+	defer callsites.Unlock()
+	key, ok := callsites.keys[pcs[0]]
+	if !ok {
+		file, line, _ := caller.Lookup(depth + 1)
+		key = fmt.Sprintf("%s:%d", file, line)
+		callsites.keys[pcs[0]] = key
+	}
+	return key
+}
+
 func (s *Stopper) runPrelude(taskKey string) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -159,7 +214,8 @@ func (s *Stopper) NumTasks() int {
 	return s.numTasks
 }
 
-// A TaskMap is returned by RunningTasks().
+// A TaskMap is returned by RunningTasks(). It maps the names of the running
+// tasks to their counts.
 type TaskMap map[string]int
 
 // String implements fmt.Stringer and returns a sorted multi-line listing of
@@ -174,7 +230,7 @@ func (tm TaskMap) String() string {
 }
 
 // RunningTasks returns a map containing the count of running tasks keyed by
-// callsite.
+// name, which is their callsite unless they were started with a name.
 func (s *Stopper) RunningTasks() TaskMap {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -251,7 +307,9 @@ func (s *Stopper) IsStopped() <-chan struct{} {
 }
 
 // Quiesce moves the stopper to state draining and waits until all
-// tasks complete. This is used from Stop() and unittests.
+// tasks complete. This is used from Stop() and unittests. Whenever the
+// quiesce report threshold elapses while tasks remain, the outstanding
+// tasks are logged by name.
 func (s *Stopper) Quiesce() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -259,9 +317,27 @@ func (s *Stopper) Quiesce() {
 		s.draining = true
 		close(s.drainer)
 	}
+	if s.numTasks == 0 {
+		return
+	}
+	start := time.Now()
+	threshold := s.quiesceReportThreshold
+	reportAt := start.Add(threshold)
+	// Wake up the loop below when it's time to report.
+	timer := time.AfterFunc(threshold, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.drain.Broadcast()
+	})
+	defer timer.Stop()
 	for s.numTasks > 0 {
-		// Use stdlib "log" instead of "cockroach/util/log" due to import cycles.
-		log.Print("draining; tasks left:\n", s.runningTasksLocked())
+		if now := time.Now(); !now.Before(reportAt) {
+			// Use stdlib "log" instead of "cockroach/util/log" due to import cycles.
+			log.Printf("quiescing for %s; %d tasks left:\n%s",
+				now.Sub(start), s.numTasks, s.runningTasksLocked())
+			reportAt = now.Add(threshold)
+			timer.Reset(threshold)
+		}
 		// Unlock s.mu, wait for the signal, and lock s.mu.
 		s.drain.Wait()
 	}
@@ -17,6 +17,9 @@
 package stop_test
 
 import (
+	"log"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -370,3 +373,80 @@ func TestStopperShouldDrain(t *testing.T) {
 	close(waiting)
 	<-cleanup
 }
+
+// logWriter is an io.Writer which sends what is written to the standard
+// logger to a channel, dropping it if the channel is full.
+type logWriter chan string
+
+func (w logWriter) Write(p []byte) (int, error) {
+	select {
+	case w <- string(p):
+	default:
+	}
+	return len(p), nil
+}
+
+// TestStopperQuiesceReport verifies that a Quiesce() which is blocked by a
+// stuck task logs the outstanding tasks by name, including the names of
+// named tasks.
+func TestStopperQuiesceReport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	logs := make(logWriter, 10)
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+
+	s := stop.NewStopper()
+	s.SetQuiesceReportThreshold(time.Millisecond)
+	stuck := make(chan struct{})
+	if !s.RunAsyncNamedTask("stuck task", func() {
+		<-stuck
+	}) {
+		t.Fatal("expected RunAsyncNamedTask to succeed")
+	}
+	if tm := s.RunningTasks(); len(tm) != 1 || tm["stuck task"] != 1 {
+		t.Fatalf("expected a single running task named \"stuck task\", got %+v", tm)
+	}
+	done := make(chan struct{})
+	go func() {
+		s.Quiesce()
+		close(done)
+	}()
+	select {
+	case report := <-logs:
+		if !strings.Contains(report, "1 tasks left") || !strings.Contains(report, "stuck task") {
+			t.Errorf("expected the report to name the stuck task, got %q", report)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the quiesce report")
+	}
+	select {
+	case <-done:
+		t.Fatal("expected Quiesce to block on the stuck task")
+	default:
+	}
+
+	close(stuck)
+	<-done
+	if s.RunNamedTask("after quiesce", func() {}) {
+		t.Error("expected RunNamedTask to fail while draining")
+	}
+	s.Stop()
+}
+
+func BenchmarkStopperRunTask(b *testing.B) {
+	s := stop.NewStopper()
+	defer s.Stop()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.RunTask(func() {})
+	}
+}
+
+func BenchmarkStopperRunNamedTask(b *testing.B) {
+	s := stop.NewStopper()
+	defer s.Stop()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.RunNamedTask("benchmark", func() {})
+	}
+}
@@ -20,8 +20,10 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"math/rand"
 	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
 	"time"
 
@@ -67,7 +69,8 @@ type TraceItem struct {
 // through the system; when the request goes out of scope, a call to Finalize
 // marks the end of the Trace, at which point it publishes itself to an
 // associated `util.Feed`. A request may create multiple Traces as it passes
-// through different parts of a distributed systems.
+// through different parts of a distributed systems; Traces created on the same
+// node can be attached to each other using Context() and JoinTrace().
 // A Trace is not safe for concurrent access.
 //
 // TODO(tschottdorf): not allowing concurrent access is the right thing to do
@@ -87,6 +90,15 @@ type Trace struct {
 	depth   int32
 	family  string
 	nTrace  ntrace.Trace
+	// ctxID is the identifier under which this Trace is registered with its
+	// Tracer for other Traces to join, or zero if it isn't.
+	ctxID uint64
+	// parentID is the identifier of the Trace this Trace was joined to, if
+	// any. On Finalize, the Trace hands its content to that Trace.
+	parentID uint64
+	// joined holds the finalized Traces which joined this Trace and whose
+	// content hasn't been spliced into it yet. Protected by tracer.mu.
+	joined []*Trace
 }
 
 // Event adds an Epoch with zero duration to the Trace.
@@ -121,6 +133,7 @@ func (t *Trace) epoch(name string) func() {
 	if t.depth < 0 {
 		panic("use of finalized Trace:\n" + t.String())
 	}
+	t.splice()
 	t.depth++
 	t.nTrace.LazyPrintf(name)
 	pos := t.add(name)
@@ -130,6 +143,7 @@ func (t *Trace) epoch(name string) func() {
 			panic("epoch terminated twice")
 		}
 		called = true
+		t.splice()
 		t.Content[pos].Duration = t.tracer.now().Sub(t.Content[pos].Timestamp)
 		t.depth--
 		t.nTrace.LazyPrintf(name + " [end]")
@@ -141,6 +155,9 @@ func (t *Trace) epoch(name string) func() {
 func (t *Trace) Finalize() {
 	defer t.nTrace.Finish()
 	if t == nil || len(t.Content) == 0 {
+		if t != nil {
+			t.tracer.release(t)
+		}
 		return
 	}
 	if r := recover(); r != nil {
@@ -150,6 +167,8 @@ func (t *Trace) Finalize() {
 	if t.depth != 0 {
 		panic("attempt to finalize unbalanced trace:\n" + t.String())
 	}
+	t.splice()
+	t.tracer.release(t)
 	t.depth = math.MinInt32
 	if t.tracer.feed != nil {
 		t.tracer.feed.Publish(t) // by reference
@@ -174,6 +193,39 @@ func (t *Trace) add(name string) int {
 	return len(t.Content) - 1
 }
 
+// Context registers the Trace with its Tracer and returns an identifier which
+// can be passed to Tracer.JoinTrace, typically along with a request sent to
+// another component, to attach the Traces created for it to this Trace. The
+// identifier is valid until the Trace is finalized.
+func (t *Trace) Context() uint64 {
+	if t == nil {
+		return 0
+	}
+	if t.ctxID == 0 {
+		t.ctxID = t.tracer.register(t)
+	}
+	return t.ctxID
+}
+
+// splice appends the content of the finalized Traces which joined this Trace
+// to it, nested under the currently open Epoch.
+func (t *Trace) splice() {
+	if t.ctxID == 0 {
+		return
+	}
+	t.tracer.mu.Lock()
+	joined := t.joined
+	t.joined = nil
+	t.tracer.mu.Unlock()
+	for _, j := range joined {
+		t.nTrace.LazyPrintf("%s", j)
+		for _, item := range j.Content {
+			item.depth += t.depth
+			t.Content = append(t.Content, item)
+		}
+	}
+}
+
 // Fork creates a new Trace, equal to (but autonomous from) that which created
 // the original Trace.
 func (t *Trace) Fork() *Trace {
@@ -215,6 +267,10 @@ type Tracer struct {
 	origin string // owner of this Tracer, i.e. Host ID
 	feed   *util.Feed
 	now    func() time.Time
+	mu     struct {
+		sync.Mutex
+		active map[uint64]*Trace // Traces which may be joined, by ctxID
+	}
 }
 
 // NewTracer returns a new Tracer whose created Traces publish to the given feed.
@@ -239,6 +295,49 @@ func (t *Tracer) NewTrace(family string, tracee Traceable) *Trace {
 	return t.newTrace(family, tracee.TraceID(), tracee.TraceName())
 }
 
+// JoinTrace creates a Trace for the given Traceable which is attached to the
+// Trace identified by parent (as returned by Trace.Context) when finalized:
+// the content of the created Trace is then spliced into the parent Trace. If
+// the parent Trace isn't known to this Tracer (for instance because it was
+// created on a different node) or has been finalized, the created Trace is
+// independent.
+func (t *Tracer) JoinTrace(family string, tracee Traceable, parent uint64) *Trace {
+	trace := t.NewTrace(family, tracee)
+	trace.parentID = parent
+	return trace
+}
+
+// register makes the Trace available for joining and returns its identifier.
+func (t *Tracer) register(trace *Trace) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.mu.active == nil {
+		t.mu.active = map[uint64]*Trace{}
+	}
+	// Identifiers are random so that those of other nodes are unlikely to
+	// match a local Trace.
+	var id uint64
+	for id == 0 || t.mu.active[id] != nil {
+		id = uint64(rand.Int63())
+	}
+	t.mu.active[id] = trace
+	return id
+}
+
+// release unregisters a finalized Trace and hands it to its parent, if the
+// parent hasn't been finalized yet.
+func (t *Tracer) release(trace *Trace) {
+	if trace.ctxID == 0 && trace.parentID == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.mu.active, trace.ctxID)
+	if parent, ok := t.mu.active[trace.parentID]; ok && trace.parentID != 0 {
+		parent.joined = append(parent.joined, trace)
+	}
+}
+
 func (t *Tracer) newTrace(family string, id, name string) *Trace {
 	nt := ntrace.New(family, name)
 	nt.SetMaxEvents(100)
@@ -154,3 +154,44 @@ func TestEpochBalance(t *testing.T) {
 		t.Fatalf("should panic when Finalize is called too early")
 	}
 }
+
+func TestJoinTrace(t *testing.T) {
+	tracer := NewTracer(nil, "")
+	parent := tracer.NewTrace("foo", traceID(1))
+	sendDone := parent.Epoch("send")
+	ctxID := parent.Context()
+	if ctxID == 0 || parent.Context() != ctxID {
+		t.Fatalf("expected a stable nonzero context, got %d", ctxID)
+	}
+
+	child := tracer.JoinTrace("bar", traceID(1), ctxID)
+	child.Epoch("execute")()
+	child.Finalize()
+	// A Trace joined to an unknown context is independent.
+	other := NewTracer(nil, "").JoinTrace("bar", traceID(1), ctxID)
+	other.Event("elsewhere")
+	other.Finalize()
+	sendDone()
+
+	var names []string
+	var depths []int32
+	for _, item := range parent.Content {
+		names = append(names, item.Name)
+		depths = append(depths, item.depth)
+	}
+	if exp := []string{"send", "execute"}; !reflect.DeepEqual(names, exp) {
+		t.Errorf("expected items %v, got %v", exp, names)
+	}
+	if exp := []int32{1, 2}; !reflect.DeepEqual(depths, exp) {
+		t.Errorf("expected depths %v, got %v", exp, depths)
+	}
+	parent.Finalize()
+
+	// Once the parent is finalized, joined Traces are no longer attached.
+	late := tracer.JoinTrace("bar", traceID(1), ctxID)
+	late.Event("late")
+	late.Finalize()
+	if len(tracer.mu.active) != 0 || len(parent.joined) != 0 {
+		t.Errorf("expected no registered Traces, got %v", tracer.mu.active)
+	}
+}